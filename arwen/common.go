@@ -133,9 +133,42 @@ type VMHostParameters struct {
 	CreateNFTThroughExecByCallerEnableEpoch         uint32
 	UseDifferentGasCostForReadingCachedStorageEpoch uint32
 	FixFailExecutionOnErrorEnableEpoch              uint32
+	ExtendedEEIEnableEpoch                          uint32
 	TimeOutForSCExecutionInMilliseconds             uint32
+
+	// WasmBackend selects the engine that executes the contracts. Only the Go backend captures the call stack
+	// of the contracts that trap; with Wasmer, the backtraces shown by the tools, the logs and mandos say it is not available.
+	WasmBackend WasmBackend
+
+	// TrapBacktraceInLogs adds the call stack of the last contract that trapped to the internalVMErrors log,
+	// when WasmBackend is GoBackend; with Wasmer, it adds the trap and a note saying that there is no call stack.
+	// The log is part of the results of the transactions, so the nodes of a network must agree on this option.
+	TrapBacktraceInLogs bool
+
+	// ErrorCodesInLogs adds the internalVMErrorCodes log, which encodes the errors of the internalVMErrors log
+	// as a JSON array of ErrorDescription. Like TrapBacktraceInLogs, it changes the results of the transactions.
+	ErrorCodesInLogs bool
+
+	// MetricsRegistry records the metrics of the host, e.g. metrics.NewRegistry() which exports them for Prometheus;
+	// the metrics are disabled when it is nil
+	MetricsRegistry MetricsRegistry
+
+	// StateAccessTracing records the accounts and storage keys read and written by each transaction,
+	// which are available from VMHost.StateAccessTracer() after the execution
+	StateAccessTracing bool
 }
 
+// WasmBackend selects the engine that executes the smart contracts
+type WasmBackend uint8
+
+const (
+	// WasmerBackend executes the smart contracts with Wasmer, it is the default
+	WasmerBackend WasmBackend = iota
+
+	// GoBackend interprets the smart contracts in pure Go, without Wasmer; it is slower, and meant for tools and tests
+	GoBackend
+)
+
 // AsyncCallInfo contains the information required to handle the asynchronous call of another SmartContract
 type AsyncCallInfo struct {
 	Destination []byte
@@ -176,10 +209,12 @@ type AsyncGeneratedCall struct {
 	Destination     []byte
 	Data            []byte
 	GasLimit        uint64
+	GasLocked       uint64
 	ValueBytes      []byte
 	SuccessCallback string
 	ErrorCallback   string
 	ProvidedGas     uint64
+	CallbackClosure []byte
 }
 
 // AsyncContext is a structure containing a group of async calls and a callback
@@ -214,7 +249,15 @@ func (ac *AsyncGeneratedCall) GetGasLimit() uint64 {
 
 // GetGasLocked returns the gas locked for the async callback
 func (ac *AsyncGeneratedCall) GetGasLocked() uint64 {
-	return 0
+	return ac.GasLocked
+}
+
+// GetCallbackName returns the callback to be called after the async call finished with the given return code
+func (ac *AsyncGeneratedCall) GetCallbackName(returnCode vmcommon.ReturnCode) string {
+	if returnCode == vmcommon.Ok {
+		return ac.SuccessCallback
+	}
+	return ac.ErrorCallback
 }
 
 // GetValueBytes returns the byte representation of the value of the async call
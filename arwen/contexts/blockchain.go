@@ -51,6 +51,8 @@ func (context *blockchainContext) NewAddress(creatorAddress []byte) ([]byte, err
 
 // AccountExists returns true if there is already an account at the given address
 func (context *blockchainContext) AccountExists(address []byte) bool {
+	context.host.StateAccessTracer().RecordRead(address, arwen.AccountExistence)
+
 	account, err := context.blockChainHook.GetUserAccount(address)
 	if err != nil {
 		return false
@@ -69,6 +71,8 @@ func (context *blockchainContext) GetBalance(address []byte) []byte {
 // GetBalanceBigInt returns the balance of the account at the given address as a big int.
 // If there is no account at that address, 0 will be returned.
 func (context *blockchainContext) GetBalanceBigInt(address []byte) *big.Int {
+	context.host.StateAccessTracer().RecordRead(address, arwen.AccountBalance)
+
 	outputAccount, isNew := context.host.Output().GetOutputAccount(address)
 	if !isNew {
 		if outputAccount.Balance == nil {
@@ -97,6 +101,8 @@ func (context *blockchainContext) GetBalanceBigInt(address []byte) *big.Int {
 
 // GetNonce returns the nonce at which the account mapped to the given address is.
 func (context *blockchainContext) GetNonce(address []byte) (uint64, error) {
+	context.host.StateAccessTracer().RecordRead(address, arwen.AccountNonce)
+
 	outputAccount, isNew := context.host.Output().GetOutputAccount(address)
 
 	readNonceFromBlockChain := isNew || outputAccount.Nonce == 0
@@ -120,15 +126,20 @@ func (context *blockchainContext) IncreaseNonce(address []byte) {
 	nonce, _ := context.GetNonce(address)
 	outputAccount, _ := context.host.Output().GetOutputAccount(address)
 	outputAccount.Nonce = nonce + 1
+	context.host.StateAccessTracer().RecordWrite(address, arwen.AccountNonce)
 }
 
 // GetESDTToken returns the unmarshalled esdt token for the given address and nonce for NFTs
 func (context *blockchainContext) GetESDTToken(address []byte, tokenID []byte, nonce uint64) (*esdt.ESDigitalToken, error) {
+	context.host.StateAccessTracer().RecordStorageRead(address, arwen.ESDTStorageKey(tokenID, nonce))
+
 	return context.blockChainHook.GetESDTToken(address, tokenID, nonce)
 }
 
 // GetCodeHash returns the code hash that is set tho the given account
 func (context *blockchainContext) GetCodeHash(address []byte) []byte {
+	context.host.StateAccessTracer().RecordRead(address, arwen.AccountCode)
+
 	account, err := context.blockChainHook.GetUserAccount(address)
 	if err != nil {
 		return nil
@@ -143,6 +154,8 @@ func (context *blockchainContext) GetCodeHash(address []byte) []byte {
 
 // GetCode returns the code that is set tho the given account
 func (context *blockchainContext) GetCode(address []byte) ([]byte, error) {
+	context.host.StateAccessTracer().RecordRead(address, arwen.AccountCode)
+
 	outputAccount, isNew := context.host.Output().GetOutputAccount(address)
 	hasCode := !isNew && len(outputAccount.Code) > 0
 	if hasCode {
@@ -169,6 +182,8 @@ func (context *blockchainContext) GetCode(address []byte) ([]byte, error) {
 
 // GetCodeSize returns the size of the code that is set tho the given account.
 func (context *blockchainContext) GetCodeSize(address []byte) (int32, error) {
+	context.host.StateAccessTracer().RecordRead(address, arwen.AccountCode)
+
 	account, err := context.blockChainHook.GetUserAccount(address)
 	if err != nil || arwen.IfNil(account) {
 		return 0, err
@@ -251,6 +266,8 @@ func (context *blockchainContext) CurrentRandomSeed() []byte {
 // GetOwnerAddress returns the address of the owner of the SC that is set in the runtime context
 func (context *blockchainContext) GetOwnerAddress() ([]byte, error) {
 	scAddress := context.host.Runtime().GetSCAddress()
+	context.host.StateAccessTracer().RecordRead(scAddress, arwen.AccountCode)
+
 	scAccount, err := context.blockChainHook.GetUserAccount(scAddress)
 	if err != nil || arwen.IfNil(scAccount) {
 		return nil, err
@@ -266,11 +283,15 @@ func (context *blockchainContext) GetShardOfAddress(addr []byte) uint32 {
 
 // IsSmartContract returns true if the current address is the address of a SC.
 func (context *blockchainContext) IsSmartContract(addr []byte) bool {
+	context.host.StateAccessTracer().RecordRead(addr, arwen.AccountCode)
+
 	return context.blockChainHook.IsSmartContract(addr)
 }
 
 // IsPayable returns true if the SC at the given address is payable
 func (context *blockchainContext) IsPayable(sndAddress []byte, rcvAddress []byte) (bool, error) {
+	context.host.StateAccessTracer().RecordRead(rcvAddress, arwen.AccountCode)
+
 	return context.blockChainHook.IsPayable(sndAddress, rcvAddress)
 }
 
@@ -286,6 +307,8 @@ func (context *blockchainContext) GetCompiledCode(codeHash []byte) (bool, []byte
 
 // GetUserAccount returns a user account
 func (context *blockchainContext) GetUserAccount(address []byte) (vmcommon.UserAccountHandler, error) {
+	context.host.StateAccessTracer().RecordRead(address, arwen.AccountExistence)
+
 	return context.blockChainHook.GetUserAccount(address)
 }
 
@@ -185,6 +185,41 @@ func TestBlockchainContext_GetNonceAndIncrease(t *testing.T) {
 	require.Equal(t, uint64(91), nonce)
 }
 
+func TestBlockchainContext_StateAccess(t *testing.T) {
+	t.Parallel()
+
+	mockOutput := &contextmock.OutputContextMock{}
+	mockOutput.OutputAccountMock = &vmcommon.OutputAccount{}
+	mockOutput.OutputAccountIsNew = true
+
+	host := &contextmock.VMHostMock{
+		OutputContext: mockOutput,
+		StateAccess:   arwen.NewStateAccessTracer(),
+	}
+
+	mockWorld := worldmock.NewMockWorld()
+	mockWorld.AcctMap.PutAccounts(testAccounts)
+	blockchainContext, _ := NewBlockchainContext(host, mockWorld)
+
+	account := []byte("account_with_code")
+	_ = blockchainContext.GetBalanceBigInt(account)
+	_, _ = blockchainContext.GetCode(account)
+	_, _ = blockchainContext.GetESDTToken(account, []byte("TOKEN-abcdef"), 2)
+	blockchainContext.IncreaseNonce(account)
+
+	stateAccess := host.StateAccess.GetStateAccess()
+	reads := stateAccess.Reads.GetAccount(account)
+	require.True(t, reads.Balance)
+	require.True(t, reads.Code)
+	require.True(t, reads.Nonce)
+	require.Equal(t, [][]byte{arwen.ESDTStorageKey([]byte("TOKEN-abcdef"), 2)}, reads.SortedStorageKeys())
+
+	writes := stateAccess.Writes.GetAccount(account)
+	require.True(t, writes.Nonce)
+	require.False(t, writes.Balance)
+	require.Empty(t, writes.StorageKeys)
+}
+
 func TestBlockchainContext_GetCodeHashAndSize(t *testing.T) {
 	t.Parallel()
 
@@ -71,6 +71,7 @@ func (context *outputContext) PushState() {
 	newState := newVMOutput()
 	mergeVMOutputs(newState, context.outputState)
 	context.stateStack = append(context.stateStack, newState)
+	context.host.StateAccessTracer().PushState()
 }
 
 // PopSetActiveState removes the latest entry from the state stack and sets it as the current vm output
@@ -83,6 +84,7 @@ func (context *outputContext) PopSetActiveState() {
 	prevState := context.stateStack[stateStackLen-1]
 	context.stateStack = context.stateStack[:stateStackLen-1]
 	context.outputState = prevState
+	context.host.StateAccessTracer().PopSetActiveState()
 }
 
 // PopMergeActiveState merges the current state into the head of the stateStack,
@@ -102,6 +104,7 @@ func (context *outputContext) PopMergeActiveState() {
 	mergeVMOutputs(prevState, context.outputState)
 	context.outputState = newVMOutput()
 	mergeVMOutputs(context.outputState, prevState)
+	context.host.StateAccessTracer().PopDiscard()
 }
 
 // PopDiscard removes the latest entry from the state stack, but maintaining
@@ -113,11 +116,13 @@ func (context *outputContext) PopDiscard() {
 	}
 
 	context.stateStack = context.stateStack[:stateStackLen-1]
+	context.host.StateAccessTracer().PopDiscard()
 }
 
 // ClearStateStack reinitializes the state stack.
 func (context *outputContext) ClearStateStack() {
 	context.stateStack = make([]*vmcommon.VMOutput, 0)
+	context.host.StateAccessTracer().ClearStateStack()
 }
 
 // CensorVMOutput will cause the next executed SC to appear isolated, as if
@@ -289,6 +294,11 @@ func (context *outputContext) TransferValueOnly(destination []byte, sender []byt
 
 	senderAcc.BalanceDelta = big.NewInt(0).Sub(senderAcc.BalanceDelta, value)
 	destAcc.BalanceDelta = big.NewInt(0).Add(destAcc.BalanceDelta, value)
+	if hasValue {
+		stateAccess := context.host.StateAccessTracer()
+		stateAccess.RecordWrite(sender, arwen.AccountBalance)
+		stateAccess.RecordWrite(destination, arwen.AccountBalance)
+	}
 
 	return nil
 }
@@ -437,6 +447,9 @@ func (context *outputContext) hasSufficientBalance(address []byte, value *big.In
 func (context *outputContext) AddTxValueToAccount(address []byte, value *big.Int) {
 	destAcc, _ := context.GetOutputAccount(address)
 	destAcc.BalanceDelta = big.NewInt(0).Add(destAcc.BalanceDelta, value)
+	if value.Cmp(arwen.Zero) != 0 {
+		context.host.StateAccessTracer().RecordWrite(address, arwen.AccountBalance)
+	}
 }
 
 // RemoveNonUpdatedStorage removes non updated storage from output state
@@ -471,6 +484,7 @@ func (context *outputContext) DeployCode(input arwen.CodeDeployInput) {
 	newSCAccount.Code = input.ContractCode
 	newSCAccount.CodeMetadata = input.ContractCodeMetadata
 	newSCAccount.CodeDeployerAddress = input.CodeDeployerAddress
+	context.host.StateAccessTracer().RecordWrite(input.ContractAddress, arwen.AccountCode)
 
 	var empty struct{}
 	context.codeUpdates[string(input.ContractAddress)] = empty
@@ -6,9 +6,12 @@ import (
 	"fmt"
 	builtinMath "math"
 	"math/big"
+	"time"
 	"unsafe"
 
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen/eei"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen/wasmanalysis"
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/math"
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/wasmer"
 	"github.com/ElrondNetwork/elrond-go-core/core/atomic"
@@ -32,6 +35,7 @@ type runtimeContext struct {
 	scAddress          []byte
 	codeHash           []byte
 	codeSize           uint64
+	codeAPILevel       eei.APILevel
 	callFunction       string
 	vmType             []byte
 	readOnly           bool
@@ -45,13 +49,18 @@ type runtimeContext struct {
 
 	asyncCallInfo    *arwen.AsyncCallInfo
 	asyncContextInfo *arwen.AsyncContextInfo
+	callbackClosure  []byte
 
 	validator       *wasmValidator
 	instanceBuilder arwen.InstanceBuilder
 	errors          arwen.WrappableError
+	trapBacktrace   *arwen.TrapBacktrace
 
 	useDifferentGasCostForReadingCachedStorageEpoch uint32
 	flagEnableNewAPIMethods                         atomic.Flag
+
+	extendedEEIEnableEpoch uint32
+	flagExtendedEEI        atomic.Flag
 }
 
 type instanceAndMemory struct {
@@ -66,6 +75,7 @@ func NewRuntimeContext(
 	builtInFuncContainer vmcommon.BuiltInFunctionContainer,
 	epochNotifier vmcommon.EpochNotifier,
 	useDifferentGasCostForReadingCachedStorageEpoch uint32,
+	extendedEEIEnableEpoch uint32,
 ) (*runtimeContext, error) {
 	scAPINames := host.GetAPIMethods().Names()
 
@@ -77,6 +87,7 @@ func NewRuntimeContext(
 		validator:     newWASMValidator(scAPINames, builtInFuncContainer),
 		errors:        nil,
 		useDifferentGasCostForReadingCachedStorageEpoch: useDifferentGasCostForReadingCachedStorageEpoch,
+		extendedEEIEnableEpoch:                          extendedEEIEnableEpoch,
 	}
 
 	var err error
@@ -118,7 +129,9 @@ func (context *runtimeContext) InitState() {
 	context.asyncContextInfo = &arwen.AsyncContextInfo{
 		AsyncContextMap: make(map[string]*arwen.AsyncContext),
 	}
+	context.callbackClosure = nil
 	context.errors = nil
+	context.trapBacktrace = nil
 
 	logRuntime.Trace("init state")
 }
@@ -130,7 +143,7 @@ func (context *runtimeContext) ClearWarmInstanceCache() {
 }
 
 // ReplaceInstanceBuilder replaces the instance builder, allowing the creation
-// of mocked Wasmer instances; this is used for tests only
+// of mocked Wasmer instances in tests, or of instances of another backend
 func (context *runtimeContext) ReplaceInstanceBuilder(builder arwen.InstanceBuilder) {
 	context.instanceBuilder = builder
 }
@@ -143,20 +156,42 @@ func (context *runtimeContext) StartWasmerInstance(contract []byte, gasLimit uin
 		return arwen.ErrMaxInstancesReached
 	}
 
+	startTime := time.Now()
 	blockchain := context.host.Blockchain()
 	codeHash := blockchain.GetCodeHash(context.GetSCAddress())
 	context.codeHash = codeHash
 	warmInstanceUsed := context.useWarmInstanceIfExists(gasLimit, newCode)
 	if warmInstanceUsed {
+		context.recordInstance(arwen.InstanceSourceWarm, startTime)
 		return nil
 	}
 
+	startTime = time.Now()
 	compiledCodeUsed := context.makeInstanceFromCompiledCode(gasLimit, newCode)
 	if compiledCodeUsed {
+		context.recordInstance(arwen.InstanceSourceCompiledCode, startTime)
 		return nil
 	}
 
-	return context.makeInstanceFromContractByteCode(contract, gasLimit, newCode)
+	startTime = time.Now()
+	err := context.makeInstanceFromContractByteCode(contract, gasLimit, newCode)
+	if err == nil {
+		context.recordInstance(arwen.InstanceSourceBytecode, startTime)
+	}
+	return err
+}
+
+// recordInstance counts the instance started from the given source, and the time it took, if the metrics are enabled
+func (context *runtimeContext) recordInstance(source string, startTime time.Time) {
+	registry := context.host.MetricsRegistry()
+	if check.IfNil(registry) {
+		return
+	}
+
+	sourceLabel := arwen.MetricLabel{Name: arwen.MetricLabelSource, Value: source}
+	registry.Counter(arwen.MetricInstances, arwen.MetricInstancesHelp, sourceLabel).Add(1)
+	registry.Histogram(arwen.MetricInstanceDuration, arwen.MetricInstanceDurationHelp, arwen.MetricDurationBuckets, sourceLabel).
+		Observe(time.Since(startTime).Seconds())
 }
 
 func (context *runtimeContext) makeInstanceFromCompiledCode(gasLimit uint64, newCode bool) bool {
@@ -232,7 +267,10 @@ func (context *runtimeContext) makeInstanceFromContractByteCode(contract []byte,
 	context.instance.SetContextData(hostReference)
 
 	if newCode {
-		err = context.VerifyContractCode()
+		context.codeAPILevel, err = context.resolveAPILevel(contract)
+		if err == nil {
+			err = context.VerifyContractCode()
+		}
 		if err != nil {
 			context.cleanInstanceWhenError()
 			logRuntime.Trace("instance creation", "code", "bytecode", "error", err)
@@ -356,6 +394,7 @@ func (context *runtimeContext) InitStateFromContractCallInput(input *vmcommon.Co
 		CallerAddr:      input.CallerAddr,
 		AsyncContextMap: make(map[string]*arwen.AsyncContext),
 	}
+	context.callbackClosure = nil
 
 	logRuntime.Trace("init state from call input",
 		"caller", input.CallerAddr,
@@ -380,6 +419,7 @@ func (context *runtimeContext) PushState() {
 		readOnly:         context.readOnly,
 		asyncCallInfo:    context.asyncCallInfo,
 		asyncContextInfo: context.asyncContextInfo,
+		callbackClosure:  context.callbackClosure,
 	}
 	newState.SetVMInput(context.vmInput)
 
@@ -413,6 +453,7 @@ func (context *runtimeContext) PopSetActiveState() {
 	context.readOnly = prevState.readOnly
 	context.asyncCallInfo = prevState.asyncCallInfo
 	context.asyncContextInfo = prevState.asyncContextInfo
+	context.callbackClosure = prevState.callbackClosure
 	context.popInstance(lastCodeHash)
 }
 
@@ -606,8 +647,8 @@ func (context *runtimeContext) FailExecution(err error) {
 			breakpoint = arwen.BreakpointOutOfGas
 		}
 	} else {
-		message = "execution failed"
-		context.AddError(errors.New(message))
+		message = arwen.ErrExecutionFailed.Error()
+		context.AddError(arwen.ErrExecutionFailed)
 	}
 
 	context.host.Output().SetReturnMessage(message)
@@ -625,7 +666,7 @@ func (context *runtimeContext) SignalUserError(message string) {
 	context.host.Output().SetReturnCode(vmcommon.UserError)
 	context.host.Output().SetReturnMessage(message)
 	context.SetRuntimeBreakpointValue(arwen.BreakpointSignalError)
-	context.AddError(errors.New(message))
+	context.AddError(arwen.NewSignalledError(message))
 	logRuntime.Trace("user error signalled", "message", message)
 }
 
@@ -660,12 +701,10 @@ func (context *runtimeContext) VerifyContractCode() error {
 		return err
 	}
 
-	if !context.flagEnableNewAPIMethods.IsSet() {
-		err = context.checkBackwardCompatibility()
-		if err != nil {
-			logRuntime.Trace("verify contract code", "error", err)
-			return err
-		}
+	err = context.validator.verifyImports(context.instance, context.codeAPILevel)
+	if err != nil {
+		logRuntime.Trace("verify contract code", "error", err)
+		return err
 	}
 
 	logRuntime.Trace("verified contract code")
@@ -673,36 +712,35 @@ func (context *runtimeContext) VerifyContractCode() error {
 	return nil
 }
 
-func (context *runtimeContext) checkBackwardCompatibility() error {
-	if context.instance.IsFunctionImported("mBufferSetByteSlice") {
-		return arwen.ErrContractInvalid
-	}
-	if context.instance.IsFunctionImported("getESDTLocalRoles") {
-		return arwen.ErrContractInvalid
-	}
-	if context.instance.IsFunctionImported("validateTokenIdentifier") {
-		return arwen.ErrContractInvalid
-	}
-	if context.instance.IsFunctionImported("managedSha256") {
-		return arwen.ErrContractInvalid
-	}
-	if context.instance.IsFunctionImported("managedKeccak256") {
-		return arwen.ErrContractInvalid
+// resolveAPILevel returns the API level against which new contract code is validated: the level declared
+// by the code, or the highest level enabled on the network if the code declares none. Before the new API
+// methods are enabled all the contracts are on the initial level, and before the extended EEI is enabled
+// they are all on the new API methods level; declarations are only honoured, or rejected, afterwards.
+func (context *runtimeContext) resolveAPILevel(contract []byte) (eei.APILevel, error) {
+	if !context.flagEnableNewAPIMethods.IsSet() {
+		return eei.APILevelInitial, nil
 	}
-	if context.instance.IsFunctionImported("mBufferStorageLoadFromAddress") {
-		return arwen.ErrContractInvalid
+	if !context.flagExtendedEEI.IsSet() {
+		return eei.APILevelNewAPIMethods, nil
 	}
-	if context.instance.IsFunctionImported("cleanReturnData") {
-		return arwen.ErrContractInvalid
+
+	maxAPILevel := eei.APILevelExtendedEEI
+	declaredLevel, err := wasmanalysis.ReadAPILevel(contract)
+	if err == wasmanalysis.ErrInvalidAPILevel {
+		return eei.NoAPILevel, arwen.ErrUnsupportedAPILevel
 	}
-	if context.instance.IsFunctionImported("deleteFromReturnData") {
-		return arwen.ErrContractInvalid
+	if err != nil || declaredLevel == 0 {
+		// the code was already accepted by the instance builder, so sections which
+		// cannot be walked are treated as not declaring an API level
+		return maxAPILevel, nil
 	}
-	if context.instance.IsFunctionImported("completedTxEvent") {
-		return arwen.ErrContractInvalid
+
+	apiLevel := eei.APILevel(declaredLevel)
+	if !eei.IsValidAPILevel(apiLevel) || apiLevel > maxAPILevel {
+		return eei.NoAPILevel, arwen.ErrUnsupportedAPILevel
 	}
 
-	return nil
+	return apiLevel, nil
 }
 
 // ElrondAPIErrorShouldFailExecution returns true
@@ -905,6 +943,17 @@ func (context *runtimeContext) GetAsyncContext(contextIdentifier []byte) (*arwen
 	return asyncContext, nil
 }
 
+// SetCallbackClosure sets the closure of the async call whose callback is being executed
+func (context *runtimeContext) SetCallbackClosure(callbackClosure []byte) {
+	context.callbackClosure = callbackClosure
+}
+
+// GetCallbackClosure returns the closure of the async call whose callback is being executed,
+// as given by the contract which created the async call; it is empty outside of callbacks.
+func (context *runtimeContext) GetCallbackClosure() []byte {
+	return context.callbackClosure
+}
+
 // GetAsyncCallInfo returns the async call info for the current context.
 func (context *runtimeContext) GetAsyncCallInfo() *arwen.AsyncCallInfo {
 	return context.asyncCallInfo
@@ -1029,6 +1078,16 @@ func (context *runtimeContext) GetAllErrors() error {
 	return context.errors
 }
 
+// SetTrapBacktrace records the last trap of the execution; like the errors, it is kept until the next transaction
+func (context *runtimeContext) SetTrapBacktrace(backtrace *arwen.TrapBacktrace) {
+	context.trapBacktrace = backtrace
+}
+
+// GetTrapBacktrace returns the last trap of the execution, or nil if no contract trapped
+func (context *runtimeContext) GetTrapBacktrace() *arwen.TrapBacktrace {
+	return context.trapBacktrace
+}
+
 // DisableUseDifferentGasCostFlag - for tests
 func (context *runtimeContext) DisableUseDifferentGasCostFlag() {
 	context.flagEnableNewAPIMethods.Reset()
@@ -1038,6 +1097,9 @@ func (context *runtimeContext) DisableUseDifferentGasCostFlag() {
 func (context *runtimeContext) EpochConfirmed(epoch uint32, _ uint64) {
 	context.flagEnableNewAPIMethods.SetValue(epoch >= context.useDifferentGasCostForReadingCachedStorageEpoch)
 	log.Debug("Arwen VM: use different gas cost for reading cached storage", "enabled", context.flagEnableNewAPIMethods.IsSet())
+
+	context.flagExtendedEEI.SetValue(epoch >= context.extendedEEIEnableEpoch)
+	log.Debug("Arwen VM: extended EEI", "enabled", context.flagExtendedEEI.IsSet())
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
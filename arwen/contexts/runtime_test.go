@@ -8,8 +8,9 @@ import (
 	"testing"
 
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
-	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen/cryptoapi"
-	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen/elrondapi"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen/eei"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen/vmhooks"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen/wasmanalysis"
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/config"
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto/factory"
 	contextmock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/context"
@@ -27,10 +28,7 @@ const counterWasmCode = "./../../test/contracts/counter/output/counter.wasm"
 var vmType = []byte("type")
 
 func MakeAPIImports() *wasmer.Imports {
-	imports, _ := elrondapi.ElrondEIImports()
-	imports, _ = elrondapi.BigIntImports(imports)
-	imports, _ = elrondapi.SmallIntImports(imports)
-	imports, _ = cryptoapi.CryptoImports(imports)
+	imports, _ := vmhooks.WasmerImports()
 	return imports
 }
 
@@ -45,6 +43,7 @@ func InitializeArwenAndWasmer() *contextmock.VMHostMock {
 
 	host := &contextmock.VMHostMock{}
 	host.SCAPIMethods = imports
+	host.Hooks = vmhooks.NewVMHooks(host)
 
 	mockMetering := &contextmock.MeteringContextMock{}
 	mockMetering.SetGasSchedule(gasSchedule)
@@ -62,6 +61,7 @@ func makeDefaultRuntimeContext(t *testing.T, host arwen.VMHost) *runtimeContext
 		builtInFunctions.NewBuiltInFunctionContainer(),
 		epochNotifier,
 		0,
+		0,
 	)
 	require.Nil(t, err)
 	require.NotNil(t, runtimeContext)
@@ -687,3 +687,74 @@ func TestRuntimeContext_PopInstanceIfStackIsEmptyShouldNotPanic(t *testing.T) {
 
 	require.Equal(t, 0, len(runtimeContext.stateStack))
 }
+
+func TestRuntimeContext_ResolveAPILevel(t *testing.T) {
+	host := InitializeArwenAndWasmer()
+	runtimeContext := makeDefaultRuntimeContext(t, host)
+	defer runtimeContext.ClearWarmInstanceCache()
+
+	codeWithAPILevel := func(levelSection ...byte) []byte {
+		code := []byte("\x00asm\x01\x00\x00\x00")
+		contents := append([]byte{byte(len(wasmanalysis.APILevelSectionName))}, wasmanalysis.APILevelSectionName...)
+		contents = append(contents, levelSection...)
+		code = append(code, 0, byte(len(contents)))
+		return append(code, contents...)
+	}
+
+	counterCode := arwen.GetSCCode(counterWasmCode)
+	apiLevel, err := runtimeContext.resolveAPILevel(counterCode)
+	require.Nil(t, err)
+	require.Equal(t, eei.LatestAPILevel, apiLevel)
+
+	apiLevel, err = runtimeContext.resolveAPILevel([]byte("contract"))
+	require.Nil(t, err)
+	require.Equal(t, eei.LatestAPILevel, apiLevel)
+
+	apiLevel, err = runtimeContext.resolveAPILevel(codeWithAPILevel(byte(eei.APILevelInitial)))
+	require.Nil(t, err)
+	require.Equal(t, eei.APILevelInitial, apiLevel)
+
+	_, err = runtimeContext.resolveAPILevel(codeWithAPILevel(byte(eei.LatestAPILevel + 1)))
+	require.Equal(t, arwen.ErrUnsupportedAPILevel, err)
+	require.True(t, errors.Is(err, arwen.ErrContractInvalid))
+
+	_, err = runtimeContext.resolveAPILevel(codeWithAPILevel(0))
+	require.Equal(t, arwen.ErrUnsupportedAPILevel, err)
+
+	runtimeContext.flagExtendedEEI.Reset()
+	apiLevel, err = runtimeContext.resolveAPILevel(counterCode)
+	require.Nil(t, err)
+	require.Equal(t, eei.APILevelNewAPIMethods, apiLevel)
+
+	apiLevel, err = runtimeContext.resolveAPILevel(codeWithAPILevel(byte(eei.APILevelInitial)))
+	require.Nil(t, err)
+	require.Equal(t, eei.APILevelNewAPIMethods, apiLevel)
+
+	apiLevel, err = runtimeContext.resolveAPILevel(codeWithAPILevel(byte(eei.LatestAPILevel + 1)))
+	require.Nil(t, err)
+	require.Equal(t, eei.APILevelNewAPIMethods, apiLevel)
+
+	runtimeContext.DisableUseDifferentGasCostFlag()
+	apiLevel, err = runtimeContext.resolveAPILevel(codeWithAPILevel(byte(eei.LatestAPILevel + 1)))
+	require.Nil(t, err)
+	require.Equal(t, eei.APILevelInitial, apiLevel)
+}
+
+func TestRuntimeContext_ExtendedEEIEnableEpoch(t *testing.T) {
+	host := InitializeArwenAndWasmer()
+	runtimeContext := makeDefaultRuntimeContext(t, host)
+	defer runtimeContext.ClearWarmInstanceCache()
+	runtimeContext.extendedEEIEnableEpoch = 2
+
+	counterCode := arwen.GetSCCode(counterWasmCode)
+
+	runtimeContext.EpochConfirmed(1, 0)
+	apiLevel, err := runtimeContext.resolveAPILevel(counterCode)
+	require.Nil(t, err)
+	require.Equal(t, eei.APILevelNewAPIMethods, apiLevel)
+
+	runtimeContext.EpochConfirmed(2, 0)
+	apiLevel, err = runtimeContext.resolveAPILevel(counterCode)
+	require.Nil(t, err)
+	require.Equal(t, eei.APILevelExtendedEEI, apiLevel)
+}
@@ -145,6 +145,7 @@ func (context *storageContext) GetStorageFromAddress(address []byte, key []byte)
 			return nil, false
 		}
 
+		context.host.StateAccessTracer().RecordRead(address, arwen.AccountCode)
 		metadata := vmcommon.CodeMetadataFromBytes(userAcc.GetCodeMetadata())
 		if !metadata.Readable {
 			context.useExtraGasForKeyIfNeeded(key, false)
@@ -167,6 +168,8 @@ func (context *storageContext) GetStorageFromAddress(address []byte, key []byte)
 }
 
 func (context *storageContext) getStorageFromAddressUnmetered(address []byte, key []byte) ([]byte, bool) {
+	context.host.StateAccessTracer().RecordStorageRead(address, key)
+
 	var value []byte
 
 	if context.isElrondReservedKey(key) && context.flagUseDifferentGasCostForReadingCachedStorage.IsSet() {
@@ -251,6 +254,7 @@ func (context *storageContext) SetStorage(key []byte, value []byte) (arwen.Stora
 	}
 
 	context.changeStorageUpdate(key, value, storageUpdates)
+	context.host.StateAccessTracer().RecordStorageWrite(context.address, key)
 
 	if len(oldValue) == 0 {
 		return context.storageAdded(length, key, value)
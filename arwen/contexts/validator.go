@@ -5,6 +5,7 @@ import (
 	"unicode"
 
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen/eei"
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/wasmer"
 	"github.com/ElrondNetwork/elrond-vm-common"
 )
@@ -13,13 +14,15 @@ const noArity = -1
 
 // wasmValidator is a validator for WASM SmartContracts
 type wasmValidator struct {
-	reserved *reservedFunctions
+	reserved    *reservedFunctions
+	eeiVersions map[string]eei.FunctionVersion
 }
 
 // newWASMValidator creates a new WASMValidator
 func newWASMValidator(scAPINames vmcommon.FunctionNames, builtInFuncContainer vmcommon.BuiltInFunctionContainer) *wasmValidator {
 	return &wasmValidator{
-		reserved: NewReservedFunctions(scAPINames, builtInFuncContainer),
+		reserved:    NewReservedFunctions(scAPINames, builtInFuncContainer),
+		eeiVersions: eei.GetVersionedFunctions(),
 	}
 }
 
@@ -47,6 +50,21 @@ func (validator *wasmValidator) verifyFunctions(instance wasmer.InstanceHandler)
 	return nil
 }
 
+// verifyImports rejects the contracts which import EEI functions not available at their API level
+func (validator *wasmValidator) verifyImports(instance wasmer.InstanceHandler, apiLevel eei.APILevel) error {
+	for functionName, version := range validator.eeiVersions {
+		if version.IsAvailableAt(apiLevel) {
+			continue
+		}
+		if instance.IsFunctionImported(functionName) {
+			logRuntime.Trace("EEI function not available", "function", functionName, "API level", apiLevel)
+			return arwen.ErrContractInvalid
+		}
+	}
+
+	return nil
+}
+
 func (validator *wasmValidator) verifyVoidFunction(instance wasmer.InstanceHandler, functionName string) error {
 	inArity, err := validator.getInputArity(instance, functionName)
 	if err != nil {
@@ -5,7 +5,9 @@ import (
 	"testing"
 
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen/eei"
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen/mock"
+	contextmock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/context"
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/wasmer"
 	"github.com/ElrondNetwork/elrond-vm-common/builtInFunctions"
 	"github.com/stretchr/testify/require"
@@ -95,3 +97,30 @@ func TestFunctionsGuard_Arity(t *testing.T) {
 	err = validator.verifyVoidFunction(instance, "wrongParamsAndReturn")
 	require.NotNil(t, err)
 }
+
+func TestFunctionsGuard_VerifyImports(t *testing.T) {
+	imports := MakeAPIImports()
+	validator := newWASMValidator(imports.Names(), builtInFunctions.NewBuiltInFunctionContainer())
+
+	instance := contextmock.NewInstanceMock(nil)
+	instance.AddMockMethod("getCaller", func() *contextmock.InstanceMock { return instance })
+	require.Nil(t, validator.verifyImports(instance, eei.APILevelInitial))
+	require.Nil(t, validator.verifyImports(instance, eei.APILevelNewAPIMethods))
+
+	instance.AddMockMethod("managedSha256", func() *contextmock.InstanceMock { return instance })
+	require.Equal(t, arwen.ErrContractInvalid, validator.verifyImports(instance, eei.APILevelInitial))
+	require.Nil(t, validator.verifyImports(instance, eei.APILevelNewAPIMethods))
+
+	instance.AddMockMethod("managedSha512", func() *contextmock.InstanceMock { return instance })
+	require.Equal(t, arwen.ErrContractInvalid, validator.verifyImports(instance, eei.APILevelNewAPIMethods))
+	require.Nil(t, validator.verifyImports(instance, eei.APILevelExtendedEEI))
+
+	validator.eeiVersions["getCaller"] = eei.FunctionVersion{
+		Introduced: eei.APILevelInitial,
+		Deprecated: eei.APILevelNewAPIMethods,
+	}
+	instance = contextmock.NewInstanceMock(nil)
+	instance.AddMockMethod("getCaller", func() *contextmock.InstanceMock { return instance })
+	require.Nil(t, validator.verifyImports(instance, eei.APILevelInitial))
+	require.Equal(t, arwen.ErrContractInvalid, validator.verifyImports(instance, eei.APILevelNewAPIMethods))
+}
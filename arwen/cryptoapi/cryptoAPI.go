@@ -0,0 +1,69 @@
+package cryptoapi
+
+import (
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto"
+)
+
+// CryptoAPI implements the cryptographic functions of the VMHooks, on top of a VM host,
+// without depending on the executor calling them
+type CryptoAPI struct {
+	host arwen.VMHost
+}
+
+// NewCryptoAPI creates the cryptographic functions of the VMHooks, for the given host
+func NewCryptoAPI(host arwen.VMHost) *CryptoAPI {
+	return &CryptoAPI{
+		host: host,
+	}
+}
+
+// GetVMHost returns the host the functions run on
+func (context *CryptoAPI) GetVMHost() arwen.VMHost {
+	return context.host
+}
+
+// GetBlockchainContext returns the blockchain context
+func (context *CryptoAPI) GetBlockchainContext() arwen.BlockchainContext {
+	return context.host.Blockchain()
+}
+
+// GetRuntimeContext returns the runtime context
+func (context *CryptoAPI) GetRuntimeContext() arwen.RuntimeContext {
+	return context.host.Runtime()
+}
+
+// GetCryptoContext returns the crypto context
+func (context *CryptoAPI) GetCryptoContext() crypto.VMCrypto {
+	return context.host.Crypto()
+}
+
+// GetManagedTypesContext returns the managed types context
+func (context *CryptoAPI) GetManagedTypesContext() arwen.ManagedTypesContext {
+	return context.host.ManagedTypes()
+}
+
+// GetOutputContext returns the output context
+func (context *CryptoAPI) GetOutputContext() arwen.OutputContext {
+	return context.host.Output()
+}
+
+// GetMeteringContext returns the metering context
+func (context *CryptoAPI) GetMeteringContext() arwen.MeteringContext {
+	return context.host.Metering()
+}
+
+// GetStorageContext returns the storage context
+func (context *CryptoAPI) GetStorageContext() arwen.StorageContext {
+	return context.host.Storage()
+}
+
+// WithFault returns true if the error is not nil, and uses the remaining gas if the execution has failed
+func (context *CryptoAPI) WithFault(err error, failExecution bool) bool {
+	return arwen.WithFaultAndHost(context.host, err, failExecution)
+}
+
+// WithFaultIfFailAlwaysActive fails the execution with the provided error, if the fix of the failed executions is enabled
+func (context *CryptoAPI) WithFaultIfFailAlwaysActive(err error, failExecution bool) {
+	arwen.WithFaultAndHostIfFailAlwaysActive(err, context.host, failExecution)
+}
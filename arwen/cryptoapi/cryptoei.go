@@ -1,45 +1,13 @@
 package cryptoapi
 
-// // Declare the function signatures (see [cgo](https://golang.org/cmd/cgo/)).
-//
-// #include <stdlib.h>
-// typedef unsigned char uint8_t;
-// typedef int int32_t;
-//
-// extern int32_t v1_4_sha256(void* context, int32_t dataOffset, int32_t length, int32_t resultOffset);
-// extern int32_t v1_4_managedSha256(void *context, int32_t inputHanle, int32_t outputHandle);
-// extern int32_t v1_4_keccak256(void *context, int32_t dataOffset, int32_t length, int32_t resultOffset);
-// extern int32_t v1_4_managedKeccak256(void *context, int32_t inputHanle, int32_t outputHandle);
-// extern int32_t v1_4_ripemd160(void *context, int32_t dataOffset, int32_t length, int32_t resultOffset);
-// extern int32_t v1_4_verifyBLS(void *context, int32_t keyOffset, int32_t messageOffset, int32_t messageLength, int32_t sigOffset);
-// extern int32_t v1_4_verifyEd25519(void *context, int32_t keyOffset, int32_t messageOffset, int32_t messageLength, int32_t sigOffset);
-// extern int32_t v1_4_verifySecp256k1(void *context, int32_t keyOffset, int32_t keyLength, int32_t messageOffset, int32_t messageLength, int32_t sigOffset);
-// extern int32_t v1_4_verifyCustomSecp256k1(void *context, int32_t keyOffset, int32_t keyLength, int32_t messageOffset, int32_t messageLength, int32_t sigOffset, int32_t hashType);
-// extern int32_t v1_4_encodeSecp256k1DerSignature(void *context, int32_t rOffset, int32_t rLength, int32_t sOffset, int32_t sLength, int32_t sigOffset);
-// extern void v1_4_addEC(void *context, int32_t xResultHandle, int32_t yResultHandle, int32_t ecHandle, int32_t fstPointXHandle, int32_t fstPointYHandle, int32_t sndPointXHandle, int32_t sndPointYHandle);
-// extern void v1_4_doubleEC(void *context, int32_t xResultHandle, int32_t yResultHandle, int32_t ecHandle, int32_t pointXHandle, int32_t pointYHandle);
-// extern int32_t v1_4_isOnCurveEC(void *context, int32_t ecHandle, int32_t pointXHandle, int32_t pointYHandle);
-// extern int32_t v1_4_scalarBaseMultEC(void *context, int32_t xResultHandle, int32_t yResultHandle, int32_t ecHandle, int32_t dataOffset, int32_t length);
-// extern int32_t v1_4_scalarMultEC(void *context, int32_t xResultHandle, int32_t yResultHandle, int32_t ecHandle, int32_t pointXHandle, int32_t pointYHandle, int32_t dataOffset, int32_t length);
-// extern int32_t v1_4_marshalEC(void *context, int32_t xPairHandle, int32_t yPairHandle, int32_t ecHandle, int32_t resultOffset);
-// extern int32_t v1_4_unmarshalEC(void *context, int32_t xResultHandle, int32_t yResultHandle, int32_t ecHandle, int32_t dataOffset, int32_t length);
-// extern int32_t v1_4_marshalCompressedEC(void *context, int32_t xPairHandle, int32_t yPairHandle, int32_t ecHandle, int32_t resultOffset);
-// extern int32_t v1_4_unmarshalCompressedEC(void *context, int32_t xResultHandle, int32_t yResultHandle, int32_t ecHandle, int32_t dataOffset, int32_t length);
-// extern int32_t v1_4_generateKeyEC(void *context, int32_t xPubKeyHandle, int32_t yPubKeyHandle, int32_t ecHandle, int32_t resultOffset);
-// extern int32_t v1_4_createEC(void *context, int32_t dataOffset, int32_t dataLength);
-// extern int32_t v1_4_getCurveLengthEC(void *context, int32_t ecHandle);
-// extern int32_t v1_4_getPrivKeyByteLengthEC(void *context, int32_t ecHandle);
-// extern int32_t v1_4_ellipticCurveGetValues(void *context, int32_t ecHandle, int32_t fieldOrderHandle, int32_t basePointOrderHandle, int32_t eqConstantHandle, int32_t xBasePointHandle, int32_t yBasePointHandle);
-import "C"
-
 import (
 	"crypto/elliptic"
-	"unsafe"
 
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/config"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto/pairing"
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto/signing/secp256k1"
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/math"
-	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/wasmer"
 )
 
 const blsPublicKeyLength = 96
@@ -49,16 +17,38 @@ const ed25519SignatureLength = 64
 const secp256k1CompressedPublicKeyLength = 33
 const secp256k1UncompressedPublicKeyLength = 65
 const secp256k1SignatureLength = 64
+const secp256r1CompressedPublicKeyLength = 33
+const secp256r1UncompressedPublicKeyLength = 65
+const schnorrPublicKeyLength = 32
+const schnorrSignatureLength = 64
+const poseidonBlockLength = 32
 const curveNameLength = 4
+const bn254PairingCurveMultiplier = 100
+const bls12381PairingCurveMultiplier = 250
 
 const (
 	sha256Name                      = "sha256"
 	keccak256Name                   = "keccak256"
 	ripemd160Name                   = "ripemd160"
+	sha512Name                      = "sha512"
+	sha3256Name                     = "sha3256"
+	blake2b256Name                  = "blake2b256"
+	blake2b512Name                  = "blake2b512"
+	poseidonName                    = "poseidon"
 	verifyBLSName                   = "verifyBLS"
 	verifyEd25519Name               = "verifyEd25519"
 	verifySecp256k1Name             = "verifySecp256k1"
 	verifyCustomSecp256k1Name       = "verifyCustomSecp256k1"
+	verifySecp256r1Name             = "verifySecp256r1"
+	managedVerifySecp256r1Name      = "managedVerifySecp256r1"
+	verifySchnorrName               = "verifySchnorr"
+	managedVerifySchnorrName        = "managedVerifySchnorr"
+	managedAddG1Name                = "managedAddG1"
+	managedScalarMultG1Name         = "managedScalarMultG1"
+	managedAddG2Name                = "managedAddG2"
+	managedScalarMultG2Name         = "managedScalarMultG2"
+	managedPairingCheckName         = "managedPairingCheck"
+	managedVerifyGroth16Name        = "managedVerifyGroth16"
 	encodeSecp256k1DerSignatureName = "encodeSecp256k1DerSignature"
 	addECName                       = "addEC"
 	doubleECName                    = "doubleEC"
@@ -76,178 +66,53 @@ const (
 	ellipticCurveGetValuesName      = "ellipticCurveGetValues"
 )
 
-// CryptoImports adds some crypto imports to the Wasmer Imports map
-func CryptoImports(imports *wasmer.Imports) (*wasmer.Imports, error) {
-	imports = imports.Namespace("env")
-	imports, err := imports.Append("sha256", v1_4_sha256, C.v1_4_sha256)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("managedSha256", v1_4_managedSha256, C.v1_4_managedSha256)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("keccak256", v1_4_keccak256, C.v1_4_keccak256)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("managedKeccak256", v1_4_managedKeccak256, C.v1_4_managedKeccak256)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("ripemd160", v1_4_ripemd160, C.v1_4_ripemd160)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("verifyBLS", v1_4_verifyBLS, C.v1_4_verifyBLS)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("verifyEd25519", v1_4_verifyEd25519, C.v1_4_verifyEd25519)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("verifySecp256k1", v1_4_verifySecp256k1, C.v1_4_verifySecp256k1)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("verifyCustomSecp256k1", v1_4_verifyCustomSecp256k1, C.v1_4_verifyCustomSecp256k1)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("encodeSecp256k1DerSignature", v1_4_encodeSecp256k1DerSignature, C.v1_4_encodeSecp256k1DerSignature)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("addEC", v1_4_addEC, C.v1_4_addEC)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("doubleEC", v1_4_doubleEC, C.v1_4_doubleEC)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("isOnCurveEC", v1_4_isOnCurveEC, C.v1_4_isOnCurveEC)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("scalarBaseMultEC", v1_4_scalarBaseMultEC, C.v1_4_scalarBaseMultEC)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("scalarMultEC", v1_4_scalarMultEC, C.v1_4_scalarMultEC)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("marshalEC", v1_4_marshalEC, C.v1_4_marshalEC)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("unmarshalEC", v1_4_unmarshalEC, C.v1_4_unmarshalEC)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("marshalCompressedEC", v1_4_marshalCompressedEC, C.v1_4_marshalCompressedEC)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("unmarshalCompressedEC", v1_4_unmarshalCompressedEC, C.v1_4_unmarshalCompressedEC)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("generateKeyEC", v1_4_generateKeyEC, C.v1_4_generateKeyEC)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("createEC", v1_4_createEC, C.v1_4_createEC)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("getCurveLengthEC", v1_4_getCurveLengthEC, C.v1_4_getCurveLengthEC)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("getPrivKeyByteLengthEC", v1_4_getPrivKeyByteLengthEC, C.v1_4_getPrivKeyByteLengthEC)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("ellipticCurveGetValues", v1_4_ellipticCurveGetValues, C.v1_4_ellipticCurveGetValues)
-	if err != nil {
-		return nil, err
-	}
-	return imports, nil
-}
-
-//export v1_4_sha256
-func v1_4_sha256(context unsafe.Pointer, dataOffset int32, length int32, resultOffset int32) int32 {
-	runtime := arwen.GetRuntimeContext(context)
-	crypto := arwen.GetCryptoContext(context)
-	metering := arwen.GetMeteringContext(context)
+// Sha256 VMHooks implementation.
+func (context *CryptoAPI) Sha256(dataOffset int32, length int32, resultOffset int32) int32 {
+	runtime := context.GetRuntimeContext()
+	crypto := context.GetCryptoContext()
+	metering := context.GetMeteringContext()
 
 	memLoadGas := math.MulUint64(metering.GasSchedule().BaseOperationCost.DataCopyPerByte, uint64(length))
 	gasToUse := math.AddUint64(metering.GasSchedule().CryptoAPICost.SHA256, memLoadGas)
 	metering.UseGasAndAddTracedGas(sha256Name, gasToUse)
 
 	data, err := runtime.MemLoad(dataOffset, length)
-	if arwen.WithFault(err, context, runtime.CryptoAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
 		return 1
 	}
 
 	result, err := crypto.Sha256(data)
 	if err != nil {
-		arwen.WithFaultIfFailAlwaysActive(err, context, runtime.CryptoAPIErrorShouldFailExecution())
+		context.WithFaultIfFailAlwaysActive(err, runtime.CryptoAPIErrorShouldFailExecution())
 		return 1
 	}
 
 	err = runtime.MemStore(resultOffset, result)
-	if arwen.WithFault(err, context, runtime.CryptoAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
 		return 1
 	}
 
 	return 0
 }
 
-//export v1_4_managedSha256
-func v1_4_managedSha256(context unsafe.Pointer, inputHandle, outputHandle int32) int32 {
-	managedType := arwen.GetManagedTypesContext(context)
-	runtime := arwen.GetRuntimeContext(context)
-	crypto := arwen.GetCryptoContext(context)
-	metering := arwen.GetMeteringContext(context)
+// ManagedSha256 VMHooks implementation.
+func (context *CryptoAPI) ManagedSha256(inputHandle, outputHandle int32) int32 {
+	managedType := context.GetManagedTypesContext()
+	runtime := context.GetRuntimeContext()
+	crypto := context.GetCryptoContext()
+	metering := context.GetMeteringContext()
 
 	metering.UseGasAndAddTracedGas(sha256Name, metering.GasSchedule().CryptoAPICost.SHA256)
 
 	inputBytes, err := managedType.GetBytes(inputHandle)
-	if arwen.WithFault(err, context, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
 		return 1
 	}
 	managedType.ConsumeGasForBytes(inputBytes)
 
 	resultBytes, err := crypto.Sha256(inputBytes)
 	if err != nil {
-		arwen.WithFaultIfFailAlwaysActive(err, context, runtime.CryptoAPIErrorShouldFailExecution())
+		context.WithFaultIfFailAlwaysActive(err, runtime.CryptoAPIErrorShouldFailExecution())
 		return 1
 	}
 
@@ -256,53 +121,53 @@ func v1_4_managedSha256(context unsafe.Pointer, inputHandle, outputHandle int32)
 	return 0
 }
 
-//export v1_4_keccak256
-func v1_4_keccak256(context unsafe.Pointer, dataOffset int32, length int32, resultOffset int32) int32 {
-	runtime := arwen.GetRuntimeContext(context)
-	crypto := arwen.GetCryptoContext(context)
-	metering := arwen.GetMeteringContext(context)
+// Keccak256 VMHooks implementation.
+func (context *CryptoAPI) Keccak256(dataOffset int32, length int32, resultOffset int32) int32 {
+	runtime := context.GetRuntimeContext()
+	crypto := context.GetCryptoContext()
+	metering := context.GetMeteringContext()
 
 	memLoadGas := math.MulUint64(metering.GasSchedule().BaseOperationCost.DataCopyPerByte, uint64(length))
 	gasToUse := math.AddUint64(metering.GasSchedule().CryptoAPICost.Keccak256, memLoadGas)
 	metering.UseGasAndAddTracedGas(keccak256Name, gasToUse)
 
 	data, err := runtime.MemLoad(dataOffset, length)
-	if arwen.WithFault(err, context, runtime.CryptoAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
 		return 1
 	}
 
 	result, err := crypto.Keccak256(data)
 	if err != nil {
-		arwen.WithFaultIfFailAlwaysActive(err, context, runtime.CryptoAPIErrorShouldFailExecution())
+		context.WithFaultIfFailAlwaysActive(err, runtime.CryptoAPIErrorShouldFailExecution())
 		return 1
 	}
 
 	err = runtime.MemStore(resultOffset, result)
-	if arwen.WithFault(err, context, runtime.CryptoAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
 		return 1
 	}
 
 	return 0
 }
 
-//export v1_4_managedKeccak256
-func v1_4_managedKeccak256(context unsafe.Pointer, inputHandle, outputHandle int32) int32 {
-	managedType := arwen.GetManagedTypesContext(context)
-	runtime := arwen.GetRuntimeContext(context)
-	crypto := arwen.GetCryptoContext(context)
-	metering := arwen.GetMeteringContext(context)
+// ManagedKeccak256 VMHooks implementation.
+func (context *CryptoAPI) ManagedKeccak256(inputHandle, outputHandle int32) int32 {
+	managedType := context.GetManagedTypesContext()
+	runtime := context.GetRuntimeContext()
+	crypto := context.GetCryptoContext()
+	metering := context.GetMeteringContext()
 
 	metering.UseGasAndAddTracedGas(keccak256Name, metering.GasSchedule().CryptoAPICost.Keccak256)
 
 	inputBytes, err := managedType.GetBytes(inputHandle)
-	if arwen.WithFault(err, context, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
 		return 1
 	}
 	managedType.ConsumeGasForBytes(inputBytes)
 
 	resultBytes, err := crypto.Keccak256(inputBytes)
 	if err != nil {
-		arwen.WithFaultIfFailAlwaysActive(err, context, runtime.CryptoAPIErrorShouldFailExecution())
+		context.WithFaultIfFailAlwaysActive(err, runtime.CryptoAPIErrorShouldFailExecution())
 		return 1
 	}
 
@@ -311,53 +176,303 @@ func v1_4_managedKeccak256(context unsafe.Pointer, inputHandle, outputHandle int
 	return 0
 }
 
-//export v1_4_ripemd160
-func v1_4_ripemd160(context unsafe.Pointer, dataOffset int32, length int32, resultOffset int32) int32 {
-	runtime := arwen.GetRuntimeContext(context)
-	crypto := arwen.GetCryptoContext(context)
-	metering := arwen.GetMeteringContext(context)
+// Ripemd160 VMHooks implementation.
+func (context *CryptoAPI) Ripemd160(dataOffset int32, length int32, resultOffset int32) int32 {
+	runtime := context.GetRuntimeContext()
+	crypto := context.GetCryptoContext()
+	metering := context.GetMeteringContext()
 
 	memLoadGas := math.MulUint64(metering.GasSchedule().BaseOperationCost.DataCopyPerByte, uint64(length))
 	gasToUse := math.AddUint64(metering.GasSchedule().CryptoAPICost.Ripemd160, memLoadGas)
 	metering.UseGasAndAddTracedGas(ripemd160Name, gasToUse)
 
 	data, err := runtime.MemLoad(dataOffset, length)
-	if arwen.WithFault(err, context, runtime.CryptoAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
 		return 1
 	}
 
 	result, err := crypto.Ripemd160(data)
 	if err != nil {
-		arwen.WithFaultIfFailAlwaysActive(err, context, runtime.CryptoAPIErrorShouldFailExecution())
+		context.WithFaultIfFailAlwaysActive(err, runtime.CryptoAPIErrorShouldFailExecution())
 		return 1
 	}
 
 	err = runtime.MemStore(resultOffset, result)
-	if arwen.WithFault(err, context, runtime.CryptoAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
 		return 1
 	}
 
 	return 0
 }
 
-//export v1_4_verifyBLS
-func v1_4_verifyBLS(
-	context unsafe.Pointer,
+// Sha512 VMHooks implementation.
+func (context *CryptoAPI) Sha512(dataOffset int32, length int32, resultOffset int32) int32 {
+	crypto := context.GetCryptoContext()
+	gasSchedule := context.GetMeteringContext().GasSchedule()
+	return hashFromMemory(
+		context,
+		sha512Name,
+		gasSchedule.CryptoAPICost.SHA512,
+		gasSchedule.CryptoAPICost.SHA512PerByte,
+		1,
+		crypto.Sha512,
+		dataOffset,
+		length,
+		resultOffset,
+	)
+}
+
+// ManagedSha512 VMHooks implementation.
+func (context *CryptoAPI) ManagedSha512(inputHandle, outputHandle int32) int32 {
+	crypto := context.GetCryptoContext()
+	gasSchedule := context.GetMeteringContext().GasSchedule()
+	return hashManagedBuffer(
+		context,
+		sha512Name,
+		gasSchedule.CryptoAPICost.SHA512,
+		gasSchedule.CryptoAPICost.SHA512PerByte,
+		1,
+		crypto.Sha512,
+		inputHandle,
+		outputHandle,
+	)
+}
+
+// Sha3256 VMHooks implementation.
+func (context *CryptoAPI) Sha3256(dataOffset int32, length int32, resultOffset int32) int32 {
+	crypto := context.GetCryptoContext()
+	gasSchedule := context.GetMeteringContext().GasSchedule()
+	return hashFromMemory(
+		context,
+		sha3256Name,
+		gasSchedule.CryptoAPICost.SHA3256,
+		gasSchedule.CryptoAPICost.SHA3256PerByte,
+		1,
+		crypto.Sha3256,
+		dataOffset,
+		length,
+		resultOffset,
+	)
+}
+
+// ManagedSha3256 VMHooks implementation.
+func (context *CryptoAPI) ManagedSha3256(inputHandle, outputHandle int32) int32 {
+	crypto := context.GetCryptoContext()
+	gasSchedule := context.GetMeteringContext().GasSchedule()
+	return hashManagedBuffer(
+		context,
+		sha3256Name,
+		gasSchedule.CryptoAPICost.SHA3256,
+		gasSchedule.CryptoAPICost.SHA3256PerByte,
+		1,
+		crypto.Sha3256,
+		inputHandle,
+		outputHandle,
+	)
+}
+
+// Blake2b256 VMHooks implementation.
+func (context *CryptoAPI) Blake2b256(dataOffset int32, length int32, resultOffset int32) int32 {
+	crypto := context.GetCryptoContext()
+	gasSchedule := context.GetMeteringContext().GasSchedule()
+	return hashFromMemory(
+		context,
+		blake2b256Name,
+		gasSchedule.CryptoAPICost.Blake2b256,
+		gasSchedule.CryptoAPICost.Blake2b256PerByte,
+		1,
+		crypto.Blake2b256,
+		dataOffset,
+		length,
+		resultOffset,
+	)
+}
+
+// ManagedBlake2b256 VMHooks implementation.
+func (context *CryptoAPI) ManagedBlake2b256(inputHandle, outputHandle int32) int32 {
+	crypto := context.GetCryptoContext()
+	gasSchedule := context.GetMeteringContext().GasSchedule()
+	return hashManagedBuffer(
+		context,
+		blake2b256Name,
+		gasSchedule.CryptoAPICost.Blake2b256,
+		gasSchedule.CryptoAPICost.Blake2b256PerByte,
+		1,
+		crypto.Blake2b256,
+		inputHandle,
+		outputHandle,
+	)
+}
+
+// Blake2b512 VMHooks implementation.
+func (context *CryptoAPI) Blake2b512(dataOffset int32, length int32, resultOffset int32) int32 {
+	crypto := context.GetCryptoContext()
+	gasSchedule := context.GetMeteringContext().GasSchedule()
+	return hashFromMemory(
+		context,
+		blake2b512Name,
+		gasSchedule.CryptoAPICost.Blake2b512,
+		gasSchedule.CryptoAPICost.Blake2b512PerByte,
+		1,
+		crypto.Blake2b512,
+		dataOffset,
+		length,
+		resultOffset,
+	)
+}
+
+// ManagedBlake2b512 VMHooks implementation.
+func (context *CryptoAPI) ManagedBlake2b512(inputHandle, outputHandle int32) int32 {
+	crypto := context.GetCryptoContext()
+	gasSchedule := context.GetMeteringContext().GasSchedule()
+	return hashManagedBuffer(
+		context,
+		blake2b512Name,
+		gasSchedule.CryptoAPICost.Blake2b512,
+		gasSchedule.CryptoAPICost.Blake2b512PerByte,
+		1,
+		crypto.Blake2b512,
+		inputHandle,
+		outputHandle,
+	)
+}
+
+// Poseidon VMHooks implementation.
+func (context *CryptoAPI) Poseidon(dataOffset int32, length int32, resultOffset int32) int32 {
+	crypto := context.GetCryptoContext()
+	gasSchedule := context.GetMeteringContext().GasSchedule()
+	return hashFromMemory(
+		context,
+		poseidonName,
+		gasSchedule.CryptoAPICost.Poseidon,
+		gasSchedule.CryptoAPICost.PoseidonPerBlock,
+		poseidonBlockLength,
+		crypto.Poseidon,
+		dataOffset,
+		length,
+		resultOffset,
+	)
+}
+
+// ManagedPoseidon VMHooks implementation.
+func (context *CryptoAPI) ManagedPoseidon(inputHandle, outputHandle int32) int32 {
+	crypto := context.GetCryptoContext()
+	gasSchedule := context.GetMeteringContext().GasSchedule()
+	return hashManagedBuffer(
+		context,
+		poseidonName,
+		gasSchedule.CryptoAPICost.Poseidon,
+		gasSchedule.CryptoAPICost.PoseidonPerBlock,
+		poseidonBlockLength,
+		crypto.Poseidon,
+		inputHandle,
+		outputHandle,
+	)
+}
+
+// hashGasCost returns the base cost plus the cost of each block of data, the last block being possibly incomplete
+func hashGasCost(baseCost uint64, costPerBlock uint64, blockLength uint64, dataLength uint64) uint64 {
+	numBlocks := (dataLength + blockLength - 1) / blockLength
+	return math.AddUint64(baseCost, math.MulUint64(costPerBlock, numBlocks))
+}
+
+// hashFromMemory charges the base and per block costs of a hash function, applies it
+// to the given WASM memory region and stores the result back into the WASM memory
+func hashFromMemory(
+	context *CryptoAPI,
+	tracedFunctionName string,
+	baseCost uint64,
+	costPerBlock uint64,
+	blockLength uint64,
+	hashFunc func(data []byte) ([]byte, error),
+	dataOffset int32,
+	length int32,
+	resultOffset int32,
+) int32 {
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
+
+	if length < 0 {
+		_ = context.WithFault(arwen.ErrNegativeLength, runtime.CryptoAPIErrorShouldFailExecution())
+		return 1
+	}
+
+	memLoadGas := math.MulUint64(metering.GasSchedule().BaseOperationCost.DataCopyPerByte, uint64(length))
+	gasToUse := math.AddUint64(hashGasCost(baseCost, costPerBlock, blockLength, uint64(length)), memLoadGas)
+	metering.UseGasAndAddTracedGas(tracedFunctionName, gasToUse)
+
+	data, err := runtime.MemLoad(dataOffset, length)
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	result, err := hashFunc(data)
+	if err != nil {
+		context.WithFaultIfFailAlwaysActive(err, runtime.CryptoAPIErrorShouldFailExecution())
+		return 1
+	}
+
+	err = runtime.MemStore(resultOffset, result)
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	return 0
+}
+
+// hashManagedBuffer charges the base and per block costs of a hash function, applies it
+// to the contents of the input managed buffer and writes the result to the output managed buffer
+func hashManagedBuffer(
+	context *CryptoAPI,
+	tracedFunctionName string,
+	baseCost uint64,
+	costPerBlock uint64,
+	blockLength uint64,
+	hashFunc func(data []byte) ([]byte, error),
+	inputHandle int32,
+	outputHandle int32,
+) int32 {
+	managedType := context.GetManagedTypesContext()
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
+
+	inputBytes, err := managedType.GetBytes(inputHandle)
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	gasToUse := hashGasCost(baseCost, costPerBlock, blockLength, uint64(len(inputBytes)))
+	metering.UseGasAndAddTracedGas(tracedFunctionName, gasToUse)
+	managedType.ConsumeGasForBytes(inputBytes)
+
+	resultBytes, err := hashFunc(inputBytes)
+	if err != nil {
+		context.WithFaultIfFailAlwaysActive(err, runtime.CryptoAPIErrorShouldFailExecution())
+		return 1
+	}
+
+	managedType.SetBytes(outputHandle, resultBytes)
+
+	return 0
+}
+
+// VerifyBLS VMHooks implementation.
+func (context *CryptoAPI) VerifyBLS(
 	keyOffset int32,
 	messageOffset int32,
 	messageLength int32,
 	sigOffset int32,
 ) int32 {
-	runtime := arwen.GetRuntimeContext(context)
-	crypto := arwen.GetCryptoContext(context)
-	metering := arwen.GetMeteringContext(context)
+	runtime := context.GetRuntimeContext()
+	crypto := context.GetCryptoContext()
+	metering := context.GetMeteringContext()
 	metering.StartGasTracing(verifyBLSName)
 
 	gasToUse := metering.GasSchedule().CryptoAPICost.VerifyBLS
 	metering.UseAndTraceGas(gasToUse)
 
 	key, err := runtime.MemLoad(keyOffset, blsPublicKeyLength)
-	if arwen.WithFault(err, context, runtime.CryptoAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
 		return 1
 	}
 
@@ -365,42 +480,41 @@ func v1_4_verifyBLS(
 	metering.UseAndTraceGas(gasToUse)
 
 	message, err := runtime.MemLoad(messageOffset, messageLength)
-	if arwen.WithFault(err, context, runtime.CryptoAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
 		return 1
 	}
 
 	sig, err := runtime.MemLoad(sigOffset, blsSignatureLength)
-	if arwen.WithFault(err, context, runtime.CryptoAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
 		return 1
 	}
 
 	invalidSigErr := crypto.VerifyBLS(key, message, sig)
 	if invalidSigErr != nil {
-		arwen.WithFaultIfFailAlwaysActive(invalidSigErr, context, runtime.CryptoAPIErrorShouldFailExecution())
+		context.WithFaultIfFailAlwaysActive(invalidSigErr, runtime.CryptoAPIErrorShouldFailExecution())
 		return -1
 	}
 
 	return 0
 }
 
-//export v1_4_verifyEd25519
-func v1_4_verifyEd25519(
-	context unsafe.Pointer,
+// VerifyEd25519 VMHooks implementation.
+func (context *CryptoAPI) VerifyEd25519(
 	keyOffset int32,
 	messageOffset int32,
 	messageLength int32,
 	sigOffset int32,
 ) int32 {
-	runtime := arwen.GetRuntimeContext(context)
-	crypto := arwen.GetCryptoContext(context)
-	metering := arwen.GetMeteringContext(context)
+	runtime := context.GetRuntimeContext()
+	crypto := context.GetCryptoContext()
+	metering := context.GetMeteringContext()
 	metering.StartGasTracing(verifyEd25519Name)
 
 	gasToUse := metering.GasSchedule().CryptoAPICost.VerifyEd25519
 	metering.UseAndTraceGas(gasToUse)
 
 	key, err := runtime.MemLoad(keyOffset, ed25519PublicKeyLength)
-	if arwen.WithFault(err, context, runtime.CryptoAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
 		return 1
 	}
 
@@ -408,27 +522,26 @@ func v1_4_verifyEd25519(
 	metering.UseAndTraceGas(gasToUse)
 
 	message, err := runtime.MemLoad(messageOffset, messageLength)
-	if arwen.WithFault(err, context, runtime.CryptoAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
 		return 1
 	}
 
 	sig, err := runtime.MemLoad(sigOffset, ed25519SignatureLength)
-	if arwen.WithFault(err, context, runtime.CryptoAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
 		return 1
 	}
 
 	invalidSigErr := crypto.VerifyEd25519(key, message, sig)
 	if invalidSigErr != nil {
-		arwen.WithFaultIfFailAlwaysActive(invalidSigErr, context, runtime.CryptoAPIErrorShouldFailExecution())
+		context.WithFaultIfFailAlwaysActive(invalidSigErr, runtime.CryptoAPIErrorShouldFailExecution())
 		return -1
 	}
 
 	return 0
 }
 
-//export v1_4_verifyCustomSecp256k1
-func v1_4_verifyCustomSecp256k1(
-	context unsafe.Pointer,
+// VerifyCustomSecp256k1 VMHooks implementation.
+func (context *CryptoAPI) VerifyCustomSecp256k1(
 	keyOffset int32,
 	keyLength int32,
 	messageOffset int32,
@@ -436,21 +549,21 @@ func v1_4_verifyCustomSecp256k1(
 	sigOffset int32,
 	hashType int32,
 ) int32 {
-	runtime := arwen.GetRuntimeContext(context)
-	crypto := arwen.GetCryptoContext(context)
-	metering := arwen.GetMeteringContext(context)
+	runtime := context.GetRuntimeContext()
+	crypto := context.GetCryptoContext()
+	metering := context.GetMeteringContext()
 	metering.StartGasTracing(verifyCustomSecp256k1Name)
 
 	gasToUse := metering.GasSchedule().CryptoAPICost.VerifySecp256k1
 	metering.UseAndTraceGas(gasToUse)
 
 	if keyLength != secp256k1CompressedPublicKeyLength && keyLength != secp256k1UncompressedPublicKeyLength {
-		_ = arwen.WithFault(arwen.ErrInvalidPublicKeySize, context, runtime.ElrondAPIErrorShouldFailExecution())
+		_ = context.WithFault(arwen.ErrInvalidPublicKeySize, runtime.ElrondAPIErrorShouldFailExecution())
 		return 1
 	}
 
 	key, err := runtime.MemLoad(keyOffset, keyLength)
-	if arwen.WithFault(err, context, runtime.CryptoAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
 		return 1
 	}
 
@@ -458,7 +571,7 @@ func v1_4_verifyCustomSecp256k1(
 	metering.UseAndTraceGas(gasToUse)
 
 	message, err := runtime.MemLoad(messageOffset, messageLength)
-	if arwen.WithFault(err, context, runtime.CryptoAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
 		return 1
 	}
 
@@ -467,35 +580,33 @@ func v1_4_verifyCustomSecp256k1(
 	// byte2: the remaining buffer length
 	const sigHeaderLength = 2
 	sigHeader, err := runtime.MemLoad(sigOffset, sigHeaderLength)
-	if arwen.WithFault(err, context, runtime.CryptoAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
 		return 1
 	}
 	sigLength := int32(sigHeader[1]) + sigHeaderLength
 	sig, err := runtime.MemLoad(sigOffset, sigLength)
-	if arwen.WithFault(err, context, runtime.CryptoAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
 		return 1
 	}
 
 	invalidSigErr := crypto.VerifySecp256k1(key, message, sig, uint8(hashType))
 	if invalidSigErr != nil {
-		arwen.WithFaultIfFailAlwaysActive(invalidSigErr, context, runtime.CryptoAPIErrorShouldFailExecution())
+		context.WithFaultIfFailAlwaysActive(invalidSigErr, runtime.CryptoAPIErrorShouldFailExecution())
 		return -1
 	}
 
 	return 0
 }
 
-//export v1_4_verifySecp256k1
-func v1_4_verifySecp256k1(
-	context unsafe.Pointer,
+// VerifySecp256k1 VMHooks implementation.
+func (context *CryptoAPI) VerifySecp256k1(
 	keyOffset int32,
 	keyLength int32,
 	messageOffset int32,
 	messageLength int32,
 	sigOffset int32,
 ) int32 {
-	return v1_4_verifyCustomSecp256k1(
-		context,
+	return context.VerifyCustomSecp256k1(
 		keyOffset,
 		keyLength,
 		messageOffset,
@@ -505,44 +616,503 @@ func v1_4_verifySecp256k1(
 	)
 }
 
-//export v1_4_encodeSecp256k1DerSignature
-func v1_4_encodeSecp256k1DerSignature(
-	context unsafe.Pointer,
+// VerifySecp256r1 VMHooks implementation.
+func (context *CryptoAPI) VerifySecp256r1(
+	keyOffset int32,
+	keyLength int32,
+	messageOffset int32,
+	messageLength int32,
+	sigOffset int32,
+) int32 {
+	runtime := context.GetRuntimeContext()
+	crypto := context.GetCryptoContext()
+	metering := context.GetMeteringContext()
+	metering.StartGasTracing(verifySecp256r1Name)
+
+	gasToUse := metering.GasSchedule().CryptoAPICost.VerifySecp256r1
+	metering.UseAndTraceGas(gasToUse)
+
+	if keyLength != secp256r1CompressedPublicKeyLength && keyLength != secp256r1UncompressedPublicKeyLength {
+		_ = context.WithFault(arwen.ErrInvalidPublicKeySize, runtime.CryptoAPIErrorShouldFailExecution())
+		return 1
+	}
+
+	key, err := runtime.MemLoad(keyOffset, keyLength)
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	gasToUse = math.MulUint64(metering.GasSchedule().BaseOperationCost.DataCopyPerByte, uint64(messageLength))
+	metering.UseAndTraceGas(gasToUse)
+
+	message, err := runtime.MemLoad(messageOffset, messageLength)
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	// read the 2 leading bytes first
+	// byte1: 0x30, header
+	// byte2: the remaining buffer length
+	const sigHeaderLength = 2
+	sigHeader, err := runtime.MemLoad(sigOffset, sigHeaderLength)
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
+		return 1
+	}
+	sigLength := int32(sigHeader[1]) + sigHeaderLength
+	sig, err := runtime.MemLoad(sigOffset, sigLength)
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	invalidSigErr := crypto.VerifySecp256r1(key, message, sig)
+	if invalidSigErr != nil {
+		context.WithFaultIfFailAlwaysActive(invalidSigErr, runtime.CryptoAPIErrorShouldFailExecution())
+		return -1
+	}
+
+	return 0
+}
+
+// ManagedVerifySecp256r1 VMHooks implementation.
+func (context *CryptoAPI) ManagedVerifySecp256r1(
+	keyHandle int32,
+	messageHandle int32,
+	sigHandle int32,
+) int32 {
+	managedType := context.GetManagedTypesContext()
+	runtime := context.GetRuntimeContext()
+	crypto := context.GetCryptoContext()
+	metering := context.GetMeteringContext()
+	metering.StartGasTracing(managedVerifySecp256r1Name)
+
+	gasToUse := metering.GasSchedule().CryptoAPICost.VerifySecp256r1
+	metering.UseAndTraceGas(gasToUse)
+
+	key, err := managedType.GetBytes(keyHandle)
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+	if len(key) != secp256r1CompressedPublicKeyLength && len(key) != secp256r1UncompressedPublicKeyLength {
+		_ = context.WithFault(arwen.ErrInvalidPublicKeySize, runtime.CryptoAPIErrorShouldFailExecution())
+		return 1
+	}
+
+	message, err := managedType.GetBytes(messageHandle)
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+	managedType.ConsumeGasForBytes(message)
+
+	sig, err := managedType.GetBytes(sigHandle)
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	invalidSigErr := crypto.VerifySecp256r1(key, message, sig)
+	if invalidSigErr != nil {
+		context.WithFaultIfFailAlwaysActive(invalidSigErr, runtime.CryptoAPIErrorShouldFailExecution())
+		return -1
+	}
+
+	return 0
+}
+
+// VerifySchnorr VMHooks implementation.
+func (context *CryptoAPI) VerifySchnorr(
+	keyOffset int32,
+	messageOffset int32,
+	messageLength int32,
+	sigOffset int32,
+) int32 {
+	runtime := context.GetRuntimeContext()
+	crypto := context.GetCryptoContext()
+	metering := context.GetMeteringContext()
+	metering.StartGasTracing(verifySchnorrName)
+
+	gasToUse := metering.GasSchedule().CryptoAPICost.VerifySchnorr
+	metering.UseAndTraceGas(gasToUse)
+
+	key, err := runtime.MemLoad(keyOffset, schnorrPublicKeyLength)
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	gasToUse = math.MulUint64(metering.GasSchedule().BaseOperationCost.DataCopyPerByte, uint64(messageLength))
+	metering.UseAndTraceGas(gasToUse)
+
+	message, err := runtime.MemLoad(messageOffset, messageLength)
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	sig, err := runtime.MemLoad(sigOffset, schnorrSignatureLength)
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	invalidSigErr := crypto.VerifySchnorr(key, message, sig)
+	if invalidSigErr != nil {
+		context.WithFaultIfFailAlwaysActive(invalidSigErr, runtime.CryptoAPIErrorShouldFailExecution())
+		return -1
+	}
+
+	return 0
+}
+
+// ManagedVerifySchnorr VMHooks implementation.
+func (context *CryptoAPI) ManagedVerifySchnorr(
+	keyHandle int32,
+	messageHandle int32,
+	sigHandle int32,
+) int32 {
+	managedType := context.GetManagedTypesContext()
+	runtime := context.GetRuntimeContext()
+	crypto := context.GetCryptoContext()
+	metering := context.GetMeteringContext()
+	metering.StartGasTracing(managedVerifySchnorrName)
+
+	gasToUse := metering.GasSchedule().CryptoAPICost.VerifySchnorr
+	metering.UseAndTraceGas(gasToUse)
+
+	key, err := managedType.GetBytes(keyHandle)
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	message, err := managedType.GetBytes(messageHandle)
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+	managedType.ConsumeGasForBytes(message)
+
+	sig, err := managedType.GetBytes(sigHandle)
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	invalidSigErr := crypto.VerifySchnorr(key, message, sig)
+	if invalidSigErr != nil {
+		context.WithFaultIfFailAlwaysActive(invalidSigErr, runtime.CryptoAPIErrorShouldFailExecution())
+		return -1
+	}
+
+	return 0
+}
+
+// ManagedAddG1 VMHooks implementation.
+func (context *CryptoAPI) ManagedAddG1(
+	curveID int32,
+	resultHandle int32,
+	point1Handle int32,
+	point2Handle int32,
+) int32 {
+	crypto := context.GetCryptoContext()
+	metering := context.GetMeteringContext()
+	return pairingGroupOperation(
+		context,
+		managedAddG1Name,
+		metering.GasSchedule().CryptoAPICost.AddG1,
+		2,
+		0,
+		crypto.AddG1,
+		curveID,
+		resultHandle,
+		point1Handle,
+		point2Handle,
+	)
+}
+
+// ManagedScalarMultG1 VMHooks implementation.
+func (context *CryptoAPI) ManagedScalarMultG1(
+	curveID int32,
+	resultHandle int32,
+	pointHandle int32,
+	scalarHandle int32,
+) int32 {
+	crypto := context.GetCryptoContext()
+	metering := context.GetMeteringContext()
+	return pairingGroupOperation(
+		context,
+		managedScalarMultG1Name,
+		metering.GasSchedule().CryptoAPICost.ScalarMultG1,
+		1,
+		0,
+		crypto.ScalarMultG1,
+		curveID,
+		resultHandle,
+		pointHandle,
+		scalarHandle,
+	)
+}
+
+// ManagedAddG2 VMHooks implementation.
+func (context *CryptoAPI) ManagedAddG2(
+	curveID int32,
+	resultHandle int32,
+	point1Handle int32,
+	point2Handle int32,
+) int32 {
+	crypto := context.GetCryptoContext()
+	metering := context.GetMeteringContext()
+	return pairingGroupOperation(
+		context,
+		managedAddG2Name,
+		metering.GasSchedule().CryptoAPICost.AddG2,
+		0,
+		2,
+		crypto.AddG2,
+		curveID,
+		resultHandle,
+		point1Handle,
+		point2Handle,
+	)
+}
+
+// ManagedScalarMultG2 VMHooks implementation.
+func (context *CryptoAPI) ManagedScalarMultG2(
+	curveID int32,
+	resultHandle int32,
+	pointHandle int32,
+	scalarHandle int32,
+) int32 {
+	crypto := context.GetCryptoContext()
+	metering := context.GetMeteringContext()
+	return pairingGroupOperation(
+		context,
+		managedScalarMultG2Name,
+		metering.GasSchedule().CryptoAPICost.ScalarMultG2,
+		0,
+		1,
+		crypto.ScalarMultG2,
+		curveID,
+		resultHandle,
+		pointHandle,
+		scalarHandle,
+	)
+}
+
+// pairingGroupOperation charges the cost of a G1 or G2 operation and of the subgroup checks of the points it decodes,
+// scaled for the given curve, applies it to the contents of two managed buffers and writes the encoded point to the
+// result managed buffer
+func pairingGroupOperation(
+	context *CryptoAPI,
+	tracedFunctionName string,
+	baseCost uint64,
+	numG1Points uint64,
+	numG2Points uint64,
+	operation func(curveID int32, first []byte, second []byte) ([]byte, error),
+	curveID int32,
+	resultHandle int32,
+	firstHandle int32,
+	secondHandle int32,
+) int32 {
+	managedType := context.GetManagedTypesContext()
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
+	metering.StartGasTracing(tracedFunctionName)
+
+	curveMultiplier := getPairingCurveGasCostMultiplier(curveID)
+	if curveMultiplier < 0 {
+		_ = context.WithFault(pairing.ErrUnknownCurve, runtime.CryptoAPIErrorShouldFailExecution())
+		return 1
+	}
+	gasToUse := math.AddUint64(baseCost, getSubgroupChecksGasCost(metering.GasSchedule(), curveID, numG1Points, numG2Points))
+	metering.UseAndTraceGas(gasToUse * uint64(curveMultiplier) / 100)
+
+	first, err := managedType.GetBytes(firstHandle)
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	second, err := managedType.GetBytes(secondHandle)
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	result, err := operation(curveID, first, second)
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	managedType.SetBytes(resultHandle, result)
+
+	return 0
+}
+
+// ManagedPairingCheck VMHooks implementation.
+// It returns 1 if the product of the pairings is one, 0 if it is not, and -1 on error.
+func (context *CryptoAPI) ManagedPairingCheck(
+	curveID int32,
+	g1PointsHandle int32,
+	g2PointsHandle int32,
+) int32 {
+	managedType := context.GetManagedTypesContext()
+	runtime := context.GetRuntimeContext()
+	crypto := context.GetCryptoContext()
+	metering := context.GetMeteringContext()
+	metering.StartGasTracing(managedPairingCheckName)
+
+	curveMultiplier := getPairingCurveGasCostMultiplier(curveID)
+	if curveMultiplier < 0 {
+		_ = context.WithFault(pairing.ErrUnknownCurve, runtime.CryptoAPIErrorShouldFailExecution())
+		return -1
+	}
+	metering.UseAndTraceGas(metering.GasSchedule().CryptoAPICost.PairingCheck * uint64(curveMultiplier) / 100)
+
+	g1Points, err := managedType.GetBytes(g1PointsHandle)
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	g2Points, err := managedType.GetBytes(g2PointsHandle)
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	g1PointLength, _ := pairing.G1PointLength(curveID)
+	numPairs := uint64(len(g1Points) / g1PointLength)
+	gasPerPair := math.AddUint64(metering.GasSchedule().CryptoAPICost.PairingCheckPerPair, getSubgroupChecksGasCost(metering.GasSchedule(), curveID, 1, 1))
+	gasPerPair = gasPerPair * uint64(curveMultiplier) / 100
+	metering.UseAndTraceGas(math.MulUint64(gasPerPair, numPairs))
+
+	ok, err := crypto.PairingCheck(curveID, g1Points, g2Points)
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	if ok {
+		return 1
+	}
+
+	return 0
+}
+
+// ManagedVerifyGroth16 VMHooks implementation.
+// It returns 1 if the proof is valid, 0 if it is not, and -1 on error, like ManagedPairingCheck.
+func (context *CryptoAPI) ManagedVerifyGroth16(
+	curveID int32,
+	verifyingKeyHandle int32,
+	proofHandle int32,
+	publicInputsHandle int32,
+) int32 {
+	managedType := context.GetManagedTypesContext()
+	runtime := context.GetRuntimeContext()
+	crypto := context.GetCryptoContext()
+	metering := context.GetMeteringContext()
+	metering.StartGasTracing(managedVerifyGroth16Name)
+
+	curveMultiplier := getPairingCurveGasCostMultiplier(curveID)
+	if curveMultiplier < 0 {
+		_ = context.WithFault(pairing.ErrUnknownCurve, runtime.CryptoAPIErrorShouldFailExecution())
+		return -1
+	}
+	// the verifying key holds alpha, beta, gamma and delta besides its IC points, and the proof holds A, B and C
+	gasToUse := math.AddUint64(metering.GasSchedule().CryptoAPICost.VerifyGroth16, getSubgroupChecksGasCost(metering.GasSchedule(), curveID, 3, 4))
+	metering.UseAndTraceGas(gasToUse * uint64(curveMultiplier) / 100)
+
+	verifyingKey, err := managedType.GetBytes(verifyingKeyHandle)
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	proof, err := managedType.GetBytes(proofHandle)
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	publicInputs, err := managedType.GetBytes(publicInputsHandle)
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+	managedType.ConsumeGasForBytes(verifyingKey)
+	managedType.ConsumeGasForBytes(publicInputs)
+
+	// the verifying key holds an IC point for each public input, and one more, all of them charged before decoding
+	numPublicInputs, err := pairing.Groth16NumPublicInputs(curveID, len(verifyingKey))
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
+		return -1
+	}
+	if len(publicInputs) != numPublicInputs*pairing.ScalarLength {
+		_ = context.WithFault(pairing.ErrInvalidPublicInputs, runtime.CryptoAPIErrorShouldFailExecution())
+		return -1
+	}
+
+	gasPerInput := metering.GasSchedule().CryptoAPICost.VerifyGroth16PerInput
+	gasToUse = math.MulUint64(gasPerInput, uint64(numPublicInputs))
+	gasToUse = math.AddUint64(gasToUse, getSubgroupChecksGasCost(metering.GasSchedule(), curveID, uint64(numPublicInputs+1), 0))
+	metering.UseAndTraceGas(gasToUse * uint64(curveMultiplier) / 100)
+
+	ok, err := crypto.VerifyGroth16(curveID, verifyingKey, proof, publicInputs)
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	if ok {
+		return 1
+	}
+
+	return 0
+}
+
+// getSubgroupChecksGasCost returns the cost of the subgroup checks done when decoding the given numbers of G1 and G2
+// points; G1 points are only checked on the curves whose G1 has a cofactor
+func getSubgroupChecksGasCost(gasSchedule *config.GasCost, curveID int32, numG1Points uint64, numG2Points uint64) uint64 {
+	gasToUse := math.MulUint64(gasSchedule.CryptoAPICost.SubgroupCheckG2, numG2Points)
+	g1SubgroupCheckRequired, _ := pairing.G1SubgroupCheckRequired(curveID)
+	if g1SubgroupCheckRequired {
+		gasToUse = math.AddUint64(gasToUse, math.MulUint64(gasSchedule.CryptoAPICost.SubgroupCheckG1, numG1Points))
+	}
+	return gasToUse
+}
+
+// getPairingCurveGasCostMultiplier returns (100*multiplier) to be used with the basic gasCost of the
+// pairing functions depending on which curve is used, or -1 for unknown curves
+func getPairingCurveGasCostMultiplier(curveID int32) int32 {
+	switch curveID {
+	case pairing.CurveBN254:
+		return bn254PairingCurveMultiplier
+	case pairing.CurveBLS12381:
+		return bls12381PairingCurveMultiplier
+	}
+	return -1
+}
+
+// EncodeSecp256k1DerSignature VMHooks implementation.
+func (context *CryptoAPI) EncodeSecp256k1DerSignature(
 	rOffset int32,
 	rLength int32,
 	sOffset int32,
 	sLength int32,
 	sigOffset int32,
 ) int32 {
-	runtime := arwen.GetRuntimeContext(context)
-	crypto := arwen.GetCryptoContext(context)
-	metering := arwen.GetMeteringContext(context)
+	runtime := context.GetRuntimeContext()
+	crypto := context.GetCryptoContext()
+	metering := context.GetMeteringContext()
 
 	gasToUse := metering.GasSchedule().CryptoAPICost.EncodeDERSig
 	metering.UseGasAndAddTracedGas(encodeSecp256k1DerSignatureName, gasToUse)
 
 	r, err := runtime.MemLoad(rOffset, rLength)
-	if arwen.WithFault(err, context, runtime.CryptoAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
 		return 1
 	}
 
 	s, err := runtime.MemLoad(sOffset, sLength)
-	if arwen.WithFault(err, context, runtime.CryptoAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
 		return 1
 	}
 
 	derSig := crypto.EncodeSecp256k1DERSignature(r, s)
 	err = runtime.MemStore(sigOffset, derSig)
-	if arwen.WithFault(err, context, runtime.CryptoAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
 		return 1
 	}
 
 	return 0
 }
 
-//export v1_4_addEC
-func v1_4_addEC(
-	context unsafe.Pointer,
+// AddEC VMHooks implementation.
+func (context *CryptoAPI) AddEC(
 	xResultHandle int32,
 	yResultHandle int32,
 	ecHandle int32,
@@ -551,42 +1121,42 @@ func v1_4_addEC(
 	sndPointXHandle int32,
 	sndPointYHandle int32,
 ) {
-	managedType := arwen.GetManagedTypesContext(context)
-	metering := arwen.GetMeteringContext(context)
-	runtime := arwen.GetRuntimeContext(context)
+	managedType := context.GetManagedTypesContext()
+	metering := context.GetMeteringContext()
+	runtime := context.GetRuntimeContext()
 	metering.StartGasTracing(addECName)
 
 	curveMultiplier := managedType.Get100xCurveGasCostMultiplier(ecHandle)
 	if curveMultiplier < 0 {
-		_ = arwen.WithFault(arwen.ErrNoEllipticCurveUnderThisHandle, context, runtime.CryptoAPIErrorShouldFailExecution())
+		_ = context.WithFault(arwen.ErrNoEllipticCurveUnderThisHandle, runtime.CryptoAPIErrorShouldFailExecution())
 		return
 	}
 	gasToUse := metering.GasSchedule().CryptoAPICost.AddECC * uint64(curveMultiplier) / 100
 	metering.UseAndTraceGas(gasToUse)
 
 	ec, err1 := managedType.GetEllipticCurve(ecHandle)
-	if arwen.WithFault(err1, context, runtime.CryptoAPIErrorShouldFailExecution()) {
+	if context.WithFault(err1, runtime.CryptoAPIErrorShouldFailExecution()) {
 		return
 	}
 
 	xResult, yResult, err := managedType.GetTwoBigInt(xResultHandle, yResultHandle)
 	if err != nil {
-		_ = arwen.WithFault(arwen.ErrNoBigIntUnderThisHandle, context, runtime.BigIntAPIErrorShouldFailExecution())
+		_ = context.WithFault(arwen.ErrNoBigIntUnderThisHandle, runtime.BigIntAPIErrorShouldFailExecution())
 		return
 	}
 	x1, y1, err := managedType.GetTwoBigInt(fstPointXHandle, fstPointYHandle)
 	if err != nil {
-		_ = arwen.WithFault(arwen.ErrNoBigIntUnderThisHandle, context, runtime.BigIntAPIErrorShouldFailExecution())
+		_ = context.WithFault(arwen.ErrNoBigIntUnderThisHandle, runtime.BigIntAPIErrorShouldFailExecution())
 		return
 	}
 	x2, y2, err := managedType.GetTwoBigInt(sndPointXHandle, sndPointYHandle)
 	if err != nil {
-		_ = arwen.WithFault(arwen.ErrNoBigIntUnderThisHandle, context, runtime.BigIntAPIErrorShouldFailExecution())
+		_ = context.WithFault(arwen.ErrNoBigIntUnderThisHandle, runtime.BigIntAPIErrorShouldFailExecution())
 		return
 	}
 
 	if !ec.IsOnCurve(x1, y1) || !ec.IsOnCurve(x2, y2) {
-		_ = arwen.WithFault(arwen.ErrPointNotOnCurve, context, runtime.CryptoAPIErrorShouldFailExecution())
+		_ = context.WithFault(arwen.ErrPointNotOnCurve, runtime.CryptoAPIErrorShouldFailExecution())
 		return
 	}
 
@@ -596,41 +1166,40 @@ func v1_4_addEC(
 	yResult.Set(yResultAdd)
 }
 
-//export v1_4_doubleEC
-func v1_4_doubleEC(
-	context unsafe.Pointer,
+// DoubleEC VMHooks implementation.
+func (context *CryptoAPI) DoubleEC(
 	xResultHandle int32,
 	yResultHandle int32,
 	ecHandle int32,
 	pointXHandle int32,
 	pointYHandle int32,
 ) {
-	managedType := arwen.GetManagedTypesContext(context)
-	metering := arwen.GetMeteringContext(context)
-	runtime := arwen.GetRuntimeContext(context)
+	managedType := context.GetManagedTypesContext()
+	metering := context.GetMeteringContext()
+	runtime := context.GetRuntimeContext()
 	metering.StartGasTracing(doubleECName)
 
 	curveMultiplier := managedType.Get100xCurveGasCostMultiplier(ecHandle)
 	if curveMultiplier < 0 {
-		_ = arwen.WithFault(arwen.ErrNoEllipticCurveUnderThisHandle, context, runtime.CryptoAPIErrorShouldFailExecution())
+		_ = context.WithFault(arwen.ErrNoEllipticCurveUnderThisHandle, runtime.CryptoAPIErrorShouldFailExecution())
 		return
 	}
 	gasToUse := metering.GasSchedule().CryptoAPICost.DoubleECC * uint64(curveMultiplier) / 100
 	metering.UseAndTraceGas(gasToUse)
 
 	ec, err1 := managedType.GetEllipticCurve(ecHandle)
-	if arwen.WithFault(err1, context, runtime.CryptoAPIErrorShouldFailExecution()) {
+	if context.WithFault(err1, runtime.CryptoAPIErrorShouldFailExecution()) {
 		return
 	}
 
 	xResult, yResult, err1 := managedType.GetTwoBigInt(xResultHandle, yResultHandle)
 	x, y, err2 := managedType.GetTwoBigInt(pointXHandle, pointYHandle)
 	if err1 != nil || err2 != nil {
-		_ = arwen.WithFault(arwen.ErrNoBigIntUnderThisHandle, context, runtime.CryptoAPIErrorShouldFailExecution())
+		_ = context.WithFault(arwen.ErrNoBigIntUnderThisHandle, runtime.CryptoAPIErrorShouldFailExecution())
 		return
 	}
 	if !ec.IsOnCurve(x, y) {
-		_ = arwen.WithFault(arwen.ErrPointNotOnCurve, context, runtime.CryptoAPIErrorShouldFailExecution())
+		_ = context.WithFault(arwen.ErrPointNotOnCurve, runtime.CryptoAPIErrorShouldFailExecution())
 		return
 	}
 
@@ -641,34 +1210,33 @@ func v1_4_doubleEC(
 	yResult.Set(yResultDouble)
 }
 
-//export v1_4_isOnCurveEC
-func v1_4_isOnCurveEC(
-	context unsafe.Pointer,
+// IsOnCurveEC VMHooks implementation.
+func (context *CryptoAPI) IsOnCurveEC(
 	ecHandle int32,
 	pointXHandle int32,
 	pointYHandle int32,
 ) int32 {
-	managedType := arwen.GetManagedTypesContext(context)
-	metering := arwen.GetMeteringContext(context)
-	runtime := arwen.GetRuntimeContext(context)
+	managedType := context.GetManagedTypesContext()
+	metering := context.GetMeteringContext()
+	runtime := context.GetRuntimeContext()
 	metering.StartGasTracing(isOnCurveECName)
 
 	curveMultiplier := managedType.Get100xCurveGasCostMultiplier(ecHandle)
 	if curveMultiplier < 0 {
-		_ = arwen.WithFault(arwen.ErrNoEllipticCurveUnderThisHandle, context, runtime.CryptoAPIErrorShouldFailExecution())
+		_ = context.WithFault(arwen.ErrNoEllipticCurveUnderThisHandle, runtime.CryptoAPIErrorShouldFailExecution())
 		return 1
 	}
 	gasToUse := metering.GasSchedule().CryptoAPICost.IsOnCurveECC * uint64(curveMultiplier) / 100
 	metering.UseAndTraceGas(gasToUse)
 
 	ec, err := managedType.GetEllipticCurve(ecHandle)
-	if arwen.WithFault(err, context, runtime.CryptoAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
 		return -1
 	}
 
 	x, y, err := managedType.GetTwoBigInt(pointXHandle, pointYHandle)
 	if err != nil || x == nil || y == nil {
-		_ = arwen.WithFault(arwen.ErrNoBigIntUnderThisHandle, context, runtime.CryptoAPIErrorShouldFailExecution())
+		_ = context.WithFault(arwen.ErrNoBigIntUnderThisHandle, runtime.CryptoAPIErrorShouldFailExecution())
 		return -1
 	}
 
@@ -680,28 +1248,27 @@ func v1_4_isOnCurveEC(
 	return 0
 }
 
-//export v1_4_scalarBaseMultEC
-func v1_4_scalarBaseMultEC(
-	context unsafe.Pointer,
+// ScalarBaseMultEC VMHooks implementation.
+func (context *CryptoAPI) ScalarBaseMultEC(
 	xResultHandle int32,
 	yResultHandle int32,
 	ecHandle int32,
 	dataOffset int32,
 	length int32,
 ) int32 {
-	runtime := arwen.GetRuntimeContext(context)
-	metering := arwen.GetMeteringContext(context)
-	managedType := arwen.GetManagedTypesContext(context)
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
+	managedType := context.GetManagedTypesContext()
 	metering.StartGasTracing(scalarBaseMultECName)
 
 	if length < 0 {
-		_ = arwen.WithFault(arwen.ErrNegativeLength, context, runtime.CryptoAPIErrorShouldFailExecution())
+		_ = context.WithFault(arwen.ErrNegativeLength, runtime.CryptoAPIErrorShouldFailExecution())
 		return 1
 	}
 
 	curveMultiplier := managedType.GetScalarMult100xCurveGasCostMultiplier(ecHandle)
 	if curveMultiplier < 0 {
-		_ = arwen.WithFault(arwen.ErrNoEllipticCurveUnderThisHandle, context, runtime.CryptoAPIErrorShouldFailExecution())
+		_ = context.WithFault(arwen.ErrNoEllipticCurveUnderThisHandle, runtime.CryptoAPIErrorShouldFailExecution())
 		return 1
 	}
 	oneByteScalarGasCost := metering.GasSchedule().CryptoAPICost.ScalarMultECC * uint64(curveMultiplier) / 100
@@ -709,17 +1276,17 @@ func v1_4_scalarBaseMultEC(
 	metering.UseAndTraceGas(gasToUse)
 
 	data, err := runtime.MemLoad(dataOffset, length)
-	if arwen.WithFault(err, context, runtime.CryptoAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
 		return 1
 	}
 
 	ec, err := managedType.GetEllipticCurve(ecHandle)
-	if arwen.WithFault(err, context, runtime.CryptoAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
 		return 1
 	}
 
 	xResult, yResult, err := managedType.GetTwoBigInt(xResultHandle, yResultHandle)
-	if arwen.WithFault(err, context, runtime.CryptoAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
 		return 1
 	}
 
@@ -727,7 +1294,7 @@ func v1_4_scalarBaseMultEC(
 
 	xResultSBM, yResultSBM := ec.ScalarBaseMult(data)
 	if !ec.IsOnCurve(xResultSBM, yResultSBM) {
-		_ = arwen.WithFault(arwen.ErrPointNotOnCurve, context, runtime.CryptoAPIErrorShouldFailExecution())
+		_ = context.WithFault(arwen.ErrPointNotOnCurve, runtime.CryptoAPIErrorShouldFailExecution())
 		return 1
 	}
 	xResult.Set(xResultSBM)
@@ -736,9 +1303,8 @@ func v1_4_scalarBaseMultEC(
 	return 0
 }
 
-//export v1_4_scalarMultEC
-func v1_4_scalarMultEC(
-	context unsafe.Pointer,
+// ScalarMultEC VMHooks implementation.
+func (context *CryptoAPI) ScalarMultEC(
 	xResultHandle int32,
 	yResultHandle int32,
 	ecHandle int32,
@@ -747,19 +1313,19 @@ func v1_4_scalarMultEC(
 	dataOffset int32,
 	length int32,
 ) int32 {
-	runtime := arwen.GetRuntimeContext(context)
-	metering := arwen.GetMeteringContext(context)
-	managedType := arwen.GetManagedTypesContext(context)
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
+	managedType := context.GetManagedTypesContext()
 	metering.StartGasTracing(scalarMultECName)
 
 	if length < 0 {
-		_ = arwen.WithFault(arwen.ErrNegativeLength, context, runtime.CryptoAPIErrorShouldFailExecution())
+		_ = context.WithFault(arwen.ErrNegativeLength, runtime.CryptoAPIErrorShouldFailExecution())
 		return 1
 	}
 
 	curveMultiplier := managedType.GetScalarMult100xCurveGasCostMultiplier(ecHandle)
 	if curveMultiplier < 0 {
-		_ = arwen.WithFault(arwen.ErrNoEllipticCurveUnderThisHandle, context, runtime.CryptoAPIErrorShouldFailExecution())
+		_ = context.WithFault(arwen.ErrNoEllipticCurveUnderThisHandle, runtime.CryptoAPIErrorShouldFailExecution())
 		return 1
 	}
 	oneByteScalarGasCost := metering.GasSchedule().CryptoAPICost.ScalarMultECC * uint64(curveMultiplier) / 100
@@ -767,30 +1333,30 @@ func v1_4_scalarMultEC(
 	metering.UseAndTraceGas(gasToUse)
 
 	data, err := runtime.MemLoad(dataOffset, length)
-	if arwen.WithFault(err, context, runtime.CryptoAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
 		return 1
 	}
 
 	ec, err1 := managedType.GetEllipticCurve(ecHandle)
-	if arwen.WithFault(err1, context, runtime.CryptoAPIErrorShouldFailExecution()) {
+	if context.WithFault(err1, runtime.CryptoAPIErrorShouldFailExecution()) {
 		return 1
 	}
 
 	xResult, yResult, err1 := managedType.GetTwoBigInt(xResultHandle, yResultHandle)
 	x, y, err2 := managedType.GetTwoBigInt(pointXHandle, pointYHandle)
 	if err1 != nil || err2 != nil {
-		_ = arwen.WithFault(arwen.ErrNoBigIntUnderThisHandle, context, runtime.CryptoAPIErrorShouldFailExecution())
+		_ = context.WithFault(arwen.ErrNoBigIntUnderThisHandle, runtime.CryptoAPIErrorShouldFailExecution())
 		return 1
 	}
 	if !ec.IsOnCurve(x, y) {
-		_ = arwen.WithFault(arwen.ErrPointNotOnCurve, context, runtime.CryptoAPIErrorShouldFailExecution())
+		_ = context.WithFault(arwen.ErrPointNotOnCurve, runtime.CryptoAPIErrorShouldFailExecution())
 		return 1
 	}
 
 	managedType.ConsumeGasForBigIntCopy(xResult, yResult, ec.P, ec.N, ec.B, ec.Gx, ec.Gy, x, y)
 	xResultSM, yResultSM := ec.ScalarMult(x, y, data)
 	if !ec.IsOnCurve(xResultSM, yResultSM) {
-		_ = arwen.WithFault(arwen.ErrPointNotOnCurve, context, runtime.CryptoAPIErrorShouldFailExecution())
+		_ = context.WithFault(arwen.ErrPointNotOnCurve, runtime.CryptoAPIErrorShouldFailExecution())
 		return 1
 	}
 	xResult.Set(xResultSM)
@@ -799,42 +1365,41 @@ func v1_4_scalarMultEC(
 	return 0
 }
 
-//export v1_4_marshalEC
-func v1_4_marshalEC(
-	context unsafe.Pointer,
+// MarshalEC VMHooks implementation.
+func (context *CryptoAPI) MarshalEC(
 	xPairHandle int32,
 	yPairHandle int32,
 	ecHandle int32,
 	resultOffset int32,
 ) int32 {
-	runtime := arwen.GetRuntimeContext(context)
-	metering := arwen.GetMeteringContext(context)
-	managedType := arwen.GetManagedTypesContext(context)
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
+	managedType := context.GetManagedTypesContext()
 	metering.StartGasTracing(marshalECName)
 
 	curveMultiplier := managedType.Get100xCurveGasCostMultiplier(ecHandle)
 	if curveMultiplier < 0 {
-		_ = arwen.WithFault(arwen.ErrNoEllipticCurveUnderThisHandle, context, runtime.CryptoAPIErrorShouldFailExecution())
+		_ = context.WithFault(arwen.ErrNoEllipticCurveUnderThisHandle, runtime.CryptoAPIErrorShouldFailExecution())
 		return -1
 	}
 	gasToUse := metering.GasSchedule().CryptoAPICost.MarshalECC * uint64(curveMultiplier) / 100
 	metering.UseAndTraceGas(gasToUse)
 
 	ec, err := managedType.GetEllipticCurve(ecHandle)
-	if arwen.WithFault(err, context, runtime.CryptoAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
 		return -1
 	}
 
 	x, y, err := managedType.GetTwoBigInt(xPairHandle, yPairHandle)
-	if arwen.WithFault(err, context, runtime.CryptoAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
 		return -1
 	}
 	if !ec.IsOnCurve(x, y) {
-		_ = arwen.WithFault(arwen.ErrPointNotOnCurve, context, runtime.CryptoAPIErrorShouldFailExecution())
+		_ = context.WithFault(arwen.ErrPointNotOnCurve, runtime.CryptoAPIErrorShouldFailExecution())
 		return -1
 	}
 	if x.BitLen() > int(ec.BitSize) || y.BitLen() > int(ec.BitSize) {
-		_ = arwen.WithFault(arwen.ErrLengthOfBufferNotCorrect, context, runtime.CryptoAPIErrorShouldFailExecution())
+		_ = context.WithFault(arwen.ErrLengthOfBufferNotCorrect, runtime.CryptoAPIErrorShouldFailExecution())
 		return -1
 	}
 
@@ -842,49 +1407,48 @@ func v1_4_marshalEC(
 
 	result := elliptic.Marshal(ec, x, y)
 	err = runtime.MemStore(resultOffset, result)
-	if arwen.WithFault(err, context, runtime.CryptoAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
 		return -1
 	}
 	return int32(len(result))
 }
 
-//export v1_4_marshalCompressedEC
-func v1_4_marshalCompressedEC(
-	context unsafe.Pointer,
+// MarshalCompressedEC VMHooks implementation.
+func (context *CryptoAPI) MarshalCompressedEC(
 	xPairHandle int32,
 	yPairHandle int32,
 	ecHandle int32,
 	resultOffset int32,
 ) int32 {
-	runtime := arwen.GetRuntimeContext(context)
-	metering := arwen.GetMeteringContext(context)
-	managedType := arwen.GetManagedTypesContext(context)
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
+	managedType := context.GetManagedTypesContext()
 	metering.StartGasTracing(marshalCompressedECName)
 
 	curveMultiplier := managedType.Get100xCurveGasCostMultiplier(ecHandle)
 	if curveMultiplier < 0 {
-		_ = arwen.WithFault(arwen.ErrNoEllipticCurveUnderThisHandle, context, runtime.CryptoAPIErrorShouldFailExecution())
+		_ = context.WithFault(arwen.ErrNoEllipticCurveUnderThisHandle, runtime.CryptoAPIErrorShouldFailExecution())
 		return -1
 	}
 	gasToUse := metering.GasSchedule().CryptoAPICost.MarshalCompressedECC * uint64(curveMultiplier) / 100
 	metering.UseAndTraceGas(gasToUse)
 
 	ec, err := managedType.GetEllipticCurve(ecHandle)
-	if arwen.WithFault(err, context, runtime.CryptoAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
 		return -1
 	}
 
 	x, y, err := managedType.GetTwoBigInt(xPairHandle, yPairHandle)
 	if err != nil || x == nil || y == nil {
-		_ = arwen.WithFault(arwen.ErrNoBigIntUnderThisHandle, context, runtime.CryptoAPIErrorShouldFailExecution())
+		_ = context.WithFault(arwen.ErrNoBigIntUnderThisHandle, runtime.CryptoAPIErrorShouldFailExecution())
 		return -1
 	}
 	if !ec.IsOnCurve(x, y) {
-		_ = arwen.WithFault(arwen.ErrPointNotOnCurve, context, runtime.CryptoAPIErrorShouldFailExecution())
+		_ = context.WithFault(arwen.ErrPointNotOnCurve, runtime.CryptoAPIErrorShouldFailExecution())
 		return -1
 	}
 	if x.BitLen() > int(ec.BitSize) || y.BitLen() > int(ec.BitSize) {
-		_ = arwen.WithFault(arwen.ErrLengthOfBufferNotCorrect, context, runtime.CryptoAPIErrorShouldFailExecution())
+		_ = context.WithFault(arwen.ErrLengthOfBufferNotCorrect, runtime.CryptoAPIErrorShouldFailExecution())
 		return -1
 	}
 
@@ -892,51 +1456,50 @@ func v1_4_marshalCompressedEC(
 
 	result := elliptic.MarshalCompressed(ec, x, y)
 	err = runtime.MemStore(resultOffset, result)
-	if arwen.WithFault(err, context, runtime.CryptoAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
 		return -1
 	}
 	return int32(len(result))
 }
 
-//export v1_4_unmarshalEC
-func v1_4_unmarshalEC(
-	context unsafe.Pointer,
+// UnmarshalEC VMHooks implementation.
+func (context *CryptoAPI) UnmarshalEC(
 	xResultHandle int32,
 	yResultHandle int32,
 	ecHandle int32,
 	dataOffset int32,
 	length int32,
 ) int32 {
-	runtime := arwen.GetRuntimeContext(context)
-	metering := arwen.GetMeteringContext(context)
-	managedType := arwen.GetManagedTypesContext(context)
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
+	managedType := context.GetManagedTypesContext()
 	metering.StartGasTracing(unmarshalECName)
 
 	curveMultiplier := managedType.Get100xCurveGasCostMultiplier(ecHandle)
 	if curveMultiplier < 0 {
-		_ = arwen.WithFault(arwen.ErrNoEllipticCurveUnderThisHandle, context, runtime.CryptoAPIErrorShouldFailExecution())
+		_ = context.WithFault(arwen.ErrNoEllipticCurveUnderThisHandle, runtime.CryptoAPIErrorShouldFailExecution())
 		return 1
 	}
 	gasToUse := metering.GasSchedule().CryptoAPICost.UnmarshalECC * uint64(curveMultiplier) / 100
 	metering.UseAndTraceGas(gasToUse)
 
 	data, err := runtime.MemLoad(dataOffset, length)
-	if arwen.WithFault(err, context, runtime.CryptoAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
 		return 1
 	}
 
 	ec, err := managedType.GetEllipticCurve(ecHandle)
-	if arwen.WithFault(err, context, runtime.CryptoAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
 		return 1
 	}
 	byteLen := (ec.BitSize + 7) / 8
 	if int(length) != 1+2*byteLen {
-		_ = arwen.WithFault(arwen.ErrLengthOfBufferNotCorrect, context, runtime.CryptoAPIErrorShouldFailExecution())
+		_ = context.WithFault(arwen.ErrLengthOfBufferNotCorrect, runtime.CryptoAPIErrorShouldFailExecution())
 		return 1
 	}
 
 	xResult, yResult, err := managedType.GetTwoBigInt(xResultHandle, yResultHandle)
-	if arwen.WithFault(err, context, runtime.CryptoAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
 		return 1
 	}
 
@@ -944,7 +1507,7 @@ func v1_4_unmarshalEC(
 
 	xResultU, yResultU := elliptic.Unmarshal(ec, data)
 	if xResultU == nil || yResultU == nil || !ec.IsOnCurve(xResultU, yResultU) {
-		_ = arwen.WithFault(arwen.ErrPointNotOnCurve, context, runtime.CryptoAPIErrorShouldFailExecution())
+		_ = context.WithFault(arwen.ErrPointNotOnCurve, runtime.CryptoAPIErrorShouldFailExecution())
 		return 1
 	}
 	xResult.Set(xResultU)
@@ -953,45 +1516,44 @@ func v1_4_unmarshalEC(
 	return 0
 }
 
-//export v1_4_unmarshalCompressedEC
-func v1_4_unmarshalCompressedEC(
-	context unsafe.Pointer,
+// UnmarshalCompressedEC VMHooks implementation.
+func (context *CryptoAPI) UnmarshalCompressedEC(
 	xResultHandle int32,
 	yResultHandle int32,
 	ecHandle int32,
 	dataOffset int32,
 	length int32,
 ) int32 {
-	runtime := arwen.GetRuntimeContext(context)
-	metering := arwen.GetMeteringContext(context)
-	managedType := arwen.GetManagedTypesContext(context)
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
+	managedType := context.GetManagedTypesContext()
 	metering.StartGasTracing(unmarshalCompressedECName)
 
 	curveMultiplier := managedType.GetUCompressed100xCurveGasCostMultiplier(ecHandle)
 	if curveMultiplier < 0 {
-		_ = arwen.WithFault(arwen.ErrNoEllipticCurveUnderThisHandle, context, runtime.CryptoAPIErrorShouldFailExecution())
+		_ = context.WithFault(arwen.ErrNoEllipticCurveUnderThisHandle, runtime.CryptoAPIErrorShouldFailExecution())
 		return 1
 	}
 	gasToUse := metering.GasSchedule().CryptoAPICost.UnmarshalCompressedECC * uint64(curveMultiplier) / 100
 	metering.UseAndTraceGas(gasToUse)
 
 	data, err := runtime.MemLoad(dataOffset, length)
-	if arwen.WithFault(err, context, runtime.CryptoAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
 		return int32(len(data))
 	}
 
 	ec, err := managedType.GetEllipticCurve(ecHandle)
-	if arwen.WithFault(err, context, runtime.CryptoAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
 		return 1
 	}
 	byteLen := (ec.BitSize+7)/8 + 1
 	if int(length) != byteLen {
-		_ = arwen.WithFault(arwen.ErrLengthOfBufferNotCorrect, context, runtime.CryptoAPIErrorShouldFailExecution())
+		_ = context.WithFault(arwen.ErrLengthOfBufferNotCorrect, runtime.CryptoAPIErrorShouldFailExecution())
 		return 1
 	}
 
 	xResult, yResult, err := managedType.GetTwoBigInt(xResultHandle, yResultHandle)
-	if arwen.WithFault(err, context, runtime.CryptoAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
 		return 1
 	}
 
@@ -999,7 +1561,7 @@ func v1_4_unmarshalCompressedEC(
 
 	xResultUC, yResultUC := elliptic.UnmarshalCompressed(ec, data)
 	if xResultUC == nil || yResultUC == nil || !ec.IsOnCurve(xResultUC, yResultUC) {
-		_ = arwen.WithFault(arwen.ErrPointNotOnCurve, context, runtime.CryptoAPIErrorShouldFailExecution())
+		_ = context.WithFault(arwen.ErrPointNotOnCurve, runtime.CryptoAPIErrorShouldFailExecution())
 		return 1
 	}
 	xResult.Set(xResultUC)
@@ -1007,22 +1569,21 @@ func v1_4_unmarshalCompressedEC(
 	return 0
 }
 
-//export v1_4_generateKeyEC
-func v1_4_generateKeyEC(
-	context unsafe.Pointer,
+// GenerateKeyEC VMHooks implementation.
+func (context *CryptoAPI) GenerateKeyEC(
 	xPubKeyHandle int32,
 	yPubKeyHandle int32,
 	ecHandle int32,
 	resultOffset int32,
 ) int32 {
-	runtime := arwen.GetRuntimeContext(context)
-	metering := arwen.GetMeteringContext(context)
-	managedType := arwen.GetManagedTypesContext(context)
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
+	managedType := context.GetManagedTypesContext()
 	metering.StartGasTracing(generateKeyECName)
 
 	curveMultiplier := managedType.Get100xCurveGasCostMultiplier(ecHandle)
 	if curveMultiplier < 0 {
-		_ = arwen.WithFault(arwen.ErrNoEllipticCurveUnderThisHandle, context, runtime.CryptoAPIErrorShouldFailExecution())
+		_ = context.WithFault(arwen.ErrNoEllipticCurveUnderThisHandle, runtime.CryptoAPIErrorShouldFailExecution())
 		return 1
 	}
 	if curveMultiplier == 250 {
@@ -1032,24 +1593,24 @@ func v1_4_generateKeyEC(
 	metering.UseAndTraceGas(gasToUse)
 
 	ec, err := managedType.GetEllipticCurve(ecHandle)
-	if arwen.WithFault(err, context, runtime.CryptoAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
 		return 1
 	}
 
 	xPubKey, yPubKey, err := managedType.GetTwoBigInt(xPubKeyHandle, yPubKeyHandle)
-	if arwen.WithFault(err, context, runtime.CryptoAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
 		return 1
 	}
 	managedType.ConsumeGasForBigIntCopy(ec.P, ec.N, ec.B, ec.Gx, ec.Gy, xPubKey, yPubKey)
 
 	ioReader := managedType.GetRandReader()
 	result, xPubKeyGK, yPubKeyGK, err := elliptic.GenerateKey(ec, ioReader)
-	if arwen.WithFault(err, context, runtime.CryptoAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
 		return int32(len(result))
 	}
 
 	err = runtime.MemStore(resultOffset, result)
-	if arwen.WithFault(err, context, runtime.CryptoAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
 		return int32(len(result))
 	}
 
@@ -1058,21 +1619,21 @@ func v1_4_generateKeyEC(
 	return 0
 }
 
-//export v1_4_createEC
-func v1_4_createEC(context unsafe.Pointer, dataOffset int32, dataLength int32) int32 {
-	managedType := arwen.GetManagedTypesContext(context)
-	runtime := arwen.GetRuntimeContext(context)
-	metering := arwen.GetMeteringContext(context)
+// CreateEC VMHooks implementation.
+func (context *CryptoAPI) CreateEC(dataOffset int32, dataLength int32) int32 {
+	managedType := context.GetManagedTypesContext()
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
 
 	gasToUse := metering.GasSchedule().CryptoAPICost.EllipticCurveNew
 	metering.UseGasAndAddTracedGas(createECName, gasToUse)
 
 	if dataLength != curveNameLength {
-		_ = arwen.WithFault(arwen.ErrBadBounds, context, runtime.CryptoAPIErrorShouldFailExecution())
+		_ = context.WithFault(arwen.ErrBadBounds, runtime.CryptoAPIErrorShouldFailExecution())
 		return -1
 	}
 	data, err := runtime.MemLoad(dataOffset, dataLength)
-	if arwen.WithFault(err, context, runtime.CryptoAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
 		return -1
 	}
 	curveChoice := string(data[:])
@@ -1093,63 +1654,63 @@ func v1_4_createEC(context unsafe.Pointer, dataOffset int32, dataLength int32) i
 	return -1
 }
 
-//export v1_4_getCurveLengthEC
-func v1_4_getCurveLengthEC(context unsafe.Pointer, ecHandle int32) int32 {
-	managedType := arwen.GetManagedTypesContext(context)
-	metering := arwen.GetMeteringContext(context)
-	runtime := arwen.GetRuntimeContext(context)
+// GetCurveLengthEC VMHooks implementation.
+func (context *CryptoAPI) GetCurveLengthEC(ecHandle int32) int32 {
+	managedType := context.GetManagedTypesContext()
+	metering := context.GetMeteringContext()
+	runtime := context.GetRuntimeContext()
 
 	gasToUse := metering.GasSchedule().BigIntAPICost.BigIntGetInt64
 	metering.UseGasAndAddTracedGas(getCurveLengthECName, gasToUse)
 
 	ecLength := managedType.GetEllipticCurveSizeOfField(ecHandle)
 	if ecLength == -1 {
-		_ = arwen.WithFault(arwen.ErrNoEllipticCurveUnderThisHandle, context, runtime.BigIntAPIErrorShouldFailExecution())
+		_ = context.WithFault(arwen.ErrNoEllipticCurveUnderThisHandle, runtime.BigIntAPIErrorShouldFailExecution())
 	}
 
 	return ecLength
 }
 
-//export v1_4_getPrivKeyByteLengthEC
-func v1_4_getPrivKeyByteLengthEC(context unsafe.Pointer, ecHandle int32) int32 {
-	managedType := arwen.GetManagedTypesContext(context)
-	metering := arwen.GetMeteringContext(context)
-	runtime := arwen.GetRuntimeContext(context)
+// GetPrivKeyByteLengthEC VMHooks implementation.
+func (context *CryptoAPI) GetPrivKeyByteLengthEC(ecHandle int32) int32 {
+	managedType := context.GetManagedTypesContext()
+	metering := context.GetMeteringContext()
+	runtime := context.GetRuntimeContext()
 
 	gasToUse := metering.GasSchedule().BigIntAPICost.BigIntGetInt64
 	metering.UseGasAndAddTracedGas(getPrivKeyByteLengthECName, gasToUse)
 
 	byteLength := managedType.GetPrivateKeyByteLengthEC(ecHandle)
 	if byteLength == -1 {
-		_ = arwen.WithFault(arwen.ErrNoEllipticCurveUnderThisHandle, context, runtime.BigIntAPIErrorShouldFailExecution())
+		_ = context.WithFault(arwen.ErrNoEllipticCurveUnderThisHandle, runtime.BigIntAPIErrorShouldFailExecution())
 	}
 
 	return byteLength
 }
 
-//export v1_4_ellipticCurveGetValues
-func v1_4_ellipticCurveGetValues(context unsafe.Pointer, ecHandle int32, fieldOrderHandle int32, basePointOrderHandle int32, eqConstantHandle int32, xBasePointHandle int32, yBasePointHandle int32) int32 {
-	managedType := arwen.GetManagedTypesContext(context)
-	metering := arwen.GetMeteringContext(context)
-	runtime := arwen.GetRuntimeContext(context)
+// EllipticCurveGetValues VMHooks implementation.
+func (context *CryptoAPI) EllipticCurveGetValues(ecHandle int32, fieldOrderHandle int32, basePointOrderHandle int32, eqConstantHandle int32, xBasePointHandle int32, yBasePointHandle int32) int32 {
+	managedType := context.GetManagedTypesContext()
+	metering := context.GetMeteringContext()
+	runtime := context.GetRuntimeContext()
 
 	gasToUse := metering.GasSchedule().BigIntAPICost.BigIntGetInt64 * 5
 	metering.UseGasAndAddTracedGas(ellipticCurveGetValuesName, gasToUse)
 
 	ec, err := managedType.GetEllipticCurve(ecHandle)
-	if arwen.WithFault(err, context, runtime.CryptoAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
 		return -1
 	}
 	fieldOrder, basePointOrder, err := managedType.GetTwoBigInt(fieldOrderHandle, basePointOrderHandle)
-	if arwen.WithFault(err, context, runtime.CryptoAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
 		return -1
 	}
 	eqConstant, err := managedType.GetBigInt(eqConstantHandle)
-	if arwen.WithFault(err, context, runtime.CryptoAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
 		return -1
 	}
 	xBasePoint, yBasePoint, err := managedType.GetTwoBigInt(xBasePointHandle, yBasePointHandle)
-	if arwen.WithFault(err, context, runtime.CryptoAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
 		return -1
 	}
 	fieldOrder.Set(ec.P)
@@ -0,0 +1,229 @@
+// Code generated by vmhooksgen. DO NOT EDIT.
+// Call `go generate` in `arwen-wasm-vm/arwen/vmhooks` to update it.
+
+package eei
+
+// functionSignatures holds the signatures of the functions of the VMHooks, by the name under which contracts import them
+var functionSignatures = map[string]FunctionSignature{
+	"getGasLeft":                          {Results: []ValueType{ValueTypeI64}},
+	"getSCAddress":                        {Params: []ValueType{ValueTypeI32}},
+	"getOwnerAddress":                     {Params: []ValueType{ValueTypeI32}},
+	"getShardOfAddress":                   {Params: []ValueType{ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"isSmartContract":                     {Params: []ValueType{ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"signalError":                         {Params: []ValueType{ValueTypeI32, ValueTypeI32}},
+	"getExternalBalance":                  {Params: []ValueType{ValueTypeI32, ValueTypeI32}},
+	"getBlockHash":                        {Params: []ValueType{ValueTypeI64, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"getESDTBalance":                      {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI64, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"getESDTNFTNameLength":                {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI64}, Results: []ValueType{ValueTypeI32}},
+	"getESDTNFTAttributeLength":           {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI64}, Results: []ValueType{ValueTypeI32}},
+	"getESDTNFTURILength":                 {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI64}, Results: []ValueType{ValueTypeI32}},
+	"getESDTTokenData":                    {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI64, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"getESDTLocalRoles":                   {Params: []ValueType{ValueTypeI32}, Results: []ValueType{ValueTypeI64}},
+	"validateTokenIdentifier":             {Params: []ValueType{ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"transferValue":                       {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"transferValueExecute":                {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI64, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"transferESDTExecute":                 {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI64, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"transferESDTNFTExecute":              {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI64, ValueTypeI64, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"multiTransferESDTNFTExecute":         {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI64, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"upgradeContract":                     {Params: []ValueType{ValueTypeI32, ValueTypeI64, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32}},
+	"upgradeFromSourceContract":           {Params: []ValueType{ValueTypeI32, ValueTypeI64, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32}},
+	"asyncCall":                           {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32}},
+	"createAsyncCall":                     {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI64}},
+	"getArgumentLength":                   {Params: []ValueType{ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"getArgument":                         {Params: []ValueType{ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"getFunction":                         {Params: []ValueType{ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"getNumArguments":                     {Results: []ValueType{ValueTypeI32}},
+	"storageStore":                        {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"storageLoadLength":                   {Params: []ValueType{ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"storageLoadFromAddress":              {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"storageLoad":                         {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"setStorageLock":                      {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI64}, Results: []ValueType{ValueTypeI32}},
+	"getStorageLock":                      {Params: []ValueType{ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI64}},
+	"isStorageLocked":                     {Params: []ValueType{ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"clearStorageLock":                    {Params: []ValueType{ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"getCaller":                           {Params: []ValueType{ValueTypeI32}},
+	"checkNoPayment":                      {},
+	"getCallValue":                        {Params: []ValueType{ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"getESDTValue":                        {Params: []ValueType{ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"getESDTValueByIndex":                 {Params: []ValueType{ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"getESDTTokenName":                    {Params: []ValueType{ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"getESDTTokenNameByIndex":             {Params: []ValueType{ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"getESDTTokenNonce":                   {Results: []ValueType{ValueTypeI64}},
+	"getESDTTokenNonceByIndex":            {Params: []ValueType{ValueTypeI32}, Results: []ValueType{ValueTypeI64}},
+	"getCurrentESDTNFTNonce":              {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI64}},
+	"getESDTTokenType":                    {Results: []ValueType{ValueTypeI32}},
+	"getESDTTokenTypeByIndex":             {Params: []ValueType{ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"getNumESDTTransfers":                 {Results: []ValueType{ValueTypeI32}},
+	"getCallValueTokenName":               {Params: []ValueType{ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"getCallValueTokenNameByIndex":        {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"writeLog":                            {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32}},
+	"writeEventLog":                       {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32}},
+	"getBlockTimestamp":                   {Results: []ValueType{ValueTypeI64}},
+	"getBlockNonce":                       {Results: []ValueType{ValueTypeI64}},
+	"getBlockRound":                       {Results: []ValueType{ValueTypeI64}},
+	"getBlockEpoch":                       {Results: []ValueType{ValueTypeI64}},
+	"getBlockRandomSeed":                  {Params: []ValueType{ValueTypeI32}},
+	"getStateRootHash":                    {Params: []ValueType{ValueTypeI32}},
+	"getPrevBlockTimestamp":               {Results: []ValueType{ValueTypeI64}},
+	"getPrevBlockNonce":                   {Results: []ValueType{ValueTypeI64}},
+	"getPrevBlockRound":                   {Results: []ValueType{ValueTypeI64}},
+	"getPrevBlockEpoch":                   {Results: []ValueType{ValueTypeI64}},
+	"getPrevBlockRandomSeed":              {Params: []ValueType{ValueTypeI32}},
+	"finish":                              {Params: []ValueType{ValueTypeI32, ValueTypeI32}},
+	"executeOnSameContext":                {Params: []ValueType{ValueTypeI64, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"executeOnDestContext":                {Params: []ValueType{ValueTypeI64, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"executeOnDestContextByCaller":        {Params: []ValueType{ValueTypeI64, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"executeReadOnly":                     {Params: []ValueType{ValueTypeI64, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"createContract":                      {Params: []ValueType{ValueTypeI64, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"deployFromSourceContract":            {Params: []ValueType{ValueTypeI64, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"getNumReturnData":                    {Results: []ValueType{ValueTypeI32}},
+	"getReturnDataSize":                   {Params: []ValueType{ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"getReturnData":                       {Params: []ValueType{ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"cleanReturnData":                     {},
+	"deleteFromReturnData":                {Params: []ValueType{ValueTypeI32}},
+	"getOriginalTxHash":                   {Params: []ValueType{ValueTypeI32}},
+	"bigIntGetUnsignedArgument":           {Params: []ValueType{ValueTypeI32, ValueTypeI32}},
+	"bigIntGetSignedArgument":             {Params: []ValueType{ValueTypeI32, ValueTypeI32}},
+	"bigIntStorageStoreUnsigned":          {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"bigIntStorageLoadUnsigned":           {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"bigIntGetCallValue":                  {Params: []ValueType{ValueTypeI32}},
+	"bigIntGetESDTCallValue":              {Params: []ValueType{ValueTypeI32}},
+	"bigIntGetESDTCallValueByIndex":       {Params: []ValueType{ValueTypeI32, ValueTypeI32}},
+	"bigIntGetExternalBalance":            {Params: []ValueType{ValueTypeI32, ValueTypeI32}},
+	"bigIntGetESDTExternalBalance":        {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI64, ValueTypeI32}},
+	"bigIntNew":                           {Params: []ValueType{ValueTypeI64}, Results: []ValueType{ValueTypeI32}},
+	"bigIntUnsignedByteLength":            {Params: []ValueType{ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"bigIntSignedByteLength":              {Params: []ValueType{ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"bigIntGetUnsignedBytes":              {Params: []ValueType{ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"bigIntGetSignedBytes":                {Params: []ValueType{ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"bigIntSetUnsignedBytes":              {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32}},
+	"bigIntSetSignedBytes":                {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32}},
+	"bigIntIsInt64":                       {Params: []ValueType{ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"bigIntGetInt64":                      {Params: []ValueType{ValueTypeI32}, Results: []ValueType{ValueTypeI64}},
+	"bigIntSetInt64":                      {Params: []ValueType{ValueTypeI32, ValueTypeI64}},
+	"bigIntAdd":                           {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32}},
+	"bigIntSub":                           {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32}},
+	"bigIntMul":                           {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32}},
+	"bigIntTDiv":                          {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32}},
+	"bigIntTMod":                          {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32}},
+	"bigIntEDiv":                          {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32}},
+	"bigIntEMod":                          {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32}},
+	"bigIntSqrt":                          {Params: []ValueType{ValueTypeI32, ValueTypeI32}},
+	"bigIntPow":                           {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32}},
+	"bigIntLog2":                          {Params: []ValueType{ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"bigIntAbs":                           {Params: []ValueType{ValueTypeI32, ValueTypeI32}},
+	"bigIntNeg":                           {Params: []ValueType{ValueTypeI32, ValueTypeI32}},
+	"bigIntSign":                          {Params: []ValueType{ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"bigIntCmp":                           {Params: []ValueType{ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"bigIntNot":                           {Params: []ValueType{ValueTypeI32, ValueTypeI32}},
+	"bigIntAnd":                           {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32}},
+	"bigIntOr":                            {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32}},
+	"bigIntXor":                           {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32}},
+	"bigIntShr":                           {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32}},
+	"bigIntShl":                           {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32}},
+	"bigIntFinishUnsigned":                {Params: []ValueType{ValueTypeI32}},
+	"bigIntFinishSigned":                  {Params: []ValueType{ValueTypeI32}},
+	"smallIntGetUnsignedArgument":         {Params: []ValueType{ValueTypeI32}, Results: []ValueType{ValueTypeI64}},
+	"smallIntGetSignedArgument":           {Params: []ValueType{ValueTypeI32}, Results: []ValueType{ValueTypeI64}},
+	"smallIntFinishUnsigned":              {Params: []ValueType{ValueTypeI64}},
+	"smallIntFinishSigned":                {Params: []ValueType{ValueTypeI64}},
+	"smallIntStorageStoreUnsigned":        {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI64}, Results: []ValueType{ValueTypeI32}},
+	"smallIntStorageStoreSigned":          {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI64}, Results: []ValueType{ValueTypeI32}},
+	"smallIntStorageLoadUnsigned":         {Params: []ValueType{ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI64}},
+	"smallIntStorageLoadSigned":           {Params: []ValueType{ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI64}},
+	"int64getArgument":                    {Params: []ValueType{ValueTypeI32}, Results: []ValueType{ValueTypeI64}},
+	"int64finish":                         {Params: []ValueType{ValueTypeI64}},
+	"int64storageStore":                   {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI64}, Results: []ValueType{ValueTypeI32}},
+	"int64storageLoad":                    {Params: []ValueType{ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI64}},
+	"managedSCAddress":                    {Params: []ValueType{ValueTypeI32}},
+	"managedOwnerAddress":                 {Params: []ValueType{ValueTypeI32}},
+	"managedCaller":                       {Params: []ValueType{ValueTypeI32}},
+	"managedSignalError":                  {Params: []ValueType{ValueTypeI32}},
+	"managedWriteLog":                     {Params: []ValueType{ValueTypeI32, ValueTypeI32}},
+	"managedGetOriginalTxHash":            {Params: []ValueType{ValueTypeI32}},
+	"managedGetStateRootHash":             {Params: []ValueType{ValueTypeI32}},
+	"managedGetBlockRandomSeed":           {Params: []ValueType{ValueTypeI32}},
+	"managedGetPrevBlockRandomSeed":       {Params: []ValueType{ValueTypeI32}},
+	"managedGetReturnData":                {Params: []ValueType{ValueTypeI32, ValueTypeI32}},
+	"managedGetMultiESDTCallValue":        {Params: []ValueType{ValueTypeI32}},
+	"managedGetESDTBalance":               {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI64, ValueTypeI32}},
+	"managedGetESDTTokenData":             {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI64, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32}},
+	"managedAsyncCall":                    {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32}},
+	"managedCreateAsyncCall":              {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI64, ValueTypeI64, ValueTypeI32}},
+	"managedGetCallbackClosure":           {Params: []ValueType{ValueTypeI32}},
+	"managedUpgradeFromSourceContract":    {Params: []ValueType{ValueTypeI32, ValueTypeI64, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32}},
+	"managedUpgradeContract":              {Params: []ValueType{ValueTypeI32, ValueTypeI64, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32}},
+	"managedDeployFromSourceContract":     {Params: []ValueType{ValueTypeI64, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"managedCreateContract":               {Params: []ValueType{ValueTypeI64, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"managedExecuteReadOnly":              {Params: []ValueType{ValueTypeI64, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"managedExecuteOnSameContext":         {Params: []ValueType{ValueTypeI64, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"managedExecuteOnDestContextByCaller": {Params: []ValueType{ValueTypeI64, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"managedExecuteOnDestContext":         {Params: []ValueType{ValueTypeI64, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"managedMultiTransferESDTNFTExecute":  {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI64, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"managedTransferValueExecute":         {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI64, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"mBufferNew":                          {Results: []ValueType{ValueTypeI32}},
+	"mBufferNewFromBytes":                 {Params: []ValueType{ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"mBufferGetLength":                    {Params: []ValueType{ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"mBufferGetBytes":                     {Params: []ValueType{ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"mBufferGetByteSlice":                 {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"mBufferCopyByteSlice":                {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"mBufferEq":                           {Params: []ValueType{ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"mBufferSetBytes":                     {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"mBufferSetByteSlice":                 {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"mBufferAppend":                       {Params: []ValueType{ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"mBufferAppendBytes":                  {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"mBufferToBigIntUnsigned":             {Params: []ValueType{ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"mBufferToBigIntSigned":               {Params: []ValueType{ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"mBufferFromBigIntUnsigned":           {Params: []ValueType{ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"mBufferFromBigIntSigned":             {Params: []ValueType{ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"mBufferStorageStore":                 {Params: []ValueType{ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"mBufferStorageLoad":                  {Params: []ValueType{ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"mBufferStorageLoadFromAddress":       {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32}},
+	"mBufferGetArgument":                  {Params: []ValueType{ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"mBufferFinish":                       {Params: []ValueType{ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"mBufferSetRandom":                    {Params: []ValueType{ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"sha256":                              {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"managedSha256":                       {Params: []ValueType{ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"keccak256":                           {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"managedKeccak256":                    {Params: []ValueType{ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"ripemd160":                           {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"sha512":                              {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"managedSha512":                       {Params: []ValueType{ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"sha3256":                             {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"managedSha3256":                      {Params: []ValueType{ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"blake2b256":                          {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"managedBlake2b256":                   {Params: []ValueType{ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"blake2b512":                          {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"managedBlake2b512":                   {Params: []ValueType{ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"poseidon":                            {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"managedPoseidon":                     {Params: []ValueType{ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"verifyBLS":                           {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"verifyEd25519":                       {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"verifyCustomSecp256k1":               {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"verifySecp256k1":                     {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"verifySecp256r1":                     {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"managedVerifySecp256r1":              {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"verifySchnorr":                       {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"managedVerifySchnorr":                {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"managedAddG1":                        {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"managedScalarMultG1":                 {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"managedAddG2":                        {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"managedScalarMultG2":                 {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"managedPairingCheck":                 {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"managedVerifyGroth16":                {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"encodeSecp256k1DerSignature":         {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"addEC":                               {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32}},
+	"doubleEC":                            {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32}},
+	"isOnCurveEC":                         {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"scalarBaseMultEC":                    {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"scalarMultEC":                        {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"marshalEC":                           {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"marshalCompressedEC":                 {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"unmarshalEC":                         {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"unmarshalCompressedEC":               {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"generateKeyEC":                       {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"createEC":                            {Params: []ValueType{ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"getCurveLengthEC":                    {Params: []ValueType{ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"getPrivKeyByteLengthEC":              {Params: []ValueType{ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+	"ellipticCurveGetValues":              {Params: []ValueType{ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32, ValueTypeI32}, Results: []ValueType{ValueTypeI32}},
+}
@@ -0,0 +1,29 @@
+package eei
+
+// ModuleName is the module from which the contracts import the EEI functions
+const ModuleName = "env"
+
+// ValueType is the type of a parameter or of the result of an EEI function, as encoded in the WebAssembly binary format
+type ValueType byte
+
+const (
+	// ValueTypeI32 is the i32 type, passed to the VMHooks as int32
+	ValueTypeI32 ValueType = 0x7f
+	// ValueTypeI64 is the i64 type, passed to the VMHooks as int64
+	ValueTypeI64 ValueType = 0x7e
+)
+
+// FunctionSignature holds the types of the parameters and of the results of an EEI function
+type FunctionSignature struct {
+	Params  []ValueType
+	Results []ValueType
+}
+
+// GetFunctionSignatures returns the signatures of all the EEI functions, by the name under which contracts import them
+func GetFunctionSignatures() map[string]FunctionSignature {
+	result := make(map[string]FunctionSignature, len(functionSignatures))
+	for name, signature := range functionSignatures {
+		result[name] = signature
+	}
+	return result
+}
@@ -0,0 +1,98 @@
+package eei
+
+// APILevel identifies a version of the EEI offered to smart contracts.
+// Contracts are validated at deployment against a single API level, either declared
+// in their code or assigned by the VM, so contracts on different levels can coexist.
+type APILevel uint32
+
+const (
+	// NoAPILevel signals that a contract does not declare an API level
+	NoAPILevel APILevel = 0
+
+	// APILevelInitial contains the EEI functions available since the first release of the VM
+	APILevelInitial APILevel = 1
+
+	// APILevelNewAPIMethods adds the managed buffer, ESDT role and return data functions
+	APILevelNewAPIMethods APILevel = 2
+
+	// APILevelExtendedEEI adds the extended crypto, pairing and promises functions
+	APILevelExtendedEEI APILevel = 3
+
+	// LatestAPILevel is the highest API level known to the VM; the highest level enabled on a network depends on the epoch
+	LatestAPILevel = APILevelExtendedEEI
+)
+
+// FunctionVersion holds the API level in which an EEI function was introduced
+// and, optionally, the API level in which it was deprecated
+type FunctionVersion struct {
+	Introduced APILevel
+	Deprecated APILevel
+}
+
+// IsAvailableAt returns true if a contract of the given API level may import the function
+func (version FunctionVersion) IsAvailableAt(level APILevel) bool {
+	if level < version.Introduced {
+		return false
+	}
+	return version.Deprecated == NoAPILevel || level < version.Deprecated
+}
+
+// functionVersions holds the EEI functions which are not available at every API level.
+// Functions missing from the registry belong to APILevelInitial and are never deprecated.
+var functionVersions = map[string]FunctionVersion{
+	"mBufferSetByteSlice":           {Introduced: APILevelNewAPIMethods},
+	"getESDTLocalRoles":             {Introduced: APILevelNewAPIMethods},
+	"validateTokenIdentifier":       {Introduced: APILevelNewAPIMethods},
+	"managedSha256":                 {Introduced: APILevelNewAPIMethods},
+	"managedKeccak256":              {Introduced: APILevelNewAPIMethods},
+	"mBufferStorageLoadFromAddress": {Introduced: APILevelNewAPIMethods},
+	"cleanReturnData":               {Introduced: APILevelNewAPIMethods},
+	"deleteFromReturnData":          {Introduced: APILevelNewAPIMethods},
+	"completedTxEvent":              {Introduced: APILevelNewAPIMethods},
+	"sha512":                        {Introduced: APILevelExtendedEEI},
+	"managedSha512":                 {Introduced: APILevelExtendedEEI},
+	"sha3256":                       {Introduced: APILevelExtendedEEI},
+	"managedSha3256":                {Introduced: APILevelExtendedEEI},
+	"blake2b256":                    {Introduced: APILevelExtendedEEI},
+	"managedBlake2b256":             {Introduced: APILevelExtendedEEI},
+	"blake2b512":                    {Introduced: APILevelExtendedEEI},
+	"managedBlake2b512":             {Introduced: APILevelExtendedEEI},
+	"poseidon":                      {Introduced: APILevelExtendedEEI},
+	"managedPoseidon":               {Introduced: APILevelExtendedEEI},
+	"verifySecp256r1":               {Introduced: APILevelExtendedEEI},
+	"managedVerifySecp256r1":        {Introduced: APILevelExtendedEEI},
+	"verifySchnorr":                 {Introduced: APILevelExtendedEEI},
+	"managedVerifySchnorr":          {Introduced: APILevelExtendedEEI},
+	"managedAddG1":                  {Introduced: APILevelExtendedEEI},
+	"managedScalarMultG1":           {Introduced: APILevelExtendedEEI},
+	"managedAddG2":                  {Introduced: APILevelExtendedEEI},
+	"managedScalarMultG2":           {Introduced: APILevelExtendedEEI},
+	"managedPairingCheck":           {Introduced: APILevelExtendedEEI},
+	"managedVerifyGroth16":          {Introduced: APILevelExtendedEEI},
+	"createAsyncCall":               {Introduced: APILevelExtendedEEI},
+	"managedCreateAsyncCall":        {Introduced: APILevelExtendedEEI},
+	"managedGetCallbackClosure":     {Introduced: APILevelExtendedEEI},
+}
+
+// GetFunctionVersion returns the version of the EEI function with the given name
+func GetFunctionVersion(functionName string) FunctionVersion {
+	version, ok := functionVersions[functionName]
+	if !ok {
+		return FunctionVersion{Introduced: APILevelInitial}
+	}
+	return version
+}
+
+// GetVersionedFunctions returns the EEI functions which are not available at every API level
+func GetVersionedFunctions() map[string]FunctionVersion {
+	result := make(map[string]FunctionVersion, len(functionVersions))
+	for name, version := range functionVersions {
+		result[name] = version
+	}
+	return result
+}
+
+// IsValidAPILevel returns true if the VM knows the given API level
+func IsValidAPILevel(level APILevel) bool {
+	return level >= APILevelInitial && level <= LatestAPILevel
+}
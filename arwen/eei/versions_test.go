@@ -0,0 +1,32 @@
+package eei
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFunctionVersion_IsAvailableAt(t *testing.T) {
+	version := FunctionVersion{Introduced: APILevelInitial}
+	require.False(t, version.IsAvailableAt(NoAPILevel))
+	require.True(t, version.IsAvailableAt(APILevelInitial))
+	require.True(t, version.IsAvailableAt(LatestAPILevel))
+
+	version = FunctionVersion{Introduced: APILevelInitial, Deprecated: APILevelNewAPIMethods}
+	require.True(t, version.IsAvailableAt(APILevelInitial))
+	require.False(t, version.IsAvailableAt(APILevelNewAPIMethods))
+}
+
+func TestGetFunctionVersion(t *testing.T) {
+	require.Equal(t, FunctionVersion{Introduced: APILevelInitial}, GetFunctionVersion("getCaller"))
+	require.Equal(t, FunctionVersion{Introduced: APILevelNewAPIMethods}, GetFunctionVersion("managedSha256"))
+	require.Equal(t, FunctionVersion{Introduced: APILevelExtendedEEI}, GetFunctionVersion("managedVerifyGroth16"))
+	require.Equal(t, FunctionVersion{Introduced: APILevelExtendedEEI}, GetFunctionVersion("managedCreateAsyncCall"))
+
+	versions := GetVersionedFunctions()
+	for _, version := range versions {
+		require.True(t, IsValidAPILevel(version.Introduced))
+	}
+	delete(versions, "managedSha256")
+	require.Equal(t, FunctionVersion{Introduced: APILevelNewAPIMethods}, GetFunctionVersion("managedSha256"))
+}
@@ -0,0 +1,69 @@
+package elrondapi
+
+import (
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto"
+)
+
+// ElrondAPI implements the Elrond API, big int, small int, managed and managed buffer functions of the VMHooks, on top of a VM host,
+// without depending on the executor calling them
+type ElrondAPI struct {
+	host arwen.VMHost
+}
+
+// NewElrondAPI creates the Elrond API, big int, small int, managed and managed buffer functions of the VMHooks, for the given host
+func NewElrondAPI(host arwen.VMHost) *ElrondAPI {
+	return &ElrondAPI{
+		host: host,
+	}
+}
+
+// GetVMHost returns the host the functions run on
+func (context *ElrondAPI) GetVMHost() arwen.VMHost {
+	return context.host
+}
+
+// GetBlockchainContext returns the blockchain context
+func (context *ElrondAPI) GetBlockchainContext() arwen.BlockchainContext {
+	return context.host.Blockchain()
+}
+
+// GetRuntimeContext returns the runtime context
+func (context *ElrondAPI) GetRuntimeContext() arwen.RuntimeContext {
+	return context.host.Runtime()
+}
+
+// GetCryptoContext returns the crypto context
+func (context *ElrondAPI) GetCryptoContext() crypto.VMCrypto {
+	return context.host.Crypto()
+}
+
+// GetManagedTypesContext returns the managed types context
+func (context *ElrondAPI) GetManagedTypesContext() arwen.ManagedTypesContext {
+	return context.host.ManagedTypes()
+}
+
+// GetOutputContext returns the output context
+func (context *ElrondAPI) GetOutputContext() arwen.OutputContext {
+	return context.host.Output()
+}
+
+// GetMeteringContext returns the metering context
+func (context *ElrondAPI) GetMeteringContext() arwen.MeteringContext {
+	return context.host.Metering()
+}
+
+// GetStorageContext returns the storage context
+func (context *ElrondAPI) GetStorageContext() arwen.StorageContext {
+	return context.host.Storage()
+}
+
+// WithFault returns true if the error is not nil, and uses the remaining gas if the execution has failed
+func (context *ElrondAPI) WithFault(err error, failExecution bool) bool {
+	return arwen.WithFaultAndHost(context.host, err, failExecution)
+}
+
+// WithFaultIfFailAlwaysActive fails the execution with the provided error, if the fix of the failed executions is enabled
+func (context *ElrondAPI) WithFaultIfFailAlwaysActive(err error, failExecution bool) {
+	arwen.WithFaultAndHostIfFailAlwaysActive(err, context.host, failExecution)
+}
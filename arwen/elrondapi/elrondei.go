@@ -1,105 +1,14 @@
 package elrondapi
 
-// // Declare the function signatures (see [cgo](https://golang.org/cmd/cgo/)).
-//
-// #include <stdlib.h>
-// typedef unsigned char uint8_t;
-// typedef int int32_t;
-//
-// extern void		v1_4_getSCAddress(void *context, int32_t resultOffset);
-// extern void		v1_4_getOwnerAddress(void *context, int32_t resultOffset);
-// extern int32_t	v1_4_getShardOfAddress(void *context, int32_t addressOffset);
-// extern int32_t	v1_4_isSmartContract(void *context, int32_t addressOffset);
-// extern void		v1_4_getExternalBalance(void *context, int32_t addressOffset, int32_t resultOffset);
-// extern int32_t	v1_4_blockHash(void *context, long long nonce, int32_t resultOffset);
-// extern int32_t	v1_4_transferValue(void *context, int32_t dstOffset, int32_t valueOffset, int32_t dataOffset, int32_t length);
-// extern int32_t	v1_4_transferESDTExecute(void *context, int32_t dstOffset, int32_t tokenIDOffset, int32_t tokenIdLen, int32_t valueOffset, long long gasLimit, int32_t functionOffset, int32_t functionLength, int32_t numArguments, int32_t argumentsLengthOffset, int32_t dataOffset);
-// extern int32_t	v1_4_transferESDTNFTExecute(void *context, int32_t dstOffset, int32_t tokenIDOffset, int32_t tokenIdLen, int32_t valueOffset, long long nonce, long long gasLimit, int32_t functionOffset, int32_t functionLength, int32_t numArguments, int32_t argumentsLengthOffset, int32_t dataOffset);
-// extern int32_t	v1_4_multiTransferESDTNFTExecute(void *context, int32_t dstOffset, int32_t numTokenTransfers, int32_t tokenTransfersArgsLengthOffset, int32_t tokenTransferDataOffset, long long gasLimit, int32_t functionOffset, int32_t functionLength, int32_t numArguments, int32_t argumentsLengthOffset, int32_t dataOffset);
-// extern int32_t	v1_4_transferValueExecute(void *context, int32_t dstOffset, int32_t valueOffset, long long gasLimit, int32_t functionOffset, int32_t functionLength, int32_t numArguments, int32_t argumentsLengthOffset, int32_t dataOffset);
-// extern int32_t	v1_4_getArgumentLength(void *context, int32_t id);
-// extern int32_t	v1_4_getArgument(void *context, int32_t id, int32_t argOffset);
-// extern int32_t	v1_4_getFunction(void *context, int32_t functionOffset);
-// extern int32_t	v1_4_getNumArguments(void *context);
-// extern int32_t	v1_4_storageStore(void *context, int32_t keyOffset, int32_t keyLength , int32_t dataOffset, int32_t dataLength);
-// extern int32_t	v1_4_storageLoadLength(void *context, int32_t keyOffset, int32_t keyLength );
-// extern int32_t	v1_4_storageLoad(void *context, int32_t keyOffset, int32_t keyLength , int32_t dataOffset);
-// extern int32_t	v1_4_storageLoadFromAddress(void *context, int32_t addressOffset, int32_t keyOffset, int32_t keyLength , int32_t dataOffset);
-// extern void		v1_4_getCaller(void *context, int32_t resultOffset);
-// extern void		v1_4_checkNoPayment(void *context);
-// extern int32_t	v1_4_callValue(void *context, int32_t resultOffset);
-// extern int32_t	v1_4_getESDTValue(void *context, int32_t resultOffset);
-// extern int32_t	v1_4_getESDTTokenName(void *context, int32_t resultOffset);
-// extern long long	v1_4_getESDTTokenNonce(void *context);
-// extern int32_t	v1_4_getESDTTokenType(void *context);
-// extern int32_t	v1_4_getCallValueTokenName(void *context, int32_t callValueOffset, int32_t tokenNameOffset);
-// extern int32_t	v1_4_getESDTValueByIndex(void *context, int32_t resultOffset, int32_t index);
-// extern int32_t	v1_4_getESDTTokenNameByIndex(void *context, int32_t resultOffset, int32_t index);
-// extern long long	v1_4_getESDTTokenNonceByIndex(void *context, int32_t index);
-// extern int32_t	v1_4_getESDTTokenTypeByIndex(void *context, int32_t index);
-// extern int32_t	v1_4_getCallValueTokenNameByIndex(void *context, int32_t callValueOffset, int32_t tokenNameOffset, int32_t index);
-// extern int32_t	v1_4_getNumESDTTransfers(void *context);
-// extern long long v1_4_getCurrentESDTNFTNonce(void *context, int32_t addressOffset, int32_t tokenIDOffset, int32_t tokenIDLen);
-// extern void		v1_4_writeLog(void *context, int32_t pointer, int32_t length, int32_t topicPtr, int32_t numTopics);
-// extern void		v1_4_writeEventLog(void *context, int32_t numTopics, int32_t topicLengthsOffset, int32_t topicOffset, int32_t dataOffset, int32_t dataLength);
-// extern void		v1_4_returnData(void* context, int32_t dataOffset, int32_t length);
-// extern void		v1_4_signalError(void* context, int32_t messageOffset, int32_t messageLength);
-// extern long long v1_4_getGasLeft(void *context);
-// extern int32_t	v1_4_getESDTBalance(void *context, int32_t addressOffset, int32_t tokenIDOffset, int32_t tokenIDLen, long long nonce, int32_t resultOffset);
-// extern int32_t	v1_4_getESDTNFTNameLength(void *context, int32_t addressOffset, int32_t tokenIDOffset, int32_t tokenIDLen, long long nonce);
-// extern int32_t	v1_4_getESDTNFTAttributeLength(void *context, int32_t addressOffset, int32_t tokenIDOffset, int32_t tokenIDLen, long long nonce);
-// extern int32_t	v1_4_getESDTNFTURILength(void *context, int32_t addressOffset, int32_t tokenIDOffset, int32_t tokenIDLen, long long nonce);
-// extern int32_t	v1_4_getESDTTokenData(void *context, int32_t addressOffset, int32_t tokenIDOffset, int32_t tokenIDLen, long long nonce, int32_t valueOffset, int32_t propertiesOffset, int32_t hashOffset, int32_t nameOffset, int32_t attributesOffset, int32_t creatorOffset, int32_t royaltiesOffset, int32_t urisOffset);
-// extern long long	v1_4_getESDTLocalRoles(void *context, int32_t tokenIdHandle);
-// extern int32_t	v1_4_validateTokenIdentifier(void *context, int32_t tokenIdHandle);
-//
-// extern int32_t	v1_4_executeOnDestContext(void *context, long long gas, int32_t addressOffset, int32_t valueOffset, int32_t functionOffset, int32_t functionLength, int32_t numArguments, int32_t argumentsLengthOffset, int32_t dataOffset);
-// extern int32_t	v1_4_executeOnDestContextByCaller(void *context, long long gas, int32_t addressOffset, int32_t valueOffset, int32_t functionOffset, int32_t functionLength, int32_t numArguments, int32_t argumentsLengthOffset, int32_t dataOffset);
-// extern int32_t	v1_4_executeOnSameContext(void *context, long long gas, int32_t addressOffset, int32_t valueOffset, int32_t functionOffset, int32_t functionLength, int32_t numArguments, int32_t argumentsLengthOffset, int32_t dataOffset);
-// extern int32_t	v1_4_executeReadOnly(void *context, long long gas, int32_t addressOffset, int32_t functionOffset, int32_t functionLength, int32_t numArguments, int32_t argumentsLengthOffset, int32_t dataOffset);
-// extern int32_t	v1_4_createContract(void *context, long long gas, int32_t valueOffset, int32_t codeOffset, int32_t codeMetadataOffset, int32_t length, int32_t resultOffset, int32_t numArguments, int32_t argumentsLengthOffset, int32_t dataOffset);
-// extern int32_t	v1_4_deployFromSourceContract(void *context, long long gas, int32_t valueOffset, int32_t addressOffset, int32_t codeMetadataOffset, int32_t resultOffset, int32_t numArguments, int32_t argumentsLengthOffset, int32_t dataOffset);
-// extern void		v1_4_upgradeContract(void *context, int32_t dstOffset, long long gas, int32_t valueOffset, int32_t codeOffset, int32_t codeMetadataOffset, int32_t length, int32_t numArguments, int32_t argumentsLengthOffset, int32_t dataOffset);
-// extern void		v1_4_upgradeFromSourceContract(void *context, int32_t dstOffset, long long gas, int32_t valueOffset, int32_t addressOffset, int32_t codeMetadataOffset, int32_t numArguments, int32_t argumentsLengthOffset, int32_t dataOffset);
-// extern void		v1_4_asyncCall(void *context, int32_t dstOffset, int32_t valueOffset, int32_t dataOffset, int32_t length);
-//
-// extern int32_t	v1_4_getNumReturnData(void *context);
-// extern int32_t	v1_4_getReturnDataSize(void *context, int32_t resultID);
-// extern int32_t	v1_4_getReturnData(void *context, int32_t resultID, int32_t dataOffset);
-// extern void		v1_4_cleanReturnData(void *context);
-// extern void		v1_4_deleteFromReturnData(void *context, int32_t resultID);
-//
-// extern int32_t	v1_4_setStorageLock(void *context, int32_t keyOffset, int32_t keyLength, long long lockTimestamp);
-// extern long long v1_4_getStorageLock(void *context, int32_t keyOffset, int32_t keyLength);
-// extern int32_t	v1_4_isStorageLocked(void *context, int32_t keyOffset, int32_t keyLength);
-// extern int32_t	v1_4_clearStorageLock(void *context, int32_t keyOffset, int32_t keyLength);
-//
-// extern long long v1_4_getBlockTimestamp(void *context);
-// extern long long v1_4_getBlockNonce(void *context);
-// extern long long v1_4_getBlockRound(void *context);
-// extern long long v1_4_getBlockEpoch(void *context);
-// extern void		v1_4_getBlockRandomSeed(void *context, int32_t resultOffset);
-// extern void		v1_4_getStateRootHash(void *context, int32_t resultOffset);
-//
-// extern long long v1_4_getPrevBlockTimestamp(void *context);
-// extern long long v1_4_getPrevBlockNonce(void *context);
-// extern long long v1_4_getPrevBlockRound(void *context);
-// extern long long v1_4_getPrevBlockEpoch(void *context);
-// extern void		v1_4_getPrevBlockRandomSeed(void *context, int32_t resultOffset);
-// extern void		v1_4_getOriginalTxHash(void *context, int32_t resultOffset);
-import "C"
-
 import (
 	"encoding/binary"
 	"encoding/hex"
 	"errors"
 	"fmt"
 	"math/big"
-	"unsafe"
 
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/math"
-	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/wasmer"
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
 	"github.com/ElrondNetwork/elrond-go-core/data/vm"
@@ -164,6 +73,7 @@ const (
 	upgradeContractName              = "upgradeContract"
 	upgradeFromSourceContractName    = "upgradeFromSourceContract"
 	asyncCallName                    = "asyncCall"
+	createAsyncCallName              = "createAsyncCall"
 	getNumReturnDataName             = "getNumReturnData"
 	getReturnDataSizeName            = "getReturnDataSize"
 	getReturnDataName                = "getReturnData"
@@ -198,405 +108,17 @@ func getESDTTransferFromInputFailIfWrongIndex(host arwen.VMHost, index int32) *v
 	return esdtTransfers[index]
 }
 
-func failIfMoreThanOneESDTTransfer(context unsafe.Pointer) bool {
-	runtime := arwen.GetRuntimeContext(context)
+func failIfMoreThanOneESDTTransfer(context *ElrondAPI) bool {
+	runtime := context.GetRuntimeContext()
 	if len(runtime.GetVMInput().ESDTTransfers) > 1 {
-		return arwen.WithFault(arwen.ErrTooManyESDTTransfers, context, true)
+		return context.WithFault(arwen.ErrTooManyESDTTransfers, true)
 	}
 	return false
 }
 
-// ElrondEIImports creates a new wasmer.Imports populated with the ElrondEI API methods
-func ElrondEIImports() (*wasmer.Imports, error) {
-	imports := wasmer.NewImports()
-	imports = imports.Namespace("env")
-
-	imports, err := imports.Append("getSCAddress", v1_4_getSCAddress, C.v1_4_getSCAddress)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("getOwnerAddress", v1_4_getOwnerAddress, C.v1_4_getOwnerAddress)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("getShardOfAddress", v1_4_getShardOfAddress, C.v1_4_getShardOfAddress)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("isSmartContract", v1_4_isSmartContract, C.v1_4_isSmartContract)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("getExternalBalance", v1_4_getExternalBalance, C.v1_4_getExternalBalance)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("getBlockHash", v1_4_blockHash, C.v1_4_blockHash)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("transferValue", v1_4_transferValue, C.v1_4_transferValue)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("transferESDTExecute", v1_4_transferESDTExecute, C.v1_4_transferESDTExecute)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("transferESDTNFTExecute", v1_4_transferESDTNFTExecute, C.v1_4_transferESDTNFTExecute)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("multiTransferESDTNFTExecute", v1_4_multiTransferESDTNFTExecute, C.v1_4_multiTransferESDTNFTExecute)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("transferValueExecute", v1_4_transferValueExecute, C.v1_4_transferValueExecute)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("asyncCall", v1_4_asyncCall, C.v1_4_asyncCall)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("getArgumentLength", v1_4_getArgumentLength, C.v1_4_getArgumentLength)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("getArgument", v1_4_getArgument, C.v1_4_getArgument)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("getFunction", v1_4_getFunction, C.v1_4_getFunction)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("getNumArguments", v1_4_getNumArguments, C.v1_4_getNumArguments)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("storageStore", v1_4_storageStore, C.v1_4_storageStore)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("storageLoadLength", v1_4_storageLoadLength, C.v1_4_storageLoadLength)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("storageLoad", v1_4_storageLoad, C.v1_4_storageLoad)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("storageLoadFromAddress", v1_4_storageLoadFromAddress, C.v1_4_storageLoadFromAddress)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("getStorageLock", v1_4_getStorageLock, C.v1_4_getStorageLock)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("setStorageLock", v1_4_setStorageLock, C.v1_4_setStorageLock)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("isStorageLocked", v1_4_isStorageLocked, C.v1_4_isStorageLocked)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("clearStorageLock", v1_4_clearStorageLock, C.v1_4_clearStorageLock)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("getCaller", v1_4_getCaller, C.v1_4_getCaller)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("checkNoPayment", v1_4_checkNoPayment, C.v1_4_checkNoPayment)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("getCallValue", v1_4_callValue, C.v1_4_callValue)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("getESDTValue", v1_4_getESDTValue, C.v1_4_getESDTValue)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("getESDTTokenName", v1_4_getESDTTokenName, C.v1_4_getESDTTokenName)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("getESDTTokenType", v1_4_getESDTTokenType, C.v1_4_getESDTTokenType)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("getESDTTokenNonce", v1_4_getESDTTokenNonce, C.v1_4_getESDTTokenNonce)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("getCallValueTokenName", v1_4_getCallValueTokenName, C.v1_4_getCallValueTokenName)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("getESDTValueByIndex", v1_4_getESDTValueByIndex, C.v1_4_getESDTValueByIndex)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("getESDTTokenNameByIndex", v1_4_getESDTTokenNameByIndex, C.v1_4_getESDTTokenNameByIndex)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("getESDTTokenTypeByIndex", v1_4_getESDTTokenTypeByIndex, C.v1_4_getESDTTokenTypeByIndex)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("getESDTTokenNonceByIndex", v1_4_getESDTTokenNonceByIndex, C.v1_4_getESDTTokenNonceByIndex)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("getCallValueTokenNameByIndex", v1_4_getCallValueTokenNameByIndex, C.v1_4_getCallValueTokenNameByIndex)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("getNumESDTTransfers", v1_4_getNumESDTTransfers, C.v1_4_getNumESDTTransfers)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("getCurrentESDTNFTNonce", v1_4_getCurrentESDTNFTNonce, C.v1_4_getCurrentESDTNFTNonce)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("validateTokenIdentifier", v1_4_validateTokenIdentifier, C.v1_4_validateTokenIdentifier)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("writeLog", v1_4_writeLog, C.v1_4_writeLog)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("writeEventLog", v1_4_writeEventLog, C.v1_4_writeEventLog)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("finish", v1_4_returnData, C.v1_4_returnData)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("signalError", v1_4_signalError, C.v1_4_signalError)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("getBlockTimestamp", v1_4_getBlockTimestamp, C.v1_4_getBlockTimestamp)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("getBlockNonce", v1_4_getBlockNonce, C.v1_4_getBlockNonce)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("getBlockRound", v1_4_getBlockRound, C.v1_4_getBlockRound)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("getBlockEpoch", v1_4_getBlockEpoch, C.v1_4_getBlockEpoch)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("getBlockRandomSeed", v1_4_getBlockRandomSeed, C.v1_4_getBlockRandomSeed)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("getStateRootHash", v1_4_getStateRootHash, C.v1_4_getStateRootHash)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("getPrevBlockTimestamp", v1_4_getPrevBlockTimestamp, C.v1_4_getPrevBlockTimestamp)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("getPrevBlockNonce", v1_4_getPrevBlockNonce, C.v1_4_getPrevBlockNonce)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("getPrevBlockRound", v1_4_getPrevBlockRound, C.v1_4_getPrevBlockRound)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("getPrevBlockEpoch", v1_4_getPrevBlockEpoch, C.v1_4_getPrevBlockEpoch)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("getPrevBlockRandomSeed", v1_4_getPrevBlockRandomSeed, C.v1_4_getPrevBlockRandomSeed)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("getOriginalTxHash", v1_4_getOriginalTxHash, C.v1_4_getOriginalTxHash)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("getGasLeft", v1_4_getGasLeft, C.v1_4_getGasLeft)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("executeOnDestContext", v1_4_executeOnDestContext, C.v1_4_executeOnDestContext)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("executeOnDestContextByCaller", v1_4_executeOnDestContextByCaller, C.v1_4_executeOnDestContextByCaller)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("executeOnSameContext", v1_4_executeOnSameContext, C.v1_4_executeOnSameContext)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("createContract", v1_4_createContract, C.v1_4_createContract)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("deployFromSourceContract", v1_4_deployFromSourceContract, C.v1_4_deployFromSourceContract)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("upgradeContract", v1_4_upgradeContract, C.v1_4_upgradeContract)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("upgradeFromSourceContract", v1_4_upgradeFromSourceContract, C.v1_4_upgradeFromSourceContract)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("executeReadOnly", v1_4_executeReadOnly, C.v1_4_executeReadOnly)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("getNumReturnData", v1_4_getNumReturnData, C.v1_4_getNumReturnData)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("getReturnDataSize", v1_4_getReturnDataSize, C.v1_4_getReturnDataSize)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("getReturnData", v1_4_getReturnData, C.v1_4_getReturnData)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("cleanReturnData", v1_4_cleanReturnData, C.v1_4_cleanReturnData)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("deleteFromReturnData", v1_4_deleteFromReturnData, C.v1_4_deleteFromReturnData)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("getESDTBalance", v1_4_getESDTBalance, C.v1_4_getESDTBalance)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("getESDTTokenData", v1_4_getESDTTokenData, C.v1_4_getESDTTokenData)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("getESDTLocalRoles", v1_4_getESDTLocalRoles, C.v1_4_getESDTLocalRoles)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("getESDTNFTNameLength", v1_4_getESDTNFTNameLength, C.v1_4_getESDTNFTNameLength)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("getESDTNFTAttributeLength", v1_4_getESDTNFTAttributeLength, C.v1_4_getESDTNFTAttributeLength)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("getESDTNFTURILength", v1_4_getESDTNFTURILength, C.v1_4_getESDTNFTURILength)
-	if err != nil {
-		return nil, err
-	}
-
-	return imports, nil
-}
-
-//export v1_4_getGasLeft
-func v1_4_getGasLeft(context unsafe.Pointer) int64 {
-	metering := arwen.GetMeteringContext(context)
+// GetGasLeft VMHooks implementation.
+func (context *ElrondAPI) GetGasLeft() int64 {
+	metering := context.GetMeteringContext()
 
 	gasToUse := metering.GasSchedule().ElrondAPICost.GetGasLeft
 	metering.UseGasAndAddTracedGas(getGasLeftName, gasToUse)
@@ -604,69 +126,69 @@ func v1_4_getGasLeft(context unsafe.Pointer) int64 {
 	return int64(metering.GasLeft())
 }
 
-//export v1_4_getSCAddress
-func v1_4_getSCAddress(context unsafe.Pointer, resultOffset int32) {
-	runtime := arwen.GetRuntimeContext(context)
-	metering := arwen.GetMeteringContext(context)
+// GetSCAddress VMHooks implementation.
+func (context *ElrondAPI) GetSCAddress(resultOffset int32) {
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
 
 	gasToUse := metering.GasSchedule().ElrondAPICost.GetSCAddress
 	metering.UseGasAndAddTracedGas(getSCAddressName, gasToUse)
 
 	owner := runtime.GetSCAddress()
 	err := runtime.MemStore(resultOffset, owner)
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return
 	}
 }
 
-//export v1_4_getOwnerAddress
-func v1_4_getOwnerAddress(context unsafe.Pointer, resultOffset int32) {
-	blockchain := arwen.GetBlockchainContext(context)
-	runtime := arwen.GetRuntimeContext(context)
-	metering := arwen.GetMeteringContext(context)
+// GetOwnerAddress VMHooks implementation.
+func (context *ElrondAPI) GetOwnerAddress(resultOffset int32) {
+	blockchain := context.GetBlockchainContext()
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
 
 	gasToUse := metering.GasSchedule().ElrondAPICost.GetOwnerAddress
 	metering.UseGasAndAddTracedGas(getOwnerAddressName, gasToUse)
 
 	owner, err := blockchain.GetOwnerAddress()
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return
 	}
 
 	err = runtime.MemStore(resultOffset, owner)
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return
 	}
 }
 
-//export v1_4_getShardOfAddress
-func v1_4_getShardOfAddress(context unsafe.Pointer, addressOffset int32) int32 {
-	blockchain := arwen.GetBlockchainContext(context)
-	runtime := arwen.GetRuntimeContext(context)
-	metering := arwen.GetMeteringContext(context)
+// GetShardOfAddress VMHooks implementation.
+func (context *ElrondAPI) GetShardOfAddress(addressOffset int32) int32 {
+	blockchain := context.GetBlockchainContext()
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
 
 	gasToUse := metering.GasSchedule().ElrondAPICost.GetShardOfAddress
 	metering.UseGasAndAddTracedGas(getShardOfAddressName, gasToUse)
 
 	address, err := runtime.MemLoad(addressOffset, arwen.AddressLen)
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return -1
 	}
 
 	return int32(blockchain.GetShardOfAddress(address))
 }
 
-//export v1_4_isSmartContract
-func v1_4_isSmartContract(context unsafe.Pointer, addressOffset int32) int32 {
-	blockchain := arwen.GetBlockchainContext(context)
-	runtime := arwen.GetRuntimeContext(context)
-	metering := arwen.GetMeteringContext(context)
+// IsSmartContract VMHooks implementation.
+func (context *ElrondAPI) IsSmartContract(addressOffset int32) int32 {
+	blockchain := context.GetBlockchainContext()
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
 
 	gasToUse := metering.GasSchedule().ElrondAPICost.IsSmartContract
 	metering.UseGasAndAddTracedGas(isSmartContractName, gasToUse)
 
 	address, err := runtime.MemLoad(addressOffset, arwen.AddressLen)
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return -1
 	}
 
@@ -675,10 +197,10 @@ func v1_4_isSmartContract(context unsafe.Pointer, addressOffset int32) int32 {
 	return int32(arwen.BooleanToInt(isSmartContract))
 }
 
-//export v1_4_signalError
-func v1_4_signalError(context unsafe.Pointer, messageOffset int32, messageLength int32) {
-	runtime := arwen.GetRuntimeContext(context)
-	metering := arwen.GetMeteringContext(context)
+// SignalError VMHooks implementation.
+func (context *ElrondAPI) SignalError(messageOffset int32, messageLength int32) {
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
 	metering.StartGasTracing(signalErrorName)
 
 	gasToUse := metering.GasSchedule().ElrondAPICost.SignalError
@@ -687,51 +209,51 @@ func v1_4_signalError(context unsafe.Pointer, messageOffset int32, messageLength
 	err := metering.UseGasBounded(gasToUse)
 
 	if err != nil {
-		_ = arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution())
+		_ = context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution())
 		return
 	}
 
 	message, err := runtime.MemLoad(messageOffset, messageLength)
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return
 	}
 	runtime.SignalUserError(string(message))
 }
 
-//export v1_4_getExternalBalance
-func v1_4_getExternalBalance(context unsafe.Pointer, addressOffset int32, resultOffset int32) {
-	blockchain := arwen.GetBlockchainContext(context)
-	runtime := arwen.GetRuntimeContext(context)
-	metering := arwen.GetMeteringContext(context)
+// GetExternalBalance VMHooks implementation.
+func (context *ElrondAPI) GetExternalBalance(addressOffset int32, resultOffset int32) {
+	blockchain := context.GetBlockchainContext()
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
 
 	gasToUse := metering.GasSchedule().ElrondAPICost.GetExternalBalance
 	metering.UseGasAndAddTracedGas(getExternalBalanceName, gasToUse)
 
 	address, err := runtime.MemLoad(addressOffset, arwen.AddressLen)
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return
 	}
 
 	balance := blockchain.GetBalance(address)
 
 	err = runtime.MemStore(resultOffset, balance)
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return
 	}
 }
 
-//export v1_4_blockHash
-func v1_4_blockHash(context unsafe.Pointer, nonce int64, resultOffset int32) int32 {
-	blockchain := arwen.GetBlockchainContext(context)
-	runtime := arwen.GetRuntimeContext(context)
-	metering := arwen.GetMeteringContext(context)
+// GetBlockHash VMHooks implementation.
+func (context *ElrondAPI) GetBlockHash(nonce int64, resultOffset int32) int32 {
+	blockchain := context.GetBlockchainContext()
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
 
 	gasToUse := metering.GasSchedule().ElrondAPICost.GetBlockHash
 	metering.UseGasAndAddTracedGas(blockHashName, gasToUse)
 
 	hash := blockchain.BlockHash(nonce)
 	err := runtime.MemStore(resultOffset, hash)
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return 1
 	}
 
@@ -745,15 +267,15 @@ func isBuiltInCall(data string, host arwen.VMHost) bool {
 }
 
 func getESDTDataFromBlockchainHook(
-	context unsafe.Pointer,
+	context *ElrondAPI,
 	addressOffset int32,
 	tokenIDOffset int32,
 	tokenIDLen int32,
 	nonce int64,
 ) (*esdt.ESDigitalToken, error) {
-	runtime := arwen.GetRuntimeContext(context)
-	metering := arwen.GetMeteringContext(context)
-	blockchain := arwen.GetBlockchainContext(context)
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
+	blockchain := context.GetBlockchainContext()
 
 	gasToUse := metering.GasSchedule().ElrondAPICost.GetExternalBalance
 	metering.UseAndTraceGas(gasToUse)
@@ -776,101 +298,97 @@ func getESDTDataFromBlockchainHook(
 	return esdtToken, nil
 }
 
-//export v1_4_getESDTBalance
-func v1_4_getESDTBalance(
-	context unsafe.Pointer,
+// GetESDTBalance VMHooks implementation.
+func (context *ElrondAPI) GetESDTBalance(
 	addressOffset int32,
 	tokenIDOffset int32,
 	tokenIDLen int32,
 	nonce int64,
 	resultOffset int32,
 ) int32 {
-	runtime := arwen.GetRuntimeContext(context)
-	metering := arwen.GetMeteringContext(context)
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
 	metering.StartGasTracing(getESDTBalanceName)
 
 	esdtData, err := getESDTDataFromBlockchainHook(context, addressOffset, tokenIDOffset, tokenIDLen, nonce)
 
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return -1
 	}
 	err = runtime.MemStore(resultOffset, esdtData.Value.Bytes())
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return -1
 	}
 
 	return int32(len(esdtData.Value.Bytes()))
 }
 
-//export v1_4_getESDTNFTNameLength
-func v1_4_getESDTNFTNameLength(
-	context unsafe.Pointer,
+// GetESDTNFTNameLength VMHooks implementation.
+func (context *ElrondAPI) GetESDTNFTNameLength(
 	addressOffset int32,
 	tokenIDOffset int32,
 	tokenIDLen int32,
 	nonce int64,
 ) int32 {
-	runtime := arwen.GetRuntimeContext(context)
-	metering := arwen.GetMeteringContext(context)
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
 	metering.StartGasTracing(getESDTNFTNameLengthName)
 
 	esdtData, err := getESDTDataFromBlockchainHook(context, addressOffset, tokenIDOffset, tokenIDLen, nonce)
 
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return -1
 	}
 	if esdtData == nil || esdtData.TokenMetaData == nil {
-		arwen.WithFaultIfFailAlwaysActive(arwen.ErrNilESDTData, context, runtime.ElrondAPIErrorShouldFailExecution())
+		context.WithFaultIfFailAlwaysActive(arwen.ErrNilESDTData, runtime.ElrondAPIErrorShouldFailExecution())
 		return 0
 	}
 
 	return int32(len(esdtData.TokenMetaData.Name))
 }
 
-//export v1_4_getESDTNFTAttributeLength
-func v1_4_getESDTNFTAttributeLength(
-	context unsafe.Pointer,
+// GetESDTNFTAttributeLength VMHooks implementation.
+func (context *ElrondAPI) GetESDTNFTAttributeLength(
 	addressOffset int32,
 	tokenIDOffset int32,
 	tokenIDLen int32,
 	nonce int64,
 ) int32 {
-	runtime := arwen.GetRuntimeContext(context)
-	metering := arwen.GetMeteringContext(context)
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
 	metering.StartGasTracing(getESDTNFTAttributeLengthName)
 
 	esdtData, err := getESDTDataFromBlockchainHook(context, addressOffset, tokenIDOffset, tokenIDLen, nonce)
 
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return -1
 	}
 	if esdtData == nil || esdtData.TokenMetaData == nil {
-		arwen.WithFaultIfFailAlwaysActive(arwen.ErrNilESDTData, context, runtime.ElrondAPIErrorShouldFailExecution())
+		context.WithFaultIfFailAlwaysActive(arwen.ErrNilESDTData, runtime.ElrondAPIErrorShouldFailExecution())
 		return 0
 	}
 
 	return int32(len(esdtData.TokenMetaData.Attributes))
 }
 
-//export v1_4_getESDTNFTURILength
-func v1_4_getESDTNFTURILength(
-	context unsafe.Pointer,
+// GetESDTNFTURILength VMHooks implementation.
+func (context *ElrondAPI) GetESDTNFTURILength(
 	addressOffset int32,
 	tokenIDOffset int32,
 	tokenIDLen int32,
 	nonce int64,
 ) int32 {
-	runtime := arwen.GetRuntimeContext(context)
-	metering := arwen.GetMeteringContext(context)
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
 	metering.StartGasTracing(getESDTNFTURILengthName)
 
 	esdtData, err := getESDTDataFromBlockchainHook(context, addressOffset, tokenIDOffset, tokenIDLen, nonce)
 
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return -1
 	}
 	if esdtData == nil || esdtData.TokenMetaData == nil {
-		arwen.WithFaultIfFailAlwaysActive(arwen.ErrNilESDTData, context, runtime.ElrondAPIErrorShouldFailExecution())
+		context.WithFaultIfFailAlwaysActive(arwen.ErrNilESDTData, runtime.ElrondAPIErrorShouldFailExecution())
 		return 0
 	}
 	if len(esdtData.TokenMetaData.URIs) == 0 {
@@ -880,9 +398,8 @@ func v1_4_getESDTNFTURILength(
 	return int32(len(esdtData.TokenMetaData.URIs[0]))
 }
 
-//export v1_4_getESDTTokenData
-func v1_4_getESDTTokenData(
-	context unsafe.Pointer,
+// GetESDTTokenData VMHooks implementation.
+func (context *ElrondAPI) GetESDTTokenData(
 	addressOffset int32,
 	tokenIDOffset int32,
 	tokenIDLen int32,
@@ -896,14 +413,14 @@ func v1_4_getESDTTokenData(
 	royaltiesHandle int32,
 	urisOffset int32,
 ) int32 {
-	managedType := arwen.GetManagedTypesContext(context)
-	runtime := arwen.GetRuntimeContext(context)
-	metering := arwen.GetMeteringContext(context)
+	managedType := context.GetManagedTypesContext()
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
 	metering.StartGasTracing(getESDTTokenDataName)
 
 	esdtData, err := getESDTDataFromBlockchainHook(context, addressOffset, tokenIDOffset, tokenIDLen, nonce)
 
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return -1
 	}
 
@@ -911,25 +428,25 @@ func v1_4_getESDTTokenData(
 	value.Set(esdtData.Value)
 
 	err = runtime.MemStore(propertiesOffset, esdtData.Properties)
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return -1
 	}
 
 	if esdtData.TokenMetaData != nil {
 		err = runtime.MemStore(hashOffset, esdtData.TokenMetaData.Hash)
-		if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+		if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 			return -1
 		}
 		err = runtime.MemStore(nameOffset, esdtData.TokenMetaData.Name)
-		if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+		if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 			return -1
 		}
 		err = runtime.MemStore(attributesOffset, esdtData.TokenMetaData.Attributes)
-		if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+		if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 			return -1
 		}
 		err = runtime.MemStore(creatorOffset, esdtData.TokenMetaData.Creator)
-		if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+		if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 			return -1
 		}
 
@@ -938,7 +455,7 @@ func v1_4_getESDTTokenData(
 
 		if len(esdtData.TokenMetaData.URIs) > 0 {
 			err = runtime.MemStore(urisOffset, esdtData.TokenMetaData.URIs[0])
-			if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+			if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 				return -1
 			}
 		}
@@ -946,15 +463,15 @@ func v1_4_getESDTTokenData(
 	return int32(len(esdtData.Value.Bytes()))
 }
 
-//export v1_4_getESDTLocalRoles
-func v1_4_getESDTLocalRoles(context unsafe.Pointer, tokenIdHandle int32) int64 {
-	managedType := arwen.GetManagedTypesContext(context)
-	runtime := arwen.GetRuntimeContext(context)
-	storage := arwen.GetStorageContext(context)
-	metering := arwen.GetMeteringContext(context)
+// GetESDTLocalRoles VMHooks implementation.
+func (context *ElrondAPI) GetESDTLocalRoles(tokenIdHandle int32) int64 {
+	managedType := context.GetManagedTypesContext()
+	runtime := context.GetRuntimeContext()
+	storage := context.GetStorageContext()
+	metering := context.GetMeteringContext()
 
 	tokenID, err := managedType.GetBytes(tokenIdHandle)
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return -1
 	}
 
@@ -967,20 +484,19 @@ func v1_4_getESDTLocalRoles(context unsafe.Pointer, tokenIdHandle int32) int64 {
 	return getESDTRoles(data)
 }
 
-//export v1_4_validateTokenIdentifier
-func v1_4_validateTokenIdentifier(
-	context unsafe.Pointer,
+// ValidateTokenIdentifier VMHooks implementation.
+func (context *ElrondAPI) ValidateTokenIdentifier(
 	tokenIdHandle int32,
 ) int32 {
-	managedType := arwen.GetManagedTypesContext(context)
-	runtime := arwen.GetRuntimeContext(context)
-	metering := arwen.GetMeteringContext(context)
+	managedType := context.GetManagedTypesContext()
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
 
 	gasToUse := metering.GasSchedule().ElrondAPICost.GetArgument
 	metering.UseGasAndAddTracedGas(validateTokenIdentifierName, gasToUse)
 
 	tokenID, err := managedType.GetBytes(tokenIdHandle)
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return -1
 	}
 
@@ -992,9 +508,9 @@ func v1_4_validateTokenIdentifier(
 
 }
 
-//export v1_4_transferValue
-func v1_4_transferValue(context unsafe.Pointer, destOffset int32, valueOffset int32, dataOffset int32, length int32) int32 {
-	host := arwen.GetVMHost(context)
+// TransferValue VMHooks implementation.
+func (context *ElrondAPI) TransferValue(destOffset int32, valueOffset int32, dataOffset int32, length int32) int32 {
+	host := context.GetVMHost()
 	runtime := host.Runtime()
 	metering := host.Metering()
 	output := host.Output()
@@ -1005,12 +521,12 @@ func v1_4_transferValue(context unsafe.Pointer, destOffset int32, valueOffset in
 
 	sender := runtime.GetSCAddress()
 	dest, err := runtime.MemLoad(destOffset, arwen.AddressLen)
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return 1
 	}
 
 	valueBytes, err := runtime.MemLoad(valueOffset, arwen.BalanceLen)
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return 1
 	}
 
@@ -1018,17 +534,17 @@ func v1_4_transferValue(context unsafe.Pointer, destOffset int32, valueOffset in
 	metering.UseAndTraceGas(gasToUse)
 
 	data, err := runtime.MemLoad(dataOffset, length)
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return 1
 	}
 
 	if isBuiltInCall(string(data), host) {
-		arwen.WithFaultIfFailAlwaysActive(arwen.ErrTransferValueOnESDTCall, context, runtime.ElrondAPIErrorShouldFailExecution())
+		context.WithFaultIfFailAlwaysActive(arwen.ErrTransferValueOnESDTCall, runtime.ElrondAPIErrorShouldFailExecution())
 		return 1
 	}
 
 	err = output.Transfer(dest, sender, 0, 0, big.NewInt(0).SetBytes(valueBytes), data, vm.DirectCall)
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return 1
 	}
 
@@ -1144,9 +660,8 @@ func extractIndirectContractCallArguments(
 	}, nil
 }
 
-//export v1_4_transferValueExecute
-func v1_4_transferValueExecute(
-	context unsafe.Pointer,
+// TransferValueExecute VMHooks implementation.
+func (context *ElrondAPI) TransferValueExecute(
 	destOffset int32,
 	valueOffset int32,
 	gasLimit int64,
@@ -1156,7 +671,7 @@ func v1_4_transferValueExecute(
 	argumentsLengthOffset int32,
 	dataOffset int32,
 ) int32 {
-	host := arwen.GetVMHost(context)
+	host := context.GetVMHost()
 	return TransferValueExecuteWithHost(
 		host,
 		destOffset,
@@ -1285,9 +800,8 @@ func makeCrossShardCallFromInput(function string, arguments [][]byte) string {
 	return txData
 }
 
-//export v1_4_transferESDTExecute
-func v1_4_transferESDTExecute(
-	context unsafe.Pointer,
+// TransferESDTExecute VMHooks implementation.
+func (context *ElrondAPI) TransferESDTExecute(
 	destOffset int32,
 	tokenIDOffset int32,
 	tokenIDLen int32,
@@ -1300,13 +814,12 @@ func v1_4_transferESDTExecute(
 	dataOffset int32,
 ) int32 {
 
-	return v1_4_transferESDTNFTExecute(context, destOffset, tokenIDOffset, tokenIDLen, valueOffset, 0,
+	return context.TransferESDTNFTExecute(destOffset, tokenIDOffset, tokenIDLen, valueOffset, 0,
 		gasLimit, functionOffset, functionLength, numArguments, argumentsLengthOffset, dataOffset)
 }
 
-//export v1_4_transferESDTNFTExecute
-func v1_4_transferESDTNFTExecute(
-	context unsafe.Pointer,
+// TransferESDTNFTExecute VMHooks implementation.
+func (context *ElrondAPI) TransferESDTNFTExecute(
 	destOffset int32,
 	tokenIDOffset int32,
 	tokenIDLen int32,
@@ -1319,7 +832,7 @@ func v1_4_transferESDTNFTExecute(
 	argumentsLengthOffset int32,
 	dataOffset int32,
 ) int32 {
-	host := arwen.GetVMHost(context)
+	host := context.GetVMHost()
 	metering := host.Metering()
 	metering.StartGasTracing(transferESDTNFTExecuteName)
 	return TransferESDTNFTExecuteWithHost(
@@ -1337,9 +850,8 @@ func v1_4_transferESDTNFTExecute(
 		dataOffset)
 }
 
-//export v1_4_multiTransferESDTNFTExecute
-func v1_4_multiTransferESDTNFTExecute(
-	context unsafe.Pointer,
+// MultiTransferESDTNFTExecute VMHooks implementation.
+func (context *ElrondAPI) MultiTransferESDTNFTExecute(
 	destOffset int32,
 	numTokenTransfers int32,
 	tokenTransfersArgsLengthOffset int32,
@@ -1351,7 +863,7 @@ func v1_4_multiTransferESDTNFTExecute(
 	argumentsLengthOffset int32,
 	dataOffset int32,
 ) int32 {
-	host := arwen.GetVMHost(context)
+	host := context.GetVMHost()
 	runtime := host.Runtime()
 	metering := host.Metering()
 	metering.StartGasTracing(multiTransferESDTNFTExecuteName)
@@ -1525,9 +1037,8 @@ func TransferESDTNFTExecuteWithTypedArgs(
 	return 0
 }
 
-//export v1_4_createAsyncCall
-func v1_4_createAsyncCall(context unsafe.Pointer,
-	asyncContextIdentifier int32,
+// CreateAsyncCall VMHooks implementation.
+func (context *ElrondAPI) CreateAsyncCall(asyncContextIdentifier int32,
 	identifierLength int32,
 	destOffset int32,
 	valueOffset int32,
@@ -1539,78 +1050,129 @@ func v1_4_createAsyncCall(context unsafe.Pointer,
 	errorLength int32,
 	gas int64,
 ) {
-	host := arwen.GetVMHost(context)
+	host := context.GetVMHost()
 	runtime := host.Runtime()
+	metering := host.Metering()
+	metering.StartGasTracing(createAsyncCallName)
 
-	// TODO consume gas
+	gasToUse := metering.GasSchedule().ElrondAPICost.AsyncCallStep
+	metering.UseAndTraceGas(gasToUse)
 
 	acIdentifier, err := runtime.MemLoad(asyncContextIdentifier, identifierLength)
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return
 	}
 
 	calledSCAddress, err := runtime.MemLoad(destOffset, arwen.AddressLen)
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return
 	}
 
 	value, err := runtime.MemLoad(valueOffset, arwen.BalanceLen)
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return
 	}
 
 	data, err := runtime.MemLoad(dataOffset, length)
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return
 	}
 
 	successFunc, err := runtime.MemLoad(successOffset, successLength)
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return
 	}
 
 	errorFunc, err := runtime.MemLoad(errorOffset, errorLength)
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
+		return
+	}
+
+	err = CreateAsyncCallWithTypedArgs(host,
+		acIdentifier,
+		calledSCAddress,
+		value,
+		data,
+		successFunc,
+		errorFunc,
+		gas,
+		0,
+		nil)
+	if errors.Is(err, arwen.ErrNotEnoughGas) {
+		runtime.SetRuntimeBreakpointValue(arwen.BreakpointOutOfGas)
 		return
 	}
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
+		return
+	}
+}
 
-	err = runtime.AddAsyncContextCall(acIdentifier, &arwen.AsyncGeneratedCall{
-		Destination:     calledSCAddress,
+// CreateAsyncCallWithTypedArgs adds an async call to the given async context of the running contract. The gas
+// needed to execute the callback, plus extraGasForCallback, is locked now and given back to the callback, which
+// also receives the callback closure.
+func CreateAsyncCallWithTypedArgs(host arwen.VMHost,
+	asyncContextIdentifier []byte,
+	destination []byte,
+	value []byte,
+	data []byte,
+	successCallback []byte,
+	errorCallback []byte,
+	gas int64,
+	extraGasForCallback int64,
+	callbackClosure []byte,
+) error {
+	runtime := host.Runtime()
+	metering := host.Metering()
+
+	if gas < 0 || extraGasForCallback < 0 {
+		return arwen.ErrArgOutOfRange
+	}
+
+	gasToUse := math.MulUint64(metering.GasSchedule().BaseOperationCost.DataCopyPerByte, uint64(len(data)+len(callbackClosure)))
+	metering.UseAndTraceGas(gasToUse)
+
+	gasToLock := math.AddUint64(metering.ComputeGasLockedForAsync(), uint64(extraGasForCallback))
+	err := metering.UseGasBounded(gasToLock)
+	if err != nil {
+		return err
+	}
+
+	return runtime.AddAsyncContextCall(asyncContextIdentifier, &arwen.AsyncGeneratedCall{
+		Destination:     destination,
 		Data:            data,
 		ValueBytes:      value,
-		SuccessCallback: string(successFunc),
-		ErrorCallback:   string(errorFunc),
+		SuccessCallback: string(successCallback),
+		ErrorCallback:   string(errorCallback),
 		ProvidedGas:     uint64(gas),
+		GasLocked:       gasToLock,
+		CallbackClosure: callbackClosure,
 	})
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
-		return
-	}
 }
 
-//export v1_4_setAsyncContextCallback
-func v1_4_setAsyncContextCallback(context unsafe.Pointer,
-	asyncContextIdentifier int32,
+// SetAsyncContextCallback VMHooks implementation. It is not imported by the contracts, because the host
+// never calls the callback of a completed async context, and setting it does not consume gas yet.
+func (context *ElrondAPI) SetAsyncContextCallback(asyncContextIdentifier int32,
 	identifierLength int32,
 	callback int32,
 	callbackLength int32,
 ) int32 {
-	host := arwen.GetVMHost(context)
+	host := context.GetVMHost()
 	runtime := host.Runtime()
 
 	// TODO consume gas
 
 	acIdentifier, err := runtime.MemLoad(asyncContextIdentifier, identifierLength)
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return -1
 	}
 
 	asyncContext, err := runtime.GetAsyncContext(acIdentifier)
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return -1
 	}
 
 	callbackFunc, err := runtime.MemLoad(callback, callbackLength)
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return -1
 	}
 
@@ -1619,9 +1181,8 @@ func v1_4_setAsyncContextCallback(context unsafe.Pointer,
 	return 0
 }
 
-//export v1_4_upgradeContract
-func v1_4_upgradeContract(
-	context unsafe.Pointer,
+// UpgradeContract VMHooks implementation.
+func (context *ElrondAPI) UpgradeContract(
 	destOffset int32,
 	gasLimit int64,
 	valueOffset int32,
@@ -1632,7 +1193,7 @@ func v1_4_upgradeContract(
 	argumentsLengthOffset int32,
 	dataOffset int32,
 ) {
-	host := arwen.GetVMHost(context)
+	host := context.GetVMHost()
 	runtime := host.Runtime()
 	metering := host.Metering()
 	metering.StartGasTracing(upgradeContractName)
@@ -1641,17 +1202,17 @@ func v1_4_upgradeContract(
 	metering.UseAndTraceGas(gasToUse)
 
 	value, err := runtime.MemLoad(valueOffset, arwen.BalanceLen)
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return
 	}
 
 	code, err := runtime.MemLoad(codeOffset, length)
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return
 	}
 
 	codeMetadata, err := runtime.MemLoad(codeMetadataOffset, arwen.CodeMetadataLen)
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return
 	}
 
@@ -1665,12 +1226,12 @@ func v1_4_upgradeContract(
 	gasToUse = math.MulUint64(metering.GasSchedule().BaseOperationCost.DataCopyPerByte, uint64(actualLen))
 	metering.UseAndTraceGas(gasToUse)
 
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return
 	}
 
 	calledSCAddress, err := runtime.MemLoad(destOffset, arwen.AddressLen)
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return
 	}
 
@@ -1681,9 +1242,8 @@ func v1_4_upgradeContract(
 	upgradeContract(host, calledSCAddress, code, codeMetadata, value, data, gasLimit)
 }
 
-//export v1_4_upgradeFromSourceContract
-func v1_4_upgradeFromSourceContract(
-	context unsafe.Pointer,
+// UpgradeFromSourceContract VMHooks implementation.
+func (context *ElrondAPI) UpgradeFromSourceContract(
 	destOffset int32,
 	gasLimit int64,
 	valueOffset int32,
@@ -1693,7 +1253,7 @@ func v1_4_upgradeFromSourceContract(
 	argumentsLengthOffset int32,
 	dataOffset int32,
 ) {
-	host := arwen.GetVMHost(context)
+	host := context.GetVMHost()
 	runtime := host.Runtime()
 	metering := host.Metering()
 	metering.StartGasTracing(upgradeFromSourceContractName)
@@ -1712,7 +1272,7 @@ func v1_4_upgradeFromSourceContract(
 	}
 
 	codeMetadata, err := runtime.MemLoad(codeMetadataOffset, arwen.CodeMetadataLen)
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return
 	}
 
@@ -1814,9 +1374,9 @@ func upgradeContract(
 	}
 }
 
-//export v1_4_asyncCall
-func v1_4_asyncCall(context unsafe.Pointer, destOffset int32, valueOffset int32, dataOffset int32, length int32) {
-	host := arwen.GetVMHost(context)
+// AsyncCall VMHooks implementation.
+func (context *ElrondAPI) AsyncCall(destOffset int32, valueOffset int32, dataOffset int32, length int32) {
+	host := context.GetVMHost()
 	runtime := host.Runtime()
 	metering := host.Metering()
 	metering.StartGasTracing(asyncCallName)
@@ -1826,12 +1386,12 @@ func v1_4_asyncCall(context unsafe.Pointer, destOffset int32, valueOffset int32,
 	metering.UseAndTraceGas(gasToUse)
 
 	calledSCAddress, err := runtime.MemLoad(destOffset, arwen.AddressLen)
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return
 	}
 
 	value, err := runtime.MemLoad(valueOffset, arwen.BalanceLen)
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return
 	}
 
@@ -1839,7 +1399,7 @@ func v1_4_asyncCall(context unsafe.Pointer, destOffset int32, valueOffset int32,
 	metering.UseAndTraceGas(gasToUse)
 
 	data, err := runtime.MemLoad(dataOffset, length)
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return
 	}
 
@@ -1848,71 +1408,71 @@ func v1_4_asyncCall(context unsafe.Pointer, destOffset int32, valueOffset int32,
 		runtime.SetRuntimeBreakpointValue(arwen.BreakpointOutOfGas)
 		return
 	}
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return
 	}
 }
 
-//export v1_4_getArgumentLength
-func v1_4_getArgumentLength(context unsafe.Pointer, id int32) int32 {
-	runtime := arwen.GetRuntimeContext(context)
-	metering := arwen.GetMeteringContext(context)
+// GetArgumentLength VMHooks implementation.
+func (context *ElrondAPI) GetArgumentLength(id int32) int32 {
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
 
 	gasToUse := metering.GasSchedule().ElrondAPICost.GetArgument
 	metering.UseGasAndAddTracedGas(getArgumentLengthName, gasToUse)
 
 	args := runtime.Arguments()
 	if id < 0 || int32(len(args)) <= id {
-		arwen.WithFaultIfFailAlwaysActive(arwen.ErrInvalidArgument, context, runtime.ElrondAPIErrorShouldFailExecution())
+		context.WithFaultIfFailAlwaysActive(arwen.ErrInvalidArgument, runtime.ElrondAPIErrorShouldFailExecution())
 		return -1
 	}
 
 	return int32(len(args[id]))
 }
 
-//export v1_4_getArgument
-func v1_4_getArgument(context unsafe.Pointer, id int32, argOffset int32) int32 {
-	runtime := arwen.GetRuntimeContext(context)
-	metering := arwen.GetMeteringContext(context)
+// GetArgument VMHooks implementation.
+func (context *ElrondAPI) GetArgument(id int32, argOffset int32) int32 {
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
 
 	gasToUse := metering.GasSchedule().ElrondAPICost.GetArgument
 	metering.UseGasAndAddTracedGas(getArgumentName, gasToUse)
 
 	args := runtime.Arguments()
 	if id < 0 || int32(len(args)) <= id {
-		arwen.WithFaultIfFailAlwaysActive(arwen.ErrInvalidArgument, context, runtime.ElrondAPIErrorShouldFailExecution())
+		context.WithFaultIfFailAlwaysActive(arwen.ErrInvalidArgument, runtime.ElrondAPIErrorShouldFailExecution())
 		return -1
 	}
 
 	err := runtime.MemStore(argOffset, args[id])
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return -1
 	}
 
 	return int32(len(args[id]))
 }
 
-//export v1_4_getFunction
-func v1_4_getFunction(context unsafe.Pointer, functionOffset int32) int32 {
-	runtime := arwen.GetRuntimeContext(context)
-	metering := arwen.GetMeteringContext(context)
+// GetFunction VMHooks implementation.
+func (context *ElrondAPI) GetFunction(functionOffset int32) int32 {
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
 
 	gasToUse := metering.GasSchedule().ElrondAPICost.GetFunction
 	metering.UseGasAndAddTracedGas(getFunctionName, gasToUse)
 
 	function := runtime.Function()
 	err := runtime.MemStore(functionOffset, []byte(function))
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return -1
 	}
 
 	return int32(len(function))
 }
 
-//export v1_4_getNumArguments
-func v1_4_getNumArguments(context unsafe.Pointer) int32 {
-	runtime := arwen.GetRuntimeContext(context)
-	metering := arwen.GetMeteringContext(context)
+// GetNumArguments VMHooks implementation.
+func (context *ElrondAPI) GetNumArguments() int32 {
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
 
 	gasToUse := metering.GasSchedule().ElrondAPICost.GetNumArguments
 	metering.UseGasAndAddTracedGas(getNumArgumentsName, gasToUse)
@@ -1921,9 +1481,9 @@ func v1_4_getNumArguments(context unsafe.Pointer) int32 {
 	return int32(len(args))
 }
 
-//export v1_4_storageStore
-func v1_4_storageStore(context unsafe.Pointer, keyOffset int32, keyLength int32, dataOffset int32, dataLength int32) int32 {
-	host := arwen.GetVMHost(context)
+// StorageStore VMHooks implementation.
+func (context *ElrondAPI) StorageStore(keyOffset int32, keyLength int32, dataOffset int32, dataLength int32) int32 {
+	host := context.GetVMHost()
 	return StorageStoreWithHost(
 		host,
 		keyOffset,
@@ -1967,14 +1527,14 @@ func StorageStoreWithTypedArgs(host arwen.VMHost, key []byte, data []byte) int32
 	return int32(storageStatus)
 }
 
-//export v1_4_storageLoadLength
-func v1_4_storageLoadLength(context unsafe.Pointer, keyOffset int32, keyLength int32) int32 {
-	runtime := arwen.GetRuntimeContext(context)
-	storage := arwen.GetStorageContext(context)
-	metering := arwen.GetMeteringContext(context)
+// StorageLoadLength VMHooks implementation.
+func (context *ElrondAPI) StorageLoadLength(keyOffset int32, keyLength int32) int32 {
+	runtime := context.GetRuntimeContext()
+	storage := context.GetStorageContext()
+	metering := context.GetMeteringContext()
 
 	key, err := runtime.MemLoad(keyOffset, keyLength)
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return -1
 	}
 
@@ -1984,9 +1544,9 @@ func v1_4_storageLoadLength(context unsafe.Pointer, keyOffset int32, keyLength i
 	return int32(len(data))
 }
 
-//export v1_4_storageLoadFromAddress
-func v1_4_storageLoadFromAddress(context unsafe.Pointer, addressOffset int32, keyOffset int32, keyLength int32, dataOffset int32) int32 {
-	host := arwen.GetVMHost(context)
+// StorageLoadFromAddress VMHooks implementation.
+func (context *ElrondAPI) StorageLoadFromAddress(addressOffset int32, keyOffset int32, keyLength int32, dataOffset int32) int32 {
+	host := context.GetVMHost()
 	return StorageLoadFromAddressWithHost(
 		host,
 		addressOffset,
@@ -2029,9 +1589,9 @@ func StorageLoadFromAddressWithTypedArgs(host arwen.VMHost, address []byte, key
 	return data
 }
 
-//export v1_4_storageLoad
-func v1_4_storageLoad(context unsafe.Pointer, keyOffset int32, keyLength int32, dataOffset int32) int32 {
-	host := arwen.GetVMHost(context)
+// StorageLoad VMHooks implementation.
+func (context *ElrondAPI) StorageLoad(keyOffset int32, keyLength int32, dataOffset int32) int32 {
+	host := context.GetVMHost()
 	return StorageLoadWithHost(
 		host,
 		keyOffset,
@@ -2068,9 +1628,9 @@ func StorageLoadWithWithTypedArgs(host arwen.VMHost, key []byte) []byte {
 	return data
 }
 
-//export v1_4_setStorageLock
-func v1_4_setStorageLock(context unsafe.Pointer, keyOffset int32, keyLength int32, lockTimestamp int64) int32 {
-	host := arwen.GetVMHost(context)
+// SetStorageLock VMHooks implementation.
+func (context *ElrondAPI) SetStorageLock(keyOffset int32, keyLength int32, lockTimestamp int64) int32 {
+	host := context.GetVMHost()
 	return SetStorageLockWithHost(
 		host,
 		keyOffset,
@@ -2108,14 +1668,14 @@ func SetStorageLockWithTypedArgs(host arwen.VMHost, key []byte, lockTimestamp in
 	return int32(storageStatus)
 }
 
-//export v1_4_getStorageLock
-func v1_4_getStorageLock(context unsafe.Pointer, keyOffset int32, keyLength int32) int64 {
-	runtime := arwen.GetRuntimeContext(context)
-	metering := arwen.GetMeteringContext(context)
-	storage := arwen.GetStorageContext(context)
+// GetStorageLock VMHooks implementation.
+func (context *ElrondAPI) GetStorageLock(keyOffset int32, keyLength int32) int64 {
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
+	storage := context.GetStorageContext()
 
 	key, err := runtime.MemLoad(keyOffset, keyLength)
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return -1
 	}
 
@@ -2130,15 +1690,15 @@ func v1_4_getStorageLock(context unsafe.Pointer, keyOffset int32, keyLength int3
 	return timeLock
 }
 
-//export v1_4_isStorageLocked
-func v1_4_isStorageLocked(context unsafe.Pointer, keyOffset int32, keyLength int32) int32 {
+// IsStorageLocked VMHooks implementation.
+func (context *ElrondAPI) IsStorageLocked(keyOffset int32, keyLength int32) int32 {
 
-	timeLock := v1_4_getStorageLock(context, keyOffset, keyLength)
+	timeLock := context.GetStorageLock(keyOffset, keyLength)
 	if timeLock < 0 {
 		return -1
 	}
 
-	currentTimestamp := v1_4_getBlockTimestamp(context)
+	currentTimestamp := context.GetBlockTimestamp()
 	if timeLock <= currentTimestamp {
 		return 0
 	}
@@ -2146,15 +1706,15 @@ func v1_4_isStorageLocked(context unsafe.Pointer, keyOffset int32, keyLength int
 	return 1
 }
 
-//export v1_4_clearStorageLock
-func v1_4_clearStorageLock(context unsafe.Pointer, keyOffset int32, keyLength int32) int32 {
-	return v1_4_setStorageLock(context, keyOffset, keyLength, 0)
+// ClearStorageLock VMHooks implementation.
+func (context *ElrondAPI) ClearStorageLock(keyOffset int32, keyLength int32) int32 {
+	return context.SetStorageLock(keyOffset, keyLength, 0)
 }
 
-//export v1_4_getCaller
-func v1_4_getCaller(context unsafe.Pointer, resultOffset int32) {
-	runtime := arwen.GetRuntimeContext(context)
-	metering := arwen.GetMeteringContext(context)
+// GetCaller VMHooks implementation.
+func (context *ElrondAPI) GetCaller(resultOffset int32) {
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
 
 	gasToUse := metering.GasSchedule().ElrondAPICost.GetCaller
 	metering.UseGasAndAddTracedGas(getCallerName, gasToUse)
@@ -2162,34 +1722,34 @@ func v1_4_getCaller(context unsafe.Pointer, resultOffset int32) {
 	caller := runtime.GetVMInput().CallerAddr
 
 	err := runtime.MemStore(resultOffset, caller)
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return
 	}
 }
 
-//export v1_4_checkNoPayment
-func v1_4_checkNoPayment(context unsafe.Pointer) {
-	runtime := arwen.GetRuntimeContext(context)
-	metering := arwen.GetMeteringContext(context)
+// CheckNoPayment VMHooks implementation.
+func (context *ElrondAPI) CheckNoPayment() {
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
 
 	gasToUse := metering.GasSchedule().ElrondAPICost.GetCallValue
 	metering.UseGasAndAddTracedGas(checkNoPaymentName, gasToUse)
 
 	vmInput := runtime.GetVMInput()
 	if vmInput.CallValue.Sign() > 0 {
-		_ = arwen.WithFault(arwen.ErrNonPayableFunctionEgld, context, runtime.ElrondAPIErrorShouldFailExecution())
+		_ = context.WithFault(arwen.ErrNonPayableFunctionEgld, runtime.ElrondAPIErrorShouldFailExecution())
 		return
 	}
 	if len(vmInput.ESDTTransfers) > 0 {
-		_ = arwen.WithFault(arwen.ErrNonPayableFunctionEsdt, context, runtime.ElrondAPIErrorShouldFailExecution())
+		_ = context.WithFault(arwen.ErrNonPayableFunctionEsdt, runtime.ElrondAPIErrorShouldFailExecution())
 		return
 	}
 }
 
-//export v1_4_callValue
-func v1_4_callValue(context unsafe.Pointer, resultOffset int32) int32 {
-	runtime := arwen.GetRuntimeContext(context)
-	metering := arwen.GetMeteringContext(context)
+// GetCallValue VMHooks implementation.
+func (context *ElrondAPI) GetCallValue(resultOffset int32) int32 {
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
 
 	gasToUse := metering.GasSchedule().ElrondAPICost.GetCallValue
 	metering.UseGasAndAddTracedGas(callValueName, gasToUse)
@@ -2198,94 +1758,94 @@ func v1_4_callValue(context unsafe.Pointer, resultOffset int32) int32 {
 	value = arwen.PadBytesLeft(value, arwen.BalanceLen)
 
 	err := runtime.MemStore(resultOffset, value)
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return -1
 	}
 
 	return int32(len(value))
 }
 
-//export v1_4_getESDTValue
-func v1_4_getESDTValue(context unsafe.Pointer, resultOffset int32) int32 {
+// GetESDTValue VMHooks implementation.
+func (context *ElrondAPI) GetESDTValue(resultOffset int32) int32 {
 	isFail := failIfMoreThanOneESDTTransfer(context)
 	if isFail {
 		return -1
 	}
-	return v1_4_getESDTValueByIndex(context, resultOffset, 0)
+	return context.GetESDTValueByIndex(resultOffset, 0)
 }
 
-//export v1_4_getESDTValueByIndex
-func v1_4_getESDTValueByIndex(context unsafe.Pointer, resultOffset int32, index int32) int32 {
-	runtime := arwen.GetRuntimeContext(context)
-	metering := arwen.GetMeteringContext(context)
+// GetESDTValueByIndex VMHooks implementation.
+func (context *ElrondAPI) GetESDTValueByIndex(resultOffset int32, index int32) int32 {
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
 
 	gasToUse := metering.GasSchedule().ElrondAPICost.GetCallValue
 	metering.UseGasAndAddTracedGas(getESDTValueByIndexName, gasToUse)
 
 	var value []byte
 
-	esdtTransfer := getESDTTransferFromInputFailIfWrongIndex(arwen.GetVMHost(context), index)
+	esdtTransfer := getESDTTransferFromInputFailIfWrongIndex(context.GetVMHost(), index)
 	if esdtTransfer != nil && esdtTransfer.ESDTValue.Cmp(arwen.Zero) > 0 {
 		value = esdtTransfer.ESDTValue.Bytes()
 		value = arwen.PadBytesLeft(value, arwen.BalanceLen)
 	}
 
 	err := runtime.MemStore(resultOffset, value)
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return -1
 	}
 
 	return int32(len(value))
 }
 
-//export v1_4_getESDTTokenName
-func v1_4_getESDTTokenName(context unsafe.Pointer, resultOffset int32) int32 {
+// GetESDTTokenName VMHooks implementation.
+func (context *ElrondAPI) GetESDTTokenName(resultOffset int32) int32 {
 	isFail := failIfMoreThanOneESDTTransfer(context)
 	if isFail {
 		return -1
 	}
-	return v1_4_getESDTTokenNameByIndex(context, resultOffset, 0)
+	return context.GetESDTTokenNameByIndex(resultOffset, 0)
 }
 
-//export v1_4_getESDTTokenNameByIndex
-func v1_4_getESDTTokenNameByIndex(context unsafe.Pointer, resultOffset int32, index int32) int32 {
-	runtime := arwen.GetRuntimeContext(context)
-	metering := arwen.GetMeteringContext(context)
+// GetESDTTokenNameByIndex VMHooks implementation.
+func (context *ElrondAPI) GetESDTTokenNameByIndex(resultOffset int32, index int32) int32 {
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
 
 	gasToUse := metering.GasSchedule().ElrondAPICost.GetCallValue
 	metering.UseGasAndAddTracedGas(getESDTTokenNameByIndexName, gasToUse)
 
-	esdtTransfer := getESDTTransferFromInputFailIfWrongIndex(arwen.GetVMHost(context), index)
+	esdtTransfer := getESDTTransferFromInputFailIfWrongIndex(context.GetVMHost(), index)
 	var tokenName []byte
 	if esdtTransfer != nil {
 		tokenName = esdtTransfer.ESDTTokenName
 	}
 
 	err := runtime.MemStore(resultOffset, tokenName)
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return -1
 	}
 
 	return int32(len(tokenName))
 }
 
-//export v1_4_getESDTTokenNonce
-func v1_4_getESDTTokenNonce(context unsafe.Pointer) int64 {
+// GetESDTTokenNonce VMHooks implementation.
+func (context *ElrondAPI) GetESDTTokenNonce() int64 {
 	isFail := failIfMoreThanOneESDTTransfer(context)
 	if isFail {
 		return -1
 	}
-	return v1_4_getESDTTokenNonceByIndex(context, 0)
+	return context.GetESDTTokenNonceByIndex(0)
 }
 
-//export v1_4_getESDTTokenNonceByIndex
-func v1_4_getESDTTokenNonceByIndex(context unsafe.Pointer, index int32) int64 {
-	metering := arwen.GetMeteringContext(context)
+// GetESDTTokenNonceByIndex VMHooks implementation.
+func (context *ElrondAPI) GetESDTTokenNonceByIndex(index int32) int64 {
+	metering := context.GetMeteringContext()
 
 	gasToUse := metering.GasSchedule().ElrondAPICost.GetCallValue
 	metering.UseGasAndAddTracedGas(getESDTTokenNonceByIndexName, gasToUse)
 
-	esdtTransfer := getESDTTransferFromInputFailIfWrongIndex(arwen.GetVMHost(context), index)
+	esdtTransfer := getESDTTransferFromInputFailIfWrongIndex(context.GetVMHost(), index)
 	nonce := uint64(0)
 	if esdtTransfer != nil {
 		nonce = esdtTransfer.ESDTTokenNonce
@@ -2293,22 +1853,22 @@ func v1_4_getESDTTokenNonceByIndex(context unsafe.Pointer, index int32) int64 {
 	return int64(nonce)
 }
 
-//export v1_4_getCurrentESDTNFTNonce
-func v1_4_getCurrentESDTNFTNonce(context unsafe.Pointer, addressOffset int32, tokenIDOffset int32, tokenIDLen int32) int64 {
-	runtime := arwen.GetRuntimeContext(context)
-	metering := arwen.GetMeteringContext(context)
-	storage := arwen.GetStorageContext(context)
+// GetCurrentESDTNFTNonce VMHooks implementation.
+func (context *ElrondAPI) GetCurrentESDTNFTNonce(addressOffset int32, tokenIDOffset int32, tokenIDLen int32) int64 {
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
+	storage := context.GetStorageContext()
 
 	gasToUse := metering.GasSchedule().ElrondAPICost.StorageLoad
 	metering.UseGasAndAddTracedGas(getCurrentESDTNFTNonceName, gasToUse)
 
 	destination, err := runtime.MemLoad(addressOffset, arwen.AddressLen)
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return 0
 	}
 
 	tokenID, err := runtime.MemLoad(tokenIDOffset, tokenIDLen)
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return 0
 	}
 
@@ -2319,33 +1879,33 @@ func v1_4_getCurrentESDTNFTNonce(context unsafe.Pointer, addressOffset int32, to
 	return int64(nonce)
 }
 
-//export v1_4_getESDTTokenType
-func v1_4_getESDTTokenType(context unsafe.Pointer) int32 {
+// GetESDTTokenType VMHooks implementation.
+func (context *ElrondAPI) GetESDTTokenType() int32 {
 	isFail := failIfMoreThanOneESDTTransfer(context)
 	if isFail {
 		return -1
 	}
-	return v1_4_getESDTTokenTypeByIndex(context, 0)
+	return context.GetESDTTokenTypeByIndex(0)
 }
 
-//export v1_4_getESDTTokenTypeByIndex
-func v1_4_getESDTTokenTypeByIndex(context unsafe.Pointer, index int32) int32 {
-	metering := arwen.GetMeteringContext(context)
+// GetESDTTokenTypeByIndex VMHooks implementation.
+func (context *ElrondAPI) GetESDTTokenTypeByIndex(index int32) int32 {
+	metering := context.GetMeteringContext()
 
 	gasToUse := metering.GasSchedule().ElrondAPICost.GetCallValue
 	metering.UseGasAndAddTracedGas(getESDTTokenTypeByIndexName, gasToUse)
 
-	esdtTransfer := getESDTTransferFromInputFailIfWrongIndex(arwen.GetVMHost(context), index)
+	esdtTransfer := getESDTTransferFromInputFailIfWrongIndex(context.GetVMHost(), index)
 	if esdtTransfer != nil {
 		return int32(esdtTransfer.ESDTTokenType)
 	}
 	return 0
 }
 
-//export v1_4_getNumESDTTransfers
-func v1_4_getNumESDTTransfers(context unsafe.Pointer) int32 {
-	runtime := arwen.GetRuntimeContext(context)
-	metering := arwen.GetMeteringContext(context)
+// GetNumESDTTransfers VMHooks implementation.
+func (context *ElrondAPI) GetNumESDTTransfers() int32 {
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
 
 	gasToUse := metering.GasSchedule().ElrondAPICost.GetCallValue
 	metering.UseGasAndAddTracedGas(getNumESDTTransfersName, gasToUse)
@@ -2353,26 +1913,26 @@ func v1_4_getNumESDTTransfers(context unsafe.Pointer) int32 {
 	return int32(len(runtime.GetVMInput().ESDTTransfers))
 }
 
-//export v1_4_getCallValueTokenName
-func v1_4_getCallValueTokenName(context unsafe.Pointer, callValueOffset int32, tokenNameOffset int32) int32 {
+// GetCallValueTokenName VMHooks implementation.
+func (context *ElrondAPI) GetCallValueTokenName(callValueOffset int32, tokenNameOffset int32) int32 {
 	isFail := failIfMoreThanOneESDTTransfer(context)
 	if isFail {
 		return -1
 	}
-	return v1_4_getCallValueTokenNameByIndex(context, callValueOffset, tokenNameOffset, 0)
+	return context.GetCallValueTokenNameByIndex(callValueOffset, tokenNameOffset, 0)
 }
 
-//export v1_4_getCallValueTokenNameByIndex
-func v1_4_getCallValueTokenNameByIndex(context unsafe.Pointer, callValueOffset int32, tokenNameOffset int32, index int32) int32 {
-	runtime := arwen.GetRuntimeContext(context)
-	metering := arwen.GetMeteringContext(context)
+// GetCallValueTokenNameByIndex VMHooks implementation.
+func (context *ElrondAPI) GetCallValueTokenNameByIndex(callValueOffset int32, tokenNameOffset int32, index int32) int32 {
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
 
 	gasToUse := metering.GasSchedule().ElrondAPICost.GetCallValue
 	metering.UseGasAndAddTracedGas(getCallValueTokenNameByIndexName, gasToUse)
 
 	callValue := runtime.GetVMInput().CallValue.Bytes()
 	tokenName := make([]byte, 0)
-	esdtTransfer := getESDTTransferFromInputFailIfWrongIndex(arwen.GetVMHost(context), index)
+	esdtTransfer := getESDTTransferFromInputFailIfWrongIndex(context.GetVMHost(), index)
 
 	if esdtTransfer != nil {
 		tokenName = make([]byte, len(esdtTransfer.ESDTTokenName))
@@ -2382,24 +1942,24 @@ func v1_4_getCallValueTokenNameByIndex(context unsafe.Pointer, callValueOffset i
 	callValue = arwen.PadBytesLeft(callValue, arwen.BalanceLen)
 
 	err := runtime.MemStore(tokenNameOffset, tokenName)
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return -1
 	}
 
 	err = runtime.MemStore(callValueOffset, callValue)
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return -1
 	}
 
 	return int32(len(tokenName))
 }
 
-//export v1_4_writeLog
-func v1_4_writeLog(context unsafe.Pointer, dataPointer int32, dataLength int32, topicPtr int32, numTopics int32) {
+// WriteLog VMHooks implementation.
+func (context *ElrondAPI) WriteLog(dataPointer int32, dataLength int32, topicPtr int32, numTopics int32) {
 	// note: deprecated
-	runtime := arwen.GetRuntimeContext(context)
-	output := arwen.GetOutputContext(context)
-	metering := arwen.GetMeteringContext(context)
+	runtime := context.GetRuntimeContext()
+	output := context.GetOutputContext()
+	metering := context.GetMeteringContext()
 
 	gasToUse := metering.GasSchedule().ElrondAPICost.Log
 	gas := math.MulUint64(metering.GasSchedule().BaseOperationCost.PersistPerByte, uint64(numTopics*arwen.HashLen+dataLength))
@@ -2407,18 +1967,18 @@ func v1_4_writeLog(context unsafe.Pointer, dataPointer int32, dataLength int32,
 	metering.UseGasAndAddTracedGas(writeLogName, gasToUse)
 
 	log, err := runtime.MemLoad(dataPointer, dataLength)
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return
 	}
 
 	topics, err := arwen.GuardedMakeByteSlice2D(numTopics)
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return
 	}
 
 	for i := int32(0); i < numTopics; i++ {
 		topics[i], err = runtime.MemLoad(topicPtr+i*arwen.HashLen, arwen.HashLen)
-		if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+		if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 			return
 		}
 	}
@@ -2426,9 +1986,8 @@ func v1_4_writeLog(context unsafe.Pointer, dataPointer int32, dataLength int32,
 	output.WriteLog(runtime.GetSCAddress(), topics, log)
 }
 
-//export v1_4_writeEventLog
-func v1_4_writeEventLog(
-	context unsafe.Pointer,
+// WriteEventLog VMHooks implementation.
+func (context *ElrondAPI) WriteEventLog(
 	numTopics int32,
 	topicLengthsOffset int32,
 	topicOffset int32,
@@ -2436,10 +1995,10 @@ func v1_4_writeEventLog(
 	dataLength int32,
 ) {
 
-	host := arwen.GetVMHost(context)
-	runtime := arwen.GetRuntimeContext(context)
-	output := arwen.GetOutputContext(context)
-	metering := arwen.GetMeteringContext(context)
+	host := context.GetVMHost()
+	runtime := context.GetRuntimeContext()
+	output := context.GetOutputContext()
+	metering := context.GetMeteringContext()
 
 	topics, topicDataTotalLen, err := getArgumentsFromMemory(
 		host,
@@ -2447,12 +2006,12 @@ func v1_4_writeEventLog(
 		topicLengthsOffset,
 		topicOffset,
 	)
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return
 	}
 
 	data, err := runtime.MemLoad(dataOffset, dataLength)
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return
 	}
 
@@ -2466,10 +2025,10 @@ func v1_4_writeEventLog(
 	output.WriteLog(runtime.GetSCAddress(), topics, data)
 }
 
-//export v1_4_getBlockTimestamp
-func v1_4_getBlockTimestamp(context unsafe.Pointer) int64 {
-	blockchain := arwen.GetBlockchainContext(context)
-	metering := arwen.GetMeteringContext(context)
+// GetBlockTimestamp VMHooks implementation.
+func (context *ElrondAPI) GetBlockTimestamp() int64 {
+	blockchain := context.GetBlockchainContext()
+	metering := context.GetMeteringContext()
 
 	gasToUse := metering.GasSchedule().ElrondAPICost.GetBlockTimeStamp
 	metering.UseGasAndAddTracedGas(getBlockTimestampName, gasToUse)
@@ -2477,10 +2036,10 @@ func v1_4_getBlockTimestamp(context unsafe.Pointer) int64 {
 	return int64(blockchain.CurrentTimeStamp())
 }
 
-//export v1_4_getBlockNonce
-func v1_4_getBlockNonce(context unsafe.Pointer) int64 {
-	blockchain := arwen.GetBlockchainContext(context)
-	metering := arwen.GetMeteringContext(context)
+// GetBlockNonce VMHooks implementation.
+func (context *ElrondAPI) GetBlockNonce() int64 {
+	blockchain := context.GetBlockchainContext()
+	metering := context.GetMeteringContext()
 
 	gasToUse := metering.GasSchedule().ElrondAPICost.GetBlockNonce
 	metering.UseGasAndAddTracedGas(getBlockNonceName, gasToUse)
@@ -2488,10 +2047,10 @@ func v1_4_getBlockNonce(context unsafe.Pointer) int64 {
 	return int64(blockchain.CurrentNonce())
 }
 
-//export v1_4_getBlockRound
-func v1_4_getBlockRound(context unsafe.Pointer) int64 {
-	blockchain := arwen.GetBlockchainContext(context)
-	metering := arwen.GetMeteringContext(context)
+// GetBlockRound VMHooks implementation.
+func (context *ElrondAPI) GetBlockRound() int64 {
+	blockchain := context.GetBlockchainContext()
+	metering := context.GetMeteringContext()
 
 	gasToUse := metering.GasSchedule().ElrondAPICost.GetBlockRound
 	metering.UseGasAndAddTracedGas(getBlockRoundName, gasToUse)
@@ -2499,10 +2058,10 @@ func v1_4_getBlockRound(context unsafe.Pointer) int64 {
 	return int64(blockchain.CurrentRound())
 }
 
-//export v1_4_getBlockEpoch
-func v1_4_getBlockEpoch(context unsafe.Pointer) int64 {
-	blockchain := arwen.GetBlockchainContext(context)
-	metering := arwen.GetMeteringContext(context)
+// GetBlockEpoch VMHooks implementation.
+func (context *ElrondAPI) GetBlockEpoch() int64 {
+	blockchain := context.GetBlockchainContext()
+	metering := context.GetMeteringContext()
 
 	gasToUse := metering.GasSchedule().ElrondAPICost.GetBlockEpoch
 	metering.UseGasAndAddTracedGas(getBlockEpochName, gasToUse)
@@ -2510,38 +2069,38 @@ func v1_4_getBlockEpoch(context unsafe.Pointer) int64 {
 	return int64(blockchain.CurrentEpoch())
 }
 
-//export v1_4_getBlockRandomSeed
-func v1_4_getBlockRandomSeed(context unsafe.Pointer, pointer int32) {
-	runtime := arwen.GetRuntimeContext(context)
-	blockchain := arwen.GetBlockchainContext(context)
-	metering := arwen.GetMeteringContext(context)
+// GetBlockRandomSeed VMHooks implementation.
+func (context *ElrondAPI) GetBlockRandomSeed(pointer int32) {
+	runtime := context.GetRuntimeContext()
+	blockchain := context.GetBlockchainContext()
+	metering := context.GetMeteringContext()
 
 	gasToUse := metering.GasSchedule().ElrondAPICost.GetBlockRandomSeed
 	metering.UseGasAndAddTracedGas(getBlockRandomSeedName, gasToUse)
 
 	randomSeed := blockchain.CurrentRandomSeed()
 	err := runtime.MemStore(pointer, randomSeed)
-	arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution())
+	context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution())
 }
 
-//export v1_4_getStateRootHash
-func v1_4_getStateRootHash(context unsafe.Pointer, pointer int32) {
-	runtime := arwen.GetRuntimeContext(context)
-	blockchain := arwen.GetBlockchainContext(context)
-	metering := arwen.GetMeteringContext(context)
+// GetStateRootHash VMHooks implementation.
+func (context *ElrondAPI) GetStateRootHash(pointer int32) {
+	runtime := context.GetRuntimeContext()
+	blockchain := context.GetBlockchainContext()
+	metering := context.GetMeteringContext()
 
 	gasToUse := metering.GasSchedule().ElrondAPICost.GetStateRootHash
 	metering.UseGasAndAddTracedGas(getStateRootHashName, gasToUse)
 
 	stateRootHash := blockchain.GetStateRootHash()
 	err := runtime.MemStore(pointer, stateRootHash)
-	arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution())
+	context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution())
 }
 
-//export v1_4_getPrevBlockTimestamp
-func v1_4_getPrevBlockTimestamp(context unsafe.Pointer) int64 {
-	blockchain := arwen.GetBlockchainContext(context)
-	metering := arwen.GetMeteringContext(context)
+// GetPrevBlockTimestamp VMHooks implementation.
+func (context *ElrondAPI) GetPrevBlockTimestamp() int64 {
+	blockchain := context.GetBlockchainContext()
+	metering := context.GetMeteringContext()
 
 	gasToUse := metering.GasSchedule().ElrondAPICost.GetBlockTimeStamp
 	metering.UseGasAndAddTracedGas(getPrevBlockTimestampName, gasToUse)
@@ -2549,10 +2108,10 @@ func v1_4_getPrevBlockTimestamp(context unsafe.Pointer) int64 {
 	return int64(blockchain.LastTimeStamp())
 }
 
-//export v1_4_getPrevBlockNonce
-func v1_4_getPrevBlockNonce(context unsafe.Pointer) int64 {
-	blockchain := arwen.GetBlockchainContext(context)
-	metering := arwen.GetMeteringContext(context)
+// GetPrevBlockNonce VMHooks implementation.
+func (context *ElrondAPI) GetPrevBlockNonce() int64 {
+	blockchain := context.GetBlockchainContext()
+	metering := context.GetMeteringContext()
 
 	gasToUse := metering.GasSchedule().ElrondAPICost.GetBlockNonce
 	metering.UseGasAndAddTracedGas(getPrevBlockNonceName, gasToUse)
@@ -2560,10 +2119,10 @@ func v1_4_getPrevBlockNonce(context unsafe.Pointer) int64 {
 	return int64(blockchain.LastNonce())
 }
 
-//export v1_4_getPrevBlockRound
-func v1_4_getPrevBlockRound(context unsafe.Pointer) int64 {
-	blockchain := arwen.GetBlockchainContext(context)
-	metering := arwen.GetMeteringContext(context)
+// GetPrevBlockRound VMHooks implementation.
+func (context *ElrondAPI) GetPrevBlockRound() int64 {
+	blockchain := context.GetBlockchainContext()
+	metering := context.GetMeteringContext()
 
 	gasToUse := metering.GasSchedule().ElrondAPICost.GetBlockRound
 	metering.UseGasAndAddTracedGas(getPrevBlockRoundName, gasToUse)
@@ -2571,10 +2130,10 @@ func v1_4_getPrevBlockRound(context unsafe.Pointer) int64 {
 	return int64(blockchain.LastRound())
 }
 
-//export v1_4_getPrevBlockEpoch
-func v1_4_getPrevBlockEpoch(context unsafe.Pointer) int64 {
-	blockchain := arwen.GetBlockchainContext(context)
-	metering := arwen.GetMeteringContext(context)
+// GetPrevBlockEpoch VMHooks implementation.
+func (context *ElrondAPI) GetPrevBlockEpoch() int64 {
+	blockchain := context.GetBlockchainContext()
+	metering := context.GetMeteringContext()
 
 	gasToUse := metering.GasSchedule().ElrondAPICost.GetBlockEpoch
 	metering.UseGasAndAddTracedGas(getPrevBlockEpochName, gasToUse)
@@ -2582,25 +2141,25 @@ func v1_4_getPrevBlockEpoch(context unsafe.Pointer) int64 {
 	return int64(blockchain.LastEpoch())
 }
 
-//export v1_4_getPrevBlockRandomSeed
-func v1_4_getPrevBlockRandomSeed(context unsafe.Pointer, pointer int32) {
-	runtime := arwen.GetRuntimeContext(context)
-	blockchain := arwen.GetBlockchainContext(context)
-	metering := arwen.GetMeteringContext(context)
+// GetPrevBlockRandomSeed VMHooks implementation.
+func (context *ElrondAPI) GetPrevBlockRandomSeed(pointer int32) {
+	runtime := context.GetRuntimeContext()
+	blockchain := context.GetBlockchainContext()
+	metering := context.GetMeteringContext()
 
 	gasToUse := metering.GasSchedule().ElrondAPICost.GetBlockRandomSeed
 	metering.UseGasAndAddTracedGas(getPrevBlockRandomSeedName, gasToUse)
 
 	randomSeed := blockchain.LastRandomSeed()
 	err := runtime.MemStore(pointer, randomSeed)
-	arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution())
+	context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution())
 }
 
-//export v1_4_returnData
-func v1_4_returnData(context unsafe.Pointer, pointer int32, length int32) {
-	runtime := arwen.GetRuntimeContext(context)
-	output := arwen.GetOutputContext(context)
-	metering := arwen.GetMeteringContext(context)
+// Finish VMHooks implementation.
+func (context *ElrondAPI) Finish(pointer int32, length int32) {
+	runtime := context.GetRuntimeContext()
+	output := context.GetOutputContext()
+	metering := context.GetMeteringContext()
 	metering.StartGasTracing(returnDataName)
 
 	gasToUse := metering.GasSchedule().ElrondAPICost.Finish
@@ -2609,21 +2168,20 @@ func v1_4_returnData(context unsafe.Pointer, pointer int32, length int32) {
 	err := metering.UseGasBounded(gasToUse)
 
 	if err != nil {
-		_ = arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution())
+		_ = context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution())
 		return
 	}
 
 	data, err := runtime.MemLoad(pointer, length)
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return
 	}
 
 	output.Finish(data)
 }
 
-//export v1_4_executeOnSameContext
-func v1_4_executeOnSameContext(
-	context unsafe.Pointer,
+// ExecuteOnSameContext VMHooks implementation.
+func (context *ElrondAPI) ExecuteOnSameContext(
 	gasLimit int64,
 	addressOffset int32,
 	valueOffset int32,
@@ -2633,7 +2191,7 @@ func v1_4_executeOnSameContext(
 	argumentsLengthOffset int32,
 	dataOffset int32,
 ) int32 {
-	host := arwen.GetVMHost(context)
+	host := context.GetVMHost()
 	metering := host.Metering()
 	metering.StartGasTracing(executeOnSameContextName)
 
@@ -2724,9 +2282,8 @@ func ExecuteOnSameContextWithTypedArgs(
 	return 0
 }
 
-//export v1_4_executeOnDestContext
-func v1_4_executeOnDestContext(
-	context unsafe.Pointer,
+// ExecuteOnDestContext VMHooks implementation.
+func (context *ElrondAPI) ExecuteOnDestContext(
 	gasLimit int64,
 	addressOffset int32,
 	valueOffset int32,
@@ -2736,7 +2293,7 @@ func v1_4_executeOnDestContext(
 	argumentsLengthOffset int32,
 	dataOffset int32,
 ) int32 {
-	host := arwen.GetVMHost(context)
+	host := context.GetVMHost()
 	metering := host.Metering()
 	metering.StartGasTracing(executeOnDestContextName)
 
@@ -2822,9 +2379,8 @@ func ExecuteOnDestContextWithTypedArgs(
 	return 0
 }
 
-//export v1_4_executeOnDestContextByCaller
-func v1_4_executeOnDestContextByCaller(
-	context unsafe.Pointer,
+// ExecuteOnDestContextByCaller VMHooks implementation.
+func (context *ElrondAPI) ExecuteOnDestContextByCaller(
 	gasLimit int64,
 	addressOffset int32,
 	valueOffset int32,
@@ -2834,7 +2390,7 @@ func v1_4_executeOnDestContextByCaller(
 	argumentsLengthOffset int32,
 	dataOffset int32,
 ) int32 {
-	host := arwen.GetVMHost(context)
+	host := context.GetVMHost()
 	metering := host.Metering()
 	metering.StartGasTracing(executeOnDestContextByCallerName)
 
@@ -2936,9 +2492,8 @@ func ExecuteOnDestContextByCallerWithTypedArgs(
 	return 0
 }
 
-//export v1_4_executeReadOnly
-func v1_4_executeReadOnly(
-	context unsafe.Pointer,
+// ExecuteReadOnly VMHooks implementation.
+func (context *ElrondAPI) ExecuteReadOnly(
 	gasLimit int64,
 	addressOffset int32,
 	functionOffset int32,
@@ -2947,7 +2502,7 @@ func v1_4_executeReadOnly(
 	argumentsLengthOffset int32,
 	dataOffset int32,
 ) int32 {
-	host := arwen.GetVMHost(context)
+	host := context.GetVMHost()
 	metering := host.Metering()
 	metering.StartGasTracing(executeReadOnlyName)
 
@@ -3036,9 +2591,8 @@ func ExecuteReadOnlyWithTypedArguments(
 	return 0
 }
 
-//export v1_4_createContract
-func v1_4_createContract(
-	context unsafe.Pointer,
+// CreateContract VMHooks implementation.
+func (context *ElrondAPI) CreateContract(
 	gasLimit int64,
 	valueOffset int32,
 	codeOffset int32,
@@ -3049,7 +2603,7 @@ func v1_4_createContract(
 	argumentsLengthOffset int32,
 	dataOffset int32,
 ) int32 {
-	host := arwen.GetVMHost(context)
+	host := context.GetVMHost()
 	runtime := host.Runtime()
 	metering := host.Metering()
 	metering.StartGasTracing(createContractName)
@@ -3059,17 +2613,17 @@ func v1_4_createContract(
 
 	sender := runtime.GetSCAddress()
 	value, err := runtime.MemLoad(valueOffset, arwen.BalanceLen)
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return 1
 	}
 
 	code, err := runtime.MemLoad(codeOffset, length)
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return 1
 	}
 
 	codeMetadata, err := runtime.MemLoad(codeMetadataOffset, arwen.CodeMetadataLen)
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return 1
 	}
 
@@ -3083,28 +2637,27 @@ func v1_4_createContract(
 	gasToUse = math.MulUint64(metering.GasSchedule().BaseOperationCost.DataCopyPerByte, uint64(actualLen))
 	metering.UseAndTraceGas(gasToUse)
 
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return 1
 	}
 
 	valueAsInt := big.NewInt(0).SetBytes(value)
 	newAddress, err := createContract(sender, data, valueAsInt, metering, gasLimit, code, codeMetadata, host, runtime)
 
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return 1
 	}
 
 	err = runtime.MemStore(resultOffset, newAddress)
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return 1
 	}
 
 	return 0
 }
 
-//export v1_4_deployFromSourceContract
-func v1_4_deployFromSourceContract(
-	context unsafe.Pointer,
+// DeployFromSourceContract VMHooks implementation.
+func (context *ElrondAPI) DeployFromSourceContract(
 	gasLimit int64,
 	valueOffset int32,
 	sourceContractAddressOffset int32,
@@ -3114,7 +2667,7 @@ func v1_4_deployFromSourceContract(
 	argumentsLengthOffset int32,
 	dataOffset int32,
 ) int32 {
-	host := arwen.GetVMHost(context)
+	host := context.GetVMHost()
 	runtime := host.Runtime()
 	metering := host.Metering()
 	metering.StartGasTracing(deployFromSourceContractName)
@@ -3133,7 +2686,7 @@ func v1_4_deployFromSourceContract(
 	}
 
 	codeMetadata, err := runtime.MemLoad(codeMetadataOffset, arwen.CodeMetadataLen)
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return 1
 	}
 
@@ -3160,12 +2713,12 @@ func v1_4_deployFromSourceContract(
 		gasLimit,
 	)
 
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return 1
 	}
 
 	err = runtime.MemStore(resultAddressOffset, newAddress)
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return 1
 	}
 
@@ -3220,10 +2773,10 @@ func createContract(
 	return host.CreateNewContract(contractCreate)
 }
 
-//export v1_4_getNumReturnData
-func v1_4_getNumReturnData(context unsafe.Pointer) int32 {
-	output := arwen.GetOutputContext(context)
-	metering := arwen.GetMeteringContext(context)
+// GetNumReturnData VMHooks implementation.
+func (context *ElrondAPI) GetNumReturnData() int32 {
+	output := context.GetOutputContext()
+	metering := context.GetMeteringContext()
 
 	gasToUse := metering.GasSchedule().ElrondAPICost.GetNumReturnData
 	metering.UseGasAndAddTracedGas(getNumReturnDataName, gasToUse)
@@ -3232,36 +2785,36 @@ func v1_4_getNumReturnData(context unsafe.Pointer) int32 {
 	return int32(len(returnData))
 }
 
-//export v1_4_getReturnDataSize
-func v1_4_getReturnDataSize(context unsafe.Pointer, resultID int32) int32 {
-	runtime := arwen.GetRuntimeContext(context)
-	output := arwen.GetOutputContext(context)
-	metering := arwen.GetMeteringContext(context)
+// GetReturnDataSize VMHooks implementation.
+func (context *ElrondAPI) GetReturnDataSize(resultID int32) int32 {
+	runtime := context.GetRuntimeContext()
+	output := context.GetOutputContext()
+	metering := context.GetMeteringContext()
 
 	gasToUse := metering.GasSchedule().ElrondAPICost.GetReturnDataSize
 	metering.UseGasAndAddTracedGas(getReturnDataSizeName, gasToUse)
 
 	returnData := output.ReturnData()
 	if resultID >= int32(len(returnData)) || resultID < 0 {
-		arwen.WithFaultAndHostIfFailAlwaysActive(arwen.ErrInvalidArgument, arwen.GetVMHost(context), runtime.ElrondAPIErrorShouldFailExecution())
+		arwen.WithFaultAndHostIfFailAlwaysActive(arwen.ErrInvalidArgument, context.GetVMHost(), runtime.ElrondAPIErrorShouldFailExecution())
 		return 0
 	}
 
 	return int32(len(returnData[resultID]))
 }
 
-//export v1_4_getReturnData
-func v1_4_getReturnData(context unsafe.Pointer, resultID int32, dataOffset int32) int32 {
-	host := arwen.GetVMHost(context)
+// GetReturnData VMHooks implementation.
+func (context *ElrondAPI) GetReturnData(resultID int32, dataOffset int32) int32 {
+	host := context.GetVMHost()
 
 	result := GetReturnDataWithHostAndTypedArgs(host, resultID)
 	if result == nil {
 		return 0
 	}
 
-	runtime := arwen.GetRuntimeContext(context)
+	runtime := context.GetRuntimeContext()
 	err := runtime.MemStore(dataOffset, result)
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return 0
 	}
 
@@ -3285,13 +2838,13 @@ func GetReturnDataWithHostAndTypedArgs(host arwen.VMHost, resultID int32) []byte
 	return returnData[resultID]
 }
 
-//export v1_4_cleanReturnData
-func v1_4_cleanReturnData(context unsafe.Pointer) {
-	host := arwen.GetVMHost(context)
+// CleanReturnData VMHooks implementation.
+func (context *ElrondAPI) CleanReturnData() {
+	host := context.GetVMHost()
 	CleanReturnDataWithHost(host)
 }
 
-// CleanReturnDataWithHost - exposed version of v1_4_deleteFromReturnData for tests
+// CleanReturnDataWithHost - exposed version of DeleteFromReturnData for tests
 func CleanReturnDataWithHost(host arwen.VMHost) {
 	output := host.Output()
 	metering := host.Metering()
@@ -3302,13 +2855,13 @@ func CleanReturnDataWithHost(host arwen.VMHost) {
 	output.ClearReturnData()
 }
 
-//export v1_4_deleteFromReturnData
-func v1_4_deleteFromReturnData(context unsafe.Pointer, resultID int32) {
-	host := arwen.GetVMHost(context)
+// DeleteFromReturnData VMHooks implementation.
+func (context *ElrondAPI) DeleteFromReturnData(resultID int32) {
+	host := context.GetVMHost()
 	DeleteFromReturnDataWithHost(host, resultID)
 }
 
-// DeleteFromReturnDataWithHost - exposed version of v1_4_deleteFromReturnData for tests
+// DeleteFromReturnDataWithHost - exposed version of DeleteFromReturnData for tests
 func DeleteFromReturnDataWithHost(host arwen.VMHost, resultID int32) {
 	output := host.Output()
 	metering := host.Metering()
@@ -3322,16 +2875,16 @@ func DeleteFromReturnDataWithHost(host arwen.VMHost, resultID int32) {
 	}
 }
 
-//export v1_4_getOriginalTxHash
-func v1_4_getOriginalTxHash(context unsafe.Pointer, dataOffset int32) {
-	runtime := arwen.GetRuntimeContext(context)
-	metering := arwen.GetMeteringContext(context)
+// GetOriginalTxHash VMHooks implementation.
+func (context *ElrondAPI) GetOriginalTxHash(dataOffset int32) {
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
 
 	gasToUse := metering.GasSchedule().ElrondAPICost.GetOriginalTxHash
 	metering.UseGasAndAddTracedGas(getOriginalTxHashName, gasToUse)
 
 	err := runtime.MemStore(dataOffset, runtime.GetOriginalTxHash())
-	_ = arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution())
+	_ = context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution())
 }
 
 func prepareIndirectContractCallInput(
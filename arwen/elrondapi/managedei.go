@@ -1,48 +1,10 @@
 package elrondapi
 
-// // Declare the function signatures (see [cgo](https://golang.org/cmd/cgo/)).
-//
-// #include <stdlib.h>
-// typedef unsigned char uint8_t;
-// typedef int int32_t;
-//
-// extern void	v1_4_managedSCAddress(void *context, int32_t addressHandle);
-// extern void	v1_4_managedOwnerAddress(void *context, int32_t addressHandle);
-// extern void	v1_4_managedCaller(void *context, int32_t addressHandle);
-// extern void	v1_4_managedSignalError(void* context, int32_t errHandle1);
-// extern void	v1_4_managedWriteLog(void* context, int32_t topicsHandle, int32_t dataHandle);
-//
-// extern int32_t	v1_4_managedMultiTransferESDTNFTExecute(void *context, int32_t dstHandle, int32_t tokenTransfersHandle, long long gasLimit, int32_t functionHandle, int32_t argumentsHandle);
-// extern int32_t	v1_4_managedTransferValueExecute(void *context, int32_t dstHandle, int32_t valueHandle, long long gasLimit, int32_t functionHandle, int32_t argumentsHandle);
-// extern int32_t	v1_4_managedExecuteOnDestContext(void *context, long long gas, int32_t addressHandle, int32_t valueHandle, int32_t functionHandle, int32_t argumentsHandle, int32_t resultHandle);
-// extern int32_t	v1_4_managedExecuteOnDestContextByCaller(void *context, long long gas, int32_t addressHandle, int32_t valueHandle, int32_t functionHandle, int32_t argumentsHandle, int32_t resultHandle);
-// extern int32_t	v1_4_managedExecuteOnSameContext(void *context, long long gas, int32_t addressHandle, int32_t valueHandle, int32_t functionHandle, int32_t argumentsHandle, int32_t resultHandle);
-// extern int32_t	v1_4_managedExecuteReadOnly(void *context, long long gas, int32_t addressHandle, int32_t functionHandle, int32_t argumentsHandle, int32_t resultHandle);
-// extern int32_t	v1_4_managedCreateContract(void *context, long long gas, int32_t valueHandle, int32_t codeHandle, int32_t codeMetadataHandle, int32_t argumentsHandle, int32_t resultAddressHandle, int32_t resultHandle);
-// extern int32_t	v1_4_managedDeployFromSourceContract(void *context, long long gas, int32_t valueHandle, int32_t addressHandle, int32_t codeMetadataHandle, int32_t argumentsHandle, int32_t resultAddressHandle, int32_t resultHandle);
-// extern void		v1_4_managedUpgradeContract(void *context, int32_t dstHandle, long long gas, int32_t valueHandle, int32_t codeHandle, int32_t codeMetadataHandle, int32_t argumentsHandle, int32_t resultHandle);
-// extern void		v1_4_managedUpgradeFromSourceContract(void *context, int32_t dstHandle, long long gas, int32_t valueHandle, int32_t addressHandle, int32_t codeMetadataHandle, int32_t argumentsHandle, int32_t resultHandle);
-// extern void		v1_4_managedAsyncCall(void *context, int32_t dstHandle, int32_t valueHandle, int32_t functionHandle, int32_t argumentsHandle);
-//
-// extern void		v1_4_managedGetMultiESDTCallValue(void *context, int32_t multiCallValueHandle);
-// extern void		v1_4_managedGetESDTBalance(void *context, int32_t addressHandle, int32_t tokenIDHandle, long long nonce, int32_t valueHandle);
-// extern void		v1_4_managedGetESDTTokenData(void *context, int32_t addressHandle, int32_t tokenIDHandle, long long nonce, int32_t valueHandle, int32_t propertiesHandle, int32_t hashHandle, int32_t nameHandle, int32_t attributesHandle, int32_t creatorHandle, int32_t royaltiesHandle, int32_t urisHandle);
-//
-// extern void		v1_4_managedGetReturnData(void *context, int32_t resultID, int32_t resultHandle);
-// extern void		v1_4_managedGetPrevBlockRandomSeed(void *context, int32_t resultHandle);
-// extern void		v1_4_managedGetBlockRandomSeed(void *context, int32_t resultHandle);
-// extern void		v1_4_managedGetStateRootHash(void *context, int32_t resultHandle);
-// extern void		v1_4_managedGetOriginalTxHash(void *context, int32_t resultHandle);
-//
-import "C"
-
 import (
 	"errors"
-	"unsafe"
 
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/math"
-	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/wasmer"
 )
 
 const (
@@ -62,6 +24,8 @@ const (
 	managedUpgradeContractName              = "managedUpgradeContract"
 	managedUpgradeFromSourceContractName    = "managedUpgradeFromSourceContract"
 	managedAsyncCallName                    = "managedAsyncCall"
+	managedCreateAsyncCallName              = "managedCreateAsyncCall"
+	managedGetCallbackClosureName           = "managedGetCallbackClosure"
 	managedGetMultiESDTCallValueName        = "managedGetMultiESDTCallValue"
 	managedGetESDTBalanceName               = "managedGetESDTBalance"
 	managedGetESDTTokenDataName             = "managedGetESDTTokenData"
@@ -72,138 +36,11 @@ const (
 	managedGetOriginalTxHashName            = "managedGetOriginalTxHash"
 )
 
-// ManagedEIImports creates a new wasmer.Imports populated with variants of the API methods that use managed types only.
-func ManagedEIImports(imports *wasmer.Imports) (*wasmer.Imports, error) {
-	imports = imports.Namespace("env")
-
-	imports, err := imports.Append("managedSCAddress", v1_4_managedSCAddress, C.v1_4_managedSCAddress)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("managedOwnerAddress", v1_4_managedOwnerAddress, C.v1_4_managedOwnerAddress)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("managedCaller", v1_4_managedCaller, C.v1_4_managedCaller)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("managedSignalError", v1_4_managedSignalError, C.v1_4_managedSignalError)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("managedWriteLog", v1_4_managedWriteLog, C.v1_4_managedWriteLog)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("managedMultiTransferESDTNFTExecute", v1_4_managedMultiTransferESDTNFTExecute, C.v1_4_managedMultiTransferESDTNFTExecute)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("managedTransferValueExecute", v1_4_managedTransferValueExecute, C.v1_4_managedTransferValueExecute)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("managedExecuteOnDestContext", v1_4_managedExecuteOnDestContext, C.v1_4_managedExecuteOnDestContext)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("managedExecuteOnDestContextByCaller", v1_4_managedExecuteOnDestContextByCaller, C.v1_4_managedExecuteOnDestContextByCaller)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("managedExecuteOnSameContext", v1_4_managedExecuteOnSameContext, C.v1_4_managedExecuteOnSameContext)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("managedExecuteReadOnly", v1_4_managedExecuteReadOnly, C.v1_4_managedExecuteReadOnly)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("managedCreateContract", v1_4_managedCreateContract, C.v1_4_managedCreateContract)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("managedDeployFromSourceContract", v1_4_managedDeployFromSourceContract, C.v1_4_managedDeployFromSourceContract)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("managedUpgradeContract", v1_4_managedUpgradeContract, C.v1_4_managedUpgradeContract)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("managedUpgradeFromSourceContract", v1_4_managedUpgradeFromSourceContract, C.v1_4_managedUpgradeFromSourceContract)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("managedAsyncCall", v1_4_managedAsyncCall, C.v1_4_managedAsyncCall)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("managedGetMultiESDTCallValue", v1_4_managedGetMultiESDTCallValue, C.v1_4_managedGetMultiESDTCallValue)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("managedGetESDTBalance", v1_4_managedGetESDTBalance, C.v1_4_managedGetESDTBalance)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("managedGetESDTTokenData", v1_4_managedGetESDTTokenData, C.v1_4_managedGetESDTTokenData)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("managedGetReturnData", v1_4_managedGetReturnData, C.v1_4_managedGetReturnData)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("managedGetPrevBlockRandomSeed", v1_4_managedGetPrevBlockRandomSeed, C.v1_4_managedGetPrevBlockRandomSeed)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("managedGetBlockRandomSeed", v1_4_managedGetBlockRandomSeed, C.v1_4_managedGetBlockRandomSeed)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("managedGetStateRootHash", v1_4_managedGetStateRootHash, C.v1_4_managedGetStateRootHash)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = imports.Append("managedGetOriginalTxHash", v1_4_managedGetOriginalTxHash, C.v1_4_managedGetOriginalTxHash)
-	if err != nil {
-		return nil, err
-	}
-
-	return imports, nil
-}
-
-//export v1_4_managedSCAddress
-func v1_4_managedSCAddress(context unsafe.Pointer, destinationHandle int32) {
-	managedType := arwen.GetManagedTypesContext(context)
-	runtime := arwen.GetRuntimeContext(context)
-	metering := arwen.GetMeteringContext(context)
+// ManagedSCAddress VMHooks implementation.
+func (context *ElrondAPI) ManagedSCAddress(destinationHandle int32) {
+	managedType := context.GetManagedTypesContext()
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
 
 	gasToUse := metering.GasSchedule().ElrondAPICost.GetSCAddress
 	metering.UseGasAndAddTracedGas(managedSCAddressName, gasToUse)
@@ -213,29 +50,29 @@ func v1_4_managedSCAddress(context unsafe.Pointer, destinationHandle int32) {
 	managedType.SetBytes(destinationHandle, scAddress)
 }
 
-//export v1_4_managedOwnerAddress
-func v1_4_managedOwnerAddress(context unsafe.Pointer, destinationHandle int32) {
-	managedType := arwen.GetManagedTypesContext(context)
-	blockchain := arwen.GetBlockchainContext(context)
-	runtime := arwen.GetRuntimeContext(context)
-	metering := arwen.GetMeteringContext(context)
+// ManagedOwnerAddress VMHooks implementation.
+func (context *ElrondAPI) ManagedOwnerAddress(destinationHandle int32) {
+	managedType := context.GetManagedTypesContext()
+	blockchain := context.GetBlockchainContext()
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
 
 	gasToUse := metering.GasSchedule().ElrondAPICost.GetOwnerAddress
 	metering.UseGasAndAddTracedGas(managedOwnerAddressName, gasToUse)
 
 	owner, err := blockchain.GetOwnerAddress()
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return
 	}
 
 	managedType.SetBytes(destinationHandle, owner)
 }
 
-//export v1_4_managedCaller
-func v1_4_managedCaller(context unsafe.Pointer, destinationHandle int32) {
-	managedType := arwen.GetManagedTypesContext(context)
-	runtime := arwen.GetRuntimeContext(context)
-	metering := arwen.GetMeteringContext(context)
+// ManagedCaller VMHooks implementation.
+func (context *ElrondAPI) ManagedCaller(destinationHandle int32) {
+	managedType := context.GetManagedTypesContext()
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
 
 	gasToUse := metering.GasSchedule().ElrondAPICost.GetCaller
 	metering.UseGasAndAddTracedGas(managedCallerName, gasToUse)
@@ -244,18 +81,18 @@ func v1_4_managedCaller(context unsafe.Pointer, destinationHandle int32) {
 	managedType.SetBytes(destinationHandle, caller)
 }
 
-//export v1_4_managedSignalError
-func v1_4_managedSignalError(context unsafe.Pointer, errHandle int32) {
-	managedType := arwen.GetManagedTypesContext(context)
-	runtime := arwen.GetRuntimeContext(context)
-	metering := arwen.GetMeteringContext(context)
+// ManagedSignalError VMHooks implementation.
+func (context *ElrondAPI) ManagedSignalError(errHandle int32) {
+	managedType := context.GetManagedTypesContext()
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
 	metering.StartGasTracing(managedSignalErrorName)
 
 	gasToUse := metering.GasSchedule().ElrondAPICost.SignalError
 	metering.UseAndTraceGas(gasToUse)
 
 	errBytes, err := managedType.GetBytes(errHandle)
-	if arwen.WithFault(err, context, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
 		return
 	}
 	managedType.ConsumeGasForBytes(errBytes)
@@ -263,32 +100,31 @@ func v1_4_managedSignalError(context unsafe.Pointer, errHandle int32) {
 	gasToUse = metering.GasSchedule().BaseOperationCost.PersistPerByte * uint64(len(errBytes))
 	err = metering.UseGasBounded(gasToUse)
 	if err != nil {
-		_ = arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution())
+		_ = context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution())
 		return
 	}
 
 	runtime.SignalUserError(string(errBytes))
 }
 
-//export v1_4_managedWriteLog
-func v1_4_managedWriteLog(
-	context unsafe.Pointer,
+// ManagedWriteLog VMHooks implementation.
+func (context *ElrondAPI) ManagedWriteLog(
 	topicsHandle int32,
 	dataHandle int32,
 ) {
-	runtime := arwen.GetRuntimeContext(context)
-	output := arwen.GetOutputContext(context)
-	metering := arwen.GetMeteringContext(context)
-	managedType := arwen.GetManagedTypesContext(context)
+	runtime := context.GetRuntimeContext()
+	output := context.GetOutputContext()
+	metering := context.GetMeteringContext()
+	managedType := context.GetManagedTypesContext()
 	metering.StartGasTracing(managedWriteLogName)
 
 	topics, sumOfTopicByteLengths, err := managedType.ReadManagedVecOfManagedBuffers(topicsHandle)
-	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ElrondAPIErrorShouldFailExecution()) {
 		return
 	}
 
 	dataBytes, err := managedType.GetBytes(dataHandle)
-	if arwen.WithFault(err, context, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
 		return
 	}
 	managedType.ConsumeGasForBytes(dataBytes)
@@ -304,11 +140,11 @@ func v1_4_managedWriteLog(
 	output.WriteLog(runtime.GetSCAddress(), topics, dataBytes)
 }
 
-//export v1_4_managedGetOriginalTxHash
-func v1_4_managedGetOriginalTxHash(context unsafe.Pointer, resultHandle int32) {
-	runtime := arwen.GetRuntimeContext(context)
-	metering := arwen.GetMeteringContext(context)
-	managedType := arwen.GetManagedTypesContext(context)
+// ManagedGetOriginalTxHash VMHooks implementation.
+func (context *ElrondAPI) ManagedGetOriginalTxHash(resultHandle int32) {
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
+	managedType := context.GetManagedTypesContext()
 
 	gasToUse := metering.GasSchedule().ElrondAPICost.GetOriginalTxHash
 	metering.UseGasAndAddTracedGas(managedGetOriginalTxHashName, gasToUse)
@@ -316,11 +152,11 @@ func v1_4_managedGetOriginalTxHash(context unsafe.Pointer, resultHandle int32) {
 	managedType.SetBytes(resultHandle, runtime.GetOriginalTxHash())
 }
 
-//export v1_4_managedGetStateRootHash
-func v1_4_managedGetStateRootHash(context unsafe.Pointer, resultHandle int32) {
-	blockchain := arwen.GetBlockchainContext(context)
-	metering := arwen.GetMeteringContext(context)
-	managedType := arwen.GetManagedTypesContext(context)
+// ManagedGetStateRootHash VMHooks implementation.
+func (context *ElrondAPI) ManagedGetStateRootHash(resultHandle int32) {
+	blockchain := context.GetBlockchainContext()
+	metering := context.GetMeteringContext()
+	managedType := context.GetManagedTypesContext()
 
 	gasToUse := metering.GasSchedule().ElrondAPICost.GetStateRootHash
 	metering.UseGasAndAddTracedGas(managedGetStateRootHashName, gasToUse)
@@ -328,11 +164,11 @@ func v1_4_managedGetStateRootHash(context unsafe.Pointer, resultHandle int32) {
 	managedType.SetBytes(resultHandle, blockchain.GetStateRootHash())
 }
 
-//export v1_4_managedGetBlockRandomSeed
-func v1_4_managedGetBlockRandomSeed(context unsafe.Pointer, resultHandle int32) {
-	blockchain := arwen.GetBlockchainContext(context)
-	metering := arwen.GetMeteringContext(context)
-	managedType := arwen.GetManagedTypesContext(context)
+// ManagedGetBlockRandomSeed VMHooks implementation.
+func (context *ElrondAPI) ManagedGetBlockRandomSeed(resultHandle int32) {
+	blockchain := context.GetBlockchainContext()
+	metering := context.GetMeteringContext()
+	managedType := context.GetManagedTypesContext()
 
 	gasToUse := metering.GasSchedule().ElrondAPICost.GetBlockRandomSeed
 	metering.UseGasAndAddTracedGas(managedGetBlockRandomSeedName, gasToUse)
@@ -340,11 +176,11 @@ func v1_4_managedGetBlockRandomSeed(context unsafe.Pointer, resultHandle int32)
 	managedType.SetBytes(resultHandle, blockchain.CurrentRandomSeed())
 }
 
-//export v1_4_managedGetPrevBlockRandomSeed
-func v1_4_managedGetPrevBlockRandomSeed(context unsafe.Pointer, resultHandle int32) {
-	blockchain := arwen.GetBlockchainContext(context)
-	metering := arwen.GetMeteringContext(context)
-	managedType := arwen.GetManagedTypesContext(context)
+// ManagedGetPrevBlockRandomSeed VMHooks implementation.
+func (context *ElrondAPI) ManagedGetPrevBlockRandomSeed(resultHandle int32) {
+	blockchain := context.GetBlockchainContext()
+	metering := context.GetMeteringContext()
+	managedType := context.GetManagedTypesContext()
 
 	gasToUse := metering.GasSchedule().ElrondAPICost.GetBlockRandomSeed
 	metering.UseGasAndAddTracedGas(managedGetPrevBlockRandomSeedName, gasToUse)
@@ -352,30 +188,30 @@ func v1_4_managedGetPrevBlockRandomSeed(context unsafe.Pointer, resultHandle int
 	managedType.SetBytes(resultHandle, blockchain.LastRandomSeed())
 }
 
-//export v1_4_managedGetReturnData
-func v1_4_managedGetReturnData(context unsafe.Pointer, resultID int32, resultHandle int32) {
-	runtime := arwen.GetRuntimeContext(context)
-	output := arwen.GetOutputContext(context)
-	metering := arwen.GetMeteringContext(context)
-	managedType := arwen.GetManagedTypesContext(context)
+// ManagedGetReturnData VMHooks implementation.
+func (context *ElrondAPI) ManagedGetReturnData(resultID int32, resultHandle int32) {
+	runtime := context.GetRuntimeContext()
+	output := context.GetOutputContext()
+	metering := context.GetMeteringContext()
+	managedType := context.GetManagedTypesContext()
 
 	gasToUse := metering.GasSchedule().ElrondAPICost.GetReturnData
 	metering.UseGasAndAddTracedGas(managedGetReturnDataName, gasToUse)
 
 	returnData := output.ReturnData()
 	if resultID >= int32(len(returnData)) || resultID < 0 {
-		_ = arwen.WithFault(arwen.ErrArgOutOfRange, context, runtime.ElrondAPIErrorShouldFailExecution())
+		_ = context.WithFault(arwen.ErrArgOutOfRange, runtime.ElrondAPIErrorShouldFailExecution())
 		return
 	}
 
 	managedType.SetBytes(resultHandle, returnData[resultID])
 }
 
-//export v1_4_managedGetMultiESDTCallValue
-func v1_4_managedGetMultiESDTCallValue(context unsafe.Pointer, multiCallValueHandle int32) {
-	runtime := arwen.GetRuntimeContext(context)
-	metering := arwen.GetMeteringContext(context)
-	managedType := arwen.GetManagedTypesContext(context)
+// ManagedGetMultiESDTCallValue VMHooks implementation.
+func (context *ElrondAPI) ManagedGetMultiESDTCallValue(multiCallValueHandle int32) {
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
+	managedType := context.GetManagedTypesContext()
 
 	gasToUse := metering.GasSchedule().ElrondAPICost.GetCallValue
 	metering.UseGasAndAddTracedGas(managedGetMultiESDTCallValueName, gasToUse)
@@ -387,30 +223,30 @@ func v1_4_managedGetMultiESDTCallValue(context unsafe.Pointer, multiCallValueHan
 	managedType.SetBytes(multiCallValueHandle, multiCallBytes)
 }
 
-//export v1_4_managedGetESDTBalance
-func v1_4_managedGetESDTBalance(context unsafe.Pointer, addressHandle int32, tokenIDHandle int32, nonce int64, valueHandle int32) {
-	runtime := arwen.GetRuntimeContext(context)
-	metering := arwen.GetMeteringContext(context)
-	blockchain := arwen.GetBlockchainContext(context)
-	managedType := arwen.GetManagedTypesContext(context)
+// ManagedGetESDTBalance VMHooks implementation.
+func (context *ElrondAPI) ManagedGetESDTBalance(addressHandle int32, tokenIDHandle int32, nonce int64, valueHandle int32) {
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
+	blockchain := context.GetBlockchainContext()
+	managedType := context.GetManagedTypesContext()
 
 	gasToUse := metering.GasSchedule().ElrondAPICost.GetExternalBalance
 	metering.UseGasAndAddTracedGas(managedGetESDTBalanceName, gasToUse)
 
 	address, err := managedType.GetBytes(addressHandle)
 	if err != nil {
-		_ = arwen.WithFault(arwen.ErrArgOutOfRange, context, runtime.ElrondAPIErrorShouldFailExecution())
+		_ = context.WithFault(arwen.ErrArgOutOfRange, runtime.ElrondAPIErrorShouldFailExecution())
 		return
 	}
 	tokenID, err := managedType.GetBytes(tokenIDHandle)
 	if err != nil {
-		_ = arwen.WithFault(arwen.ErrArgOutOfRange, context, runtime.ElrondAPIErrorShouldFailExecution())
+		_ = context.WithFault(arwen.ErrArgOutOfRange, runtime.ElrondAPIErrorShouldFailExecution())
 		return
 	}
 
 	esdtToken, err := blockchain.GetESDTToken(address, tokenID, uint64(nonce))
 	if err != nil {
-		_ = arwen.WithFault(arwen.ErrArgOutOfRange, context, runtime.ElrondAPIErrorShouldFailExecution())
+		_ = context.WithFault(arwen.ErrArgOutOfRange, runtime.ElrondAPIErrorShouldFailExecution())
 		return
 	}
 
@@ -418,13 +254,13 @@ func v1_4_managedGetESDTBalance(context unsafe.Pointer, addressHandle int32, tok
 	value.Set(esdtToken.Value)
 }
 
-//export v1_4_managedGetESDTTokenData
-func v1_4_managedGetESDTTokenData(context unsafe.Pointer, addressHandle int32, tokenIDHandle int32, nonce int64,
+// ManagedGetESDTTokenData VMHooks implementation.
+func (context *ElrondAPI) ManagedGetESDTTokenData(addressHandle int32, tokenIDHandle int32, nonce int64,
 	valueHandle, propertiesHandle, hashHandle, nameHandle, attributesHandle, creatorHandle, royaltiesHandle, urisHandle int32) {
-	runtime := arwen.GetRuntimeContext(context)
-	metering := arwen.GetMeteringContext(context)
-	blockchain := arwen.GetBlockchainContext(context)
-	managedType := arwen.GetManagedTypesContext(context)
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
+	blockchain := context.GetBlockchainContext()
+	managedType := context.GetManagedTypesContext()
 	metering.StartGasTracing(managedGetESDTTokenDataName)
 
 	gasToUse := metering.GasSchedule().ElrondAPICost.GetExternalBalance
@@ -432,18 +268,18 @@ func v1_4_managedGetESDTTokenData(context unsafe.Pointer, addressHandle int32, t
 
 	address, err := managedType.GetBytes(addressHandle)
 	if err != nil {
-		_ = arwen.WithFault(arwen.ErrArgOutOfRange, context, runtime.ElrondAPIErrorShouldFailExecution())
+		_ = context.WithFault(arwen.ErrArgOutOfRange, runtime.ElrondAPIErrorShouldFailExecution())
 		return
 	}
 	tokenID, err := managedType.GetBytes(tokenIDHandle)
 	if err != nil {
-		_ = arwen.WithFault(arwen.ErrArgOutOfRange, context, runtime.ElrondAPIErrorShouldFailExecution())
+		_ = context.WithFault(arwen.ErrArgOutOfRange, runtime.ElrondAPIErrorShouldFailExecution())
 		return
 	}
 
 	esdtToken, err := blockchain.GetESDTToken(address, tokenID, uint64(nonce))
 	if err != nil {
-		_ = arwen.WithFault(arwen.ErrArgOutOfRange, context, runtime.ElrondAPIErrorShouldFailExecution())
+		_ = context.WithFault(arwen.ErrArgOutOfRange, runtime.ElrondAPIErrorShouldFailExecution())
 		return
 	}
 
@@ -468,14 +304,13 @@ func v1_4_managedGetESDTTokenData(context unsafe.Pointer, addressHandle int32, t
 
 }
 
-//export v1_4_managedAsyncCall
-func v1_4_managedAsyncCall(
-	context unsafe.Pointer,
+// ManagedAsyncCall VMHooks implementation.
+func (context *ElrondAPI) ManagedAsyncCall(
 	destHandle int32,
 	valueHandle int32,
 	functionHandle int32,
 	argumentsHandle int32) {
-	host := arwen.GetVMHost(context)
+	host := context.GetVMHost()
 	ManagedAsyncCallWithHost(
 		host,
 		destHandle,
@@ -525,9 +360,96 @@ func ManagedAsyncCallWithHost(
 	}
 }
 
-//export v1_4_managedUpgradeFromSourceContract
-func v1_4_managedUpgradeFromSourceContract(
-	context unsafe.Pointer,
+// ManagedCreateAsyncCall VMHooks implementation.
+func (context *ElrondAPI) ManagedCreateAsyncCall(
+	asyncContextIdentifierHandle int32,
+	destHandle int32,
+	valueHandle int32,
+	functionHandle int32,
+	argumentsHandle int32,
+	successHandle int32,
+	errorHandle int32,
+	gas int64,
+	extraGasForCallback int64,
+	callbackClosureHandle int32,
+) {
+	host := context.GetVMHost()
+	runtime := host.Runtime()
+	metering := host.Metering()
+	managedType := host.ManagedTypes()
+	metering.StartGasTracing(managedCreateAsyncCallName)
+
+	gasToUse := metering.GasSchedule().ElrondAPICost.AsyncCallStep
+	metering.UseAndTraceGas(gasToUse)
+
+	vmInput, err := readDestinationFunctionArguments(host, destHandle, functionHandle, argumentsHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ElrondAPIErrorShouldFailExecution()) {
+		return
+	}
+
+	data := makeCrossShardCallFromInput(vmInput.function, vmInput.arguments)
+
+	value, err := managedType.GetBigInt(valueHandle)
+	if err != nil {
+		_ = arwen.WithFaultAndHost(host, arwen.ErrArgOutOfRange, runtime.ElrondAPIErrorShouldFailExecution())
+		return
+	}
+
+	asyncContextIdentifier, err := managedType.GetBytes(asyncContextIdentifierHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ElrondAPIErrorShouldFailExecution()) {
+		return
+	}
+
+	successCallback, err := managedType.GetBytes(successHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ElrondAPIErrorShouldFailExecution()) {
+		return
+	}
+
+	errorCallback, err := managedType.GetBytes(errorHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ElrondAPIErrorShouldFailExecution()) {
+		return
+	}
+
+	callbackClosure, err := managedType.GetBytes(callbackClosureHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ElrondAPIErrorShouldFailExecution()) {
+		return
+	}
+
+	err = CreateAsyncCallWithTypedArgs(host,
+		asyncContextIdentifier,
+		vmInput.destination,
+		value.Bytes(),
+		[]byte(data),
+		successCallback,
+		errorCallback,
+		gas,
+		extraGasForCallback,
+		callbackClosure)
+	if errors.Is(err, arwen.ErrNotEnoughGas) {
+		runtime.SetRuntimeBreakpointValue(arwen.BreakpointOutOfGas)
+		return
+	}
+	if arwen.WithFaultAndHost(host, err, runtime.ElrondAPIErrorShouldFailExecution()) {
+		return
+	}
+}
+
+// ManagedGetCallbackClosure VMHooks implementation.
+func (context *ElrondAPI) ManagedGetCallbackClosure(callbackClosureHandle int32) {
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
+	managedType := context.GetManagedTypesContext()
+
+	callbackClosure := runtime.GetCallbackClosure()
+	gasSchedule := metering.GasSchedule()
+	gasToUse := math.AddUint64(gasSchedule.ElrondAPICost.GetArgument, math.MulUint64(gasSchedule.BaseOperationCost.DataCopyPerByte, uint64(len(callbackClosure))))
+	metering.UseGasAndAddTracedGas(managedGetCallbackClosureName, gasToUse)
+
+	managedType.SetBytes(callbackClosureHandle, callbackClosure)
+}
+
+// ManagedUpgradeFromSourceContract VMHooks implementation.
+func (context *ElrondAPI) ManagedUpgradeFromSourceContract(
 	destHandle int32,
 	gas int64,
 	valueHandle int32,
@@ -536,7 +458,7 @@ func v1_4_managedUpgradeFromSourceContract(
 	argumentsHandle int32,
 	resultHandle int32,
 ) {
-	host := arwen.GetVMHost(context)
+	host := context.GetVMHost()
 	runtime := host.Runtime()
 	metering := host.Metering()
 	managedType := host.ManagedTypes()
@@ -574,9 +496,8 @@ func v1_4_managedUpgradeFromSourceContract(
 	setReturnDataIfExists(host, lenReturnData, resultHandle)
 }
 
-//export v1_4_managedUpgradeContract
-func v1_4_managedUpgradeContract(
-	context unsafe.Pointer,
+// ManagedUpgradeContract VMHooks implementation.
+func (context *ElrondAPI) ManagedUpgradeContract(
 	destHandle int32,
 	gas int64,
 	valueHandle int32,
@@ -585,7 +506,7 @@ func v1_4_managedUpgradeContract(
 	argumentsHandle int32,
 	resultHandle int32,
 ) {
-	host := arwen.GetVMHost(context)
+	host := context.GetVMHost()
 	runtime := host.Runtime()
 	metering := host.Metering()
 	managedType := host.ManagedTypes()
@@ -619,9 +540,8 @@ func v1_4_managedUpgradeContract(
 	setReturnDataIfExists(host, lenReturnData, resultHandle)
 }
 
-//export v1_4_managedDeployFromSourceContract
-func v1_4_managedDeployFromSourceContract(
-	context unsafe.Pointer,
+// ManagedDeployFromSourceContract VMHooks implementation.
+func (context *ElrondAPI) ManagedDeployFromSourceContract(
 	gas int64,
 	valueHandle int32,
 	addressHandle int32,
@@ -630,7 +550,7 @@ func v1_4_managedDeployFromSourceContract(
 	resultAddressHandle int32,
 	resultHandle int32,
 ) int32 {
-	host := arwen.GetVMHost(context)
+	host := context.GetVMHost()
 	runtime := host.Runtime()
 	metering := host.Metering()
 	managedType := host.ManagedTypes()
@@ -669,9 +589,8 @@ func v1_4_managedDeployFromSourceContract(
 	return 0
 }
 
-//export v1_4_managedCreateContract
-func v1_4_managedCreateContract(
-	context unsafe.Pointer,
+// ManagedCreateContract VMHooks implementation.
+func (context *ElrondAPI) ManagedCreateContract(
 	gas int64,
 	valueHandle int32,
 	codeHandle int32,
@@ -680,7 +599,7 @@ func v1_4_managedCreateContract(
 	resultAddressHandle int32,
 	resultHandle int32,
 ) int32 {
-	host := arwen.GetVMHost(context)
+	host := context.GetVMHost()
 	runtime := host.Runtime()
 	metering := host.Metering()
 	managedType := host.ManagedTypes()
@@ -738,16 +657,15 @@ func setReturnDataIfExists(
 	}
 }
 
-//export v1_4_managedExecuteReadOnly
-func v1_4_managedExecuteReadOnly(
-	context unsafe.Pointer,
+// ManagedExecuteReadOnly VMHooks implementation.
+func (context *ElrondAPI) ManagedExecuteReadOnly(
 	gas int64,
 	addressHandle int32,
 	functionHandle int32,
 	argumentsHandle int32,
 	resultHandle int32,
 ) int32 {
-	host := arwen.GetVMHost(context)
+	host := context.GetVMHost()
 	metering := host.Metering()
 	metering.StartGasTracing(managedExecuteReadOnlyName)
 
@@ -768,9 +686,8 @@ func v1_4_managedExecuteReadOnly(
 	return returnVal
 }
 
-//export v1_4_managedExecuteOnSameContext
-func v1_4_managedExecuteOnSameContext(
-	context unsafe.Pointer,
+// ManagedExecuteOnSameContext VMHooks implementation.
+func (context *ElrondAPI) ManagedExecuteOnSameContext(
 	gas int64,
 	addressHandle int32,
 	valueHandle int32,
@@ -778,7 +695,7 @@ func v1_4_managedExecuteOnSameContext(
 	argumentsHandle int32,
 	resultHandle int32,
 ) int32 {
-	host := arwen.GetVMHost(context)
+	host := context.GetVMHost()
 	metering := host.Metering()
 	metering.StartGasTracing(managedExecuteOnSameContextName)
 
@@ -800,9 +717,8 @@ func v1_4_managedExecuteOnSameContext(
 	return returnVal
 }
 
-//export v1_4_managedExecuteOnDestContextByCaller
-func v1_4_managedExecuteOnDestContextByCaller(
-	context unsafe.Pointer,
+// ManagedExecuteOnDestContextByCaller VMHooks implementation.
+func (context *ElrondAPI) ManagedExecuteOnDestContextByCaller(
 	gas int64,
 	addressHandle int32,
 	valueHandle int32,
@@ -810,7 +726,7 @@ func v1_4_managedExecuteOnDestContextByCaller(
 	argumentsHandle int32,
 	resultHandle int32,
 ) int32 {
-	host := arwen.GetVMHost(context)
+	host := context.GetVMHost()
 	metering := host.Metering()
 	metering.StartGasTracing(managedExecuteOnDestContextByCallerName)
 
@@ -832,9 +748,8 @@ func v1_4_managedExecuteOnDestContextByCaller(
 	return returnVal
 }
 
-//export v1_4_managedExecuteOnDestContext
-func v1_4_managedExecuteOnDestContext(
-	context unsafe.Pointer,
+// ManagedExecuteOnDestContext VMHooks implementation.
+func (context *ElrondAPI) ManagedExecuteOnDestContext(
 	gas int64,
 	addressHandle int32,
 	valueHandle int32,
@@ -842,7 +757,7 @@ func v1_4_managedExecuteOnDestContext(
 	argumentsHandle int32,
 	resultHandle int32,
 ) int32 {
-	host := arwen.GetVMHost(context)
+	host := context.GetVMHost()
 	metering := host.Metering()
 	metering.StartGasTracing(managedExecuteOnDestContextName)
 
@@ -864,16 +779,15 @@ func v1_4_managedExecuteOnDestContext(
 	return returnVal
 }
 
-//export v1_4_managedMultiTransferESDTNFTExecute
-func v1_4_managedMultiTransferESDTNFTExecute(
-	context unsafe.Pointer,
+// ManagedMultiTransferESDTNFTExecute VMHooks implementation.
+func (context *ElrondAPI) ManagedMultiTransferESDTNFTExecute(
 	dstHandle int32,
 	tokenTransfersHandle int32,
 	gasLimit int64,
 	functionHandle int32,
 	argumentsHandle int32,
 ) int32 {
-	host := arwen.GetVMHost(context)
+	host := context.GetVMHost()
 	managedType := host.ManagedTypes()
 	runtime := host.Runtime()
 	metering := host.Metering()
@@ -899,16 +813,15 @@ func v1_4_managedMultiTransferESDTNFTExecute(
 	)
 }
 
-//export v1_4_managedTransferValueExecute
-func v1_4_managedTransferValueExecute(
-	context unsafe.Pointer,
+// ManagedTransferValueExecute VMHooks implementation.
+func (context *ElrondAPI) ManagedTransferValueExecute(
 	dstHandle int32,
 	valueHandle int32,
 	gasLimit int64,
 	functionHandle int32,
 	argumentsHandle int32,
 ) int32 {
-	host := arwen.GetVMHost(context)
+	host := context.GetVMHost()
 	metering := host.Metering()
 	metering.StartGasTracing(managedTransferValueExecuteName)
 
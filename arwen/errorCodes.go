@@ -0,0 +1,216 @@
+package arwen
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// ErrorCategory tells who is responsible for an error of the VM
+type ErrorCategory string
+
+const (
+	// ErrorCategoryUserError is given when the transaction or the logic of the contract rejected the call,
+	// e.g. an unknown function, an unexpected payment, an invalid argument or an error signalled by the contract
+	ErrorCategoryUserError ErrorCategory = "userError"
+
+	// ErrorCategoryOutOfGas is given when the gas provided to the call was not enough
+	ErrorCategoryOutOfGas ErrorCategory = "outOfGas"
+
+	// ErrorCategoryVMFault is given when the VM or its host failed, independently of the transaction and the contract
+	ErrorCategoryVMFault ErrorCategory = "vmFault"
+
+	// ErrorCategoryInvalidContract is given when the code of the contract cannot be executed, or it misuses the VM API,
+	// e.g. it traps, it accesses memory out of bounds or it uses an unknown handle
+	ErrorCategoryInvalidContract ErrorCategory = "invalidContract"
+)
+
+// ErrorCode is the stable number of an error of the VM. The codes are never renumbered nor reused;
+// the new errors are appended to the catalogue.
+type ErrorCode uint32
+
+// ErrorCodeUnknown is given to the errors which are not in the catalogue, e.g. the errors of the blockchain hooks
+const ErrorCodeUnknown ErrorCode = 0
+
+// ErrorInfo is the entry of an error in the catalogue
+type ErrorInfo struct {
+	Code     ErrorCode
+	Name     string
+	Category ErrorCategory
+	Err      error
+}
+
+var unknownErrorInfo = ErrorInfo{
+	Code:     ErrorCodeUnknown,
+	Name:     "ErrUnknown",
+	Category: ErrorCategoryVMFault,
+}
+
+var errorCatalogue = []ErrorInfo{
+	{1, "ErrReturnCodeNotOk", ErrorCategoryVMFault, ErrReturnCodeNotOk},
+	{2, "ErrInvalidCallOnReadOnlyMode", ErrorCategoryUserError, ErrInvalidCallOnReadOnlyMode},
+	{3, "ErrNotEnoughGas", ErrorCategoryOutOfGas, ErrNotEnoughGas},
+	{4, "ErrUnhandledRuntimeBreakpoint", ErrorCategoryVMFault, ErrUnhandledRuntimeBreakpoint},
+	{5, "ErrSignalError", ErrorCategoryUserError, ErrSignalError},
+	{6, "ErrExecutionFailed", ErrorCategoryInvalidContract, ErrExecutionFailed},
+	{7, "ErrExecutionPanicked", ErrorCategoryVMFault, ErrExecutionPanicked},
+	{8, "ErrExecutionFailedWithTimeout", ErrorCategoryVMFault, ErrExecutionFailedWithTimeout},
+	{9, "ErrMemoryLimit", ErrorCategoryInvalidContract, ErrMemoryLimit},
+	{10, "ErrBadBounds", ErrorCategoryInvalidContract, ErrBadBounds},
+	{11, "ErrBadLowerBounds", ErrorCategoryInvalidContract, ErrBadLowerBounds},
+	{12, "ErrBadUpperBounds", ErrorCategoryInvalidContract, ErrBadUpperBounds},
+	{13, "ErrNegativeLength", ErrorCategoryInvalidContract, ErrNegativeLength},
+	{14, "ErrFailedTransfer", ErrorCategoryUserError, ErrFailedTransfer},
+	{15, "ErrTransferInsufficientFunds", ErrorCategoryUserError, ErrTransferInsufficientFunds},
+	{16, "ErrTransferNegativeValue", ErrorCategoryUserError, ErrTransferNegativeValue},
+	{17, "ErrUpgradeFailed", ErrorCategoryUserError, ErrUpgradeFailed},
+	{18, "ErrInvalidUpgradeArguments", ErrorCategoryUserError, ErrInvalidUpgradeArguments},
+	{19, "ErrInvalidFunction", ErrorCategoryUserError, ErrInvalidFunction},
+	{20, "ErrInitFuncCalledInRun", ErrorCategoryUserError, ErrInitFuncCalledInRun},
+	{21, "ErrCallBackFuncCalledInRun", ErrorCategoryUserError, ErrCallBackFuncCalledInRun},
+	{22, "ErrCallBackFuncNotExpected", ErrorCategoryUserError, ErrCallBackFuncNotExpected},
+	{23, "ErrFuncNotFound", ErrorCategoryUserError, ErrFuncNotFound},
+	{24, "ErrInvalidFunctionName", ErrorCategoryUserError, ErrInvalidFunctionName},
+	{25, "ErrFunctionNonvoidSignature", ErrorCategoryInvalidContract, ErrFunctionNonvoidSignature},
+	{26, "ErrContractInvalid", ErrorCategoryInvalidContract, ErrContractInvalid},
+	{27, "ErrContractNotFound", ErrorCategoryUserError, ErrContractNotFound},
+	{28, "ErrMemoryDeclarationMissing", ErrorCategoryInvalidContract, ErrMemoryDeclarationMissing},
+	{29, "ErrUnsupportedAPILevel", ErrorCategoryInvalidContract, ErrUnsupportedAPILevel},
+	{30, "ErrMaxInstancesReached", ErrorCategoryVMFault, ErrMaxInstancesReached},
+	{31, "ErrStoreElrondReservedKey", ErrorCategoryInvalidContract, ErrStoreElrondReservedKey},
+	{32, "ErrCannotWriteProtectedKey", ErrorCategoryInvalidContract, ErrCannotWriteProtectedKey},
+	{33, "ErrNonPayableFunctionEgld", ErrorCategoryUserError, ErrNonPayableFunctionEgld},
+	{34, "ErrNonPayableFunctionEsdt", ErrorCategoryUserError, ErrNonPayableFunctionEsdt},
+	{35, "ErrArgIndexOutOfRange", ErrorCategoryUserError, ErrArgIndexOutOfRange},
+	{36, "ErrArgOutOfRange", ErrorCategoryUserError, ErrArgOutOfRange},
+	{37, "ErrStorageValueOutOfRange", ErrorCategoryInvalidContract, ErrStorageValueOutOfRange},
+	{38, "ErrDivZero", ErrorCategoryInvalidContract, ErrDivZero},
+	{39, "ErrBitwiseNegative", ErrorCategoryInvalidContract, ErrBitwiseNegative},
+	{40, "ErrShiftNegative", ErrorCategoryInvalidContract, ErrShiftNegative},
+	{41, "ErrAsyncContextDoesNotExist", ErrorCategoryVMFault, ErrAsyncContextDoesNotExist},
+	{42, "ErrInvalidAccount", ErrorCategoryUserError, ErrInvalidAccount},
+	{43, "ErrDeploymentOverExistingAccount", ErrorCategoryUserError, ErrDeploymentOverExistingAccount},
+	{44, "ErrAccountNotPayable", ErrorCategoryUserError, ErrAccountNotPayable},
+	{45, "ErrInvalidPublicKeySize", ErrorCategoryUserError, ErrInvalidPublicKeySize},
+	{46, "ErrNilCallbackFunction", ErrorCategoryVMFault, ErrNilCallbackFunction},
+	{47, "ErrUpgradeNotAllowed", ErrorCategoryUserError, ErrUpgradeNotAllowed},
+	{48, "ErrNilContract", ErrorCategoryVMFault, ErrNilContract},
+	{49, "ErrBuiltinCallOnSameContextDisallowed", ErrorCategoryInvalidContract, ErrBuiltinCallOnSameContextDisallowed},
+	{50, "ErrSyncExecutionNotInSameShard", ErrorCategoryUserError, ErrSyncExecutionNotInSameShard},
+	{51, "ErrInputAndOutputGasDoesNotMatch", ErrorCategoryVMFault, ErrInputAndOutputGasDoesNotMatch},
+	{52, "ErrTransferValueOnESDTCall", ErrorCategoryUserError, ErrTransferValueOnESDTCall},
+	{53, "ErrNoBigIntUnderThisHandle", ErrorCategoryInvalidContract, ErrNoBigIntUnderThisHandle},
+	{54, "ErrLengthOfBufferNotCorrect", ErrorCategoryInvalidContract, ErrLengthOfBufferNotCorrect},
+	{55, "ErrNoEllipticCurveUnderThisHandle", ErrorCategoryInvalidContract, ErrNoEllipticCurveUnderThisHandle},
+	{56, "ErrPointNotOnCurve", ErrorCategoryUserError, ErrPointNotOnCurve},
+	{57, "ErrNoManagedBufferUnderThisHandle", ErrorCategoryInvalidContract, ErrNoManagedBufferUnderThisHandle},
+	{58, "ErrNilHostParameters", ErrorCategoryVMFault, ErrNilHostParameters},
+	{59, "ErrNilESDTTransferParser", ErrorCategoryVMFault, ErrNilESDTTransferParser},
+	{60, "ErrNilBuiltInFunctionsContainer", ErrorCategoryVMFault, ErrNilBuiltInFunctionsContainer},
+	{61, "ErrNilBlockChainHook", ErrorCategoryVMFault, ErrNilBlockChainHook},
+	{62, "ErrTooManyESDTTransfers", ErrorCategoryUserError, ErrTooManyESDTTransfers},
+	{63, "ErrNilEpochNotifier", ErrorCategoryVMFault, ErrNilEpochNotifier},
+	{64, "ErrUnknownWasmBackend", ErrorCategoryVMFault, ErrUnknownWasmBackend},
+	{65, "ErrVMIsClosing", ErrorCategoryVMFault, ErrVMIsClosing},
+	{66, "ErrNilESDTData", ErrorCategoryUserError, ErrNilESDTData},
+	{67, "ErrInvalidArgument", ErrorCategoryUserError, ErrInvalidArgument},
+	{68, "ErrInvalidTokenIndex", ErrorCategoryUserError, ErrInvalidTokenIndex},
+	{69, "ErrInvalidBuiltInFunctionCall", ErrorCategoryInvalidContract, ErrInvalidBuiltInFunctionCall},
+}
+
+var errorInfoByError = makeErrorInfoByError()
+
+func makeErrorInfoByError() map[error]ErrorInfo {
+	infoByError := make(map[error]ErrorInfo, len(errorCatalogue))
+	for _, info := range errorCatalogue {
+		infoByError[info.Err] = info
+	}
+	return infoByError
+}
+
+// ErrorCatalogue returns the entries of all the errors of the VM, ordered by code
+func ErrorCatalogue() []ErrorInfo {
+	catalogue := make([]ErrorInfo, len(errorCatalogue))
+	copy(catalogue, errorCatalogue)
+	return catalogue
+}
+
+// GetErrorInfo returns the catalogue entry of the most specific error of the VM wrapped by the given error,
+// e.g. ErrFuncNotFound rather than ErrInvalidFunction; the errors outside the catalogue get ErrorCodeUnknown
+func GetErrorInfo(err error) ErrorInfo {
+	for ; err != nil; err = errors.Unwrap(err) {
+		info, ok := errorInfoByError[err]
+		if ok {
+			return info
+		}
+	}
+	return unknownErrorInfo
+}
+
+// signalledError carries the message of an error signalled by a contract
+type signalledError struct {
+	message string
+}
+
+// NewSignalledError creates the error for the message signalled by a contract, which is an ErrSignalError
+func NewSignalledError(message string) error {
+	return &signalledError{message: message}
+}
+
+// Error returns the message signalled by the contract
+func (err *signalledError) Error() string {
+	return err.message
+}
+
+// Unwrap returns ErrSignalError
+func (err *signalledError) Unwrap() error {
+	return ErrSignalError
+}
+
+// ErrorDescription is the structured form of an error accumulated during an execution
+type ErrorDescription struct {
+	Code     ErrorCode     `json:"code"`
+	Name     string        `json:"name"`
+	Category ErrorCategory `json:"category"`
+	Message  string        `json:"message"`
+	Info     []string      `json:"info,omitempty"`
+}
+
+// DescribeErrors returns the structured form of the errors accumulated during an execution, oldest first.
+// Unlike the text of a WrappableError, the descriptions leave out the source locations of the errors,
+// which depend on the build of the VM.
+func DescribeErrors(err error) []ErrorDescription {
+	if err == nil {
+		return nil
+	}
+
+	werr, ok := err.(*wrappableError)
+	if !ok {
+		return []ErrorDescription{describeError(err, nil)}
+	}
+
+	descriptions := make([]ErrorDescription, 0, len(werr.errsWithLocation))
+	for _, errWithLocation := range werr.errsWithLocation {
+		descriptions = append(descriptions, describeError(errWithLocation.err, errWithLocation.otherInfo))
+	}
+	return descriptions
+}
+
+func describeError(err error, otherInfo []string) ErrorDescription {
+	info := GetErrorInfo(err)
+	return ErrorDescription{
+		Code:     info.Code,
+		Name:     info.Name,
+		Category: info.Category,
+		Message:  err.Error(),
+		Info:     otherInfo,
+	}
+}
+
+// EncodeErrors encodes the structured form of the errors accumulated during an execution as a JSON array
+func EncodeErrors(err error) ([]byte, error) {
+	descriptions := DescribeErrors(err)
+	if descriptions == nil {
+		descriptions = make([]ErrorDescription, 0)
+	}
+	return json.Marshal(descriptions)
+}
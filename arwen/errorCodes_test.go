@@ -0,0 +1,119 @@
+package arwen
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// declaredErrors returns the names of the errors declared in errors.go
+func declaredErrors(t *testing.T) []string {
+	file, err := parser.ParseFile(token.NewFileSet(), "errors.go", nil, 0)
+	require.Nil(t, err)
+
+	names := make([]string, 0)
+	for _, declaration := range file.Decls {
+		genDecl, ok := declaration.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.VAR {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			for _, name := range spec.(*ast.ValueSpec).Names {
+				names = append(names, name.Name)
+			}
+		}
+	}
+	return names
+}
+
+func TestErrorCatalogue_CoversAllErrors(t *testing.T) {
+	catalogued := make(map[string]bool)
+	for i, info := range ErrorCatalogue() {
+		require.Equal(t, ErrorCode(i+1), info.Code, "the codes are consecutive")
+		require.False(t, catalogued[info.Name], info.Name)
+		require.NotNil(t, info.Err, info.Name)
+		catalogued[info.Name] = true
+	}
+
+	names := declaredErrors(t)
+	require.Len(t, catalogued, len(names))
+	for _, name := range names {
+		require.True(t, catalogued[name], "%s has no error code", name)
+	}
+}
+
+func TestErrorCatalogue_Documented(t *testing.T) {
+	readme, err := ioutil.ReadFile("../README.md")
+	require.Nil(t, err)
+
+	for _, info := range ErrorCatalogue() {
+		row := fmt.Sprintf("| %d | `%s` | %s |", info.Code, info.Name, info.Category)
+		require.True(t, strings.Contains(string(readme), row), "README.md lacks %s", row)
+	}
+}
+
+func TestGetErrorInfo(t *testing.T) {
+	require.Equal(t, ErrorCode(3), GetErrorInfo(ErrNotEnoughGas).Code)
+	require.Equal(t, ErrorCategoryOutOfGas, GetErrorInfo(ErrNotEnoughGas).Category)
+
+	info := GetErrorInfo(ErrFuncNotFound)
+	require.Equal(t, "ErrFuncNotFound", info.Name)
+	require.Equal(t, ErrFuncNotFound, info.Err)
+
+	info = GetErrorInfo(fmt.Errorf("%w: runtime error", ErrExecutionPanicked))
+	require.Equal(t, "ErrExecutionPanicked", info.Name)
+	require.Equal(t, ErrorCategoryVMFault, info.Category)
+
+	info = GetErrorInfo(NewSignalledError("wrong caller"))
+	require.Equal(t, "ErrSignalError", info.Name)
+	require.Equal(t, ErrorCategoryUserError, info.Category)
+
+	info = GetErrorInfo(errors.New("insufficient funds"))
+	require.Equal(t, ErrorCodeUnknown, info.Code)
+	require.Equal(t, ErrorCategoryVMFault, info.Category)
+
+	info = GetErrorInfo(nil)
+	require.Equal(t, ErrorCodeUnknown, info.Code)
+}
+
+func TestDescribeErrors(t *testing.T) {
+	require.Nil(t, DescribeErrors(nil))
+
+	descriptions := DescribeErrors(ErrNotEnoughGas)
+	require.Equal(t, []ErrorDescription{
+		{Code: 3, Name: "ErrNotEnoughGas", Category: ErrorCategoryOutOfGas, Message: "not enough gas"},
+	}, descriptions)
+
+	allErrors := WrapError(NewSignalledError("wrong caller")).
+		WrapWithError(ErrSignalError).
+		WrapWithError(ErrFuncNotFound, "claim")
+	descriptions = DescribeErrors(allErrors)
+	require.Equal(t, []ErrorDescription{
+		{Code: 5, Name: "ErrSignalError", Category: ErrorCategoryUserError, Message: "wrong caller"},
+		{Code: 5, Name: "ErrSignalError", Category: ErrorCategoryUserError, Message: "error signalled by smartcontract"},
+		{Code: 23, Name: "ErrFuncNotFound", Category: ErrorCategoryUserError, Message: "invalid function (not found)", Info: []string{"claim"}},
+	}, descriptions)
+}
+
+func TestEncodeErrors(t *testing.T) {
+	encoded, err := EncodeErrors(nil)
+	require.Nil(t, err)
+	require.Equal(t, "[]", string(encoded))
+
+	encoded, err = EncodeErrors(WrapError(ErrNonPayableFunctionEgld, "deposit"))
+	require.Nil(t, err)
+	require.Equal(t, `[{"code":33,"name":"ErrNonPayableFunctionEgld","category":"userError","message":"function does not accept EGLD payment","info":["deposit"]}]`, string(encoded))
+
+	var descriptions []ErrorDescription
+	err = json.Unmarshal(encoded, &descriptions)
+	require.Nil(t, err)
+	require.Equal(t, ErrorCode(33), descriptions[0].Code)
+}
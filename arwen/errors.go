@@ -89,6 +89,9 @@ var ErrContractNotFound = fmt.Errorf("%w (not found)", ErrContractInvalid)
 // ErrMemoryDeclarationMissing signals that a memory declaration is missing
 var ErrMemoryDeclarationMissing = fmt.Errorf("%w (missing memory declaration)", ErrContractInvalid)
 
+// ErrUnsupportedAPILevel signals that the contract declares an API level which is unknown or not yet enabled
+var ErrUnsupportedAPILevel = fmt.Errorf("%w (unsupported API level)", ErrContractInvalid)
+
 // ErrMaxInstancesReached signals that the max number of Wasmer instances has been reached.
 var ErrMaxInstancesReached = fmt.Errorf("%w (max instances reached)", ErrExecutionFailed)
 
@@ -192,6 +195,9 @@ var ErrTooManyESDTTransfers = errors.New("too many ESDT transfers")
 // ErrNilEpochNotifier signals that epoch notifier is nil
 var ErrNilEpochNotifier = errors.New("nil epoch notifier")
 
+// ErrUnknownWasmBackend signals that the host parameters select an unknown WASM backend
+var ErrUnknownWasmBackend = errors.New("unknown WASM backend")
+
 // ErrVMIsClosing signals that vm is closing
 var ErrVMIsClosing = errors.New("vm is closing")
 
@@ -10,6 +10,7 @@ import (
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto"
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/math"
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/wasmer"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/wasmgo"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 )
 
@@ -139,11 +140,16 @@ type nilInterfaceChecker interface {
 	IsInterfaceNil() bool
 }
 
-// GetVMHost returns the vm Context from the vm context map
+// GetVMHost returns the vm Context from the vm context map; the lookup of the Go backend instances
+// is skipped while there are none, so that a process running only Wasmer goes straight to the instance context
 func GetVMHost(vmHostPtr unsafe.Pointer) VMHost {
-	instCtx := wasmer.IntoInstanceContext(vmHostPtr)
-	var ptr = *(*uintptr)(instCtx.Data())
-	return *(*VMHost)(unsafe.Pointer(ptr))
+	ptr, isGoInstance := wasmgo.ContextData(vmHostPtr)
+	if !isGoInstance {
+		instCtx := wasmer.IntoInstanceContext(vmHostPtr)
+		ptr = *(*uintptr)(instCtx.Data())
+	}
+	// the data is read as a pointer, rather than converted from uintptr, which the checks of the race detector reject
+	return *(*VMHost)(*(*unsafe.Pointer)(unsafe.Pointer(&ptr)))
 }
 
 // GetBlockchainContext returns the blockchain context
@@ -9,12 +9,12 @@ import (
 
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen/contexts"
-	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen/cryptoapi"
-	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen/elrondapi"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen/vmhooks"
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/config"
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto"
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto/factory"
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/wasmer"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/wasmgo"
 	"github.com/ElrondNetwork/elrond-go-core/core/atomic"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
@@ -32,6 +32,8 @@ var _ arwen.VMHost = (*vmHost)(nil)
 const minExecutionTimeout = time.Second
 const internalVMErrors = "internalVMErrors"
 
+const internalVMErrorCodes = "internalVMErrorCodes"
+
 // vmHost implements HostContext interface.
 type vmHost struct {
 	cryptoHook       crypto.VMCrypto
@@ -47,9 +49,11 @@ type vmHost struct {
 	meteringContext     arwen.MeteringContext
 	storageContext      arwen.StorageContext
 	managedTypesContext arwen.ManagedTypesContext
+	vmHooks             arwen.VMHooks
 
 	gasSchedule          config.GasScheduleMap
 	scAPIMethods         *wasmer.Imports
+	goInstanceBuilder    *wasmgo.InstanceBuilder
 	builtInFuncContainer vmcommon.BuiltInFunctionContainer
 	esdtTransferParser   vmcommon.ESDTTransferParser
 
@@ -70,6 +74,15 @@ type vmHost struct {
 
 	useDifferentGasCostForReadingCachedStorageEpoch uint32
 	flagUseDifferentGasCostForCachedStorage         atomic.Flag
+
+	trapBacktraceInLogs bool
+	errorCodesInLogs    bool
+
+	metricsRegistry arwen.MetricsRegistry
+	callMetrics     *executionMetrics
+	createMetrics   *executionMetrics
+
+	stateAccessTracer *arwen.StateAccessTracer
 }
 
 // NewArwenVM creates a new Arwen vmHost
@@ -113,48 +126,38 @@ func NewArwenVM(
 		createNFTThroughExecByCallerEnableEpoch:         hostParameters.CreateNFTThroughExecByCallerEnableEpoch,
 		fixFailExecutionOnErrorEnableEpoch:              hostParameters.FixFailExecutionOnErrorEnableEpoch,
 		useDifferentGasCostForReadingCachedStorageEpoch: hostParameters.UseDifferentGasCostForReadingCachedStorageEpoch,
+		trapBacktraceInLogs:                             hostParameters.TrapBacktraceInLogs,
+		errorCodesInLogs:                                hostParameters.ErrorCodesInLogs,
+		metricsRegistry:                                 hostParameters.MetricsRegistry,
+		callMetrics:                                     newExecutionMetrics(hostParameters.MetricsRegistry, entryPointCall),
+		createMetrics:                                   newExecutionMetrics(hostParameters.MetricsRegistry, entryPointCreate),
+	}
+
+	if hostParameters.StateAccessTracing {
+		host.stateAccessTracer = arwen.NewStateAccessTracer()
 	}
 
 	newExecutionTimeout := time.Duration(hostParameters.TimeOutForSCExecutionInMilliseconds) * time.Millisecond
 	if newExecutionTimeout > minExecutionTimeout {
 		host.executionTimeout = newExecutionTimeout
 	}
-	imports, err := elrondapi.ElrondEIImports()
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = elrondapi.BigIntImports(imports)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = elrondapi.SmallIntImports(imports)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = elrondapi.ManagedEIImports(imports)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = elrondapi.ManagedBufferImports(imports)
-	if err != nil {
-		return nil, err
-	}
-
-	imports, err = cryptoapi.CryptoImports(imports)
+	imports, err := CreateEEIImports()
 	if err != nil {
 		return nil, err
 	}
 
-	err = wasmer.SetImports(imports)
-	if err != nil {
-		return nil, err
+	if hostParameters.WasmBackend == arwen.WasmerBackend {
+		err = wasmer.SetImports(imports)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	host.scAPIMethods = imports
+	host.vmHooks = vmhooks.NewVMHooks(host)
+	if !check.IfNil(hostParameters.MetricsRegistry) {
+		host.vmHooks = vmhooks.NewVMHooksWithMetrics(host.vmHooks, hostParameters.MetricsRegistry)
+	}
 
 	host.blockchainContext, err = contexts.NewBlockchainContext(host, blockChainHook)
 	if err != nil {
@@ -167,6 +170,7 @@ func NewArwenVM(
 		host.builtInFuncContainer,
 		hostParameters.EpochNotifier,
 		hostParameters.UseDifferentGasCostForReadingCachedStorageEpoch,
+		hostParameters.ExtendedEEIEnableEpoch,
 	)
 	if err != nil {
 		return nil, err
@@ -206,11 +210,22 @@ func NewArwenVM(
 	host.runtimeContext.SetMaxInstanceCount(MaximumWasmerInstanceCount)
 
 	opcodeCosts := gasCostConfig.WASMOpcodeCost.ToOpcodeCostsArray()
-	wasmer.SetOpcodeCosts(&opcodeCosts)
-	wasmer.SetRkyvSerializationEnabled(true)
+	switch hostParameters.WasmBackend {
+	case arwen.WasmerBackend:
+		wasmer.SetOpcodeCosts(&opcodeCosts)
+		wasmer.SetRkyvSerializationEnabled(true)
 
-	if hostParameters.WasmerSIGSEGVPassthrough {
-		wasmer.SetSIGSEGVPassthrough()
+		if hostParameters.WasmerSIGSEGVPassthrough {
+			wasmer.SetSIGSEGVPassthrough()
+		}
+	case arwen.GoBackend:
+		host.goInstanceBuilder, err = wasmgo.NewInstanceBuilder(imports, &opcodeCosts)
+		if err != nil {
+			return nil, err
+		}
+		host.runtimeContext.ReplaceInstanceBuilder(host.goInstanceBuilder)
+	default:
+		return nil, arwen.ErrUnknownWasmBackend
 	}
 
 	host.initContexts()
@@ -254,6 +269,21 @@ func (host *vmHost) Storage() arwen.StorageContext {
 	return host.storageContext
 }
 
+// VMHooks returns the functions of the Elrond Environment Interface, running on this host
+func (host *vmHost) VMHooks() arwen.VMHooks {
+	return host.vmHooks
+}
+
+// MetricsRegistry returns the registry of the metrics of the host, or nil if the metrics are disabled
+func (host *vmHost) MetricsRegistry() arwen.MetricsRegistry {
+	return host.metricsRegistry
+}
+
+// StateAccessTracer returns the tracer of the state accessed by the transactions, or nil if the tracing is disabled
+func (host *vmHost) StateAccessTracer() *arwen.StateAccessTracer {
+	return host.stateAccessTracer
+}
+
 // ManagedTypes returns the ManagedTypeContext instance of the host
 func (host *vmHost) ManagedTypes() arwen.ManagedTypesContext {
 	return host.managedTypesContext
@@ -310,6 +340,7 @@ func (host *vmHost) initContexts() {
 	host.meteringContext.InitState()
 	host.runtimeContext.InitState()
 	host.storageContext.InitState()
+	host.stateAccessTracer.InitState()
 	host.ethInput = nil
 }
 
@@ -322,6 +353,11 @@ func (host *vmHost) ClearContextStateStack() {
 	host.storageContext.ClearStateStack()
 }
 
+// CreateEEIImports assembles all the functions of the Elrond Environment Interface as a set of imports for Wasmer
+func CreateEEIImports() (*wasmer.Imports, error) {
+	return vmhooks.WasmerImports()
+}
+
 // GetAPIMethods returns the EEI as a set of imports for Wasmer
 func (host *vmHost) GetAPIMethods() *wasmer.Imports {
 	return host.scAPIMethods
@@ -340,7 +376,11 @@ func (host *vmHost) GasScheduleChange(newGasSchedule config.GasScheduleMap) {
 	}
 
 	opcodeCosts := gasCostConfig.WASMOpcodeCost.ToOpcodeCostsArray()
-	wasmer.SetOpcodeCosts(&opcodeCosts)
+	if host.goInstanceBuilder != nil {
+		host.goInstanceBuilder.SetOpcodeCosts(&opcodeCosts)
+	} else {
+		wasmer.SetOpcodeCosts(&opcodeCosts)
+	}
 
 	host.meteringContext.SetGasSchedule(newGasSchedule)
 	host.runtimeContext.ClearWarmInstanceCache()
@@ -360,6 +400,7 @@ func (host *vmHost) RunSmartContractCreate(input *vmcommon.ContractCreateInput)
 		return nil, arwen.ErrVMIsClosing
 	}
 
+	startTime := time.Now()
 	host.setGasTracerEnabledIfLogIsTrace()
 	ctx, cancel := context.WithTimeout(context.Background(), host.executionTimeout)
 	defer cancel()
@@ -382,10 +423,11 @@ func (host *vmHost) RunSmartContractCreate(input *vmcommon.ContractCreateInput)
 		}()
 
 		vmOutput = host.doRunSmartContractCreate(input)
-		logsFromErrors := host.createLogEntryFromErrors(input.CallerAddr, input.CallerAddr, "_init")
-		if logsFromErrors != nil {
-			vmOutput.Logs = append(vmOutput.Logs, logsFromErrors)
+		if vmOutput.ReturnCode != vmcommon.Ok {
+			host.stateAccessTracer.DiscardWrites()
 		}
+		logsFromErrors := host.createLogEntriesFromErrors(input.CallerAddr, input.CallerAddr, "_init")
+		vmOutput.Logs = append(vmOutput.Logs, logsFromErrors...)
 
 		log.Trace("RunSmartContractCreate end",
 			"returnCode", vmOutput.ReturnCode,
@@ -398,13 +440,17 @@ func (host *vmHost) RunSmartContractCreate(input *vmcommon.ContractCreateInput)
 
 	select {
 	case <-done:
+		host.createMetrics.recordExecution(startTime, input.GasProvided, vmOutput)
 		return
 	case <-ctx.Done():
 		err = arwen.ErrExecutionFailedWithTimeout
 		host.Runtime().FailExecution(err)
 		<-done
+		host.createMetrics.recordTimeout()
+		host.createMetrics.recordExecution(startTime, input.GasProvided, vmOutput)
 	case err = <-errChan:
 		host.Runtime().FailExecution(err)
+		host.createMetrics.recordPanic()
 		panic(err)
 	}
 
@@ -420,6 +466,7 @@ func (host *vmHost) RunSmartContractCall(input *vmcommon.ContractCallInput) (vmO
 		return nil, arwen.ErrVMIsClosing
 	}
 
+	startTime := time.Now()
 	host.setGasTracerEnabledIfLogIsTrace()
 	ctx, cancel := context.WithTimeout(context.Background(), host.executionTimeout)
 	defer cancel()
@@ -446,12 +493,13 @@ func (host *vmHost) RunSmartContractCall(input *vmcommon.ContractCallInput) (vmO
 		} else {
 			vmOutput = host.doRunSmartContractCall(input)
 		}
-
-		logsFromErrors := host.createLogEntryFromErrors(input.CallerAddr, input.RecipientAddr, input.Function)
-		if logsFromErrors != nil {
-			vmOutput.Logs = append(vmOutput.Logs, logsFromErrors)
+		if vmOutput.ReturnCode != vmcommon.Ok {
+			host.stateAccessTracer.DiscardWrites()
 		}
 
+		logsFromErrors := host.createLogEntriesFromErrors(input.CallerAddr, input.RecipientAddr, input.Function)
+		vmOutput.Logs = append(vmOutput.Logs, logsFromErrors...)
+
 		log.Trace("RunSmartContractCall end",
 			"function", input.Function,
 			"returnCode", vmOutput.ReturnCode,
@@ -465,6 +513,7 @@ func (host *vmHost) RunSmartContractCall(input *vmcommon.ContractCallInput) (vmO
 	select {
 	case <-done:
 		// Normal termination.
+		host.callMetrics.recordExecution(startTime, input.GasProvided, vmOutput)
 		return
 	case <-ctx.Done():
 		// Terminated due to timeout. The VM sets the `ExecutionFailed` breakpoint
@@ -475,31 +524,55 @@ func (host *vmHost) RunSmartContractCall(input *vmcommon.ContractCallInput) (vmO
 		err = arwen.ErrExecutionFailedWithTimeout
 		host.Runtime().FailExecution(err)
 		<-done
+		host.callMetrics.recordTimeout()
+		host.callMetrics.recordExecution(startTime, input.GasProvided, vmOutput)
 	case err = <-errChan:
 		// Terminated due to a panic outside of the SC, namely either in Wasmer, in the
 		// VM, in the EEI or in the blockchain hooks. The `done` channel is not
 		// read again, because the call to `close(done)` will not happen anymore.
 		host.Runtime().FailExecution(err)
+		host.callMetrics.recordPanic()
 		panic(err)
 	}
 
 	return
 }
 
-func (host *vmHost) createLogEntryFromErrors(sndAddress, rcvAddress []byte, function string) *vmcommon.LogEntry {
+func (host *vmHost) createLogEntriesFromErrors(sndAddress, rcvAddress []byte, function string) []*vmcommon.LogEntry {
 	formattedErrors := host.runtimeContext.GetAllErrors()
 	if formattedErrors == nil {
 		return nil
 	}
 
+	logData := formattedErrors.Error()
+	backtrace := host.runtimeContext.GetTrapBacktrace()
+	if host.trapBacktraceInLogs && backtrace != nil {
+		logData += "\n" + backtrace.String()
+	}
+
 	logFromError := &vmcommon.LogEntry{
 		Identifier: []byte(internalVMErrors),
 		Address:    sndAddress,
 		Topics:     [][]byte{rcvAddress, []byte(function)},
-		Data:       []byte(formattedErrors.Error()),
+		Data:       []byte(logData),
+	}
+	if !host.errorCodesInLogs {
+		return []*vmcommon.LogEntry{logFromError}
 	}
 
-	return logFromError
+	encodedErrors, err := arwen.EncodeErrors(formattedErrors)
+	if err != nil {
+		log.Error("cannot encode the error codes", "error", err)
+		return []*vmcommon.LogEntry{logFromError}
+	}
+
+	logFromErrorCodes := &vmcommon.LogEntry{
+		Identifier: []byte(internalVMErrorCodes),
+		Address:    sndAddress,
+		Topics:     [][]byte{rcvAddress, []byte(function)},
+		Data:       encodedErrors,
+	}
+	return []*vmcommon.LogEntry{logFromError, logFromErrorCodes}
 }
 
 // AreInSameShard returns true if the provided addresses are part of the same shard
@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"math/big"
+	"sort"
 
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/math"
@@ -136,14 +137,14 @@ func (host *vmHost) determineDestinationForAsyncCall(asyncCallInfo arwen.AsyncCa
 	return parsedTransfer.RcvAddr
 }
 
-func (host *vmHost) determineAsyncCallExecutionMode(asyncCallInfo *arwen.AsyncCallInfo) (arwen.AsyncCallExecutionMode, error) {
+func (host *vmHost) determineAsyncCallExecutionMode(asyncCallInfo arwen.AsyncCallInfoHandler) (arwen.AsyncCallExecutionMode, error) {
 	runtime := host.Runtime()
 	blockchain := host.Blockchain()
 
 	// If ArgParser cannot read the Data field, then this is neither a SC call,
 	// nor a built-in function call.
 	argParser := parsers.NewCallArgsParser()
-	functionName, args, err := argParser.ParseData(string(asyncCallInfo.Data))
+	functionName, args, err := argParser.ParseData(string(asyncCallInfo.GetData()))
 	if err != nil {
 		return arwen.AsyncUnknown, err
 	}
@@ -247,14 +248,63 @@ func (host *vmHost) executeSyncCallbackCall(
 }
 
 func (host *vmHost) canExecuteSynchronously(destination []byte, _ []byte) bool {
-	// TODO replace this function in promise-related code below.
 	blockchain := host.Blockchain()
 	calledSCCode, err := blockchain.GetCode(destination)
 
 	return len(calledSCCode) > 0 && err == nil
 }
 
+// canExecuteAsyncCallSynchronously decides like handleAsyncCallBreakpoint whether an async call created by the
+// promises API runs in this shard, with its callback, or is sent to the shard of its destination
+func (host *vmHost) canExecuteAsyncCallSynchronously(asyncCall *arwen.AsyncGeneratedCall) bool {
+	execMode, err := host.determineAsyncCallExecutionMode(asyncCall)
+	if err != nil {
+		return false
+	}
+
+	return execMode == arwen.SyncCall ||
+		execMode == arwen.AsyncBuiltinFuncIntraShard ||
+		execMode == arwen.ESDTTransferOnCallBack
+}
+
 func (host *vmHost) sendAsyncCallToDestination(asyncCallInfo arwen.AsyncCallInfoHandler) error {
+	err := host.transferAsyncCallToDestination(asyncCallInfo)
+	if err != nil {
+		return err
+	}
+
+	metering := host.Metering()
+	gasLeft := metering.GasLeft()
+	metering.UseGas(gasLeft)
+	return nil
+}
+
+// sendAsyncGeneratedCallToDestination sends an async call created by the promises API to the shard of its
+// destination. Unlike sendAsyncCallToDestination, only the gas limit of the call is used, leaving the rest to
+// the other calls. Cross-shard built-in functions are executed in this shard too, as for the asyncCall.
+func (host *vmHost) sendAsyncGeneratedCallToDestination(asyncCall *arwen.AsyncGeneratedCall) error {
+	execMode, err := host.determineAsyncCallExecutionMode(asyncCall)
+	if err == nil && execMode == arwen.AsyncBuiltinFuncCrossShard {
+		destinationCallInput, err := host.createDestinationContractCallInput(asyncCall)
+		if err != nil {
+			return err
+		}
+
+		destinationCallInput.GasProvided = asyncCall.GasLimit
+		_, _, err = host.ExecuteOnDestContext(destinationCallInput)
+		return err
+	}
+
+	err = host.transferAsyncCallToDestination(asyncCall)
+	if err != nil {
+		return err
+	}
+
+	host.Metering().UseGas(asyncCall.GasLimit)
+	return nil
+}
+
+func (host *vmHost) transferAsyncCallToDestination(asyncCallInfo arwen.AsyncCallInfoHandler) error {
 	runtime := host.Runtime()
 	output := host.Output()
 
@@ -274,9 +324,6 @@ func (host *vmHost) sendAsyncCallToDestination(asyncCallInfo arwen.AsyncCallInfo
 		return err
 	}
 
-	metering := host.Metering()
-	gasLeft := metering.GasLeft()
-	metering.UseGas(gasLeft)
 	return nil
 }
 
@@ -338,6 +385,8 @@ func (host *vmHost) sendStorageCallbackToDestination(callerAddress, returnData [
 		return err
 	}
 
+	gasLeft := metering.GasLeft()
+	metering.UseGas(gasLeft)
 	return nil
 }
 
@@ -537,22 +586,28 @@ func (host *vmHost) computeDataLengthFromArguments(function string, arguments []
  *  Given the fact that the generated async calls that remain pending will be saved on storage, the processing is
  *  done in two steps in order to correctly use all remaining gas. We first split the gas as specified by the developer,
  *  then we save the storage, then we split again the gas to calls that leave this shard.
+ *  The async contexts are processed in the order of their identifiers, so that the execution is deterministic.
  *
  * returns a list of pending calls (the ones that should be processed on other hosts)
  */
 func (host *vmHost) processAsyncInfo(asyncInfo *arwen.AsyncContextInfo) (*arwen.AsyncContextInfo, error) {
-	if len(asyncInfo.AsyncContextMap) == 0 {
+	if asyncInfo == nil || len(asyncInfo.AsyncContextMap) == 0 {
 		return asyncInfo, nil
 	}
 
-	err := host.setupAsyncCallsGas(asyncInfo)
+	pendingMapInfo := host.getPendingAsyncCalls(asyncInfo)
+	if len(pendingMapInfo.AsyncContextMap) == 0 {
+		return pendingMapInfo, nil
+	}
+
+	err := host.setupAsyncCallsGas(pendingMapInfo)
 	if err != nil {
 		return nil, err
 	}
 
-	for _, asyncContext := range asyncInfo.AsyncContextMap {
-		for _, asyncCall := range asyncContext.AsyncCalls {
-			if !host.canExecuteSynchronously(asyncCall.Destination, asyncCall.Data) {
+	for _, contextIdentifier := range sortedAsyncContextIdentifiers(pendingMapInfo) {
+		for _, asyncCall := range pendingMapInfo.AsyncContextMap[contextIdentifier].AsyncCalls {
+			if !host.canExecuteAsyncCallSynchronously(asyncCall) {
 				continue
 			}
 
@@ -563,7 +618,7 @@ func (host *vmHost) processAsyncInfo(asyncInfo *arwen.AsyncContextInfo) (*arwen.
 		}
 	}
 
-	pendingMapInfo := host.getPendingAsyncCalls(asyncInfo)
+	pendingMapInfo = host.getPendingAsyncCalls(asyncInfo)
 	if len(pendingMapInfo.AsyncContextMap) == 0 {
 		return pendingMapInfo, nil
 	}
@@ -578,10 +633,10 @@ func (host *vmHost) processAsyncInfo(asyncInfo *arwen.AsyncContextInfo) (*arwen.
 		return nil, err
 	}
 
-	for _, asyncContext := range pendingMapInfo.AsyncContextMap {
-		for _, asyncCall := range asyncContext.AsyncCalls {
-			if !host.canExecuteSynchronously(asyncCall.Destination, asyncCall.Data) {
-				sendErr := host.sendAsyncCallToDestination(asyncCall)
+	for _, contextIdentifier := range sortedAsyncContextIdentifiers(pendingMapInfo) {
+		for _, asyncCall := range pendingMapInfo.AsyncContextMap[contextIdentifier].AsyncCalls {
+			if !host.canExecuteAsyncCallSynchronously(asyncCall) {
+				sendErr := host.sendAsyncGeneratedCallToDestination(asyncCall)
 				if sendErr != nil {
 					return nil, sendErr
 				}
@@ -592,30 +647,51 @@ func (host *vmHost) processAsyncInfo(asyncInfo *arwen.AsyncContextInfo) (*arwen.
 	return pendingMapInfo, nil
 }
 
+func sortedAsyncContextIdentifiers(asyncInfo *arwen.AsyncContextInfo) []string {
+	identifiers := make([]string, 0, len(asyncInfo.AsyncContextMap))
+	for identifier := range asyncInfo.AsyncContextMap {
+		identifiers = append(identifiers, identifier)
+	}
+	sort.Strings(identifiers)
+	return identifiers
+}
+
 /**
- * processAsyncCall executes an async call and processes the callback if no extra calls are pending
+ * processAsyncCall executes an async call with its gas limit and processes the callback if no extra calls are pending
  */
 func (host *vmHost) processAsyncCall(asyncCall *arwen.AsyncGeneratedCall) error {
-	input, _ := host.createDestinationContractCallInput(asyncCall)
+	input, err := host.createDestinationContractCallInput(asyncCall)
+	if err != nil {
+		return err
+	}
+
+	input.GasProvided = asyncCall.GasLimit
 	output, asyncMap, executionError := host.ExecuteOnDestContext(input)
 
-	pendingMap := host.getPendingAsyncCalls(asyncMap)
-	if len(pendingMap.AsyncContextMap) == 0 {
-		return host.callbackAsync(asyncCall, output, executionError)
+	if asyncMap != nil {
+		pendingMap := host.getPendingAsyncCalls(asyncMap)
+		if len(pendingMap.AsyncContextMap) > 0 {
+			return executionError
+		}
 	}
 
-	return executionError
+	return host.callbackAsync(asyncCall, output, executionError)
 }
 
 /**
- * callbackAsync will execute a callback from an async call that was ran on this host and set it's status to resolved or rejected
+ * callbackAsync will execute a callback from an async call that was ran on this host and set it's status to resolved or rejected.
+ *  The callback receives the gas locked for it when the async call was created, and the callback closure of the call.
  */
 func (host *vmHost) callbackAsync(asyncCall *arwen.AsyncGeneratedCall, vmOutput *vmcommon.VMOutput, executionError error) error {
 	asyncCall.Status = arwen.AsyncCallResolved
-	callbackFunction := asyncCall.SuccessCallback
 	if vmOutput.ReturnCode != vmcommon.Ok {
 		asyncCall.Status = arwen.AsyncCallRejected
-		callbackFunction = asyncCall.ErrorCallback
+	}
+
+	callbackFunction := asyncCall.GetCallbackName(vmOutput.ReturnCode)
+	if len(callbackFunction) == 0 {
+		host.Metering().RestoreGas(asyncCall.GetGasLocked())
+		return nil
 	}
 
 	callbackCallInput, err := host.createCallbackContractCallInput(
@@ -629,8 +705,11 @@ func (host *vmHost) callbackAsync(asyncCall *arwen.AsyncGeneratedCall, vmOutput
 		return err
 	}
 
+	// Restore gas locked while still on the caller instance, as in executeSyncCallbackCall
+	host.Metering().RestoreGas(asyncCall.GetGasLocked())
+
 	// Callback omits for now any async call - TODO: take into consideration async calls generated from callbacks
-	callbackVMOutput, _, callBackErr := host.ExecuteOnDestContext(callbackCallInput)
+	callbackVMOutput, _, callBackErr := host.executeOnDestContext(callbackCallInput, asyncCall.CallbackClosure)
 	err = host.processCallbackVMOutput(callbackVMOutput, callBackErr, vmOutput.ReturnCode, false)
 	if err != nil {
 		return err
@@ -676,7 +755,7 @@ func (host *vmHost) saveCrossShardCalls(asyncInfo *arwen.AsyncContextInfo) error
 
 	for contextIdentifier, asyncContext := range asyncInfo.AsyncContextMap {
 		for _, asyncCall := range asyncContext.AsyncCalls {
-			if !host.canExecuteSynchronously(asyncCall.Destination, asyncCall.Data) {
+			if !host.canExecuteAsyncCallSynchronously(asyncCall) {
 				_, ok := crossMap.AsyncContextMap[contextIdentifier]
 				if !ok {
 					crossMap.AsyncContextMap[contextIdentifier] = &arwen.AsyncContext{
@@ -806,9 +885,8 @@ func (host *vmHost) processCallbackStack() error {
 	}
 
 	// The caller is in the same shard, execute it's callback
-	// TODO nil pointer exception warning: must refactor
 	callbackCallInput, err := host.createCallbackContractCallInput(
-		nil,
+		&arwen.AsyncCallInfo{},
 		host.Output().GetVMOutput(),
 		asyncInfo.CallerAddr,
 		arwen.CallbackFunctionName,
@@ -893,11 +971,18 @@ func (host *vmHost) getFunctionByCallType(callType vm.CallType) (wasmer.Exported
 	vmInput := runtime.GetVMInput()
 
 	customCallback := false
-	for _, asyncContext := range asyncInfo.AsyncContextMap {
-		for _, asyncCall := range asyncContext.AsyncCalls {
+	for _, contextIdentifier := range sortedAsyncContextIdentifiers(asyncInfo) {
+		for _, asyncCall := range asyncInfo.AsyncContextMap[contextIdentifier].AsyncCalls {
 			if bytes.Equal(vmInput.CallerAddr, asyncCall.Destination) {
+				callbackFunction := asyncCall.GetCallbackName(returnCodeOfCallback(vmInput.Arguments))
+				if len(callbackFunction) == 0 {
+					log.Trace("get function by call type", "error", arwen.ErrNilCallbackFunction)
+					return nil, arwen.ErrNilCallbackFunction
+				}
+
 				customCallback = true
-				runtime.SetCustomCallFunction(asyncCall.SuccessCallback)
+				runtime.SetCustomCallFunction(callbackFunction)
+				runtime.SetCallbackClosure(asyncCall.CallbackClosure)
 				break
 			}
 		}
@@ -916,6 +1001,26 @@ func (host *vmHost) getFunctionByCallType(callType vm.CallType) (wasmer.Exported
 	return function, nil
 }
 
+// returnCodeOfCallback reads the return code of the async call from the first argument of its callback,
+// which is the name of the return code when sent by another shard, and its number otherwise
+func returnCodeOfCallback(arguments [][]byte) vmcommon.ReturnCode {
+	if len(arguments) == 0 {
+		return vmcommon.Ok
+	}
+
+	returnCode := arguments[0]
+	if string(returnCode) == vmcommon.Ok.String() {
+		return vmcommon.Ok
+	}
+
+	code := big.NewInt(0).SetBytes(returnCode)
+	if !code.IsUint64() {
+		return vmcommon.ExecutionFailed
+	}
+
+	return vmcommon.ReturnCode(code.Uint64())
+}
+
 func (host *vmHost) getCurrentAsyncInfo() (*arwen.AsyncContextInfo, error) {
 	runtime := host.Runtime()
 	storage := host.Storage()
@@ -19,9 +19,19 @@ func (host *vmHost) handleBreakpointIfAny(executionErr error) error {
 	}
 
 	log.Trace("wasmer execution error", "err", executionErr)
+	host.recordTrap(executionErr)
 	return arwen.ErrExecutionFailed
 }
 
+// recordTrap keeps the call stack of a contract that trapped for the tools and the logs; the ErrExecutionFailed
+// returned for the trap is added to the errors by the caller, like any other execution error
+func (host *vmHost) recordTrap(executionErr error) {
+	runtime := host.Runtime()
+	backtrace := arwen.NewTrapBacktrace(runtime.GetSCAddress(), executionErr)
+	runtime.SetTrapBacktrace(backtrace)
+	log.Trace("contract trapped", "backtrace", backtrace.String())
+}
+
 func (host *vmHost) handleBreakpoint(breakpointValue arwen.BreakpointValue) error {
 	if breakpointValue == arwen.BreakpointAsyncCall {
 		return host.handleAsyncCallBreakpoint()
@@ -215,6 +215,12 @@ func copyTxHashesFromContext(runtime arwen.RuntimeContext, input *vmcommon.Contr
 // ExecuteOnDestContext pushes each context to the corresponding stack
 // and initializes new contexts for executing the contract call with the given input
 func (host *vmHost) ExecuteOnDestContext(input *vmcommon.ContractCallInput) (vmOutput *vmcommon.VMOutput, asyncInfo *arwen.AsyncContextInfo, err error) {
+	return host.executeOnDestContext(input, nil)
+}
+
+// executeOnDestContext executes the contract call on its own runtime context; the callback
+// closure is given to the contract when the call is the callback of an async call.
+func (host *vmHost) executeOnDestContext(input *vmcommon.ContractCallInput, callbackClosure []byte) (vmOutput *vmcommon.VMOutput, asyncInfo *arwen.AsyncContextInfo, err error) {
 	log.Trace("ExecuteOnDestContext", "caller", input.CallerAddr, "dest", input.RecipientAddr, "function", input.Function)
 
 	scExecutionInput := input
@@ -233,7 +239,7 @@ func (host *vmHost) ExecuteOnDestContext(input *vmcommon.ContractCallInput) (vmO
 	}
 
 	if scExecutionInput != nil {
-		vmOutput, asyncInfo, err = host.executeOnDestContextNoBuiltinFunction(scExecutionInput)
+		vmOutput, asyncInfo, err = host.executeOnDestContextNoBuiltinFunction(scExecutionInput, callbackClosure)
 	}
 
 	if err != nil {
@@ -258,7 +264,7 @@ func (host *vmHost) handleBuiltinFunctionCall(input *vmcommon.ContractCallInput)
 	return postBuiltinInput, builtinOutput, nil
 }
 
-func (host *vmHost) executeOnDestContextNoBuiltinFunction(input *vmcommon.ContractCallInput) (vmOutput *vmcommon.VMOutput, asyncInfo *arwen.AsyncContextInfo, err error) {
+func (host *vmHost) executeOnDestContextNoBuiltinFunction(input *vmcommon.ContractCallInput, callbackClosure []byte) (vmOutput *vmcommon.VMOutput, asyncInfo *arwen.AsyncContextInfo, err error) {
 	managedTypes, _, metering, output, runtime, storage := host.GetContexts()
 	managedTypes.PushState()
 	managedTypes.InitState()
@@ -269,6 +275,7 @@ func (host *vmHost) executeOnDestContextNoBuiltinFunction(input *vmcommon.Contra
 	copyTxHashesFromContext(runtime, input)
 	runtime.PushState()
 	runtime.InitStateFromContractCallInput(input)
+	runtime.SetCallbackClosure(callbackClosure)
 
 	metering.PushState()
 	metering.InitStateFromContractCallInput(&input.VMInput)
@@ -709,6 +716,8 @@ func (host *vmHost) ExecuteESDTTransfer(destination []byte, sender []byte, trans
 		return vmOutput, esdtTransferInput.GasProvided, arwen.ErrExecutionFailed
 	}
 
+	host.recordBuiltinFunctionStateAccess(esdtTransferInput, vmOutput)
+
 	gasConsumed := math.SubUint64(esdtTransferInput.GasProvided, vmOutput.GasRemaining)
 	for _, outAcc := range vmOutput.OutputAccounts {
 		for _, transfer := range outAcc.OutputTransfers {
@@ -748,12 +757,47 @@ func (host *vmHost) callBuiltinFunction(input *vmcommon.ContractCallInput) (*vmc
 	}
 
 	metering.TrackGasUsedByBuiltinFunction(input, vmOutput, newVMInput)
+	host.recordBuiltinFunctionStateAccess(input, vmOutput)
 
 	host.addESDTTransferToVMOutputSCIntraShardCall(input, vmOutput)
 
 	return newVMInput, vmOutput, nil
 }
 
+// recordBuiltinFunctionStateAccess records the state accessed by a built-in function, which the node
+// executes directly on its accounts: the ESDT data of the sender and receiver of an ESDT transfer,
+// and the storage and balances changed in the output of the function
+func (host *vmHost) recordBuiltinFunctionStateAccess(input *vmcommon.ContractCallInput, vmOutput *vmcommon.VMOutput) {
+	stateAccess := host.stateAccessTracer
+	if stateAccess == nil || vmOutput.ReturnCode != vmcommon.Ok {
+		return
+	}
+
+	parsedTransfer, err := host.esdtTransferParser.ParseESDTTransfers(input.CallerAddr, input.RecipientAddr, input.Function, input.Arguments)
+	if err == nil {
+		for _, transfer := range parsedTransfer.ESDTTransfers {
+			key := arwen.ESDTStorageKey(transfer.ESDTTokenName, transfer.ESDTTokenNonce)
+			for _, address := range [][]byte{input.CallerAddr, parsedTransfer.RcvAddr} {
+				stateAccess.RecordStorageRead(address, key)
+				stateAccess.RecordStorageWrite(address, key)
+			}
+		}
+	}
+
+	for _, outAcc := range vmOutput.OutputAccounts {
+		for _, storageUpdate := range outAcc.StorageUpdates {
+			stateAccess.RecordStorageRead(outAcc.Address, storageUpdate.Offset)
+			if storageUpdate.Written {
+				stateAccess.RecordStorageWrite(outAcc.Address, storageUpdate.Offset)
+			}
+		}
+		if outAcc.BalanceDelta != nil && outAcc.BalanceDelta.Sign() != 0 {
+			stateAccess.RecordRead(outAcc.Address, arwen.AccountBalance)
+			stateAccess.RecordWrite(outAcc.Address, arwen.AccountBalance)
+		}
+	}
+}
+
 // add output transfer of esdt transfer when sc calling another sc intra shard to log the transfer information
 func (host *vmHost) addESDTTransferToVMOutputSCIntraShardCall(
 	input *vmcommon.ContractCallInput,
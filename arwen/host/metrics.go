@@ -0,0 +1,72 @@
+package host
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+const (
+	entryPointCall   = "RunSmartContractCall"
+	entryPointCreate = "RunSmartContractCreate"
+)
+
+// executionMetrics records the executions through an entry point of the host; a nil executionMetrics records nothing
+type executionMetrics struct {
+	registry        arwen.MetricsRegistry
+	entryPointLabel arwen.MetricLabel
+	duration        arwen.MetricHistogram
+	gasUsed         arwen.MetricCounter
+	timeouts        arwen.MetricCounter
+	panics          arwen.MetricCounter
+}
+
+func newExecutionMetrics(registry arwen.MetricsRegistry, entryPoint string) *executionMetrics {
+	if check.IfNil(registry) {
+		return nil
+	}
+
+	entryPointLabel := arwen.MetricLabel{Name: arwen.MetricLabelEntryPoint, Value: entryPoint}
+	return &executionMetrics{
+		registry:        registry,
+		entryPointLabel: entryPointLabel,
+		duration:        registry.Histogram(arwen.MetricExecutionDuration, arwen.MetricExecutionDurationHelp, arwen.MetricDurationBuckets, entryPointLabel),
+		gasUsed:         registry.Counter(arwen.MetricGasUsed, arwen.MetricGasUsedHelp, entryPointLabel),
+		timeouts:        registry.Counter(arwen.MetricExecutionTimeouts, arwen.MetricExecutionTimeoutsHelp, entryPointLabel),
+		panics:          registry.Counter(arwen.MetricExecutionPanics, arwen.MetricExecutionPanicsHelp, entryPointLabel),
+	}
+}
+
+// recordExecution records an execution which produced an output, including those which timed out
+func (metrics *executionMetrics) recordExecution(startTime time.Time, gasProvided uint64, vmOutput *vmcommon.VMOutput) {
+	if metrics == nil || vmOutput == nil {
+		return
+	}
+
+	metrics.duration.Observe(time.Since(startTime).Seconds())
+	metrics.registry.Counter(
+		arwen.MetricExecutions,
+		arwen.MetricExecutionsHelp,
+		metrics.entryPointLabel,
+		arwen.MetricLabel{Name: arwen.MetricLabelReturnCode, Value: vmOutput.ReturnCode.String()},
+	).Add(1)
+	if gasProvided > vmOutput.GasRemaining {
+		metrics.gasUsed.Add(gasProvided - vmOutput.GasRemaining)
+	}
+}
+
+func (metrics *executionMetrics) recordTimeout() {
+	if metrics == nil {
+		return
+	}
+	metrics.timeouts.Add(1)
+}
+
+func (metrics *executionMetrics) recordPanic() {
+	if metrics == nil {
+		return
+	}
+	metrics.panics.Add(1)
+}
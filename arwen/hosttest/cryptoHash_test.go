@@ -0,0 +1,77 @@
+package hosttest
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/require"
+)
+
+func poseidonInput(elements ...int64) []byte {
+	input := make([]byte, 0, 32*len(elements))
+	for _, element := range elements {
+		input = append(input, big.NewInt(element).FillBytes(make([]byte, 32))...)
+	}
+	return input
+}
+
+func TestCryptoHash_Poseidon(t *testing.T) {
+	// circomlib poseidon([1]) and poseidon([1, 2])
+	hashOfOne, _ := big.NewInt(0).SetString("18586133768512220936620570745912940619677854269274689475585506675881198879027", 10)
+	hashOfOneAndTwo, _ := big.NewInt(0).SetString("7853200120776062878684798364095072458815029376092732009249414926327459813530", 10)
+
+	for _, function := range []string{"poseidonHash", "managedPoseidonHash"} {
+		host := goBackendTestHost(t, "crypto-hash", nil)
+
+		vmOutput := runCryptoTestFunction(t, host, function, poseidonInput(1))
+		require.Equal(t, vmcommon.Ok, vmOutput.ReturnCode, function)
+		require.Equal(t, [][]byte{hashOfOne.FillBytes(make([]byte, 32))}, vmOutput.ReturnData, function)
+
+		vmOutput = runCryptoTestFunction(t, host, function, poseidonInput(1, 2))
+		require.Equal(t, vmcommon.Ok, vmOutput.ReturnCode, function)
+		require.Equal(t, [][]byte{hashOfOneAndTwo.FillBytes(make([]byte, 32))}, vmOutput.ReturnData, function)
+
+		host.Reset()
+	}
+}
+
+func TestCryptoHash_PoseidonInvalidInput(t *testing.T) {
+	for _, function := range []string{"poseidonHash", "managedPoseidonHash"} {
+		host := goBackendTestHost(t, "crypto-hash", nil)
+
+		vmOutput := runCryptoTestFunction(t, host, function, poseidonInput(1)[1:])
+		require.Equal(t, vmcommon.ExecutionFailed, vmOutput.ReturnCode, function)
+
+		vmOutput = runCryptoTestFunction(t, host, function, poseidonInput(make([]int64, 17)...))
+		require.Equal(t, vmcommon.ExecutionFailed, vmOutput.ReturnCode, function)
+
+		host.Reset()
+	}
+}
+
+func TestCryptoHash_PoseidonGasCost(t *testing.T) {
+	gasUsed := func(function string, gasCostName string, gasCost uint64, input []byte) uint64 {
+		host := goBackendTestHost(t, "crypto-hash", func(hostParameters *arwen.VMHostParameters) {
+			hostParameters.GasSchedule["CryptoAPICost"][gasCostName] = gasCost
+		})
+		defer host.Reset()
+
+		vmOutput := runCryptoTestFunction(t, host, function, input)
+		require.Equal(t, vmcommon.Ok, vmOutput.ReturnCode, function)
+		return 1000000 - vmOutput.GasRemaining
+	}
+
+	for _, function := range []string{"poseidonHash", "managedPoseidonHash"} {
+		baseGas := gasUsed(function, "Poseidon", 100, poseidonInput(1))
+		require.Equal(t, baseGas+10000, gasUsed(function, "Poseidon", 10100, poseidonInput(1)), function)
+
+		// the cost per block is charged for each input field element
+		for _, input := range [][]byte{poseidonInput(1), poseidonInput(1, 2, 3)} {
+			numBlocks := uint64(len(input) / 32)
+			baseGas = gasUsed(function, "PoseidonPerBlock", 100, input)
+			require.Equal(t, baseGas+numBlocks*10000, gasUsed(function, "PoseidonPerBlock", 10100, input), function)
+		}
+	}
+}
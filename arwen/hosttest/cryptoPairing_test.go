@@ -0,0 +1,181 @@
+package hosttest
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto/pairing"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	curveBN254    = []byte{}
+	curveBLS12381 = []byte{1}
+	bn254G1, _    = hex.DecodeString("0000000000000000000000000000000000000000000000000000000000000001" + "0000000000000000000000000000000000000000000000000000000000000002")
+	bn254G2, _    = hex.DecodeString("198e9393920d483a7260bfb731fb5d25f1aa493335a9e71297e485b7aef312c2" + "1800deef121f1e76426a00665e5c4479674322d4f75edadd46debd5cd992f6ed" + "090689d0585ff075ec9e99ad690c3395bc4b313370b38ef355acdadcd122975b" + "12c85ea5db8c6deb4aab71808dcb408fe3d1e7690c43d37b4ce6cc0166fa7daa")
+	bls12381G1, _ = hex.DecodeString("17f1d3a73197d7942695638c4fa9ac0fc3688c4f9774b905a14e3a3f171bac586c55e83ff97a1aeffb3af00adb22c6bb" + "08b3f481e3aaa0f1a09e30ed741d8ae4fcf5e095d5d00af600db18cb2c04b3edd03cc744a2888ae40caa232946c5e7e1")
+	scalarTwo     = []byte{2}
+)
+
+// groth16VerifyingKey, groth16Proof and groth16PublicInputs form a valid Groth16 verification on BN254,
+// built from known discrete logarithms by the test data of the pairing package
+var (
+	groth16VerifyingKey, _ = hex.DecodeString(
+		"2a14705537b009189da8808651eecdb82482477fe92ac12ca8b71f80fc3d49ef2df7ee7f243ea8b38e1ddf14029258877a618c779fd4717db6177e19ea67ec38" +
+			"009edaf0698a8c56f51139588acc094cee3c37d427bb6d2eab830aae529097d123ad66f3a7cca9dc75049635faebd124316244b91de5fb2764cd151572a905f7" +
+			"2700e8a29b7bb45f3022a18a07bdc66d0254559e17cce64e3b4ad21578fcf4101ad4f87d3b4375a39988ac099b042b1e7c0c715678e4c2bea8905f607cf950f8" +
+			"227071bba5ff3b47ed8b504bb5b215bc701d7a3259b933bff1a4164eae499c2c0c51a367b61d3119677b29739ddccbb78002b5558d8f49ff16e299c1b41f8098" +
+			"08bb188b2a6187bb1e87834c85a6a917763d65b98febf2c45ea339dd77fac41518fd2fd13be8494c39e8a91325d1ef3ba7d1a205d10788e38bc9e09d9be87769" +
+			"25407be35f18c6594174374841311466c0e66ff003762448c06bca4fa5e9c54e15cbba9ab73bc73d0ba4ad132a15cb0c73107a9c19b040c4c73d89f6bf75404d" +
+			"1edef86c1a42fa85ab6ae8d268a7e9b46890b2130dd83b91c86c504cf1f93fbf2c750c045112e4ab07f18b12475309cebdcb726bda1ca9948bacd498a28cf411" +
+			"14b4fa251277a6f4cbbfe379a152a976641f58a4a2bffd3b677ea093bdad853c28ce094a6d16280abcf8d84efa062c85511819dd87d8da255885ce0580ebee36" +
+			"0ac610b573e9fb98deaf5aa48feb447536418ddc4cefd17c277c852a2a02a4131940e395f5eeaaf3b73a54a9db9910c3b7f907cad7f55137fb0c3847a682d315" +
+			"1bf3ebe16a0321c0c357f5c82f2c87abd0da6e916f5f6171b649840c052bf8922cc236a9e084af730472e0def08271b50385b691c3bc64432a382506552049b1")
+	groth16Proof, _ = hex.DecodeString(
+		"1e28260f0ee971dec1e84cf81ff2776ad314d2cfb9ef81d4c970620c29b811f128fc8a72d4ff12654c3c39dab54eaef9638d28de738959779fcd3e7ac918b396" +
+			"01cf2a133d7ef6f147fbb6ca741560a3e3e29b2289b072425f9b4ee5d1d4df43135753ec2345d0a7afed8b315842be0d4a8bfe12aabfc17e1ef505a6a101bac8" +
+			"058c1fa13bffdcfa53a4050df9f5989ef6f2e85779b697fb70fd3fd6d1b8d5f01ea2d9ee6b6bf93f13ba95c0b2ff4d86bc4bb03fa5fd1301cade34ff52cc4983" +
+			"2a58cdaf95386580a00d8cc160d1145fe31105eb50ea7e303be2383ef7567f8c073a83a6ed8a917af1bba1013a96e42599960732a8eebd2b78409f9b30bdd317")
+	groth16PublicInputs, _ = hex.DecodeString("0000000000000000000000000000000000000000000000000000000000000005" +
+		"0000000000000000000000000000000000000000000000000000000000000007")
+)
+
+func TestCryptoPairing_GroupOperations(t *testing.T) {
+	host := goBackendTestHost(t, "crypto-pairing", nil)
+	defer host.Reset()
+
+	for _, points := range [][]byte{bn254G1, bn254G2} {
+		addFunction, scalarMultFunction := "addG1", "scalarMultG1"
+		if len(points) == len(bn254G2) {
+			addFunction, scalarMultFunction = "addG2", "scalarMultG2"
+		}
+
+		sum := runCryptoTestFunction(t, host, addFunction, curveBN254, points, points)
+		require.Equal(t, vmcommon.Ok, sum.ReturnCode, addFunction)
+
+		product := runCryptoTestFunction(t, host, scalarMultFunction, curveBN254, points, scalarTwo)
+		require.Equal(t, vmcommon.Ok, product.ReturnCode, scalarMultFunction)
+		require.Equal(t, product.ReturnData, sum.ReturnData, addFunction)
+	}
+}
+
+func pairingGasUsed(t *testing.T, function string, gasCostName string, gasCost uint64, arguments ...[]byte) uint64 {
+	host := goBackendTestHost(t, "crypto-pairing", func(hostParameters *arwen.VMHostParameters) {
+		hostParameters.GasSchedule["CryptoAPICost"][gasCostName] = gasCost
+	})
+	defer host.Reset()
+
+	vmOutput := runCryptoTestFunction(t, host, function, arguments...)
+	require.Equal(t, vmcommon.Ok, vmOutput.ReturnCode, function)
+	return 1000000 - vmOutput.GasRemaining
+}
+
+// groth16TestData returns a verifying key with numPublicInputs+1 IC points, a proof and numPublicInputs zero inputs,
+// all of them made of valid points, though the proof does not verify
+func groth16TestData(g1 []byte, g2 []byte, numPublicInputs int) ([]byte, []byte, []byte) {
+	verifyingKey := append([]byte{}, g1...)
+	for i := 0; i < 3; i++ {
+		verifyingKey = append(verifyingKey, g2...)
+	}
+	for i := 0; i <= numPublicInputs; i++ {
+		verifyingKey = append(verifyingKey, g1...)
+	}
+
+	proof := append(append(append([]byte{}, g1...), g2...), g1...)
+	return verifyingKey, proof, make([]byte, numPublicInputs*pairing.ScalarLength)
+}
+
+func TestCryptoPairing_SubgroupCheckGasCost(t *testing.T) {
+	// the G2 points are always checked, once for each decoded point
+	baseGas := pairingGasUsed(t, "addG2", "SubgroupCheckG2", 100, curveBN254, bn254G2, bn254G2)
+	require.Equal(t, baseGas+2*10000, pairingGasUsed(t, "addG2", "SubgroupCheckG2", 10100, curveBN254, bn254G2, bn254G2))
+
+	baseGas = pairingGasUsed(t, "scalarMultG2", "SubgroupCheckG2", 100, curveBN254, bn254G2, scalarTwo)
+	require.Equal(t, baseGas+10000, pairingGasUsed(t, "scalarMultG2", "SubgroupCheckG2", 10100, curveBN254, bn254G2, scalarTwo))
+
+	// the G1 of BN254 has no cofactor, so that its points are not checked
+	baseGas = pairingGasUsed(t, "addG1", "SubgroupCheckG1", 100, curveBN254, bn254G1, bn254G1)
+	require.Equal(t, baseGas, pairingGasUsed(t, "addG1", "SubgroupCheckG1", 10100, curveBN254, bn254G1, bn254G1))
+
+	// the costs are scaled by 2.5 for BLS12-381
+	baseGas = pairingGasUsed(t, "addG1", "SubgroupCheckG1", 100, curveBLS12381, bls12381G1, bls12381G1)
+	require.Equal(t, baseGas+2*25000, pairingGasUsed(t, "addG1", "SubgroupCheckG1", 10100, curveBLS12381, bls12381G1, bls12381G1))
+}
+
+func TestCryptoPairing_VerifyGroth16GasCost(t *testing.T) {
+	verifyingKey, proof, publicInputs := groth16TestData(bn254G1, bn254G2, 2)
+	baseGas := pairingGasUsed(t, "verifyGroth16", "VerifyGroth16PerInput", 100, curveBN254, verifyingKey, proof, publicInputs)
+	require.Equal(t, baseGas+2*10000, pairingGasUsed(t, "verifyGroth16", "VerifyGroth16PerInput", 10100, curveBN254, verifyingKey, proof, publicInputs))
+
+	// alpha, A, C and the 3 IC points are checked on BLS12-381, with the point at infinity as the G2 points
+	verifyingKey, proof, publicInputs = groth16TestData(bls12381G1, make([]byte, 192), 2)
+	baseGas = pairingGasUsed(t, "verifyGroth16", "SubgroupCheckG1", 100, curveBLS12381, verifyingKey, proof, publicInputs)
+	require.Equal(t, baseGas+6*25000, pairingGasUsed(t, "verifyGroth16", "SubgroupCheckG1", 10100, curveBLS12381, verifyingKey, proof, publicInputs))
+}
+
+func TestCryptoPairing_VerifyGroth16PublicInputsCountMismatch(t *testing.T) {
+	host := goBackendTestHost(t, "crypto-pairing", nil)
+	defer host.Reset()
+
+	// the number of public inputs is checked against the length of the verifying key before any point is decoded
+	verifyingKey, proof, publicInputs := groth16TestData(bn254G1, bn254G2, 2)
+	for i := range verifyingKey {
+		verifyingKey[i] = 0xff
+	}
+	vmOutput := runCryptoTestFunction(t, host, "verifyGroth16", curveBN254, verifyingKey, proof, publicInputs[pairing.ScalarLength:])
+	require.Equal(t, vmcommon.ExecutionFailed, vmOutput.ReturnCode)
+	require.Equal(t, pairing.ErrInvalidPublicInputs.Error(), vmOutput.ReturnMessage)
+
+	vmOutput = runCryptoTestFunction(t, host, "verifyGroth16", curveBN254, verifyingKey[1:], proof, publicInputs)
+	require.Equal(t, vmcommon.ExecutionFailed, vmOutput.ReturnCode)
+	require.Equal(t, pairing.ErrInvalidVerifyingKey.Error(), vmOutput.ReturnMessage)
+}
+
+func TestCryptoPairing_PairingCheckReturnCodes(t *testing.T) {
+	// jeff1 of the EIP-197 precompile tests
+	g1Points, _ := hex.DecodeString(
+		"1c76476f4def4bb94541d57ebba1193381ffa7aa76ada664dd31c16024c43f593034dd2920f673e204fee2811c678745fc819b55d3e9d294e45c9b03a76aef41" +
+			"111e129f1cf1097710d41c4ac70fcdfa5ba2023c6ff1cbeac322de49d1b6df7c2032c61a830e3c17286de9462bf242fca2883585b93870a73853face6a6bf411")
+	g2Points, _ := hex.DecodeString(
+		"209dd15ebff5d46c4bd888e51a93cf99a7329636c63514396b4a452003a35bf704bf11ca01483bfa8b34b43561848d28905960114c8ac04049af4b6315a41678" +
+			"2bb8324af6cfc93537a2ad1a445cfd0ca2a71acd7ac41fadbf933c2a51be344d120a2a4cf30c1bf9845f20c6fe39e07ea2cce61f0c9bb048165fe5e4de877550")
+	g2Points = append(g2Points, bn254G2...)
+
+	host := goBackendTestHost(t, "crypto-pairing", nil)
+	defer host.Reset()
+
+	vmOutput := runCryptoTestFunction(t, host, "pairingCheck", curveBN254, g1Points, g2Points)
+	require.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
+	require.Equal(t, [][]byte{{1}}, vmOutput.ReturnData)
+
+	// a pairing check that does not hold is not an error
+	vmOutput = runCryptoTestFunction(t, host, "pairingCheck", curveBN254, bn254G1, bn254G2)
+	require.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
+	require.Equal(t, [][]byte{{}}, vmOutput.ReturnData)
+
+	vmOutput = runCryptoTestFunction(t, host, "pairingCheck", curveBN254, bn254G1, bn254G2[1:])
+	require.Equal(t, vmcommon.ExecutionFailed, vmOutput.ReturnCode)
+	require.Equal(t, pairing.ErrInvalidPairingInput.Error(), vmOutput.ReturnMessage)
+}
+
+func TestCryptoPairing_VerifyGroth16ReturnCodes(t *testing.T) {
+	host := goBackendTestHost(t, "crypto-pairing", nil)
+	defer host.Reset()
+
+	vmOutput := runCryptoTestFunction(t, host, "verifyGroth16", curveBN254, groth16VerifyingKey, groth16Proof, groth16PublicInputs)
+	require.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
+	require.Equal(t, [][]byte{{1}}, vmOutput.ReturnData)
+
+	// a well formed proof that does not verify is not an error
+	tamperedInputs := append([]byte{}, groth16PublicInputs...)
+	tamperedInputs[len(tamperedInputs)-1]++
+	vmOutput = runCryptoTestFunction(t, host, "verifyGroth16", curveBN254, groth16VerifyingKey, groth16Proof, tamperedInputs)
+	require.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
+	require.Equal(t, [][]byte{{}}, vmOutput.ReturnData)
+
+	vmOutput = runCryptoTestFunction(t, host, "verifyGroth16", curveBN254, groth16VerifyingKey, groth16Proof[1:], groth16PublicInputs)
+	require.Equal(t, vmcommon.ExecutionFailed, vmOutput.ReturnCode)
+	require.Equal(t, pairing.ErrInvalidProof.Error(), vmOutput.ReturnMessage)
+}
@@ -0,0 +1,201 @@
+package hosttest
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	test "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/testcommon"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/require"
+)
+
+// schnorrKey, schnorrMessage and schnorrSignature form a valid BIP-340 test vector
+var (
+	schnorrKey, _       = hex.DecodeString("DFF1D77F2A671C5F36183726DB2341BE58FEAE1DA2DECED843240F7B502BA659")
+	schnorrMessage, _   = hex.DecodeString("243F6A8885A308D313198A2E03707344A4093822299F31D0082EFA98EC4E6C89")
+	schnorrSignature, _ = hex.DecodeString("6896BD60EEAE296DB48A229FF71DFE071BDE413E6D43F917DC8DCF8C78DE33418906D11AC976ABCCB20B091292BFF4EA897EFCB639EA871CFA95F6DE339E4B0A")
+)
+
+var (
+	verificationSucceeded = []byte{}
+	verificationFailed    = []byte{0xff}
+)
+
+func secp256r1Signature(t *testing.T, message []byte) ([]byte, []byte) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+
+	hash := sha256.Sum256(message)
+	signature, err := ecdsa.SignASN1(rand.Reader, privateKey, hash[:])
+	require.Nil(t, err)
+
+	return elliptic.MarshalCompressed(elliptic.P256(), privateKey.X, privateKey.Y), signature
+}
+
+func runCryptoTestFunction(t *testing.T, host arwen.VMHost, function string, arguments ...[]byte) *vmcommon.VMOutput {
+	input := test.CreateTestContractCallInputBuilder().
+		WithRecipientAddr(test.ParentAddress).
+		WithGasProvided(1000000).
+		WithFunction(function).
+		WithArguments(arguments...).
+		Build()
+	vmOutput, err := host.RunSmartContractCall(input)
+	require.Nil(t, err)
+	return vmOutput
+}
+
+func withFailExecutionOnErrorDisabled(hostParameters *arwen.VMHostParameters) {
+	hostParameters.FixFailExecutionOnErrorEnableEpoch = 1
+}
+
+func TestCryptoVerify_Secp256r1(t *testing.T) {
+	message := []byte("webauthn assertion")
+	key, signature := secp256r1Signature(t, message)
+
+	for _, function := range []string{"verifySecp256r1Signature", "managedVerifySecp256r1Signature"} {
+		host := goBackendTestHost(t, "crypto-verify", nil)
+
+		vmOutput := runCryptoTestFunction(t, host, function, key, message, signature)
+		require.Equal(t, vmcommon.Ok, vmOutput.ReturnCode, function)
+		require.Equal(t, [][]byte{verificationSucceeded}, vmOutput.ReturnData, function)
+
+		vmOutput = runCryptoTestFunction(t, host, function, key, []byte("tampered"), signature)
+		require.Equal(t, vmcommon.ExecutionFailed, vmOutput.ReturnCode, function)
+		require.Equal(t, uint64(0), vmOutput.GasRemaining, function)
+
+		host.Reset()
+	}
+}
+
+func TestCryptoVerify_Secp256r1InvalidSignatureReturnsMinusOne(t *testing.T) {
+	message := []byte("webauthn assertion")
+	key, signature := secp256r1Signature(t, message)
+
+	for _, function := range []string{"verifySecp256r1Signature", "managedVerifySecp256r1Signature"} {
+		host := goBackendTestHost(t, "crypto-verify", withFailExecutionOnErrorDisabled)
+
+		vmOutput := runCryptoTestFunction(t, host, function, key, []byte("tampered"), signature)
+		require.Equal(t, vmcommon.Ok, vmOutput.ReturnCode, function)
+		require.Equal(t, [][]byte{verificationFailed}, vmOutput.ReturnData, function)
+
+		host.Reset()
+	}
+}
+
+func TestCryptoVerify_Secp256r1InvalidKeyLength(t *testing.T) {
+	message := []byte("webauthn assertion")
+	key, signature := secp256r1Signature(t, message)
+
+	for _, function := range []string{"verifySecp256r1Signature", "managedVerifySecp256r1Signature"} {
+		host := goBackendTestHost(t, "crypto-verify", withFailExecutionOnErrorDisabled)
+
+		vmOutput := runCryptoTestFunction(t, host, function, key[:len(key)-1], message, signature)
+		require.Equal(t, vmcommon.ExecutionFailed, vmOutput.ReturnCode, function)
+		require.Equal(t, arwen.ErrInvalidPublicKeySize.Error(), vmOutput.ReturnMessage, function)
+
+		host.Reset()
+	}
+}
+
+func TestCryptoVerify_Schnorr(t *testing.T) {
+	for _, function := range []string{"verifySchnorrSignature", "managedVerifySchnorrSignature"} {
+		host := goBackendTestHost(t, "crypto-verify", nil)
+
+		vmOutput := runCryptoTestFunction(t, host, function, schnorrKey, schnorrMessage, schnorrSignature)
+		require.Equal(t, vmcommon.Ok, vmOutput.ReturnCode, function)
+		require.Equal(t, [][]byte{verificationSucceeded}, vmOutput.ReturnData, function)
+
+		vmOutput = runCryptoTestFunction(t, host, function, schnorrKey, []byte("tampered"), schnorrSignature)
+		require.Equal(t, vmcommon.ExecutionFailed, vmOutput.ReturnCode, function)
+
+		host.Reset()
+	}
+}
+
+func TestCryptoVerify_SchnorrInvalidSignatureReturnsMinusOne(t *testing.T) {
+	for _, function := range []string{"verifySchnorrSignature", "managedVerifySchnorrSignature"} {
+		host := goBackendTestHost(t, "crypto-verify", withFailExecutionOnErrorDisabled)
+
+		vmOutput := runCryptoTestFunction(t, host, function, schnorrKey, []byte("tampered"), schnorrSignature)
+		require.Equal(t, vmcommon.Ok, vmOutput.ReturnCode, function)
+		require.Equal(t, [][]byte{verificationFailed}, vmOutput.ReturnData, function)
+
+		host.Reset()
+	}
+}
+
+func TestCryptoVerify_SchnorrInvalidLengths(t *testing.T) {
+	host := goBackendTestHost(t, "crypto-verify", withFailExecutionOnErrorDisabled)
+	defer host.Reset()
+
+	// the managed function takes the key and the signature whole, so that wrong lengths are rejected
+	vmOutput := runCryptoTestFunction(t, host, "managedVerifySchnorrSignature", schnorrKey[1:], schnorrMessage, schnorrSignature)
+	require.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
+	require.Equal(t, [][]byte{verificationFailed}, vmOutput.ReturnData)
+
+	vmOutput = runCryptoTestFunction(t, host, "managedVerifySchnorrSignature", schnorrKey, schnorrMessage, schnorrSignature[1:])
+	require.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
+	require.Equal(t, [][]byte{verificationFailed}, vmOutput.ReturnData)
+
+	// the function on memory reads the key and the signature with their fixed lengths
+	vmOutput = runCryptoTestFunction(t, host, "verifySchnorrSignature", schnorrKey[:16], schnorrMessage, schnorrSignature)
+	require.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
+	require.Equal(t, [][]byte{verificationFailed}, vmOutput.ReturnData)
+
+	// a missing managed buffer always fails the execution
+	vmOutput = runCryptoTestFunction(t, host, "managedVerifySchnorrSignature", schnorrKey, schnorrMessage)
+	require.Equal(t, vmcommon.ExecutionFailed, vmOutput.ReturnCode)
+}
+
+func TestCryptoVerify_GasCost(t *testing.T) {
+	message := []byte("webauthn assertion")
+	key, signature := secp256r1Signature(t, message)
+
+	gasUsed := func(function string, gasCostName string, gasCost uint64, arguments ...[]byte) uint64 {
+		host := goBackendTestHost(t, "crypto-verify", func(hostParameters *arwen.VMHostParameters) {
+			hostParameters.GasSchedule["CryptoAPICost"][gasCostName] = gasCost
+		})
+		defer host.Reset()
+
+		vmOutput := runCryptoTestFunction(t, host, function, arguments...)
+		require.Equal(t, vmcommon.Ok, vmOutput.ReturnCode, function)
+		return 1000000 - vmOutput.GasRemaining
+	}
+
+	for _, function := range []string{"verifySecp256r1Signature", "managedVerifySecp256r1Signature"} {
+		baseGas := gasUsed(function, "VerifySecp256r1", 100, key, message, signature)
+		require.Equal(t, baseGas+10000, gasUsed(function, "VerifySecp256r1", 10100, key, message, signature), function)
+	}
+
+	for _, function := range []string{"verifySchnorrSignature", "managedVerifySchnorrSignature"} {
+		baseGas := gasUsed(function, "VerifySchnorr", 100, schnorrKey, schnorrMessage, schnorrSignature)
+		require.Equal(t, baseGas+10000, gasUsed(function, "VerifySchnorr", 10100, schnorrKey, schnorrMessage, schnorrSignature), function)
+	}
+}
+
+func TestCryptoVerify_DeployBeforeExtendedEEIEnableEpoch(t *testing.T) {
+	deployInput := test.CreateTestContractCreateInputBuilder().
+		WithCallerAddr(test.ParentAddress).
+		WithGasProvided(1000000).
+		WithContractCode(test.GetTestSCCode("crypto-verify", "../../")).
+		Build()
+
+	host := goBackendTestHost(t, "crypto-verify", func(hostParameters *arwen.VMHostParameters) {
+		hostParameters.ExtendedEEIEnableEpoch = 1
+	})
+	vmOutput, err := host.RunSmartContractCreate(deployInput)
+	require.Nil(t, err)
+	require.Equal(t, vmcommon.ContractInvalid, vmOutput.ReturnCode, vmOutput.ReturnMessage)
+	host.Reset()
+
+	host = goBackendTestHost(t, "crypto-verify", nil)
+	vmOutput, err = host.RunSmartContractCreate(deployInput)
+	require.Nil(t, err)
+	require.Equal(t, vmcommon.Ok, vmOutput.ReturnCode, vmOutput.ReturnMessage)
+	host.Reset()
+}
@@ -0,0 +1,41 @@
+package hosttest
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen/metrics"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetrics_Executions(t *testing.T) {
+	registry := metrics.NewRegistry()
+	host := trapTestHost(t, func(hostParameters *arwen.VMHostParameters) {
+		hostParameters.MetricsRegistry = registry
+	})
+	defer host.Reset()
+
+	vmOutput := runTrapTestFunction(t, host, "divideByZero")
+	require.Equal(t, vmcommon.ExecutionFailed, vmOutput.ReturnCode)
+	runTrapTestFunction(t, host, "divideByZero")
+	vmOutput = runTrapTestFunction(t, host, "missingFunction")
+	require.Equal(t, vmcommon.FunctionNotFound, vmOutput.ReturnCode)
+
+	var buffer bytes.Buffer
+	err := registry.WritePrometheus(&buffer)
+	require.Nil(t, err)
+	exported := buffer.String()
+
+	require.Contains(t, exported, `arwen_executions_total{entry_point="RunSmartContractCall",return_code="execution failed"} 2`+"\n")
+	require.Contains(t, exported, `arwen_executions_total{entry_point="RunSmartContractCall",return_code="function not found"} 1`+"\n")
+	require.Contains(t, exported, `arwen_execution_duration_seconds_count{entry_point="RunSmartContractCall"} 3`+"\n")
+	require.Contains(t, exported, `arwen_gas_used_total{entry_point="RunSmartContractCall"} `)
+	require.Contains(t, exported, `arwen_execution_timeouts_total{entry_point="RunSmartContractCall"} 0`+"\n")
+	require.Contains(t, exported, `arwen_execution_panics_total{entry_point="RunSmartContractCall"} 0`+"\n")
+	require.Contains(t, exported, `arwen_eei_calls_total{function="getNumArguments"} 2`+"\n")
+	require.Contains(t, exported, `arwen_eei_calls_total{function="signalError"} 0`+"\n")
+	require.Contains(t, exported, `arwen_instance_duration_seconds_count{source="bytecode"} 1`+"\n")
+	require.Contains(t, exported, `arwen_instances_total{source="bytecode"} 1`+"\n")
+}
@@ -0,0 +1,69 @@
+package hosttest
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/contracts"
+	worldmock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/world"
+	test "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/testcommon"
+	"github.com/ElrondNetwork/elrond-go-core/data/vm"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPromises_CrossShardCallback(t *testing.T) {
+	callbackReturnCodes := map[string][]byte{
+		"onSuccess": []byte(vmcommon.Ok.String()),
+		"onError":   []byte(vmcommon.UserError.String()),
+	}
+
+	for expectedCallback, returnCode := range callbackReturnCodes {
+		input := test.CreateTestContractCallInputBuilder().
+			WithCallerAddr(test.ChildAddress).
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(1000).
+			WithFunction("callBack").
+			WithArguments(returnCode, []byte("result")).
+			WithCallType(vm.AsynchronousCallBack).
+			Build()
+
+		test.BuildMockInstanceCallTest(t).
+			WithContracts(
+				test.CreateMockContractOnShard(test.ParentAddress, 0).
+					WithMethods(contracts.PromisesCallbacksParentMock),
+			).
+			WithInput(input).
+			WithSetup(func(host arwen.VMHost, world *worldmock.MockWorld) {
+				world.SelfShardID = 0
+				setZeroCodeCosts(host)
+				// the promise was created by the parent, and sent to the child in another shard
+				asyncInfo := &arwen.AsyncContextInfo{
+					CallerAddr: test.UserAddress,
+					AsyncContextMap: map[string]*arwen.AsyncContext{
+						"promises": {
+							AsyncCalls: []*arwen.AsyncGeneratedCall{{
+								Status:          arwen.AsyncCallPending,
+								Destination:     test.ChildAddress,
+								SuccessCallback: "onSuccess",
+								ErrorCallback:   "onError",
+								CallbackClosure: []byte("closure"),
+							}},
+						},
+					},
+				}
+				asyncInfoBytes, err := json.Marshal(asyncInfo)
+				require.Nil(t, err)
+
+				storageKey := arwen.CustomStorageKey(arwen.AsyncDataPrefix, input.OriginalTxHash)
+				accountHandler, _ := world.GetUserAccount(test.ParentAddress)
+				(accountHandler.(*worldmock.Account)).Storage[string(storageKey)] = asyncInfoBytes
+			}).
+			AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+				verify.
+					Ok().
+					ReturnData([]byte(expectedCallback), []byte("closure"))
+			})
+	}
+}
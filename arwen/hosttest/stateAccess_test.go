@@ -0,0 +1,117 @@
+package hosttest
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	test "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/testcommon"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/require"
+)
+
+func storageKeys(access *arwen.AccountAccess) []string {
+	keys := make([]string, 0)
+	for _, key := range access.SortedStorageKeys() {
+		keys = append(keys, string(key))
+	}
+	return keys
+}
+
+func TestStateAccess_Disabled(t *testing.T) {
+	host := goBackendTestHost(t, "state-access", nil)
+	defer host.Reset()
+
+	vmOutput := runTrapTestFunction(t, host, "readWrite")
+	require.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
+	require.Nil(t, host.StateAccessTracer())
+	require.Nil(t, host.StateAccessTracer().GetStateAccess())
+}
+
+func TestStateAccess_ReadsAndWrites(t *testing.T) {
+	host := goBackendTestHost(t, "state-access", func(hostParameters *arwen.VMHostParameters) {
+		hostParameters.StateAccessTracing = true
+	})
+	defer host.Reset()
+
+	vmOutput := runTrapTestFunction(t, host, "readWrite")
+	require.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
+
+	stateAccess := host.StateAccessTracer().GetStateAccess()
+	reads := stateAccess.Reads.GetAccount(test.ParentAddress)
+	require.NotNil(t, reads)
+	require.True(t, reads.Code)
+	require.Equal(t, []string{"read", "written"}, storageKeys(reads))
+
+	writes := stateAccess.Writes.GetAccount(test.ParentAddress)
+	require.NotNil(t, writes)
+	require.False(t, writes.Code)
+	require.Equal(t, []string{"written"}, storageKeys(writes))
+
+	vmOutput = runTrapTestFunction(t, host, "readWriteFail")
+	require.Equal(t, vmcommon.UserError, vmOutput.ReturnCode)
+
+	stateAccess = host.StateAccessTracer().GetStateAccess()
+	require.Equal(t, []string{"failed"}, storageKeys(stateAccess.Reads.GetAccount(test.ParentAddress)))
+	require.Nil(t, stateAccess.Writes.GetAccount(test.ParentAddress))
+}
+
+func TestStateAccess_NestedCall(t *testing.T) {
+	host := goBackendTestHost(t, "state-access", func(hostParameters *arwen.VMHostParameters) {
+		hostParameters.StateAccessTracing = true
+	})
+	defer host.Reset()
+
+	vmOutput := runTrapTestFunction(t, host, "callReadWrite")
+	require.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
+
+	stateAccess := host.StateAccessTracer().GetStateAccess()
+	require.Equal(t, []string{"read", "written"}, storageKeys(stateAccess.Reads.GetAccount(test.ParentAddress)))
+	require.Equal(t, []string{"written"}, storageKeys(stateAccess.Writes.GetAccount(test.ParentAddress)))
+
+	other := &arwen.StateAccessSets{
+		Reads:  arwen.NewStateAccessSet(),
+		Writes: arwen.NewStateAccessSet(),
+	}
+	other.Reads.AddStorageKey(test.ParentAddress, []byte("unrelated"))
+	other.Writes.AddStorageKey(test.ParentAddress, []byte("read"))
+	require.True(t, stateAccess.ConflictsWith(other))
+	require.True(t, other.ConflictsWith(stateAccess))
+
+	other.Writes = arwen.NewStateAccessSet()
+	require.False(t, stateAccess.ConflictsWith(other))
+}
+
+func TestStateAccess_FailedNestedCall(t *testing.T) {
+	host := goBackendTestHost(t, "state-access", func(hostParameters *arwen.VMHostParameters) {
+		hostParameters.StateAccessTracing = true
+	})
+	defer host.Reset()
+
+	vmOutput := runTrapTestFunction(t, host, "callChildren")
+	require.Equal(t, vmcommon.ExecutionFailed, vmOutput.ReturnCode)
+
+	stateAccess := host.StateAccessTracer().GetStateAccess()
+	require.Equal(t, []string{"failed", "read", "written"}, storageKeys(stateAccess.Reads.GetAccount(test.ParentAddress)))
+	require.Empty(t, stateAccess.Writes.Accounts())
+}
+
+func TestStateAccess_FailedAsyncCall(t *testing.T) {
+	host := goBackendTestHost(t, "state-access", func(hostParameters *arwen.VMHostParameters) {
+		hostParameters.StateAccessTracing = true
+	})
+	defer host.Reset()
+
+	// the async call locks gas for its callback
+	input := test.CreateTestContractCallInputBuilder().
+		WithRecipientAddr(test.ParentAddress).
+		WithGasProvided(1000000).
+		WithFunction("asyncCallFail").
+		Build()
+	vmOutput, err := host.RunSmartContractCall(input)
+	require.Nil(t, err)
+	require.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
+
+	stateAccess := host.StateAccessTracer().GetStateAccess()
+	require.Equal(t, []string{"callBack", "failed"}, storageKeys(stateAccess.Reads.GetAccount(test.ParentAddress)))
+	require.Nil(t, stateAccess.Writes.GetAccount(test.ParentAddress))
+}
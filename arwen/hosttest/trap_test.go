@@ -0,0 +1,148 @@
+package hosttest
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	arwenHost "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen/host"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/config"
+	worldmock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/world"
+	test "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/testcommon"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/ElrondNetwork/elrond-vm-common/builtInFunctions"
+	"github.com/ElrondNetwork/elrond-vm-common/parsers"
+	"github.com/stretchr/testify/require"
+)
+
+// trapTestHost deploys the trap contract on a host with the Go backend, which captures the call stacks of the traps
+func trapTestHost(t *testing.T, configure func(hostParameters *arwen.VMHostParameters)) arwen.VMHost {
+	return goBackendTestHost(t, "trap", configure)
+}
+
+// goBackendTestHost deploys the given test contract at test.ParentAddress, on a host with the Go backend
+func goBackendTestHost(t *testing.T, contract string, configure func(hostParameters *arwen.VMHostParameters)) arwen.VMHost {
+	world := worldmock.NewMockWorld()
+	world.AcctMap.CreateSmartContractAccount(test.UserAddress, test.ParentAddress, test.GetTestSCCode(contract, "../../"), world)
+
+	esdtTransferParser, _ := parsers.NewESDTTransferParser(worldmock.WorldMarshalizer)
+	hostParameters := &arwen.VMHostParameters{
+		VMType:                   test.DefaultVMType,
+		BlockGasLimit:            uint64(1000),
+		GasSchedule:              config.MakeGasMapForTests(),
+		BuiltInFuncContainer:     builtInFunctions.NewBuiltInFunctionContainer(),
+		ElrondProtectedKeyPrefix: []byte("ELROND"),
+		ESDTTransferParser:       esdtTransferParser,
+		EpochNotifier:            &worldmock.EpochNotifierStub{},
+		WasmBackend:              arwen.GoBackend,
+	}
+	if configure != nil {
+		configure(hostParameters)
+	}
+
+	host, err := arwenHost.NewArwenVM(world, hostParameters)
+	require.Nil(t, err)
+	return host
+}
+
+func runTrapTestFunction(t *testing.T, host arwen.VMHost, function string) *vmcommon.VMOutput {
+	input := test.CreateTestContractCallInputBuilder().
+		WithRecipientAddr(test.ParentAddress).
+		WithGasProvided(100000).
+		WithFunction(function).
+		Build()
+	vmOutput, err := host.RunSmartContractCall(input)
+	require.Nil(t, err)
+	return vmOutput
+}
+
+func internalVMErrorsLog(vmOutput *vmcommon.VMOutput) string {
+	return logData(vmOutput, "internalVMErrors")
+}
+
+func logData(vmOutput *vmcommon.VMOutput, identifier string) string {
+	for _, logEntry := range vmOutput.Logs {
+		if string(logEntry.Identifier) == identifier {
+			return string(logEntry.Data)
+		}
+	}
+	return ""
+}
+
+func TestTrap_Backtrace(t *testing.T) {
+	host := trapTestHost(t, nil)
+	defer host.Reset()
+
+	vmOutput := runTrapTestFunction(t, host, "divideByZero")
+	require.Equal(t, vmcommon.ExecutionFailed, vmOutput.ReturnCode)
+	require.Equal(t, arwen.ErrExecutionFailed.Error(), vmOutput.ReturnMessage)
+	require.NotContains(t, internalVMErrorsLog(vmOutput), "#0")
+
+	backtrace := host.Runtime().GetTrapBacktrace()
+	require.NotNil(t, backtrace)
+	require.Equal(t, test.ParentAddress, backtrace.Address)
+	require.Equal(t, "integer divide by zero", backtrace.Trap)
+	require.Len(t, backtrace.Frames, 2)
+	require.Equal(t, "divide", backtrace.Frames[0].FunctionName)
+	require.Equal(t, "divideByZero", backtrace.Frames[1].FunctionName)
+
+	vmOutput = runTrapTestFunction(t, host, "outOfBounds")
+	require.Equal(t, vmcommon.ExecutionFailed, vmOutput.ReturnCode)
+	backtrace = host.Runtime().GetTrapBacktrace()
+	require.Equal(t, "out of bounds memory access", backtrace.Trap)
+	require.Equal(t, "load", backtrace.Frames[0].FunctionName)
+
+	vmOutput = runTrapTestFunction(t, host, "missingFunction")
+	require.Equal(t, vmcommon.FunctionNotFound, vmOutput.ReturnCode)
+	require.Nil(t, host.Runtime().GetTrapBacktrace())
+}
+
+func TestTrap_BacktraceInLogs(t *testing.T) {
+	host := trapTestHost(t, func(hostParameters *arwen.VMHostParameters) {
+		hostParameters.TrapBacktraceInLogs = true
+	})
+	defer host.Reset()
+
+	vmOutput := runTrapTestFunction(t, host, "unreachable")
+	require.Equal(t, vmcommon.ExecutionFailed, vmOutput.ReturnCode)
+	require.Equal(t, arwen.ErrExecutionFailed.Error(), vmOutput.ReturnMessage)
+
+	internalVMErrors := internalVMErrorsLog(vmOutput)
+	require.Equal(t, 1, strings.Count(internalVMErrors, arwen.ErrExecutionFailed.Error()))
+	require.Contains(t, internalVMErrors, "unreachable")
+	require.Contains(t, internalVMErrors, "#0 fail (func 3) at 0x")
+	require.Contains(t, internalVMErrors, "#1 unreachable (func 6) at 0x")
+}
+
+func TestTrap_ErrorCodesInLogs(t *testing.T) {
+	host := trapTestHost(t, nil)
+	vmOutput := runTrapTestFunction(t, host, "divideByZero")
+	require.Equal(t, "", logData(vmOutput, "internalVMErrorCodes"))
+	host.Reset()
+
+	host = trapTestHost(t, func(hostParameters *arwen.VMHostParameters) {
+		hostParameters.ErrorCodesInLogs = true
+	})
+	defer host.Reset()
+
+	vmOutput = runTrapTestFunction(t, host, "divideByZero")
+	var descriptions []arwen.ErrorDescription
+	err := json.Unmarshal([]byte(logData(vmOutput, "internalVMErrorCodes")), &descriptions)
+	require.Nil(t, err)
+	require.NotEmpty(t, descriptions)
+	for _, description := range descriptions {
+		require.Equal(t, arwen.ErrorCode(6), description.Code)
+		require.Equal(t, "ErrExecutionFailed", description.Name)
+		require.Equal(t, arwen.ErrorCategoryInvalidContract, description.Category)
+	}
+
+	vmOutput = runTrapTestFunction(t, host, "missingFunction")
+	require.Equal(t, vmcommon.FunctionNotFound, vmOutput.ReturnCode)
+	err = json.Unmarshal([]byte(logData(vmOutput, "internalVMErrorCodes")), &descriptions)
+	require.Nil(t, err)
+	require.Len(t, descriptions, 1)
+	require.Equal(t, arwen.ErrorCode(23), descriptions[0].Code)
+	require.Equal(t, arwen.ErrorCategoryUserError, descriptions[0].Category)
+	require.Equal(t, []string{"missingFunction"}, descriptions[0].Info)
+}
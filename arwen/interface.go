@@ -38,6 +38,9 @@ type VMHost interface {
 	Output() OutputContext
 	Metering() MeteringContext
 	Storage() StorageContext
+	VMHooks() VMHooks
+	MetricsRegistry() MetricsRegistry
+	StateAccessTracer() *StateAccessTracer
 
 	ExecuteESDTTransfer(destination []byte, sender []byte, esdtTransfers []*vmcommon.ESDTTransfer, callType vm.CallType) (*vmcommon.VMOutput, uint64, error)
 	CreateNewContract(input *vmcommon.ContractCreateInput) ([]byte, error)
@@ -126,6 +129,8 @@ type RuntimeContext interface {
 	AddAsyncContextCall(contextIdentifier []byte, asyncCall *AsyncGeneratedCall) error
 	GetAsyncContextInfo() *AsyncContextInfo
 	GetAsyncContext(contextIdentifier []byte) (*AsyncContext, error)
+	SetCallbackClosure(callbackClosure []byte)
+	GetCallbackClosure() []byte
 	RunningInstancesCount() uint64
 	IsFunctionImported(name string) bool
 	ReadOnly() bool
@@ -152,6 +157,8 @@ type RuntimeContext interface {
 
 	AddError(err error, otherInfo ...string)
 	GetAllErrors() error
+	SetTrapBacktrace(backtrace *TrapBacktrace)
+	GetTrapBacktrace() *TrapBacktrace
 
 	DisableUseDifferentGasCostFlag()
 	ReplaceInstanceBuilder(builder InstanceBuilder)
@@ -317,3 +324,21 @@ type GasTracing interface {
 	GetGasTrace() map[string]map[string][]uint64
 	IsInterfaceNil() bool
 }
+
+// MetricsRegistry records the metrics of the VM hosts; a node can plug in its own implementation.
+// The metrics are identified by name and labels, and the registry returns the same metric for the same identity.
+type MetricsRegistry interface {
+	Counter(name string, help string, labels ...MetricLabel) MetricCounter
+	Histogram(name string, help string, buckets []float64, labels ...MetricLabel) MetricHistogram
+	IsInterfaceNil() bool
+}
+
+// MetricCounter is a metric which only increases, e.g. the number of executions
+type MetricCounter interface {
+	Add(value uint64)
+}
+
+// MetricHistogram counts the observed values in buckets, e.g. the durations of the executions
+type MetricHistogram interface {
+	Observe(value float64)
+}
@@ -0,0 +1,61 @@
+package arwen
+
+// MetricLabel is a dimension of a metric, e.g. the entry point of the executions
+type MetricLabel struct {
+	Name  string
+	Value string
+}
+
+// The metrics recorded by the VM hosts, in the naming convention of Prometheus
+const (
+	// MetricExecutions counts the executions by entry point and return code
+	MetricExecutions     = "arwen_executions_total"
+	MetricExecutionsHelp = "Number of executions of smart contracts, by entry point and return code."
+
+	// MetricExecutionDuration measures the duration of the executions by entry point
+	MetricExecutionDuration     = "arwen_execution_duration_seconds"
+	MetricExecutionDurationHelp = "Duration of the executions of smart contracts, by entry point."
+
+	// MetricGasUsed adds up the gas used by the executions by entry point
+	MetricGasUsed     = "arwen_gas_used_total"
+	MetricGasUsedHelp = "Gas used by the executions of smart contracts, by entry point."
+
+	// MetricExecutionTimeouts counts the executions which failed with ErrExecutionFailedWithTimeout
+	MetricExecutionTimeouts     = "arwen_execution_timeouts_total"
+	MetricExecutionTimeoutsHelp = "Number of executions of smart contracts which timed out, by entry point."
+
+	// MetricExecutionPanics counts the executions which failed with ErrExecutionPanicked
+	MetricExecutionPanics     = "arwen_execution_panics_total"
+	MetricExecutionPanicsHelp = "Number of executions of smart contracts which panicked, by entry point."
+
+	// MetricInstances counts the instances of contracts by source: a warm instance, the compiled code or the bytecode
+	MetricInstances     = "arwen_instances_total"
+	MetricInstancesHelp = "Number of instances of smart contracts started, by source."
+
+	// MetricInstanceDuration measures the time to start the instances of contracts by source,
+	// e.g. the compilation of the bytecode versus the load of the compiled code
+	MetricInstanceDuration     = "arwen_instance_duration_seconds"
+	MetricInstanceDurationHelp = "Duration of starting the instances of smart contracts, by source."
+
+	// MetricEEICalls counts the calls of the functions of the VMHooks by the contracts
+	MetricEEICalls     = "arwen_eei_calls_total"
+	MetricEEICallsHelp = "Number of calls of the Elrond Environment Interface functions by smart contracts, by function."
+)
+
+// The labels of the metrics recorded by the VM hosts
+const (
+	MetricLabelEntryPoint = "entry_point"
+	MetricLabelReturnCode = "return_code"
+	MetricLabelSource     = "source"
+	MetricLabelFunction   = "function"
+)
+
+// The values of the source label of the instances
+const (
+	InstanceSourceWarm         = "warm"
+	InstanceSourceCompiledCode = "compiledCode"
+	InstanceSourceBytecode     = "bytecode"
+)
+
+// MetricDurationBuckets are the upper bounds, in seconds, of the buckets of the duration histograms
+var MetricDurationBuckets = []float64{0.0001, 0.00025, 0.0005, 0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5}
@@ -0,0 +1,86 @@
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// PrometheusContentType is the content type of the Prometheus text format, written by WritePrometheus
+const PrometheusContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// WritePrometheus writes all the metrics in the Prometheus text format, sorted by name and labels
+func (registry *Registry) WritePrometheus(writer io.Writer) error {
+	registry.mutex.RLock()
+	families := make([]*family, 0, len(registry.families))
+	for _, metricFamily := range registry.families {
+		families = append(families, metricFamily)
+	}
+	registry.mutex.RUnlock()
+
+	sort.Slice(families, func(i, j int) bool {
+		return families[i].name < families[j].name
+	})
+
+	buffered := bufio.NewWriter(writer)
+	for _, metricFamily := range families {
+		registry.writeFamily(buffered, metricFamily)
+	}
+	return buffered.Flush()
+}
+
+func (registry *Registry) writeFamily(writer *bufio.Writer, metricFamily *family) {
+	registry.mutex.RLock()
+	keys := make([]string, 0, len(metricFamily.metrics))
+	metrics := make(map[string]interface{}, len(metricFamily.metrics))
+	for key, metric := range metricFamily.metrics {
+		keys = append(keys, key)
+		metrics[key] = metric
+	}
+	registry.mutex.RUnlock()
+	sort.Strings(keys)
+
+	fmt.Fprintf(writer, "# HELP %s %s\n", metricFamily.name, helpEscaper.Replace(metricFamily.help))
+	fmt.Fprintf(writer, "# TYPE %s %s\n", metricFamily.name, metricFamily.metricType)
+	for _, key := range keys {
+		switch metric := metrics[key].(type) {
+		case *counter:
+			fmt.Fprintf(writer, "%s%s %d\n", metricFamily.name, key, metric.get())
+		case *histogram:
+			writeHistogram(writer, metricFamily.name, key, metric)
+		}
+	}
+}
+
+func writeHistogram(writer *bufio.Writer, name string, labels string, metric *histogram) {
+	cumulativeCounts, sum := metric.snapshot()
+	for i, upperBound := range metric.upperBounds {
+		fmt.Fprintf(writer, "%s_bucket%s %d\n", name, withLabel(labels, "le", formatFloat(upperBound)), cumulativeCounts[i])
+	}
+	count := cumulativeCounts[len(cumulativeCounts)-1]
+	fmt.Fprintf(writer, "%s_bucket%s %d\n", name, withLabel(labels, "le", "+Inf"), count)
+	fmt.Fprintf(writer, "%s_sum%s %s\n", name, labels, formatFloat(sum))
+	fmt.Fprintf(writer, "%s_count%s %d\n", name, labels, count)
+}
+
+// withLabel adds a label after the formatted labels
+func withLabel(labels string, name string, value string) string {
+	label := name + `="` + value + `"`
+	if len(labels) == 0 {
+		return "{" + label + "}"
+	}
+	return labels[:len(labels)-1] + "," + label + "}"
+}
+
+func formatFloat(value float64) string {
+	if math.IsInf(value, 1) {
+		return "+Inf"
+	}
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+var helpEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
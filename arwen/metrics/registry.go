@@ -0,0 +1,206 @@
+package metrics
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+)
+
+var log = logger.GetOrCreate("arwen/metrics")
+
+var _ arwen.MetricsRegistry = (*Registry)(nil)
+
+const (
+	counterType   = "counter"
+	histogramType = "histogram"
+)
+
+// Registry keeps the metrics of the VM hosts in memory, and exports them in the Prometheus text format.
+// It can be shared by several hosts, and used concurrently.
+type Registry struct {
+	mutex    sync.RWMutex
+	families map[string]*family
+}
+
+// family groups the metrics with the same name, which differ by their labels
+type family struct {
+	name       string
+	help       string
+	metricType string
+	buckets    []float64
+	metrics    map[string]interface{}
+}
+
+// NewRegistry creates an empty registry
+func NewRegistry() *Registry {
+	return &Registry{
+		families: make(map[string]*family),
+	}
+}
+
+// Counter returns the counter with the given name and labels, creating it if needed
+func (registry *Registry) Counter(name string, help string, labels ...arwen.MetricLabel) arwen.MetricCounter {
+	metric := registry.getOrCreate(name, help, counterType, nil, labels, func(_ *family) interface{} {
+		return &counter{}
+	})
+	if metric == nil {
+		return &counter{}
+	}
+	return metric.(*counter)
+}
+
+// Histogram returns the histogram with the given name and labels, creating it if needed;
+// the buckets are only taken from the first histogram of the name
+func (registry *Registry) Histogram(name string, help string, buckets []float64, labels ...arwen.MetricLabel) arwen.MetricHistogram {
+	sortedBuckets := make([]float64, len(buckets))
+	copy(sortedBuckets, buckets)
+	sort.Float64s(sortedBuckets)
+
+	metric := registry.getOrCreate(name, help, histogramType, sortedBuckets, labels, func(metricFamily *family) interface{} {
+		return newHistogram(metricFamily.buckets)
+	})
+	if metric == nil {
+		return newHistogram(sortedBuckets)
+	}
+	return metric.(*histogram)
+}
+
+// getOrCreate returns the metric of the family with the given name and labels, or nil if the family has another type
+func (registry *Registry) getOrCreate(
+	name string,
+	help string,
+	metricType string,
+	buckets []float64,
+	labels []arwen.MetricLabel,
+	create func(metricFamily *family) interface{},
+) interface{} {
+	key := formatLabels(labels)
+
+	registry.mutex.RLock()
+	metricFamily, ok := registry.families[name]
+	if ok && metricFamily.metricType == metricType {
+		metric, found := metricFamily.metrics[key]
+		if found {
+			registry.mutex.RUnlock()
+			return metric
+		}
+	}
+	registry.mutex.RUnlock()
+
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	metricFamily, ok = registry.families[name]
+	if !ok {
+		metricFamily = &family{
+			name:       name,
+			help:       help,
+			metricType: metricType,
+			buckets:    buckets,
+			metrics:    make(map[string]interface{}),
+		}
+		registry.families[name] = metricFamily
+	}
+	if metricFamily.metricType != metricType {
+		log.Error("metric registered with another type, it will not be exported", "name", name, "type", metricType)
+		return nil
+	}
+
+	metric, found := metricFamily.metrics[key]
+	if found {
+		return metric
+	}
+	metric = create(metricFamily)
+	metricFamily.metrics[key] = metric
+	return metric
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (registry *Registry) IsInterfaceNil() bool {
+	return registry == nil
+}
+
+// formatLabels formats the labels as in the Prometheus text format, sorted by name,
+// e.g. {entry_point="RunSmartContractCall",return_code="ok"}
+func formatLabels(labels []arwen.MetricLabel) string {
+	all := make([]arwen.MetricLabel, len(labels))
+	copy(all, labels)
+	sort.SliceStable(all, func(i, j int) bool {
+		return all[i].Name < all[j].Name
+	})
+	if len(all) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+	sb.WriteString("{")
+	for i, label := range all {
+		if i > 0 {
+			sb.WriteString(",")
+		}
+		sb.WriteString(label.Name)
+		sb.WriteString(`="`)
+		sb.WriteString(labelValueEscaper.Replace(label.Value))
+		sb.WriteString(`"`)
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+var labelValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+type counter struct {
+	value uint64
+}
+
+// Add increases the counter
+func (c *counter) Add(value uint64) {
+	atomic.AddUint64(&c.value, value)
+}
+
+func (c *counter) get() uint64 {
+	return atomic.LoadUint64(&c.value)
+}
+
+type histogram struct {
+	mutex sync.Mutex
+	// upperBounds are the sorted upper bounds of the buckets; the last bucket, for the larger values, is implicit
+	upperBounds []float64
+	counts      []uint64
+	sum         float64
+}
+
+func newHistogram(upperBounds []float64) *histogram {
+	return &histogram{
+		upperBounds: upperBounds,
+		counts:      make([]uint64, len(upperBounds)+1),
+	}
+}
+
+// Observe counts the value in its bucket
+func (h *histogram) Observe(value float64) {
+	bucket := sort.SearchFloat64s(h.upperBounds, value)
+
+	h.mutex.Lock()
+	h.counts[bucket]++
+	h.sum += value
+	h.mutex.Unlock()
+}
+
+// snapshot returns the cumulative counts of the buckets, the last one being the count of all the values, and the sum
+func (h *histogram) snapshot() ([]uint64, float64) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	cumulativeCounts := make([]uint64, len(h.counts))
+	var total uint64
+	for i, count := range h.counts {
+		total += count
+		cumulativeCounts[i] = total
+	}
+	return cumulativeCounts, h.sum
+}
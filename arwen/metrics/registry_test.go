@@ -0,0 +1,93 @@
+package metrics
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	"github.com/stretchr/testify/require"
+)
+
+func exportPrometheus(t *testing.T, registry *Registry) string {
+	var buffer bytes.Buffer
+	err := registry.WritePrometheus(&buffer)
+	require.Nil(t, err)
+	return buffer.String()
+}
+
+func TestRegistry_Counter(t *testing.T) {
+	registry := NewRegistry()
+	okLabels := []arwen.MetricLabel{{Name: "return_code", Value: "ok"}, {Name: "entry_point", Value: "call"}}
+
+	registry.Counter("executions_total", "Number of executions.", okLabels...).Add(2)
+	registry.Counter("executions_total", "Number of executions.",
+		arwen.MetricLabel{Name: "entry_point", Value: "call"},
+		arwen.MetricLabel{Name: "return_code", Value: "ok"},
+	).Add(1)
+	registry.Counter("executions_total", "Number of executions.",
+		arwen.MetricLabel{Name: "entry_point", Value: "create"},
+		arwen.MetricLabel{Name: "return_code", Value: `out "of" gas`},
+	).Add(1)
+	registry.Counter("a_total", "Line\nbreak.").Add(5)
+
+	expected := `# HELP a_total Line\nbreak.
+# TYPE a_total counter
+a_total 5
+# HELP executions_total Number of executions.
+# TYPE executions_total counter
+executions_total{entry_point="call",return_code="ok"} 3
+executions_total{entry_point="create",return_code="out \"of\" gas"} 1
+`
+	require.Equal(t, expected, exportPrometheus(t, registry))
+}
+
+func TestRegistry_Histogram(t *testing.T) {
+	registry := NewRegistry()
+	histogram := registry.Histogram("duration_seconds", "Duration.", []float64{1, 0.1}, arwen.MetricLabel{Name: "source", Value: "warm"})
+	histogram.Observe(0.05)
+	histogram.Observe(0.1)
+	histogram.Observe(0.5)
+	histogram.Observe(3)
+
+	expected := `# HELP duration_seconds Duration.
+# TYPE duration_seconds histogram
+duration_seconds_bucket{source="warm",le="0.1"} 2
+duration_seconds_bucket{source="warm",le="1"} 3
+duration_seconds_bucket{source="warm",le="+Inf"} 4
+duration_seconds_sum{source="warm"} 3.65
+duration_seconds_count{source="warm"} 4
+`
+	require.Equal(t, expected, exportPrometheus(t, registry))
+}
+
+func TestRegistry_SameMetric(t *testing.T) {
+	registry := NewRegistry()
+	first := registry.Counter("calls_total", "Calls.", arwen.MetricLabel{Name: "function", Value: "getGasLeft"})
+	second := registry.Counter("calls_total", "Calls.", arwen.MetricLabel{Name: "function", Value: "getGasLeft"})
+	require.True(t, first == second)
+
+	conflicting := registry.Histogram("calls_total", "Calls.", []float64{1})
+	conflicting.Observe(1)
+	require.NotContains(t, exportPrometheus(t, registry), "histogram")
+}
+
+func TestRegistry_Concurrency(t *testing.T) {
+	registry := NewRegistry()
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				registry.Counter("calls_total", "Calls.").Add(1)
+				registry.Histogram("duration_seconds", "Duration.", []float64{1}).Observe(0.5)
+			}
+		}()
+	}
+	wg.Wait()
+
+	export := exportPrometheus(t, registry)
+	require.Contains(t, export, "calls_total 8000\n")
+	require.Contains(t, export, "duration_seconds_count 8000\n")
+}
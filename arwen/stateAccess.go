@@ -0,0 +1,302 @@
+package arwen
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+)
+
+// AccountField identifies a part of an account, other than its storage
+type AccountField int
+
+const (
+	// AccountExistence is the existence of the account, read e.g. by AccountExists and GetUserAccount
+	AccountExistence AccountField = iota
+
+	// AccountBalance is the EGLD balance of the account
+	AccountBalance
+
+	// AccountNonce is the nonce of the account
+	AccountNonce
+
+	// AccountCode is the code of the account, with its hash, metadata and owner
+	AccountCode
+)
+
+// ESDTStorageKey returns the storage key under which the node keeps the ESDT data of an account,
+// i.e. the balance of a fungible token or the data of an NFT when the nonce is not 0
+func ESDTStorageKey(tokenID []byte, nonce uint64) []byte {
+	key := append([]byte(core.ElrondProtectedKeyPrefix+core.ESDTKeyIdentifier), tokenID...)
+	if nonce > 0 {
+		key = append(key, big.NewInt(0).SetUint64(nonce).Bytes()...)
+	}
+	return key
+}
+
+// AccountAccess holds the accessed parts of an account
+type AccountAccess struct {
+	Address     []byte
+	Existence   bool
+	Balance     bool
+	Nonce       bool
+	Code        bool
+	StorageKeys map[string]struct{}
+}
+
+func newAccountAccess(address []byte) *AccountAccess {
+	return &AccountAccess{
+		Address:     address,
+		StorageKeys: make(map[string]struct{}),
+	}
+}
+
+// SortedStorageKeys returns the accessed storage keys, in ascending order
+func (access *AccountAccess) SortedStorageKeys() [][]byte {
+	keys := make([][]byte, 0, len(access.StorageKeys))
+	for key := range access.StorageKeys {
+		keys = append(keys, []byte(key))
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(keys[i], keys[j]) < 0
+	})
+	return keys
+}
+
+func (access *AccountAccess) add(field AccountField) {
+	switch field {
+	case AccountExistence:
+		access.Existence = true
+	case AccountBalance:
+		access.Balance = true
+	case AccountNonce:
+		access.Nonce = true
+	case AccountCode:
+		access.Code = true
+	}
+}
+
+// overlaps returns true if both accesses touch the same part of the account; reading
+// the existence of an account overlaps with any access to it
+func (access *AccountAccess) overlaps(other *AccountAccess) bool {
+	if access.Existence || other.Existence {
+		return true
+	}
+	if (access.Balance && other.Balance) || (access.Nonce && other.Nonce) || (access.Code && other.Code) {
+		return true
+	}
+
+	smaller, larger := access.StorageKeys, other.StorageKeys
+	if len(smaller) > len(larger) {
+		smaller, larger = larger, smaller
+	}
+	for key := range smaller {
+		if _, ok := larger[key]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (access *AccountAccess) clone() *AccountAccess {
+	cloned := *access
+	cloned.StorageKeys = make(map[string]struct{}, len(access.StorageKeys))
+	for key := range access.StorageKeys {
+		cloned.StorageKeys[key] = struct{}{}
+	}
+	return &cloned
+}
+
+// StateAccessSet holds the accessed parts of the accounts, by address
+type StateAccessSet struct {
+	accounts map[string]*AccountAccess
+}
+
+// NewStateAccessSet creates an empty set
+func NewStateAccessSet() *StateAccessSet {
+	return &StateAccessSet{
+		accounts: make(map[string]*AccountAccess),
+	}
+}
+
+// Add adds a part of the account with the given address to the set
+func (set *StateAccessSet) Add(address []byte, field AccountField) {
+	set.getOrCreate(address).add(field)
+}
+
+// AddStorageKey adds a storage key of the account with the given address to the set
+func (set *StateAccessSet) AddStorageKey(address []byte, key []byte) {
+	set.getOrCreate(address).StorageKeys[string(key)] = struct{}{}
+}
+
+// GetAccount returns the accessed parts of the account with the given address, or nil if the account was not accessed
+func (set *StateAccessSet) GetAccount(address []byte) *AccountAccess {
+	return set.accounts[string(address)]
+}
+
+// Accounts returns the accessed accounts, ordered by address
+func (set *StateAccessSet) Accounts() []*AccountAccess {
+	accounts := make([]*AccountAccess, 0, len(set.accounts))
+	for _, access := range set.accounts {
+		accounts = append(accounts, access)
+	}
+	sort.Slice(accounts, func(i, j int) bool {
+		return bytes.Compare(accounts[i].Address, accounts[j].Address) < 0
+	})
+	return accounts
+}
+
+// Overlaps returns true if both sets access the same part of an account
+func (set *StateAccessSet) Overlaps(other *StateAccessSet) bool {
+	for address, access := range set.accounts {
+		otherAccess, ok := other.accounts[address]
+		if ok && access.overlaps(otherAccess) {
+			return true
+		}
+	}
+	return false
+}
+
+func (set *StateAccessSet) getOrCreate(address []byte) *AccountAccess {
+	access, ok := set.accounts[string(address)]
+	if !ok {
+		access = newAccountAccess(address)
+		set.accounts[string(address)] = access
+	}
+	return access
+}
+
+func (set *StateAccessSet) clone() *StateAccessSet {
+	cloned := NewStateAccessSet()
+	for address, access := range set.accounts {
+		cloned.accounts[address] = access.clone()
+	}
+	return cloned
+}
+
+// StateAccessSets are the parts of the state read and written by a transaction
+type StateAccessSets struct {
+	Reads  *StateAccessSet
+	Writes *StateAccessSet
+}
+
+// ConflictsWith returns true if the transactions cannot be executed in parallel,
+// because one of them writes what the other one reads or writes
+func (sets *StateAccessSets) ConflictsWith(other *StateAccessSets) bool {
+	return sets.Writes.Overlaps(other.Writes) ||
+		sets.Writes.Overlaps(other.Reads) ||
+		other.Writes.Overlaps(sets.Reads)
+}
+
+// StateAccessTracer records the parts of the state accessed by a transaction. The reads are
+// kept for the whole transaction, even those of the failed nested calls, while the writes follow
+// the state stack of the OutputContext, so that the writes of the failed nested calls are dropped.
+// All its methods do nothing on a nil tracer, which the host holds when the recording is disabled.
+type StateAccessTracer struct {
+	reads      *StateAccessSet
+	writes     *StateAccessSet
+	stateStack []*StateAccessSet
+}
+
+// NewStateAccessTracer creates a tracer with empty sets
+func NewStateAccessTracer() *StateAccessTracer {
+	tracer := &StateAccessTracer{}
+	tracer.InitState()
+	return tracer
+}
+
+// InitState empties the sets, before a new transaction
+func (tracer *StateAccessTracer) InitState() {
+	if tracer == nil {
+		return
+	}
+	tracer.reads = NewStateAccessSet()
+	tracer.writes = NewStateAccessSet()
+	tracer.stateStack = make([]*StateAccessSet, 0)
+}
+
+// PushState saves the current writes, before a nested call
+func (tracer *StateAccessTracer) PushState() {
+	if tracer == nil {
+		return
+	}
+	tracer.stateStack = append(tracer.stateStack, tracer.writes.clone())
+}
+
+// PopSetActiveState restores the writes saved before a nested call that failed
+func (tracer *StateAccessTracer) PopSetActiveState() {
+	if tracer == nil || len(tracer.stateStack) == 0 {
+		return
+	}
+	stateStackLen := len(tracer.stateStack)
+	tracer.writes = tracer.stateStack[stateStackLen-1]
+	tracer.stateStack = tracer.stateStack[:stateStackLen-1]
+}
+
+// PopDiscard keeps the writes of a nested call that succeeded
+func (tracer *StateAccessTracer) PopDiscard() {
+	if tracer == nil || len(tracer.stateStack) == 0 {
+		return
+	}
+	tracer.stateStack = tracer.stateStack[:len(tracer.stateStack)-1]
+}
+
+// ClearStateStack reinitializes the state stack
+func (tracer *StateAccessTracer) ClearStateStack() {
+	if tracer == nil {
+		return
+	}
+	tracer.stateStack = make([]*StateAccessSet, 0)
+}
+
+// DiscardWrites empties the writes, when the transaction fails
+func (tracer *StateAccessTracer) DiscardWrites() {
+	if tracer == nil {
+		return
+	}
+	tracer.writes = NewStateAccessSet()
+}
+
+// RecordRead records the read of a part of an account
+func (tracer *StateAccessTracer) RecordRead(address []byte, field AccountField) {
+	if tracer == nil {
+		return
+	}
+	tracer.reads.Add(address, field)
+}
+
+// RecordStorageRead records the read of a storage key of an account
+func (tracer *StateAccessTracer) RecordStorageRead(address []byte, key []byte) {
+	if tracer == nil {
+		return
+	}
+	tracer.reads.AddStorageKey(address, key)
+}
+
+// RecordWrite records the write of a part of an account
+func (tracer *StateAccessTracer) RecordWrite(address []byte, field AccountField) {
+	if tracer == nil {
+		return
+	}
+	tracer.writes.Add(address, field)
+}
+
+// RecordStorageWrite records the write of a storage key of an account
+func (tracer *StateAccessTracer) RecordStorageWrite(address []byte, key []byte) {
+	if tracer == nil {
+		return
+	}
+	tracer.writes.AddStorageKey(address, key)
+}
+
+// GetStateAccess returns a copy of the sets recorded since the beginning of the transaction, or nil on a nil tracer
+func (tracer *StateAccessTracer) GetStateAccess() *StateAccessSets {
+	if tracer == nil {
+		return nil
+	}
+	return &StateAccessSets{
+		Reads:  tracer.reads.clone(),
+		Writes: tracer.writes.clone(),
+	}
+}
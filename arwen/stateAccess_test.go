@@ -0,0 +1,79 @@
+package arwen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestESDTStorageKey(t *testing.T) {
+	require.Equal(t, []byte("ELRONDesdtTOKEN-abcdef"), ESDTStorageKey([]byte("TOKEN-abcdef"), 0))
+	require.Equal(t, []byte("ELRONDesdtNFT-abcdef\x01\x00"), ESDTStorageKey([]byte("NFT-abcdef"), 256))
+}
+
+func TestStateAccessSet_Overlaps(t *testing.T) {
+	alice, bob := []byte("alice"), []byte("bob")
+
+	reads := NewStateAccessSet()
+	reads.Add(alice, AccountBalance)
+	reads.AddStorageKey(bob, []byte("key"))
+
+	writes := NewStateAccessSet()
+	writes.Add(alice, AccountNonce)
+	writes.AddStorageKey(bob, []byte("other"))
+	require.False(t, reads.Overlaps(writes))
+	require.False(t, writes.Overlaps(reads))
+
+	writes.AddStorageKey(bob, []byte("key"))
+	require.True(t, reads.Overlaps(writes))
+	require.True(t, writes.Overlaps(reads))
+
+	existence := NewStateAccessSet()
+	existence.Add(alice, AccountExistence)
+	require.True(t, existence.Overlaps(writes))
+	require.False(t, existence.Overlaps(NewStateAccessSet()))
+
+	accounts := writes.Accounts()
+	require.Len(t, accounts, 2)
+	require.Equal(t, alice, accounts[0].Address)
+	require.Equal(t, [][]byte{[]byte("key"), []byte("other")}, accounts[1].SortedStorageKeys())
+}
+
+func TestStateAccessTracer_StateStack(t *testing.T) {
+	address := []byte("contract")
+	tracer := NewStateAccessTracer()
+
+	tracer.RecordStorageWrite(address, []byte("parent"))
+	tracer.PushState()
+	tracer.RecordStorageRead(address, []byte("failed"))
+	tracer.RecordStorageWrite(address, []byte("failed"))
+	tracer.PopSetActiveState()
+
+	tracer.PushState()
+	tracer.RecordWrite(address, AccountBalance)
+	tracer.PopDiscard()
+
+	stateAccess := tracer.GetStateAccess()
+	require.Equal(t, [][]byte{[]byte("failed")}, stateAccess.Reads.GetAccount(address).SortedStorageKeys())
+	writes := stateAccess.Writes.GetAccount(address)
+	require.True(t, writes.Balance)
+	require.Equal(t, [][]byte{[]byte("parent")}, writes.SortedStorageKeys())
+
+	tracer.DiscardWrites()
+	require.Nil(t, tracer.GetStateAccess().Writes.GetAccount(address))
+	require.NotNil(t, stateAccess.Writes.GetAccount(address))
+
+	tracer.InitState()
+	require.Empty(t, tracer.GetStateAccess().Reads.Accounts())
+}
+
+func TestStateAccessTracer_Nil(t *testing.T) {
+	var tracer *StateAccessTracer
+	tracer.InitState()
+	tracer.PushState()
+	tracer.RecordRead([]byte("address"), AccountCode)
+	tracer.RecordStorageWrite([]byte("address"), []byte("key"))
+	tracer.PopSetActiveState()
+	tracer.DiscardWrites()
+	require.Nil(t, tracer.GetStateAccess())
+}
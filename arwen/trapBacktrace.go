@@ -0,0 +1,56 @@
+package arwen
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/wasmer"
+)
+
+// TrapBacktrace describes where the execution of a contract trapped, e.g. on unreachable,
+// an out of bounds memory access or a division by zero
+type TrapBacktrace struct {
+	Address []byte
+	Trap    string
+
+	// Frames is the WebAssembly call stack at the trap, innermost function first
+	Frames []wasmer.TrapFrame
+
+	// HasCallStack is false when the backend cannot walk the call stack, which is the case of Wasmer;
+	// only the Go backend, see VMHostParameters.WasmBackend, fills in Frames
+	HasCallStack bool
+}
+
+// noCallStackNote replaces the frames of the traps for which the backend did not capture the call stack
+const noCallStackNote = "(no call stack: only the Go backend captures it, see VMHostParameters.WasmBackend)"
+
+// NewTrapBacktrace describes the execution error of the contract with the given address
+func NewTrapBacktrace(address []byte, executionErr error) *TrapBacktrace {
+	backtrace := &TrapBacktrace{
+		Address: address,
+		Trap:    executionErr.Error(),
+	}
+
+	trapErr := wasmer.GetTrapError(executionErr)
+	if trapErr != nil {
+		backtrace.Trap = trapErr.Error()
+		backtrace.Frames = trapErr.Frames
+		backtrace.HasCallStack = true
+	}
+	return backtrace
+}
+
+// String formats the trap on the first line, followed by a line for each frame,
+// or by a note saying that the call stack is not available
+func (backtrace *TrapBacktrace) String() string {
+	var sb strings.Builder
+	sb.WriteString(backtrace.Trap)
+	if !backtrace.HasCallStack {
+		sb.WriteString("\n  " + noCallStackNote)
+		return sb.String()
+	}
+	for i, frame := range backtrace.Frames {
+		sb.WriteString(fmt.Sprintf("\n  #%d %s", i, frame.String()))
+	}
+	return sb.String()
+}
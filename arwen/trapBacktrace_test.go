@@ -0,0 +1,33 @@
+package arwen
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/wasmer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTrapBacktrace_WithCallStack(t *testing.T) {
+	t.Parallel()
+
+	trapErr := &wasmer.TrapError{
+		Err: errors.New("unreachable"),
+		Frames: []wasmer.TrapFrame{
+			{FunctionIndex: 3, FunctionName: "fail", CodeOffset: 0x2a},
+			{FunctionIndex: 1, CodeOffset: 0x10},
+		},
+	}
+	backtrace := NewTrapBacktrace([]byte("contract"), trapErr)
+	require.True(t, backtrace.HasCallStack)
+	require.Equal(t, "unreachable\n  #0 fail (func 3) at 0x2a\n  #1 func 1 at 0x10", backtrace.String())
+}
+
+func TestNewTrapBacktrace_WithoutCallStack(t *testing.T) {
+	t.Parallel()
+
+	backtrace := NewTrapBacktrace([]byte("contract"), errors.New("unreachable"))
+	require.False(t, backtrace.HasCallStack)
+	require.Empty(t, backtrace.Frames)
+	require.Equal(t, "unreachable\n  "+noCallStackNote, backtrace.String())
+}
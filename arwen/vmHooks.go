@@ -0,0 +1,257 @@
+package arwen
+
+// VMHooks is the Elrond Environment Interface: the functions that the smart contracts import.
+// The implementations are typed and do not depend on the executor, the cgo adapters of Wasmer are generated from this interface.
+type VMHooks interface {
+	MainVMHooks
+	BigIntVMHooks
+	SmallIntVMHooks
+	ManagedVMHooks
+	ManagedBufferVMHooks
+	CryptoVMHooks
+}
+
+// MainVMHooks contains the Elrond API functions of the VMHooks
+type MainVMHooks interface {
+	GetGasLeft() int64
+	GetSCAddress(resultOffset int32)
+	GetOwnerAddress(resultOffset int32)
+	GetShardOfAddress(addressOffset int32) int32
+	IsSmartContract(addressOffset int32) int32
+	SignalError(messageOffset int32, messageLength int32)
+	GetExternalBalance(addressOffset int32, resultOffset int32)
+	GetBlockHash(nonce int64, resultOffset int32) int32
+	GetESDTBalance(addressOffset int32, tokenIDOffset int32, tokenIDLen int32, nonce int64, resultOffset int32) int32
+	GetESDTNFTNameLength(addressOffset int32, tokenIDOffset int32, tokenIDLen int32, nonce int64) int32
+	GetESDTNFTAttributeLength(addressOffset int32, tokenIDOffset int32, tokenIDLen int32, nonce int64) int32
+	GetESDTNFTURILength(addressOffset int32, tokenIDOffset int32, tokenIDLen int32, nonce int64) int32
+	GetESDTTokenData(addressOffset int32, tokenIDOffset int32, tokenIDLen int32, nonce int64, valueHandle int32, propertiesOffset int32, hashOffset int32, nameOffset int32, attributesOffset int32, creatorOffset int32, royaltiesHandle int32, urisOffset int32) int32
+	GetESDTLocalRoles(tokenIdHandle int32) int64
+	ValidateTokenIdentifier(tokenIdHandle int32) int32
+	TransferValue(destOffset int32, valueOffset int32, dataOffset int32, length int32) int32
+	TransferValueExecute(destOffset int32, valueOffset int32, gasLimit int64, functionOffset int32, functionLength int32, numArguments int32, argumentsLengthOffset int32, dataOffset int32) int32
+	TransferESDTExecute(destOffset int32, tokenIDOffset int32, tokenIDLen int32, valueOffset int32, gasLimit int64, functionOffset int32, functionLength int32, numArguments int32, argumentsLengthOffset int32, dataOffset int32) int32
+	TransferESDTNFTExecute(destOffset int32, tokenIDOffset int32, tokenIDLen int32, valueOffset int32, nonce int64, gasLimit int64, functionOffset int32, functionLength int32, numArguments int32, argumentsLengthOffset int32, dataOffset int32) int32
+	MultiTransferESDTNFTExecute(destOffset int32, numTokenTransfers int32, tokenTransfersArgsLengthOffset int32, tokenTransferDataOffset int32, gasLimit int64, functionOffset int32, functionLength int32, numArguments int32, argumentsLengthOffset int32, dataOffset int32) int32
+	UpgradeContract(destOffset int32, gasLimit int64, valueOffset int32, codeOffset int32, codeMetadataOffset int32, length int32, numArguments int32, argumentsLengthOffset int32, dataOffset int32)
+	UpgradeFromSourceContract(destOffset int32, gasLimit int64, valueOffset int32, sourceContractAddressOffset int32, codeMetadataOffset int32, numArguments int32, argumentsLengthOffset int32, dataOffset int32)
+	AsyncCall(destOffset int32, valueOffset int32, dataOffset int32, length int32)
+	CreateAsyncCall(asyncContextIdentifier int32, identifierLength int32, destOffset int32, valueOffset int32, dataOffset int32, length int32, successOffset int32, successLength int32, errorOffset int32, errorLength int32, gas int64)
+	GetArgumentLength(id int32) int32
+	GetArgument(id int32, argOffset int32) int32
+	GetFunction(functionOffset int32) int32
+	GetNumArguments() int32
+	StorageStore(keyOffset int32, keyLength int32, dataOffset int32, dataLength int32) int32
+	StorageLoadLength(keyOffset int32, keyLength int32) int32
+	StorageLoadFromAddress(addressOffset int32, keyOffset int32, keyLength int32, dataOffset int32) int32
+	StorageLoad(keyOffset int32, keyLength int32, dataOffset int32) int32
+	SetStorageLock(keyOffset int32, keyLength int32, lockTimestamp int64) int32
+	GetStorageLock(keyOffset int32, keyLength int32) int64
+	IsStorageLocked(keyOffset int32, keyLength int32) int32
+	ClearStorageLock(keyOffset int32, keyLength int32) int32
+	GetCaller(resultOffset int32)
+	CheckNoPayment()
+	GetCallValue(resultOffset int32) int32
+	GetESDTValue(resultOffset int32) int32
+	GetESDTValueByIndex(resultOffset int32, index int32) int32
+	GetESDTTokenName(resultOffset int32) int32
+	GetESDTTokenNameByIndex(resultOffset int32, index int32) int32
+	GetESDTTokenNonce() int64
+	GetESDTTokenNonceByIndex(index int32) int64
+	GetCurrentESDTNFTNonce(addressOffset int32, tokenIDOffset int32, tokenIDLen int32) int64
+	GetESDTTokenType() int32
+	GetESDTTokenTypeByIndex(index int32) int32
+	GetNumESDTTransfers() int32
+	GetCallValueTokenName(callValueOffset int32, tokenNameOffset int32) int32
+	GetCallValueTokenNameByIndex(callValueOffset int32, tokenNameOffset int32, index int32) int32
+	WriteLog(dataPointer int32, dataLength int32, topicPtr int32, numTopics int32)
+	WriteEventLog(numTopics int32, topicLengthsOffset int32, topicOffset int32, dataOffset int32, dataLength int32)
+	GetBlockTimestamp() int64
+	GetBlockNonce() int64
+	GetBlockRound() int64
+	GetBlockEpoch() int64
+	GetBlockRandomSeed(pointer int32)
+	GetStateRootHash(pointer int32)
+	GetPrevBlockTimestamp() int64
+	GetPrevBlockNonce() int64
+	GetPrevBlockRound() int64
+	GetPrevBlockEpoch() int64
+	GetPrevBlockRandomSeed(pointer int32)
+	Finish(pointer int32, length int32)
+	ExecuteOnSameContext(gasLimit int64, addressOffset int32, valueOffset int32, functionOffset int32, functionLength int32, numArguments int32, argumentsLengthOffset int32, dataOffset int32) int32
+	ExecuteOnDestContext(gasLimit int64, addressOffset int32, valueOffset int32, functionOffset int32, functionLength int32, numArguments int32, argumentsLengthOffset int32, dataOffset int32) int32
+	ExecuteOnDestContextByCaller(gasLimit int64, addressOffset int32, valueOffset int32, functionOffset int32, functionLength int32, numArguments int32, argumentsLengthOffset int32, dataOffset int32) int32
+	ExecuteReadOnly(gasLimit int64, addressOffset int32, functionOffset int32, functionLength int32, numArguments int32, argumentsLengthOffset int32, dataOffset int32) int32
+	CreateContract(gasLimit int64, valueOffset int32, codeOffset int32, codeMetadataOffset int32, length int32, resultOffset int32, numArguments int32, argumentsLengthOffset int32, dataOffset int32) int32
+	DeployFromSourceContract(gasLimit int64, valueOffset int32, sourceContractAddressOffset int32, codeMetadataOffset int32, resultAddressOffset int32, numArguments int32, argumentsLengthOffset int32, dataOffset int32) int32
+	GetNumReturnData() int32
+	GetReturnDataSize(resultID int32) int32
+	GetReturnData(resultID int32, dataOffset int32) int32
+	CleanReturnData()
+	DeleteFromReturnData(resultID int32)
+	GetOriginalTxHash(dataOffset int32)
+}
+
+// BigIntVMHooks contains the big int functions of the VMHooks
+type BigIntVMHooks interface {
+	BigIntGetUnsignedArgument(id int32, destinationHandle int32)
+	BigIntGetSignedArgument(id int32, destinationHandle int32)
+	BigIntStorageStoreUnsigned(keyOffset int32, keyLength int32, sourceHandle int32) int32
+	BigIntStorageLoadUnsigned(keyOffset int32, keyLength int32, destinationHandle int32) int32
+	BigIntGetCallValue(destinationHandle int32)
+	BigIntGetESDTCallValue(destination int32)
+	BigIntGetESDTCallValueByIndex(destinationHandle int32, index int32)
+	BigIntGetExternalBalance(addressOffset int32, result int32)
+	BigIntGetESDTExternalBalance(addressOffset int32, tokenIDOffset int32, tokenIDLen int32, nonce int64, resultHandle int32)
+	BigIntNew(smallValue int64) int32
+	BigIntUnsignedByteLength(referenceHandle int32) int32
+	BigIntSignedByteLength(referenceHandle int32) int32
+	BigIntGetUnsignedBytes(referenceHandle int32, byteOffset int32) int32
+	BigIntGetSignedBytes(referenceHandle int32, byteOffset int32) int32
+	BigIntSetUnsignedBytes(destinationHandle int32, byteOffset int32, byteLength int32)
+	BigIntSetSignedBytes(destinationHandle int32, byteOffset int32, byteLength int32)
+	BigIntIsInt64(destinationHandle int32) int32
+	BigIntGetInt64(destinationHandle int32) int64
+	BigIntSetInt64(destinationHandle int32, value int64)
+	BigIntAdd(destinationHandle, op1Handle, op2Handle int32)
+	BigIntSub(destinationHandle, op1Handle, op2Handle int32)
+	BigIntMul(destinationHandle, op1Handle, op2Handle int32)
+	BigIntTDiv(destinationHandle, op1Handle, op2Handle int32)
+	BigIntTMod(destinationHandle, op1Handle, op2Handle int32)
+	BigIntEDiv(destinationHandle, op1Handle, op2Handle int32)
+	BigIntEMod(destinationHandle, op1Handle, op2Handle int32)
+	BigIntSqrt(destinationHandle, opHandle int32)
+	BigIntPow(destinationHandle, op1Handle, op2Handle int32)
+	BigIntLog2(op1Handle int32) int32
+	BigIntAbs(destinationHandle, opHandle int32)
+	BigIntNeg(destinationHandle, opHandle int32)
+	BigIntSign(opHandle int32) int32
+	BigIntCmp(op1Handle, op2Handle int32) int32
+	BigIntNot(destinationHandle, opHandle int32)
+	BigIntAnd(destinationHandle, op1Handle, op2Handle int32)
+	BigIntOr(destinationHandle, op1Handle, op2Handle int32)
+	BigIntXor(destinationHandle, op1Handle, op2Handle int32)
+	BigIntShr(destinationHandle, opHandle, bits int32)
+	BigIntShl(destinationHandle, opHandle, bits int32)
+	BigIntFinishUnsigned(referenceHandle int32)
+	BigIntFinishSigned(referenceHandle int32)
+}
+
+// SmallIntVMHooks contains the small int (int64/uint64) functions of the VMHooks
+type SmallIntVMHooks interface {
+	SmallIntGetUnsignedArgument(id int32) int64
+	SmallIntGetSignedArgument(id int32) int64
+	SmallIntFinishUnsigned(value int64)
+	SmallIntFinishSigned(value int64)
+	SmallIntStorageStoreUnsigned(keyOffset int32, keyLength int32, value int64) int32
+	SmallIntStorageStoreSigned(keyOffset int32, keyLength int32, value int64) int32
+	SmallIntStorageLoadUnsigned(keyOffset int32, keyLength int32) int64
+	SmallIntStorageLoadSigned(keyOffset int32, keyLength int32) int64
+	Int64getArgument(id int32) int64
+	Int64finish(value int64)
+	Int64storageStore(keyOffset int32, keyLength int32, value int64) int32
+	Int64storageLoad(keyOffset int32, keyLength int32) int64
+}
+
+// ManagedVMHooks contains the variants of the Elrond API functions that only use managed types of the VMHooks
+type ManagedVMHooks interface {
+	ManagedSCAddress(destinationHandle int32)
+	ManagedOwnerAddress(destinationHandle int32)
+	ManagedCaller(destinationHandle int32)
+	ManagedSignalError(errHandle int32)
+	ManagedWriteLog(topicsHandle int32, dataHandle int32)
+	ManagedGetOriginalTxHash(resultHandle int32)
+	ManagedGetStateRootHash(resultHandle int32)
+	ManagedGetBlockRandomSeed(resultHandle int32)
+	ManagedGetPrevBlockRandomSeed(resultHandle int32)
+	ManagedGetReturnData(resultID int32, resultHandle int32)
+	ManagedGetMultiESDTCallValue(multiCallValueHandle int32)
+	ManagedGetESDTBalance(addressHandle int32, tokenIDHandle int32, nonce int64, valueHandle int32)
+	ManagedGetESDTTokenData(addressHandle int32, tokenIDHandle int32, nonce int64, valueHandle, propertiesHandle, hashHandle, nameHandle, attributesHandle, creatorHandle, royaltiesHandle, urisHandle int32)
+	ManagedAsyncCall(destHandle int32, valueHandle int32, functionHandle int32, argumentsHandle int32)
+	ManagedCreateAsyncCall(asyncContextIdentifierHandle int32, destHandle int32, valueHandle int32, functionHandle int32, argumentsHandle int32, successHandle int32, errorHandle int32, gas int64, extraGasForCallback int64, callbackClosureHandle int32)
+	ManagedGetCallbackClosure(callbackClosureHandle int32)
+	ManagedUpgradeFromSourceContract(destHandle int32, gas int64, valueHandle int32, addressHandle int32, codeMetadataHandle int32, argumentsHandle int32, resultHandle int32)
+	ManagedUpgradeContract(destHandle int32, gas int64, valueHandle int32, codeHandle int32, codeMetadataHandle int32, argumentsHandle int32, resultHandle int32)
+	ManagedDeployFromSourceContract(gas int64, valueHandle int32, addressHandle int32, codeMetadataHandle int32, argumentsHandle int32, resultAddressHandle int32, resultHandle int32) int32
+	ManagedCreateContract(gas int64, valueHandle int32, codeHandle int32, codeMetadataHandle int32, argumentsHandle int32, resultAddressHandle int32, resultHandle int32) int32
+	ManagedExecuteReadOnly(gas int64, addressHandle int32, functionHandle int32, argumentsHandle int32, resultHandle int32) int32
+	ManagedExecuteOnSameContext(gas int64, addressHandle int32, valueHandle int32, functionHandle int32, argumentsHandle int32, resultHandle int32) int32
+	ManagedExecuteOnDestContextByCaller(gas int64, addressHandle int32, valueHandle int32, functionHandle int32, argumentsHandle int32, resultHandle int32) int32
+	ManagedExecuteOnDestContext(gas int64, addressHandle int32, valueHandle int32, functionHandle int32, argumentsHandle int32, resultHandle int32) int32
+	ManagedMultiTransferESDTNFTExecute(dstHandle int32, tokenTransfersHandle int32, gasLimit int64, functionHandle int32, argumentsHandle int32) int32
+	ManagedTransferValueExecute(dstHandle int32, valueHandle int32, gasLimit int64, functionHandle int32, argumentsHandle int32) int32
+}
+
+// ManagedBufferVMHooks contains the managed buffer functions of the VMHooks
+type ManagedBufferVMHooks interface {
+	MBufferNew() int32
+	MBufferNewFromBytes(dataOffset int32, dataLength int32) int32
+	MBufferGetLength(mBufferHandle int32) int32
+	MBufferGetBytes(mBufferHandle int32, resultOffset int32) int32
+	MBufferGetByteSlice(sourceHandle int32, startingPosition int32, sliceLength int32, resultOffset int32) int32
+	MBufferCopyByteSlice(sourceHandle int32, startingPosition int32, sliceLength int32, destinationHandle int32) int32
+	MBufferEq(mBufferHandle1 int32, mBufferHandle2 int32) int32
+	MBufferSetBytes(mBufferHandle int32, dataOffset int32, dataLength int32) int32
+	MBufferSetByteSlice(mBufferHandle int32, startingPosition int32, dataLength int32, dataOffset int32) int32
+	MBufferAppend(accumulatorHandle int32, dataHandle int32) int32
+	MBufferAppendBytes(accumulatorHandle int32, dataOffset int32, dataLength int32) int32
+	MBufferToBigIntUnsigned(mBufferHandle int32, bigIntHandle int32) int32
+	MBufferToBigIntSigned(mBufferHandle int32, bigIntHandle int32) int32
+	MBufferFromBigIntUnsigned(mBufferHandle int32, bigIntHandle int32) int32
+	MBufferFromBigIntSigned(mBufferHandle int32, bigIntHandle int32) int32
+	MBufferStorageStore(keyHandle int32, sourceHandle int32) int32
+	MBufferStorageLoad(keyHandle int32, destinationHandle int32) int32
+	MBufferStorageLoadFromAddress(addressHandle, keyHandle, destinationHandle int32)
+	MBufferGetArgument(id int32, destinationHandle int32) int32
+	MBufferFinish(sourceHandle int32) int32
+	MBufferSetRandom(destinationHandle int32, length int32) int32
+}
+
+// CryptoVMHooks contains the cryptographic functions of the VMHooks
+type CryptoVMHooks interface {
+	Sha256(dataOffset int32, length int32, resultOffset int32) int32
+	ManagedSha256(inputHandle, outputHandle int32) int32
+	Keccak256(dataOffset int32, length int32, resultOffset int32) int32
+	ManagedKeccak256(inputHandle, outputHandle int32) int32
+	Ripemd160(dataOffset int32, length int32, resultOffset int32) int32
+	Sha512(dataOffset int32, length int32, resultOffset int32) int32
+	ManagedSha512(inputHandle, outputHandle int32) int32
+	Sha3256(dataOffset int32, length int32, resultOffset int32) int32
+	ManagedSha3256(inputHandle, outputHandle int32) int32
+	Blake2b256(dataOffset int32, length int32, resultOffset int32) int32
+	ManagedBlake2b256(inputHandle, outputHandle int32) int32
+	Blake2b512(dataOffset int32, length int32, resultOffset int32) int32
+	ManagedBlake2b512(inputHandle, outputHandle int32) int32
+	Poseidon(dataOffset int32, length int32, resultOffset int32) int32
+	ManagedPoseidon(inputHandle, outputHandle int32) int32
+	VerifyBLS(keyOffset int32, messageOffset int32, messageLength int32, sigOffset int32) int32
+	VerifyEd25519(keyOffset int32, messageOffset int32, messageLength int32, sigOffset int32) int32
+	VerifyCustomSecp256k1(keyOffset int32, keyLength int32, messageOffset int32, messageLength int32, sigOffset int32, hashType int32) int32
+	VerifySecp256k1(keyOffset int32, keyLength int32, messageOffset int32, messageLength int32, sigOffset int32) int32
+	VerifySecp256r1(keyOffset int32, keyLength int32, messageOffset int32, messageLength int32, sigOffset int32) int32
+	ManagedVerifySecp256r1(keyHandle int32, messageHandle int32, sigHandle int32) int32
+	VerifySchnorr(keyOffset int32, messageOffset int32, messageLength int32, sigOffset int32) int32
+	ManagedVerifySchnorr(keyHandle int32, messageHandle int32, sigHandle int32) int32
+	ManagedAddG1(curveID int32, resultHandle int32, point1Handle int32, point2Handle int32) int32
+	ManagedScalarMultG1(curveID int32, resultHandle int32, pointHandle int32, scalarHandle int32) int32
+	ManagedAddG2(curveID int32, resultHandle int32, point1Handle int32, point2Handle int32) int32
+	ManagedScalarMultG2(curveID int32, resultHandle int32, pointHandle int32, scalarHandle int32) int32
+	ManagedPairingCheck(curveID int32, g1PointsHandle int32, g2PointsHandle int32) int32
+	ManagedVerifyGroth16(curveID int32, verifyingKeyHandle int32, proofHandle int32, publicInputsHandle int32) int32
+	EncodeSecp256k1DerSignature(rOffset int32, rLength int32, sOffset int32, sLength int32, sigOffset int32) int32
+	AddEC(xResultHandle int32, yResultHandle int32, ecHandle int32, fstPointXHandle int32, fstPointYHandle int32, sndPointXHandle int32, sndPointYHandle int32)
+	DoubleEC(xResultHandle int32, yResultHandle int32, ecHandle int32, pointXHandle int32, pointYHandle int32)
+	IsOnCurveEC(ecHandle int32, pointXHandle int32, pointYHandle int32) int32
+	ScalarBaseMultEC(xResultHandle int32, yResultHandle int32, ecHandle int32, dataOffset int32, length int32) int32
+	ScalarMultEC(xResultHandle int32, yResultHandle int32, ecHandle int32, pointXHandle int32, pointYHandle int32, dataOffset int32, length int32) int32
+	MarshalEC(xPairHandle int32, yPairHandle int32, ecHandle int32, resultOffset int32) int32
+	MarshalCompressedEC(xPairHandle int32, yPairHandle int32, ecHandle int32, resultOffset int32) int32
+	UnmarshalEC(xResultHandle int32, yResultHandle int32, ecHandle int32, dataOffset int32, length int32) int32
+	UnmarshalCompressedEC(xResultHandle int32, yResultHandle int32, ecHandle int32, dataOffset int32, length int32) int32
+	GenerateKeyEC(xPubKeyHandle int32, yPubKeyHandle int32, ecHandle int32, resultOffset int32) int32
+	CreateEC(dataOffset int32, dataLength int32) int32
+	GetCurveLengthEC(ecHandle int32) int32
+	GetPrivKeyByteLengthEC(ecHandle int32) int32
+	EllipticCurveGetValues(ecHandle int32, fieldOrderHandle int32, basePointOrderHandle int32, eqConstantHandle int32, xBasePointHandle int32, yBasePointHandle int32) int32
+}
@@ -0,0 +1,35 @@
+package vmhooks
+
+import (
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen/cryptoapi"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen/elrondapi"
+)
+
+//go:generate go run ../../cmd/vmhooksgen -in ../vmHooks.go -out wasmerImportsCgo.go -metrics vmHooksWithMetrics.go -eei ../eei/functions.go
+
+type vmHooks struct {
+	*elrondapi.ElrondAPI
+	*cryptoapi.CryptoAPI
+}
+
+// NewVMHooks creates all the functions of the Elrond Environment Interface, running on the given host
+func NewVMHooks(host arwen.VMHost) arwen.VMHooks {
+	return &vmHooks{
+		ElrondAPI: elrondapi.NewElrondAPI(host),
+		CryptoAPI: cryptoapi.NewCryptoAPI(host),
+	}
+}
+
+// NewVMHooksWithMetrics wraps the VMHooks, counting the calls of each function in the given registry
+func NewVMHooksWithMetrics(hooks arwen.VMHooks, registry arwen.MetricsRegistry) arwen.VMHooks {
+	decorator := &vmHooksWithMetrics{hooks: hooks}
+	for i, name := range vmHooksFunctionNames {
+		decorator.calls[i] = registry.Counter(
+			arwen.MetricEEICalls,
+			arwen.MetricEEICallsHelp,
+			arwen.MetricLabel{Name: arwen.MetricLabelFunction, Value: name},
+		)
+	}
+	return decorator
+}
@@ -0,0 +1,1563 @@
+// Code generated by vmhooksgen. DO NOT EDIT.
+// Call `go generate` in `arwen-wasm-vm/arwen/vmhooks` to update it.
+
+package vmhooks
+
+import "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+
+// vmHooksFunctionNames are the names of the functions of the VMHooks, as imported by the contracts
+var vmHooksFunctionNames = [221]string{
+	"getGasLeft",
+	"getSCAddress",
+	"getOwnerAddress",
+	"getShardOfAddress",
+	"isSmartContract",
+	"signalError",
+	"getExternalBalance",
+	"getBlockHash",
+	"getESDTBalance",
+	"getESDTNFTNameLength",
+	"getESDTNFTAttributeLength",
+	"getESDTNFTURILength",
+	"getESDTTokenData",
+	"getESDTLocalRoles",
+	"validateTokenIdentifier",
+	"transferValue",
+	"transferValueExecute",
+	"transferESDTExecute",
+	"transferESDTNFTExecute",
+	"multiTransferESDTNFTExecute",
+	"upgradeContract",
+	"upgradeFromSourceContract",
+	"asyncCall",
+	"createAsyncCall",
+	"getArgumentLength",
+	"getArgument",
+	"getFunction",
+	"getNumArguments",
+	"storageStore",
+	"storageLoadLength",
+	"storageLoadFromAddress",
+	"storageLoad",
+	"setStorageLock",
+	"getStorageLock",
+	"isStorageLocked",
+	"clearStorageLock",
+	"getCaller",
+	"checkNoPayment",
+	"getCallValue",
+	"getESDTValue",
+	"getESDTValueByIndex",
+	"getESDTTokenName",
+	"getESDTTokenNameByIndex",
+	"getESDTTokenNonce",
+	"getESDTTokenNonceByIndex",
+	"getCurrentESDTNFTNonce",
+	"getESDTTokenType",
+	"getESDTTokenTypeByIndex",
+	"getNumESDTTransfers",
+	"getCallValueTokenName",
+	"getCallValueTokenNameByIndex",
+	"writeLog",
+	"writeEventLog",
+	"getBlockTimestamp",
+	"getBlockNonce",
+	"getBlockRound",
+	"getBlockEpoch",
+	"getBlockRandomSeed",
+	"getStateRootHash",
+	"getPrevBlockTimestamp",
+	"getPrevBlockNonce",
+	"getPrevBlockRound",
+	"getPrevBlockEpoch",
+	"getPrevBlockRandomSeed",
+	"finish",
+	"executeOnSameContext",
+	"executeOnDestContext",
+	"executeOnDestContextByCaller",
+	"executeReadOnly",
+	"createContract",
+	"deployFromSourceContract",
+	"getNumReturnData",
+	"getReturnDataSize",
+	"getReturnData",
+	"cleanReturnData",
+	"deleteFromReturnData",
+	"getOriginalTxHash",
+	"bigIntGetUnsignedArgument",
+	"bigIntGetSignedArgument",
+	"bigIntStorageStoreUnsigned",
+	"bigIntStorageLoadUnsigned",
+	"bigIntGetCallValue",
+	"bigIntGetESDTCallValue",
+	"bigIntGetESDTCallValueByIndex",
+	"bigIntGetExternalBalance",
+	"bigIntGetESDTExternalBalance",
+	"bigIntNew",
+	"bigIntUnsignedByteLength",
+	"bigIntSignedByteLength",
+	"bigIntGetUnsignedBytes",
+	"bigIntGetSignedBytes",
+	"bigIntSetUnsignedBytes",
+	"bigIntSetSignedBytes",
+	"bigIntIsInt64",
+	"bigIntGetInt64",
+	"bigIntSetInt64",
+	"bigIntAdd",
+	"bigIntSub",
+	"bigIntMul",
+	"bigIntTDiv",
+	"bigIntTMod",
+	"bigIntEDiv",
+	"bigIntEMod",
+	"bigIntSqrt",
+	"bigIntPow",
+	"bigIntLog2",
+	"bigIntAbs",
+	"bigIntNeg",
+	"bigIntSign",
+	"bigIntCmp",
+	"bigIntNot",
+	"bigIntAnd",
+	"bigIntOr",
+	"bigIntXor",
+	"bigIntShr",
+	"bigIntShl",
+	"bigIntFinishUnsigned",
+	"bigIntFinishSigned",
+	"smallIntGetUnsignedArgument",
+	"smallIntGetSignedArgument",
+	"smallIntFinishUnsigned",
+	"smallIntFinishSigned",
+	"smallIntStorageStoreUnsigned",
+	"smallIntStorageStoreSigned",
+	"smallIntStorageLoadUnsigned",
+	"smallIntStorageLoadSigned",
+	"int64getArgument",
+	"int64finish",
+	"int64storageStore",
+	"int64storageLoad",
+	"managedSCAddress",
+	"managedOwnerAddress",
+	"managedCaller",
+	"managedSignalError",
+	"managedWriteLog",
+	"managedGetOriginalTxHash",
+	"managedGetStateRootHash",
+	"managedGetBlockRandomSeed",
+	"managedGetPrevBlockRandomSeed",
+	"managedGetReturnData",
+	"managedGetMultiESDTCallValue",
+	"managedGetESDTBalance",
+	"managedGetESDTTokenData",
+	"managedAsyncCall",
+	"managedCreateAsyncCall",
+	"managedGetCallbackClosure",
+	"managedUpgradeFromSourceContract",
+	"managedUpgradeContract",
+	"managedDeployFromSourceContract",
+	"managedCreateContract",
+	"managedExecuteReadOnly",
+	"managedExecuteOnSameContext",
+	"managedExecuteOnDestContextByCaller",
+	"managedExecuteOnDestContext",
+	"managedMultiTransferESDTNFTExecute",
+	"managedTransferValueExecute",
+	"mBufferNew",
+	"mBufferNewFromBytes",
+	"mBufferGetLength",
+	"mBufferGetBytes",
+	"mBufferGetByteSlice",
+	"mBufferCopyByteSlice",
+	"mBufferEq",
+	"mBufferSetBytes",
+	"mBufferSetByteSlice",
+	"mBufferAppend",
+	"mBufferAppendBytes",
+	"mBufferToBigIntUnsigned",
+	"mBufferToBigIntSigned",
+	"mBufferFromBigIntUnsigned",
+	"mBufferFromBigIntSigned",
+	"mBufferStorageStore",
+	"mBufferStorageLoad",
+	"mBufferStorageLoadFromAddress",
+	"mBufferGetArgument",
+	"mBufferFinish",
+	"mBufferSetRandom",
+	"sha256",
+	"managedSha256",
+	"keccak256",
+	"managedKeccak256",
+	"ripemd160",
+	"sha512",
+	"managedSha512",
+	"sha3256",
+	"managedSha3256",
+	"blake2b256",
+	"managedBlake2b256",
+	"blake2b512",
+	"managedBlake2b512",
+	"poseidon",
+	"managedPoseidon",
+	"verifyBLS",
+	"verifyEd25519",
+	"verifyCustomSecp256k1",
+	"verifySecp256k1",
+	"verifySecp256r1",
+	"managedVerifySecp256r1",
+	"verifySchnorr",
+	"managedVerifySchnorr",
+	"managedAddG1",
+	"managedScalarMultG1",
+	"managedAddG2",
+	"managedScalarMultG2",
+	"managedPairingCheck",
+	"managedVerifyGroth16",
+	"encodeSecp256k1DerSignature",
+	"addEC",
+	"doubleEC",
+	"isOnCurveEC",
+	"scalarBaseMultEC",
+	"scalarMultEC",
+	"marshalEC",
+	"marshalCompressedEC",
+	"unmarshalEC",
+	"unmarshalCompressedEC",
+	"generateKeyEC",
+	"createEC",
+	"getCurveLengthEC",
+	"getPrivKeyByteLengthEC",
+	"ellipticCurveGetValues",
+}
+
+// vmHooksWithMetrics counts the calls of the functions of the VMHooks, in the order of vmHooksFunctionNames
+type vmHooksWithMetrics struct {
+	hooks arwen.VMHooks
+	calls [221]arwen.MetricCounter
+}
+
+// GetGasLeft counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) GetGasLeft() int64 {
+	decorator.calls[0].Add(1)
+	return decorator.hooks.GetGasLeft()
+}
+
+// GetSCAddress counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) GetSCAddress(resultOffset int32) {
+	decorator.calls[1].Add(1)
+	decorator.hooks.GetSCAddress(resultOffset)
+}
+
+// GetOwnerAddress counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) GetOwnerAddress(resultOffset int32) {
+	decorator.calls[2].Add(1)
+	decorator.hooks.GetOwnerAddress(resultOffset)
+}
+
+// GetShardOfAddress counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) GetShardOfAddress(addressOffset int32) int32 {
+	decorator.calls[3].Add(1)
+	return decorator.hooks.GetShardOfAddress(addressOffset)
+}
+
+// IsSmartContract counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) IsSmartContract(addressOffset int32) int32 {
+	decorator.calls[4].Add(1)
+	return decorator.hooks.IsSmartContract(addressOffset)
+}
+
+// SignalError counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) SignalError(messageOffset int32, messageLength int32) {
+	decorator.calls[5].Add(1)
+	decorator.hooks.SignalError(messageOffset, messageLength)
+}
+
+// GetExternalBalance counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) GetExternalBalance(addressOffset int32, resultOffset int32) {
+	decorator.calls[6].Add(1)
+	decorator.hooks.GetExternalBalance(addressOffset, resultOffset)
+}
+
+// GetBlockHash counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) GetBlockHash(nonce int64, resultOffset int32) int32 {
+	decorator.calls[7].Add(1)
+	return decorator.hooks.GetBlockHash(nonce, resultOffset)
+}
+
+// GetESDTBalance counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) GetESDTBalance(addressOffset int32, tokenIDOffset int32, tokenIDLen int32, nonce int64, resultOffset int32) int32 {
+	decorator.calls[8].Add(1)
+	return decorator.hooks.GetESDTBalance(addressOffset, tokenIDOffset, tokenIDLen, nonce, resultOffset)
+}
+
+// GetESDTNFTNameLength counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) GetESDTNFTNameLength(addressOffset int32, tokenIDOffset int32, tokenIDLen int32, nonce int64) int32 {
+	decorator.calls[9].Add(1)
+	return decorator.hooks.GetESDTNFTNameLength(addressOffset, tokenIDOffset, tokenIDLen, nonce)
+}
+
+// GetESDTNFTAttributeLength counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) GetESDTNFTAttributeLength(addressOffset int32, tokenIDOffset int32, tokenIDLen int32, nonce int64) int32 {
+	decorator.calls[10].Add(1)
+	return decorator.hooks.GetESDTNFTAttributeLength(addressOffset, tokenIDOffset, tokenIDLen, nonce)
+}
+
+// GetESDTNFTURILength counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) GetESDTNFTURILength(addressOffset int32, tokenIDOffset int32, tokenIDLen int32, nonce int64) int32 {
+	decorator.calls[11].Add(1)
+	return decorator.hooks.GetESDTNFTURILength(addressOffset, tokenIDOffset, tokenIDLen, nonce)
+}
+
+// GetESDTTokenData counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) GetESDTTokenData(addressOffset int32, tokenIDOffset int32, tokenIDLen int32, nonce int64, valueHandle int32, propertiesOffset int32, hashOffset int32, nameOffset int32, attributesOffset int32, creatorOffset int32, royaltiesHandle int32, urisOffset int32) int32 {
+	decorator.calls[12].Add(1)
+	return decorator.hooks.GetESDTTokenData(addressOffset, tokenIDOffset, tokenIDLen, nonce, valueHandle, propertiesOffset, hashOffset, nameOffset, attributesOffset, creatorOffset, royaltiesHandle, urisOffset)
+}
+
+// GetESDTLocalRoles counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) GetESDTLocalRoles(tokenIdHandle int32) int64 {
+	decorator.calls[13].Add(1)
+	return decorator.hooks.GetESDTLocalRoles(tokenIdHandle)
+}
+
+// ValidateTokenIdentifier counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) ValidateTokenIdentifier(tokenIdHandle int32) int32 {
+	decorator.calls[14].Add(1)
+	return decorator.hooks.ValidateTokenIdentifier(tokenIdHandle)
+}
+
+// TransferValue counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) TransferValue(destOffset int32, valueOffset int32, dataOffset int32, length int32) int32 {
+	decorator.calls[15].Add(1)
+	return decorator.hooks.TransferValue(destOffset, valueOffset, dataOffset, length)
+}
+
+// TransferValueExecute counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) TransferValueExecute(destOffset int32, valueOffset int32, gasLimit int64, functionOffset int32, functionLength int32, numArguments int32, argumentsLengthOffset int32, dataOffset int32) int32 {
+	decorator.calls[16].Add(1)
+	return decorator.hooks.TransferValueExecute(destOffset, valueOffset, gasLimit, functionOffset, functionLength, numArguments, argumentsLengthOffset, dataOffset)
+}
+
+// TransferESDTExecute counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) TransferESDTExecute(destOffset int32, tokenIDOffset int32, tokenIDLen int32, valueOffset int32, gasLimit int64, functionOffset int32, functionLength int32, numArguments int32, argumentsLengthOffset int32, dataOffset int32) int32 {
+	decorator.calls[17].Add(1)
+	return decorator.hooks.TransferESDTExecute(destOffset, tokenIDOffset, tokenIDLen, valueOffset, gasLimit, functionOffset, functionLength, numArguments, argumentsLengthOffset, dataOffset)
+}
+
+// TransferESDTNFTExecute counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) TransferESDTNFTExecute(destOffset int32, tokenIDOffset int32, tokenIDLen int32, valueOffset int32, nonce int64, gasLimit int64, functionOffset int32, functionLength int32, numArguments int32, argumentsLengthOffset int32, dataOffset int32) int32 {
+	decorator.calls[18].Add(1)
+	return decorator.hooks.TransferESDTNFTExecute(destOffset, tokenIDOffset, tokenIDLen, valueOffset, nonce, gasLimit, functionOffset, functionLength, numArguments, argumentsLengthOffset, dataOffset)
+}
+
+// MultiTransferESDTNFTExecute counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) MultiTransferESDTNFTExecute(destOffset int32, numTokenTransfers int32, tokenTransfersArgsLengthOffset int32, tokenTransferDataOffset int32, gasLimit int64, functionOffset int32, functionLength int32, numArguments int32, argumentsLengthOffset int32, dataOffset int32) int32 {
+	decorator.calls[19].Add(1)
+	return decorator.hooks.MultiTransferESDTNFTExecute(destOffset, numTokenTransfers, tokenTransfersArgsLengthOffset, tokenTransferDataOffset, gasLimit, functionOffset, functionLength, numArguments, argumentsLengthOffset, dataOffset)
+}
+
+// UpgradeContract counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) UpgradeContract(destOffset int32, gasLimit int64, valueOffset int32, codeOffset int32, codeMetadataOffset int32, length int32, numArguments int32, argumentsLengthOffset int32, dataOffset int32) {
+	decorator.calls[20].Add(1)
+	decorator.hooks.UpgradeContract(destOffset, gasLimit, valueOffset, codeOffset, codeMetadataOffset, length, numArguments, argumentsLengthOffset, dataOffset)
+}
+
+// UpgradeFromSourceContract counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) UpgradeFromSourceContract(destOffset int32, gasLimit int64, valueOffset int32, sourceContractAddressOffset int32, codeMetadataOffset int32, numArguments int32, argumentsLengthOffset int32, dataOffset int32) {
+	decorator.calls[21].Add(1)
+	decorator.hooks.UpgradeFromSourceContract(destOffset, gasLimit, valueOffset, sourceContractAddressOffset, codeMetadataOffset, numArguments, argumentsLengthOffset, dataOffset)
+}
+
+// AsyncCall counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) AsyncCall(destOffset int32, valueOffset int32, dataOffset int32, length int32) {
+	decorator.calls[22].Add(1)
+	decorator.hooks.AsyncCall(destOffset, valueOffset, dataOffset, length)
+}
+
+// CreateAsyncCall counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) CreateAsyncCall(asyncContextIdentifier int32, identifierLength int32, destOffset int32, valueOffset int32, dataOffset int32, length int32, successOffset int32, successLength int32, errorOffset int32, errorLength int32, gas int64) {
+	decorator.calls[23].Add(1)
+	decorator.hooks.CreateAsyncCall(asyncContextIdentifier, identifierLength, destOffset, valueOffset, dataOffset, length, successOffset, successLength, errorOffset, errorLength, gas)
+}
+
+// GetArgumentLength counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) GetArgumentLength(id int32) int32 {
+	decorator.calls[24].Add(1)
+	return decorator.hooks.GetArgumentLength(id)
+}
+
+// GetArgument counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) GetArgument(id int32, argOffset int32) int32 {
+	decorator.calls[25].Add(1)
+	return decorator.hooks.GetArgument(id, argOffset)
+}
+
+// GetFunction counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) GetFunction(functionOffset int32) int32 {
+	decorator.calls[26].Add(1)
+	return decorator.hooks.GetFunction(functionOffset)
+}
+
+// GetNumArguments counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) GetNumArguments() int32 {
+	decorator.calls[27].Add(1)
+	return decorator.hooks.GetNumArguments()
+}
+
+// StorageStore counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) StorageStore(keyOffset int32, keyLength int32, dataOffset int32, dataLength int32) int32 {
+	decorator.calls[28].Add(1)
+	return decorator.hooks.StorageStore(keyOffset, keyLength, dataOffset, dataLength)
+}
+
+// StorageLoadLength counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) StorageLoadLength(keyOffset int32, keyLength int32) int32 {
+	decorator.calls[29].Add(1)
+	return decorator.hooks.StorageLoadLength(keyOffset, keyLength)
+}
+
+// StorageLoadFromAddress counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) StorageLoadFromAddress(addressOffset int32, keyOffset int32, keyLength int32, dataOffset int32) int32 {
+	decorator.calls[30].Add(1)
+	return decorator.hooks.StorageLoadFromAddress(addressOffset, keyOffset, keyLength, dataOffset)
+}
+
+// StorageLoad counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) StorageLoad(keyOffset int32, keyLength int32, dataOffset int32) int32 {
+	decorator.calls[31].Add(1)
+	return decorator.hooks.StorageLoad(keyOffset, keyLength, dataOffset)
+}
+
+// SetStorageLock counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) SetStorageLock(keyOffset int32, keyLength int32, lockTimestamp int64) int32 {
+	decorator.calls[32].Add(1)
+	return decorator.hooks.SetStorageLock(keyOffset, keyLength, lockTimestamp)
+}
+
+// GetStorageLock counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) GetStorageLock(keyOffset int32, keyLength int32) int64 {
+	decorator.calls[33].Add(1)
+	return decorator.hooks.GetStorageLock(keyOffset, keyLength)
+}
+
+// IsStorageLocked counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) IsStorageLocked(keyOffset int32, keyLength int32) int32 {
+	decorator.calls[34].Add(1)
+	return decorator.hooks.IsStorageLocked(keyOffset, keyLength)
+}
+
+// ClearStorageLock counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) ClearStorageLock(keyOffset int32, keyLength int32) int32 {
+	decorator.calls[35].Add(1)
+	return decorator.hooks.ClearStorageLock(keyOffset, keyLength)
+}
+
+// GetCaller counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) GetCaller(resultOffset int32) {
+	decorator.calls[36].Add(1)
+	decorator.hooks.GetCaller(resultOffset)
+}
+
+// CheckNoPayment counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) CheckNoPayment() {
+	decorator.calls[37].Add(1)
+	decorator.hooks.CheckNoPayment()
+}
+
+// GetCallValue counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) GetCallValue(resultOffset int32) int32 {
+	decorator.calls[38].Add(1)
+	return decorator.hooks.GetCallValue(resultOffset)
+}
+
+// GetESDTValue counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) GetESDTValue(resultOffset int32) int32 {
+	decorator.calls[39].Add(1)
+	return decorator.hooks.GetESDTValue(resultOffset)
+}
+
+// GetESDTValueByIndex counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) GetESDTValueByIndex(resultOffset int32, index int32) int32 {
+	decorator.calls[40].Add(1)
+	return decorator.hooks.GetESDTValueByIndex(resultOffset, index)
+}
+
+// GetESDTTokenName counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) GetESDTTokenName(resultOffset int32) int32 {
+	decorator.calls[41].Add(1)
+	return decorator.hooks.GetESDTTokenName(resultOffset)
+}
+
+// GetESDTTokenNameByIndex counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) GetESDTTokenNameByIndex(resultOffset int32, index int32) int32 {
+	decorator.calls[42].Add(1)
+	return decorator.hooks.GetESDTTokenNameByIndex(resultOffset, index)
+}
+
+// GetESDTTokenNonce counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) GetESDTTokenNonce() int64 {
+	decorator.calls[43].Add(1)
+	return decorator.hooks.GetESDTTokenNonce()
+}
+
+// GetESDTTokenNonceByIndex counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) GetESDTTokenNonceByIndex(index int32) int64 {
+	decorator.calls[44].Add(1)
+	return decorator.hooks.GetESDTTokenNonceByIndex(index)
+}
+
+// GetCurrentESDTNFTNonce counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) GetCurrentESDTNFTNonce(addressOffset int32, tokenIDOffset int32, tokenIDLen int32) int64 {
+	decorator.calls[45].Add(1)
+	return decorator.hooks.GetCurrentESDTNFTNonce(addressOffset, tokenIDOffset, tokenIDLen)
+}
+
+// GetESDTTokenType counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) GetESDTTokenType() int32 {
+	decorator.calls[46].Add(1)
+	return decorator.hooks.GetESDTTokenType()
+}
+
+// GetESDTTokenTypeByIndex counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) GetESDTTokenTypeByIndex(index int32) int32 {
+	decorator.calls[47].Add(1)
+	return decorator.hooks.GetESDTTokenTypeByIndex(index)
+}
+
+// GetNumESDTTransfers counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) GetNumESDTTransfers() int32 {
+	decorator.calls[48].Add(1)
+	return decorator.hooks.GetNumESDTTransfers()
+}
+
+// GetCallValueTokenName counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) GetCallValueTokenName(callValueOffset int32, tokenNameOffset int32) int32 {
+	decorator.calls[49].Add(1)
+	return decorator.hooks.GetCallValueTokenName(callValueOffset, tokenNameOffset)
+}
+
+// GetCallValueTokenNameByIndex counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) GetCallValueTokenNameByIndex(callValueOffset int32, tokenNameOffset int32, index int32) int32 {
+	decorator.calls[50].Add(1)
+	return decorator.hooks.GetCallValueTokenNameByIndex(callValueOffset, tokenNameOffset, index)
+}
+
+// WriteLog counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) WriteLog(dataPointer int32, dataLength int32, topicPtr int32, numTopics int32) {
+	decorator.calls[51].Add(1)
+	decorator.hooks.WriteLog(dataPointer, dataLength, topicPtr, numTopics)
+}
+
+// WriteEventLog counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) WriteEventLog(numTopics int32, topicLengthsOffset int32, topicOffset int32, dataOffset int32, dataLength int32) {
+	decorator.calls[52].Add(1)
+	decorator.hooks.WriteEventLog(numTopics, topicLengthsOffset, topicOffset, dataOffset, dataLength)
+}
+
+// GetBlockTimestamp counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) GetBlockTimestamp() int64 {
+	decorator.calls[53].Add(1)
+	return decorator.hooks.GetBlockTimestamp()
+}
+
+// GetBlockNonce counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) GetBlockNonce() int64 {
+	decorator.calls[54].Add(1)
+	return decorator.hooks.GetBlockNonce()
+}
+
+// GetBlockRound counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) GetBlockRound() int64 {
+	decorator.calls[55].Add(1)
+	return decorator.hooks.GetBlockRound()
+}
+
+// GetBlockEpoch counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) GetBlockEpoch() int64 {
+	decorator.calls[56].Add(1)
+	return decorator.hooks.GetBlockEpoch()
+}
+
+// GetBlockRandomSeed counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) GetBlockRandomSeed(pointer int32) {
+	decorator.calls[57].Add(1)
+	decorator.hooks.GetBlockRandomSeed(pointer)
+}
+
+// GetStateRootHash counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) GetStateRootHash(pointer int32) {
+	decorator.calls[58].Add(1)
+	decorator.hooks.GetStateRootHash(pointer)
+}
+
+// GetPrevBlockTimestamp counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) GetPrevBlockTimestamp() int64 {
+	decorator.calls[59].Add(1)
+	return decorator.hooks.GetPrevBlockTimestamp()
+}
+
+// GetPrevBlockNonce counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) GetPrevBlockNonce() int64 {
+	decorator.calls[60].Add(1)
+	return decorator.hooks.GetPrevBlockNonce()
+}
+
+// GetPrevBlockRound counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) GetPrevBlockRound() int64 {
+	decorator.calls[61].Add(1)
+	return decorator.hooks.GetPrevBlockRound()
+}
+
+// GetPrevBlockEpoch counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) GetPrevBlockEpoch() int64 {
+	decorator.calls[62].Add(1)
+	return decorator.hooks.GetPrevBlockEpoch()
+}
+
+// GetPrevBlockRandomSeed counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) GetPrevBlockRandomSeed(pointer int32) {
+	decorator.calls[63].Add(1)
+	decorator.hooks.GetPrevBlockRandomSeed(pointer)
+}
+
+// Finish counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) Finish(pointer int32, length int32) {
+	decorator.calls[64].Add(1)
+	decorator.hooks.Finish(pointer, length)
+}
+
+// ExecuteOnSameContext counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) ExecuteOnSameContext(gasLimit int64, addressOffset int32, valueOffset int32, functionOffset int32, functionLength int32, numArguments int32, argumentsLengthOffset int32, dataOffset int32) int32 {
+	decorator.calls[65].Add(1)
+	return decorator.hooks.ExecuteOnSameContext(gasLimit, addressOffset, valueOffset, functionOffset, functionLength, numArguments, argumentsLengthOffset, dataOffset)
+}
+
+// ExecuteOnDestContext counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) ExecuteOnDestContext(gasLimit int64, addressOffset int32, valueOffset int32, functionOffset int32, functionLength int32, numArguments int32, argumentsLengthOffset int32, dataOffset int32) int32 {
+	decorator.calls[66].Add(1)
+	return decorator.hooks.ExecuteOnDestContext(gasLimit, addressOffset, valueOffset, functionOffset, functionLength, numArguments, argumentsLengthOffset, dataOffset)
+}
+
+// ExecuteOnDestContextByCaller counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) ExecuteOnDestContextByCaller(gasLimit int64, addressOffset int32, valueOffset int32, functionOffset int32, functionLength int32, numArguments int32, argumentsLengthOffset int32, dataOffset int32) int32 {
+	decorator.calls[67].Add(1)
+	return decorator.hooks.ExecuteOnDestContextByCaller(gasLimit, addressOffset, valueOffset, functionOffset, functionLength, numArguments, argumentsLengthOffset, dataOffset)
+}
+
+// ExecuteReadOnly counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) ExecuteReadOnly(gasLimit int64, addressOffset int32, functionOffset int32, functionLength int32, numArguments int32, argumentsLengthOffset int32, dataOffset int32) int32 {
+	decorator.calls[68].Add(1)
+	return decorator.hooks.ExecuteReadOnly(gasLimit, addressOffset, functionOffset, functionLength, numArguments, argumentsLengthOffset, dataOffset)
+}
+
+// CreateContract counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) CreateContract(gasLimit int64, valueOffset int32, codeOffset int32, codeMetadataOffset int32, length int32, resultOffset int32, numArguments int32, argumentsLengthOffset int32, dataOffset int32) int32 {
+	decorator.calls[69].Add(1)
+	return decorator.hooks.CreateContract(gasLimit, valueOffset, codeOffset, codeMetadataOffset, length, resultOffset, numArguments, argumentsLengthOffset, dataOffset)
+}
+
+// DeployFromSourceContract counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) DeployFromSourceContract(gasLimit int64, valueOffset int32, sourceContractAddressOffset int32, codeMetadataOffset int32, resultAddressOffset int32, numArguments int32, argumentsLengthOffset int32, dataOffset int32) int32 {
+	decorator.calls[70].Add(1)
+	return decorator.hooks.DeployFromSourceContract(gasLimit, valueOffset, sourceContractAddressOffset, codeMetadataOffset, resultAddressOffset, numArguments, argumentsLengthOffset, dataOffset)
+}
+
+// GetNumReturnData counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) GetNumReturnData() int32 {
+	decorator.calls[71].Add(1)
+	return decorator.hooks.GetNumReturnData()
+}
+
+// GetReturnDataSize counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) GetReturnDataSize(resultID int32) int32 {
+	decorator.calls[72].Add(1)
+	return decorator.hooks.GetReturnDataSize(resultID)
+}
+
+// GetReturnData counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) GetReturnData(resultID int32, dataOffset int32) int32 {
+	decorator.calls[73].Add(1)
+	return decorator.hooks.GetReturnData(resultID, dataOffset)
+}
+
+// CleanReturnData counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) CleanReturnData() {
+	decorator.calls[74].Add(1)
+	decorator.hooks.CleanReturnData()
+}
+
+// DeleteFromReturnData counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) DeleteFromReturnData(resultID int32) {
+	decorator.calls[75].Add(1)
+	decorator.hooks.DeleteFromReturnData(resultID)
+}
+
+// GetOriginalTxHash counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) GetOriginalTxHash(dataOffset int32) {
+	decorator.calls[76].Add(1)
+	decorator.hooks.GetOriginalTxHash(dataOffset)
+}
+
+// BigIntGetUnsignedArgument counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) BigIntGetUnsignedArgument(id int32, destinationHandle int32) {
+	decorator.calls[77].Add(1)
+	decorator.hooks.BigIntGetUnsignedArgument(id, destinationHandle)
+}
+
+// BigIntGetSignedArgument counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) BigIntGetSignedArgument(id int32, destinationHandle int32) {
+	decorator.calls[78].Add(1)
+	decorator.hooks.BigIntGetSignedArgument(id, destinationHandle)
+}
+
+// BigIntStorageStoreUnsigned counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) BigIntStorageStoreUnsigned(keyOffset int32, keyLength int32, sourceHandle int32) int32 {
+	decorator.calls[79].Add(1)
+	return decorator.hooks.BigIntStorageStoreUnsigned(keyOffset, keyLength, sourceHandle)
+}
+
+// BigIntStorageLoadUnsigned counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) BigIntStorageLoadUnsigned(keyOffset int32, keyLength int32, destinationHandle int32) int32 {
+	decorator.calls[80].Add(1)
+	return decorator.hooks.BigIntStorageLoadUnsigned(keyOffset, keyLength, destinationHandle)
+}
+
+// BigIntGetCallValue counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) BigIntGetCallValue(destinationHandle int32) {
+	decorator.calls[81].Add(1)
+	decorator.hooks.BigIntGetCallValue(destinationHandle)
+}
+
+// BigIntGetESDTCallValue counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) BigIntGetESDTCallValue(destination int32) {
+	decorator.calls[82].Add(1)
+	decorator.hooks.BigIntGetESDTCallValue(destination)
+}
+
+// BigIntGetESDTCallValueByIndex counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) BigIntGetESDTCallValueByIndex(destinationHandle int32, index int32) {
+	decorator.calls[83].Add(1)
+	decorator.hooks.BigIntGetESDTCallValueByIndex(destinationHandle, index)
+}
+
+// BigIntGetExternalBalance counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) BigIntGetExternalBalance(addressOffset int32, result int32) {
+	decorator.calls[84].Add(1)
+	decorator.hooks.BigIntGetExternalBalance(addressOffset, result)
+}
+
+// BigIntGetESDTExternalBalance counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) BigIntGetESDTExternalBalance(addressOffset int32, tokenIDOffset int32, tokenIDLen int32, nonce int64, resultHandle int32) {
+	decorator.calls[85].Add(1)
+	decorator.hooks.BigIntGetESDTExternalBalance(addressOffset, tokenIDOffset, tokenIDLen, nonce, resultHandle)
+}
+
+// BigIntNew counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) BigIntNew(smallValue int64) int32 {
+	decorator.calls[86].Add(1)
+	return decorator.hooks.BigIntNew(smallValue)
+}
+
+// BigIntUnsignedByteLength counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) BigIntUnsignedByteLength(referenceHandle int32) int32 {
+	decorator.calls[87].Add(1)
+	return decorator.hooks.BigIntUnsignedByteLength(referenceHandle)
+}
+
+// BigIntSignedByteLength counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) BigIntSignedByteLength(referenceHandle int32) int32 {
+	decorator.calls[88].Add(1)
+	return decorator.hooks.BigIntSignedByteLength(referenceHandle)
+}
+
+// BigIntGetUnsignedBytes counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) BigIntGetUnsignedBytes(referenceHandle int32, byteOffset int32) int32 {
+	decorator.calls[89].Add(1)
+	return decorator.hooks.BigIntGetUnsignedBytes(referenceHandle, byteOffset)
+}
+
+// BigIntGetSignedBytes counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) BigIntGetSignedBytes(referenceHandle int32, byteOffset int32) int32 {
+	decorator.calls[90].Add(1)
+	return decorator.hooks.BigIntGetSignedBytes(referenceHandle, byteOffset)
+}
+
+// BigIntSetUnsignedBytes counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) BigIntSetUnsignedBytes(destinationHandle int32, byteOffset int32, byteLength int32) {
+	decorator.calls[91].Add(1)
+	decorator.hooks.BigIntSetUnsignedBytes(destinationHandle, byteOffset, byteLength)
+}
+
+// BigIntSetSignedBytes counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) BigIntSetSignedBytes(destinationHandle int32, byteOffset int32, byteLength int32) {
+	decorator.calls[92].Add(1)
+	decorator.hooks.BigIntSetSignedBytes(destinationHandle, byteOffset, byteLength)
+}
+
+// BigIntIsInt64 counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) BigIntIsInt64(destinationHandle int32) int32 {
+	decorator.calls[93].Add(1)
+	return decorator.hooks.BigIntIsInt64(destinationHandle)
+}
+
+// BigIntGetInt64 counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) BigIntGetInt64(destinationHandle int32) int64 {
+	decorator.calls[94].Add(1)
+	return decorator.hooks.BigIntGetInt64(destinationHandle)
+}
+
+// BigIntSetInt64 counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) BigIntSetInt64(destinationHandle int32, value int64) {
+	decorator.calls[95].Add(1)
+	decorator.hooks.BigIntSetInt64(destinationHandle, value)
+}
+
+// BigIntAdd counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) BigIntAdd(destinationHandle int32, op1Handle int32, op2Handle int32) {
+	decorator.calls[96].Add(1)
+	decorator.hooks.BigIntAdd(destinationHandle, op1Handle, op2Handle)
+}
+
+// BigIntSub counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) BigIntSub(destinationHandle int32, op1Handle int32, op2Handle int32) {
+	decorator.calls[97].Add(1)
+	decorator.hooks.BigIntSub(destinationHandle, op1Handle, op2Handle)
+}
+
+// BigIntMul counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) BigIntMul(destinationHandle int32, op1Handle int32, op2Handle int32) {
+	decorator.calls[98].Add(1)
+	decorator.hooks.BigIntMul(destinationHandle, op1Handle, op2Handle)
+}
+
+// BigIntTDiv counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) BigIntTDiv(destinationHandle int32, op1Handle int32, op2Handle int32) {
+	decorator.calls[99].Add(1)
+	decorator.hooks.BigIntTDiv(destinationHandle, op1Handle, op2Handle)
+}
+
+// BigIntTMod counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) BigIntTMod(destinationHandle int32, op1Handle int32, op2Handle int32) {
+	decorator.calls[100].Add(1)
+	decorator.hooks.BigIntTMod(destinationHandle, op1Handle, op2Handle)
+}
+
+// BigIntEDiv counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) BigIntEDiv(destinationHandle int32, op1Handle int32, op2Handle int32) {
+	decorator.calls[101].Add(1)
+	decorator.hooks.BigIntEDiv(destinationHandle, op1Handle, op2Handle)
+}
+
+// BigIntEMod counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) BigIntEMod(destinationHandle int32, op1Handle int32, op2Handle int32) {
+	decorator.calls[102].Add(1)
+	decorator.hooks.BigIntEMod(destinationHandle, op1Handle, op2Handle)
+}
+
+// BigIntSqrt counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) BigIntSqrt(destinationHandle int32, opHandle int32) {
+	decorator.calls[103].Add(1)
+	decorator.hooks.BigIntSqrt(destinationHandle, opHandle)
+}
+
+// BigIntPow counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) BigIntPow(destinationHandle int32, op1Handle int32, op2Handle int32) {
+	decorator.calls[104].Add(1)
+	decorator.hooks.BigIntPow(destinationHandle, op1Handle, op2Handle)
+}
+
+// BigIntLog2 counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) BigIntLog2(op1Handle int32) int32 {
+	decorator.calls[105].Add(1)
+	return decorator.hooks.BigIntLog2(op1Handle)
+}
+
+// BigIntAbs counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) BigIntAbs(destinationHandle int32, opHandle int32) {
+	decorator.calls[106].Add(1)
+	decorator.hooks.BigIntAbs(destinationHandle, opHandle)
+}
+
+// BigIntNeg counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) BigIntNeg(destinationHandle int32, opHandle int32) {
+	decorator.calls[107].Add(1)
+	decorator.hooks.BigIntNeg(destinationHandle, opHandle)
+}
+
+// BigIntSign counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) BigIntSign(opHandle int32) int32 {
+	decorator.calls[108].Add(1)
+	return decorator.hooks.BigIntSign(opHandle)
+}
+
+// BigIntCmp counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) BigIntCmp(op1Handle int32, op2Handle int32) int32 {
+	decorator.calls[109].Add(1)
+	return decorator.hooks.BigIntCmp(op1Handle, op2Handle)
+}
+
+// BigIntNot counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) BigIntNot(destinationHandle int32, opHandle int32) {
+	decorator.calls[110].Add(1)
+	decorator.hooks.BigIntNot(destinationHandle, opHandle)
+}
+
+// BigIntAnd counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) BigIntAnd(destinationHandle int32, op1Handle int32, op2Handle int32) {
+	decorator.calls[111].Add(1)
+	decorator.hooks.BigIntAnd(destinationHandle, op1Handle, op2Handle)
+}
+
+// BigIntOr counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) BigIntOr(destinationHandle int32, op1Handle int32, op2Handle int32) {
+	decorator.calls[112].Add(1)
+	decorator.hooks.BigIntOr(destinationHandle, op1Handle, op2Handle)
+}
+
+// BigIntXor counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) BigIntXor(destinationHandle int32, op1Handle int32, op2Handle int32) {
+	decorator.calls[113].Add(1)
+	decorator.hooks.BigIntXor(destinationHandle, op1Handle, op2Handle)
+}
+
+// BigIntShr counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) BigIntShr(destinationHandle int32, opHandle int32, bits int32) {
+	decorator.calls[114].Add(1)
+	decorator.hooks.BigIntShr(destinationHandle, opHandle, bits)
+}
+
+// BigIntShl counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) BigIntShl(destinationHandle int32, opHandle int32, bits int32) {
+	decorator.calls[115].Add(1)
+	decorator.hooks.BigIntShl(destinationHandle, opHandle, bits)
+}
+
+// BigIntFinishUnsigned counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) BigIntFinishUnsigned(referenceHandle int32) {
+	decorator.calls[116].Add(1)
+	decorator.hooks.BigIntFinishUnsigned(referenceHandle)
+}
+
+// BigIntFinishSigned counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) BigIntFinishSigned(referenceHandle int32) {
+	decorator.calls[117].Add(1)
+	decorator.hooks.BigIntFinishSigned(referenceHandle)
+}
+
+// SmallIntGetUnsignedArgument counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) SmallIntGetUnsignedArgument(id int32) int64 {
+	decorator.calls[118].Add(1)
+	return decorator.hooks.SmallIntGetUnsignedArgument(id)
+}
+
+// SmallIntGetSignedArgument counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) SmallIntGetSignedArgument(id int32) int64 {
+	decorator.calls[119].Add(1)
+	return decorator.hooks.SmallIntGetSignedArgument(id)
+}
+
+// SmallIntFinishUnsigned counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) SmallIntFinishUnsigned(value int64) {
+	decorator.calls[120].Add(1)
+	decorator.hooks.SmallIntFinishUnsigned(value)
+}
+
+// SmallIntFinishSigned counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) SmallIntFinishSigned(value int64) {
+	decorator.calls[121].Add(1)
+	decorator.hooks.SmallIntFinishSigned(value)
+}
+
+// SmallIntStorageStoreUnsigned counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) SmallIntStorageStoreUnsigned(keyOffset int32, keyLength int32, value int64) int32 {
+	decorator.calls[122].Add(1)
+	return decorator.hooks.SmallIntStorageStoreUnsigned(keyOffset, keyLength, value)
+}
+
+// SmallIntStorageStoreSigned counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) SmallIntStorageStoreSigned(keyOffset int32, keyLength int32, value int64) int32 {
+	decorator.calls[123].Add(1)
+	return decorator.hooks.SmallIntStorageStoreSigned(keyOffset, keyLength, value)
+}
+
+// SmallIntStorageLoadUnsigned counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) SmallIntStorageLoadUnsigned(keyOffset int32, keyLength int32) int64 {
+	decorator.calls[124].Add(1)
+	return decorator.hooks.SmallIntStorageLoadUnsigned(keyOffset, keyLength)
+}
+
+// SmallIntStorageLoadSigned counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) SmallIntStorageLoadSigned(keyOffset int32, keyLength int32) int64 {
+	decorator.calls[125].Add(1)
+	return decorator.hooks.SmallIntStorageLoadSigned(keyOffset, keyLength)
+}
+
+// Int64getArgument counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) Int64getArgument(id int32) int64 {
+	decorator.calls[126].Add(1)
+	return decorator.hooks.Int64getArgument(id)
+}
+
+// Int64finish counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) Int64finish(value int64) {
+	decorator.calls[127].Add(1)
+	decorator.hooks.Int64finish(value)
+}
+
+// Int64storageStore counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) Int64storageStore(keyOffset int32, keyLength int32, value int64) int32 {
+	decorator.calls[128].Add(1)
+	return decorator.hooks.Int64storageStore(keyOffset, keyLength, value)
+}
+
+// Int64storageLoad counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) Int64storageLoad(keyOffset int32, keyLength int32) int64 {
+	decorator.calls[129].Add(1)
+	return decorator.hooks.Int64storageLoad(keyOffset, keyLength)
+}
+
+// ManagedSCAddress counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) ManagedSCAddress(destinationHandle int32) {
+	decorator.calls[130].Add(1)
+	decorator.hooks.ManagedSCAddress(destinationHandle)
+}
+
+// ManagedOwnerAddress counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) ManagedOwnerAddress(destinationHandle int32) {
+	decorator.calls[131].Add(1)
+	decorator.hooks.ManagedOwnerAddress(destinationHandle)
+}
+
+// ManagedCaller counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) ManagedCaller(destinationHandle int32) {
+	decorator.calls[132].Add(1)
+	decorator.hooks.ManagedCaller(destinationHandle)
+}
+
+// ManagedSignalError counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) ManagedSignalError(errHandle int32) {
+	decorator.calls[133].Add(1)
+	decorator.hooks.ManagedSignalError(errHandle)
+}
+
+// ManagedWriteLog counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) ManagedWriteLog(topicsHandle int32, dataHandle int32) {
+	decorator.calls[134].Add(1)
+	decorator.hooks.ManagedWriteLog(topicsHandle, dataHandle)
+}
+
+// ManagedGetOriginalTxHash counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) ManagedGetOriginalTxHash(resultHandle int32) {
+	decorator.calls[135].Add(1)
+	decorator.hooks.ManagedGetOriginalTxHash(resultHandle)
+}
+
+// ManagedGetStateRootHash counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) ManagedGetStateRootHash(resultHandle int32) {
+	decorator.calls[136].Add(1)
+	decorator.hooks.ManagedGetStateRootHash(resultHandle)
+}
+
+// ManagedGetBlockRandomSeed counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) ManagedGetBlockRandomSeed(resultHandle int32) {
+	decorator.calls[137].Add(1)
+	decorator.hooks.ManagedGetBlockRandomSeed(resultHandle)
+}
+
+// ManagedGetPrevBlockRandomSeed counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) ManagedGetPrevBlockRandomSeed(resultHandle int32) {
+	decorator.calls[138].Add(1)
+	decorator.hooks.ManagedGetPrevBlockRandomSeed(resultHandle)
+}
+
+// ManagedGetReturnData counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) ManagedGetReturnData(resultID int32, resultHandle int32) {
+	decorator.calls[139].Add(1)
+	decorator.hooks.ManagedGetReturnData(resultID, resultHandle)
+}
+
+// ManagedGetMultiESDTCallValue counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) ManagedGetMultiESDTCallValue(multiCallValueHandle int32) {
+	decorator.calls[140].Add(1)
+	decorator.hooks.ManagedGetMultiESDTCallValue(multiCallValueHandle)
+}
+
+// ManagedGetESDTBalance counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) ManagedGetESDTBalance(addressHandle int32, tokenIDHandle int32, nonce int64, valueHandle int32) {
+	decorator.calls[141].Add(1)
+	decorator.hooks.ManagedGetESDTBalance(addressHandle, tokenIDHandle, nonce, valueHandle)
+}
+
+// ManagedGetESDTTokenData counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) ManagedGetESDTTokenData(addressHandle int32, tokenIDHandle int32, nonce int64, valueHandle int32, propertiesHandle int32, hashHandle int32, nameHandle int32, attributesHandle int32, creatorHandle int32, royaltiesHandle int32, urisHandle int32) {
+	decorator.calls[142].Add(1)
+	decorator.hooks.ManagedGetESDTTokenData(addressHandle, tokenIDHandle, nonce, valueHandle, propertiesHandle, hashHandle, nameHandle, attributesHandle, creatorHandle, royaltiesHandle, urisHandle)
+}
+
+// ManagedAsyncCall counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) ManagedAsyncCall(destHandle int32, valueHandle int32, functionHandle int32, argumentsHandle int32) {
+	decorator.calls[143].Add(1)
+	decorator.hooks.ManagedAsyncCall(destHandle, valueHandle, functionHandle, argumentsHandle)
+}
+
+// ManagedCreateAsyncCall counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) ManagedCreateAsyncCall(asyncContextIdentifierHandle int32, destHandle int32, valueHandle int32, functionHandle int32, argumentsHandle int32, successHandle int32, errorHandle int32, gas int64, extraGasForCallback int64, callbackClosureHandle int32) {
+	decorator.calls[144].Add(1)
+	decorator.hooks.ManagedCreateAsyncCall(asyncContextIdentifierHandle, destHandle, valueHandle, functionHandle, argumentsHandle, successHandle, errorHandle, gas, extraGasForCallback, callbackClosureHandle)
+}
+
+// ManagedGetCallbackClosure counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) ManagedGetCallbackClosure(callbackClosureHandle int32) {
+	decorator.calls[145].Add(1)
+	decorator.hooks.ManagedGetCallbackClosure(callbackClosureHandle)
+}
+
+// ManagedUpgradeFromSourceContract counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) ManagedUpgradeFromSourceContract(destHandle int32, gas int64, valueHandle int32, addressHandle int32, codeMetadataHandle int32, argumentsHandle int32, resultHandle int32) {
+	decorator.calls[146].Add(1)
+	decorator.hooks.ManagedUpgradeFromSourceContract(destHandle, gas, valueHandle, addressHandle, codeMetadataHandle, argumentsHandle, resultHandle)
+}
+
+// ManagedUpgradeContract counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) ManagedUpgradeContract(destHandle int32, gas int64, valueHandle int32, codeHandle int32, codeMetadataHandle int32, argumentsHandle int32, resultHandle int32) {
+	decorator.calls[147].Add(1)
+	decorator.hooks.ManagedUpgradeContract(destHandle, gas, valueHandle, codeHandle, codeMetadataHandle, argumentsHandle, resultHandle)
+}
+
+// ManagedDeployFromSourceContract counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) ManagedDeployFromSourceContract(gas int64, valueHandle int32, addressHandle int32, codeMetadataHandle int32, argumentsHandle int32, resultAddressHandle int32, resultHandle int32) int32 {
+	decorator.calls[148].Add(1)
+	return decorator.hooks.ManagedDeployFromSourceContract(gas, valueHandle, addressHandle, codeMetadataHandle, argumentsHandle, resultAddressHandle, resultHandle)
+}
+
+// ManagedCreateContract counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) ManagedCreateContract(gas int64, valueHandle int32, codeHandle int32, codeMetadataHandle int32, argumentsHandle int32, resultAddressHandle int32, resultHandle int32) int32 {
+	decorator.calls[149].Add(1)
+	return decorator.hooks.ManagedCreateContract(gas, valueHandle, codeHandle, codeMetadataHandle, argumentsHandle, resultAddressHandle, resultHandle)
+}
+
+// ManagedExecuteReadOnly counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) ManagedExecuteReadOnly(gas int64, addressHandle int32, functionHandle int32, argumentsHandle int32, resultHandle int32) int32 {
+	decorator.calls[150].Add(1)
+	return decorator.hooks.ManagedExecuteReadOnly(gas, addressHandle, functionHandle, argumentsHandle, resultHandle)
+}
+
+// ManagedExecuteOnSameContext counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) ManagedExecuteOnSameContext(gas int64, addressHandle int32, valueHandle int32, functionHandle int32, argumentsHandle int32, resultHandle int32) int32 {
+	decorator.calls[151].Add(1)
+	return decorator.hooks.ManagedExecuteOnSameContext(gas, addressHandle, valueHandle, functionHandle, argumentsHandle, resultHandle)
+}
+
+// ManagedExecuteOnDestContextByCaller counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) ManagedExecuteOnDestContextByCaller(gas int64, addressHandle int32, valueHandle int32, functionHandle int32, argumentsHandle int32, resultHandle int32) int32 {
+	decorator.calls[152].Add(1)
+	return decorator.hooks.ManagedExecuteOnDestContextByCaller(gas, addressHandle, valueHandle, functionHandle, argumentsHandle, resultHandle)
+}
+
+// ManagedExecuteOnDestContext counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) ManagedExecuteOnDestContext(gas int64, addressHandle int32, valueHandle int32, functionHandle int32, argumentsHandle int32, resultHandle int32) int32 {
+	decorator.calls[153].Add(1)
+	return decorator.hooks.ManagedExecuteOnDestContext(gas, addressHandle, valueHandle, functionHandle, argumentsHandle, resultHandle)
+}
+
+// ManagedMultiTransferESDTNFTExecute counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) ManagedMultiTransferESDTNFTExecute(dstHandle int32, tokenTransfersHandle int32, gasLimit int64, functionHandle int32, argumentsHandle int32) int32 {
+	decorator.calls[154].Add(1)
+	return decorator.hooks.ManagedMultiTransferESDTNFTExecute(dstHandle, tokenTransfersHandle, gasLimit, functionHandle, argumentsHandle)
+}
+
+// ManagedTransferValueExecute counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) ManagedTransferValueExecute(dstHandle int32, valueHandle int32, gasLimit int64, functionHandle int32, argumentsHandle int32) int32 {
+	decorator.calls[155].Add(1)
+	return decorator.hooks.ManagedTransferValueExecute(dstHandle, valueHandle, gasLimit, functionHandle, argumentsHandle)
+}
+
+// MBufferNew counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) MBufferNew() int32 {
+	decorator.calls[156].Add(1)
+	return decorator.hooks.MBufferNew()
+}
+
+// MBufferNewFromBytes counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) MBufferNewFromBytes(dataOffset int32, dataLength int32) int32 {
+	decorator.calls[157].Add(1)
+	return decorator.hooks.MBufferNewFromBytes(dataOffset, dataLength)
+}
+
+// MBufferGetLength counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) MBufferGetLength(mBufferHandle int32) int32 {
+	decorator.calls[158].Add(1)
+	return decorator.hooks.MBufferGetLength(mBufferHandle)
+}
+
+// MBufferGetBytes counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) MBufferGetBytes(mBufferHandle int32, resultOffset int32) int32 {
+	decorator.calls[159].Add(1)
+	return decorator.hooks.MBufferGetBytes(mBufferHandle, resultOffset)
+}
+
+// MBufferGetByteSlice counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) MBufferGetByteSlice(sourceHandle int32, startingPosition int32, sliceLength int32, resultOffset int32) int32 {
+	decorator.calls[160].Add(1)
+	return decorator.hooks.MBufferGetByteSlice(sourceHandle, startingPosition, sliceLength, resultOffset)
+}
+
+// MBufferCopyByteSlice counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) MBufferCopyByteSlice(sourceHandle int32, startingPosition int32, sliceLength int32, destinationHandle int32) int32 {
+	decorator.calls[161].Add(1)
+	return decorator.hooks.MBufferCopyByteSlice(sourceHandle, startingPosition, sliceLength, destinationHandle)
+}
+
+// MBufferEq counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) MBufferEq(mBufferHandle1 int32, mBufferHandle2 int32) int32 {
+	decorator.calls[162].Add(1)
+	return decorator.hooks.MBufferEq(mBufferHandle1, mBufferHandle2)
+}
+
+// MBufferSetBytes counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) MBufferSetBytes(mBufferHandle int32, dataOffset int32, dataLength int32) int32 {
+	decorator.calls[163].Add(1)
+	return decorator.hooks.MBufferSetBytes(mBufferHandle, dataOffset, dataLength)
+}
+
+// MBufferSetByteSlice counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) MBufferSetByteSlice(mBufferHandle int32, startingPosition int32, dataLength int32, dataOffset int32) int32 {
+	decorator.calls[164].Add(1)
+	return decorator.hooks.MBufferSetByteSlice(mBufferHandle, startingPosition, dataLength, dataOffset)
+}
+
+// MBufferAppend counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) MBufferAppend(accumulatorHandle int32, dataHandle int32) int32 {
+	decorator.calls[165].Add(1)
+	return decorator.hooks.MBufferAppend(accumulatorHandle, dataHandle)
+}
+
+// MBufferAppendBytes counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) MBufferAppendBytes(accumulatorHandle int32, dataOffset int32, dataLength int32) int32 {
+	decorator.calls[166].Add(1)
+	return decorator.hooks.MBufferAppendBytes(accumulatorHandle, dataOffset, dataLength)
+}
+
+// MBufferToBigIntUnsigned counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) MBufferToBigIntUnsigned(mBufferHandle int32, bigIntHandle int32) int32 {
+	decorator.calls[167].Add(1)
+	return decorator.hooks.MBufferToBigIntUnsigned(mBufferHandle, bigIntHandle)
+}
+
+// MBufferToBigIntSigned counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) MBufferToBigIntSigned(mBufferHandle int32, bigIntHandle int32) int32 {
+	decorator.calls[168].Add(1)
+	return decorator.hooks.MBufferToBigIntSigned(mBufferHandle, bigIntHandle)
+}
+
+// MBufferFromBigIntUnsigned counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) MBufferFromBigIntUnsigned(mBufferHandle int32, bigIntHandle int32) int32 {
+	decorator.calls[169].Add(1)
+	return decorator.hooks.MBufferFromBigIntUnsigned(mBufferHandle, bigIntHandle)
+}
+
+// MBufferFromBigIntSigned counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) MBufferFromBigIntSigned(mBufferHandle int32, bigIntHandle int32) int32 {
+	decorator.calls[170].Add(1)
+	return decorator.hooks.MBufferFromBigIntSigned(mBufferHandle, bigIntHandle)
+}
+
+// MBufferStorageStore counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) MBufferStorageStore(keyHandle int32, sourceHandle int32) int32 {
+	decorator.calls[171].Add(1)
+	return decorator.hooks.MBufferStorageStore(keyHandle, sourceHandle)
+}
+
+// MBufferStorageLoad counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) MBufferStorageLoad(keyHandle int32, destinationHandle int32) int32 {
+	decorator.calls[172].Add(1)
+	return decorator.hooks.MBufferStorageLoad(keyHandle, destinationHandle)
+}
+
+// MBufferStorageLoadFromAddress counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) MBufferStorageLoadFromAddress(addressHandle int32, keyHandle int32, destinationHandle int32) {
+	decorator.calls[173].Add(1)
+	decorator.hooks.MBufferStorageLoadFromAddress(addressHandle, keyHandle, destinationHandle)
+}
+
+// MBufferGetArgument counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) MBufferGetArgument(id int32, destinationHandle int32) int32 {
+	decorator.calls[174].Add(1)
+	return decorator.hooks.MBufferGetArgument(id, destinationHandle)
+}
+
+// MBufferFinish counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) MBufferFinish(sourceHandle int32) int32 {
+	decorator.calls[175].Add(1)
+	return decorator.hooks.MBufferFinish(sourceHandle)
+}
+
+// MBufferSetRandom counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) MBufferSetRandom(destinationHandle int32, length int32) int32 {
+	decorator.calls[176].Add(1)
+	return decorator.hooks.MBufferSetRandom(destinationHandle, length)
+}
+
+// Sha256 counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) Sha256(dataOffset int32, length int32, resultOffset int32) int32 {
+	decorator.calls[177].Add(1)
+	return decorator.hooks.Sha256(dataOffset, length, resultOffset)
+}
+
+// ManagedSha256 counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) ManagedSha256(inputHandle int32, outputHandle int32) int32 {
+	decorator.calls[178].Add(1)
+	return decorator.hooks.ManagedSha256(inputHandle, outputHandle)
+}
+
+// Keccak256 counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) Keccak256(dataOffset int32, length int32, resultOffset int32) int32 {
+	decorator.calls[179].Add(1)
+	return decorator.hooks.Keccak256(dataOffset, length, resultOffset)
+}
+
+// ManagedKeccak256 counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) ManagedKeccak256(inputHandle int32, outputHandle int32) int32 {
+	decorator.calls[180].Add(1)
+	return decorator.hooks.ManagedKeccak256(inputHandle, outputHandle)
+}
+
+// Ripemd160 counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) Ripemd160(dataOffset int32, length int32, resultOffset int32) int32 {
+	decorator.calls[181].Add(1)
+	return decorator.hooks.Ripemd160(dataOffset, length, resultOffset)
+}
+
+// Sha512 counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) Sha512(dataOffset int32, length int32, resultOffset int32) int32 {
+	decorator.calls[182].Add(1)
+	return decorator.hooks.Sha512(dataOffset, length, resultOffset)
+}
+
+// ManagedSha512 counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) ManagedSha512(inputHandle int32, outputHandle int32) int32 {
+	decorator.calls[183].Add(1)
+	return decorator.hooks.ManagedSha512(inputHandle, outputHandle)
+}
+
+// Sha3256 counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) Sha3256(dataOffset int32, length int32, resultOffset int32) int32 {
+	decorator.calls[184].Add(1)
+	return decorator.hooks.Sha3256(dataOffset, length, resultOffset)
+}
+
+// ManagedSha3256 counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) ManagedSha3256(inputHandle int32, outputHandle int32) int32 {
+	decorator.calls[185].Add(1)
+	return decorator.hooks.ManagedSha3256(inputHandle, outputHandle)
+}
+
+// Blake2b256 counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) Blake2b256(dataOffset int32, length int32, resultOffset int32) int32 {
+	decorator.calls[186].Add(1)
+	return decorator.hooks.Blake2b256(dataOffset, length, resultOffset)
+}
+
+// ManagedBlake2b256 counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) ManagedBlake2b256(inputHandle int32, outputHandle int32) int32 {
+	decorator.calls[187].Add(1)
+	return decorator.hooks.ManagedBlake2b256(inputHandle, outputHandle)
+}
+
+// Blake2b512 counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) Blake2b512(dataOffset int32, length int32, resultOffset int32) int32 {
+	decorator.calls[188].Add(1)
+	return decorator.hooks.Blake2b512(dataOffset, length, resultOffset)
+}
+
+// ManagedBlake2b512 counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) ManagedBlake2b512(inputHandle int32, outputHandle int32) int32 {
+	decorator.calls[189].Add(1)
+	return decorator.hooks.ManagedBlake2b512(inputHandle, outputHandle)
+}
+
+// Poseidon counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) Poseidon(dataOffset int32, length int32, resultOffset int32) int32 {
+	decorator.calls[190].Add(1)
+	return decorator.hooks.Poseidon(dataOffset, length, resultOffset)
+}
+
+// ManagedPoseidon counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) ManagedPoseidon(inputHandle int32, outputHandle int32) int32 {
+	decorator.calls[191].Add(1)
+	return decorator.hooks.ManagedPoseidon(inputHandle, outputHandle)
+}
+
+// VerifyBLS counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) VerifyBLS(keyOffset int32, messageOffset int32, messageLength int32, sigOffset int32) int32 {
+	decorator.calls[192].Add(1)
+	return decorator.hooks.VerifyBLS(keyOffset, messageOffset, messageLength, sigOffset)
+}
+
+// VerifyEd25519 counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) VerifyEd25519(keyOffset int32, messageOffset int32, messageLength int32, sigOffset int32) int32 {
+	decorator.calls[193].Add(1)
+	return decorator.hooks.VerifyEd25519(keyOffset, messageOffset, messageLength, sigOffset)
+}
+
+// VerifyCustomSecp256k1 counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) VerifyCustomSecp256k1(keyOffset int32, keyLength int32, messageOffset int32, messageLength int32, sigOffset int32, hashType int32) int32 {
+	decorator.calls[194].Add(1)
+	return decorator.hooks.VerifyCustomSecp256k1(keyOffset, keyLength, messageOffset, messageLength, sigOffset, hashType)
+}
+
+// VerifySecp256k1 counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) VerifySecp256k1(keyOffset int32, keyLength int32, messageOffset int32, messageLength int32, sigOffset int32) int32 {
+	decorator.calls[195].Add(1)
+	return decorator.hooks.VerifySecp256k1(keyOffset, keyLength, messageOffset, messageLength, sigOffset)
+}
+
+// VerifySecp256r1 counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) VerifySecp256r1(keyOffset int32, keyLength int32, messageOffset int32, messageLength int32, sigOffset int32) int32 {
+	decorator.calls[196].Add(1)
+	return decorator.hooks.VerifySecp256r1(keyOffset, keyLength, messageOffset, messageLength, sigOffset)
+}
+
+// ManagedVerifySecp256r1 counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) ManagedVerifySecp256r1(keyHandle int32, messageHandle int32, sigHandle int32) int32 {
+	decorator.calls[197].Add(1)
+	return decorator.hooks.ManagedVerifySecp256r1(keyHandle, messageHandle, sigHandle)
+}
+
+// VerifySchnorr counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) VerifySchnorr(keyOffset int32, messageOffset int32, messageLength int32, sigOffset int32) int32 {
+	decorator.calls[198].Add(1)
+	return decorator.hooks.VerifySchnorr(keyOffset, messageOffset, messageLength, sigOffset)
+}
+
+// ManagedVerifySchnorr counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) ManagedVerifySchnorr(keyHandle int32, messageHandle int32, sigHandle int32) int32 {
+	decorator.calls[199].Add(1)
+	return decorator.hooks.ManagedVerifySchnorr(keyHandle, messageHandle, sigHandle)
+}
+
+// ManagedAddG1 counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) ManagedAddG1(curveID int32, resultHandle int32, point1Handle int32, point2Handle int32) int32 {
+	decorator.calls[200].Add(1)
+	return decorator.hooks.ManagedAddG1(curveID, resultHandle, point1Handle, point2Handle)
+}
+
+// ManagedScalarMultG1 counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) ManagedScalarMultG1(curveID int32, resultHandle int32, pointHandle int32, scalarHandle int32) int32 {
+	decorator.calls[201].Add(1)
+	return decorator.hooks.ManagedScalarMultG1(curveID, resultHandle, pointHandle, scalarHandle)
+}
+
+// ManagedAddG2 counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) ManagedAddG2(curveID int32, resultHandle int32, point1Handle int32, point2Handle int32) int32 {
+	decorator.calls[202].Add(1)
+	return decorator.hooks.ManagedAddG2(curveID, resultHandle, point1Handle, point2Handle)
+}
+
+// ManagedScalarMultG2 counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) ManagedScalarMultG2(curveID int32, resultHandle int32, pointHandle int32, scalarHandle int32) int32 {
+	decorator.calls[203].Add(1)
+	return decorator.hooks.ManagedScalarMultG2(curveID, resultHandle, pointHandle, scalarHandle)
+}
+
+// ManagedPairingCheck counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) ManagedPairingCheck(curveID int32, g1PointsHandle int32, g2PointsHandle int32) int32 {
+	decorator.calls[204].Add(1)
+	return decorator.hooks.ManagedPairingCheck(curveID, g1PointsHandle, g2PointsHandle)
+}
+
+// ManagedVerifyGroth16 counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) ManagedVerifyGroth16(curveID int32, verifyingKeyHandle int32, proofHandle int32, publicInputsHandle int32) int32 {
+	decorator.calls[205].Add(1)
+	return decorator.hooks.ManagedVerifyGroth16(curveID, verifyingKeyHandle, proofHandle, publicInputsHandle)
+}
+
+// EncodeSecp256k1DerSignature counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) EncodeSecp256k1DerSignature(rOffset int32, rLength int32, sOffset int32, sLength int32, sigOffset int32) int32 {
+	decorator.calls[206].Add(1)
+	return decorator.hooks.EncodeSecp256k1DerSignature(rOffset, rLength, sOffset, sLength, sigOffset)
+}
+
+// AddEC counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) AddEC(xResultHandle int32, yResultHandle int32, ecHandle int32, fstPointXHandle int32, fstPointYHandle int32, sndPointXHandle int32, sndPointYHandle int32) {
+	decorator.calls[207].Add(1)
+	decorator.hooks.AddEC(xResultHandle, yResultHandle, ecHandle, fstPointXHandle, fstPointYHandle, sndPointXHandle, sndPointYHandle)
+}
+
+// DoubleEC counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) DoubleEC(xResultHandle int32, yResultHandle int32, ecHandle int32, pointXHandle int32, pointYHandle int32) {
+	decorator.calls[208].Add(1)
+	decorator.hooks.DoubleEC(xResultHandle, yResultHandle, ecHandle, pointXHandle, pointYHandle)
+}
+
+// IsOnCurveEC counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) IsOnCurveEC(ecHandle int32, pointXHandle int32, pointYHandle int32) int32 {
+	decorator.calls[209].Add(1)
+	return decorator.hooks.IsOnCurveEC(ecHandle, pointXHandle, pointYHandle)
+}
+
+// ScalarBaseMultEC counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) ScalarBaseMultEC(xResultHandle int32, yResultHandle int32, ecHandle int32, dataOffset int32, length int32) int32 {
+	decorator.calls[210].Add(1)
+	return decorator.hooks.ScalarBaseMultEC(xResultHandle, yResultHandle, ecHandle, dataOffset, length)
+}
+
+// ScalarMultEC counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) ScalarMultEC(xResultHandle int32, yResultHandle int32, ecHandle int32, pointXHandle int32, pointYHandle int32, dataOffset int32, length int32) int32 {
+	decorator.calls[211].Add(1)
+	return decorator.hooks.ScalarMultEC(xResultHandle, yResultHandle, ecHandle, pointXHandle, pointYHandle, dataOffset, length)
+}
+
+// MarshalEC counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) MarshalEC(xPairHandle int32, yPairHandle int32, ecHandle int32, resultOffset int32) int32 {
+	decorator.calls[212].Add(1)
+	return decorator.hooks.MarshalEC(xPairHandle, yPairHandle, ecHandle, resultOffset)
+}
+
+// MarshalCompressedEC counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) MarshalCompressedEC(xPairHandle int32, yPairHandle int32, ecHandle int32, resultOffset int32) int32 {
+	decorator.calls[213].Add(1)
+	return decorator.hooks.MarshalCompressedEC(xPairHandle, yPairHandle, ecHandle, resultOffset)
+}
+
+// UnmarshalEC counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) UnmarshalEC(xResultHandle int32, yResultHandle int32, ecHandle int32, dataOffset int32, length int32) int32 {
+	decorator.calls[214].Add(1)
+	return decorator.hooks.UnmarshalEC(xResultHandle, yResultHandle, ecHandle, dataOffset, length)
+}
+
+// UnmarshalCompressedEC counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) UnmarshalCompressedEC(xResultHandle int32, yResultHandle int32, ecHandle int32, dataOffset int32, length int32) int32 {
+	decorator.calls[215].Add(1)
+	return decorator.hooks.UnmarshalCompressedEC(xResultHandle, yResultHandle, ecHandle, dataOffset, length)
+}
+
+// GenerateKeyEC counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) GenerateKeyEC(xPubKeyHandle int32, yPubKeyHandle int32, ecHandle int32, resultOffset int32) int32 {
+	decorator.calls[216].Add(1)
+	return decorator.hooks.GenerateKeyEC(xPubKeyHandle, yPubKeyHandle, ecHandle, resultOffset)
+}
+
+// CreateEC counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) CreateEC(dataOffset int32, dataLength int32) int32 {
+	decorator.calls[217].Add(1)
+	return decorator.hooks.CreateEC(dataOffset, dataLength)
+}
+
+// GetCurveLengthEC counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) GetCurveLengthEC(ecHandle int32) int32 {
+	decorator.calls[218].Add(1)
+	return decorator.hooks.GetCurveLengthEC(ecHandle)
+}
+
+// GetPrivKeyByteLengthEC counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) GetPrivKeyByteLengthEC(ecHandle int32) int32 {
+	decorator.calls[219].Add(1)
+	return decorator.hooks.GetPrivKeyByteLengthEC(ecHandle)
+}
+
+// EllipticCurveGetValues counts the call and calls the wrapped VMHooks
+func (decorator *vmHooksWithMetrics) EllipticCurveGetValues(ecHandle int32, fieldOrderHandle int32, basePointOrderHandle int32, eqConstantHandle int32, xBasePointHandle int32, yBasePointHandle int32) int32 {
+	decorator.calls[220].Add(1)
+	return decorator.hooks.EllipticCurveGetValues(ecHandle, fieldOrderHandle, basePointOrderHandle, eqConstantHandle, xBasePointHandle, yBasePointHandle)
+}
@@ -0,0 +1,53 @@
+package vmhooks
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/config"
+	contextmock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/context"
+	"github.com/stretchr/testify/require"
+)
+
+func newHostForTests(t *testing.T) *contextmock.VMHostMock {
+	gasCost, err := config.CreateGasConfig(config.MakeGasMapForTests())
+	require.Nil(t, err)
+
+	host := &contextmock.VMHostMock{}
+	host.MeteringContext = &contextmock.MeteringContextMock{
+		GasCost:     gasCost,
+		GasLeftMock: 1000,
+	}
+	host.OutputContext = &contextmock.OutputContextMock{}
+	host.Hooks = NewVMHooks(host)
+	return host
+}
+
+func TestVMHooks_CalledWithoutExecutor(t *testing.T) {
+	host := newHostForTests(t)
+	hooks := host.VMHooks()
+
+	require.Equal(t, int64(1000), hooks.GetGasLeft())
+
+	hooks.SmallIntFinishSigned(-2)
+	hooks.SmallIntFinishUnsigned(7)
+	require.Equal(t, [][]byte{{0xfe}, {7}}, host.OutputContext.ReturnData())
+}
+
+func TestWasmerImports_AllVMHooksImported(t *testing.T) {
+	imports, err := WasmerImports()
+	require.Nil(t, err)
+
+	vmHooksType := reflect.TypeOf((*arwen.VMHooks)(nil)).Elem()
+	require.Equal(t, vmHooksType.NumMethod(), imports.Count())
+
+	names := imports.Names()
+	for i := 0; i < vmHooksType.NumMethod(); i++ {
+		methodName := vmHooksType.Method(i).Name
+		importName := strings.ToLower(methodName[:1]) + methodName[1:]
+		_, imported := names[importName]
+		require.True(t, imported, importName)
+	}
+}
@@ -0,0 +1,2459 @@
+// Code generated by vmhooksgen. DO NOT EDIT.
+// Call `go generate` in `arwen-wasm-vm/arwen/vmhooks` to update it.
+
+package vmhooks
+
+// // Declare the function signatures (see [cgo](https://golang.org/cmd/cgo/)).
+//
+// #include <stdlib.h>
+// typedef unsigned char uint8_t;
+// typedef int int32_t;
+//
+// extern long long v1_4_getGasLeft(void *context);
+// extern void v1_4_getSCAddress(void *context, int32_t resultOffset);
+// extern void v1_4_getOwnerAddress(void *context, int32_t resultOffset);
+// extern int32_t v1_4_getShardOfAddress(void *context, int32_t addressOffset);
+// extern int32_t v1_4_isSmartContract(void *context, int32_t addressOffset);
+// extern void v1_4_signalError(void *context, int32_t messageOffset, int32_t messageLength);
+// extern void v1_4_getExternalBalance(void *context, int32_t addressOffset, int32_t resultOffset);
+// extern int32_t v1_4_getBlockHash(void *context, long long nonce, int32_t resultOffset);
+// extern int32_t v1_4_getESDTBalance(void *context, int32_t addressOffset, int32_t tokenIDOffset, int32_t tokenIDLen, long long nonce, int32_t resultOffset);
+// extern int32_t v1_4_getESDTNFTNameLength(void *context, int32_t addressOffset, int32_t tokenIDOffset, int32_t tokenIDLen, long long nonce);
+// extern int32_t v1_4_getESDTNFTAttributeLength(void *context, int32_t addressOffset, int32_t tokenIDOffset, int32_t tokenIDLen, long long nonce);
+// extern int32_t v1_4_getESDTNFTURILength(void *context, int32_t addressOffset, int32_t tokenIDOffset, int32_t tokenIDLen, long long nonce);
+// extern int32_t v1_4_getESDTTokenData(void *context, int32_t addressOffset, int32_t tokenIDOffset, int32_t tokenIDLen, long long nonce, int32_t valueHandle, int32_t propertiesOffset, int32_t hashOffset, int32_t nameOffset, int32_t attributesOffset, int32_t creatorOffset, int32_t royaltiesHandle, int32_t urisOffset);
+// extern long long v1_4_getESDTLocalRoles(void *context, int32_t tokenIdHandle);
+// extern int32_t v1_4_validateTokenIdentifier(void *context, int32_t tokenIdHandle);
+// extern int32_t v1_4_transferValue(void *context, int32_t destOffset, int32_t valueOffset, int32_t dataOffset, int32_t length);
+// extern int32_t v1_4_transferValueExecute(void *context, int32_t destOffset, int32_t valueOffset, long long gasLimit, int32_t functionOffset, int32_t functionLength, int32_t numArguments, int32_t argumentsLengthOffset, int32_t dataOffset);
+// extern int32_t v1_4_transferESDTExecute(void *context, int32_t destOffset, int32_t tokenIDOffset, int32_t tokenIDLen, int32_t valueOffset, long long gasLimit, int32_t functionOffset, int32_t functionLength, int32_t numArguments, int32_t argumentsLengthOffset, int32_t dataOffset);
+// extern int32_t v1_4_transferESDTNFTExecute(void *context, int32_t destOffset, int32_t tokenIDOffset, int32_t tokenIDLen, int32_t valueOffset, long long nonce, long long gasLimit, int32_t functionOffset, int32_t functionLength, int32_t numArguments, int32_t argumentsLengthOffset, int32_t dataOffset);
+// extern int32_t v1_4_multiTransferESDTNFTExecute(void *context, int32_t destOffset, int32_t numTokenTransfers, int32_t tokenTransfersArgsLengthOffset, int32_t tokenTransferDataOffset, long long gasLimit, int32_t functionOffset, int32_t functionLength, int32_t numArguments, int32_t argumentsLengthOffset, int32_t dataOffset);
+// extern void v1_4_upgradeContract(void *context, int32_t destOffset, long long gasLimit, int32_t valueOffset, int32_t codeOffset, int32_t codeMetadataOffset, int32_t length, int32_t numArguments, int32_t argumentsLengthOffset, int32_t dataOffset);
+// extern void v1_4_upgradeFromSourceContract(void *context, int32_t destOffset, long long gasLimit, int32_t valueOffset, int32_t sourceContractAddressOffset, int32_t codeMetadataOffset, int32_t numArguments, int32_t argumentsLengthOffset, int32_t dataOffset);
+// extern void v1_4_asyncCall(void *context, int32_t destOffset, int32_t valueOffset, int32_t dataOffset, int32_t length);
+// extern void v1_4_createAsyncCall(void *context, int32_t asyncContextIdentifier, int32_t identifierLength, int32_t destOffset, int32_t valueOffset, int32_t dataOffset, int32_t length, int32_t successOffset, int32_t successLength, int32_t errorOffset, int32_t errorLength, long long gas);
+// extern int32_t v1_4_getArgumentLength(void *context, int32_t id);
+// extern int32_t v1_4_getArgument(void *context, int32_t id, int32_t argOffset);
+// extern int32_t v1_4_getFunction(void *context, int32_t functionOffset);
+// extern int32_t v1_4_getNumArguments(void *context);
+// extern int32_t v1_4_storageStore(void *context, int32_t keyOffset, int32_t keyLength, int32_t dataOffset, int32_t dataLength);
+// extern int32_t v1_4_storageLoadLength(void *context, int32_t keyOffset, int32_t keyLength);
+// extern int32_t v1_4_storageLoadFromAddress(void *context, int32_t addressOffset, int32_t keyOffset, int32_t keyLength, int32_t dataOffset);
+// extern int32_t v1_4_storageLoad(void *context, int32_t keyOffset, int32_t keyLength, int32_t dataOffset);
+// extern int32_t v1_4_setStorageLock(void *context, int32_t keyOffset, int32_t keyLength, long long lockTimestamp);
+// extern long long v1_4_getStorageLock(void *context, int32_t keyOffset, int32_t keyLength);
+// extern int32_t v1_4_isStorageLocked(void *context, int32_t keyOffset, int32_t keyLength);
+// extern int32_t v1_4_clearStorageLock(void *context, int32_t keyOffset, int32_t keyLength);
+// extern void v1_4_getCaller(void *context, int32_t resultOffset);
+// extern void v1_4_checkNoPayment(void *context);
+// extern int32_t v1_4_getCallValue(void *context, int32_t resultOffset);
+// extern int32_t v1_4_getESDTValue(void *context, int32_t resultOffset);
+// extern int32_t v1_4_getESDTValueByIndex(void *context, int32_t resultOffset, int32_t index);
+// extern int32_t v1_4_getESDTTokenName(void *context, int32_t resultOffset);
+// extern int32_t v1_4_getESDTTokenNameByIndex(void *context, int32_t resultOffset, int32_t index);
+// extern long long v1_4_getESDTTokenNonce(void *context);
+// extern long long v1_4_getESDTTokenNonceByIndex(void *context, int32_t index);
+// extern long long v1_4_getCurrentESDTNFTNonce(void *context, int32_t addressOffset, int32_t tokenIDOffset, int32_t tokenIDLen);
+// extern int32_t v1_4_getESDTTokenType(void *context);
+// extern int32_t v1_4_getESDTTokenTypeByIndex(void *context, int32_t index);
+// extern int32_t v1_4_getNumESDTTransfers(void *context);
+// extern int32_t v1_4_getCallValueTokenName(void *context, int32_t callValueOffset, int32_t tokenNameOffset);
+// extern int32_t v1_4_getCallValueTokenNameByIndex(void *context, int32_t callValueOffset, int32_t tokenNameOffset, int32_t index);
+// extern void v1_4_writeLog(void *context, int32_t dataPointer, int32_t dataLength, int32_t topicPtr, int32_t numTopics);
+// extern void v1_4_writeEventLog(void *context, int32_t numTopics, int32_t topicLengthsOffset, int32_t topicOffset, int32_t dataOffset, int32_t dataLength);
+// extern long long v1_4_getBlockTimestamp(void *context);
+// extern long long v1_4_getBlockNonce(void *context);
+// extern long long v1_4_getBlockRound(void *context);
+// extern long long v1_4_getBlockEpoch(void *context);
+// extern void v1_4_getBlockRandomSeed(void *context, int32_t pointer);
+// extern void v1_4_getStateRootHash(void *context, int32_t pointer);
+// extern long long v1_4_getPrevBlockTimestamp(void *context);
+// extern long long v1_4_getPrevBlockNonce(void *context);
+// extern long long v1_4_getPrevBlockRound(void *context);
+// extern long long v1_4_getPrevBlockEpoch(void *context);
+// extern void v1_4_getPrevBlockRandomSeed(void *context, int32_t pointer);
+// extern void v1_4_finish(void *context, int32_t pointer, int32_t length);
+// extern int32_t v1_4_executeOnSameContext(void *context, long long gasLimit, int32_t addressOffset, int32_t valueOffset, int32_t functionOffset, int32_t functionLength, int32_t numArguments, int32_t argumentsLengthOffset, int32_t dataOffset);
+// extern int32_t v1_4_executeOnDestContext(void *context, long long gasLimit, int32_t addressOffset, int32_t valueOffset, int32_t functionOffset, int32_t functionLength, int32_t numArguments, int32_t argumentsLengthOffset, int32_t dataOffset);
+// extern int32_t v1_4_executeOnDestContextByCaller(void *context, long long gasLimit, int32_t addressOffset, int32_t valueOffset, int32_t functionOffset, int32_t functionLength, int32_t numArguments, int32_t argumentsLengthOffset, int32_t dataOffset);
+// extern int32_t v1_4_executeReadOnly(void *context, long long gasLimit, int32_t addressOffset, int32_t functionOffset, int32_t functionLength, int32_t numArguments, int32_t argumentsLengthOffset, int32_t dataOffset);
+// extern int32_t v1_4_createContract(void *context, long long gasLimit, int32_t valueOffset, int32_t codeOffset, int32_t codeMetadataOffset, int32_t length, int32_t resultOffset, int32_t numArguments, int32_t argumentsLengthOffset, int32_t dataOffset);
+// extern int32_t v1_4_deployFromSourceContract(void *context, long long gasLimit, int32_t valueOffset, int32_t sourceContractAddressOffset, int32_t codeMetadataOffset, int32_t resultAddressOffset, int32_t numArguments, int32_t argumentsLengthOffset, int32_t dataOffset);
+// extern int32_t v1_4_getNumReturnData(void *context);
+// extern int32_t v1_4_getReturnDataSize(void *context, int32_t resultID);
+// extern int32_t v1_4_getReturnData(void *context, int32_t resultID, int32_t dataOffset);
+// extern void v1_4_cleanReturnData(void *context);
+// extern void v1_4_deleteFromReturnData(void *context, int32_t resultID);
+// extern void v1_4_getOriginalTxHash(void *context, int32_t dataOffset);
+// extern void v1_4_bigIntGetUnsignedArgument(void *context, int32_t id, int32_t destinationHandle);
+// extern void v1_4_bigIntGetSignedArgument(void *context, int32_t id, int32_t destinationHandle);
+// extern int32_t v1_4_bigIntStorageStoreUnsigned(void *context, int32_t keyOffset, int32_t keyLength, int32_t sourceHandle);
+// extern int32_t v1_4_bigIntStorageLoadUnsigned(void *context, int32_t keyOffset, int32_t keyLength, int32_t destinationHandle);
+// extern void v1_4_bigIntGetCallValue(void *context, int32_t destinationHandle);
+// extern void v1_4_bigIntGetESDTCallValue(void *context, int32_t destination);
+// extern void v1_4_bigIntGetESDTCallValueByIndex(void *context, int32_t destinationHandle, int32_t index);
+// extern void v1_4_bigIntGetExternalBalance(void *context, int32_t addressOffset, int32_t result);
+// extern void v1_4_bigIntGetESDTExternalBalance(void *context, int32_t addressOffset, int32_t tokenIDOffset, int32_t tokenIDLen, long long nonce, int32_t resultHandle);
+// extern int32_t v1_4_bigIntNew(void *context, long long smallValue);
+// extern int32_t v1_4_bigIntUnsignedByteLength(void *context, int32_t referenceHandle);
+// extern int32_t v1_4_bigIntSignedByteLength(void *context, int32_t referenceHandle);
+// extern int32_t v1_4_bigIntGetUnsignedBytes(void *context, int32_t referenceHandle, int32_t byteOffset);
+// extern int32_t v1_4_bigIntGetSignedBytes(void *context, int32_t referenceHandle, int32_t byteOffset);
+// extern void v1_4_bigIntSetUnsignedBytes(void *context, int32_t destinationHandle, int32_t byteOffset, int32_t byteLength);
+// extern void v1_4_bigIntSetSignedBytes(void *context, int32_t destinationHandle, int32_t byteOffset, int32_t byteLength);
+// extern int32_t v1_4_bigIntIsInt64(void *context, int32_t destinationHandle);
+// extern long long v1_4_bigIntGetInt64(void *context, int32_t destinationHandle);
+// extern void v1_4_bigIntSetInt64(void *context, int32_t destinationHandle, long long value);
+// extern void v1_4_bigIntAdd(void *context, int32_t destinationHandle, int32_t op1Handle, int32_t op2Handle);
+// extern void v1_4_bigIntSub(void *context, int32_t destinationHandle, int32_t op1Handle, int32_t op2Handle);
+// extern void v1_4_bigIntMul(void *context, int32_t destinationHandle, int32_t op1Handle, int32_t op2Handle);
+// extern void v1_4_bigIntTDiv(void *context, int32_t destinationHandle, int32_t op1Handle, int32_t op2Handle);
+// extern void v1_4_bigIntTMod(void *context, int32_t destinationHandle, int32_t op1Handle, int32_t op2Handle);
+// extern void v1_4_bigIntEDiv(void *context, int32_t destinationHandle, int32_t op1Handle, int32_t op2Handle);
+// extern void v1_4_bigIntEMod(void *context, int32_t destinationHandle, int32_t op1Handle, int32_t op2Handle);
+// extern void v1_4_bigIntSqrt(void *context, int32_t destinationHandle, int32_t opHandle);
+// extern void v1_4_bigIntPow(void *context, int32_t destinationHandle, int32_t op1Handle, int32_t op2Handle);
+// extern int32_t v1_4_bigIntLog2(void *context, int32_t op1Handle);
+// extern void v1_4_bigIntAbs(void *context, int32_t destinationHandle, int32_t opHandle);
+// extern void v1_4_bigIntNeg(void *context, int32_t destinationHandle, int32_t opHandle);
+// extern int32_t v1_4_bigIntSign(void *context, int32_t opHandle);
+// extern int32_t v1_4_bigIntCmp(void *context, int32_t op1Handle, int32_t op2Handle);
+// extern void v1_4_bigIntNot(void *context, int32_t destinationHandle, int32_t opHandle);
+// extern void v1_4_bigIntAnd(void *context, int32_t destinationHandle, int32_t op1Handle, int32_t op2Handle);
+// extern void v1_4_bigIntOr(void *context, int32_t destinationHandle, int32_t op1Handle, int32_t op2Handle);
+// extern void v1_4_bigIntXor(void *context, int32_t destinationHandle, int32_t op1Handle, int32_t op2Handle);
+// extern void v1_4_bigIntShr(void *context, int32_t destinationHandle, int32_t opHandle, int32_t bits);
+// extern void v1_4_bigIntShl(void *context, int32_t destinationHandle, int32_t opHandle, int32_t bits);
+// extern void v1_4_bigIntFinishUnsigned(void *context, int32_t referenceHandle);
+// extern void v1_4_bigIntFinishSigned(void *context, int32_t referenceHandle);
+// extern long long v1_4_smallIntGetUnsignedArgument(void *context, int32_t id);
+// extern long long v1_4_smallIntGetSignedArgument(void *context, int32_t id);
+// extern void v1_4_smallIntFinishUnsigned(void *context, long long value);
+// extern void v1_4_smallIntFinishSigned(void *context, long long value);
+// extern int32_t v1_4_smallIntStorageStoreUnsigned(void *context, int32_t keyOffset, int32_t keyLength, long long value);
+// extern int32_t v1_4_smallIntStorageStoreSigned(void *context, int32_t keyOffset, int32_t keyLength, long long value);
+// extern long long v1_4_smallIntStorageLoadUnsigned(void *context, int32_t keyOffset, int32_t keyLength);
+// extern long long v1_4_smallIntStorageLoadSigned(void *context, int32_t keyOffset, int32_t keyLength);
+// extern long long v1_4_int64getArgument(void *context, int32_t id);
+// extern void v1_4_int64finish(void *context, long long value);
+// extern int32_t v1_4_int64storageStore(void *context, int32_t keyOffset, int32_t keyLength, long long value);
+// extern long long v1_4_int64storageLoad(void *context, int32_t keyOffset, int32_t keyLength);
+// extern void v1_4_managedSCAddress(void *context, int32_t destinationHandle);
+// extern void v1_4_managedOwnerAddress(void *context, int32_t destinationHandle);
+// extern void v1_4_managedCaller(void *context, int32_t destinationHandle);
+// extern void v1_4_managedSignalError(void *context, int32_t errHandle);
+// extern void v1_4_managedWriteLog(void *context, int32_t topicsHandle, int32_t dataHandle);
+// extern void v1_4_managedGetOriginalTxHash(void *context, int32_t resultHandle);
+// extern void v1_4_managedGetStateRootHash(void *context, int32_t resultHandle);
+// extern void v1_4_managedGetBlockRandomSeed(void *context, int32_t resultHandle);
+// extern void v1_4_managedGetPrevBlockRandomSeed(void *context, int32_t resultHandle);
+// extern void v1_4_managedGetReturnData(void *context, int32_t resultID, int32_t resultHandle);
+// extern void v1_4_managedGetMultiESDTCallValue(void *context, int32_t multiCallValueHandle);
+// extern void v1_4_managedGetESDTBalance(void *context, int32_t addressHandle, int32_t tokenIDHandle, long long nonce, int32_t valueHandle);
+// extern void v1_4_managedGetESDTTokenData(void *context, int32_t addressHandle, int32_t tokenIDHandle, long long nonce, int32_t valueHandle, int32_t propertiesHandle, int32_t hashHandle, int32_t nameHandle, int32_t attributesHandle, int32_t creatorHandle, int32_t royaltiesHandle, int32_t urisHandle);
+// extern void v1_4_managedAsyncCall(void *context, int32_t destHandle, int32_t valueHandle, int32_t functionHandle, int32_t argumentsHandle);
+// extern void v1_4_managedCreateAsyncCall(void *context, int32_t asyncContextIdentifierHandle, int32_t destHandle, int32_t valueHandle, int32_t functionHandle, int32_t argumentsHandle, int32_t successHandle, int32_t errorHandle, long long gas, long long extraGasForCallback, int32_t callbackClosureHandle);
+// extern void v1_4_managedGetCallbackClosure(void *context, int32_t callbackClosureHandle);
+// extern void v1_4_managedUpgradeFromSourceContract(void *context, int32_t destHandle, long long gas, int32_t valueHandle, int32_t addressHandle, int32_t codeMetadataHandle, int32_t argumentsHandle, int32_t resultHandle);
+// extern void v1_4_managedUpgradeContract(void *context, int32_t destHandle, long long gas, int32_t valueHandle, int32_t codeHandle, int32_t codeMetadataHandle, int32_t argumentsHandle, int32_t resultHandle);
+// extern int32_t v1_4_managedDeployFromSourceContract(void *context, long long gas, int32_t valueHandle, int32_t addressHandle, int32_t codeMetadataHandle, int32_t argumentsHandle, int32_t resultAddressHandle, int32_t resultHandle);
+// extern int32_t v1_4_managedCreateContract(void *context, long long gas, int32_t valueHandle, int32_t codeHandle, int32_t codeMetadataHandle, int32_t argumentsHandle, int32_t resultAddressHandle, int32_t resultHandle);
+// extern int32_t v1_4_managedExecuteReadOnly(void *context, long long gas, int32_t addressHandle, int32_t functionHandle, int32_t argumentsHandle, int32_t resultHandle);
+// extern int32_t v1_4_managedExecuteOnSameContext(void *context, long long gas, int32_t addressHandle, int32_t valueHandle, int32_t functionHandle, int32_t argumentsHandle, int32_t resultHandle);
+// extern int32_t v1_4_managedExecuteOnDestContextByCaller(void *context, long long gas, int32_t addressHandle, int32_t valueHandle, int32_t functionHandle, int32_t argumentsHandle, int32_t resultHandle);
+// extern int32_t v1_4_managedExecuteOnDestContext(void *context, long long gas, int32_t addressHandle, int32_t valueHandle, int32_t functionHandle, int32_t argumentsHandle, int32_t resultHandle);
+// extern int32_t v1_4_managedMultiTransferESDTNFTExecute(void *context, int32_t dstHandle, int32_t tokenTransfersHandle, long long gasLimit, int32_t functionHandle, int32_t argumentsHandle);
+// extern int32_t v1_4_managedTransferValueExecute(void *context, int32_t dstHandle, int32_t valueHandle, long long gasLimit, int32_t functionHandle, int32_t argumentsHandle);
+// extern int32_t v1_4_mBufferNew(void *context);
+// extern int32_t v1_4_mBufferNewFromBytes(void *context, int32_t dataOffset, int32_t dataLength);
+// extern int32_t v1_4_mBufferGetLength(void *context, int32_t mBufferHandle);
+// extern int32_t v1_4_mBufferGetBytes(void *context, int32_t mBufferHandle, int32_t resultOffset);
+// extern int32_t v1_4_mBufferGetByteSlice(void *context, int32_t sourceHandle, int32_t startingPosition, int32_t sliceLength, int32_t resultOffset);
+// extern int32_t v1_4_mBufferCopyByteSlice(void *context, int32_t sourceHandle, int32_t startingPosition, int32_t sliceLength, int32_t destinationHandle);
+// extern int32_t v1_4_mBufferEq(void *context, int32_t mBufferHandle1, int32_t mBufferHandle2);
+// extern int32_t v1_4_mBufferSetBytes(void *context, int32_t mBufferHandle, int32_t dataOffset, int32_t dataLength);
+// extern int32_t v1_4_mBufferSetByteSlice(void *context, int32_t mBufferHandle, int32_t startingPosition, int32_t dataLength, int32_t dataOffset);
+// extern int32_t v1_4_mBufferAppend(void *context, int32_t accumulatorHandle, int32_t dataHandle);
+// extern int32_t v1_4_mBufferAppendBytes(void *context, int32_t accumulatorHandle, int32_t dataOffset, int32_t dataLength);
+// extern int32_t v1_4_mBufferToBigIntUnsigned(void *context, int32_t mBufferHandle, int32_t bigIntHandle);
+// extern int32_t v1_4_mBufferToBigIntSigned(void *context, int32_t mBufferHandle, int32_t bigIntHandle);
+// extern int32_t v1_4_mBufferFromBigIntUnsigned(void *context, int32_t mBufferHandle, int32_t bigIntHandle);
+// extern int32_t v1_4_mBufferFromBigIntSigned(void *context, int32_t mBufferHandle, int32_t bigIntHandle);
+// extern int32_t v1_4_mBufferStorageStore(void *context, int32_t keyHandle, int32_t sourceHandle);
+// extern int32_t v1_4_mBufferStorageLoad(void *context, int32_t keyHandle, int32_t destinationHandle);
+// extern void v1_4_mBufferStorageLoadFromAddress(void *context, int32_t addressHandle, int32_t keyHandle, int32_t destinationHandle);
+// extern int32_t v1_4_mBufferGetArgument(void *context, int32_t id, int32_t destinationHandle);
+// extern int32_t v1_4_mBufferFinish(void *context, int32_t sourceHandle);
+// extern int32_t v1_4_mBufferSetRandom(void *context, int32_t destinationHandle, int32_t length);
+// extern int32_t v1_4_sha256(void *context, int32_t dataOffset, int32_t length, int32_t resultOffset);
+// extern int32_t v1_4_managedSha256(void *context, int32_t inputHandle, int32_t outputHandle);
+// extern int32_t v1_4_keccak256(void *context, int32_t dataOffset, int32_t length, int32_t resultOffset);
+// extern int32_t v1_4_managedKeccak256(void *context, int32_t inputHandle, int32_t outputHandle);
+// extern int32_t v1_4_ripemd160(void *context, int32_t dataOffset, int32_t length, int32_t resultOffset);
+// extern int32_t v1_4_sha512(void *context, int32_t dataOffset, int32_t length, int32_t resultOffset);
+// extern int32_t v1_4_managedSha512(void *context, int32_t inputHandle, int32_t outputHandle);
+// extern int32_t v1_4_sha3256(void *context, int32_t dataOffset, int32_t length, int32_t resultOffset);
+// extern int32_t v1_4_managedSha3256(void *context, int32_t inputHandle, int32_t outputHandle);
+// extern int32_t v1_4_blake2b256(void *context, int32_t dataOffset, int32_t length, int32_t resultOffset);
+// extern int32_t v1_4_managedBlake2b256(void *context, int32_t inputHandle, int32_t outputHandle);
+// extern int32_t v1_4_blake2b512(void *context, int32_t dataOffset, int32_t length, int32_t resultOffset);
+// extern int32_t v1_4_managedBlake2b512(void *context, int32_t inputHandle, int32_t outputHandle);
+// extern int32_t v1_4_poseidon(void *context, int32_t dataOffset, int32_t length, int32_t resultOffset);
+// extern int32_t v1_4_managedPoseidon(void *context, int32_t inputHandle, int32_t outputHandle);
+// extern int32_t v1_4_verifyBLS(void *context, int32_t keyOffset, int32_t messageOffset, int32_t messageLength, int32_t sigOffset);
+// extern int32_t v1_4_verifyEd25519(void *context, int32_t keyOffset, int32_t messageOffset, int32_t messageLength, int32_t sigOffset);
+// extern int32_t v1_4_verifyCustomSecp256k1(void *context, int32_t keyOffset, int32_t keyLength, int32_t messageOffset, int32_t messageLength, int32_t sigOffset, int32_t hashType);
+// extern int32_t v1_4_verifySecp256k1(void *context, int32_t keyOffset, int32_t keyLength, int32_t messageOffset, int32_t messageLength, int32_t sigOffset);
+// extern int32_t v1_4_verifySecp256r1(void *context, int32_t keyOffset, int32_t keyLength, int32_t messageOffset, int32_t messageLength, int32_t sigOffset);
+// extern int32_t v1_4_managedVerifySecp256r1(void *context, int32_t keyHandle, int32_t messageHandle, int32_t sigHandle);
+// extern int32_t v1_4_verifySchnorr(void *context, int32_t keyOffset, int32_t messageOffset, int32_t messageLength, int32_t sigOffset);
+// extern int32_t v1_4_managedVerifySchnorr(void *context, int32_t keyHandle, int32_t messageHandle, int32_t sigHandle);
+// extern int32_t v1_4_managedAddG1(void *context, int32_t curveID, int32_t resultHandle, int32_t point1Handle, int32_t point2Handle);
+// extern int32_t v1_4_managedScalarMultG1(void *context, int32_t curveID, int32_t resultHandle, int32_t pointHandle, int32_t scalarHandle);
+// extern int32_t v1_4_managedAddG2(void *context, int32_t curveID, int32_t resultHandle, int32_t point1Handle, int32_t point2Handle);
+// extern int32_t v1_4_managedScalarMultG2(void *context, int32_t curveID, int32_t resultHandle, int32_t pointHandle, int32_t scalarHandle);
+// extern int32_t v1_4_managedPairingCheck(void *context, int32_t curveID, int32_t g1PointsHandle, int32_t g2PointsHandle);
+// extern int32_t v1_4_managedVerifyGroth16(void *context, int32_t curveID, int32_t verifyingKeyHandle, int32_t proofHandle, int32_t publicInputsHandle);
+// extern int32_t v1_4_encodeSecp256k1DerSignature(void *context, int32_t rOffset, int32_t rLength, int32_t sOffset, int32_t sLength, int32_t sigOffset);
+// extern void v1_4_addEC(void *context, int32_t xResultHandle, int32_t yResultHandle, int32_t ecHandle, int32_t fstPointXHandle, int32_t fstPointYHandle, int32_t sndPointXHandle, int32_t sndPointYHandle);
+// extern void v1_4_doubleEC(void *context, int32_t xResultHandle, int32_t yResultHandle, int32_t ecHandle, int32_t pointXHandle, int32_t pointYHandle);
+// extern int32_t v1_4_isOnCurveEC(void *context, int32_t ecHandle, int32_t pointXHandle, int32_t pointYHandle);
+// extern int32_t v1_4_scalarBaseMultEC(void *context, int32_t xResultHandle, int32_t yResultHandle, int32_t ecHandle, int32_t dataOffset, int32_t length);
+// extern int32_t v1_4_scalarMultEC(void *context, int32_t xResultHandle, int32_t yResultHandle, int32_t ecHandle, int32_t pointXHandle, int32_t pointYHandle, int32_t dataOffset, int32_t length);
+// extern int32_t v1_4_marshalEC(void *context, int32_t xPairHandle, int32_t yPairHandle, int32_t ecHandle, int32_t resultOffset);
+// extern int32_t v1_4_marshalCompressedEC(void *context, int32_t xPairHandle, int32_t yPairHandle, int32_t ecHandle, int32_t resultOffset);
+// extern int32_t v1_4_unmarshalEC(void *context, int32_t xResultHandle, int32_t yResultHandle, int32_t ecHandle, int32_t dataOffset, int32_t length);
+// extern int32_t v1_4_unmarshalCompressedEC(void *context, int32_t xResultHandle, int32_t yResultHandle, int32_t ecHandle, int32_t dataOffset, int32_t length);
+// extern int32_t v1_4_generateKeyEC(void *context, int32_t xPubKeyHandle, int32_t yPubKeyHandle, int32_t ecHandle, int32_t resultOffset);
+// extern int32_t v1_4_createEC(void *context, int32_t dataOffset, int32_t dataLength);
+// extern int32_t v1_4_getCurveLengthEC(void *context, int32_t ecHandle);
+// extern int32_t v1_4_getPrivKeyByteLengthEC(void *context, int32_t ecHandle);
+// extern int32_t v1_4_ellipticCurveGetValues(void *context, int32_t ecHandle, int32_t fieldOrderHandle, int32_t basePointOrderHandle, int32_t eqConstantHandle, int32_t xBasePointHandle, int32_t yBasePointHandle);
+import "C"
+
+import (
+	"unsafe"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/wasmer"
+)
+
+// WasmerImports creates a new wasmer.Imports populated with all the functions of the VMHooks
+func WasmerImports() (*wasmer.Imports, error) {
+	imports := wasmer.NewImports()
+	imports = imports.Namespace("env")
+
+	var err error
+	imports, err = imports.Append("getGasLeft", v1_4_getGasLeft, C.v1_4_getGasLeft)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("getSCAddress", v1_4_getSCAddress, C.v1_4_getSCAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("getOwnerAddress", v1_4_getOwnerAddress, C.v1_4_getOwnerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("getShardOfAddress", v1_4_getShardOfAddress, C.v1_4_getShardOfAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("isSmartContract", v1_4_isSmartContract, C.v1_4_isSmartContract)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("signalError", v1_4_signalError, C.v1_4_signalError)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("getExternalBalance", v1_4_getExternalBalance, C.v1_4_getExternalBalance)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("getBlockHash", v1_4_getBlockHash, C.v1_4_getBlockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("getESDTBalance", v1_4_getESDTBalance, C.v1_4_getESDTBalance)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("getESDTNFTNameLength", v1_4_getESDTNFTNameLength, C.v1_4_getESDTNFTNameLength)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("getESDTNFTAttributeLength", v1_4_getESDTNFTAttributeLength, C.v1_4_getESDTNFTAttributeLength)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("getESDTNFTURILength", v1_4_getESDTNFTURILength, C.v1_4_getESDTNFTURILength)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("getESDTTokenData", v1_4_getESDTTokenData, C.v1_4_getESDTTokenData)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("getESDTLocalRoles", v1_4_getESDTLocalRoles, C.v1_4_getESDTLocalRoles)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("validateTokenIdentifier", v1_4_validateTokenIdentifier, C.v1_4_validateTokenIdentifier)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("transferValue", v1_4_transferValue, C.v1_4_transferValue)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("transferValueExecute", v1_4_transferValueExecute, C.v1_4_transferValueExecute)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("transferESDTExecute", v1_4_transferESDTExecute, C.v1_4_transferESDTExecute)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("transferESDTNFTExecute", v1_4_transferESDTNFTExecute, C.v1_4_transferESDTNFTExecute)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("multiTransferESDTNFTExecute", v1_4_multiTransferESDTNFTExecute, C.v1_4_multiTransferESDTNFTExecute)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("upgradeContract", v1_4_upgradeContract, C.v1_4_upgradeContract)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("upgradeFromSourceContract", v1_4_upgradeFromSourceContract, C.v1_4_upgradeFromSourceContract)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("asyncCall", v1_4_asyncCall, C.v1_4_asyncCall)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("createAsyncCall", v1_4_createAsyncCall, C.v1_4_createAsyncCall)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("getArgumentLength", v1_4_getArgumentLength, C.v1_4_getArgumentLength)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("getArgument", v1_4_getArgument, C.v1_4_getArgument)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("getFunction", v1_4_getFunction, C.v1_4_getFunction)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("getNumArguments", v1_4_getNumArguments, C.v1_4_getNumArguments)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("storageStore", v1_4_storageStore, C.v1_4_storageStore)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("storageLoadLength", v1_4_storageLoadLength, C.v1_4_storageLoadLength)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("storageLoadFromAddress", v1_4_storageLoadFromAddress, C.v1_4_storageLoadFromAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("storageLoad", v1_4_storageLoad, C.v1_4_storageLoad)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("setStorageLock", v1_4_setStorageLock, C.v1_4_setStorageLock)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("getStorageLock", v1_4_getStorageLock, C.v1_4_getStorageLock)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("isStorageLocked", v1_4_isStorageLocked, C.v1_4_isStorageLocked)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("clearStorageLock", v1_4_clearStorageLock, C.v1_4_clearStorageLock)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("getCaller", v1_4_getCaller, C.v1_4_getCaller)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("checkNoPayment", v1_4_checkNoPayment, C.v1_4_checkNoPayment)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("getCallValue", v1_4_getCallValue, C.v1_4_getCallValue)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("getESDTValue", v1_4_getESDTValue, C.v1_4_getESDTValue)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("getESDTValueByIndex", v1_4_getESDTValueByIndex, C.v1_4_getESDTValueByIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("getESDTTokenName", v1_4_getESDTTokenName, C.v1_4_getESDTTokenName)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("getESDTTokenNameByIndex", v1_4_getESDTTokenNameByIndex, C.v1_4_getESDTTokenNameByIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("getESDTTokenNonce", v1_4_getESDTTokenNonce, C.v1_4_getESDTTokenNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("getESDTTokenNonceByIndex", v1_4_getESDTTokenNonceByIndex, C.v1_4_getESDTTokenNonceByIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("getCurrentESDTNFTNonce", v1_4_getCurrentESDTNFTNonce, C.v1_4_getCurrentESDTNFTNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("getESDTTokenType", v1_4_getESDTTokenType, C.v1_4_getESDTTokenType)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("getESDTTokenTypeByIndex", v1_4_getESDTTokenTypeByIndex, C.v1_4_getESDTTokenTypeByIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("getNumESDTTransfers", v1_4_getNumESDTTransfers, C.v1_4_getNumESDTTransfers)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("getCallValueTokenName", v1_4_getCallValueTokenName, C.v1_4_getCallValueTokenName)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("getCallValueTokenNameByIndex", v1_4_getCallValueTokenNameByIndex, C.v1_4_getCallValueTokenNameByIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("writeLog", v1_4_writeLog, C.v1_4_writeLog)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("writeEventLog", v1_4_writeEventLog, C.v1_4_writeEventLog)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("getBlockTimestamp", v1_4_getBlockTimestamp, C.v1_4_getBlockTimestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("getBlockNonce", v1_4_getBlockNonce, C.v1_4_getBlockNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("getBlockRound", v1_4_getBlockRound, C.v1_4_getBlockRound)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("getBlockEpoch", v1_4_getBlockEpoch, C.v1_4_getBlockEpoch)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("getBlockRandomSeed", v1_4_getBlockRandomSeed, C.v1_4_getBlockRandomSeed)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("getStateRootHash", v1_4_getStateRootHash, C.v1_4_getStateRootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("getPrevBlockTimestamp", v1_4_getPrevBlockTimestamp, C.v1_4_getPrevBlockTimestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("getPrevBlockNonce", v1_4_getPrevBlockNonce, C.v1_4_getPrevBlockNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("getPrevBlockRound", v1_4_getPrevBlockRound, C.v1_4_getPrevBlockRound)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("getPrevBlockEpoch", v1_4_getPrevBlockEpoch, C.v1_4_getPrevBlockEpoch)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("getPrevBlockRandomSeed", v1_4_getPrevBlockRandomSeed, C.v1_4_getPrevBlockRandomSeed)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("finish", v1_4_finish, C.v1_4_finish)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("executeOnSameContext", v1_4_executeOnSameContext, C.v1_4_executeOnSameContext)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("executeOnDestContext", v1_4_executeOnDestContext, C.v1_4_executeOnDestContext)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("executeOnDestContextByCaller", v1_4_executeOnDestContextByCaller, C.v1_4_executeOnDestContextByCaller)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("executeReadOnly", v1_4_executeReadOnly, C.v1_4_executeReadOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("createContract", v1_4_createContract, C.v1_4_createContract)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("deployFromSourceContract", v1_4_deployFromSourceContract, C.v1_4_deployFromSourceContract)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("getNumReturnData", v1_4_getNumReturnData, C.v1_4_getNumReturnData)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("getReturnDataSize", v1_4_getReturnDataSize, C.v1_4_getReturnDataSize)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("getReturnData", v1_4_getReturnData, C.v1_4_getReturnData)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("cleanReturnData", v1_4_cleanReturnData, C.v1_4_cleanReturnData)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("deleteFromReturnData", v1_4_deleteFromReturnData, C.v1_4_deleteFromReturnData)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("getOriginalTxHash", v1_4_getOriginalTxHash, C.v1_4_getOriginalTxHash)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigIntGetUnsignedArgument", v1_4_bigIntGetUnsignedArgument, C.v1_4_bigIntGetUnsignedArgument)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigIntGetSignedArgument", v1_4_bigIntGetSignedArgument, C.v1_4_bigIntGetSignedArgument)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigIntStorageStoreUnsigned", v1_4_bigIntStorageStoreUnsigned, C.v1_4_bigIntStorageStoreUnsigned)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigIntStorageLoadUnsigned", v1_4_bigIntStorageLoadUnsigned, C.v1_4_bigIntStorageLoadUnsigned)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigIntGetCallValue", v1_4_bigIntGetCallValue, C.v1_4_bigIntGetCallValue)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigIntGetESDTCallValue", v1_4_bigIntGetESDTCallValue, C.v1_4_bigIntGetESDTCallValue)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigIntGetESDTCallValueByIndex", v1_4_bigIntGetESDTCallValueByIndex, C.v1_4_bigIntGetESDTCallValueByIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigIntGetExternalBalance", v1_4_bigIntGetExternalBalance, C.v1_4_bigIntGetExternalBalance)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigIntGetESDTExternalBalance", v1_4_bigIntGetESDTExternalBalance, C.v1_4_bigIntGetESDTExternalBalance)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigIntNew", v1_4_bigIntNew, C.v1_4_bigIntNew)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigIntUnsignedByteLength", v1_4_bigIntUnsignedByteLength, C.v1_4_bigIntUnsignedByteLength)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigIntSignedByteLength", v1_4_bigIntSignedByteLength, C.v1_4_bigIntSignedByteLength)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigIntGetUnsignedBytes", v1_4_bigIntGetUnsignedBytes, C.v1_4_bigIntGetUnsignedBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigIntGetSignedBytes", v1_4_bigIntGetSignedBytes, C.v1_4_bigIntGetSignedBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigIntSetUnsignedBytes", v1_4_bigIntSetUnsignedBytes, C.v1_4_bigIntSetUnsignedBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigIntSetSignedBytes", v1_4_bigIntSetSignedBytes, C.v1_4_bigIntSetSignedBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigIntIsInt64", v1_4_bigIntIsInt64, C.v1_4_bigIntIsInt64)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigIntGetInt64", v1_4_bigIntGetInt64, C.v1_4_bigIntGetInt64)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigIntSetInt64", v1_4_bigIntSetInt64, C.v1_4_bigIntSetInt64)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigIntAdd", v1_4_bigIntAdd, C.v1_4_bigIntAdd)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigIntSub", v1_4_bigIntSub, C.v1_4_bigIntSub)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigIntMul", v1_4_bigIntMul, C.v1_4_bigIntMul)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigIntTDiv", v1_4_bigIntTDiv, C.v1_4_bigIntTDiv)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigIntTMod", v1_4_bigIntTMod, C.v1_4_bigIntTMod)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigIntEDiv", v1_4_bigIntEDiv, C.v1_4_bigIntEDiv)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigIntEMod", v1_4_bigIntEMod, C.v1_4_bigIntEMod)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigIntSqrt", v1_4_bigIntSqrt, C.v1_4_bigIntSqrt)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigIntPow", v1_4_bigIntPow, C.v1_4_bigIntPow)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigIntLog2", v1_4_bigIntLog2, C.v1_4_bigIntLog2)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigIntAbs", v1_4_bigIntAbs, C.v1_4_bigIntAbs)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigIntNeg", v1_4_bigIntNeg, C.v1_4_bigIntNeg)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigIntSign", v1_4_bigIntSign, C.v1_4_bigIntSign)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigIntCmp", v1_4_bigIntCmp, C.v1_4_bigIntCmp)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigIntNot", v1_4_bigIntNot, C.v1_4_bigIntNot)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigIntAnd", v1_4_bigIntAnd, C.v1_4_bigIntAnd)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigIntOr", v1_4_bigIntOr, C.v1_4_bigIntOr)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigIntXor", v1_4_bigIntXor, C.v1_4_bigIntXor)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigIntShr", v1_4_bigIntShr, C.v1_4_bigIntShr)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigIntShl", v1_4_bigIntShl, C.v1_4_bigIntShl)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigIntFinishUnsigned", v1_4_bigIntFinishUnsigned, C.v1_4_bigIntFinishUnsigned)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigIntFinishSigned", v1_4_bigIntFinishSigned, C.v1_4_bigIntFinishSigned)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("smallIntGetUnsignedArgument", v1_4_smallIntGetUnsignedArgument, C.v1_4_smallIntGetUnsignedArgument)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("smallIntGetSignedArgument", v1_4_smallIntGetSignedArgument, C.v1_4_smallIntGetSignedArgument)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("smallIntFinishUnsigned", v1_4_smallIntFinishUnsigned, C.v1_4_smallIntFinishUnsigned)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("smallIntFinishSigned", v1_4_smallIntFinishSigned, C.v1_4_smallIntFinishSigned)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("smallIntStorageStoreUnsigned", v1_4_smallIntStorageStoreUnsigned, C.v1_4_smallIntStorageStoreUnsigned)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("smallIntStorageStoreSigned", v1_4_smallIntStorageStoreSigned, C.v1_4_smallIntStorageStoreSigned)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("smallIntStorageLoadUnsigned", v1_4_smallIntStorageLoadUnsigned, C.v1_4_smallIntStorageLoadUnsigned)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("smallIntStorageLoadSigned", v1_4_smallIntStorageLoadSigned, C.v1_4_smallIntStorageLoadSigned)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("int64getArgument", v1_4_int64getArgument, C.v1_4_int64getArgument)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("int64finish", v1_4_int64finish, C.v1_4_int64finish)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("int64storageStore", v1_4_int64storageStore, C.v1_4_int64storageStore)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("int64storageLoad", v1_4_int64storageLoad, C.v1_4_int64storageLoad)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedSCAddress", v1_4_managedSCAddress, C.v1_4_managedSCAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedOwnerAddress", v1_4_managedOwnerAddress, C.v1_4_managedOwnerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedCaller", v1_4_managedCaller, C.v1_4_managedCaller)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedSignalError", v1_4_managedSignalError, C.v1_4_managedSignalError)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedWriteLog", v1_4_managedWriteLog, C.v1_4_managedWriteLog)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedGetOriginalTxHash", v1_4_managedGetOriginalTxHash, C.v1_4_managedGetOriginalTxHash)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedGetStateRootHash", v1_4_managedGetStateRootHash, C.v1_4_managedGetStateRootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedGetBlockRandomSeed", v1_4_managedGetBlockRandomSeed, C.v1_4_managedGetBlockRandomSeed)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedGetPrevBlockRandomSeed", v1_4_managedGetPrevBlockRandomSeed, C.v1_4_managedGetPrevBlockRandomSeed)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedGetReturnData", v1_4_managedGetReturnData, C.v1_4_managedGetReturnData)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedGetMultiESDTCallValue", v1_4_managedGetMultiESDTCallValue, C.v1_4_managedGetMultiESDTCallValue)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedGetESDTBalance", v1_4_managedGetESDTBalance, C.v1_4_managedGetESDTBalance)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedGetESDTTokenData", v1_4_managedGetESDTTokenData, C.v1_4_managedGetESDTTokenData)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedAsyncCall", v1_4_managedAsyncCall, C.v1_4_managedAsyncCall)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedCreateAsyncCall", v1_4_managedCreateAsyncCall, C.v1_4_managedCreateAsyncCall)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedGetCallbackClosure", v1_4_managedGetCallbackClosure, C.v1_4_managedGetCallbackClosure)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedUpgradeFromSourceContract", v1_4_managedUpgradeFromSourceContract, C.v1_4_managedUpgradeFromSourceContract)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedUpgradeContract", v1_4_managedUpgradeContract, C.v1_4_managedUpgradeContract)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedDeployFromSourceContract", v1_4_managedDeployFromSourceContract, C.v1_4_managedDeployFromSourceContract)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedCreateContract", v1_4_managedCreateContract, C.v1_4_managedCreateContract)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedExecuteReadOnly", v1_4_managedExecuteReadOnly, C.v1_4_managedExecuteReadOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedExecuteOnSameContext", v1_4_managedExecuteOnSameContext, C.v1_4_managedExecuteOnSameContext)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedExecuteOnDestContextByCaller", v1_4_managedExecuteOnDestContextByCaller, C.v1_4_managedExecuteOnDestContextByCaller)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedExecuteOnDestContext", v1_4_managedExecuteOnDestContext, C.v1_4_managedExecuteOnDestContext)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedMultiTransferESDTNFTExecute", v1_4_managedMultiTransferESDTNFTExecute, C.v1_4_managedMultiTransferESDTNFTExecute)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedTransferValueExecute", v1_4_managedTransferValueExecute, C.v1_4_managedTransferValueExecute)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mBufferNew", v1_4_mBufferNew, C.v1_4_mBufferNew)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mBufferNewFromBytes", v1_4_mBufferNewFromBytes, C.v1_4_mBufferNewFromBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mBufferGetLength", v1_4_mBufferGetLength, C.v1_4_mBufferGetLength)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mBufferGetBytes", v1_4_mBufferGetBytes, C.v1_4_mBufferGetBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mBufferGetByteSlice", v1_4_mBufferGetByteSlice, C.v1_4_mBufferGetByteSlice)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mBufferCopyByteSlice", v1_4_mBufferCopyByteSlice, C.v1_4_mBufferCopyByteSlice)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mBufferEq", v1_4_mBufferEq, C.v1_4_mBufferEq)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mBufferSetBytes", v1_4_mBufferSetBytes, C.v1_4_mBufferSetBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mBufferSetByteSlice", v1_4_mBufferSetByteSlice, C.v1_4_mBufferSetByteSlice)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mBufferAppend", v1_4_mBufferAppend, C.v1_4_mBufferAppend)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mBufferAppendBytes", v1_4_mBufferAppendBytes, C.v1_4_mBufferAppendBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mBufferToBigIntUnsigned", v1_4_mBufferToBigIntUnsigned, C.v1_4_mBufferToBigIntUnsigned)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mBufferToBigIntSigned", v1_4_mBufferToBigIntSigned, C.v1_4_mBufferToBigIntSigned)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mBufferFromBigIntUnsigned", v1_4_mBufferFromBigIntUnsigned, C.v1_4_mBufferFromBigIntUnsigned)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mBufferFromBigIntSigned", v1_4_mBufferFromBigIntSigned, C.v1_4_mBufferFromBigIntSigned)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mBufferStorageStore", v1_4_mBufferStorageStore, C.v1_4_mBufferStorageStore)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mBufferStorageLoad", v1_4_mBufferStorageLoad, C.v1_4_mBufferStorageLoad)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mBufferStorageLoadFromAddress", v1_4_mBufferStorageLoadFromAddress, C.v1_4_mBufferStorageLoadFromAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mBufferGetArgument", v1_4_mBufferGetArgument, C.v1_4_mBufferGetArgument)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mBufferFinish", v1_4_mBufferFinish, C.v1_4_mBufferFinish)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mBufferSetRandom", v1_4_mBufferSetRandom, C.v1_4_mBufferSetRandom)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("sha256", v1_4_sha256, C.v1_4_sha256)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedSha256", v1_4_managedSha256, C.v1_4_managedSha256)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("keccak256", v1_4_keccak256, C.v1_4_keccak256)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedKeccak256", v1_4_managedKeccak256, C.v1_4_managedKeccak256)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("ripemd160", v1_4_ripemd160, C.v1_4_ripemd160)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("sha512", v1_4_sha512, C.v1_4_sha512)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedSha512", v1_4_managedSha512, C.v1_4_managedSha512)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("sha3256", v1_4_sha3256, C.v1_4_sha3256)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedSha3256", v1_4_managedSha3256, C.v1_4_managedSha3256)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("blake2b256", v1_4_blake2b256, C.v1_4_blake2b256)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedBlake2b256", v1_4_managedBlake2b256, C.v1_4_managedBlake2b256)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("blake2b512", v1_4_blake2b512, C.v1_4_blake2b512)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedBlake2b512", v1_4_managedBlake2b512, C.v1_4_managedBlake2b512)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("poseidon", v1_4_poseidon, C.v1_4_poseidon)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedPoseidon", v1_4_managedPoseidon, C.v1_4_managedPoseidon)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("verifyBLS", v1_4_verifyBLS, C.v1_4_verifyBLS)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("verifyEd25519", v1_4_verifyEd25519, C.v1_4_verifyEd25519)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("verifyCustomSecp256k1", v1_4_verifyCustomSecp256k1, C.v1_4_verifyCustomSecp256k1)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("verifySecp256k1", v1_4_verifySecp256k1, C.v1_4_verifySecp256k1)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("verifySecp256r1", v1_4_verifySecp256r1, C.v1_4_verifySecp256r1)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedVerifySecp256r1", v1_4_managedVerifySecp256r1, C.v1_4_managedVerifySecp256r1)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("verifySchnorr", v1_4_verifySchnorr, C.v1_4_verifySchnorr)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedVerifySchnorr", v1_4_managedVerifySchnorr, C.v1_4_managedVerifySchnorr)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedAddG1", v1_4_managedAddG1, C.v1_4_managedAddG1)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedScalarMultG1", v1_4_managedScalarMultG1, C.v1_4_managedScalarMultG1)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedAddG2", v1_4_managedAddG2, C.v1_4_managedAddG2)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedScalarMultG2", v1_4_managedScalarMultG2, C.v1_4_managedScalarMultG2)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedPairingCheck", v1_4_managedPairingCheck, C.v1_4_managedPairingCheck)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedVerifyGroth16", v1_4_managedVerifyGroth16, C.v1_4_managedVerifyGroth16)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("encodeSecp256k1DerSignature", v1_4_encodeSecp256k1DerSignature, C.v1_4_encodeSecp256k1DerSignature)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("addEC", v1_4_addEC, C.v1_4_addEC)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("doubleEC", v1_4_doubleEC, C.v1_4_doubleEC)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("isOnCurveEC", v1_4_isOnCurveEC, C.v1_4_isOnCurveEC)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("scalarBaseMultEC", v1_4_scalarBaseMultEC, C.v1_4_scalarBaseMultEC)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("scalarMultEC", v1_4_scalarMultEC, C.v1_4_scalarMultEC)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("marshalEC", v1_4_marshalEC, C.v1_4_marshalEC)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("marshalCompressedEC", v1_4_marshalCompressedEC, C.v1_4_marshalCompressedEC)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("unmarshalEC", v1_4_unmarshalEC, C.v1_4_unmarshalEC)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("unmarshalCompressedEC", v1_4_unmarshalCompressedEC, C.v1_4_unmarshalCompressedEC)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("generateKeyEC", v1_4_generateKeyEC, C.v1_4_generateKeyEC)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("createEC", v1_4_createEC, C.v1_4_createEC)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("getCurveLengthEC", v1_4_getCurveLengthEC, C.v1_4_getCurveLengthEC)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("getPrivKeyByteLengthEC", v1_4_getPrivKeyByteLengthEC, C.v1_4_getPrivKeyByteLengthEC)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("ellipticCurveGetValues", v1_4_ellipticCurveGetValues, C.v1_4_ellipticCurveGetValues)
+	if err != nil {
+		return nil, err
+	}
+
+	return imports, nil
+}
+
+//export v1_4_getGasLeft
+func v1_4_getGasLeft(context unsafe.Pointer) int64 {
+	return arwen.GetVMHost(context).VMHooks().GetGasLeft()
+}
+
+//export v1_4_getSCAddress
+func v1_4_getSCAddress(context unsafe.Pointer, resultOffset int32) {
+	arwen.GetVMHost(context).VMHooks().GetSCAddress(resultOffset)
+}
+
+//export v1_4_getOwnerAddress
+func v1_4_getOwnerAddress(context unsafe.Pointer, resultOffset int32) {
+	arwen.GetVMHost(context).VMHooks().GetOwnerAddress(resultOffset)
+}
+
+//export v1_4_getShardOfAddress
+func v1_4_getShardOfAddress(context unsafe.Pointer, addressOffset int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().GetShardOfAddress(addressOffset)
+}
+
+//export v1_4_isSmartContract
+func v1_4_isSmartContract(context unsafe.Pointer, addressOffset int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().IsSmartContract(addressOffset)
+}
+
+//export v1_4_signalError
+func v1_4_signalError(context unsafe.Pointer, messageOffset int32, messageLength int32) {
+	arwen.GetVMHost(context).VMHooks().SignalError(messageOffset, messageLength)
+}
+
+//export v1_4_getExternalBalance
+func v1_4_getExternalBalance(context unsafe.Pointer, addressOffset int32, resultOffset int32) {
+	arwen.GetVMHost(context).VMHooks().GetExternalBalance(addressOffset, resultOffset)
+}
+
+//export v1_4_getBlockHash
+func v1_4_getBlockHash(context unsafe.Pointer, nonce int64, resultOffset int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().GetBlockHash(nonce, resultOffset)
+}
+
+//export v1_4_getESDTBalance
+func v1_4_getESDTBalance(context unsafe.Pointer, addressOffset int32, tokenIDOffset int32, tokenIDLen int32, nonce int64, resultOffset int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().GetESDTBalance(addressOffset, tokenIDOffset, tokenIDLen, nonce, resultOffset)
+}
+
+//export v1_4_getESDTNFTNameLength
+func v1_4_getESDTNFTNameLength(context unsafe.Pointer, addressOffset int32, tokenIDOffset int32, tokenIDLen int32, nonce int64) int32 {
+	return arwen.GetVMHost(context).VMHooks().GetESDTNFTNameLength(addressOffset, tokenIDOffset, tokenIDLen, nonce)
+}
+
+//export v1_4_getESDTNFTAttributeLength
+func v1_4_getESDTNFTAttributeLength(context unsafe.Pointer, addressOffset int32, tokenIDOffset int32, tokenIDLen int32, nonce int64) int32 {
+	return arwen.GetVMHost(context).VMHooks().GetESDTNFTAttributeLength(addressOffset, tokenIDOffset, tokenIDLen, nonce)
+}
+
+//export v1_4_getESDTNFTURILength
+func v1_4_getESDTNFTURILength(context unsafe.Pointer, addressOffset int32, tokenIDOffset int32, tokenIDLen int32, nonce int64) int32 {
+	return arwen.GetVMHost(context).VMHooks().GetESDTNFTURILength(addressOffset, tokenIDOffset, tokenIDLen, nonce)
+}
+
+//export v1_4_getESDTTokenData
+func v1_4_getESDTTokenData(context unsafe.Pointer, addressOffset int32, tokenIDOffset int32, tokenIDLen int32, nonce int64, valueHandle int32, propertiesOffset int32, hashOffset int32, nameOffset int32, attributesOffset int32, creatorOffset int32, royaltiesHandle int32, urisOffset int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().GetESDTTokenData(addressOffset, tokenIDOffset, tokenIDLen, nonce, valueHandle, propertiesOffset, hashOffset, nameOffset, attributesOffset, creatorOffset, royaltiesHandle, urisOffset)
+}
+
+//export v1_4_getESDTLocalRoles
+func v1_4_getESDTLocalRoles(context unsafe.Pointer, tokenIdHandle int32) int64 {
+	return arwen.GetVMHost(context).VMHooks().GetESDTLocalRoles(tokenIdHandle)
+}
+
+//export v1_4_validateTokenIdentifier
+func v1_4_validateTokenIdentifier(context unsafe.Pointer, tokenIdHandle int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().ValidateTokenIdentifier(tokenIdHandle)
+}
+
+//export v1_4_transferValue
+func v1_4_transferValue(context unsafe.Pointer, destOffset int32, valueOffset int32, dataOffset int32, length int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().TransferValue(destOffset, valueOffset, dataOffset, length)
+}
+
+//export v1_4_transferValueExecute
+func v1_4_transferValueExecute(context unsafe.Pointer, destOffset int32, valueOffset int32, gasLimit int64, functionOffset int32, functionLength int32, numArguments int32, argumentsLengthOffset int32, dataOffset int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().TransferValueExecute(destOffset, valueOffset, gasLimit, functionOffset, functionLength, numArguments, argumentsLengthOffset, dataOffset)
+}
+
+//export v1_4_transferESDTExecute
+func v1_4_transferESDTExecute(context unsafe.Pointer, destOffset int32, tokenIDOffset int32, tokenIDLen int32, valueOffset int32, gasLimit int64, functionOffset int32, functionLength int32, numArguments int32, argumentsLengthOffset int32, dataOffset int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().TransferESDTExecute(destOffset, tokenIDOffset, tokenIDLen, valueOffset, gasLimit, functionOffset, functionLength, numArguments, argumentsLengthOffset, dataOffset)
+}
+
+//export v1_4_transferESDTNFTExecute
+func v1_4_transferESDTNFTExecute(context unsafe.Pointer, destOffset int32, tokenIDOffset int32, tokenIDLen int32, valueOffset int32, nonce int64, gasLimit int64, functionOffset int32, functionLength int32, numArguments int32, argumentsLengthOffset int32, dataOffset int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().TransferESDTNFTExecute(destOffset, tokenIDOffset, tokenIDLen, valueOffset, nonce, gasLimit, functionOffset, functionLength, numArguments, argumentsLengthOffset, dataOffset)
+}
+
+//export v1_4_multiTransferESDTNFTExecute
+func v1_4_multiTransferESDTNFTExecute(context unsafe.Pointer, destOffset int32, numTokenTransfers int32, tokenTransfersArgsLengthOffset int32, tokenTransferDataOffset int32, gasLimit int64, functionOffset int32, functionLength int32, numArguments int32, argumentsLengthOffset int32, dataOffset int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().MultiTransferESDTNFTExecute(destOffset, numTokenTransfers, tokenTransfersArgsLengthOffset, tokenTransferDataOffset, gasLimit, functionOffset, functionLength, numArguments, argumentsLengthOffset, dataOffset)
+}
+
+//export v1_4_upgradeContract
+func v1_4_upgradeContract(context unsafe.Pointer, destOffset int32, gasLimit int64, valueOffset int32, codeOffset int32, codeMetadataOffset int32, length int32, numArguments int32, argumentsLengthOffset int32, dataOffset int32) {
+	arwen.GetVMHost(context).VMHooks().UpgradeContract(destOffset, gasLimit, valueOffset, codeOffset, codeMetadataOffset, length, numArguments, argumentsLengthOffset, dataOffset)
+}
+
+//export v1_4_upgradeFromSourceContract
+func v1_4_upgradeFromSourceContract(context unsafe.Pointer, destOffset int32, gasLimit int64, valueOffset int32, sourceContractAddressOffset int32, codeMetadataOffset int32, numArguments int32, argumentsLengthOffset int32, dataOffset int32) {
+	arwen.GetVMHost(context).VMHooks().UpgradeFromSourceContract(destOffset, gasLimit, valueOffset, sourceContractAddressOffset, codeMetadataOffset, numArguments, argumentsLengthOffset, dataOffset)
+}
+
+//export v1_4_asyncCall
+func v1_4_asyncCall(context unsafe.Pointer, destOffset int32, valueOffset int32, dataOffset int32, length int32) {
+	arwen.GetVMHost(context).VMHooks().AsyncCall(destOffset, valueOffset, dataOffset, length)
+}
+
+//export v1_4_createAsyncCall
+func v1_4_createAsyncCall(context unsafe.Pointer, asyncContextIdentifier int32, identifierLength int32, destOffset int32, valueOffset int32, dataOffset int32, length int32, successOffset int32, successLength int32, errorOffset int32, errorLength int32, gas int64) {
+	arwen.GetVMHost(context).VMHooks().CreateAsyncCall(asyncContextIdentifier, identifierLength, destOffset, valueOffset, dataOffset, length, successOffset, successLength, errorOffset, errorLength, gas)
+}
+
+//export v1_4_getArgumentLength
+func v1_4_getArgumentLength(context unsafe.Pointer, id int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().GetArgumentLength(id)
+}
+
+//export v1_4_getArgument
+func v1_4_getArgument(context unsafe.Pointer, id int32, argOffset int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().GetArgument(id, argOffset)
+}
+
+//export v1_4_getFunction
+func v1_4_getFunction(context unsafe.Pointer, functionOffset int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().GetFunction(functionOffset)
+}
+
+//export v1_4_getNumArguments
+func v1_4_getNumArguments(context unsafe.Pointer) int32 {
+	return arwen.GetVMHost(context).VMHooks().GetNumArguments()
+}
+
+//export v1_4_storageStore
+func v1_4_storageStore(context unsafe.Pointer, keyOffset int32, keyLength int32, dataOffset int32, dataLength int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().StorageStore(keyOffset, keyLength, dataOffset, dataLength)
+}
+
+//export v1_4_storageLoadLength
+func v1_4_storageLoadLength(context unsafe.Pointer, keyOffset int32, keyLength int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().StorageLoadLength(keyOffset, keyLength)
+}
+
+//export v1_4_storageLoadFromAddress
+func v1_4_storageLoadFromAddress(context unsafe.Pointer, addressOffset int32, keyOffset int32, keyLength int32, dataOffset int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().StorageLoadFromAddress(addressOffset, keyOffset, keyLength, dataOffset)
+}
+
+//export v1_4_storageLoad
+func v1_4_storageLoad(context unsafe.Pointer, keyOffset int32, keyLength int32, dataOffset int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().StorageLoad(keyOffset, keyLength, dataOffset)
+}
+
+//export v1_4_setStorageLock
+func v1_4_setStorageLock(context unsafe.Pointer, keyOffset int32, keyLength int32, lockTimestamp int64) int32 {
+	return arwen.GetVMHost(context).VMHooks().SetStorageLock(keyOffset, keyLength, lockTimestamp)
+}
+
+//export v1_4_getStorageLock
+func v1_4_getStorageLock(context unsafe.Pointer, keyOffset int32, keyLength int32) int64 {
+	return arwen.GetVMHost(context).VMHooks().GetStorageLock(keyOffset, keyLength)
+}
+
+//export v1_4_isStorageLocked
+func v1_4_isStorageLocked(context unsafe.Pointer, keyOffset int32, keyLength int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().IsStorageLocked(keyOffset, keyLength)
+}
+
+//export v1_4_clearStorageLock
+func v1_4_clearStorageLock(context unsafe.Pointer, keyOffset int32, keyLength int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().ClearStorageLock(keyOffset, keyLength)
+}
+
+//export v1_4_getCaller
+func v1_4_getCaller(context unsafe.Pointer, resultOffset int32) {
+	arwen.GetVMHost(context).VMHooks().GetCaller(resultOffset)
+}
+
+//export v1_4_checkNoPayment
+func v1_4_checkNoPayment(context unsafe.Pointer) {
+	arwen.GetVMHost(context).VMHooks().CheckNoPayment()
+}
+
+//export v1_4_getCallValue
+func v1_4_getCallValue(context unsafe.Pointer, resultOffset int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().GetCallValue(resultOffset)
+}
+
+//export v1_4_getESDTValue
+func v1_4_getESDTValue(context unsafe.Pointer, resultOffset int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().GetESDTValue(resultOffset)
+}
+
+//export v1_4_getESDTValueByIndex
+func v1_4_getESDTValueByIndex(context unsafe.Pointer, resultOffset int32, index int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().GetESDTValueByIndex(resultOffset, index)
+}
+
+//export v1_4_getESDTTokenName
+func v1_4_getESDTTokenName(context unsafe.Pointer, resultOffset int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().GetESDTTokenName(resultOffset)
+}
+
+//export v1_4_getESDTTokenNameByIndex
+func v1_4_getESDTTokenNameByIndex(context unsafe.Pointer, resultOffset int32, index int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().GetESDTTokenNameByIndex(resultOffset, index)
+}
+
+//export v1_4_getESDTTokenNonce
+func v1_4_getESDTTokenNonce(context unsafe.Pointer) int64 {
+	return arwen.GetVMHost(context).VMHooks().GetESDTTokenNonce()
+}
+
+//export v1_4_getESDTTokenNonceByIndex
+func v1_4_getESDTTokenNonceByIndex(context unsafe.Pointer, index int32) int64 {
+	return arwen.GetVMHost(context).VMHooks().GetESDTTokenNonceByIndex(index)
+}
+
+//export v1_4_getCurrentESDTNFTNonce
+func v1_4_getCurrentESDTNFTNonce(context unsafe.Pointer, addressOffset int32, tokenIDOffset int32, tokenIDLen int32) int64 {
+	return arwen.GetVMHost(context).VMHooks().GetCurrentESDTNFTNonce(addressOffset, tokenIDOffset, tokenIDLen)
+}
+
+//export v1_4_getESDTTokenType
+func v1_4_getESDTTokenType(context unsafe.Pointer) int32 {
+	return arwen.GetVMHost(context).VMHooks().GetESDTTokenType()
+}
+
+//export v1_4_getESDTTokenTypeByIndex
+func v1_4_getESDTTokenTypeByIndex(context unsafe.Pointer, index int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().GetESDTTokenTypeByIndex(index)
+}
+
+//export v1_4_getNumESDTTransfers
+func v1_4_getNumESDTTransfers(context unsafe.Pointer) int32 {
+	return arwen.GetVMHost(context).VMHooks().GetNumESDTTransfers()
+}
+
+//export v1_4_getCallValueTokenName
+func v1_4_getCallValueTokenName(context unsafe.Pointer, callValueOffset int32, tokenNameOffset int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().GetCallValueTokenName(callValueOffset, tokenNameOffset)
+}
+
+//export v1_4_getCallValueTokenNameByIndex
+func v1_4_getCallValueTokenNameByIndex(context unsafe.Pointer, callValueOffset int32, tokenNameOffset int32, index int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().GetCallValueTokenNameByIndex(callValueOffset, tokenNameOffset, index)
+}
+
+//export v1_4_writeLog
+func v1_4_writeLog(context unsafe.Pointer, dataPointer int32, dataLength int32, topicPtr int32, numTopics int32) {
+	arwen.GetVMHost(context).VMHooks().WriteLog(dataPointer, dataLength, topicPtr, numTopics)
+}
+
+//export v1_4_writeEventLog
+func v1_4_writeEventLog(context unsafe.Pointer, numTopics int32, topicLengthsOffset int32, topicOffset int32, dataOffset int32, dataLength int32) {
+	arwen.GetVMHost(context).VMHooks().WriteEventLog(numTopics, topicLengthsOffset, topicOffset, dataOffset, dataLength)
+}
+
+//export v1_4_getBlockTimestamp
+func v1_4_getBlockTimestamp(context unsafe.Pointer) int64 {
+	return arwen.GetVMHost(context).VMHooks().GetBlockTimestamp()
+}
+
+//export v1_4_getBlockNonce
+func v1_4_getBlockNonce(context unsafe.Pointer) int64 {
+	return arwen.GetVMHost(context).VMHooks().GetBlockNonce()
+}
+
+//export v1_4_getBlockRound
+func v1_4_getBlockRound(context unsafe.Pointer) int64 {
+	return arwen.GetVMHost(context).VMHooks().GetBlockRound()
+}
+
+//export v1_4_getBlockEpoch
+func v1_4_getBlockEpoch(context unsafe.Pointer) int64 {
+	return arwen.GetVMHost(context).VMHooks().GetBlockEpoch()
+}
+
+//export v1_4_getBlockRandomSeed
+func v1_4_getBlockRandomSeed(context unsafe.Pointer, pointer int32) {
+	arwen.GetVMHost(context).VMHooks().GetBlockRandomSeed(pointer)
+}
+
+//export v1_4_getStateRootHash
+func v1_4_getStateRootHash(context unsafe.Pointer, pointer int32) {
+	arwen.GetVMHost(context).VMHooks().GetStateRootHash(pointer)
+}
+
+//export v1_4_getPrevBlockTimestamp
+func v1_4_getPrevBlockTimestamp(context unsafe.Pointer) int64 {
+	return arwen.GetVMHost(context).VMHooks().GetPrevBlockTimestamp()
+}
+
+//export v1_4_getPrevBlockNonce
+func v1_4_getPrevBlockNonce(context unsafe.Pointer) int64 {
+	return arwen.GetVMHost(context).VMHooks().GetPrevBlockNonce()
+}
+
+//export v1_4_getPrevBlockRound
+func v1_4_getPrevBlockRound(context unsafe.Pointer) int64 {
+	return arwen.GetVMHost(context).VMHooks().GetPrevBlockRound()
+}
+
+//export v1_4_getPrevBlockEpoch
+func v1_4_getPrevBlockEpoch(context unsafe.Pointer) int64 {
+	return arwen.GetVMHost(context).VMHooks().GetPrevBlockEpoch()
+}
+
+//export v1_4_getPrevBlockRandomSeed
+func v1_4_getPrevBlockRandomSeed(context unsafe.Pointer, pointer int32) {
+	arwen.GetVMHost(context).VMHooks().GetPrevBlockRandomSeed(pointer)
+}
+
+//export v1_4_finish
+func v1_4_finish(context unsafe.Pointer, pointer int32, length int32) {
+	arwen.GetVMHost(context).VMHooks().Finish(pointer, length)
+}
+
+//export v1_4_executeOnSameContext
+func v1_4_executeOnSameContext(context unsafe.Pointer, gasLimit int64, addressOffset int32, valueOffset int32, functionOffset int32, functionLength int32, numArguments int32, argumentsLengthOffset int32, dataOffset int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().ExecuteOnSameContext(gasLimit, addressOffset, valueOffset, functionOffset, functionLength, numArguments, argumentsLengthOffset, dataOffset)
+}
+
+//export v1_4_executeOnDestContext
+func v1_4_executeOnDestContext(context unsafe.Pointer, gasLimit int64, addressOffset int32, valueOffset int32, functionOffset int32, functionLength int32, numArguments int32, argumentsLengthOffset int32, dataOffset int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().ExecuteOnDestContext(gasLimit, addressOffset, valueOffset, functionOffset, functionLength, numArguments, argumentsLengthOffset, dataOffset)
+}
+
+//export v1_4_executeOnDestContextByCaller
+func v1_4_executeOnDestContextByCaller(context unsafe.Pointer, gasLimit int64, addressOffset int32, valueOffset int32, functionOffset int32, functionLength int32, numArguments int32, argumentsLengthOffset int32, dataOffset int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().ExecuteOnDestContextByCaller(gasLimit, addressOffset, valueOffset, functionOffset, functionLength, numArguments, argumentsLengthOffset, dataOffset)
+}
+
+//export v1_4_executeReadOnly
+func v1_4_executeReadOnly(context unsafe.Pointer, gasLimit int64, addressOffset int32, functionOffset int32, functionLength int32, numArguments int32, argumentsLengthOffset int32, dataOffset int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().ExecuteReadOnly(gasLimit, addressOffset, functionOffset, functionLength, numArguments, argumentsLengthOffset, dataOffset)
+}
+
+//export v1_4_createContract
+func v1_4_createContract(context unsafe.Pointer, gasLimit int64, valueOffset int32, codeOffset int32, codeMetadataOffset int32, length int32, resultOffset int32, numArguments int32, argumentsLengthOffset int32, dataOffset int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().CreateContract(gasLimit, valueOffset, codeOffset, codeMetadataOffset, length, resultOffset, numArguments, argumentsLengthOffset, dataOffset)
+}
+
+//export v1_4_deployFromSourceContract
+func v1_4_deployFromSourceContract(context unsafe.Pointer, gasLimit int64, valueOffset int32, sourceContractAddressOffset int32, codeMetadataOffset int32, resultAddressOffset int32, numArguments int32, argumentsLengthOffset int32, dataOffset int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().DeployFromSourceContract(gasLimit, valueOffset, sourceContractAddressOffset, codeMetadataOffset, resultAddressOffset, numArguments, argumentsLengthOffset, dataOffset)
+}
+
+//export v1_4_getNumReturnData
+func v1_4_getNumReturnData(context unsafe.Pointer) int32 {
+	return arwen.GetVMHost(context).VMHooks().GetNumReturnData()
+}
+
+//export v1_4_getReturnDataSize
+func v1_4_getReturnDataSize(context unsafe.Pointer, resultID int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().GetReturnDataSize(resultID)
+}
+
+//export v1_4_getReturnData
+func v1_4_getReturnData(context unsafe.Pointer, resultID int32, dataOffset int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().GetReturnData(resultID, dataOffset)
+}
+
+//export v1_4_cleanReturnData
+func v1_4_cleanReturnData(context unsafe.Pointer) {
+	arwen.GetVMHost(context).VMHooks().CleanReturnData()
+}
+
+//export v1_4_deleteFromReturnData
+func v1_4_deleteFromReturnData(context unsafe.Pointer, resultID int32) {
+	arwen.GetVMHost(context).VMHooks().DeleteFromReturnData(resultID)
+}
+
+//export v1_4_getOriginalTxHash
+func v1_4_getOriginalTxHash(context unsafe.Pointer, dataOffset int32) {
+	arwen.GetVMHost(context).VMHooks().GetOriginalTxHash(dataOffset)
+}
+
+//export v1_4_bigIntGetUnsignedArgument
+func v1_4_bigIntGetUnsignedArgument(context unsafe.Pointer, id int32, destinationHandle int32) {
+	arwen.GetVMHost(context).VMHooks().BigIntGetUnsignedArgument(id, destinationHandle)
+}
+
+//export v1_4_bigIntGetSignedArgument
+func v1_4_bigIntGetSignedArgument(context unsafe.Pointer, id int32, destinationHandle int32) {
+	arwen.GetVMHost(context).VMHooks().BigIntGetSignedArgument(id, destinationHandle)
+}
+
+//export v1_4_bigIntStorageStoreUnsigned
+func v1_4_bigIntStorageStoreUnsigned(context unsafe.Pointer, keyOffset int32, keyLength int32, sourceHandle int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().BigIntStorageStoreUnsigned(keyOffset, keyLength, sourceHandle)
+}
+
+//export v1_4_bigIntStorageLoadUnsigned
+func v1_4_bigIntStorageLoadUnsigned(context unsafe.Pointer, keyOffset int32, keyLength int32, destinationHandle int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().BigIntStorageLoadUnsigned(keyOffset, keyLength, destinationHandle)
+}
+
+//export v1_4_bigIntGetCallValue
+func v1_4_bigIntGetCallValue(context unsafe.Pointer, destinationHandle int32) {
+	arwen.GetVMHost(context).VMHooks().BigIntGetCallValue(destinationHandle)
+}
+
+//export v1_4_bigIntGetESDTCallValue
+func v1_4_bigIntGetESDTCallValue(context unsafe.Pointer, destination int32) {
+	arwen.GetVMHost(context).VMHooks().BigIntGetESDTCallValue(destination)
+}
+
+//export v1_4_bigIntGetESDTCallValueByIndex
+func v1_4_bigIntGetESDTCallValueByIndex(context unsafe.Pointer, destinationHandle int32, index int32) {
+	arwen.GetVMHost(context).VMHooks().BigIntGetESDTCallValueByIndex(destinationHandle, index)
+}
+
+//export v1_4_bigIntGetExternalBalance
+func v1_4_bigIntGetExternalBalance(context unsafe.Pointer, addressOffset int32, result int32) {
+	arwen.GetVMHost(context).VMHooks().BigIntGetExternalBalance(addressOffset, result)
+}
+
+//export v1_4_bigIntGetESDTExternalBalance
+func v1_4_bigIntGetESDTExternalBalance(context unsafe.Pointer, addressOffset int32, tokenIDOffset int32, tokenIDLen int32, nonce int64, resultHandle int32) {
+	arwen.GetVMHost(context).VMHooks().BigIntGetESDTExternalBalance(addressOffset, tokenIDOffset, tokenIDLen, nonce, resultHandle)
+}
+
+//export v1_4_bigIntNew
+func v1_4_bigIntNew(context unsafe.Pointer, smallValue int64) int32 {
+	return arwen.GetVMHost(context).VMHooks().BigIntNew(smallValue)
+}
+
+//export v1_4_bigIntUnsignedByteLength
+func v1_4_bigIntUnsignedByteLength(context unsafe.Pointer, referenceHandle int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().BigIntUnsignedByteLength(referenceHandle)
+}
+
+//export v1_4_bigIntSignedByteLength
+func v1_4_bigIntSignedByteLength(context unsafe.Pointer, referenceHandle int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().BigIntSignedByteLength(referenceHandle)
+}
+
+//export v1_4_bigIntGetUnsignedBytes
+func v1_4_bigIntGetUnsignedBytes(context unsafe.Pointer, referenceHandle int32, byteOffset int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().BigIntGetUnsignedBytes(referenceHandle, byteOffset)
+}
+
+//export v1_4_bigIntGetSignedBytes
+func v1_4_bigIntGetSignedBytes(context unsafe.Pointer, referenceHandle int32, byteOffset int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().BigIntGetSignedBytes(referenceHandle, byteOffset)
+}
+
+//export v1_4_bigIntSetUnsignedBytes
+func v1_4_bigIntSetUnsignedBytes(context unsafe.Pointer, destinationHandle int32, byteOffset int32, byteLength int32) {
+	arwen.GetVMHost(context).VMHooks().BigIntSetUnsignedBytes(destinationHandle, byteOffset, byteLength)
+}
+
+//export v1_4_bigIntSetSignedBytes
+func v1_4_bigIntSetSignedBytes(context unsafe.Pointer, destinationHandle int32, byteOffset int32, byteLength int32) {
+	arwen.GetVMHost(context).VMHooks().BigIntSetSignedBytes(destinationHandle, byteOffset, byteLength)
+}
+
+//export v1_4_bigIntIsInt64
+func v1_4_bigIntIsInt64(context unsafe.Pointer, destinationHandle int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().BigIntIsInt64(destinationHandle)
+}
+
+//export v1_4_bigIntGetInt64
+func v1_4_bigIntGetInt64(context unsafe.Pointer, destinationHandle int32) int64 {
+	return arwen.GetVMHost(context).VMHooks().BigIntGetInt64(destinationHandle)
+}
+
+//export v1_4_bigIntSetInt64
+func v1_4_bigIntSetInt64(context unsafe.Pointer, destinationHandle int32, value int64) {
+	arwen.GetVMHost(context).VMHooks().BigIntSetInt64(destinationHandle, value)
+}
+
+//export v1_4_bigIntAdd
+func v1_4_bigIntAdd(context unsafe.Pointer, destinationHandle int32, op1Handle int32, op2Handle int32) {
+	arwen.GetVMHost(context).VMHooks().BigIntAdd(destinationHandle, op1Handle, op2Handle)
+}
+
+//export v1_4_bigIntSub
+func v1_4_bigIntSub(context unsafe.Pointer, destinationHandle int32, op1Handle int32, op2Handle int32) {
+	arwen.GetVMHost(context).VMHooks().BigIntSub(destinationHandle, op1Handle, op2Handle)
+}
+
+//export v1_4_bigIntMul
+func v1_4_bigIntMul(context unsafe.Pointer, destinationHandle int32, op1Handle int32, op2Handle int32) {
+	arwen.GetVMHost(context).VMHooks().BigIntMul(destinationHandle, op1Handle, op2Handle)
+}
+
+//export v1_4_bigIntTDiv
+func v1_4_bigIntTDiv(context unsafe.Pointer, destinationHandle int32, op1Handle int32, op2Handle int32) {
+	arwen.GetVMHost(context).VMHooks().BigIntTDiv(destinationHandle, op1Handle, op2Handle)
+}
+
+//export v1_4_bigIntTMod
+func v1_4_bigIntTMod(context unsafe.Pointer, destinationHandle int32, op1Handle int32, op2Handle int32) {
+	arwen.GetVMHost(context).VMHooks().BigIntTMod(destinationHandle, op1Handle, op2Handle)
+}
+
+//export v1_4_bigIntEDiv
+func v1_4_bigIntEDiv(context unsafe.Pointer, destinationHandle int32, op1Handle int32, op2Handle int32) {
+	arwen.GetVMHost(context).VMHooks().BigIntEDiv(destinationHandle, op1Handle, op2Handle)
+}
+
+//export v1_4_bigIntEMod
+func v1_4_bigIntEMod(context unsafe.Pointer, destinationHandle int32, op1Handle int32, op2Handle int32) {
+	arwen.GetVMHost(context).VMHooks().BigIntEMod(destinationHandle, op1Handle, op2Handle)
+}
+
+//export v1_4_bigIntSqrt
+func v1_4_bigIntSqrt(context unsafe.Pointer, destinationHandle int32, opHandle int32) {
+	arwen.GetVMHost(context).VMHooks().BigIntSqrt(destinationHandle, opHandle)
+}
+
+//export v1_4_bigIntPow
+func v1_4_bigIntPow(context unsafe.Pointer, destinationHandle int32, op1Handle int32, op2Handle int32) {
+	arwen.GetVMHost(context).VMHooks().BigIntPow(destinationHandle, op1Handle, op2Handle)
+}
+
+//export v1_4_bigIntLog2
+func v1_4_bigIntLog2(context unsafe.Pointer, op1Handle int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().BigIntLog2(op1Handle)
+}
+
+//export v1_4_bigIntAbs
+func v1_4_bigIntAbs(context unsafe.Pointer, destinationHandle int32, opHandle int32) {
+	arwen.GetVMHost(context).VMHooks().BigIntAbs(destinationHandle, opHandle)
+}
+
+//export v1_4_bigIntNeg
+func v1_4_bigIntNeg(context unsafe.Pointer, destinationHandle int32, opHandle int32) {
+	arwen.GetVMHost(context).VMHooks().BigIntNeg(destinationHandle, opHandle)
+}
+
+//export v1_4_bigIntSign
+func v1_4_bigIntSign(context unsafe.Pointer, opHandle int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().BigIntSign(opHandle)
+}
+
+//export v1_4_bigIntCmp
+func v1_4_bigIntCmp(context unsafe.Pointer, op1Handle int32, op2Handle int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().BigIntCmp(op1Handle, op2Handle)
+}
+
+//export v1_4_bigIntNot
+func v1_4_bigIntNot(context unsafe.Pointer, destinationHandle int32, opHandle int32) {
+	arwen.GetVMHost(context).VMHooks().BigIntNot(destinationHandle, opHandle)
+}
+
+//export v1_4_bigIntAnd
+func v1_4_bigIntAnd(context unsafe.Pointer, destinationHandle int32, op1Handle int32, op2Handle int32) {
+	arwen.GetVMHost(context).VMHooks().BigIntAnd(destinationHandle, op1Handle, op2Handle)
+}
+
+//export v1_4_bigIntOr
+func v1_4_bigIntOr(context unsafe.Pointer, destinationHandle int32, op1Handle int32, op2Handle int32) {
+	arwen.GetVMHost(context).VMHooks().BigIntOr(destinationHandle, op1Handle, op2Handle)
+}
+
+//export v1_4_bigIntXor
+func v1_4_bigIntXor(context unsafe.Pointer, destinationHandle int32, op1Handle int32, op2Handle int32) {
+	arwen.GetVMHost(context).VMHooks().BigIntXor(destinationHandle, op1Handle, op2Handle)
+}
+
+//export v1_4_bigIntShr
+func v1_4_bigIntShr(context unsafe.Pointer, destinationHandle int32, opHandle int32, bits int32) {
+	arwen.GetVMHost(context).VMHooks().BigIntShr(destinationHandle, opHandle, bits)
+}
+
+//export v1_4_bigIntShl
+func v1_4_bigIntShl(context unsafe.Pointer, destinationHandle int32, opHandle int32, bits int32) {
+	arwen.GetVMHost(context).VMHooks().BigIntShl(destinationHandle, opHandle, bits)
+}
+
+//export v1_4_bigIntFinishUnsigned
+func v1_4_bigIntFinishUnsigned(context unsafe.Pointer, referenceHandle int32) {
+	arwen.GetVMHost(context).VMHooks().BigIntFinishUnsigned(referenceHandle)
+}
+
+//export v1_4_bigIntFinishSigned
+func v1_4_bigIntFinishSigned(context unsafe.Pointer, referenceHandle int32) {
+	arwen.GetVMHost(context).VMHooks().BigIntFinishSigned(referenceHandle)
+}
+
+//export v1_4_smallIntGetUnsignedArgument
+func v1_4_smallIntGetUnsignedArgument(context unsafe.Pointer, id int32) int64 {
+	return arwen.GetVMHost(context).VMHooks().SmallIntGetUnsignedArgument(id)
+}
+
+//export v1_4_smallIntGetSignedArgument
+func v1_4_smallIntGetSignedArgument(context unsafe.Pointer, id int32) int64 {
+	return arwen.GetVMHost(context).VMHooks().SmallIntGetSignedArgument(id)
+}
+
+//export v1_4_smallIntFinishUnsigned
+func v1_4_smallIntFinishUnsigned(context unsafe.Pointer, value int64) {
+	arwen.GetVMHost(context).VMHooks().SmallIntFinishUnsigned(value)
+}
+
+//export v1_4_smallIntFinishSigned
+func v1_4_smallIntFinishSigned(context unsafe.Pointer, value int64) {
+	arwen.GetVMHost(context).VMHooks().SmallIntFinishSigned(value)
+}
+
+//export v1_4_smallIntStorageStoreUnsigned
+func v1_4_smallIntStorageStoreUnsigned(context unsafe.Pointer, keyOffset int32, keyLength int32, value int64) int32 {
+	return arwen.GetVMHost(context).VMHooks().SmallIntStorageStoreUnsigned(keyOffset, keyLength, value)
+}
+
+//export v1_4_smallIntStorageStoreSigned
+func v1_4_smallIntStorageStoreSigned(context unsafe.Pointer, keyOffset int32, keyLength int32, value int64) int32 {
+	return arwen.GetVMHost(context).VMHooks().SmallIntStorageStoreSigned(keyOffset, keyLength, value)
+}
+
+//export v1_4_smallIntStorageLoadUnsigned
+func v1_4_smallIntStorageLoadUnsigned(context unsafe.Pointer, keyOffset int32, keyLength int32) int64 {
+	return arwen.GetVMHost(context).VMHooks().SmallIntStorageLoadUnsigned(keyOffset, keyLength)
+}
+
+//export v1_4_smallIntStorageLoadSigned
+func v1_4_smallIntStorageLoadSigned(context unsafe.Pointer, keyOffset int32, keyLength int32) int64 {
+	return arwen.GetVMHost(context).VMHooks().SmallIntStorageLoadSigned(keyOffset, keyLength)
+}
+
+//export v1_4_int64getArgument
+func v1_4_int64getArgument(context unsafe.Pointer, id int32) int64 {
+	return arwen.GetVMHost(context).VMHooks().Int64getArgument(id)
+}
+
+//export v1_4_int64finish
+func v1_4_int64finish(context unsafe.Pointer, value int64) {
+	arwen.GetVMHost(context).VMHooks().Int64finish(value)
+}
+
+//export v1_4_int64storageStore
+func v1_4_int64storageStore(context unsafe.Pointer, keyOffset int32, keyLength int32, value int64) int32 {
+	return arwen.GetVMHost(context).VMHooks().Int64storageStore(keyOffset, keyLength, value)
+}
+
+//export v1_4_int64storageLoad
+func v1_4_int64storageLoad(context unsafe.Pointer, keyOffset int32, keyLength int32) int64 {
+	return arwen.GetVMHost(context).VMHooks().Int64storageLoad(keyOffset, keyLength)
+}
+
+//export v1_4_managedSCAddress
+func v1_4_managedSCAddress(context unsafe.Pointer, destinationHandle int32) {
+	arwen.GetVMHost(context).VMHooks().ManagedSCAddress(destinationHandle)
+}
+
+//export v1_4_managedOwnerAddress
+func v1_4_managedOwnerAddress(context unsafe.Pointer, destinationHandle int32) {
+	arwen.GetVMHost(context).VMHooks().ManagedOwnerAddress(destinationHandle)
+}
+
+//export v1_4_managedCaller
+func v1_4_managedCaller(context unsafe.Pointer, destinationHandle int32) {
+	arwen.GetVMHost(context).VMHooks().ManagedCaller(destinationHandle)
+}
+
+//export v1_4_managedSignalError
+func v1_4_managedSignalError(context unsafe.Pointer, errHandle int32) {
+	arwen.GetVMHost(context).VMHooks().ManagedSignalError(errHandle)
+}
+
+//export v1_4_managedWriteLog
+func v1_4_managedWriteLog(context unsafe.Pointer, topicsHandle int32, dataHandle int32) {
+	arwen.GetVMHost(context).VMHooks().ManagedWriteLog(topicsHandle, dataHandle)
+}
+
+//export v1_4_managedGetOriginalTxHash
+func v1_4_managedGetOriginalTxHash(context unsafe.Pointer, resultHandle int32) {
+	arwen.GetVMHost(context).VMHooks().ManagedGetOriginalTxHash(resultHandle)
+}
+
+//export v1_4_managedGetStateRootHash
+func v1_4_managedGetStateRootHash(context unsafe.Pointer, resultHandle int32) {
+	arwen.GetVMHost(context).VMHooks().ManagedGetStateRootHash(resultHandle)
+}
+
+//export v1_4_managedGetBlockRandomSeed
+func v1_4_managedGetBlockRandomSeed(context unsafe.Pointer, resultHandle int32) {
+	arwen.GetVMHost(context).VMHooks().ManagedGetBlockRandomSeed(resultHandle)
+}
+
+//export v1_4_managedGetPrevBlockRandomSeed
+func v1_4_managedGetPrevBlockRandomSeed(context unsafe.Pointer, resultHandle int32) {
+	arwen.GetVMHost(context).VMHooks().ManagedGetPrevBlockRandomSeed(resultHandle)
+}
+
+//export v1_4_managedGetReturnData
+func v1_4_managedGetReturnData(context unsafe.Pointer, resultID int32, resultHandle int32) {
+	arwen.GetVMHost(context).VMHooks().ManagedGetReturnData(resultID, resultHandle)
+}
+
+//export v1_4_managedGetMultiESDTCallValue
+func v1_4_managedGetMultiESDTCallValue(context unsafe.Pointer, multiCallValueHandle int32) {
+	arwen.GetVMHost(context).VMHooks().ManagedGetMultiESDTCallValue(multiCallValueHandle)
+}
+
+//export v1_4_managedGetESDTBalance
+func v1_4_managedGetESDTBalance(context unsafe.Pointer, addressHandle int32, tokenIDHandle int32, nonce int64, valueHandle int32) {
+	arwen.GetVMHost(context).VMHooks().ManagedGetESDTBalance(addressHandle, tokenIDHandle, nonce, valueHandle)
+}
+
+//export v1_4_managedGetESDTTokenData
+func v1_4_managedGetESDTTokenData(context unsafe.Pointer, addressHandle int32, tokenIDHandle int32, nonce int64, valueHandle int32, propertiesHandle int32, hashHandle int32, nameHandle int32, attributesHandle int32, creatorHandle int32, royaltiesHandle int32, urisHandle int32) {
+	arwen.GetVMHost(context).VMHooks().ManagedGetESDTTokenData(addressHandle, tokenIDHandle, nonce, valueHandle, propertiesHandle, hashHandle, nameHandle, attributesHandle, creatorHandle, royaltiesHandle, urisHandle)
+}
+
+//export v1_4_managedAsyncCall
+func v1_4_managedAsyncCall(context unsafe.Pointer, destHandle int32, valueHandle int32, functionHandle int32, argumentsHandle int32) {
+	arwen.GetVMHost(context).VMHooks().ManagedAsyncCall(destHandle, valueHandle, functionHandle, argumentsHandle)
+}
+
+//export v1_4_managedCreateAsyncCall
+func v1_4_managedCreateAsyncCall(context unsafe.Pointer, asyncContextIdentifierHandle int32, destHandle int32, valueHandle int32, functionHandle int32, argumentsHandle int32, successHandle int32, errorHandle int32, gas int64, extraGasForCallback int64, callbackClosureHandle int32) {
+	arwen.GetVMHost(context).VMHooks().ManagedCreateAsyncCall(asyncContextIdentifierHandle, destHandle, valueHandle, functionHandle, argumentsHandle, successHandle, errorHandle, gas, extraGasForCallback, callbackClosureHandle)
+}
+
+//export v1_4_managedGetCallbackClosure
+func v1_4_managedGetCallbackClosure(context unsafe.Pointer, callbackClosureHandle int32) {
+	arwen.GetVMHost(context).VMHooks().ManagedGetCallbackClosure(callbackClosureHandle)
+}
+
+//export v1_4_managedUpgradeFromSourceContract
+func v1_4_managedUpgradeFromSourceContract(context unsafe.Pointer, destHandle int32, gas int64, valueHandle int32, addressHandle int32, codeMetadataHandle int32, argumentsHandle int32, resultHandle int32) {
+	arwen.GetVMHost(context).VMHooks().ManagedUpgradeFromSourceContract(destHandle, gas, valueHandle, addressHandle, codeMetadataHandle, argumentsHandle, resultHandle)
+}
+
+//export v1_4_managedUpgradeContract
+func v1_4_managedUpgradeContract(context unsafe.Pointer, destHandle int32, gas int64, valueHandle int32, codeHandle int32, codeMetadataHandle int32, argumentsHandle int32, resultHandle int32) {
+	arwen.GetVMHost(context).VMHooks().ManagedUpgradeContract(destHandle, gas, valueHandle, codeHandle, codeMetadataHandle, argumentsHandle, resultHandle)
+}
+
+//export v1_4_managedDeployFromSourceContract
+func v1_4_managedDeployFromSourceContract(context unsafe.Pointer, gas int64, valueHandle int32, addressHandle int32, codeMetadataHandle int32, argumentsHandle int32, resultAddressHandle int32, resultHandle int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().ManagedDeployFromSourceContract(gas, valueHandle, addressHandle, codeMetadataHandle, argumentsHandle, resultAddressHandle, resultHandle)
+}
+
+//export v1_4_managedCreateContract
+func v1_4_managedCreateContract(context unsafe.Pointer, gas int64, valueHandle int32, codeHandle int32, codeMetadataHandle int32, argumentsHandle int32, resultAddressHandle int32, resultHandle int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().ManagedCreateContract(gas, valueHandle, codeHandle, codeMetadataHandle, argumentsHandle, resultAddressHandle, resultHandle)
+}
+
+//export v1_4_managedExecuteReadOnly
+func v1_4_managedExecuteReadOnly(context unsafe.Pointer, gas int64, addressHandle int32, functionHandle int32, argumentsHandle int32, resultHandle int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().ManagedExecuteReadOnly(gas, addressHandle, functionHandle, argumentsHandle, resultHandle)
+}
+
+//export v1_4_managedExecuteOnSameContext
+func v1_4_managedExecuteOnSameContext(context unsafe.Pointer, gas int64, addressHandle int32, valueHandle int32, functionHandle int32, argumentsHandle int32, resultHandle int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().ManagedExecuteOnSameContext(gas, addressHandle, valueHandle, functionHandle, argumentsHandle, resultHandle)
+}
+
+//export v1_4_managedExecuteOnDestContextByCaller
+func v1_4_managedExecuteOnDestContextByCaller(context unsafe.Pointer, gas int64, addressHandle int32, valueHandle int32, functionHandle int32, argumentsHandle int32, resultHandle int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().ManagedExecuteOnDestContextByCaller(gas, addressHandle, valueHandle, functionHandle, argumentsHandle, resultHandle)
+}
+
+//export v1_4_managedExecuteOnDestContext
+func v1_4_managedExecuteOnDestContext(context unsafe.Pointer, gas int64, addressHandle int32, valueHandle int32, functionHandle int32, argumentsHandle int32, resultHandle int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().ManagedExecuteOnDestContext(gas, addressHandle, valueHandle, functionHandle, argumentsHandle, resultHandle)
+}
+
+//export v1_4_managedMultiTransferESDTNFTExecute
+func v1_4_managedMultiTransferESDTNFTExecute(context unsafe.Pointer, dstHandle int32, tokenTransfersHandle int32, gasLimit int64, functionHandle int32, argumentsHandle int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().ManagedMultiTransferESDTNFTExecute(dstHandle, tokenTransfersHandle, gasLimit, functionHandle, argumentsHandle)
+}
+
+//export v1_4_managedTransferValueExecute
+func v1_4_managedTransferValueExecute(context unsafe.Pointer, dstHandle int32, valueHandle int32, gasLimit int64, functionHandle int32, argumentsHandle int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().ManagedTransferValueExecute(dstHandle, valueHandle, gasLimit, functionHandle, argumentsHandle)
+}
+
+//export v1_4_mBufferNew
+func v1_4_mBufferNew(context unsafe.Pointer) int32 {
+	return arwen.GetVMHost(context).VMHooks().MBufferNew()
+}
+
+//export v1_4_mBufferNewFromBytes
+func v1_4_mBufferNewFromBytes(context unsafe.Pointer, dataOffset int32, dataLength int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().MBufferNewFromBytes(dataOffset, dataLength)
+}
+
+//export v1_4_mBufferGetLength
+func v1_4_mBufferGetLength(context unsafe.Pointer, mBufferHandle int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().MBufferGetLength(mBufferHandle)
+}
+
+//export v1_4_mBufferGetBytes
+func v1_4_mBufferGetBytes(context unsafe.Pointer, mBufferHandle int32, resultOffset int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().MBufferGetBytes(mBufferHandle, resultOffset)
+}
+
+//export v1_4_mBufferGetByteSlice
+func v1_4_mBufferGetByteSlice(context unsafe.Pointer, sourceHandle int32, startingPosition int32, sliceLength int32, resultOffset int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().MBufferGetByteSlice(sourceHandle, startingPosition, sliceLength, resultOffset)
+}
+
+//export v1_4_mBufferCopyByteSlice
+func v1_4_mBufferCopyByteSlice(context unsafe.Pointer, sourceHandle int32, startingPosition int32, sliceLength int32, destinationHandle int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().MBufferCopyByteSlice(sourceHandle, startingPosition, sliceLength, destinationHandle)
+}
+
+//export v1_4_mBufferEq
+func v1_4_mBufferEq(context unsafe.Pointer, mBufferHandle1 int32, mBufferHandle2 int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().MBufferEq(mBufferHandle1, mBufferHandle2)
+}
+
+//export v1_4_mBufferSetBytes
+func v1_4_mBufferSetBytes(context unsafe.Pointer, mBufferHandle int32, dataOffset int32, dataLength int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().MBufferSetBytes(mBufferHandle, dataOffset, dataLength)
+}
+
+//export v1_4_mBufferSetByteSlice
+func v1_4_mBufferSetByteSlice(context unsafe.Pointer, mBufferHandle int32, startingPosition int32, dataLength int32, dataOffset int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().MBufferSetByteSlice(mBufferHandle, startingPosition, dataLength, dataOffset)
+}
+
+//export v1_4_mBufferAppend
+func v1_4_mBufferAppend(context unsafe.Pointer, accumulatorHandle int32, dataHandle int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().MBufferAppend(accumulatorHandle, dataHandle)
+}
+
+//export v1_4_mBufferAppendBytes
+func v1_4_mBufferAppendBytes(context unsafe.Pointer, accumulatorHandle int32, dataOffset int32, dataLength int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().MBufferAppendBytes(accumulatorHandle, dataOffset, dataLength)
+}
+
+//export v1_4_mBufferToBigIntUnsigned
+func v1_4_mBufferToBigIntUnsigned(context unsafe.Pointer, mBufferHandle int32, bigIntHandle int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().MBufferToBigIntUnsigned(mBufferHandle, bigIntHandle)
+}
+
+//export v1_4_mBufferToBigIntSigned
+func v1_4_mBufferToBigIntSigned(context unsafe.Pointer, mBufferHandle int32, bigIntHandle int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().MBufferToBigIntSigned(mBufferHandle, bigIntHandle)
+}
+
+//export v1_4_mBufferFromBigIntUnsigned
+func v1_4_mBufferFromBigIntUnsigned(context unsafe.Pointer, mBufferHandle int32, bigIntHandle int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().MBufferFromBigIntUnsigned(mBufferHandle, bigIntHandle)
+}
+
+//export v1_4_mBufferFromBigIntSigned
+func v1_4_mBufferFromBigIntSigned(context unsafe.Pointer, mBufferHandle int32, bigIntHandle int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().MBufferFromBigIntSigned(mBufferHandle, bigIntHandle)
+}
+
+//export v1_4_mBufferStorageStore
+func v1_4_mBufferStorageStore(context unsafe.Pointer, keyHandle int32, sourceHandle int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().MBufferStorageStore(keyHandle, sourceHandle)
+}
+
+//export v1_4_mBufferStorageLoad
+func v1_4_mBufferStorageLoad(context unsafe.Pointer, keyHandle int32, destinationHandle int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().MBufferStorageLoad(keyHandle, destinationHandle)
+}
+
+//export v1_4_mBufferStorageLoadFromAddress
+func v1_4_mBufferStorageLoadFromAddress(context unsafe.Pointer, addressHandle int32, keyHandle int32, destinationHandle int32) {
+	arwen.GetVMHost(context).VMHooks().MBufferStorageLoadFromAddress(addressHandle, keyHandle, destinationHandle)
+}
+
+//export v1_4_mBufferGetArgument
+func v1_4_mBufferGetArgument(context unsafe.Pointer, id int32, destinationHandle int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().MBufferGetArgument(id, destinationHandle)
+}
+
+//export v1_4_mBufferFinish
+func v1_4_mBufferFinish(context unsafe.Pointer, sourceHandle int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().MBufferFinish(sourceHandle)
+}
+
+//export v1_4_mBufferSetRandom
+func v1_4_mBufferSetRandom(context unsafe.Pointer, destinationHandle int32, length int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().MBufferSetRandom(destinationHandle, length)
+}
+
+//export v1_4_sha256
+func v1_4_sha256(context unsafe.Pointer, dataOffset int32, length int32, resultOffset int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().Sha256(dataOffset, length, resultOffset)
+}
+
+//export v1_4_managedSha256
+func v1_4_managedSha256(context unsafe.Pointer, inputHandle int32, outputHandle int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().ManagedSha256(inputHandle, outputHandle)
+}
+
+//export v1_4_keccak256
+func v1_4_keccak256(context unsafe.Pointer, dataOffset int32, length int32, resultOffset int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().Keccak256(dataOffset, length, resultOffset)
+}
+
+//export v1_4_managedKeccak256
+func v1_4_managedKeccak256(context unsafe.Pointer, inputHandle int32, outputHandle int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().ManagedKeccak256(inputHandle, outputHandle)
+}
+
+//export v1_4_ripemd160
+func v1_4_ripemd160(context unsafe.Pointer, dataOffset int32, length int32, resultOffset int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().Ripemd160(dataOffset, length, resultOffset)
+}
+
+//export v1_4_sha512
+func v1_4_sha512(context unsafe.Pointer, dataOffset int32, length int32, resultOffset int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().Sha512(dataOffset, length, resultOffset)
+}
+
+//export v1_4_managedSha512
+func v1_4_managedSha512(context unsafe.Pointer, inputHandle int32, outputHandle int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().ManagedSha512(inputHandle, outputHandle)
+}
+
+//export v1_4_sha3256
+func v1_4_sha3256(context unsafe.Pointer, dataOffset int32, length int32, resultOffset int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().Sha3256(dataOffset, length, resultOffset)
+}
+
+//export v1_4_managedSha3256
+func v1_4_managedSha3256(context unsafe.Pointer, inputHandle int32, outputHandle int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().ManagedSha3256(inputHandle, outputHandle)
+}
+
+//export v1_4_blake2b256
+func v1_4_blake2b256(context unsafe.Pointer, dataOffset int32, length int32, resultOffset int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().Blake2b256(dataOffset, length, resultOffset)
+}
+
+//export v1_4_managedBlake2b256
+func v1_4_managedBlake2b256(context unsafe.Pointer, inputHandle int32, outputHandle int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().ManagedBlake2b256(inputHandle, outputHandle)
+}
+
+//export v1_4_blake2b512
+func v1_4_blake2b512(context unsafe.Pointer, dataOffset int32, length int32, resultOffset int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().Blake2b512(dataOffset, length, resultOffset)
+}
+
+//export v1_4_managedBlake2b512
+func v1_4_managedBlake2b512(context unsafe.Pointer, inputHandle int32, outputHandle int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().ManagedBlake2b512(inputHandle, outputHandle)
+}
+
+//export v1_4_poseidon
+func v1_4_poseidon(context unsafe.Pointer, dataOffset int32, length int32, resultOffset int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().Poseidon(dataOffset, length, resultOffset)
+}
+
+//export v1_4_managedPoseidon
+func v1_4_managedPoseidon(context unsafe.Pointer, inputHandle int32, outputHandle int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().ManagedPoseidon(inputHandle, outputHandle)
+}
+
+//export v1_4_verifyBLS
+func v1_4_verifyBLS(context unsafe.Pointer, keyOffset int32, messageOffset int32, messageLength int32, sigOffset int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().VerifyBLS(keyOffset, messageOffset, messageLength, sigOffset)
+}
+
+//export v1_4_verifyEd25519
+func v1_4_verifyEd25519(context unsafe.Pointer, keyOffset int32, messageOffset int32, messageLength int32, sigOffset int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().VerifyEd25519(keyOffset, messageOffset, messageLength, sigOffset)
+}
+
+//export v1_4_verifyCustomSecp256k1
+func v1_4_verifyCustomSecp256k1(context unsafe.Pointer, keyOffset int32, keyLength int32, messageOffset int32, messageLength int32, sigOffset int32, hashType int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().VerifyCustomSecp256k1(keyOffset, keyLength, messageOffset, messageLength, sigOffset, hashType)
+}
+
+//export v1_4_verifySecp256k1
+func v1_4_verifySecp256k1(context unsafe.Pointer, keyOffset int32, keyLength int32, messageOffset int32, messageLength int32, sigOffset int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().VerifySecp256k1(keyOffset, keyLength, messageOffset, messageLength, sigOffset)
+}
+
+//export v1_4_verifySecp256r1
+func v1_4_verifySecp256r1(context unsafe.Pointer, keyOffset int32, keyLength int32, messageOffset int32, messageLength int32, sigOffset int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().VerifySecp256r1(keyOffset, keyLength, messageOffset, messageLength, sigOffset)
+}
+
+//export v1_4_managedVerifySecp256r1
+func v1_4_managedVerifySecp256r1(context unsafe.Pointer, keyHandle int32, messageHandle int32, sigHandle int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().ManagedVerifySecp256r1(keyHandle, messageHandle, sigHandle)
+}
+
+//export v1_4_verifySchnorr
+func v1_4_verifySchnorr(context unsafe.Pointer, keyOffset int32, messageOffset int32, messageLength int32, sigOffset int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().VerifySchnorr(keyOffset, messageOffset, messageLength, sigOffset)
+}
+
+//export v1_4_managedVerifySchnorr
+func v1_4_managedVerifySchnorr(context unsafe.Pointer, keyHandle int32, messageHandle int32, sigHandle int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().ManagedVerifySchnorr(keyHandle, messageHandle, sigHandle)
+}
+
+//export v1_4_managedAddG1
+func v1_4_managedAddG1(context unsafe.Pointer, curveID int32, resultHandle int32, point1Handle int32, point2Handle int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().ManagedAddG1(curveID, resultHandle, point1Handle, point2Handle)
+}
+
+//export v1_4_managedScalarMultG1
+func v1_4_managedScalarMultG1(context unsafe.Pointer, curveID int32, resultHandle int32, pointHandle int32, scalarHandle int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().ManagedScalarMultG1(curveID, resultHandle, pointHandle, scalarHandle)
+}
+
+//export v1_4_managedAddG2
+func v1_4_managedAddG2(context unsafe.Pointer, curveID int32, resultHandle int32, point1Handle int32, point2Handle int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().ManagedAddG2(curveID, resultHandle, point1Handle, point2Handle)
+}
+
+//export v1_4_managedScalarMultG2
+func v1_4_managedScalarMultG2(context unsafe.Pointer, curveID int32, resultHandle int32, pointHandle int32, scalarHandle int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().ManagedScalarMultG2(curveID, resultHandle, pointHandle, scalarHandle)
+}
+
+//export v1_4_managedPairingCheck
+func v1_4_managedPairingCheck(context unsafe.Pointer, curveID int32, g1PointsHandle int32, g2PointsHandle int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().ManagedPairingCheck(curveID, g1PointsHandle, g2PointsHandle)
+}
+
+//export v1_4_managedVerifyGroth16
+func v1_4_managedVerifyGroth16(context unsafe.Pointer, curveID int32, verifyingKeyHandle int32, proofHandle int32, publicInputsHandle int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().ManagedVerifyGroth16(curveID, verifyingKeyHandle, proofHandle, publicInputsHandle)
+}
+
+//export v1_4_encodeSecp256k1DerSignature
+func v1_4_encodeSecp256k1DerSignature(context unsafe.Pointer, rOffset int32, rLength int32, sOffset int32, sLength int32, sigOffset int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().EncodeSecp256k1DerSignature(rOffset, rLength, sOffset, sLength, sigOffset)
+}
+
+//export v1_4_addEC
+func v1_4_addEC(context unsafe.Pointer, xResultHandle int32, yResultHandle int32, ecHandle int32, fstPointXHandle int32, fstPointYHandle int32, sndPointXHandle int32, sndPointYHandle int32) {
+	arwen.GetVMHost(context).VMHooks().AddEC(xResultHandle, yResultHandle, ecHandle, fstPointXHandle, fstPointYHandle, sndPointXHandle, sndPointYHandle)
+}
+
+//export v1_4_doubleEC
+func v1_4_doubleEC(context unsafe.Pointer, xResultHandle int32, yResultHandle int32, ecHandle int32, pointXHandle int32, pointYHandle int32) {
+	arwen.GetVMHost(context).VMHooks().DoubleEC(xResultHandle, yResultHandle, ecHandle, pointXHandle, pointYHandle)
+}
+
+//export v1_4_isOnCurveEC
+func v1_4_isOnCurveEC(context unsafe.Pointer, ecHandle int32, pointXHandle int32, pointYHandle int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().IsOnCurveEC(ecHandle, pointXHandle, pointYHandle)
+}
+
+//export v1_4_scalarBaseMultEC
+func v1_4_scalarBaseMultEC(context unsafe.Pointer, xResultHandle int32, yResultHandle int32, ecHandle int32, dataOffset int32, length int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().ScalarBaseMultEC(xResultHandle, yResultHandle, ecHandle, dataOffset, length)
+}
+
+//export v1_4_scalarMultEC
+func v1_4_scalarMultEC(context unsafe.Pointer, xResultHandle int32, yResultHandle int32, ecHandle int32, pointXHandle int32, pointYHandle int32, dataOffset int32, length int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().ScalarMultEC(xResultHandle, yResultHandle, ecHandle, pointXHandle, pointYHandle, dataOffset, length)
+}
+
+//export v1_4_marshalEC
+func v1_4_marshalEC(context unsafe.Pointer, xPairHandle int32, yPairHandle int32, ecHandle int32, resultOffset int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().MarshalEC(xPairHandle, yPairHandle, ecHandle, resultOffset)
+}
+
+//export v1_4_marshalCompressedEC
+func v1_4_marshalCompressedEC(context unsafe.Pointer, xPairHandle int32, yPairHandle int32, ecHandle int32, resultOffset int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().MarshalCompressedEC(xPairHandle, yPairHandle, ecHandle, resultOffset)
+}
+
+//export v1_4_unmarshalEC
+func v1_4_unmarshalEC(context unsafe.Pointer, xResultHandle int32, yResultHandle int32, ecHandle int32, dataOffset int32, length int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().UnmarshalEC(xResultHandle, yResultHandle, ecHandle, dataOffset, length)
+}
+
+//export v1_4_unmarshalCompressedEC
+func v1_4_unmarshalCompressedEC(context unsafe.Pointer, xResultHandle int32, yResultHandle int32, ecHandle int32, dataOffset int32, length int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().UnmarshalCompressedEC(xResultHandle, yResultHandle, ecHandle, dataOffset, length)
+}
+
+//export v1_4_generateKeyEC
+func v1_4_generateKeyEC(context unsafe.Pointer, xPubKeyHandle int32, yPubKeyHandle int32, ecHandle int32, resultOffset int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().GenerateKeyEC(xPubKeyHandle, yPubKeyHandle, ecHandle, resultOffset)
+}
+
+//export v1_4_createEC
+func v1_4_createEC(context unsafe.Pointer, dataOffset int32, dataLength int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().CreateEC(dataOffset, dataLength)
+}
+
+//export v1_4_getCurveLengthEC
+func v1_4_getCurveLengthEC(context unsafe.Pointer, ecHandle int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().GetCurveLengthEC(ecHandle)
+}
+
+//export v1_4_getPrivKeyByteLengthEC
+func v1_4_getPrivKeyByteLengthEC(context unsafe.Pointer, ecHandle int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().GetPrivKeyByteLengthEC(ecHandle)
+}
+
+//export v1_4_ellipticCurveGetValues
+func v1_4_ellipticCurveGetValues(context unsafe.Pointer, ecHandle int32, fieldOrderHandle int32, basePointOrderHandle int32, eqConstantHandle int32, xBasePointHandle int32, yBasePointHandle int32) int32 {
+	return arwen.GetVMHost(context).VMHooks().EllipticCurveGetValues(ecHandle, fieldOrderHandle, basePointOrderHandle, eqConstantHandle, xBasePointHandle, yBasePointHandle)
+}
@@ -0,0 +1,414 @@
+package wasmanalysis
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/math"
+)
+
+// EEIModuleName is the import module name under which the VM provides the EEI functions
+const EEIModuleName = "env"
+
+// WasmPageSize is the size of a WebAssembly memory page, in bytes
+const WasmPageSize = 65536
+
+// maxLengthOfFunctionName mirrors the limit enforced by the VM on the exported function names
+const maxLengthOfFunctionName = 256
+
+// Severity tells whether an issue prevents the deployment or only deserves attention
+type Severity int
+
+const (
+	// SeverityError marks an issue that makes the VM reject the contract
+	SeverityError Severity = iota
+	// SeverityWarning marks a suspicious construct that the VM accepts
+	SeverityWarning
+)
+
+// String returns the name of the severity
+func (severity Severity) String() string {
+	if severity == SeverityError {
+		return "error"
+	}
+	return "warning"
+}
+
+// MarshalText encodes the severity by name
+func (severity Severity) MarshalText() ([]byte, error) {
+	return []byte(severity.String()), nil
+}
+
+const (
+	// RuleUnknownImport reports imports that the VM does not provide
+	RuleUnknownImport = "unknown-import"
+	// RuleImportSignature reports EEI imports declared with a signature different from the VM one
+	RuleImportSignature = "import-signature"
+	// RuleFloatOpcode reports functions using floating point instructions
+	RuleFloatOpcode = "float-opcode"
+	// RuleFloatType reports floating point types in signatures, locals and globals
+	RuleFloatType = "float-type"
+	// RuleUnsupportedOpcode reports SIMD and atomic instructions
+	RuleUnsupportedOpcode = "unsupported-opcode"
+	// RuleReservedExport reports exported functions named like EEI or built-in functions
+	RuleReservedExport = "reserved-export"
+	// RuleInvalidExportName reports exported functions with empty, too long or non ASCII names
+	RuleInvalidExportName = "invalid-export-name"
+	// RuleNonVoidExport reports exported functions that take arguments or return results
+	RuleNonVoidExport = "non-void-export"
+	// RuleMissingMemory reports modules that do not export a memory
+	RuleMissingMemory = "missing-memory"
+	// RuleAPILevel reports contracts declaring an API level that the VM does not support
+	RuleAPILevel = "api-level"
+	// RuleUnavailableImport reports EEI imports not available at the API level of the contract
+	RuleUnavailableImport = "unavailable-import"
+)
+
+// APIVersion holds the API level in which an EEI function was introduced and, if not zero, deprecated
+type APIVersion struct {
+	Introduced uint32
+	Deprecated uint32
+}
+
+func (version APIVersion) isAvailableAt(level uint32) bool {
+	if level < version.Introduced {
+		return false
+	}
+	return version.Deprecated == 0 || level < version.Deprecated
+}
+
+// Issue is a single finding of the analysis
+type Issue struct {
+	Severity Severity `json:"severity"`
+	Rule     string   `json:"rule"`
+	Message  string   `json:"message"`
+}
+
+// DeployGasCosts holds the gas schedule entries used to estimate the cost of deploying and calling a contract
+type DeployGasCosts struct {
+	CreateContract    uint64
+	CompilePerByte    uint64
+	GetCode           uint64
+	AoTPreparePerByte uint64
+}
+
+// Rules holds the constraints that the VM enforces when a contract is deployed
+type Rules struct {
+	// EEIFunctions holds the signatures of the functions provided by the VM, the only allowed imports
+	EEIFunctions map[string]FunctionType
+	// ReservedNames holds the names that contracts cannot export, such as the EEI and built-in functions
+	ReservedNames map[string]struct{}
+	// EEIVersions holds the API levels of the EEI functions which are not available at every level
+	EEIVersions map[string]APIVersion
+	// MaxAPILevel is the highest API level enabled on the VM, also assigned to the contracts that declare none.
+	// Zero disables the API level checks.
+	MaxAPILevel uint32
+	// Gas holds the costs used for the gas estimations
+	Gas DeployGasCosts
+}
+
+// Report holds the metrics and the issues found by the analysis of a contract
+type Report struct {
+	CodeSize          int      `json:"codeSize"`
+	APILevel          uint32   `json:"apiLevel,omitempty"`
+	APILevelDeclared  bool     `json:"apiLevelDeclared"`
+	ImportedFunctions int      `json:"importedFunctions"`
+	DefinedFunctions  int      `json:"definedFunctions"`
+	ExportedFunctions []string `json:"exportedFunctions"`
+	MemoryPages       uint32   `json:"memoryPages"`
+	MemoryMaxPages    uint32   `json:"memoryMaxPages,omitempty"`
+	DataSegments      int      `json:"dataSegments"`
+	DataSize          int      `json:"dataSize"`
+	DeployGas         uint64   `json:"deployGas"`
+	CallOverheadGas   uint64   `json:"callOverheadGas"`
+	Issues            []Issue  `json:"issues"`
+}
+
+// HasErrors returns true if any of the issues would make the VM reject the contract
+func (report *Report) HasErrors() bool {
+	for _, issue := range report.Issues {
+		if issue.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+func (report *Report) addIssue(severity Severity, rule string, format string, args ...interface{}) {
+	report.Issues = append(report.Issues, Issue{
+		Severity: severity,
+		Rule:     rule,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// Analyze parses the contract code and checks it against the rules of the VM, without compiling it.
+// The deployment gas estimate covers the cost charged before the init function starts executing.
+func Analyze(code []byte, rules Rules) (*Report, error) {
+	module, err := ParseModule(code)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{
+		CodeSize:          len(code),
+		ImportedFunctions: module.NumImportedFunctions(),
+		DefinedFunctions:  len(module.Functions),
+		ExportedFunctions: make([]string, 0),
+		DataSegments:      len(module.DataSegmentSizes),
+		DeployGas:         math.AddUint64(rules.Gas.CreateContract, math.MulUint64(rules.Gas.CompilePerByte, uint64(len(code)))),
+		CallOverheadGas:   math.AddUint64(rules.Gas.GetCode, math.MulUint64(rules.Gas.AoTPreparePerByte, uint64(len(code)))),
+		Issues:            make([]Issue, 0),
+	}
+	for _, size := range module.DataSegmentSizes {
+		report.DataSize += size
+	}
+	if len(module.Memories) > 0 {
+		report.MemoryPages = module.Memories[0].Initial
+		if module.Memories[0].HasMaximum {
+			report.MemoryMaxPages = module.Memories[0].Maximum
+		}
+	}
+
+	checkAPILevel(module, rules, report)
+	checkImports(module, rules, report)
+	checkExports(module, rules, report)
+	err = checkFunctionBodies(module, report)
+	if err != nil {
+		return nil, err
+	}
+	checkFloatTypes(module, report)
+
+	return report, nil
+}
+
+func checkAPILevel(module *Module, rules Rules, report *Report) {
+	report.APILevelDeclared = module.APILevel != 0
+	report.APILevel = module.APILevel
+	if !report.APILevelDeclared {
+		report.APILevel = rules.MaxAPILevel
+	}
+
+	if rules.MaxAPILevel != 0 && report.APILevel > rules.MaxAPILevel {
+		report.addIssue(SeverityError, RuleAPILevel,
+			"the contract declares the API level %d, but the VM supports up to %d", report.APILevel, rules.MaxAPILevel)
+	}
+}
+
+func checkImports(module *Module, rules Rules, report *Report) {
+	for _, imp := range module.Imports {
+		qualifiedName := imp.Module + "." + imp.Name
+		if imp.Kind != ExternalFunction {
+			report.addIssue(SeverityError, RuleUnknownImport,
+				"import %s is a %s, but the VM only provides functions", qualifiedName, imp.Kind)
+			continue
+		}
+
+		eeiType, ok := rules.EEIFunctions[imp.Name]
+		if imp.Module != EEIModuleName || !ok {
+			report.addIssue(SeverityError, RuleUnknownImport, "import %s is not provided by the VM", qualifiedName)
+			continue
+		}
+
+		version, isVersioned := rules.EEIVersions[imp.Name]
+		if rules.MaxAPILevel != 0 && isVersioned && !version.isAvailableAt(report.APILevel) {
+			report.addIssue(SeverityError, RuleUnavailableImport,
+				"import %s is not available at API level %d", qualifiedName, report.APILevel)
+		}
+
+		if int(imp.TypeIndex) >= len(module.Types) {
+			report.addIssue(SeverityError, RuleImportSignature, "import %s has an invalid type index", qualifiedName)
+			continue
+		}
+		declaredType := module.Types[imp.TypeIndex]
+		if !equalFunctionTypes(declaredType, eeiType) {
+			report.addIssue(SeverityError, RuleImportSignature,
+				"import %s is declared as %s, but the VM provides %s",
+				qualifiedName, formatFunctionType(declaredType), formatFunctionType(eeiType))
+		}
+	}
+}
+
+func checkExports(module *Module, rules Rules, report *Report) {
+	hasMemory := false
+	for _, export := range module.Exports {
+		if export.Kind == ExternalMemory {
+			hasMemory = true
+		}
+		if export.Kind != ExternalFunction {
+			continue
+		}
+
+		report.ExportedFunctions = append(report.ExportedFunctions, export.Name)
+		if !isValidFunctionName(export.Name) {
+			report.addIssue(SeverityError, RuleInvalidExportName, "exported function name %q is invalid", export.Name)
+		}
+		if _, ok := rules.ReservedNames[export.Name]; ok {
+			report.addIssue(SeverityError, RuleReservedExport, "exported function name %q is reserved", export.Name)
+		}
+
+		functionType, err := module.FunctionTypeAt(export.Index)
+		if err != nil {
+			report.addIssue(SeverityError, RuleNonVoidExport, "exported function %q has no valid signature", export.Name)
+			continue
+		}
+		if len(functionType.Params) > 0 || len(functionType.Results) > 0 {
+			report.addIssue(SeverityError, RuleNonVoidExport,
+				"exported function %q has the signature %s, but only functions without arguments and results can be exported",
+				export.Name, formatFunctionType(functionType))
+		}
+	}
+
+	if !hasMemory {
+		report.addIssue(SeverityError, RuleMissingMemory, "the module does not export a memory")
+	}
+}
+
+func checkFunctionBodies(module *Module, report *Report) error {
+	numImported := module.NumImportedFunctions()
+	exportNames := functionExportNames(module)
+
+	for i, body := range module.Bodies {
+		functionIndex := uint32(numImported + i)
+		floatUses := make(map[string]int)
+		firstFloatOffset := -1
+		var unsupported *instruction
+
+		err := scanInstructions(body.Code, body.Offset, func(ins instruction) {
+			if ins.isFloat() {
+				floatUses[ins.name()]++
+				if firstFloatOffset < 0 {
+					firstFloatOffset = ins.offset
+				}
+			}
+			if ins.isUnsupportedProposal() {
+				insCopy := ins
+				unsupported = &insCopy
+			}
+		})
+		if err != nil {
+			return fmt.Errorf("%w: function %d: %s", ErrInvalidSection, functionIndex, err.Error())
+		}
+
+		functionName := describeFunction(functionIndex, exportNames)
+		if len(floatUses) > 0 {
+			report.addIssue(SeverityError, RuleFloatOpcode,
+				"%s uses floating point instructions at offset 0x%x: %s",
+				functionName, firstFloatOffset, formatOpcodeCounts(floatUses))
+		}
+		if unsupported != nil {
+			report.addIssue(SeverityError, RuleUnsupportedOpcode,
+				"%s uses the unsupported instruction %s at offset 0x%x", functionName, unsupported.name(), unsupported.offset)
+		}
+	}
+
+	return nil
+}
+
+func checkFloatTypes(module *Module, report *Report) {
+	for typeIndex, functionType := range module.Types {
+		if hasFloatType(functionType.Params) || hasFloatType(functionType.Results) {
+			report.addIssue(SeverityWarning, RuleFloatType,
+				"function type %d uses floating point values: %s", typeIndex, formatFunctionType(functionType))
+		}
+	}
+
+	numImported := module.NumImportedFunctions()
+	exportNames := functionExportNames(module)
+	for i, body := range module.Bodies {
+		if hasFloatType(body.Locals) {
+			report.addIssue(SeverityWarning, RuleFloatType,
+				"%s declares floating point locals", describeFunction(uint32(numImported+i), exportNames))
+		}
+	}
+
+	for globalIndex, globalType := range module.Globals {
+		if globalType.IsFloat() {
+			report.addIssue(SeverityWarning, RuleFloatType, "global %d has the type %s", globalIndex, globalType)
+		}
+	}
+}
+
+func functionExportNames(module *Module) map[uint32]string {
+	names := make(map[uint32]string)
+	for _, export := range module.Exports {
+		if export.Kind == ExternalFunction {
+			names[export.Index] = export.Name
+		}
+	}
+	return names
+}
+
+func describeFunction(functionIndex uint32, exportNames map[uint32]string) string {
+	name, ok := exportNames[functionIndex]
+	if ok {
+		return fmt.Sprintf("function %d (%q)", functionIndex, name)
+	}
+	return fmt.Sprintf("function %d", functionIndex)
+}
+
+// isValidFunctionName applies the same checks as the VM validator, except for the reserved names
+func isValidFunctionName(name string) bool {
+	if len(name) == 0 || len(name) >= maxLengthOfFunctionName {
+		return false
+	}
+	for i := 0; i < len(name); i++ {
+		if name[i] > unicode.MaxASCII {
+			return false
+		}
+	}
+	return true
+}
+
+func hasFloatType(valueTypes []ValueType) bool {
+	for _, valueType := range valueTypes {
+		if valueType.IsFloat() {
+			return true
+		}
+	}
+	return false
+}
+
+func equalFunctionTypes(first FunctionType, second FunctionType) bool {
+	return equalValueTypes(first.Params, second.Params) && equalValueTypes(first.Results, second.Results)
+}
+
+func equalValueTypes(first []ValueType, second []ValueType) bool {
+	if len(first) != len(second) {
+		return false
+	}
+	for i := range first {
+		if first[i] != second[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func formatFunctionType(functionType FunctionType) string {
+	return fmt.Sprintf("(%s) -> (%s)", formatValueTypes(functionType.Params), formatValueTypes(functionType.Results))
+}
+
+func formatValueTypes(valueTypes []ValueType) string {
+	names := make([]string, len(valueTypes))
+	for i, valueType := range valueTypes {
+		names[i] = valueType.String()
+	}
+	return strings.Join(names, ", ")
+}
+
+func formatOpcodeCounts(counts map[string]int) string {
+	names := make([]string, 0, len(counts))
+	for name := range counts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	parts := make([]string, len(names))
+	for i, name := range names {
+		parts[i] = fmt.Sprintf("%s (%d)", name, counts[name])
+	}
+	return strings.Join(parts, ", ")
+}
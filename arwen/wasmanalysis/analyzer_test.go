@@ -0,0 +1,290 @@
+package wasmanalysis
+
+import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testGasCosts = DeployGasCosts{
+	CreateContract:    1000,
+	CompilePerByte:    2,
+	GetCode:           100,
+	AoTPreparePerByte: 1,
+}
+
+func loadTestContract(t *testing.T, name string) []byte {
+	code, err := ioutil.ReadFile(filepath.Join("../../test/contracts", name, "output", name+".wasm"))
+	require.Nil(t, err)
+	return code
+}
+
+// rulesAllowingImportsOf returns rules whose EEI contains exactly the functions imported by the given module
+func rulesAllowingImportsOf(t *testing.T, code []byte) Rules {
+	module, err := ParseModule(code)
+	require.Nil(t, err)
+
+	rules := Rules{
+		EEIFunctions:  make(map[string]FunctionType),
+		ReservedNames: make(map[string]struct{}),
+		Gas:           testGasCosts,
+	}
+	for _, imp := range module.Imports {
+		rules.EEIFunctions[imp.Name] = module.Types[imp.TypeIndex]
+		rules.ReservedNames[imp.Name] = struct{}{}
+	}
+	return rules
+}
+
+func issuesWithRule(report *Report, rule string) []Issue {
+	result := make([]Issue, 0)
+	for _, issue := range report.Issues {
+		if issue.Rule == rule {
+			result = append(result, issue)
+		}
+	}
+	return result
+}
+
+// wasmModule assembles a module from raw sections, each given as the section id followed by its contents
+func wasmModule(sections ...[]byte) []byte {
+	code := []byte("\x00asm\x01\x00\x00\x00")
+	for _, section := range sections {
+		code = append(code, section[0], byte(len(section)-1))
+		code = append(code, section[1:]...)
+	}
+	return code
+}
+
+func wasmName(name string) []byte {
+	return append([]byte{byte(len(name))}, name...)
+}
+
+func concat(parts ...[]byte) []byte {
+	result := make([]byte, 0)
+	for _, part := range parts {
+		result = append(result, part...)
+	}
+	return result
+}
+
+func TestParseModule_AllTestContracts(t *testing.T) {
+	paths, err := filepath.Glob("../../test/contracts/*/output/*.wasm")
+	require.Nil(t, err)
+	require.NotEmpty(t, paths)
+
+	for _, path := range paths {
+		code, err := ioutil.ReadFile(path)
+		require.Nil(t, err)
+
+		module, err := ParseModule(code)
+		require.Nil(t, err, path)
+		for i, body := range module.Bodies {
+			err = scanInstructions(body.Code, body.Offset, func(ins instruction) {})
+			require.Nil(t, err, "%s function %d", path, i)
+		}
+	}
+}
+
+func TestParseModule_InvalidCode(t *testing.T) {
+	_, err := ParseModule([]byte("not wasm"))
+	assert.Equal(t, ErrInvalidMagic, err)
+
+	_, err = ParseModule([]byte("\x00asm\x02\x00\x00\x00"))
+	assert.Equal(t, ErrUnsupportedVersion, err)
+
+	_, err = ParseModule([]byte("\x00asm\x01\x00\x00\x00\x01\x05\x01"))
+	assert.Equal(t, ErrUnexpectedEnd, err)
+}
+
+func TestAnalyze_ValidContract(t *testing.T) {
+	code := loadTestContract(t, "counter")
+	rules := rulesAllowingImportsOf(t, code)
+
+	report, err := Analyze(code, rules)
+	require.Nil(t, err)
+
+	assert.False(t, report.HasErrors(), report.Issues)
+	assert.Equal(t, len(code), report.CodeSize)
+	assert.Contains(t, report.ExportedFunctions, "init")
+	assert.True(t, report.DefinedFunctions > 0)
+	assert.True(t, report.MemoryPages > 0)
+	assert.Equal(t, uint64(1000+2*len(code)), report.DeployGas)
+	assert.Equal(t, uint64(100+len(code)), report.CallOverheadGas)
+}
+
+func TestAnalyze_FloatingPoint(t *testing.T) {
+	code := loadTestContract(t, "num-with-fp")
+	rules := rulesAllowingImportsOf(t, code)
+
+	report, err := Analyze(code, rules)
+	require.Nil(t, err)
+
+	require.True(t, report.HasErrors())
+	floatIssues := issuesWithRule(report, RuleFloatOpcode)
+	require.Len(t, floatIssues, 1)
+	assert.Contains(t, floatIssues[0].Message, "doSomething")
+	assert.Contains(t, floatIssues[0].Message, "f32.")
+}
+
+func TestAnalyze_UnknownImports(t *testing.T) {
+	code := loadTestContract(t, "counter")
+	rules := rulesAllowingImportsOf(t, code)
+	module, _ := ParseModule(code)
+
+	missingImport := module.Imports[0].Name
+	delete(rules.EEIFunctions, missingImport)
+	changedImport := module.Imports[1].Name
+	rules.EEIFunctions[changedImport] = FunctionType{Params: []ValueType{ValueTypeI64, ValueTypeI64, ValueTypeI64}}
+
+	report, err := Analyze(code, rules)
+	require.Nil(t, err)
+
+	unknownIssues := issuesWithRule(report, RuleUnknownImport)
+	require.Len(t, unknownIssues, 1)
+	assert.Contains(t, unknownIssues[0].Message, "env."+missingImport)
+
+	signatureIssues := issuesWithRule(report, RuleImportSignature)
+	require.Len(t, signatureIssues, 1)
+	assert.Contains(t, signatureIssues[0].Message, "(i64, i64, i64) -> ()")
+}
+
+func TestAnalyze_Exports(t *testing.T) {
+	typeSection := concat([]byte{sectionType, 2, functionTypeForm, 0, 0, functionTypeForm, 1, byte(ValueTypeI32), 1, byte(ValueTypeF64)})
+	importSection := concat([]byte{sectionImport, 1}, wasmName("other"), wasmName("getCaller"), []byte{byte(ExternalFunction), 0})
+	functionSection := []byte{sectionFunction, 3, 0, 0, 1}
+	exportSection := concat([]byte{sectionExport, 3},
+		wasmName("getCaller"), []byte{byte(ExternalFunction), 1},
+		wasmName("add"), []byte{byte(ExternalFunction), 3},
+		wasmName(""), []byte{byte(ExternalFunction), 2},
+	)
+	codeSection := concat([]byte{sectionCode, 3},
+		[]byte{2, 0, opcodeEnd},
+		[]byte{2, 0, opcodeEnd},
+		[]byte{4, 1, 1, byte(ValueTypeF32), opcodeEnd},
+	)
+	code := wasmModule(typeSection, importSection, functionSection, exportSection, codeSection)
+
+	rules := Rules{
+		EEIFunctions:  map[string]FunctionType{"getCaller": {}},
+		ReservedNames: map[string]struct{}{"getCaller": {}},
+	}
+	report, err := Analyze(code, rules)
+	require.Nil(t, err)
+
+	assert.Equal(t, []string{"getCaller", "add", ""}, report.ExportedFunctions)
+	assert.Equal(t, 1, report.ImportedFunctions)
+	assert.Equal(t, 3, report.DefinedFunctions)
+	assert.Len(t, issuesWithRule(report, RuleUnknownImport), 1)
+	assert.Len(t, issuesWithRule(report, RuleReservedExport), 1)
+	assert.Len(t, issuesWithRule(report, RuleInvalidExportName), 1)
+	assert.Len(t, issuesWithRule(report, RuleNonVoidExport), 1)
+	assert.Len(t, issuesWithRule(report, RuleMissingMemory), 1)
+	assert.Len(t, issuesWithRule(report, RuleFloatType), 2)
+	assert.Len(t, issuesWithRule(report, RuleFloatOpcode), 0)
+}
+
+func TestAnalyze_MemoryDataAndUnsupportedOpcodes(t *testing.T) {
+	typeSection := []byte{sectionType, 1, functionTypeForm, 0, 0}
+	functionSection := []byte{sectionFunction, 1, 0}
+	memorySection := []byte{sectionMemory, 1, 1, 2, 16}
+	exportSection := concat([]byte{sectionExport, 2},
+		wasmName("memory"), []byte{byte(ExternalMemory), 0},
+		wasmName("init"), []byte{byte(ExternalFunction), 0},
+	)
+	// i32.const 0, v128.load, end
+	codeSection := []byte{sectionCode, 1, 8, 0, opcodeI32Const, 0, prefixSIMD, 0, 4, 0, opcodeEnd}
+	dataSection := concat([]byte{sectionData, 2},
+		[]byte{0, opcodeI32Const, 0, opcodeEnd, 3, 'a', 'b', 'c'},
+		[]byte{1, 2, 'd', 'e'},
+	)
+	code := wasmModule(typeSection, functionSection, memorySection, exportSection, codeSection, dataSection)
+
+	report, err := Analyze(code, Rules{})
+	require.Nil(t, err)
+
+	assert.Equal(t, uint32(2), report.MemoryPages)
+	assert.Equal(t, uint32(16), report.MemoryMaxPages)
+	assert.Equal(t, 2, report.DataSegments)
+	assert.Equal(t, 5, report.DataSize)
+
+	unsupportedIssues := issuesWithRule(report, RuleUnsupportedOpcode)
+	require.Len(t, unsupportedIssues, 1)
+	assert.Contains(t, unsupportedIssues[0].Message, "\"init\"")
+	assert.Len(t, report.Issues, 1)
+}
+
+func TestReadAPILevel(t *testing.T) {
+	level, err := ReadAPILevel(loadTestContract(t, "counter"))
+	require.Nil(t, err)
+	assert.Equal(t, uint32(0), level)
+
+	apiLevelSection := concat([]byte{sectionCustom}, wasmName(APILevelSectionName), []byte{2})
+	otherSection := concat([]byte{sectionCustom}, wasmName("name"), []byte{0, 0})
+	level, err = ReadAPILevel(wasmModule(otherSection, apiLevelSection))
+	require.Nil(t, err)
+	assert.Equal(t, uint32(2), level)
+
+	_, err = ReadAPILevel(wasmModule(apiLevelSection, apiLevelSection))
+	require.Equal(t, ErrInvalidAPILevel, err)
+
+	_, err = ReadAPILevel(wasmModule(concat([]byte{sectionCustom}, wasmName(APILevelSectionName), []byte{0})))
+	require.Equal(t, ErrInvalidAPILevel, err)
+
+	_, err = ReadAPILevel(wasmModule(concat([]byte{sectionCustom}, wasmName(APILevelSectionName), []byte{1, 1})))
+	require.Equal(t, ErrInvalidAPILevel, err)
+
+	_, err = ReadAPILevel([]byte("contract"))
+	require.Equal(t, ErrInvalidMagic, err)
+
+	_, err = ParseModule(wasmModule(apiLevelSection, apiLevelSection))
+	require.True(t, errors.Is(err, ErrInvalidSection))
+}
+
+func TestAnalyze_APILevel(t *testing.T) {
+	typeSection := []byte{sectionType, 1, functionTypeForm, 0, 0}
+	importSection := concat([]byte{sectionImport, 2},
+		wasmName(EEIModuleName), wasmName("getCaller"), []byte{byte(ExternalFunction), 0},
+		wasmName(EEIModuleName), wasmName("managedSha256"), []byte{byte(ExternalFunction), 0},
+	)
+	analyzeAtLevel := func(declaredLevel byte, maxLevel uint32) *Report {
+		sections := [][]byte{typeSection, importSection}
+		if declaredLevel != 0 {
+			sections = append(sections, concat([]byte{sectionCustom}, wasmName(APILevelSectionName), []byte{declaredLevel}))
+		}
+		rules := Rules{
+			EEIFunctions: map[string]FunctionType{"getCaller": {}, "managedSha256": {}},
+			EEIVersions:  map[string]APIVersion{"getCaller": {Introduced: 1, Deprecated: 3}, "managedSha256": {Introduced: 2}},
+			MaxAPILevel:  maxLevel,
+		}
+		report, err := Analyze(wasmModule(sections...), rules)
+		require.Nil(t, err)
+		return report
+	}
+
+	report := analyzeAtLevel(0, 2)
+	assert.Equal(t, uint32(2), report.APILevel)
+	assert.False(t, report.APILevelDeclared)
+	assert.Len(t, issuesWithRule(report, RuleUnavailableImport), 0)
+	assert.Len(t, issuesWithRule(report, RuleAPILevel), 0)
+
+	report = analyzeAtLevel(1, 2)
+	assert.Equal(t, uint32(1), report.APILevel)
+	assert.True(t, report.APILevelDeclared)
+	unavailableIssues := issuesWithRule(report, RuleUnavailableImport)
+	require.Len(t, unavailableIssues, 1)
+	assert.Contains(t, unavailableIssues[0].Message, "env.managedSha256")
+
+	report = analyzeAtLevel(3, 2)
+	assert.Len(t, issuesWithRule(report, RuleAPILevel), 1)
+	unavailableIssues = issuesWithRule(report, RuleUnavailableImport)
+	require.Len(t, unavailableIssues, 1)
+	assert.Contains(t, unavailableIssues[0].Message, "env.getCaller")
+
+	report = analyzeAtLevel(1, 0)
+	assert.Len(t, issuesWithRule(report, RuleUnavailableImport), 0)
+}
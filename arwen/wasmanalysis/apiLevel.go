@@ -0,0 +1,48 @@
+package wasmanalysis
+
+// APILevelSectionName is the name of the custom section in which a contract declares the EEI API level it targets.
+// The section holds the level as an unsigned LEB128 integer.
+const APILevelSectionName = "arwen_api_level"
+
+// ReadAPILevel returns the API level declared by the contract code, or zero if the code declares none.
+// Only the custom sections are decoded, so that the VM can afford calling it on every deployment.
+// A malformed API level section is reported as ErrInvalidAPILevel, unwrapped.
+func ReadAPILevel(code []byte) (uint32, error) {
+	module := &Module{}
+	var levelErr error
+	err := walkSections(code, func(sectionID byte, contents []byte, _ int) error {
+		if sectionID != sectionCustom {
+			return nil
+		}
+		r := newReader(contents)
+		name, err := r.readName()
+		if err != nil {
+			return err
+		}
+		if name != APILevelSectionName {
+			return nil
+		}
+		levelErr = module.parseAPILevel(r)
+		return levelErr
+	})
+	if levelErr != nil {
+		return 0, levelErr
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return module.APILevel, nil
+}
+
+func (module *Module) parseAPILevel(r *reader) error {
+	if module.APILevel != 0 {
+		return ErrInvalidAPILevel
+	}
+	level, err := r.readU32()
+	if err != nil || level == 0 || !r.isEOF() {
+		return ErrInvalidAPILevel
+	}
+	module.APILevel = level
+	return nil
+}
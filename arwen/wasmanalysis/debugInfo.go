@@ -0,0 +1,211 @@
+package wasmanalysis
+
+import (
+	"debug/dwarf"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// NameSectionName is the name of the custom section which holds the names of the functions, as emitted by the compilers
+const NameSectionName = "name"
+
+const nameSubsectionFunctions = 1
+
+const dwarfSectionPrefix = ".debug_"
+
+// SourceLocation is a position in the source code of a contract
+type SourceLocation struct {
+	File   string
+	Line   int
+	Column int
+}
+
+// DebugInfo resolves function indices to names and code offsets to source locations,
+// using the name section and the DWARF sections of a module.
+// The code offsets count from the start of the code section contents, as in the DWARF sections.
+type DebugInfo struct {
+	functionNames map[uint32]string
+	lines         []lineRow
+}
+
+// lineRow is a row of the DWARF line tables; it covers the code up to the address of the next row
+type lineRow struct {
+	address     uint64
+	location    SourceLocation
+	endSequence bool
+}
+
+// ParseDebugInfo reads the name section and the DWARF sections of the given module.
+// A module without them yields a DebugInfo which resolves nothing. When one of the sections is malformed,
+// the error comes along with the DebugInfo built from the other sections.
+func ParseDebugInfo(code []byte) (*DebugInfo, error) {
+	info := &DebugInfo{
+		functionNames: make(map[uint32]string),
+	}
+	dwarfSections := make(map[string][]byte)
+	var nameErr error
+	err := walkSections(code, func(sectionID byte, contents []byte, _ int) error {
+		if sectionID != sectionCustom {
+			return nil
+		}
+		r := newReader(contents)
+		name, err := r.readName()
+		if err != nil {
+			return err
+		}
+		if name == NameSectionName {
+			nameErr = info.parseNameSection(r)
+		}
+		if strings.HasPrefix(name, dwarfSectionPrefix) {
+			dwarfSections[strings.TrimPrefix(name, dwarfSectionPrefix)] = contents[r.offset:]
+		}
+		return nil
+	})
+	if err != nil {
+		return info, err
+	}
+	if nameErr != nil {
+		return info, fmt.Errorf("%w: %s", ErrInvalidNameSection, nameErr.Error())
+	}
+
+	err = info.parseLineTables(dwarfSections)
+	if err != nil {
+		return info, fmt.Errorf("%w: %s", ErrInvalidDebugInfo, err.Error())
+	}
+	return info, nil
+}
+
+// FunctionName returns the name of the function with the given index, or an empty string if the module does not name it
+func (info *DebugInfo) FunctionName(functionIndex uint32) string {
+	return info.functionNames[functionIndex]
+}
+
+// SourceLocation returns the source location of the instruction at the given code offset, if the DWARF sections cover it
+func (info *DebugInfo) SourceLocation(codeOffset uint32) (SourceLocation, bool) {
+	address := uint64(codeOffset)
+	next := sort.Search(len(info.lines), func(i int) bool {
+		return info.lines[i].address > address
+	})
+	if next == 0 {
+		return SourceLocation{}, false
+	}
+	row := info.lines[next-1]
+	if row.endSequence || row.location.Line == 0 {
+		return SourceLocation{}, false
+	}
+	return row.location, true
+}
+
+// parseNameSection reads the function names subsection, skipping the other subsections
+func (info *DebugInfo) parseNameSection(r *reader) error {
+	for !r.isEOF() {
+		subsectionID, err := r.readByte()
+		if err != nil {
+			return err
+		}
+		size, err := r.readU32()
+		if err != nil {
+			return err
+		}
+		contents, err := r.readBytes(int(size))
+		if err != nil {
+			return err
+		}
+		if subsectionID != nameSubsectionFunctions {
+			continue
+		}
+
+		err = parseVector(newReader(contents), func(r *reader) error {
+			functionIndex, err := r.readU32()
+			if err != nil {
+				return err
+			}
+			name, err := r.readName()
+			info.functionNames[functionIndex] = name
+			return err
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseLineTables collects the rows of the line tables of all the compilation units, sorted by address
+func (info *DebugInfo) parseLineTables(sections map[string][]byte) error {
+	if len(sections["info"]) == 0 {
+		return nil
+	}
+
+	data, err := dwarf.New(sections["abbrev"], nil, nil, sections["info"], sections["line"], nil, sections["ranges"], sections["str"])
+	if err != nil {
+		return err
+	}
+	for _, name := range []string{"addr", "line_str", "str_offsets", "rnglists"} {
+		if len(sections[name]) > 0 {
+			err = data.AddSection(dwarfSectionPrefix+name, sections[name])
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	entries := data.Reader()
+	for {
+		entry, err := entries.Next()
+		if err != nil {
+			return err
+		}
+		if entry == nil {
+			break
+		}
+		if entry.Tag != dwarf.TagCompileUnit {
+			entries.SkipChildren()
+			continue
+		}
+
+		err = info.readLineTable(data, entry)
+		if err != nil {
+			return err
+		}
+		entries.SkipChildren()
+	}
+
+	sort.SliceStable(info.lines, func(i, j int) bool {
+		return info.lines[i].address < info.lines[j].address
+	})
+	return nil
+}
+
+func (info *DebugInfo) readLineTable(data *dwarf.Data, unit *dwarf.Entry) error {
+	lineReader, err := data.LineReader(unit)
+	if err != nil || lineReader == nil {
+		return err
+	}
+
+	var lineEntry dwarf.LineEntry
+	for {
+		err = lineReader.Next(&lineEntry)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		row := lineRow{
+			address:     lineEntry.Address,
+			endSequence: lineEntry.EndSequence,
+		}
+		if lineEntry.File != nil {
+			row.location = SourceLocation{
+				File:   lineEntry.File.Name,
+				Line:   lineEntry.Line,
+				Column: lineEntry.Column,
+			}
+		}
+		info.lines = append(info.lines, row)
+	}
+}
@@ -0,0 +1,169 @@
+package wasmanalysis
+
+import (
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func customSection(name string, contents ...[]byte) []byte {
+	return concat([]byte{sectionCustom}, wasmName(name), concat(contents...))
+}
+
+func uleb128(value uint32) []byte {
+	result := make([]byte, 0)
+	for {
+		b := byte(value & 0x7f)
+		value >>= 7
+		if value == 0 {
+			return append(result, b)
+		}
+		result = append(result, b|0x80)
+	}
+}
+
+func u32(value uint32) []byte {
+	result := make([]byte, 4)
+	binary.LittleEndian.PutUint32(result, value)
+	return result
+}
+
+// withLength prefixes the contents with their length, as a 32 bit DWARF unit
+func withLength(contents ...[]byte) []byte {
+	joined := concat(contents...)
+	return concat(u32(uint32(len(joined))), joined)
+}
+
+func nameSubsection(id byte, contents ...[]byte) []byte {
+	joined := concat(contents...)
+	return concat([]byte{id, byte(len(joined))}, joined)
+}
+
+type testLineRow struct {
+	address uint32
+	line    uint32
+	column  uint32
+}
+
+// dwarfSections encodes a DWARF 4 compilation unit for src/lib.rs, with a single line sequence made of the given rows, ending at the given address
+func dwarfSections(rows []testLineRow, endAddress uint32) [][]byte {
+	abbrev := []byte{
+		1, 0x11, 0, // abbreviation 1: compile unit, without children
+		0x03, 0x08, // name, inline string
+		0x10, 0x17, // stmt_list, section offset
+		0, 0,
+		0,
+	}
+	info := withLength(
+		[]byte{4, 0}, u32(0), []byte{4},
+		[]byte{1}, []byte("lib.rs\x00"), u32(0),
+	)
+
+	// the increments of the lines are small enough to encode the same as signed LEB128
+	program := concat([]byte{0, 5, 2}, u32(rows[0].address))
+	address, line := rows[0].address, uint32(1)
+	for _, row := range rows {
+		program = concat(program,
+			[]byte{2}, uleb128(row.address-address),
+			[]byte{3}, uleb128(row.line-line),
+			[]byte{5}, uleb128(row.column),
+			[]byte{1},
+		)
+		address, line = row.address, row.line
+	}
+	program = concat(program, []byte{2}, uleb128(endAddress-address), []byte{0, 1, 1})
+
+	header := concat(
+		[]byte{1, 1, 1, 0xfb, 14, 13},
+		[]byte{0, 1, 1, 1, 1, 0, 0, 0, 1, 0, 0, 1},
+		[]byte("src\x00\x00"),
+		[]byte("lib.rs\x00"), []byte{1, 0, 0},
+		[]byte{0},
+	)
+	lines := withLength([]byte{4, 0}, u32(uint32(len(header))), header, program)
+
+	return [][]byte{
+		customSection(".debug_abbrev", abbrev),
+		customSection(".debug_info", info),
+		customSection(".debug_line", lines),
+	}
+}
+
+func TestParseDebugInfo_FunctionNames(t *testing.T) {
+	names := concat(
+		nameSubsection(0, wasmName("module")),
+		nameSubsection(1, []byte{2}, []byte{0}, wasmName("signalError"), []byte{3}, wasmName("panic")),
+		nameSubsection(2, []byte{0}),
+	)
+	code := wasmModule(customSection(NameSectionName, names))
+
+	info, err := ParseDebugInfo(code)
+	require.Nil(t, err)
+	require.Equal(t, "signalError", info.FunctionName(0))
+	require.Equal(t, "panic", info.FunctionName(3))
+	require.Equal(t, "", info.FunctionName(1))
+
+	_, ok := info.SourceLocation(0)
+	require.False(t, ok)
+}
+
+func TestParseDebugInfo_InvalidNameSection(t *testing.T) {
+	code := wasmModule(customSection(NameSectionName, []byte{1, 10, 1, 0}))
+
+	info, err := ParseDebugInfo(code)
+	require.True(t, errors.Is(err, ErrInvalidNameSection))
+	require.NotNil(t, info)
+	require.Equal(t, "", info.FunctionName(0))
+}
+
+func TestParseDebugInfo_WithoutDebugSections(t *testing.T) {
+	info, err := ParseDebugInfo(loadTestContract(t, "promises-managed"))
+	require.Nil(t, err)
+	require.Equal(t, "", info.FunctionName(0))
+
+	_, ok := info.SourceLocation(0x20)
+	require.False(t, ok)
+}
+
+func TestParseDebugInfo_LineTables(t *testing.T) {
+	rows := []testLineRow{
+		{address: 0x10, line: 3, column: 5},
+		{address: 0x18, line: 4, column: 9},
+		{address: 0x30, line: 12, column: 1},
+	}
+	code := wasmModule(dwarfSections(rows, 0x40)...)
+
+	info, err := ParseDebugInfo(code)
+	require.Nil(t, err)
+
+	_, ok := info.SourceLocation(0x0f)
+	require.False(t, ok)
+
+	location, ok := info.SourceLocation(0x10)
+	require.True(t, ok)
+	require.Equal(t, SourceLocation{File: "src/lib.rs", Line: 3, Column: 5}, location)
+
+	location, ok = info.SourceLocation(0x2f)
+	require.True(t, ok)
+	require.Equal(t, SourceLocation{File: "src/lib.rs", Line: 4, Column: 9}, location)
+
+	location, ok = info.SourceLocation(0x3f)
+	require.True(t, ok)
+	require.Equal(t, 12, location.Line)
+
+	_, ok = info.SourceLocation(0x40)
+	require.False(t, ok)
+}
+
+func TestParseDebugInfo_InvalidDebugInfo(t *testing.T) {
+	code := wasmModule(
+		customSection(".debug_abbrev", []byte{0}),
+		customSection(".debug_info", []byte{1, 2, 3}),
+	)
+
+	info, err := ParseDebugInfo(code)
+	require.True(t, errors.Is(err, ErrInvalidDebugInfo))
+	require.NotNil(t, info)
+}
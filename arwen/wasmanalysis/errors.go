@@ -0,0 +1,32 @@
+package wasmanalysis
+
+import (
+	"errors"
+)
+
+// ErrInvalidMagic signals that the code does not start with the WebAssembly magic number
+var ErrInvalidMagic = errors.New("not a WebAssembly module")
+
+// ErrUnsupportedVersion signals a WebAssembly binary format version other than 1
+var ErrUnsupportedVersion = errors.New("unsupported WebAssembly version")
+
+// ErrUnexpectedEnd signals that a section or the module ends in the middle of an item
+var ErrUnexpectedEnd = errors.New("unexpected end of WebAssembly module")
+
+// ErrInvalidLEB128 signals an integer encoding longer than its type allows
+var ErrInvalidLEB128 = errors.New("invalid LEB128 integer")
+
+// ErrInvalidSection signals a malformed or misplaced section
+var ErrInvalidSection = errors.New("invalid WebAssembly section")
+
+// ErrInvalidTypeIndex signals a reference to a function type that does not exist
+var ErrInvalidTypeIndex = errors.New("invalid function type index")
+
+// ErrInvalidAPILevel signals an API level section which is repeated or does not hold a single positive integer
+var ErrInvalidAPILevel = errors.New("invalid API level section")
+
+// ErrInvalidNameSection signals a malformed name section
+var ErrInvalidNameSection = errors.New("invalid name section")
+
+// ErrInvalidDebugInfo signals malformed DWARF sections
+var ErrInvalidDebugInfo = errors.New("invalid DWARF debug info")
@@ -0,0 +1,242 @@
+package wasmanalysis
+
+import (
+	"fmt"
+)
+
+const (
+	opcodeBlock        = 0x02
+	opcodeLoop         = 0x03
+	opcodeIf           = 0x04
+	opcodeEnd          = 0x0b
+	opcodeBr           = 0x0c
+	opcodeBrIf         = 0x0d
+	opcodeBrTable      = 0x0e
+	opcodeCall         = 0x10
+	opcodeCallIndirect = 0x11
+	opcodeTypedSelect  = 0x1c
+	opcodeLocalGet     = 0x20
+	opcodeTableSet     = 0x26
+	opcodeFirstMemory  = 0x28
+	opcodeLastMemory   = 0x3e
+	opcodeMemorySize   = 0x3f
+	opcodeMemoryGrow   = 0x40
+	opcodeI32Const     = 0x41
+	opcodeI64Const     = 0x42
+	opcodeF32Const     = 0x43
+	opcodeF64Const     = 0x44
+	opcodeRefNull      = 0xd0
+	opcodeRefFunc      = 0xd2
+	prefixMisc         = 0xfc
+	prefixSIMD         = 0xfd
+	prefixAtomic       = 0xfe
+)
+
+const emptyBlockType = 0x40
+
+// floatOpcodeNames holds the single byte opcodes that operate on floating point values
+var floatOpcodeNames = map[byte]string{
+	0x2a: "f32.load", 0x2b: "f64.load", 0x38: "f32.store", 0x39: "f64.store",
+	0x43: "f32.const", 0x44: "f64.const",
+	0x5b: "f32.eq", 0x5c: "f32.ne", 0x5d: "f32.lt", 0x5e: "f32.gt", 0x5f: "f32.le", 0x60: "f32.ge",
+	0x61: "f64.eq", 0x62: "f64.ne", 0x63: "f64.lt", 0x64: "f64.gt", 0x65: "f64.le", 0x66: "f64.ge",
+	0x8b: "f32.abs", 0x8c: "f32.neg", 0x8d: "f32.ceil", 0x8e: "f32.floor", 0x8f: "f32.trunc",
+	0x90: "f32.nearest", 0x91: "f32.sqrt", 0x92: "f32.add", 0x93: "f32.sub", 0x94: "f32.mul",
+	0x95: "f32.div", 0x96: "f32.min", 0x97: "f32.max", 0x98: "f32.copysign",
+	0x99: "f64.abs", 0x9a: "f64.neg", 0x9b: "f64.ceil", 0x9c: "f64.floor", 0x9d: "f64.trunc",
+	0x9e: "f64.nearest", 0x9f: "f64.sqrt", 0xa0: "f64.add", 0xa1: "f64.sub", 0xa2: "f64.mul",
+	0xa3: "f64.div", 0xa4: "f64.min", 0xa5: "f64.max", 0xa6: "f64.copysign",
+	0xa8: "i32.trunc_f32_s", 0xa9: "i32.trunc_f32_u", 0xaa: "i32.trunc_f64_s", 0xab: "i32.trunc_f64_u",
+	0xae: "i64.trunc_f32_s", 0xaf: "i64.trunc_f32_u", 0xb0: "i64.trunc_f64_s", 0xb1: "i64.trunc_f64_u",
+	0xb2: "f32.convert_i32_s", 0xb3: "f32.convert_i32_u", 0xb4: "f32.convert_i64_s", 0xb5: "f32.convert_i64_u",
+	0xb6: "f32.demote_f64",
+	0xb7: "f64.convert_i32_s", 0xb8: "f64.convert_i32_u", 0xb9: "f64.convert_i64_s", 0xba: "f64.convert_i64_u",
+	0xbb: "f64.promote_f32",
+	0xbc: "i32.reinterpret_f32", 0xbd: "i64.reinterpret_f64", 0xbe: "f32.reinterpret_i32", 0xbf: "f64.reinterpret_i64",
+}
+
+// floatMiscOpcodeNames holds the 0xfc prefixed opcodes that operate on floating point values
+var floatMiscOpcodeNames = map[uint32]string{
+	0: "i32.trunc_sat_f32_s", 1: "i32.trunc_sat_f32_u", 2: "i32.trunc_sat_f64_s", 3: "i32.trunc_sat_f64_u",
+	4: "i64.trunc_sat_f32_s", 5: "i64.trunc_sat_f32_u", 6: "i64.trunc_sat_f64_s", 7: "i64.trunc_sat_f64_u",
+}
+
+// instruction is a decoded opcode, along with its sub-opcode for the prefixed instructions
+type instruction struct {
+	opcode    byte
+	subOpcode uint32
+	offset    int
+}
+
+// isFloat returns true if the instruction operates on floating point values
+func (ins instruction) isFloat() bool {
+	if ins.opcode == prefixMisc {
+		_, ok := floatMiscOpcodeNames[ins.subOpcode]
+		return ok
+	}
+	_, ok := floatOpcodeNames[ins.opcode]
+	return ok
+}
+
+// isUnsupportedProposal returns true for the SIMD and threads instructions, which the VM does not enable
+func (ins instruction) isUnsupportedProposal() bool {
+	return ins.opcode == prefixSIMD || ins.opcode == prefixAtomic
+}
+
+func (ins instruction) name() string {
+	if ins.opcode == prefixMisc {
+		name, ok := floatMiscOpcodeNames[ins.subOpcode]
+		if ok {
+			return name
+		}
+	}
+	name, ok := floatOpcodeNames[ins.opcode]
+	if ok {
+		return name
+	}
+	if ins.opcode >= prefixMisc {
+		return fmt.Sprintf("0x%02x 0x%02x", ins.opcode, ins.subOpcode)
+	}
+	return fmt.Sprintf("0x%02x", ins.opcode)
+}
+
+// scanInstructions decodes the instructions of a function body and passes each of them to visit.
+// Decoding stops at the first SIMD or atomic instruction, since their immediates are not decoded.
+func scanInstructions(code []byte, baseOffset int, visit func(ins instruction)) error {
+	r := newReader(code)
+	for !r.isEOF() {
+		ins, err := readInstruction(r)
+		if err != nil {
+			return err
+		}
+		ins.offset += baseOffset
+		visit(ins)
+		if ins.isUnsupportedProposal() {
+			return nil
+		}
+	}
+	return nil
+}
+
+// skipConstantExpression skips the instructions of an initializer expression, up to its end opcode
+func skipConstantExpression(r *reader) error {
+	for {
+		ins, err := readInstruction(r)
+		if err != nil {
+			return err
+		}
+		if ins.opcode == opcodeEnd {
+			return nil
+		}
+		if ins.isUnsupportedProposal() {
+			return fmt.Errorf("unsupported instruction %s in constant expression", ins.name())
+		}
+	}
+}
+
+func readInstruction(r *reader) (instruction, error) {
+	ins := instruction{offset: r.offset}
+	opcode, err := r.readByte()
+	if err != nil {
+		return ins, err
+	}
+	ins.opcode = opcode
+
+	switch {
+	case opcode == opcodeBlock || opcode == opcodeLoop || opcode == opcodeIf:
+		err = skipBlockType(r)
+	case opcode == opcodeBr || opcode == opcodeBrIf || opcode == opcodeCall || opcode == opcodeRefFunc:
+		_, err = r.readU32()
+	case opcode == opcodeBrTable:
+		err = parseVector(r, func(r *reader) error {
+			_, err := r.readU32()
+			return err
+		})
+		if err == nil {
+			_, err = r.readU32()
+		}
+	case opcode == opcodeCallIndirect:
+		_, err = r.readU32()
+		if err == nil {
+			_, err = r.readU32()
+		}
+	case opcode == opcodeTypedSelect:
+		_, err = readValueTypes(r)
+	case opcode >= opcodeLocalGet && opcode <= opcodeTableSet:
+		_, err = r.readU32()
+	case opcode >= opcodeFirstMemory && opcode <= opcodeLastMemory:
+		err = skipMemoryArgument(r)
+	case opcode == opcodeMemorySize || opcode == opcodeMemoryGrow:
+		_, err = r.readU32()
+	case opcode == opcodeI32Const:
+		_, err = r.readVarInt(32)
+	case opcode == opcodeI64Const:
+		_, err = r.readVarInt(64)
+	case opcode == opcodeF32Const:
+		err = r.skip(4)
+	case opcode == opcodeF64Const:
+		err = r.skip(8)
+	case opcode == opcodeRefNull:
+		err = r.skip(1)
+	case opcode == prefixMisc:
+		ins.subOpcode, err = r.readU32()
+		if err == nil {
+			err = skipMiscImmediates(r, ins.subOpcode)
+		}
+	case opcode == prefixSIMD || opcode == prefixAtomic:
+		ins.subOpcode, err = r.readU32()
+	}
+
+	return ins, err
+}
+
+func skipBlockType(r *reader) error {
+	if r.isEOF() {
+		return ErrUnexpectedEnd
+	}
+	next := ValueType(r.data[r.offset])
+	switch next {
+	case emptyBlockType, ValueTypeI32, ValueTypeI64, ValueTypeF32, ValueTypeF64,
+		ValueTypeV128, ValueTypeFuncRef, ValueTypeExternRef:
+		return r.skip(1)
+	}
+	_, err := r.readVarInt(33)
+	return err
+}
+
+func skipMemoryArgument(r *reader) error {
+	_, err := r.readU32()
+	if err != nil {
+		return err
+	}
+	_, err = r.readU32()
+	return err
+}
+
+func skipMiscImmediates(r *reader, subOpcode uint32) error {
+	var err error
+	switch subOpcode {
+	case 8:
+		// memory.init dataidx 0x00
+		_, err = r.readU32()
+		if err == nil {
+			err = r.skip(1)
+		}
+	case 9, 13, 15, 16, 17:
+		// data.drop, elem.drop, table.grow, table.size, table.fill
+		_, err = r.readU32()
+	case 10:
+		// memory.copy 0x00 0x00
+		err = r.skip(2)
+	case 11:
+		// memory.fill 0x00
+		err = r.skip(1)
+	case 12, 14:
+		// table.init, table.copy
+		_, err = r.readU32()
+		if err == nil {
+			_, err = r.readU32()
+		}
+	}
+	return err
+}
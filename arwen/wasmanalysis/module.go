@@ -0,0 +1,508 @@
+package wasmanalysis
+
+import (
+	"fmt"
+)
+
+const wasmMagic = "\x00asm"
+const wasmVersion = 1
+
+const (
+	sectionCustom   = 0
+	sectionType     = 1
+	sectionImport   = 2
+	sectionFunction = 3
+	sectionTable    = 4
+	sectionMemory   = 5
+	sectionGlobal   = 6
+	sectionExport   = 7
+	sectionStart    = 8
+	sectionElement  = 9
+	sectionCode     = 10
+	sectionData     = 11
+	sectionDataCnt  = 12
+)
+
+const functionTypeForm = 0x60
+
+// ValueType is a WebAssembly value type, as encoded in the binary format
+type ValueType byte
+
+const (
+	// ValueTypeI32 is the i32 type
+	ValueTypeI32 ValueType = 0x7f
+	// ValueTypeI64 is the i64 type
+	ValueTypeI64 ValueType = 0x7e
+	// ValueTypeF32 is the f32 type
+	ValueTypeF32 ValueType = 0x7d
+	// ValueTypeF64 is the f64 type
+	ValueTypeF64 ValueType = 0x7c
+	// ValueTypeV128 is the SIMD v128 type
+	ValueTypeV128 ValueType = 0x7b
+	// ValueTypeFuncRef is the funcref reference type
+	ValueTypeFuncRef ValueType = 0x70
+	// ValueTypeExternRef is the externref reference type
+	ValueTypeExternRef ValueType = 0x6f
+)
+
+// String returns the name of the value type in the WebAssembly text format
+func (valueType ValueType) String() string {
+	switch valueType {
+	case ValueTypeI32:
+		return "i32"
+	case ValueTypeI64:
+		return "i64"
+	case ValueTypeF32:
+		return "f32"
+	case ValueTypeF64:
+		return "f64"
+	case ValueTypeV128:
+		return "v128"
+	case ValueTypeFuncRef:
+		return "funcref"
+	case ValueTypeExternRef:
+		return "externref"
+	}
+	return fmt.Sprintf("0x%02x", byte(valueType))
+}
+
+// IsFloat returns true for the floating point types
+func (valueType ValueType) IsFloat() bool {
+	return valueType == ValueTypeF32 || valueType == ValueTypeF64
+}
+
+// ExternalKind is the kind of an imported or exported item
+type ExternalKind byte
+
+const (
+	// ExternalFunction denotes an imported or exported function
+	ExternalFunction ExternalKind = 0
+	// ExternalTable denotes an imported or exported table
+	ExternalTable ExternalKind = 1
+	// ExternalMemory denotes an imported or exported memory
+	ExternalMemory ExternalKind = 2
+	// ExternalGlobal denotes an imported or exported global
+	ExternalGlobal ExternalKind = 3
+)
+
+// String returns the name of the kind in the WebAssembly text format
+func (kind ExternalKind) String() string {
+	switch kind {
+	case ExternalFunction:
+		return "func"
+	case ExternalTable:
+		return "table"
+	case ExternalMemory:
+		return "memory"
+	case ExternalGlobal:
+		return "global"
+	}
+	return fmt.Sprintf("0x%02x", byte(kind))
+}
+
+// FunctionType is the signature of a function
+type FunctionType struct {
+	Params  []ValueType
+	Results []ValueType
+}
+
+// Import is an item imported by the module
+type Import struct {
+	Module    string
+	Name      string
+	Kind      ExternalKind
+	TypeIndex uint32
+}
+
+// Export is an item exported by the module
+type Export struct {
+	Name  string
+	Kind  ExternalKind
+	Index uint32
+}
+
+// Limits are the initial and the optional maximum size of a memory or a table
+type Limits struct {
+	Initial    uint32
+	Maximum    uint32
+	HasMaximum bool
+}
+
+// FunctionBody holds the local declarations and the instructions of a function defined by the module
+type FunctionBody struct {
+	Locals []ValueType
+	Code   []byte
+	Offset int
+}
+
+// Module holds the parts of a WebAssembly module that are relevant for analysis
+type Module struct {
+	Size             int
+	Types            []FunctionType
+	Imports          []Import
+	Functions        []uint32
+	Memories         []Limits
+	Globals          []ValueType
+	Exports          []Export
+	HasStart         bool
+	Bodies           []FunctionBody
+	DataSegmentSizes []int
+	CustomSections   []string
+	APILevel         uint32
+}
+
+// NumImportedFunctions returns the number of imported functions, which come first in the function index space
+func (module *Module) NumImportedFunctions() int {
+	count := 0
+	for _, imp := range module.Imports {
+		if imp.Kind == ExternalFunction {
+			count++
+		}
+	}
+	return count
+}
+
+// FunctionTypeAt returns the signature of the function with the given index, counting imports first
+func (module *Module) FunctionTypeAt(functionIndex uint32) (FunctionType, error) {
+	typeIndex := uint32(0)
+	found := false
+	importIndex := uint32(0)
+	for _, imp := range module.Imports {
+		if imp.Kind != ExternalFunction {
+			continue
+		}
+		if importIndex == functionIndex {
+			typeIndex = imp.TypeIndex
+			found = true
+			break
+		}
+		importIndex++
+	}
+	if !found {
+		definedIndex := functionIndex - importIndex
+		if int(definedIndex) >= len(module.Functions) {
+			return FunctionType{}, ErrInvalidTypeIndex
+		}
+		typeIndex = module.Functions[definedIndex]
+	}
+
+	if int(typeIndex) >= len(module.Types) {
+		return FunctionType{}, ErrInvalidTypeIndex
+	}
+	return module.Types[typeIndex], nil
+}
+
+// ParseModule decodes the sections of a WebAssembly module in the binary format
+func ParseModule(code []byte) (*Module, error) {
+	module := &Module{Size: len(code)}
+	err := walkSections(code, module.parseSection)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(module.Functions) != len(module.Bodies) {
+		return nil, fmt.Errorf("%w: function and code sections have different lengths", ErrInvalidSection)
+	}
+
+	return module, nil
+}
+
+// walkSections checks the module header and calls parseSection with the contents of each section, in order
+func walkSections(code []byte, parseSection func(sectionID byte, contents []byte, sectionOffset int) error) error {
+	r := newReader(code)
+	magic, err := r.readBytes(len(wasmMagic))
+	if err != nil || string(magic) != wasmMagic {
+		return ErrInvalidMagic
+	}
+	version, err := r.readBytes(4)
+	if err != nil || version[0] != wasmVersion || version[1] != 0 || version[2] != 0 || version[3] != 0 {
+		return ErrUnsupportedVersion
+	}
+
+	for !r.isEOF() {
+		sectionID, err := r.readByte()
+		if err != nil {
+			return err
+		}
+		sectionSize, err := r.readU32()
+		if err != nil {
+			return err
+		}
+		sectionOffset := r.offset
+		contents, err := r.readBytes(int(sectionSize))
+		if err != nil {
+			return err
+		}
+
+		err = parseSection(sectionID, contents, sectionOffset)
+		if err != nil {
+			return fmt.Errorf("%w: section %d: %s", ErrInvalidSection, sectionID, err.Error())
+		}
+	}
+
+	return nil
+}
+
+func (module *Module) parseSection(sectionID byte, contents []byte, sectionOffset int) error {
+	r := newReader(contents)
+	var err error
+	switch sectionID {
+	case sectionCustom:
+		var name string
+		name, err = r.readName()
+		if err != nil {
+			return err
+		}
+		module.CustomSections = append(module.CustomSections, name)
+		if name == APILevelSectionName {
+			return module.parseAPILevel(r)
+		}
+		return nil
+	case sectionType:
+		err = parseVector(r, module.parseFunctionType)
+	case sectionImport:
+		err = parseVector(r, module.parseImport)
+	case sectionFunction:
+		err = parseVector(r, func(r *reader) error {
+			typeIndex, err := r.readU32()
+			module.Functions = append(module.Functions, typeIndex)
+			return err
+		})
+	case sectionMemory:
+		err = parseVector(r, func(r *reader) error {
+			limits, err := readLimits(r)
+			module.Memories = append(module.Memories, limits)
+			return err
+		})
+	case sectionGlobal:
+		err = parseVector(r, module.parseGlobal)
+	case sectionExport:
+		err = parseVector(r, module.parseExport)
+	case sectionStart:
+		module.HasStart = true
+		_, err = r.readU32()
+	case sectionCode:
+		err = parseVector(r, func(r *reader) error {
+			return module.parseFunctionBody(r, sectionOffset)
+		})
+	case sectionData:
+		err = parseVector(r, module.parseDataSegment)
+	case sectionTable, sectionElement, sectionDataCnt:
+		return nil
+	default:
+		return fmt.Errorf("unknown section id")
+	}
+	if err != nil {
+		return err
+	}
+	if !r.isEOF() {
+		return fmt.Errorf("unexpected data at the end of the section")
+	}
+	return nil
+}
+
+func parseVector(r *reader, parseItem func(r *reader) error) error {
+	count, err := r.readU32()
+	if err != nil {
+		return err
+	}
+	for i := uint32(0); i < count; i++ {
+		err = parseItem(r)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readValueTypes(r *reader) ([]ValueType, error) {
+	count, err := r.readU32()
+	if err != nil {
+		return nil, err
+	}
+	raw, err := r.readBytes(int(count))
+	if err != nil {
+		return nil, err
+	}
+	valueTypes := make([]ValueType, count)
+	for i, b := range raw {
+		valueTypes[i] = ValueType(b)
+	}
+	return valueTypes, nil
+}
+
+func readLimits(r *reader) (Limits, error) {
+	flags, err := r.readByte()
+	if err != nil {
+		return Limits{}, err
+	}
+	initial, err := r.readU32()
+	if err != nil {
+		return Limits{}, err
+	}
+	limits := Limits{Initial: initial}
+	if flags&0x01 != 0 {
+		limits.Maximum, err = r.readU32()
+		limits.HasMaximum = true
+	}
+	return limits, err
+}
+
+func (module *Module) parseFunctionType(r *reader) error {
+	form, err := r.readByte()
+	if err != nil {
+		return err
+	}
+	if form != functionTypeForm {
+		return fmt.Errorf("invalid function type form 0x%02x", form)
+	}
+	params, err := readValueTypes(r)
+	if err != nil {
+		return err
+	}
+	results, err := readValueTypes(r)
+	if err != nil {
+		return err
+	}
+	module.Types = append(module.Types, FunctionType{Params: params, Results: results})
+	return nil
+}
+
+func (module *Module) parseImport(r *reader) error {
+	moduleName, err := r.readName()
+	if err != nil {
+		return err
+	}
+	name, err := r.readName()
+	if err != nil {
+		return err
+	}
+	kind, err := r.readByte()
+	if err != nil {
+		return err
+	}
+
+	imp := Import{Module: moduleName, Name: name, Kind: ExternalKind(kind)}
+	switch imp.Kind {
+	case ExternalFunction:
+		imp.TypeIndex, err = r.readU32()
+	case ExternalTable:
+		err = r.skip(1)
+		if err == nil {
+			_, err = readLimits(r)
+		}
+	case ExternalMemory:
+		_, err = readLimits(r)
+	case ExternalGlobal:
+		err = r.skip(2)
+	default:
+		err = fmt.Errorf("invalid import kind 0x%02x", kind)
+	}
+	if err != nil {
+		return err
+	}
+
+	module.Imports = append(module.Imports, imp)
+	return nil
+}
+
+func (module *Module) parseGlobal(r *reader) error {
+	valueType, err := r.readByte()
+	if err != nil {
+		return err
+	}
+	err = r.skip(1)
+	if err != nil {
+		return err
+	}
+	err = skipConstantExpression(r)
+	if err != nil {
+		return err
+	}
+	module.Globals = append(module.Globals, ValueType(valueType))
+	return nil
+}
+
+func (module *Module) parseExport(r *reader) error {
+	name, err := r.readName()
+	if err != nil {
+		return err
+	}
+	kind, err := r.readByte()
+	if err != nil {
+		return err
+	}
+	index, err := r.readU32()
+	if err != nil {
+		return err
+	}
+	module.Exports = append(module.Exports, Export{Name: name, Kind: ExternalKind(kind), Index: index})
+	return nil
+}
+
+func (module *Module) parseFunctionBody(r *reader, sectionOffset int) error {
+	bodySize, err := r.readU32()
+	if err != nil {
+		return err
+	}
+	bodyStart := r.offset
+	body, err := r.readBytes(int(bodySize))
+	if err != nil {
+		return err
+	}
+
+	bodyReader := newReader(body)
+	var locals []ValueType
+	err = parseVector(bodyReader, func(r *reader) error {
+		_, err := r.readU32()
+		if err != nil {
+			return err
+		}
+		valueType, err := r.readByte()
+		locals = append(locals, ValueType(valueType))
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	module.Bodies = append(module.Bodies, FunctionBody{
+		Locals: locals,
+		Code:   body[bodyReader.offset:],
+		Offset: sectionOffset + bodyStart + bodyReader.offset,
+	})
+	return nil
+}
+
+func (module *Module) parseDataSegment(r *reader) error {
+	flags, err := r.readU32()
+	if err != nil {
+		return err
+	}
+	switch flags {
+	case 0:
+		err = skipConstantExpression(r)
+	case 1:
+	case 2:
+		_, err = r.readU32()
+		if err == nil {
+			err = skipConstantExpression(r)
+		}
+	default:
+		err = fmt.Errorf("invalid data segment flags %d", flags)
+	}
+	if err != nil {
+		return err
+	}
+
+	size, err := r.readU32()
+	if err != nil {
+		return err
+	}
+	err = r.skip(int(size))
+	if err != nil {
+		return err
+	}
+	module.DataSegmentSizes = append(module.DataSegmentSizes, int(size))
+	return nil
+}
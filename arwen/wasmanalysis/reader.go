@@ -0,0 +1,98 @@
+package wasmanalysis
+
+// reader decodes the primitive encodings of the WebAssembly binary format
+type reader struct {
+	data   []byte
+	offset int
+}
+
+func newReader(data []byte) *reader {
+	return &reader{data: data}
+}
+
+func (r *reader) isEOF() bool {
+	return r.offset >= len(r.data)
+}
+
+func (r *reader) readByte() (byte, error) {
+	if r.isEOF() {
+		return 0, ErrUnexpectedEnd
+	}
+	b := r.data[r.offset]
+	r.offset++
+	return b, nil
+}
+
+func (r *reader) readBytes(length int) ([]byte, error) {
+	if length < 0 || len(r.data)-r.offset < length {
+		return nil, ErrUnexpectedEnd
+	}
+	result := r.data[r.offset : r.offset+length]
+	r.offset += length
+	return result, nil
+}
+
+func (r *reader) skip(length int) error {
+	_, err := r.readBytes(length)
+	return err
+}
+
+// readVarUint decodes an unsigned LEB128 integer of at most maxBits bits
+func (r *reader) readVarUint(maxBits uint) (uint64, error) {
+	result := uint64(0)
+	shift := uint(0)
+	for {
+		b, err := r.readByte()
+		if err != nil {
+			return 0, err
+		}
+		result |= uint64(b&0x7f) << shift
+		shift += 7
+		if b&0x80 == 0 {
+			return result, nil
+		}
+		if shift >= maxBits {
+			return 0, ErrInvalidLEB128
+		}
+	}
+}
+
+// readVarInt decodes a signed LEB128 integer of at most maxBits bits
+func (r *reader) readVarInt(maxBits uint) (int64, error) {
+	result := int64(0)
+	shift := uint(0)
+	for {
+		b, err := r.readByte()
+		if err != nil {
+			return 0, err
+		}
+		result |= int64(b&0x7f) << shift
+		shift += 7
+		if b&0x80 == 0 {
+			if shift < 64 && b&0x40 != 0 {
+				result |= -1 << shift
+			}
+			return result, nil
+		}
+		if shift >= maxBits {
+			return 0, ErrInvalidLEB128
+		}
+	}
+}
+
+func (r *reader) readU32() (uint32, error) {
+	value, err := r.readVarUint(32)
+	return uint32(value), err
+}
+
+func (r *reader) readName() (string, error) {
+	length, err := r.readU32()
+	if err != nil {
+		return "", err
+	}
+	name, err := r.readBytes(int(length))
+	if err != nil {
+		return "", err
+	}
+	return string(name), nil
+}
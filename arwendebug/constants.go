@@ -1,4 +1,13 @@
 package arwendebug
 
+import "github.com/ElrondNetwork/elrond-go-core/core"
+
 // DefaultGasPrice is the default gas price for debugging
 const DefaultGasPrice = 200000000000
+
+// tokenRandomSuffixLength is the length of the random part of the token identifiers
+const tokenRandomSuffixLength = 6
+
+// semiFungibleTokenType is the type of the semi-fungible ESDT instances, following core.NonFungible
+// as in the later versions of the protocol, since the core package only declares the first two types
+const semiFungibleTokenType = core.NonFungible + 1
@@ -6,10 +6,14 @@ import (
 	"io/ioutil"
 	"os"
 	"path"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
 )
 
 type database struct {
-	rootPath string
+	rootPath        string
+	metricsRegistry arwen.MetricsRegistry
+	wasmBackend     arwen.WasmBackend
 }
 
 // newDatabase creates a new debugging database (basically, a folder with JSON files)
@@ -43,7 +47,7 @@ func (db *database) loadWorld(worldID string) (*world, error) {
 		}
 	}
 
-	world, err := newWorld(dataModel)
+	world, err := newWorld(dataModel, db.metricsRegistry, db.wasmBackend)
 	if err != nil {
 		return nil, err
 	}
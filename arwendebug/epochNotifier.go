@@ -0,0 +1,38 @@
+package arwendebug
+
+import (
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+var _ vmcommon.EpochNotifier = (*epochNotifier)(nil)
+
+// epochNotifier lets the simulations override the epoch seen by the VM, e.g. by its epoch dependent flags
+type epochNotifier struct {
+	handlers  []vmcommon.EpochSubscriberHandler
+	epoch     uint32
+	timestamp uint64
+}
+
+// RegisterNotifyHandler registers the handler, and notifies it of the current epoch
+func (notifier *epochNotifier) RegisterNotifyHandler(handler vmcommon.EpochSubscriberHandler) {
+	if handler == nil || handler.IsInterfaceNil() {
+		return
+	}
+
+	notifier.handlers = append(notifier.handlers, handler)
+	handler.EpochConfirmed(notifier.epoch, notifier.timestamp)
+}
+
+// confirmEpoch notifies the handlers of a new epoch
+func (notifier *epochNotifier) confirmEpoch(epoch uint32, timestamp uint64) {
+	notifier.epoch = epoch
+	notifier.timestamp = timestamp
+	for _, handler := range notifier.handlers {
+		handler.EpochConfirmed(epoch, timestamp)
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (notifier *epochNotifier) IsInterfaceNil() bool {
+	return notifier == nil
+}
@@ -44,3 +44,9 @@ var ErrInvalidArgumentEncoding = errors.New("invalid contract argument encoding"
 
 // ErrAccountDoesntExist signals an error
 var ErrAccountDoesntExist = errors.New("account does not exist")
+
+// ErrTokenInstanceDoesntExist signals an error
+var ErrTokenInstanceDoesntExist = errors.New("token instance does not exist")
+
+// ErrMissingNFTCreateRole signals an error
+var ErrMissingNFTCreateRole = errors.New("account does not have the NFT create role")
@@ -3,7 +3,10 @@ package arwendebug
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen/metrics"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 )
 
@@ -11,11 +14,15 @@ var log = logger.GetOrCreate("arwendebug")
 
 // DebugFacade is the debug facade
 type DebugFacade struct {
+	metricsRegistry *metrics.Registry
+	wasmBackend     arwen.WasmBackend
 }
 
 // NewDebugFacade creates a new debug facade
 func NewDebugFacade() *DebugFacade {
-	return &DebugFacade{}
+	return &DebugFacade{
+		metricsRegistry: metrics.NewRegistry(),
+	}
 }
 
 // DeploySmartContract deploys a smart contract
@@ -52,11 +59,24 @@ func (f *DebugFacade) DeploySmartContract(request DeployRequest) (*DeployRespons
 	return response, err
 }
 
+// SetWasmBackend selects the engine that executes the contracts; only the Go backend
+// returns the call stacks of the contracts that trap
+func (f *DebugFacade) SetWasmBackend(wasmBackend arwen.WasmBackend) {
+	f.wasmBackend = wasmBackend
+}
+
 func (f *DebugFacade) loadDatabase(rootPath string) *database {
 	database := newDatabase(rootPath)
+	database.metricsRegistry = f.metricsRegistry
+	database.wasmBackend = f.wasmBackend
 	return database
 }
 
+// WriteMetrics writes the metrics of the VMs run by the facade, in the Prometheus text format
+func (f *DebugFacade) WriteMetrics(writer io.Writer) error {
+	return f.metricsRegistry.WritePrometheus(writer)
+}
+
 // UpgradeSmartContract upgrades a smart contract
 func (f *DebugFacade) UpgradeSmartContract(request UpgradeRequest) (*UpgradeResponse, error) {
 	log.Debug("Debugf.UpgradeSmartContract()")
@@ -155,6 +175,38 @@ func (f *DebugFacade) QuerySmartContract(request QueryRequest) (*QueryResponse,
 	return response, err
 }
 
+// SimulateSmartContract runs a smart contract function on top of temporary overrides of the world, without storing its changes
+func (f *DebugFacade) SimulateSmartContract(request SimulateRequest) (*SimulateResponse, error) {
+	log.Debug("Debugf.SimulateSmartContract()")
+
+	err := request.digest()
+	if err != nil {
+		return nil, err
+	}
+
+	database := f.loadDatabase(request.DatabasePath)
+	world, err := database.loadWorld(request.World)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = world.vm.Close()
+	}()
+
+	response, err := world.simulateSmartContract(request)
+	if err != nil {
+		return nil, err
+	}
+
+	err = database.storeOutcome(request.Outcome, response)
+	if err != nil {
+		return nil, err
+	}
+
+	dumpOutcome(&response)
+	return response, err
+}
+
 // CreateAccount creates a test account
 func (f *DebugFacade) CreateAccount(request CreateAccountRequest) (*CreateAccountResponse, error) {
 	log.Debug("Debugf.CreateAccount()")
@@ -190,6 +242,223 @@ func (f *DebugFacade) CreateAccount(request CreateAccountRequest) (*CreateAccoun
 	return response, err
 }
 
+// IssueToken issues a fungible or non-fungible token, giving its roles and its initial supply to the owner
+func (f *DebugFacade) IssueToken(request IssueTokenRequest) (*IssueTokenResponse, error) {
+	log.Debug("Debugf.IssueToken()")
+
+	err := request.digest()
+	if err != nil {
+		return nil, err
+	}
+
+	database := f.loadDatabase(request.DatabasePath)
+	world, err := database.loadWorld(request.World)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = world.vm.Close()
+	}()
+
+	response, err := world.issueToken(request)
+	if err != nil {
+		return nil, err
+	}
+
+	err = database.storeWorld(world)
+	if err != nil {
+		return nil, err
+	}
+
+	err = database.storeOutcome(request.Outcome, response)
+	if err != nil {
+		return nil, err
+	}
+
+	dumpOutcome(&response)
+	return response, err
+}
+
+// SetTokenRoles sets the ESDT roles of an account for a token
+func (f *DebugFacade) SetTokenRoles(request SetTokenRolesRequest) (*SetTokenRolesResponse, error) {
+	log.Debug("Debugf.SetTokenRoles()")
+
+	err := request.digest()
+	if err != nil {
+		return nil, err
+	}
+
+	database := f.loadDatabase(request.DatabasePath)
+	world, err := database.loadWorld(request.World)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = world.vm.Close()
+	}()
+
+	response, err := world.setTokenRoles(request)
+	if err != nil {
+		return nil, err
+	}
+
+	err = database.storeWorld(world)
+	if err != nil {
+		return nil, err
+	}
+
+	err = database.storeOutcome(request.Outcome, response)
+	if err != nil {
+		return nil, err
+	}
+
+	dumpOutcome(&response)
+	return response, err
+}
+
+// MintTokens adds to the ESDT balance of an account, or to the quantity of one of its NFTs
+func (f *DebugFacade) MintTokens(request MintTokensRequest) (*MintTokensResponse, error) {
+	log.Debug("Debugf.MintTokens()")
+
+	err := request.digest()
+	if err != nil {
+		return nil, err
+	}
+
+	database := f.loadDatabase(request.DatabasePath)
+	world, err := database.loadWorld(request.World)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = world.vm.Close()
+	}()
+
+	response, err := world.mintTokens(request)
+	if err != nil {
+		return nil, err
+	}
+
+	err = database.storeWorld(world)
+	if err != nil {
+		return nil, err
+	}
+
+	err = database.storeOutcome(request.Outcome, response)
+	if err != nil {
+		return nil, err
+	}
+
+	dumpOutcome(&response)
+	return response, err
+}
+
+// CreateNFT creates an NFT, with its attributes and URIs, on an account
+func (f *DebugFacade) CreateNFT(request CreateNFTRequest) (*CreateNFTResponse, error) {
+	log.Debug("Debugf.CreateNFT()")
+
+	err := request.digest()
+	if err != nil {
+		return nil, err
+	}
+
+	database := f.loadDatabase(request.DatabasePath)
+	world, err := database.loadWorld(request.World)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = world.vm.Close()
+	}()
+
+	response, err := world.createNFT(request)
+	if err != nil {
+		return nil, err
+	}
+
+	err = database.storeWorld(world)
+	if err != nil {
+		return nil, err
+	}
+
+	err = database.storeOutcome(request.Outcome, response)
+	if err != nil {
+		return nil, err
+	}
+
+	dumpOutcome(&response)
+	return response, err
+}
+
+// TransferTokens transfers ESDT tokens and NFTs between accounts
+func (f *DebugFacade) TransferTokens(request TransferTokensRequest) (*TransferTokensResponse, error) {
+	log.Debug("Debugf.TransferTokens()")
+
+	err := request.digest()
+	if err != nil {
+		return nil, err
+	}
+
+	database := f.loadDatabase(request.DatabasePath)
+	world, err := database.loadWorld(request.World)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = world.vm.Close()
+	}()
+
+	response, err := world.transferTokensDirectly(request)
+	if err != nil {
+		return nil, err
+	}
+
+	err = database.storeWorld(world)
+	if err != nil {
+		return nil, err
+	}
+
+	err = database.storeOutcome(request.Outcome, response)
+	if err != nil {
+		return nil, err
+	}
+
+	dumpOutcome(&response)
+	return response, err
+}
+
+// GetTokenInventory returns the tokens, NFTs and ESDT roles of an account
+func (f *DebugFacade) GetTokenInventory(request GetTokenInventoryRequest) (*GetTokenInventoryResponse, error) {
+	log.Debug("Debugf.GetTokenInventory()")
+
+	err := request.digest()
+	if err != nil {
+		return nil, err
+	}
+
+	database := f.loadDatabase(request.DatabasePath)
+	world, err := database.loadWorld(request.World)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = world.vm.Close()
+	}()
+
+	response, err := world.getTokenInventoryOfAccount(request)
+	if err != nil {
+		return nil, err
+	}
+
+	err = database.storeOutcome(request.Outcome, response)
+	if err != nil {
+		return nil, err
+	}
+
+	dumpOutcome(&response)
+	return response, err
+}
+
 func dumpOutcome(outcome interface{}) {
 	data, err := json.MarshalIndent(outcome, "", "\t")
 	if err != nil {
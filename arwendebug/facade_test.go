@@ -1,16 +1,21 @@
 package arwendebug
 
 import (
+	"bytes"
 	"os"
 	"testing"
 
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
 	worldmock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/world"
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/stretchr/testify/require"
 )
 
 var databasePath = "./testdata/db"
 var wasmCounterPath = "../test/contracts/counter/output/counter.wasm"
 var wasmErc20Path = "../test/contracts/erc20/output/erc20.wasm"
+var wasmTrapPath = "../test/contracts/trap/output/trap.wasm"
 
 func init() {
 	_ = os.RemoveAll(databasePath)
@@ -104,3 +109,377 @@ func TestFacade_UpgradeContract_CounterToERC20(t *testing.T) {
 	require.Equal(t, int64(90), balanceOfAlice)
 	require.Equal(t, int64(10), balanceOfBob)
 }
+
+func TestFacade_RunContract_TrapBacktrace(t *testing.T) {
+	context := newTestContext(t)
+	context.facade.SetWasmBackend(arwen.GoBackend)
+
+	alice := newDummyAddress("alice")
+	context.createAccount(alice.hex, "42")
+	deployResponse := context.deployContract(wasmTrapPath, alice.hex)
+
+	response, err := context.facade.RunSmartContract(RunRequest{
+		ContractRequestBase: ContractRequestBase{
+			RequestBase:     context.createRequestBase(),
+			ImpersonatedHex: alice.hex,
+			GasLimit:        gasLimit,
+		},
+		ContractAddressHex: deployResponse.ContractAddressHex,
+		Function:           "divideByZero",
+	})
+	require.Nil(t, err)
+	require.Equal(t, vmcommon.ExecutionFailed.String(), response.ReturnCodeString)
+	require.NotNil(t, response.Trap)
+	require.Equal(t, "integer divide by zero", response.Trap.Trap)
+	require.Len(t, response.Trap.Frames, 2)
+	require.Equal(t, "divide", response.Trap.Frames[0].FunctionName)
+	require.Equal(t, "divideByZero", response.Trap.Frames[1].FunctionName)
+}
+
+func TestFacade_WriteMetrics(t *testing.T) {
+	context := newTestContext(t)
+
+	alice := newDummyAddress("alice")
+	context.createAccount(alice.hex, "42")
+	_, err := context.facade.DeploySmartContract(DeployRequest{
+		ContractRequestBase: ContractRequestBase{
+			RequestBase:     context.createRequestBase(),
+			ImpersonatedHex: alice.hex,
+			GasLimit:        gasLimit,
+		},
+		CodePath: wasmCounterPath,
+	})
+	require.Nil(t, err)
+
+	var buffer bytes.Buffer
+	err = context.facade.WriteMetrics(&buffer)
+	require.Nil(t, err)
+	require.Contains(t, buffer.String(), "# TYPE arwen_executions_total counter\n")
+	require.Contains(t, buffer.String(), `arwen_executions_total{entry_point="RunSmartContractCreate",return_code=`)
+}
+
+func TestFacade_SimulateSmartContract(t *testing.T) {
+	context := newTestContext(t)
+
+	alice := newDummyAddress("alice")
+	contract := newDummyAddress("contract")
+	context.createAccount(alice.hex, "42")
+
+	nonce := uint64(7)
+	epoch := uint32(3)
+	request := SimulateRequest{
+		RunRequest: RunRequest{
+			ContractRequestBase: ContractRequestBase{
+				RequestBase:     context.createRequestBase(),
+				ImpersonatedHex: alice.hex,
+				GasLimit:        gasLimit,
+			},
+			ContractAddressHex: contract.hex,
+			Function:           "missing",
+		},
+		Overrides: SimulationOverrides{
+			Accounts: []*AccountOverride{
+				{AddressHex: alice.hex, Balance: "1000", Nonce: &nonce},
+				{
+					AddressHex:   contract.hex,
+					StorageHex:   map[string]string{toHex([]byte("key")): "01"},
+					ESDTBalances: []*ESDTBalanceOverride{{TokenIdentifier: "TOKEN-abcdef", Balance: "5"}},
+				},
+			},
+			Block: &BlockOverride{Epoch: &epoch},
+		},
+	}
+
+	response, err := context.facade.SimulateSmartContract(request)
+	require.Nil(t, err)
+	require.NotNil(t, response.Output)
+	require.Empty(t, response.StateDiff)
+
+	world := context.loadWorld()
+	require.Equal(t, "42", world.blockchainHook.AcctMap.GetAccount(alice.raw).Balance.String())
+	require.Nil(t, world.blockchainHook.AcctMap.GetAccount(contract.raw))
+
+	request.Overrides.Accounts[0].AddressHex = "not hex"
+	_, err = context.facade.SimulateSmartContract(request)
+	require.NotNil(t, err)
+}
+
+func TestWorld_ApplyOverrides(t *testing.T) {
+	context := newTestContext(t)
+	alice := newDummyAddress("alice")
+	context.createAccount(alice.hex, "42")
+
+	nonce := uint64(7)
+	timestamp := uint64(1234)
+	epoch := uint32(3)
+	overrides := SimulationOverrides{
+		Accounts: []*AccountOverride{
+			{
+				AddressHex:   alice.hex,
+				Balance:      "1000",
+				Nonce:        &nonce,
+				CodeHex:      "0061736d01000000",
+				ESDTBalances: []*ESDTBalanceOverride{{TokenIdentifier: "NFT-abcdef", Nonce: 2, Balance: "1"}},
+			},
+		},
+		Block: &BlockOverride{Timestamp: &timestamp, Epoch: &epoch},
+	}
+	require.Nil(t, overrides.digest())
+
+	world := context.loadWorld()
+	require.Nil(t, world.applyOverrides(overrides))
+
+	account := world.blockchainHook.AcctMap.GetAccount(alice.raw)
+	require.Equal(t, "1000", account.Balance.String())
+	require.Equal(t, nonce, account.Nonce)
+	require.True(t, account.IsSmartContract)
+	balance, err := account.GetTokenBalance([]byte("NFT-abcdef"), 2)
+	require.Nil(t, err)
+	require.Equal(t, "1", balance.String())
+	require.Equal(t, timestamp, world.blockchainHook.CurrentTimeStamp())
+	require.Equal(t, epoch, world.blockchainHook.CurrentEpoch())
+
+	handler := &epochHandlerStub{}
+	world.epochNotifier.RegisterNotifyHandler(handler)
+	require.Equal(t, epoch, handler.epoch)
+	require.Equal(t, timestamp, handler.timestamp)
+}
+
+type epochHandlerStub struct {
+	epoch     uint32
+	timestamp uint64
+}
+
+func (handler *epochHandlerStub) EpochConfirmed(epoch uint32, timestamp uint64) {
+	handler.epoch = epoch
+	handler.timestamp = timestamp
+}
+
+func (handler *epochHandlerStub) IsInterfaceNil() bool {
+	return handler == nil
+}
+
+func TestFacade_ESDT(t *testing.T) {
+	context := newTestContext(t)
+
+	alice := newDummyAddress("alice")
+	bob := newDummyAddress("bob")
+	context.createAccount(alice.hex, "42")
+	context.createAccount(bob.hex, "0")
+
+	issueResponse, err := context.facade.IssueToken(IssueTokenRequest{
+		RequestBase:     context.createRequestBase(),
+		OwnerHex:        alice.hex,
+		TokenIdentifier: "FUNG-abcdef",
+		InitialSupply:   "1000",
+	})
+	require.Nil(t, err)
+	require.Len(t, issueResponse.Tokens, 1)
+	require.Equal(t, []string{core.ESDTRoleLocalMint, core.ESDTRoleLocalBurn}, issueResponse.Tokens[0].Roles)
+	require.Equal(t, "1000", issueResponse.Tokens[0].Instances[0].Balance)
+
+	_, err = context.facade.IssueToken(IssueTokenRequest{
+		RequestBase:     context.createRequestBase(),
+		OwnerHex:        alice.hex,
+		TokenIdentifier: "SEMI-abcdef",
+		TokenType:       core.SemiFungibleESDT,
+	})
+	require.Nil(t, err)
+
+	_, err = context.facade.MintTokens(MintTokensRequest{
+		RequestBase: context.createRequestBase(),
+		AddressHex:  alice.hex,
+		ESDTPayment: ESDTPayment{TokenIdentifier: "FUNG-abcdef", Value: "500"},
+	})
+	require.Nil(t, err)
+
+	createResponse, err := context.facade.CreateNFT(CreateNFTRequest{
+		RequestBase:     context.createRequestBase(),
+		AddressHex:      alice.hex,
+		TokenIdentifier: "SEMI-abcdef",
+		Quantity:        "10",
+		Name:            "first",
+		Royalties:       500,
+		AttributesHex:   "0102",
+		URIs:            []string{"https://example.com/1.png"},
+	})
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), createResponse.Nonce)
+
+	_, err = context.facade.MintTokens(MintTokensRequest{
+		RequestBase: context.createRequestBase(),
+		AddressHex:  alice.hex,
+		ESDTPayment: ESDTPayment{TokenIdentifier: "SEMI-abcdef", Nonce: 2, Value: "1"},
+	})
+	require.Equal(t, ErrTokenInstanceDoesntExist, err)
+
+	_, err = context.facade.SetTokenRoles(SetTokenRolesRequest{
+		RequestBase:     context.createRequestBase(),
+		AddressHex:      bob.hex,
+		TokenIdentifier: "SEMI-abcdef",
+		Roles:           []string{core.ESDTRoleNFTBurn},
+	})
+	require.Nil(t, err)
+
+	transferResponse, err := context.facade.TransferTokens(TransferTokensRequest{
+		RequestBase:      context.createRequestBase(),
+		SenderHex:        alice.hex,
+		ReceiverHex:      bob.hex,
+		ESDTPaymentsArgs: []string{"FUNG-abcdef:0:100", "SEMI-abcdef:1:4"},
+	})
+	require.Nil(t, err)
+	require.Equal(t, "1400", transferResponse.Sender.Tokens[0].Instances[0].Balance)
+	require.Equal(t, "6", transferResponse.Sender.Tokens[1].Instances[0].Balance)
+
+	inventoryResponse, err := context.facade.GetTokenInventory(GetTokenInventoryRequest{
+		RequestBase: context.createRequestBase(),
+		AddressHex:  bob.hex,
+	})
+	require.Nil(t, err)
+	require.Equal(t, transferResponse.Receiver, inventoryResponse.TokenInventory)
+	require.Len(t, inventoryResponse.Tokens, 2)
+	require.Equal(t, "FUNG-abcdef", inventoryResponse.Tokens[0].TokenIdentifier)
+	require.Equal(t, "100", inventoryResponse.Tokens[0].Instances[0].Balance)
+
+	nft := inventoryResponse.Tokens[1]
+	require.Equal(t, "SEMI-abcdef", nft.TokenIdentifier)
+	require.Equal(t, []string{core.ESDTRoleNFTBurn}, nft.Roles)
+	require.Equal(t, &TokenInstance{
+		Nonce:         1,
+		Balance:       "4",
+		Name:          "first",
+		CreatorHex:    alice.hex,
+		Royalties:     500,
+		AttributesHex: "0102",
+		URIs:          []string{"https://example.com/1.png"},
+	}, nft.Instances[0])
+}
+
+func TestFacade_CreateNFT_TokenType(t *testing.T) {
+	context := newTestContext(t)
+
+	alice := newDummyAddress("alice")
+	context.createAccount(alice.hex, "42")
+
+	for _, issueRequest := range []IssueTokenRequest{
+		{TokenIdentifier: "NFT-abcdef", TokenType: core.NonFungibleESDT},
+		{TokenIdentifier: "SEMI-abcdef", TokenType: core.SemiFungibleESDT},
+	} {
+		issueRequest.RequestBase = context.createRequestBase()
+		issueRequest.OwnerHex = alice.hex
+		_, err := context.facade.IssueToken(issueRequest)
+		require.Nil(t, err)
+	}
+
+	createNFT := func(tokenIdentifier string, quantity string) core.ESDTType {
+		createResponse, err := context.facade.CreateNFT(CreateNFTRequest{
+			RequestBase:     context.createRequestBase(),
+			AddressHex:      alice.hex,
+			TokenIdentifier: tokenIdentifier,
+			Quantity:        quantity,
+		})
+		require.Nil(t, err)
+
+		account := context.loadWorld().blockchainHook.AcctMap.GetAccount(alice.raw)
+		tokenData, err := account.GetTokenData([]byte(tokenIdentifier), createResponse.Nonce, make(map[string][]byte))
+		require.Nil(t, err)
+		return core.ESDTType(tokenData.Type)
+	}
+
+	require.Equal(t, core.NonFungible, createNFT("NFT-abcdef", "1"))
+	require.Equal(t, semiFungibleTokenType, createNFT("NFT-abcdef", "3"))
+	require.Equal(t, semiFungibleTokenType, createNFT("SEMI-abcdef", "1"))
+	require.Equal(t, semiFungibleTokenType, createNFT("SEMI-abcdef", "10"))
+}
+
+func TestFacade_CreateNFT_RequiresCreateRole(t *testing.T) {
+	context := newTestContext(t)
+
+	alice := newDummyAddress("alice")
+	bob := newDummyAddress("bob")
+	context.createAccount(alice.hex, "42")
+	context.createAccount(bob.hex, "0")
+
+	_, err := context.facade.IssueToken(IssueTokenRequest{
+		RequestBase:     context.createRequestBase(),
+		OwnerHex:        alice.hex,
+		TokenIdentifier: "NFT-abcdef",
+		TokenType:       core.NonFungibleESDT,
+		Roles:           []string{core.ESDTRoleNFTBurn},
+	})
+	require.Nil(t, err)
+
+	createRequest := CreateNFTRequest{
+		RequestBase:     context.createRequestBase(),
+		AddressHex:      alice.hex,
+		TokenIdentifier: "NFT-abcdef",
+	}
+	_, err = context.facade.CreateNFT(createRequest)
+	require.Equal(t, ErrMissingNFTCreateRole, err)
+
+	createRequest.AddressHex = bob.hex
+	_, err = context.facade.CreateNFT(createRequest)
+	require.Equal(t, ErrMissingNFTCreateRole, err)
+
+	_, err = context.facade.SetTokenRoles(SetTokenRolesRequest{
+		RequestBase:     context.createRequestBase(),
+		AddressHex:      bob.hex,
+		TokenIdentifier: "NFT-abcdef",
+		Roles:           []string{core.ESDTRoleNFTCreate},
+	})
+	require.Nil(t, err)
+
+	createResponse, err := context.facade.CreateNFT(createRequest)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), createResponse.Nonce)
+}
+
+func TestFacade_RunContract_ESDTPaymentsRevertedOnFailure(t *testing.T) {
+	context := newTestContext(t)
+
+	alice := newDummyAddress("alice")
+	contract := newDummyAddress("contract")
+	context.createAccount(alice.hex, "42")
+	context.createAccount(contract.hex, "0")
+
+	_, err := context.facade.IssueToken(IssueTokenRequest{
+		RequestBase:     context.createRequestBase(),
+		OwnerHex:        alice.hex,
+		TokenIdentifier: "FUNG-abcdef",
+		InitialSupply:   "1000",
+	})
+	require.Nil(t, err)
+
+	response, err := context.facade.RunSmartContract(RunRequest{
+		ContractRequestBase: ContractRequestBase{
+			RequestBase:     context.createRequestBase(),
+			ImpersonatedHex: alice.hex,
+			GasLimit:        gasLimit,
+		},
+		ContractAddressHex: contract.hex,
+		Function:           "missing",
+		ESDTPayments:       []*ESDTPayment{{TokenIdentifier: "FUNG-abcdef", Value: "100"}},
+	})
+	require.Nil(t, err)
+	require.NotEqual(t, vmcommon.Ok.String(), response.ReturnCodeString)
+	require.Len(t, response.Input.ESDTTransfers, 1)
+
+	world := context.loadWorld()
+	balance, err := world.blockchainHook.AcctMap.GetAccount(alice.raw).GetTokenBalance([]byte("FUNG-abcdef"), 0)
+	require.Nil(t, err)
+	require.Equal(t, "1000", balance.String())
+
+	_, err = context.facade.QuerySmartContract(QueryRequest{
+		RunRequest: RunRequest{
+			ContractRequestBase: ContractRequestBase{
+				RequestBase:     context.createRequestBase(),
+				ImpersonatedHex: alice.hex,
+				GasLimit:        gasLimit,
+			},
+			ContractAddressHex: contract.hex,
+			Function:           "missing",
+			ESDTPaymentsArgs:   []string{"FUNG-abcdef:0:100"},
+		},
+	})
+	require.NotNil(t, err)
+}
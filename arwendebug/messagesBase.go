@@ -3,6 +3,7 @@ package arwendebug
 import (
 	"math/big"
 
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
 	"github.com/ElrondNetwork/elrond-vm-common"
 )
 
@@ -78,12 +79,14 @@ type ContractResponseBase struct {
 	Input            *vmcommon.VMInput
 	Output           *vmcommon.VMOutput
 	ReturnCodeString string
+	Trap             *arwen.TrapBacktrace
 }
 
-func createContractResponseBase(input *vmcommon.VMInput, output *vmcommon.VMOutput) ContractResponseBase {
+func createContractResponseBase(input *vmcommon.VMInput, output *vmcommon.VMOutput, trap *arwen.TrapBacktrace) ContractResponseBase {
 	response := ContractResponseBase{
 		Input:  input,
 		Output: output,
+		Trap:   trap,
 	}
 
 	if output != nil {
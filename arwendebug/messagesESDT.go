@@ -0,0 +1,407 @@
+package arwendebug
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+)
+
+// ESDTPayment is an amount of a fungible token, or of an NFT when the nonce is not 0
+type ESDTPayment struct {
+	TokenIdentifier string
+	Nonce           uint64
+	Value           string
+	ValueAsBigInt   *big.Int
+}
+
+func (payment *ESDTPayment) digest() error {
+	var err error
+
+	err = digestTokenIdentifier(payment.TokenIdentifier)
+	if err != nil {
+		return err
+	}
+
+	payment.ValueAsBigInt, err = parseValue(payment.Value)
+	if err != nil {
+		return err
+	}
+
+	if payment.ValueAsBigInt.Sign() == 0 {
+		return NewRequestError("zero ESDT value")
+	}
+
+	return nil
+}
+
+// digestESDTPayments digests the payments, after appending those given as arguments, in the form TOKEN:NONCE:VALUE
+func digestESDTPayments(payments []*ESDTPayment, paymentsArgs []string) ([]*ESDTPayment, error) {
+	for _, paymentArg := range paymentsArgs {
+		payment, err := parseESDTPayment(paymentArg)
+		if err != nil {
+			return nil, err
+		}
+
+		payments = append(payments, payment)
+	}
+
+	for _, payment := range payments {
+		err := payment.digest()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return payments, nil
+}
+
+func parseESDTPayment(paymentArg string) (*ESDTPayment, error) {
+	parts := strings.Split(paymentArg, ":")
+	if len(parts) != 3 {
+		return nil, NewRequestError("invalid ESDT payment, expected TOKEN:NONCE:VALUE")
+	}
+
+	nonce, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return nil, NewRequestErrorMessageInner("invalid ESDT payment nonce", err)
+	}
+
+	return &ESDTPayment{
+		TokenIdentifier: parts[0],
+		Nonce:           nonce,
+		Value:           parts[2],
+	}, nil
+}
+
+// IssueTokenRequest is a CLI / REST request message
+type IssueTokenRequest struct {
+	RequestBase
+	OwnerHex        string
+	Owner           []byte
+	TokenIdentifier string
+	TokenType       string
+	InitialSupply   string
+	SupplyAsBigInt  *big.Int
+	Roles           []string
+}
+
+func (request *IssueTokenRequest) digest() error {
+	var err error
+
+	err = request.RequestBase.digest()
+	if err != nil {
+		return err
+	}
+
+	request.Owner, err = digestAccountAddress(request.OwnerHex)
+	if err != nil {
+		return err
+	}
+
+	err = digestTokenIdentifier(request.TokenIdentifier)
+	if err != nil {
+		return err
+	}
+
+	if len(request.TokenType) == 0 {
+		request.TokenType = core.FungibleESDT
+	}
+
+	defaultRoles, ok := defaultTokenRoles[request.TokenType]
+	if !ok {
+		return NewRequestError("invalid token type")
+	}
+
+	if len(request.Roles) == 0 {
+		request.Roles = defaultRoles
+	}
+
+	request.SupplyAsBigInt, err = parseValue(request.InitialSupply)
+	if err != nil {
+		return err
+	}
+
+	if request.SupplyAsBigInt.Sign() > 0 && request.TokenType != core.FungibleESDT {
+		return NewRequestError("initial supply is only allowed for fungible tokens")
+	}
+
+	return nil
+}
+
+// defaultTokenRoles are the roles given to the owner at issue, unless the request specifies them
+var defaultTokenRoles = map[string][]string{
+	core.FungibleESDT:     {core.ESDTRoleLocalMint, core.ESDTRoleLocalBurn},
+	core.NonFungibleESDT:  {core.ESDTRoleNFTCreate, core.ESDTRoleNFTBurn},
+	core.SemiFungibleESDT: {core.ESDTRoleNFTCreate, core.ESDTRoleNFTBurn, core.ESDTRoleNFTAddQuantity},
+}
+
+// IssueTokenResponse is a CLI / REST response message
+type IssueTokenResponse struct {
+	TokenInventory
+}
+
+// SetTokenRolesRequest is a CLI / REST request message
+type SetTokenRolesRequest struct {
+	RequestBase
+	AddressHex      string
+	Address         []byte
+	TokenIdentifier string
+	Roles           []string
+}
+
+func (request *SetTokenRolesRequest) digest() error {
+	var err error
+
+	err = request.RequestBase.digest()
+	if err != nil {
+		return err
+	}
+
+	request.Address, err = digestAccountAddress(request.AddressHex)
+	if err != nil {
+		return err
+	}
+
+	err = digestTokenIdentifier(request.TokenIdentifier)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SetTokenRolesResponse is a CLI / REST response message
+type SetTokenRolesResponse struct {
+	TokenInventory
+}
+
+// MintTokensRequest is a CLI / REST request message
+type MintTokensRequest struct {
+	RequestBase
+	AddressHex string
+	Address    []byte
+	ESDTPayment
+}
+
+func (request *MintTokensRequest) digest() error {
+	var err error
+
+	err = request.RequestBase.digest()
+	if err != nil {
+		return err
+	}
+
+	request.Address, err = digestAccountAddress(request.AddressHex)
+	if err != nil {
+		return err
+	}
+
+	return request.ESDTPayment.digest()
+}
+
+// MintTokensResponse is a CLI / REST response message
+type MintTokensResponse struct {
+	TokenInventory
+}
+
+// CreateNFTRequest is a CLI / REST request message
+type CreateNFTRequest struct {
+	RequestBase
+	AddressHex       string
+	Address          []byte
+	TokenIdentifier  string
+	Quantity         string
+	QuantityAsBigInt *big.Int
+	Name             string
+	Royalties        uint32
+	HashHex          string
+	Hash             []byte
+	AttributesHex    string
+	Attributes       []byte
+	URIs             []string
+}
+
+func (request *CreateNFTRequest) digest() error {
+	var err error
+
+	err = request.RequestBase.digest()
+	if err != nil {
+		return err
+	}
+
+	request.Address, err = digestAccountAddress(request.AddressHex)
+	if err != nil {
+		return err
+	}
+
+	err = digestTokenIdentifier(request.TokenIdentifier)
+	if err != nil {
+		return err
+	}
+
+	if len(request.Quantity) == 0 {
+		request.Quantity = "1"
+	}
+
+	request.QuantityAsBigInt, err = parseValue(request.Quantity)
+	if err != nil {
+		return err
+	}
+
+	if request.QuantityAsBigInt.Sign() == 0 {
+		return NewRequestError("zero NFT quantity")
+	}
+
+	if request.Royalties > core.MaxRoyalty {
+		return NewRequestError("invalid NFT royalties")
+	}
+
+	request.Hash, err = fromHex(request.HashHex)
+	if err != nil {
+		return NewRequestErrorMessageInner("invalid NFT hash", err)
+	}
+
+	request.Attributes, err = fromHex(request.AttributesHex)
+	if err != nil {
+		return NewRequestErrorMessageInner("invalid NFT attributes", err)
+	}
+
+	return nil
+}
+
+// CreateNFTResponse is a CLI / REST response message
+type CreateNFTResponse struct {
+	TokenInventory
+	Nonce uint64
+}
+
+// TransferTokensRequest is a CLI / REST request message
+type TransferTokensRequest struct {
+	RequestBase
+	SenderHex        string
+	Sender           []byte
+	ReceiverHex      string
+	Receiver         []byte
+	ESDTPayments     []*ESDTPayment
+	ESDTPaymentsArgs []string
+}
+
+func (request *TransferTokensRequest) digest() error {
+	var err error
+
+	err = request.RequestBase.digest()
+	if err != nil {
+		return err
+	}
+
+	request.Sender, err = digestAccountAddress(request.SenderHex)
+	if err != nil {
+		return err
+	}
+
+	request.Receiver, err = digestAccountAddress(request.ReceiverHex)
+	if err != nil {
+		return err
+	}
+
+	request.ESDTPayments, err = digestESDTPayments(request.ESDTPayments, request.ESDTPaymentsArgs)
+	if err != nil {
+		return err
+	}
+
+	if len(request.ESDTPayments) == 0 {
+		return NewRequestError("no ESDT payments")
+	}
+
+	return nil
+}
+
+// TransferTokensResponse is a CLI / REST response message
+type TransferTokensResponse struct {
+	Sender   TokenInventory
+	Receiver TokenInventory
+}
+
+// GetTokenInventoryRequest is a CLI / REST request message
+type GetTokenInventoryRequest struct {
+	RequestBase
+	AddressHex string
+	Address    []byte
+}
+
+func (request *GetTokenInventoryRequest) digest() error {
+	var err error
+
+	err = request.RequestBase.digest()
+	if err != nil {
+		return err
+	}
+
+	request.Address, err = digestAccountAddress(request.AddressHex)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetTokenInventoryResponse is a CLI / REST response message
+type GetTokenInventoryResponse struct {
+	TokenInventory
+}
+
+// TokenInventory holds the tokens of an account, ordered by identifier
+type TokenInventory struct {
+	AddressHex string
+	Tokens     []*TokenHolding
+}
+
+// TokenHolding holds the roles and the balances of an account for a token; the instances are ordered by nonce
+type TokenHolding struct {
+	TokenIdentifier string
+	Roles           []string
+	LastNonce       uint64
+	Instances       []*TokenInstance
+}
+
+// TokenInstance is the balance of a fungible token (nonce 0), or the quantity and the metadata of an NFT
+type TokenInstance struct {
+	Nonce         uint64
+	Balance       string
+	Name          string
+	CreatorHex    string
+	Royalties     uint32
+	HashHex       string
+	AttributesHex string
+	URIs          []string
+}
+
+func digestAccountAddress(addressHex string) ([]byte, error) {
+	if len(addressHex) == 0 {
+		return nil, NewRequestError("empty account address")
+	}
+
+	address, err := fromHex(addressHex)
+	if err != nil {
+		return nil, NewRequestErrorMessageInner("invalid account address", err)
+	}
+
+	return address, nil
+}
+
+// digestTokenIdentifier checks that the identifier has the form TICKER-random, which the inventory relies
+// on to tell the token from the nonce in the storage keys of the NFTs
+func digestTokenIdentifier(tokenIdentifier string) error {
+	if len(tokenIdentifier) == 0 {
+		return NewRequestError("empty token identifier")
+	}
+
+	separatorIndex := strings.LastIndex(tokenIdentifier, "-")
+	if separatorIndex <= 0 || len(tokenIdentifier)-separatorIndex-1 != tokenRandomSuffixLength {
+		return NewRequestError("invalid token identifier, expected TICKER-random with a 6 characters random part")
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package arwendebug
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDigestESDTPayments(t *testing.T) {
+	payments, err := digestESDTPayments(
+		[]*ESDTPayment{{TokenIdentifier: "FUNG-abcdef", Value: "10"}},
+		[]string{"NFT-123456:3:1"},
+	)
+	require.Nil(t, err)
+	require.Len(t, payments, 2)
+	require.Equal(t, "10", payments[0].ValueAsBigInt.String())
+	require.Equal(t, "NFT-123456", payments[1].TokenIdentifier)
+	require.Equal(t, uint64(3), payments[1].Nonce)
+	require.Equal(t, "1", payments[1].ValueAsBigInt.String())
+
+	_, err = digestESDTPayments(nil, []string{"NFT-123456:3"})
+	require.NotNil(t, err)
+
+	_, err = digestESDTPayments(nil, []string{"NFT-123456:x:1"})
+	require.NotNil(t, err)
+
+	_, err = digestESDTPayments(nil, []string{"NFT:0:1"})
+	require.NotNil(t, err)
+
+	_, err = digestESDTPayments(nil, []string{"FUNG-abcdef:0:0"})
+	require.NotNil(t, err)
+}
@@ -8,6 +8,8 @@ type RunRequest struct {
 	Function           string
 	ArgumentsHex       []string
 	Arguments          [][]byte
+	ESDTPayments       []*ESDTPayment
+	ESDTPaymentsArgs   []string
 }
 
 func (request *RunRequest) digest() error {
@@ -26,6 +28,11 @@ func (request *RunRequest) digest() error {
 		return err
 	}
 
+	request.ESDTPayments, err = digestESDTPayments(request.ESDTPayments, request.ESDTPaymentsArgs)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
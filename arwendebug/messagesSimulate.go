@@ -0,0 +1,181 @@
+package arwendebug
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+)
+
+// SimulateRequest is a CLI / REST request message
+type SimulateRequest struct {
+	RunRequest
+	Overrides     SimulationOverrides
+	OverridesPath string
+}
+
+func (request *SimulateRequest) digest() error {
+	err := request.RunRequest.digest()
+	if err != nil {
+		return err
+	}
+
+	if len(request.OverridesPath) > 0 {
+		data, err := ioutil.ReadFile(request.OverridesPath)
+		if err != nil {
+			return err
+		}
+
+		err = json.Unmarshal(data, &request.Overrides)
+		if err != nil {
+			return NewRequestErrorMessageInner("invalid overrides file", err)
+		}
+	}
+
+	return request.Overrides.digest()
+}
+
+// SimulationOverrides are the changes of the world that only last for a simulation
+type SimulationOverrides struct {
+	Accounts []*AccountOverride
+	Block    *BlockOverride
+}
+
+func (overrides *SimulationOverrides) digest() error {
+	for _, account := range overrides.Accounts {
+		err := account.digest()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AccountOverride overrides the state of an account, which is created if needed; the empty fields are left unchanged
+type AccountOverride struct {
+	AddressHex      string
+	Address         []byte
+	Balance         string
+	BalanceAsBigInt *big.Int
+	Nonce           *uint64
+	CodeHex         string
+	CodePath        string
+	Code            []byte
+	StorageHex      map[string]string
+	Storage         map[string][]byte
+	ESDTBalances    []*ESDTBalanceOverride
+}
+
+func (override *AccountOverride) digest() error {
+	var err error
+
+	if len(override.AddressHex) == 0 {
+		return NewRequestError("empty account address")
+	}
+
+	override.Address, err = fromHex(override.AddressHex)
+	if err != nil {
+		return NewRequestErrorMessageInner("invalid account address", err)
+	}
+
+	if len(override.Balance) > 0 {
+		override.BalanceAsBigInt, err = parseValue(override.Balance)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(override.CodeHex) > 0 {
+		override.Code, err = fromHex(override.CodeHex)
+		if err != nil {
+			return NewRequestErrorMessageInner("invalid contract code", err)
+		}
+	}
+
+	if len(override.CodePath) > 0 {
+		override.Code, err = ioutil.ReadFile(override.CodePath)
+		if err != nil {
+			return err
+		}
+	}
+
+	override.Storage = make(map[string][]byte, len(override.StorageHex))
+	for keyHex, valueHex := range override.StorageHex {
+		key, err := fromHex(keyHex)
+		if err != nil {
+			return NewRequestErrorMessageInner("invalid storage key", err)
+		}
+
+		override.Storage[string(key)], err = fromHex(valueHex)
+		if err != nil {
+			return NewRequestErrorMessageInner("invalid storage value", err)
+		}
+	}
+
+	for _, esdtBalance := range override.ESDTBalances {
+		err = esdtBalance.digest()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ESDTBalanceOverride overrides the balance of a fungible token, or of an NFT when the nonce is not 0
+type ESDTBalanceOverride struct {
+	TokenIdentifier string
+	Nonce           uint64
+	Balance         string
+	BalanceAsBigInt *big.Int
+}
+
+func (override *ESDTBalanceOverride) digest() error {
+	var err error
+
+	if len(override.TokenIdentifier) == 0 {
+		return NewRequestError("empty token identifier")
+	}
+
+	override.BalanceAsBigInt, err = parseValue(override.Balance)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// BlockOverride overrides the current block; the missing fields are left unchanged.
+// The epoch is seen by the contracts, and also enables the epoch dependent features of the VM.
+type BlockOverride struct {
+	Timestamp *uint64
+	Nonce     *uint64
+	Round     *uint64
+	Epoch     *uint32
+}
+
+// SimulateResponse is a CLI / REST response message
+type SimulateResponse struct {
+	ContractResponseBase
+	StateDiff []*AccountDiff
+}
+
+// AccountDiff is the change of an account made by a simulated call
+type AccountDiff struct {
+	AddressHex     string
+	Created        bool
+	BalanceBefore  string
+	BalanceAfter   string
+	NonceBefore    uint64
+	NonceAfter     uint64
+	CodeHashBefore string
+	CodeHashAfter  string
+	Storage        []*StorageDiff
+}
+
+// StorageDiff is the change of a storage key, including the keys of the ESDT data
+type StorageDiff struct {
+	KeyHex         string
+	ValueBeforeHex string
+	ValueAfterHex  string
+}
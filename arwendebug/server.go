@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen/metrics"
 	"github.com/gin-gonic/gin"
 )
 
@@ -32,6 +33,14 @@ func (server *DebugServer) Start() error {
 	router.POST("/upgrade", server.handleUpgrade)
 	router.POST("/run", server.handleRun)
 	router.POST("/query", server.handleQuery)
+	router.POST("/simulate", server.handleSimulate)
+	router.POST("/esdt/issue", server.handleIssueToken)
+	router.POST("/esdt/roles", server.handleSetTokenRoles)
+	router.POST("/esdt/mint", server.handleMintTokens)
+	router.POST("/esdt/nft", server.handleCreateNFT)
+	router.POST("/esdt/transfer", server.handleTransferTokens)
+	router.POST("/esdt/inventory", server.handleGetTokenInventory)
+	router.GET("/metrics", server.handleMetrics)
 
 	return router.Run(server.address)
 }
@@ -126,6 +135,142 @@ func (server *DebugServer) handleQuery(ginContext *gin.Context) {
 	returnOkResponse(ginContext, response)
 }
 
+func (server *DebugServer) handleSimulate(ginContext *gin.Context) {
+	request := SimulateRequest{}
+
+	err := ginContext.ShouldBindJSON(&request)
+	if err != nil {
+		returnBadRequest(ginContext, "handleSimulate.ShouldBindJSON", err)
+		return
+	}
+
+	response, err := server.facade.SimulateSmartContract(request)
+	if err != nil {
+		returnBadRequest(ginContext, "handleSimulate.SimulateSmartContract", err)
+		return
+	}
+
+	returnOkResponse(ginContext, response)
+}
+
+func (server *DebugServer) handleIssueToken(ginContext *gin.Context) {
+	request := IssueTokenRequest{}
+
+	err := ginContext.ShouldBindJSON(&request)
+	if err != nil {
+		returnBadRequest(ginContext, "handleIssueToken.ShouldBindJSON", err)
+		return
+	}
+
+	response, err := server.facade.IssueToken(request)
+	if err != nil {
+		returnBadRequest(ginContext, "handleIssueToken.IssueToken", err)
+		return
+	}
+
+	returnOkResponse(ginContext, response)
+}
+
+func (server *DebugServer) handleSetTokenRoles(ginContext *gin.Context) {
+	request := SetTokenRolesRequest{}
+
+	err := ginContext.ShouldBindJSON(&request)
+	if err != nil {
+		returnBadRequest(ginContext, "handleSetTokenRoles.ShouldBindJSON", err)
+		return
+	}
+
+	response, err := server.facade.SetTokenRoles(request)
+	if err != nil {
+		returnBadRequest(ginContext, "handleSetTokenRoles.SetTokenRoles", err)
+		return
+	}
+
+	returnOkResponse(ginContext, response)
+}
+
+func (server *DebugServer) handleMintTokens(ginContext *gin.Context) {
+	request := MintTokensRequest{}
+
+	err := ginContext.ShouldBindJSON(&request)
+	if err != nil {
+		returnBadRequest(ginContext, "handleMintTokens.ShouldBindJSON", err)
+		return
+	}
+
+	response, err := server.facade.MintTokens(request)
+	if err != nil {
+		returnBadRequest(ginContext, "handleMintTokens.MintTokens", err)
+		return
+	}
+
+	returnOkResponse(ginContext, response)
+}
+
+func (server *DebugServer) handleCreateNFT(ginContext *gin.Context) {
+	request := CreateNFTRequest{}
+
+	err := ginContext.ShouldBindJSON(&request)
+	if err != nil {
+		returnBadRequest(ginContext, "handleCreateNFT.ShouldBindJSON", err)
+		return
+	}
+
+	response, err := server.facade.CreateNFT(request)
+	if err != nil {
+		returnBadRequest(ginContext, "handleCreateNFT.CreateNFT", err)
+		return
+	}
+
+	returnOkResponse(ginContext, response)
+}
+
+func (server *DebugServer) handleTransferTokens(ginContext *gin.Context) {
+	request := TransferTokensRequest{}
+
+	err := ginContext.ShouldBindJSON(&request)
+	if err != nil {
+		returnBadRequest(ginContext, "handleTransferTokens.ShouldBindJSON", err)
+		return
+	}
+
+	response, err := server.facade.TransferTokens(request)
+	if err != nil {
+		returnBadRequest(ginContext, "handleTransferTokens.TransferTokens", err)
+		return
+	}
+
+	returnOkResponse(ginContext, response)
+}
+
+func (server *DebugServer) handleGetTokenInventory(ginContext *gin.Context) {
+	request := GetTokenInventoryRequest{}
+
+	err := ginContext.ShouldBindJSON(&request)
+	if err != nil {
+		returnBadRequest(ginContext, "handleGetTokenInventory.ShouldBindJSON", err)
+		return
+	}
+
+	response, err := server.facade.GetTokenInventory(request)
+	if err != nil {
+		returnBadRequest(ginContext, "handleGetTokenInventory.GetTokenInventory", err)
+		return
+	}
+
+	returnOkResponse(ginContext, response)
+}
+
+func (server *DebugServer) handleMetrics(ginContext *gin.Context) {
+	ginContext.Header("Content-Type", metrics.PrometheusContentType)
+	ginContext.Status(http.StatusOK)
+
+	err := server.facade.WriteMetrics(ginContext.Writer)
+	if err != nil {
+		log.Error("handleMetrics.WriteMetrics", "err", err)
+	}
+}
+
 func returnBadRequest(context *gin.Context, errScope string, err error) {
 	context.JSON(http.StatusBadRequest, gin.H{
 		"error":        fmt.Sprintf("%T", err),
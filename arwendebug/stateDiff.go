@@ -0,0 +1,85 @@
+package arwendebug
+
+import (
+	"bytes"
+	"sort"
+
+	worldmock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/world"
+)
+
+// computeStateDiff returns the accounts changed between the two states, ordered by address
+func computeStateDiff(before worldmock.AccountMap, after worldmock.AccountMap) []*AccountDiff {
+	addresses := make([]string, 0, len(after))
+	for address := range after {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	diffs := make([]*AccountDiff, 0)
+	for _, address := range addresses {
+		diff := diffAccount(before[address], after[address])
+		if diff != nil {
+			diffs = append(diffs, diff)
+		}
+	}
+
+	return diffs
+}
+
+// diffAccount returns the change of an account, or nil if it did not change; the account did not exist before if nil
+func diffAccount(before *worldmock.Account, after *worldmock.Account) *AccountDiff {
+	diff := &AccountDiff{
+		AddressHex:    toHex(after.Address),
+		Created:       before == nil,
+		BalanceAfter:  after.Balance.String(),
+		NonceAfter:    after.Nonce,
+		CodeHashAfter: toHex(after.CodeHash),
+	}
+
+	beforeStorage := make(map[string][]byte)
+	if before != nil {
+		diff.BalanceBefore = before.Balance.String()
+		diff.NonceBefore = before.Nonce
+		diff.CodeHashBefore = toHex(before.CodeHash)
+		beforeStorage = before.Storage
+	}
+
+	diff.Storage = diffStorage(beforeStorage, after.Storage)
+
+	changed := diff.Created ||
+		diff.BalanceBefore != diff.BalanceAfter ||
+		diff.NonceBefore != diff.NonceAfter ||
+		diff.CodeHashBefore != diff.CodeHashAfter ||
+		len(diff.Storage) > 0
+	if !changed {
+		return nil
+	}
+
+	return diff
+}
+
+func diffStorage(before map[string][]byte, after map[string][]byte) []*StorageDiff {
+	keys := make([]string, 0)
+	for key, value := range after {
+		if !bytes.Equal(before[key], value) {
+			keys = append(keys, key)
+		}
+	}
+	for key := range before {
+		if _, ok := after[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	diffs := make([]*StorageDiff, 0, len(keys))
+	for _, key := range keys {
+		diffs = append(diffs, &StorageDiff{
+			KeyHex:         toHex([]byte(key)),
+			ValueBeforeHex: toHex(before[key]),
+			ValueAfterHex:  toHex(after[key]),
+		})
+	}
+
+	return diffs
+}
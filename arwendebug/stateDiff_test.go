@@ -0,0 +1,49 @@
+package arwendebug
+
+import (
+	"math/big"
+	"testing"
+
+	worldmock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/world"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeStateDiff(t *testing.T) {
+	before := worldmock.NewAccountMap()
+	alice := before.CreateAccount([]byte("alice"), nil)
+	alice.Balance = big.NewInt(100)
+	alice.Storage["kept"] = []byte{1}
+	alice.Storage["changed"] = []byte{2}
+	alice.Storage["deleted"] = []byte{3}
+	before.CreateAccount([]byte("bob"), nil)
+
+	after := before.Clone()
+	alice = after.GetAccount([]byte("alice"))
+	alice.Balance = big.NewInt(90)
+	alice.Nonce = 1
+	alice.Storage["changed"] = []byte{4}
+	delete(alice.Storage, "deleted")
+	alice.Storage["added"] = []byte{5}
+	after.CreateAccount([]byte("carol"), nil)
+
+	diffs := computeStateDiff(before, after)
+	require.Len(t, diffs, 2)
+
+	aliceDiff := diffs[0]
+	require.Equal(t, toHex([]byte("alice")), aliceDiff.AddressHex)
+	require.False(t, aliceDiff.Created)
+	require.Equal(t, "100", aliceDiff.BalanceBefore)
+	require.Equal(t, "90", aliceDiff.BalanceAfter)
+	require.Equal(t, uint64(1), aliceDiff.NonceAfter)
+	require.Equal(t, []*StorageDiff{
+		{KeyHex: toHex([]byte("added")), ValueBeforeHex: "", ValueAfterHex: "05"},
+		{KeyHex: toHex([]byte("changed")), ValueBeforeHex: "02", ValueAfterHex: "04"},
+		{KeyHex: toHex([]byte("deleted")), ValueBeforeHex: "03", ValueAfterHex: ""},
+	}, aliceDiff.Storage)
+
+	carolDiff := diffs[1]
+	require.Equal(t, toHex([]byte("carol")), carolDiff.AddressHex)
+	require.True(t, carolDiff.Created)
+	require.Equal(t, "", carolDiff.BalanceBefore)
+	require.Equal(t, "0", carolDiff.BalanceAfter)
+}
@@ -8,7 +8,6 @@ import (
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/config"
 	worldmock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/world"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
-	"github.com/ElrondNetwork/elrond-vm-common/builtInFunctions"
 	"github.com/ElrondNetwork/elrond-vm-common/parsers"
 )
 
@@ -20,7 +19,8 @@ type worldDataModel struct {
 type world struct {
 	id             string
 	blockchainHook *worldmock.MockWorld
-	vm             vmcommon.VMExecutionHandler
+	epochNotifier  *epochNotifier
+	vm             arwen.VMHost
 }
 
 func newWorldDataModel(worldID string) *worldDataModel {
@@ -30,14 +30,27 @@ func newWorldDataModel(worldID string) *worldDataModel {
 	}
 }
 
-// newWorld creates a new debugging world
-func newWorld(dataModel *worldDataModel) (*world, error) {
+// newWorld creates a new debugging world, whose VM executes the contracts with the given backend
+// and records its metrics in the given registry, if any
+func newWorld(dataModel *worldDataModel, metricsRegistry arwen.MetricsRegistry, wasmBackend arwen.WasmBackend) (*world, error) {
 	blockchainHook := worldmock.NewMockWorld()
-	blockchainHook.AcctMap = dataModel.Accounts
+	// the accounts are keyed again by their address, because the JSON keys of the addresses
+	// which are not valid UTF-8 (e.g. the system account, holding the NFT metadata) are mangled
+	for _, account := range dataModel.Accounts {
+		account.MockWorld = blockchainHook
+		blockchainHook.AcctMap.PutAccount(account)
+	}
 
+	gasSchedule := config.MakeGasMap(1, 1)
+	err := blockchainHook.InitBuiltinFunctions(gasSchedule)
+	if err != nil {
+		return nil, err
+	}
+
+	notifier := &epochNotifier{}
 	vm, err := host.NewArwenVM(
 		blockchainHook,
-		getHostParameters(),
+		getHostParameters(gasSchedule, blockchainHook.BuiltinFuncs.Container, notifier, metricsRegistry, wasmBackend),
 	)
 	if err != nil {
 		return nil, err
@@ -46,21 +59,30 @@ func newWorld(dataModel *worldDataModel) (*world, error) {
 	return &world{
 		id:             dataModel.ID,
 		blockchainHook: blockchainHook,
+		epochNotifier:  notifier,
 		vm:             vm,
 	}, nil
 }
 
-func getHostParameters() *arwen.VMHostParameters {
+func getHostParameters(
+	gasSchedule config.GasScheduleMap,
+	builtInFuncContainer vmcommon.BuiltInFunctionContainer,
+	epochNotifier vmcommon.EpochNotifier,
+	metricsRegistry arwen.MetricsRegistry,
+	wasmBackend arwen.WasmBackend,
+) *arwen.VMHostParameters {
 	esdtTransferParser, _ := parsers.NewESDTTransferParser(worldmock.WorldMarshalizer)
 	return &arwen.VMHostParameters{
 		VMType:                   []byte{5, 0},
 		BlockGasLimit:            uint64(10000000),
-		GasSchedule:              config.MakeGasMap(1, 1),
+		GasSchedule:              gasSchedule,
 		ElrondProtectedKeyPrefix: []byte("ELROND"),
-		BuiltInFuncContainer:     builtInFunctions.NewBuiltInFunctionContainer(),
+		BuiltInFuncContainer:     builtInFuncContainer,
 		ESDTTransferParser:       esdtTransferParser,
-		EpochNotifier:            &worldmock.EpochNotifierStub{},
+		EpochNotifier:            epochNotifier,
 		WasmerSIGSEGVPassthrough: false,
+		MetricsRegistry:          metricsRegistry,
+		WasmBackend:              wasmBackend,
 	}
 }
 
@@ -74,7 +96,7 @@ func (w *world) deploySmartContract(request DeployRequest) *DeployResponse {
 	}
 
 	response := &DeployResponse{}
-	response.ContractResponseBase = createContractResponseBase(&input.VMInput, vmOutput)
+	response.ContractResponseBase = createContractResponseBase(&input.VMInput, vmOutput, w.vm.Runtime().GetTrapBacktrace())
 	response.Error = err
 	response.ContractAddress = w.blockchainHook.LastCreatedContractAddress
 	response.ContractAddressHex = toHex(response.ContractAddress)
@@ -91,7 +113,7 @@ func (w *world) upgradeSmartContract(request UpgradeRequest) *UpgradeResponse {
 	}
 
 	response := &UpgradeResponse{}
-	response.ContractResponseBase = createContractResponseBase(&input.VMInput, vmOutput)
+	response.ContractResponseBase = createContractResponseBase(&input.VMInput, vmOutput, w.vm.Runtime().GetTrapBacktrace())
 	response.Error = err
 
 	return response
@@ -101,18 +123,41 @@ func (w *world) runSmartContract(request RunRequest) *RunResponse {
 	input := w.prepareCallInput(request)
 	log.Trace("w.runSmartContract()", "input", prettyJson(input))
 
-	vmOutput, err := w.vm.RunSmartContractCall(input)
+	vmOutput, err := w.executeCall(request, input)
 	if err == nil {
 		_ = w.blockchainHook.UpdateAccounts(vmOutput.OutputAccounts, nil)
 	}
 
 	response := &RunResponse{}
-	response.ContractResponseBase = createContractResponseBase(&input.VMInput, vmOutput)
+	response.ContractResponseBase = createContractResponseBase(&input.VMInput, vmOutput, w.vm.Runtime().GetTrapBacktrace())
 	response.Error = err
 
 	return response
 }
 
+// executeCall sends the ESDT payments of the request, if any, then runs the call with the remaining gas;
+// the payments are reverted if the call fails
+func (w *world) executeCall(request RunRequest, input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+	if len(request.ESDTPayments) == 0 {
+		return w.vm.RunSmartContractCall(input)
+	}
+
+	accountsBeforePayments := w.blockchainHook.AcctMap.Clone()
+	gasRemaining, err := w.transferTokens(request.Impersonated, request.ContractAddress, request.ESDTPayments, input.GasProvided, input.GasPrice)
+	if err != nil {
+		w.blockchainHook.AcctMap = accountsBeforePayments
+		return nil, err
+	}
+
+	input.GasProvided = gasRemaining
+	vmOutput, err := w.vm.RunSmartContractCall(input)
+	if err != nil || vmOutput.ReturnCode != vmcommon.Ok {
+		w.blockchainHook.AcctMap = accountsBeforePayments
+	}
+
+	return vmOutput, err
+}
+
 func (w *world) querySmartContract(request QueryRequest) *QueryResponse {
 	input := w.prepareCallInput(request.RunRequest)
 	log.Trace("w.querySmartContract()", "input", prettyJson(input))
@@ -120,12 +165,108 @@ func (w *world) querySmartContract(request QueryRequest) *QueryResponse {
 	vmOutput, err := w.vm.RunSmartContractCall(input)
 
 	response := &QueryResponse{}
-	response.ContractResponseBase = createContractResponseBase(&input.VMInput, vmOutput)
+	response.ContractResponseBase = createContractResponseBase(&input.VMInput, vmOutput, w.vm.Runtime().GetTrapBacktrace())
 	response.Error = err
 
 	return response
 }
 
+// simulateSmartContract runs the call on top of the overrides, and returns the changes of the accounts;
+// the world must not be stored afterwards
+func (w *world) simulateSmartContract(request SimulateRequest) (*SimulateResponse, error) {
+	err := w.applyOverrides(request.Overrides)
+	if err != nil {
+		return nil, err
+	}
+
+	input := w.prepareCallInput(request.RunRequest)
+	log.Trace("w.simulateSmartContract()", "input", prettyJson(input))
+
+	stateBefore := w.blockchainHook.AcctMap.Clone()
+	vmOutput, err := w.executeCall(request.RunRequest, input)
+	if err == nil {
+		_ = w.blockchainHook.UpdateAccounts(vmOutput.OutputAccounts, nil)
+	}
+
+	response := &SimulateResponse{}
+	response.ContractResponseBase = createContractResponseBase(&input.VMInput, vmOutput, w.vm.Runtime().GetTrapBacktrace())
+	response.Error = err
+	response.StateDiff = computeStateDiff(stateBefore, w.blockchainHook.AcctMap)
+
+	return response, nil
+}
+
+func (w *world) applyOverrides(overrides SimulationOverrides) error {
+	for _, override := range overrides.Accounts {
+		err := w.applyAccountOverride(override)
+		if err != nil {
+			return err
+		}
+	}
+
+	if overrides.Block != nil {
+		w.applyBlockOverride(overrides.Block)
+	}
+
+	return nil
+}
+
+func (w *world) applyAccountOverride(override *AccountOverride) error {
+	account := w.blockchainHook.AcctMap.GetAccount(override.Address)
+	if account == nil {
+		account = w.blockchainHook.AcctMap.CreateAccount(override.Address, w.blockchainHook)
+	}
+
+	if override.BalanceAsBigInt != nil {
+		account.Balance = big.NewInt(0).Set(override.BalanceAsBigInt)
+	}
+	if override.Nonce != nil {
+		account.Nonce = *override.Nonce
+	}
+	if len(override.Code) > 0 {
+		account.SetCodeAndMetadata(override.Code, &vmcommon.CodeMetadata{
+			Payable:     true,
+			Upgradeable: true,
+			Readable:    true,
+		})
+	}
+	for key, value := range override.Storage {
+		account.Storage[key] = value
+	}
+
+	for _, esdtBalance := range override.ESDTBalances {
+		err := account.SetTokenBalance([]byte(esdtBalance.TokenIdentifier), esdtBalance.Nonce, esdtBalance.BalanceAsBigInt)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// applyBlockOverride changes the current block; an overridden epoch is also confirmed to the VM,
+// so that its epoch dependent flags match the block
+func (w *world) applyBlockOverride(override *BlockOverride) {
+	if w.blockchainHook.CurrentBlockInfo == nil {
+		w.blockchainHook.CurrentBlockInfo = &worldmock.BlockInfo{}
+	}
+
+	blockInfo := w.blockchainHook.CurrentBlockInfo
+	if override.Timestamp != nil {
+		blockInfo.BlockTimestamp = *override.Timestamp
+	}
+	if override.Nonce != nil {
+		blockInfo.BlockNonce = *override.Nonce
+	}
+	if override.Round != nil {
+		blockInfo.BlockRound = *override.Round
+	}
+	if override.Epoch != nil {
+		blockInfo.BlockEpoch = *override.Epoch
+		w.epochNotifier.confirmEpoch(blockInfo.BlockEpoch, blockInfo.BlockTimestamp)
+	}
+}
+
 func (w *world) createAccount(request CreateAccountRequest) *CreateAccountResponse {
 	log.Trace("w.createAccount()", "request", prettyJson(request))
 
@@ -134,6 +275,7 @@ func (w *world) createAccount(request CreateAccountRequest) *CreateAccountRespon
 		Nonce:           request.Nonce,
 		Balance:         request.BalanceAsBigInt,
 		BalanceDelta:    big.NewInt(0),
+		Storage:         make(map[string][]byte),
 		DeveloperReward: big.NewInt(0),
 	}
 	w.blockchainHook.AcctMap.PutAccount(&account)
@@ -0,0 +1,345 @@
+package arwendebug
+
+import (
+	"math/big"
+	"sort"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/esdtconvert"
+	mj "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/model"
+	worldmock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/world"
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
+	"github.com/ElrondNetwork/elrond-go-core/data/vm"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+// transferGasLimit is the gas given to the builtin functions of the direct token transfers, which are free in a debugging world
+const transferGasLimit = uint64(1000000)
+
+func (w *world) issueToken(request IssueTokenRequest) (*IssueTokenResponse, error) {
+	log.Trace("w.issueToken()", "request", prettyJson(request))
+
+	account, err := w.getAccount(request.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenIdentifier := []byte(request.TokenIdentifier)
+	err = account.SetTokenRolesAsStrings(tokenIdentifier, request.Roles)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.SupplyAsBigInt.Sign() > 0 {
+		err = account.SetTokenBalance(tokenIdentifier, 0, request.SupplyAsBigInt)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	inventory, err := w.getTokenInventory(account)
+	if err != nil {
+		return nil, err
+	}
+
+	return &IssueTokenResponse{TokenInventory: *inventory}, nil
+}
+
+func (w *world) setTokenRoles(request SetTokenRolesRequest) (*SetTokenRolesResponse, error) {
+	log.Trace("w.setTokenRoles()", "request", prettyJson(request))
+
+	account, err := w.getAccount(request.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	err = account.SetTokenRolesAsStrings([]byte(request.TokenIdentifier), request.Roles)
+	if err != nil {
+		return nil, err
+	}
+
+	inventory, err := w.getTokenInventory(account)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SetTokenRolesResponse{TokenInventory: *inventory}, nil
+}
+
+// mintTokens adds to the balance of a fungible token, or to the quantity of an existing NFT when the nonce is not 0
+func (w *world) mintTokens(request MintTokensRequest) (*MintTokensResponse, error) {
+	log.Trace("w.mintTokens()", "request", prettyJson(request))
+
+	account, err := w.getAccount(request.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenIdentifier := []byte(request.TokenIdentifier)
+	if request.Nonce > 0 {
+		_, exists := account.Storage[string(arwen.ESDTStorageKey(tokenIdentifier, request.Nonce))]
+		if !exists {
+			return nil, ErrTokenInstanceDoesntExist
+		}
+	}
+
+	balance, err := account.GetTokenBalance(tokenIdentifier, request.Nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	balance = big.NewInt(0).Add(balance, request.ValueAsBigInt)
+	err = account.SetTokenBalance(tokenIdentifier, request.Nonce, balance)
+	if err != nil {
+		return nil, err
+	}
+
+	inventory, err := w.getTokenInventory(account)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MintTokensResponse{TokenInventory: *inventory}, nil
+}
+
+// createNFT creates the next nonce of the token on the account, which becomes its creator.
+// As in the protocol, the account needs the NFT create role; the instance is semi-fungible when created
+// with a quantity above 1, or when the account may add quantity, a role given only for semi-fungible tokens.
+func (w *world) createNFT(request CreateNFTRequest) (*CreateNFTResponse, error) {
+	log.Trace("w.createNFT()", "request", prettyJson(request))
+
+	account, err := w.getAccount(request.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenIdentifier := []byte(request.TokenIdentifier)
+	roles, err := esdtconvert.GetTokenRoles(tokenIdentifier, account.Storage)
+	if err != nil {
+		return nil, err
+	}
+	if !hasTokenRole(roles, core.ESDTRoleNFTCreate) {
+		return nil, ErrMissingNFTCreateRole
+	}
+
+	tokenType := core.NonFungible
+	if request.QuantityAsBigInt.Cmp(big.NewInt(1)) > 0 || hasTokenRole(roles, core.ESDTRoleNFTAddQuantity) {
+		tokenType = semiFungibleTokenType
+	}
+
+	nonce := account.GetLastNonce(tokenIdentifier) + 1
+
+	uris := make([][]byte, len(request.URIs))
+	for i, uri := range request.URIs {
+		uris[i] = []byte(uri)
+	}
+
+	tokenData := &esdt.ESDigitalToken{
+		Value: request.QuantityAsBigInt,
+		Type:  uint32(tokenType),
+		TokenMetaData: &esdt.MetaData{
+			Nonce:      nonce,
+			Name:       []byte(request.Name),
+			Creator:    request.Address,
+			Royalties:  request.Royalties,
+			Hash:       request.Hash,
+			URIs:       uris,
+			Attributes: request.Attributes,
+		},
+	}
+
+	err = account.SetTokenData(tokenIdentifier, nonce, tokenData)
+	if err != nil {
+		return nil, err
+	}
+
+	err = account.SetLastNonce(tokenIdentifier, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	inventory, err := w.getTokenInventory(account)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CreateNFTResponse{TokenInventory: *inventory, Nonce: nonce}, nil
+}
+
+func hasTokenRole(roles [][]byte, role string) bool {
+	for _, tokenRole := range roles {
+		if string(tokenRole) == role {
+			return true
+		}
+	}
+	return false
+}
+
+func (w *world) transferTokensDirectly(request TransferTokensRequest) (*TransferTokensResponse, error) {
+	log.Trace("w.transferTokensDirectly()", "request", prettyJson(request))
+
+	sender, err := w.getAccount(request.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = w.transferTokens(request.Sender, request.Receiver, request.ESDTPayments, transferGasLimit, DefaultGasPrice)
+	if err != nil {
+		return nil, err
+	}
+
+	senderInventory, err := w.getTokenInventory(sender)
+	if err != nil {
+		return nil, err
+	}
+
+	receiver, err := w.getAccount(request.Receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	receiverInventory, err := w.getTokenInventory(receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TransferTokensResponse{Sender: *senderInventory, Receiver: *receiverInventory}, nil
+}
+
+// transferTokens sends the payments through the ESDT builtin functions, as the protocol does before
+// calling a contract with tokens, and returns the remaining gas
+func (w *world) transferTokens(sender []byte, receiver []byte, payments []*ESDTPayment, gasLimit uint64, gasPrice uint64) (uint64, error) {
+	builtinFuncs := w.blockchainHook.BuiltinFuncs
+
+	if len(payments) == 1 {
+		return builtinFuncs.PerformDirectESDTTransfer(
+			sender,
+			receiver,
+			[]byte(payments[0].TokenIdentifier),
+			payments[0].Nonce,
+			payments[0].ValueAsBigInt,
+			vm.DirectCall,
+			gasLimit,
+			gasPrice)
+	}
+
+	esdtTransfers := make([]*mj.ESDTTxData, len(payments))
+	for i, payment := range payments {
+		esdtTransfers[i] = &mj.ESDTTxData{
+			TokenIdentifier: mj.JSONBytesFromString{Value: []byte(payment.TokenIdentifier)},
+			Nonce:           mj.JSONUint64{Value: payment.Nonce},
+			Value:           mj.JSONBigInt{Value: payment.ValueAsBigInt},
+		}
+	}
+
+	return builtinFuncs.PerformDirectMultiESDTTransfer(
+		sender,
+		receiver,
+		esdtTransfers,
+		vm.DirectCall,
+		gasLimit,
+		gasPrice)
+}
+
+func (w *world) getTokenInventoryOfAccount(request GetTokenInventoryRequest) (*GetTokenInventoryResponse, error) {
+	log.Trace("w.getTokenInventoryOfAccount()", "request", prettyJson(request))
+
+	account, err := w.getAccount(request.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	inventory, err := w.getTokenInventory(account)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetTokenInventoryResponse{TokenInventory: *inventory}, nil
+}
+
+// getAccount returns an existing account, whose storage is ready to hold tokens
+func (w *world) getAccount(address []byte) (*worldmock.Account, error) {
+	account := w.blockchainHook.AcctMap.GetAccount(address)
+	if account == nil {
+		return nil, ErrAccountDoesntExist
+	}
+
+	if account.Storage == nil {
+		account.Storage = make(map[string][]byte)
+	}
+
+	return account, nil
+}
+
+func (w *world) getTokenInventory(account *worldmock.Account) (*TokenInventory, error) {
+	systemAccountStorage := make(map[string][]byte)
+	systemAccount := w.blockchainHook.AcctMap.GetAccount(vmcommon.SystemAccountAddress)
+	if systemAccount != nil {
+		systemAccountStorage = systemAccount.Storage
+	}
+
+	tokens, err := esdtconvert.GetFullMockESDTData(account.Storage, systemAccountStorage)
+	if err != nil {
+		return nil, err
+	}
+
+	inventory := &TokenInventory{
+		AddressHex: toHex(account.Address),
+		Tokens:     make([]*TokenHolding, 0, len(tokens)),
+	}
+	for _, token := range tokens {
+		inventory.Tokens = append(inventory.Tokens, newTokenHolding(token))
+	}
+	sort.Slice(inventory.Tokens, func(i, j int) bool {
+		return inventory.Tokens[i].TokenIdentifier < inventory.Tokens[j].TokenIdentifier
+	})
+
+	return inventory, nil
+}
+
+func newTokenHolding(token *esdtconvert.MockESDTData) *TokenHolding {
+	holding := &TokenHolding{
+		TokenIdentifier: string(token.TokenIdentifier),
+		Roles:           make([]string, len(token.Roles)),
+		LastNonce:       token.LastNonce,
+		Instances:       make([]*TokenInstance, 0, len(token.Instances)),
+	}
+
+	for i, role := range token.Roles {
+		holding.Roles[i] = string(role)
+	}
+
+	for _, tokenData := range token.Instances {
+		holding.Instances = append(holding.Instances, newTokenInstance(tokenData))
+	}
+	sort.Slice(holding.Instances, func(i, j int) bool {
+		return holding.Instances[i].Nonce < holding.Instances[j].Nonce
+	})
+
+	return holding
+}
+
+func newTokenInstance(tokenData *esdt.ESDigitalToken) *TokenInstance {
+	instance := &TokenInstance{
+		Balance: tokenData.Value.String(),
+	}
+
+	metadata := tokenData.TokenMetaData
+	instance.Nonce = metadata.Nonce
+	if metadata.Nonce == 0 {
+		return instance
+	}
+
+	instance.Name = string(metadata.Name)
+	instance.CreatorHex = toHex(metadata.Creator)
+	instance.Royalties = metadata.Royalties
+	instance.HashHex = toHex(metadata.Hash)
+	instance.AttributesHex = toHex(metadata.Attributes)
+	instance.URIs = make([]string, len(metadata.URIs))
+	for i, uri := range metadata.URIs {
+		instance.URIs[i] = string(uri)
+	}
+
+	return instance
+}
@@ -2,6 +2,7 @@ package arwendebug
 
 import (
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-vm-common"
 )
 
@@ -44,6 +45,24 @@ func (w *world) prepareCallInput(request RunRequest) *vmcommon.ContractCallInput
 	callInput.Arguments = request.Arguments
 	callInput.GasProvided = request.GasLimit
 	callInput.GasPrice = request.GasPrice
+	callInput.ESDTTransfers = prepareESDTTransfers(request.ESDTPayments)
 
 	return callInput
 }
+
+func prepareESDTTransfers(payments []*ESDTPayment) []*vmcommon.ESDTTransfer {
+	transfers := make([]*vmcommon.ESDTTransfer, len(payments))
+	for i, payment := range payments {
+		transfers[i] = &vmcommon.ESDTTransfer{
+			ESDTValue:      payment.ValueAsBigInt,
+			ESDTTokenName:  []byte(payment.TokenIdentifier),
+			ESDTTokenType:  uint32(core.Fungible),
+			ESDTTokenNonce: payment.Nonce,
+		}
+		if payment.Nonce > 0 {
+			transfers[i].ESDTTokenType = uint32(core.NonFungible)
+		}
+	}
+
+	return transfers
+}
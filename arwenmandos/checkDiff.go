@@ -0,0 +1,155 @@
+package arwenmandos
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+
+	mc "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/controller"
+	er "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/expression/reconstructor"
+	mj "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/model"
+	oj "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/orderedjson"
+)
+
+// changedField describes a value that does not match its expectation
+func changedField(field string, key string, expected *mc.DiffValue, actual *mc.DiffValue) *mc.FieldDiff {
+	return &mc.FieldDiff{
+		Kind:     mc.DiffChanged,
+		Field:    field,
+		Key:      key,
+		Expected: expected,
+		Actual:   actual,
+	}
+}
+
+// expectedValue shows an expectation as written in the scenario, with the value it was interpreted to
+func expectedValue(original string, value []byte) *mc.DiffValue {
+	return &mc.DiffValue{
+		Mandos: original,
+		Hex:    hexString(value),
+	}
+}
+
+func expectedBytes(expected mj.JSONCheckBytes) *mc.DiffValue {
+	return expectedValue(originalString(expected.Original), expected.Value)
+}
+
+func expectedBigInt(expected mj.JSONCheckBigInt) *mc.DiffValue {
+	if expected.Value == nil {
+		return expectedValue(expected.Original, nil)
+	}
+	return expectedValue(expected.Original, expected.Value.Bytes())
+}
+
+func expectedUint64(expected mj.JSONCheckUint64) *mc.DiffValue {
+	return expectedValue(expected.Original, big.NewInt(0).SetUint64(expected.Value).Bytes())
+}
+
+// actualValue shows an actual result, reconstructed as a Mandos expression
+func (ae *ArwenTestExecutor) actualValue(value []byte, hint er.ExprReconstructorHint) *mc.DiffValue {
+	return &mc.DiffValue{
+		Mandos: ae.exprReconstructor.ReconstructExpression(value, hint),
+		Hex:    hexString(value),
+	}
+}
+
+func actualBigInt(value *big.Int) *mc.DiffValue {
+	if value == nil {
+		value = big.NewInt(0)
+	}
+	return &mc.DiffValue{
+		Mandos: value.String(),
+		Hex:    hexString(value.Bytes()),
+	}
+}
+
+func actualUint64(value uint64) *mc.DiffValue {
+	return actualBigInt(big.NewInt(0).SetUint64(value))
+}
+
+// diffValueLists compares lists item by item, e.g. transaction outputs or ESDT URIs
+func (ae *ArwenTestExecutor) diffValueLists(
+	field string,
+	keyPrefix string,
+	expected mj.JSONCheckValueList,
+	actual [][]byte,
+	hint er.ExprReconstructorHint,
+) []*mc.FieldDiff {
+	if expected.CheckList(actual) {
+		return nil
+	}
+	return diffListItems(field, keyPrefix, expected, actual,
+		func(_ int, expectedItem mj.JSONCheckBytes, actualItem []byte) bool {
+			return expectedItem.Check(actualItem)
+		},
+		func(_ int, actualItem []byte) *mc.DiffValue {
+			return ae.actualValue(actualItem, hint)
+		})
+}
+
+func diffListItems(
+	field string,
+	keyPrefix string,
+	expected mj.JSONCheckValueList,
+	actual [][]byte,
+	checkItem func(index int, expectedItem mj.JSONCheckBytes, actualItem []byte) bool,
+	actualItemValue func(index int, actualItem []byte) *mc.DiffValue,
+) []*mc.FieldDiff {
+	var diffs []*mc.FieldDiff
+	for i := 0; i < len(expected.Values) || i < len(actual); i++ {
+		key := fmt.Sprintf("[%d]", i)
+		if len(keyPrefix) > 0 {
+			key = keyPrefix + " " + key
+		}
+		switch {
+		case i >= len(actual):
+			diffs = append(diffs, &mc.FieldDiff{
+				Kind:     mc.DiffMissing,
+				Field:    field,
+				Key:      key,
+				Expected: expectedBytes(expected.Values[i]),
+			})
+		case i >= len(expected.Values):
+			if !expected.MoreAllowedAtEnd {
+				diffs = append(diffs, &mc.FieldDiff{
+					Kind:   mc.DiffExtra,
+					Field:  field,
+					Key:    key,
+					Actual: actualItemValue(i, actual[i]),
+				})
+			}
+		case !checkItem(i, expected.Values[i], actual[i]):
+			diffs = append(diffs, changedField(field, key,
+				expectedBytes(expected.Values[i]),
+				actualItemValue(i, actual[i])))
+		}
+	}
+	return diffs
+}
+
+func hexString(value []byte) string {
+	if len(value) == 0 {
+		return ""
+	}
+	return "0x" + hex.EncodeToString(value)
+}
+
+func originalString(obj oj.OJsonObject) string {
+	if obj == nil {
+		return ""
+	}
+	if str, isStr := obj.(*oj.OJsonString); isStr {
+		return str.Value
+	}
+	return oj.JSONString(obj)
+}
+
+func sortedStrings(set map[string]bool) []string {
+	sorted := make([]string, 0, len(set))
+	for str := range set {
+		sorted = append(sorted, str)
+	}
+	sort.Strings(sorted)
+	return sorted
+}
@@ -1,7 +1,9 @@
 package arwenmandos
 
 import (
+	"errors"
 	"fmt"
+	"sync"
 
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
 	arwenHost "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen/host"
@@ -19,6 +21,10 @@ import (
 
 var log = logger.GetOrCreate("arwen/mandos")
 
+// vmCreationMutex serializes the creation of the VMs, which sets process-wide Wasmer options,
+// for the executors that run scenarios in parallel
+var vmCreationMutex sync.Mutex
+
 // TestVMType is the VM type argument we use in tests.
 var TestVMType = []byte{0, 0}
 
@@ -31,10 +37,17 @@ type ArwenTestExecutor struct {
 	scenarioTraceGas  []bool
 	fileResolver      fr.FileResolver
 	exprReconstructor er.ExprReconstructor
+	totalGasUsed      uint64
+	wasmBackend       arwen.WasmBackend
+
+	// expectationReviewer is only set while updating the expectations of scenarios
+	expectationReviewer mc.ExpectationReviewer
 }
 
 var _ mc.TestExecutor = (*ArwenTestExecutor)(nil)
 var _ mc.ScenarioExecutor = (*ArwenTestExecutor)(nil)
+var _ mc.GasUsageReporter = (*ArwenTestExecutor)(nil)
+var _ mc.ExpectationUpdatingExecutor = (*ArwenTestExecutor)(nil)
 
 // NewArwenTestExecutor prepares a new ArwenTestExecutor instance.
 func NewArwenTestExecutor() (*ArwenTestExecutor, error) {
@@ -62,13 +75,25 @@ func (ae *ArwenTestExecutor) InitVM(mandosGasSchedule mj.GasSchedule) error {
 		return err
 	}
 
-	err = ae.World.InitBuiltinFunctions(gasSchedule)
+	return ae.InitVMWithGasSchedule(gasSchedule)
+}
+
+// InitVMWithGasSchedule will initialize the VM and the builtin function container with the given gas costs.
+// Does nothing if the VM is already initialized.
+func (ae *ArwenTestExecutor) InitVMWithGasSchedule(gasSchedule config.GasScheduleMap) error {
+	if ae.vm != nil {
+		return nil
+	}
+
+	err := ae.World.InitBuiltinFunctions(gasSchedule)
 	if err != nil {
 		return err
 	}
 
 	blockGasLimit := uint64(10000000)
 	esdtTransferParser, _ := parsers.NewESDTTransferParser(worldhook.WorldMarshalizer)
+	vmCreationMutex.Lock()
+	defer vmCreationMutex.Unlock()
 	vm, err := arwenHost.NewArwenVM(ae.World, &arwen.VMHostParameters{
 		VMType:                   TestVMType,
 		BlockGasLimit:            blockGasLimit,
@@ -78,6 +103,7 @@ func (ae *ArwenTestExecutor) InitVM(mandosGasSchedule mj.GasSchedule) error {
 		ESDTTransferParser:       esdtTransferParser,
 		EpochNotifier:            &worldhook.EpochNotifierStub{},
 		WasmerSIGSEGVPassthrough: false,
+		WasmBackend:              ae.wasmBackend,
 	})
 	if err != nil {
 		return err
@@ -88,6 +114,12 @@ func (ae *ArwenTestExecutor) InitVM(mandosGasSchedule mj.GasSchedule) error {
 	return nil
 }
 
+// SetWasmBackend selects the engine that executes the contracts.
+// It only applies to the VM initialized afterwards.
+func (ae *ArwenTestExecutor) SetWasmBackend(wasmBackend arwen.WasmBackend) {
+	ae.wasmBackend = wasmBackend
+}
+
 // GetVM yields a reference to the VMExecutionHandler used.
 func (ae *ArwenTestExecutor) GetVM() vmi.VMExecutionHandler {
 	return ae.vm
@@ -108,11 +140,30 @@ func (ae *ArwenTestExecutor) gasScheduleMapFromMandos(mandosGasSchedule mj.GasSc
 		return gasSchedules.LoadGasScheduleConfig(gasSchedules.GetV3())
 	case mj.GasScheduleV4:
 		return gasSchedules.LoadGasScheduleConfig(gasSchedules.GetV4())
+	case mj.GasScheduleFile:
+		return nil, errors.New("mandos GasSchedule loaded from file requires the scenario")
 	default:
 		return nil, fmt.Errorf("unknown mandos GasSchedule: %d", mandosGasSchedule)
 	}
 }
 
+// gasScheduleMapFromScenario loads the gas schedule of the scenario, either embedded or from its file, then applies its overrides
+func (ae *ArwenTestExecutor) gasScheduleMapFromScenario(scenario *mj.Scenario) (config.GasScheduleMap, error) {
+	if scenario.GasSchedule != mj.GasScheduleFile {
+		gasSchedule, err := ae.gasScheduleMapFromMandos(scenario.GasSchedule)
+		if err != nil {
+			return nil, err
+		}
+		return gasSchedule, applyGasScheduleOverrides(gasSchedule, scenario.GasScheduleOverrides)
+	}
+
+	gasSchedule, err := gasSchedules.LoadGasScheduleConfig(string(scenario.GasScheduleFile.Value))
+	if err != nil {
+		return nil, fmt.Errorf("cannot load gas schedule %s: %w", scenario.GasScheduleFile.Original, err)
+	}
+	return gasSchedule, applyGasScheduleOverrides(gasSchedule, scenario.GasScheduleOverrides)
+}
+
 func (ae *ArwenTestExecutor) PeekTraceGas() bool {
 	length := len(ae.scenarioTraceGas)
 	if length != 0 {
@@ -2,6 +2,7 @@ package arwenmandos
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
 	mc "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/controller"
@@ -18,6 +19,12 @@ func (ae *ArwenTestExecutor) Reset() {
 		ae.vmHost.Reset()
 	}
 	ae.World.Clear()
+	ae.totalGasUsed = 0
+}
+
+// GetTotalGasUsed returns the gas consumed by the deployments and the calls executed since the last reset.
+func (ae *ArwenTestExecutor) GetTotalGasUsed() uint64 {
+	return ae.totalGasUsed
 }
 
 // Close will simply close the VM
@@ -33,9 +40,15 @@ func (ae *ArwenTestExecutor) ExecuteScenario(scenario *mj.Scenario, fileResolver
 	ae.checkGas = scenario.CheckGas
 	resetGasTracesIfNewTest(ae, scenario)
 
-	err := ae.InitVM(scenario.GasSchedule)
-	if err != nil {
-		return err
+	if ae.vm == nil {
+		gasSchedule, err := ae.gasScheduleMapFromScenario(scenario)
+		if err != nil {
+			return err
+		}
+		err = ae.InitVMWithGasSchedule(gasSchedule)
+		if err != nil {
+			return err
+		}
 	}
 
 	txIndex := 0
@@ -52,6 +65,22 @@ func (ae *ArwenTestExecutor) ExecuteScenario(scenario *mj.Scenario, fileResolver
 	return nil
 }
 
+// ExecuteScenarioUpdatingExpectations executes an individual test,
+// first replacing the expectations that do not match the actual results, if the reviewer accepts the changes.
+func (ae *ArwenTestExecutor) ExecuteScenarioUpdatingExpectations(
+	scenario *mj.Scenario,
+	fileResolver fr.FileResolver,
+	reviewer mc.ExpectationReviewer,
+) error {
+	reviewerBackup := ae.expectationReviewer
+	ae.expectationReviewer = reviewer
+	defer func() {
+		ae.expectationReviewer = reviewerBackup
+	}()
+
+	return ae.ExecuteScenario(scenario, fileResolver)
+}
+
 // ExecuteStep executes an individual step from a scenario.
 func (ae *ArwenTestExecutor) ExecuteStep(generalStep mj.Step) error {
 	err := error(nil)
@@ -62,6 +91,8 @@ func (ae *ArwenTestExecutor) ExecuteStep(generalStep mj.Step) error {
 		length := len(ae.scenarioTraceGas)
 		ae.scenarioTraceGas = ae.scenarioTraceGas[:length-1]
 		return err
+	case *mj.ForEachStep:
+		return ae.ExecuteForEachStep(step)
 	case *mj.SetStateStep:
 		err = ae.ExecuteSetStateStep(step)
 	case *mj.CheckStateStep:
@@ -91,7 +122,14 @@ func (ae *ArwenTestExecutor) ExecuteExternalStep(step *mj.ExternalStepsStep) err
 	extAbsPth := ae.fileResolver.ResolveAbsolutePath(step.Path)
 	setExternalStepGasTracing(ae, step)
 
-	err := externalStepsRunner.RunSingleJSONScenario(extAbsPth, mc.DefaultRunScenarioOptions())
+	options := mc.DefaultRunScenarioOptions()
+	options.Arguments = step.Arguments
+	if ae.expectationReviewer != nil {
+		options.UpdateExpectations = true
+		options.ExpectationReviewer = ae.expectationReviewer
+	}
+
+	err := externalStepsRunner.RunSingleJSONScenario(extAbsPth, options)
 	if err != nil {
 		return err
 	}
@@ -101,6 +139,31 @@ func (ae *ArwenTestExecutor) ExecuteExternalStep(step *mj.ExternalStepsStep) err
 	return nil
 }
 
+// ExecuteForEachStep executes the steps of each iteration of a ForEachStep, in order.
+// Expectations are not updated inside the iterations, since all iterations share the same expectations in the JSON.
+func (ae *ArwenTestExecutor) ExecuteForEachStep(step *mj.ForEachStep) error {
+	if len(step.Comment) > 0 {
+		log.Trace("ForEachStep", "comment", step.Comment)
+	}
+
+	reviewerBackup := ae.expectationReviewer
+	ae.expectationReviewer = nil
+	defer func() {
+		ae.expectationReviewer = reviewerBackup
+	}()
+
+	for i, iteration := range step.Iterations {
+		for _, iterationStep := range iteration {
+			err := ae.ExecuteStep(iterationStep)
+			if err != nil {
+				return fmt.Errorf("forEach iteration %d: %w", i, err)
+			}
+		}
+	}
+
+	return nil
+}
+
 // ExecuteSetStateStep executes a SetStateStep.
 func (ae *ArwenTestExecutor) ExecuteSetStateStep(step *mj.SetStateStep) error {
 	if len(step.Comment) > 0 {
@@ -161,6 +224,9 @@ func (ae *ArwenTestExecutor) ExecuteTxStep(step *mj.TxStep) (*vmi.VMOutput, erro
 
 	// check results
 	if step.ExpectedResult != nil {
+		if ae.expectationReviewer != nil {
+			ae.updateTxExpectations(step, output)
+		}
 		err = ae.checkTxResults(step.TxIdent, step.ExpectedResult, ae.checkGas, output)
 		if err != nil {
 			return nil, err
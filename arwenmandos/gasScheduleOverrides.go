@@ -0,0 +1,58 @@
+package arwenmandos
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/config"
+	mj "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/model"
+)
+
+// applyGasScheduleOverrides replaces the costs named by the overrides, which must already exist in the gas schedule
+func applyGasScheduleOverrides(gasSchedule config.GasScheduleMap, overrides []*mj.GasScheduleOverride) error {
+	for _, override := range overrides {
+		section, name, err := findGasScheduleEntry(gasSchedule, override.Key)
+		if err != nil {
+			return err
+		}
+		gasSchedule[section][name] = override.Value.Value
+	}
+	return nil
+}
+
+// findGasScheduleEntry resolves either a "Section.Name" key, or a name that appears in a single section
+func findGasScheduleEntry(gasSchedule config.GasScheduleMap, key string) (string, string, error) {
+	separatorIndex := strings.Index(key, ".")
+	if separatorIndex >= 0 {
+		section, name := key[:separatorIndex], key[separatorIndex+1:]
+		costs, ok := gasSchedule[section]
+		if !ok {
+			return "", "", fmt.Errorf("unknown gas schedule section: %s", section)
+		}
+		_, ok = costs[name]
+		if !ok {
+			return "", "", fmt.Errorf("unknown gas schedule entry: %s", key)
+		}
+		return section, name, nil
+	}
+
+	sections := make([]string, 0)
+	for section, costs := range gasSchedule {
+		_, ok := costs[key]
+		if ok {
+			sections = append(sections, section)
+		}
+	}
+	sort.Strings(sections)
+
+	switch len(sections) {
+	case 0:
+		return "", "", fmt.Errorf("unknown gas schedule entry: %s", key)
+	case 1:
+		return sections[0], key, nil
+	default:
+		return "", "", fmt.Errorf("ambiguous gas schedule entry %s, prefix it with one of the sections: %s",
+			key, strings.Join(sections, ", "))
+	}
+}
@@ -0,0 +1,34 @@
+package arwenmandos
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/config"
+	mj "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/model"
+	"github.com/stretchr/testify/require"
+)
+
+func gasOverride(key string, value uint64) *mj.GasScheduleOverride {
+	return &mj.GasScheduleOverride{Key: key, Value: mj.JSONUint64{Value: value}}
+}
+
+func TestApplyGasScheduleOverrides(t *testing.T) {
+	gasSchedule := config.MakeGasMapForTests()
+	err := applyGasScheduleOverrides(gasSchedule, []*mj.GasScheduleOverride{
+		gasOverride("BaseOperationCost.StorePerByte", 7),
+		gasOverride("GetNumArguments", 9),
+	})
+	require.Nil(t, err)
+	require.Equal(t, uint64(7), gasSchedule["BaseOperationCost"]["StorePerByte"])
+	require.Equal(t, uint64(9), gasSchedule["ElrondAPICost"]["GetNumArguments"])
+
+	err = applyGasScheduleOverrides(gasSchedule, []*mj.GasScheduleOverride{gasOverride("StorageStore", 1)})
+	require.Contains(t, err.Error(), "ambiguous gas schedule entry StorageStore")
+	require.Contains(t, err.Error(), "ElrondAPICost, EthAPICost")
+
+	err = applyGasScheduleOverrides(gasSchedule, []*mj.GasScheduleOverride{gasOverride("NoSuchSection.StorePerByte", 1)})
+	require.Contains(t, err.Error(), "unknown gas schedule section")
+
+	err = applyGasScheduleOverrides(gasSchedule, []*mj.GasScheduleOverride{gasOverride("BaseOperationCost.NoSuchCost", 1)})
+	require.Contains(t, err.Error(), "unknown gas schedule entry")
+}
@@ -185,9 +185,21 @@ const (
     SHA256                 = 1000000
     Keccak256              = 1000000
     Ripemd160              = 1000000
+    SHA512                 = 1000000
+    SHA512PerByte          = 100
+    SHA3256                = 1000000
+    SHA3256PerByte         = 100
+    Blake2b256             = 500000
+    Blake2b256PerByte      = 50
+    Blake2b512             = 500000
+    Blake2b512PerByte      = 50
+    Poseidon               = 1000000
+    PoseidonPerBlock       = 5500000
     VerifyBLS              = 5000000
     VerifyEd25519          = 2000000
     VerifySecp256k1        = 2000000
+    VerifySecp256r1        = 2000000
+    VerifySchnorr          = 2000000
     EllipticCurveNew       = 10000
     AddECC                 = 75000
     DoubleECC              = 65000
@@ -199,6 +211,16 @@ const (
     UnmarshalCompressedECC = 270000
     GenerateKeyECC         = 7000000
     EncodeDERSig           = 1000000
+    AddG1                  = 50000
+    ScalarMultG1           = 2000000
+    AddG2                  = 100000
+    ScalarMultG2           = 5000000
+    SubgroupCheckG1        = 2000000
+    SubgroupCheckG2        = 5000000
+    PairingCheck           = 10000000
+    PairingCheckPerPair    = 20000000
+    VerifyGroth16          = 40000000
+    VerifyGroth16PerInput  = 2000000
 
 [ManagedBufferAPICost]
     MBufferNew                   = 2000
@@ -852,9 +874,21 @@ const (
     SHA256                 = 1000000
     Keccak256              = 1000000
     Ripemd160              = 1000000
+    SHA512                 = 1000000
+    SHA512PerByte          = 100
+    SHA3256                = 1000000
+    SHA3256PerByte         = 100
+    Blake2b256             = 500000
+    Blake2b256PerByte      = 50
+    Blake2b512             = 500000
+    Blake2b512PerByte      = 50
+    Poseidon               = 1000000
+    PoseidonPerBlock       = 5500000
     VerifyBLS              = 5000000
     VerifyEd25519          = 2000000
     VerifySecp256k1        = 2000000
+    VerifySecp256r1        = 2000000
+    VerifySchnorr          = 2000000
     EllipticCurveNew       = 10000
     AddECC                 = 75000
     DoubleECC              = 65000
@@ -866,6 +900,16 @@ const (
     UnmarshalCompressedECC = 270000
     GenerateKeyECC         = 7000000
     EncodeDERSig           = 10000000
+    AddG1                  = 50000
+    ScalarMultG1           = 2000000
+    AddG2                  = 100000
+    ScalarMultG2           = 5000000
+    SubgroupCheckG1        = 2000000
+    SubgroupCheckG2        = 5000000
+    PairingCheck           = 10000000
+    PairingCheckPerPair    = 20000000
+    VerifyGroth16          = 40000000
+    VerifyGroth16PerInput  = 2000000
 
 [ManagedBufferAPICost]
     MBufferNew                   = 2000
@@ -20,9 +20,16 @@ func LoadGasScheduleConfig(fileContents string) (config.GasScheduleMap, error) {
 	flattenedGasSchedule := make(config.GasScheduleMap)
 	for libType, costs := range gasScheduleConfig {
 		flattenedGasSchedule[libType] = make(map[string]uint64)
-		costsMap := costs.(map[string]interface{})
+		costsMap, isMap := costs.(map[string]interface{})
+		if !isMap {
+			return nil, fmt.Errorf("gas schedule entry %s is not a section", libType)
+		}
 		for operationName, cost := range costsMap {
-			flattenedGasSchedule[libType][operationName] = uint64(cost.(int64))
+			costValue, isInt := cost.(int64)
+			if !isInt || costValue < 0 {
+				return nil, fmt.Errorf("gas schedule cost %s.%s is not a non-negative integer", libType, operationName)
+			}
+			flattenedGasSchedule[libType][operationName] = uint64(costValue)
 		}
 	}
 
@@ -4,12 +4,13 @@ import (
 	"bytes"
 	"fmt"
 	"math/big"
+	"sort"
 	"strings"
 
+	mc "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/controller"
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/esdtconvert"
 	er "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/expression/reconstructor"
 	mj "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/model"
-	oj "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/orderedjson"
 	worldmock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/world"
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
@@ -22,101 +23,121 @@ func (ae *ArwenTestExecutor) ExecuteCheckStateStep(step *mj.CheckStateStep) erro
 		log.Trace("CheckStateStep", "comment", step.Comment)
 	}
 
+	if ae.expectationReviewer != nil {
+		ae.updateCheckAccounts(step.CheckAccounts)
+	}
+
 	return ae.checkAccounts(step.CheckAccounts)
 }
 
 func (ae *ArwenTestExecutor) checkAccounts(checkAccounts *mj.CheckAccounts) error {
+	failure := &mc.CheckFailure{Step: mj.StepNameCheckState}
+
+	for _, expectedAcct := range checkAccounts.Accounts {
+		matchingAcct, isMatch := ae.World.AcctMap[string(expectedAcct.Address.Value)]
+		if !isMatch {
+			failure.Accounts = append(failure.Accounts, &mc.AccountDiff{
+				Kind:    mc.DiffMissing,
+				Address: expectedAcct.Address.Original,
+			})
+			continue
+		}
+
+		fieldDiffs, err := ae.diffAccount(expectedAcct, matchingAcct)
+		if err != nil {
+			return err
+		}
+		if len(fieldDiffs) > 0 {
+			failure.Accounts = append(failure.Accounts, &mc.AccountDiff{
+				Kind:    mc.DiffChanged,
+				Address: expectedAcct.Address.Original,
+				Fields:  fieldDiffs,
+			})
+		}
+	}
+
 	if !checkAccounts.MoreAccountsAllowed {
+		worldAddresses := make(map[string]bool)
 		for worldAcctAddr := range ae.World.AcctMap {
+			worldAddresses[worldAcctAddr] = true
+		}
+		for _, worldAcctAddr := range sortedStrings(worldAddresses) {
 			postAcctMatch := mj.FindCheckAccount(checkAccounts.Accounts, []byte(worldAcctAddr))
 			if postAcctMatch == nil && !bytes.Equal([]byte(worldAcctAddr), vmcommon.SystemAccountAddress) {
-				return fmt.Errorf("unexpected account address: %s",
-					ae.exprReconstructor.Reconstruct(
+				failure.Accounts = append(failure.Accounts, &mc.AccountDiff{
+					Kind: mc.DiffExtra,
+					Address: ae.exprReconstructor.Reconstruct(
 						[]byte(worldAcctAddr),
-						er.AddressHint))
+						er.AddressHint),
+				})
 			}
 		}
 	}
 
-	for _, expectedAcct := range checkAccounts.Accounts {
-		matchingAcct, isMatch := ae.World.AcctMap[string(expectedAcct.Address.Value)]
-		if !isMatch {
-			return fmt.Errorf("account %s expected but not found after running test",
-				expectedAcct.Address.Original)
-		}
-
-		if !bytes.Equal(matchingAcct.Address, expectedAcct.Address.Value) {
-			return fmt.Errorf("bad account address %s",
-				ae.exprReconstructor.Reconstruct(
-					matchingAcct.Address,
-					er.AddressHint))
-		}
+	if failure.HasDifferences() {
+		return failure
+	}
+	return nil
+}
 
-		if !expectedAcct.Nonce.Check(matchingAcct.Nonce) {
-			return fmt.Errorf("bad account nonce. Account: %s. Want: \"%s\". Have: \"%d\"",
-				expectedAcct.Address.Original,
-				expectedAcct.Nonce.Original,
-				matchingAcct.Nonce)
-		}
+func (ae *ArwenTestExecutor) diffAccount(expectedAcct *mj.CheckAccount, matchingAcct *worldmock.Account) ([]*mc.FieldDiff, error) {
+	var diffs []*mc.FieldDiff
 
-		if !expectedAcct.Balance.Check(matchingAcct.Balance) {
-			return fmt.Errorf("bad account balance. Account: %s. Want: \"%s\". Have: \"%s\"",
-				expectedAcct.Address.Original,
-				expectedAcct.Balance.Original,
-				ae.exprReconstructor.ReconstructFromBigInt(matchingAcct.Balance))
-		}
+	if !bytes.Equal(matchingAcct.Address, expectedAcct.Address.Value) {
+		diffs = append(diffs, changedField("address", "",
+			expectedValue(expectedAcct.Address.Original, expectedAcct.Address.Value),
+			ae.actualValue(matchingAcct.Address, er.AddressHint)))
+	}
 
-		if !expectedAcct.Username.Check(matchingAcct.Username) {
-			return fmt.Errorf("bad account username. Account: %s. Want: %s. Have: \"%s\"",
-				expectedAcct.Address.Original,
-				oj.JSONString(expectedAcct.Username.Original),
-				ae.exprReconstructor.Reconstruct(
-					matchingAcct.Username,
-					er.StrHint))
-		}
+	if !expectedAcct.Nonce.Check(matchingAcct.Nonce) {
+		diffs = append(diffs, changedField("nonce", "",
+			expectedUint64(expectedAcct.Nonce),
+			actualUint64(matchingAcct.Nonce)))
+	}
 
-		if !expectedAcct.Code.Check(matchingAcct.Code) {
-			return fmt.Errorf("bad account code. Account: %s. Want: %s. Have: \"%s\"",
-				expectedAcct.Address.Original,
-				oj.JSONString(expectedAcct.Code.Original),
-				ae.exprReconstructor.Reconstruct(
-					matchingAcct.Code,
-					er.CodeHint))
-		}
+	if !expectedAcct.Balance.Check(matchingAcct.Balance) {
+		diffs = append(diffs, changedField("balance", "",
+			expectedBigInt(expectedAcct.Balance),
+			actualBigInt(matchingAcct.Balance)))
+	}
 
-		if !expectedAcct.Owner.IsUnspecified() && !bytes.Equal(matchingAcct.OwnerAddress, expectedAcct.Owner.Value) {
-			return fmt.Errorf("bad account owner. Account: %s. Want: %s. Have: \"%s\"",
-				expectedAcct.Address.Original,
-				oj.JSONString(expectedAcct.Owner.Original),
-				ae.exprReconstructor.Reconstruct(
-					matchingAcct.OwnerAddress,
-					er.AddressHint))
-		}
+	if !expectedAcct.Username.Check(matchingAcct.Username) {
+		diffs = append(diffs, changedField("username", "",
+			expectedBytes(expectedAcct.Username),
+			ae.actualValue(matchingAcct.Username, er.StrHint)))
+	}
 
-		// currently ignoring asyncCallData that is unspecified in the json
-		if !expectedAcct.AsyncCallData.IsUnspecified() &&
-			!expectedAcct.AsyncCallData.Check([]byte(matchingAcct.AsyncCallData)) {
-			return fmt.Errorf("bad async call data. Account: %s. Want: [%s]. Have: [%s]",
-				expectedAcct.Address.Original,
-				expectedAcct.AsyncCallData.Original,
-				matchingAcct.AsyncCallData)
-		}
+	if !expectedAcct.Code.Check(matchingAcct.Code) {
+		// code is too long to show in full
+		diffs = append(diffs, changedField("code", "",
+			&mc.DiffValue{Mandos: originalString(expectedAcct.Code.Original)},
+			&mc.DiffValue{Mandos: ae.exprReconstructor.Reconstruct(matchingAcct.Code, er.CodeHint)}))
+	}
 
-		err := ae.checkAccountStorage(expectedAcct, matchingAcct)
-		if err != nil {
-			return err
-		}
+	if !expectedAcct.Owner.IsUnspecified() && !bytes.Equal(matchingAcct.OwnerAddress, expectedAcct.Owner.Value) {
+		diffs = append(diffs, changedField("owner", "",
+			expectedBytes(expectedAcct.Owner),
+			ae.actualValue(matchingAcct.OwnerAddress, er.AddressHint)))
+	}
 
-		err = ae.checkAccountESDT(expectedAcct, matchingAcct)
-		if err != nil {
-			return err
-		}
+	// currently ignoring asyncCallData that is unspecified in the json
+	if !expectedAcct.AsyncCallData.IsUnspecified() &&
+		!expectedAcct.AsyncCallData.Check([]byte(matchingAcct.AsyncCallData)) {
+		diffs = append(diffs, changedField("asyncCallData", "",
+			expectedBytes(expectedAcct.AsyncCallData),
+			ae.actualValue([]byte(matchingAcct.AsyncCallData), er.StrHint)))
 	}
 
-	return nil
+	diffs = append(diffs, ae.diffAccountStorage(expectedAcct, matchingAcct)...)
+
+	esdtDiffs, err := ae.diffAccountESDT(expectedAcct, matchingAcct)
+	if err != nil {
+		return nil, err
+	}
+	return append(diffs, esdtDiffs...), nil
 }
 
-func (ae *ArwenTestExecutor) checkAccountStorage(expectedAcct *mj.CheckAccount, matchingAcct *worldmock.Account) error {
+func (ae *ArwenTestExecutor) diffAccountStorage(expectedAcct *mj.CheckAccount, matchingAcct *worldmock.Account) []*mc.FieldDiff {
 	if expectedAcct.IgnoreStorage {
 		return nil
 	}
@@ -133,8 +154,8 @@ func (ae *ArwenTestExecutor) checkAccountStorage(expectedAcct *mj.CheckAccount,
 	for k := range matchingAcct.Storage {
 		allKeys[k] = true
 	}
-	storageError := ""
-	for k := range allKeys {
+	var diffs []*mc.FieldDiff
+	for _, k := range sortedStrings(allKeys) {
 		// ignore all reserved "ELROND..." keys
 		if strings.HasPrefix(k, core.ElrondProtectedKeyPrefix) {
 			continue
@@ -154,23 +175,26 @@ func (ae *ArwenTestExecutor) checkAccountStorage(expectedAcct *mj.CheckAccount,
 		have := matchingAcct.StorageValue(k)
 
 		if !want.Check(have) {
-			storageError += fmt.Sprintf(
-				"\n  for key %s: Want: %s. Have: \"%s\"",
-				ae.exprReconstructor.Reconstruct([]byte(k), er.NoHint),
-				oj.JSONString(want.Original),
-				ae.exprReconstructor.Reconstruct(have, er.NoHint))
+			diff := changedField("storage", ae.exprReconstructor.ReconstructExpression([]byte(k), er.StrHint),
+				expectedBytes(want),
+				ae.actualValue(have, er.NoHint))
+			switch {
+			case !specified:
+				diff.Kind = mc.DiffExtra
+				diff.Expected = nil
+			case len(have) == 0:
+				diff.Kind = mc.DiffMissing
+				diff.Actual = nil
+			}
+			diffs = append(diffs, diff)
 		}
 	}
-	if len(storageError) > 0 {
-		return fmt.Errorf("wrong account storage for account \"%s\":%s",
-			expectedAcct.Address.Original, storageError)
-	}
-	return nil
+	return diffs
 }
 
-func (ae *ArwenTestExecutor) checkAccountESDT(expectedAcct *mj.CheckAccount, matchingAcct *worldmock.Account) error {
+func (ae *ArwenTestExecutor) diffAccountESDT(expectedAcct *mj.CheckAccount, matchingAcct *worldmock.Account) ([]*mc.FieldDiff, error) {
 	if expectedAcct.IgnoreESDT {
-		return nil
+		return nil, nil
 	}
 
 	systemAccStorage := make(map[string][]byte)
@@ -179,11 +203,10 @@ func (ae *ArwenTestExecutor) checkAccountESDT(expectedAcct *mj.CheckAccount, mat
 		systemAccStorage = systemAcc.Storage
 	}
 
-	accountAddress := expectedAcct.Address.Original
 	expectedTokens := getExpectedTokens(expectedAcct)
 	accountTokens, err := esdtconvert.GetFullMockESDTData(matchingAcct.Storage, systemAccStorage)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	allTokenNames := make(map[string]bool)
@@ -193,8 +216,8 @@ func (ae *ArwenTestExecutor) checkAccountESDT(expectedAcct *mj.CheckAccount, mat
 	for tokenName := range accountTokens {
 		allTokenNames[tokenName] = true
 	}
-	var errs []error
-	for tokenName := range allTokenNames {
+	var diffs []*mc.FieldDiff
+	for _, tokenName := range sortedStrings(allTokenNames) {
 		expectedToken := expectedTokens[tokenName]
 		accountToken := accountTokens[tokenName]
 		if expectedToken == nil {
@@ -216,15 +239,10 @@ func (ae *ArwenTestExecutor) checkAccountESDT(expectedAcct *mj.CheckAccount, mat
 			}
 		}
 
-		errs = append(errs, ae.checkTokenState(accountAddress, tokenName, expectedToken, accountToken)...)
-	}
-
-	errorString := makeErrorString(errs)
-	if len(errorString) > 0 {
-		return fmt.Errorf("mismatch for account \"%s\":%s", accountAddress, errorString)
+		diffs = append(diffs, ae.diffTokenState(tokenName, expectedToken, accountToken)...)
 	}
 
-	return nil
+	return diffs, nil
 }
 
 func getExpectedTokens(expectedAcct *mj.CheckAccount) map[string]*mj.CheckESDTData {
@@ -237,63 +255,66 @@ func getExpectedTokens(expectedAcct *mj.CheckAccount) map[string]*mj.CheckESDTDa
 	return expectedTokens
 }
 
-func (ae *ArwenTestExecutor) checkTokenState(
-	accountAddress string,
+func (ae *ArwenTestExecutor) diffTokenState(
 	tokenName string,
 	expectedToken *mj.CheckESDTData,
 	accountToken *esdtconvert.MockESDTData,
-) []error {
+) []*mc.FieldDiff {
 
-	var errors []error
+	var diffs []*mc.FieldDiff
 
-	errors = append(errors, ae.checkTokenInstances(accountAddress, tokenName, expectedToken, accountToken)...)
+	diffs = append(diffs, ae.diffTokenInstances(tokenName, expectedToken, accountToken)...)
 
 	if !expectedToken.LastNonce.Check(accountToken.LastNonce) {
-		errors = append(errors, fmt.Errorf("bad account ESDT last nonce. Account: %s. Token: %s. Want: \"%s\". Have: %d",
-			accountAddress,
-			tokenName,
-			expectedToken.LastNonce.Original,
-			accountToken.LastNonce))
+		diffs = append(diffs, changedField("esdt lastNonce", tokenName,
+			expectedUint64(expectedToken.LastNonce),
+			actualUint64(accountToken.LastNonce)))
 	}
 
-	errors = append(errors, checkTokenRoles(accountAddress, tokenName, expectedToken, accountToken)...)
+	diffs = append(diffs, diffTokenRoles(tokenName, expectedToken, accountToken)...)
 
-	return errors
+	return diffs
 }
 
-func (ae *ArwenTestExecutor) checkTokenInstances(
-	_ string,
+func (ae *ArwenTestExecutor) diffTokenInstances(
 	tokenName string,
 	expectedToken *mj.CheckESDTData,
 	accountToken *esdtconvert.MockESDTData,
-) []error {
+) []*mc.FieldDiff {
 
-	var errors []error
+	var diffs []*mc.FieldDiff
 
-	allNonces := make(map[uint64]bool)
+	var allNonces []uint64
 	expectedInstances := make(map[uint64]*mj.CheckESDTInstance)
 	accountInstances := make(map[uint64]*esdt.ESDigitalToken)
 	for _, expectedInstance := range expectedToken.Instances {
 		nonce := expectedInstance.Nonce.Value
-		allNonces[nonce] = true
+		allNonces = append(allNonces, nonce)
 		expectedInstances[nonce] = expectedInstance
 	}
 	for _, accountInstance := range accountToken.Instances {
 		nonce := accountInstance.TokenMetaData.Nonce
-		allNonces[nonce] = true
+		if _, alreadyAdded := expectedInstances[nonce]; !alreadyAdded {
+			allNonces = append(allNonces, nonce)
+		}
 		accountInstances[nonce] = accountInstance
 	}
+	sort.Slice(allNonces, func(i, j int) bool { return allNonces[i] < allNonces[j] })
 
-	for nonce := range allNonces {
+	for _, nonce := range allNonces {
 		expectedInstance := expectedInstances[nonce]
 		accountInstance := accountInstances[nonce]
+		key := fmt.Sprintf("%s nonce %d", tokenName, nonce)
 
+		balanceDiffKind := mc.DiffChanged
 		if expectedInstance == nil {
+			balanceDiffKind = mc.DiffExtra
 			expectedInstance = &mj.CheckESDTInstance{
 				Nonce:   mj.JSONUint64{Value: nonce, Original: ""},
 				Balance: mj.JSONCheckBigInt{Value: big.NewInt(0), Original: ""},
 			}
 		} else if accountInstance == nil {
+			balanceDiffKind = mc.DiffMissing
 			accountInstance = &esdt.ESDigitalToken{
 				Value: big.NewInt(0),
 				TokenMetaData: &esdt.MetaData{
@@ -304,81 +325,62 @@ func (ae *ArwenTestExecutor) checkTokenInstances(
 		}
 
 		if !expectedInstance.Balance.Check(accountInstance.Value) {
-			errors = append(errors, fmt.Errorf(
-				"for token: %s, nonce: %d: Bad balance. Want: \"%s\". Have: \"%d\"",
-				tokenName,
-				nonce,
-				expectedInstance.Balance.Original,
-				accountInstance.Value))
+			diff := changedField("esdt balance", key,
+				expectedBigInt(expectedInstance.Balance),
+				actualBigInt(accountInstance.Value))
+			diff.Kind = balanceDiffKind
+			switch balanceDiffKind {
+			case mc.DiffExtra:
+				diff.Expected = nil
+			case mc.DiffMissing:
+				diff.Actual = nil
+			}
+			diffs = append(diffs, diff)
 		}
 		if !expectedInstance.Creator.IsUnspecified() &&
 			!expectedInstance.Creator.Check(accountInstance.TokenMetaData.Creator) {
-			errors = append(errors, fmt.Errorf(
-				"for token: %s, nonce: %d: Bad creator. Want: %s. Have: \"%s\"",
-				tokenName,
-				nonce,
-				objectStringOrDefault(expectedInstance.Creator.Original),
-				ae.exprReconstructor.Reconstruct(
-					accountInstance.TokenMetaData.Creator,
-					er.AddressHint)))
+			diffs = append(diffs, changedField("esdt creator", key,
+				expectedBytes(expectedInstance.Creator),
+				ae.actualValue(accountInstance.TokenMetaData.Creator, er.AddressHint)))
 		}
 		if !expectedInstance.Royalties.IsUnspecified() &&
 			!expectedInstance.Royalties.Check(uint64(accountInstance.TokenMetaData.Royalties)) {
-			errors = append(errors, fmt.Errorf(
-				"for token: %s, nonce: %d: Bad royalties. Want: \"%s\". Have: \"%s\"",
-				tokenName,
-				nonce,
-				expectedInstance.Royalties.Original,
-				ae.exprReconstructor.ReconstructFromUint64(
-					uint64(accountInstance.TokenMetaData.Royalties))))
+			diffs = append(diffs, changedField("esdt royalties", key,
+				expectedUint64(expectedInstance.Royalties),
+				actualUint64(uint64(accountInstance.TokenMetaData.Royalties))))
 		}
 		if !expectedInstance.Hash.IsUnspecified() &&
 			!expectedInstance.Hash.Check(accountInstance.TokenMetaData.Hash) {
-			errors = append(errors, fmt.Errorf(
-				"for token: %s, nonce: %d: Bad hash. Want: %s. Have: %s",
-				tokenName,
-				nonce,
-				objectStringOrDefault(expectedInstance.Hash.Original),
-				ae.exprReconstructor.Reconstruct(
-					accountInstance.TokenMetaData.Hash,
-					er.NoHint)))
+			diffs = append(diffs, changedField("esdt hash", key,
+				expectedBytes(expectedInstance.Hash),
+				ae.actualValue(accountInstance.TokenMetaData.Hash, er.NoHint)))
 		}
 
-		if !expectedInstance.Uris.IsUnspecified() &&
-			!expectedInstance.Uris.CheckList(accountInstance.TokenMetaData.URIs) {
-			// in this case unspecified is interpreted as *
-			errors = append(errors, fmt.Errorf(
-				"for token: %s, nonce: %d: Bad URI. Want: %s. Have: %s",
-				tokenName,
-				nonce,
-				checkBytesListPretty(expectedInstance.Uris),
-				ae.exprReconstructor.ReconstructList(accountInstance.TokenMetaData.URIs, er.StrHint)))
+		// in this case unspecified is interpreted as *
+		if !expectedInstance.Uris.IsUnspecified() {
+			diffs = append(diffs, ae.diffValueLists("esdt uri", key,
+				expectedInstance.Uris,
+				accountInstance.TokenMetaData.URIs,
+				er.StrHint)...)
 		}
 
 		if !expectedInstance.Attributes.IsUnspecified() &&
 			!expectedInstance.Attributes.Check(accountInstance.TokenMetaData.Attributes) {
-			errors = append(errors, fmt.Errorf(
-				"for token: %s, nonce: %d: Bad attributes. Want: %s. Have: \"%s\"",
-				tokenName,
-				nonce,
-				objectStringOrDefault(expectedInstance.Attributes.Original),
-				ae.exprReconstructor.Reconstruct(
-					accountInstance.TokenMetaData.Attributes,
-					er.StrHint)))
+			diffs = append(diffs, changedField("esdt attributes", key,
+				expectedBytes(expectedInstance.Attributes),
+				ae.actualValue(accountInstance.TokenMetaData.Attributes, er.StrHint)))
 		}
-
 	}
 
-	return errors
+	return diffs
 }
 
-func checkTokenRoles(
-	accountAddress string,
+func diffTokenRoles(
 	tokenName string,
 	expectedToken *mj.CheckESDTData,
-	accountToken *esdtconvert.MockESDTData) []error {
+	accountToken *esdtconvert.MockESDTData) []*mc.FieldDiff {
 
-	var errors []error
+	var diffs []*mc.FieldDiff
 
 	allRoles := make(map[string]bool)
 	expectedRoles := make(map[string]bool)
@@ -392,36 +394,24 @@ func checkTokenRoles(
 		allRoles[string(accountRole)] = true
 		accountRoles[string(accountRole)] = true
 	}
-	for role := range allRoles {
+	for _, role := range sortedStrings(allRoles) {
 		if !expectedRoles[role] {
-			errors = append(errors, fmt.Errorf("unexpected ESDT role. Account: %s. Token: %s. Role: %s",
-				accountAddress,
-				tokenName,
-				role))
+			diffs = append(diffs, &mc.FieldDiff{
+				Kind:   mc.DiffExtra,
+				Field:  "esdt role",
+				Key:    tokenName,
+				Actual: &mc.DiffValue{Mandos: role},
+			})
 		}
 		if !accountRoles[role] {
-			errors = append(errors, fmt.Errorf("missing ESDT role. Account: %s. Token: %s. Role: %s",
-				accountAddress,
-				tokenName,
-				role))
+			diffs = append(diffs, &mc.FieldDiff{
+				Kind:     mc.DiffMissing,
+				Field:    "esdt role",
+				Key:      tokenName,
+				Expected: &mc.DiffValue{Mandos: role},
+			})
 		}
 	}
 
-	return errors
-}
-
-func makeErrorString(errors []error) string {
-	errorString := ""
-	for _, err := range errors {
-		errorString += "\n  " + err.Error()
-	}
-	return errorString
-}
-
-func objectStringOrDefault(obj oj.OJsonObject) string {
-	if obj == nil {
-		return ""
-	}
-
-	return oj.JSONString(obj)
+	return diffs
 }
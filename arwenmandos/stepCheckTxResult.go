@@ -4,8 +4,8 @@ import (
 	"fmt"
 	"math/big"
 
+	mc "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/controller"
 	er "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/expression/reconstructor"
-	mjwrite "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/json/write"
 	mj "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/model"
 	oj "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/orderedjson"
 	vmi "github.com/ElrondNetwork/elrond-vm-common"
@@ -17,115 +17,193 @@ func (ae *ArwenTestExecutor) checkTxResults(
 	checkGas bool,
 	output *vmi.VMOutput,
 ) error {
+	failure := &mc.CheckFailure{Step: txIndex}
 
 	if !blResult.Status.Check(big.NewInt(int64(output.ReturnCode))) {
-		return fmt.Errorf("result code mismatch. Tx %s. Want: %s. Have: %d (%s). Message: %s",
-			txIndex, blResult.Status.Original, int(output.ReturnCode), output.ReturnCode.String(), output.ReturnMessage)
+		failure.TxResult = append(failure.TxResult, changedField("status", "",
+			expectedBigInt(blResult.Status),
+			&mc.DiffValue{Mandos: fmt.Sprintf("%d (%s)", int(output.ReturnCode), output.ReturnCode.String())}))
 	}
 
 	if !blResult.Message.Check([]byte(output.ReturnMessage)) {
-		return fmt.Errorf("result message mismatch. Tx %s. Want: %s. Have: %s",
-			txIndex, blResult.Message.Original, output.ReturnMessage)
+		failure.TxResult = append(failure.TxResult, changedField("message", "",
+			expectedBytes(blResult.Message),
+			&mc.DiffValue{Mandos: output.ReturnMessage}))
 	}
 
 	// check result
-	if !blResult.Out.CheckList(output.ReturnData) {
-		return fmt.Errorf("result mismatch. Tx %s. Want: %s. Have: %s",
-			txIndex,
-			checkBytesListPretty(blResult.Out),
-			ae.exprReconstructor.ReconstructList(output.ReturnData, er.NoHint))
-	}
+	failure.TxResult = append(failure.TxResult,
+		ae.diffValueLists("out", "", blResult.Out, output.ReturnData, er.NoHint)...)
 
 	// check refund
 	if !blResult.Refund.Check(output.GasRefund) {
-		return fmt.Errorf("result gas refund mismatch. Tx %s. Want: %s. Have: 0x%x",
-			txIndex, blResult.Refund.Original, output.GasRefund)
+		failure.TxResult = append(failure.TxResult, changedField("refund", "",
+			expectedBigInt(blResult.Refund),
+			actualBigInt(output.GasRefund)))
 	}
 
 	// check gas
 	// unlike other checks, if unspecified the remaining gas check is ignored
 	if checkGas && !blResult.Gas.IsUnspecified() && !blResult.Gas.Check(output.GasRemaining) {
-		return fmt.Errorf("result gas mismatch. Tx %s. Want: %s. Got: %d (0x%x)",
-			txIndex,
-			blResult.Gas.Original,
-			output.GasRemaining,
-			output.GasRemaining)
+		failure.TxResult = append(failure.TxResult, changedField("gas", "",
+			expectedUint64(blResult.Gas),
+			actualUint64(output.GasRemaining)))
 	}
 
-	return ae.checkTxLogs(txIndex, blResult.Logs, output.Logs)
+	failure.TxResult = append(failure.TxResult, ae.diffTxLogs(blResult.Logs, output.Logs)...)
+
+	if failure.HasDifferences() {
+		failure.Trap = ae.lastTrap()
+		return failure
+	}
+	return nil
 }
 
-func (ae *ArwenTestExecutor) checkTxLogs(
-	txIndex string,
+// lastTrap describes where the contract of the last transaction trapped, or returns an empty string if none did
+func (ae *ArwenTestExecutor) lastTrap() string {
+	if ae.vmHost == nil {
+		return ""
+	}
+	backtrace := ae.vmHost.Runtime().GetTrapBacktrace()
+	if backtrace == nil {
+		return ""
+	}
+	return backtrace.String()
+}
+
+func (ae *ArwenTestExecutor) diffTxLogs(
 	expectedLogs mj.LogList,
 	actualLogs []*vmi.LogEntry,
-) error {
+) []*mc.FieldDiff {
 	// "logs": "*" means any value is accepted, log check ignored
 	if expectedLogs.IsStar {
 		return nil
 	}
 
-	// this is the real log check
-	if len(actualLogs) < len(expectedLogs.List) {
-		return fmt.Errorf("too few logs. Tx %s. Want:%d. Got:%d",
-			txIndex,
-			len(expectedLogs.List),
-			len(actualLogs))
+	if expectedLogs.MatchEvents {
+		return ae.diffTxLogEvents(expectedLogs.Events, actualLogs)
 	}
 
-	for i, actualLog := range actualLogs {
-		if i < len(expectedLogs.List) {
-			testLog := expectedLogs.List[i]
-			err := ae.checkTxLog(txIndex, i, testLog, actualLog)
-			if err != nil {
-				return err
+	// missing and unexpected logs are shown by their identifier
+	var diffs []*mc.FieldDiff
+	for i := 0; i < len(expectedLogs.List) || i < len(actualLogs); i++ {
+		key := fmt.Sprintf("[%d]", i)
+		switch {
+		case i >= len(actualLogs):
+			diffs = append(diffs, &mc.FieldDiff{
+				Kind:     mc.DiffMissing,
+				Field:    "log",
+				Key:      key,
+				Expected: expectedBytes(expectedLogs.List[i].Endpoint),
+			})
+		case i >= len(expectedLogs.List):
+			if !expectedLogs.MoreAllowedAtEnd {
+				diffs = append(diffs, &mc.FieldDiff{
+					Kind:   mc.DiffExtra,
+					Field:  "log",
+					Key:    key,
+					Actual: ae.actualValue(actualLogs[i].Identifier, er.StrHint),
+				})
 			}
-		} else if !expectedLogs.MoreAllowedAtEnd {
-			return fmt.Errorf("unexpected log. Tx %s. Log index: %d. Log:%s",
-				txIndex,
-				i,
-				mjwrite.LogToString(ae.convertLogToTestFormat(actualLog)),
-			)
+		default:
+			diffs = append(diffs, ae.diffTxLog(key, expectedLogs.List[i], actualLogs[i])...)
 		}
 	}
 
-	return nil
+	return diffs
 }
 
-func (ae *ArwenTestExecutor) checkTxLog(
-	txIndex string,
-	logIndex int,
+// diffTxLogEvents counts the logs matching each event, wherever they are
+func (ae *ArwenTestExecutor) diffTxLogEvents(
+	events []*mj.LogEvent,
+	actualLogs []*vmi.LogEntry,
+) []*mc.FieldDiff {
+	var diffs []*mc.FieldDiff
+	for i, event := range events {
+		numMatches := uint64(0)
+		for _, actualLog := range actualLogs {
+			if event.Check(actualLog.Address, actualLog.Identifier, actualLog.Topics, actualLog.Data) {
+				numMatches++
+			}
+		}
+
+		expectedCount := &mc.DiffValue{Mandos: "at least 1"}
+		countOk := numMatches > 0
+		if !event.Count.IsUnspecified() {
+			expectedCount = expectedUint64(event.Count)
+			countOk = event.Count.Check(numMatches)
+		}
+		if countOk {
+			continue
+		}
+
+		key := fmt.Sprintf("[%d]", i)
+		if !event.Endpoint.IsUnspecified() {
+			key += " " + originalString(event.Endpoint.Original)
+		}
+		diff := changedField("log event count", key, expectedCount, actualUint64(numMatches))
+		if numMatches == 0 {
+			diff.Kind = mc.DiffMissing
+		}
+		diffs = append(diffs, diff)
+	}
+	return diffs
+}
+
+func (ae *ArwenTestExecutor) diffTxLog(
+	key string,
 	expectedLog *mj.LogEntry,
-	actualLog *vmi.LogEntry) error {
+	actualLog *vmi.LogEntry) []*mc.FieldDiff {
+	var diffs []*mc.FieldDiff
 	if !expectedLog.Address.Check(actualLog.Address) {
-		return fmt.Errorf("bad log address. Tx %s. Log index: %d. Want:\n%s\nGot:\n%s",
-			txIndex,
-			logIndex,
-			mjwrite.LogToString(expectedLog),
-			mjwrite.LogToString(ae.convertLogToTestFormat(actualLog)))
+		diffs = append(diffs, changedField("log address", key,
+			expectedBytes(expectedLog.Address),
+			ae.actualValue(actualLog.Address, er.AddressHint)))
 	}
 	if !expectedLog.Endpoint.Check(actualLog.Identifier) {
-		return fmt.Errorf("bad log identifier. Tx %s. Log index: %d. Want:\n%s\nGot:\n%s",
-			txIndex,
-			logIndex,
-			mjwrite.LogToString(expectedLog),
-			mjwrite.LogToString(ae.convertLogToTestFormat(actualLog)))
-	}
-	if !expectedLog.Topics.CheckList(actualLog.Topics) {
-		return fmt.Errorf("bad log topics. Tx %s. Log index: %d. Want: %s. Have: %s",
-			txIndex,
-			logIndex,
-			checkBytesListPretty(expectedLog.Topics),
-			ae.exprReconstructor.ReconstructList(actualLog.Topics, er.NoHint))
+		diffs = append(diffs, changedField("log identifier", key,
+			expectedBytes(expectedLog.Endpoint),
+			ae.actualValue(actualLog.Identifier, er.StrHint)))
+	}
+	if !expectedLog.CheckTopics(actualLog.Topics) {
+		diffs = append(diffs, diffListItems("log topic", key, expectedLog.Topics, actualLog.Topics,
+			expectedLog.CheckTopic,
+			func(index int, topic []byte) *mc.DiffValue {
+				return ae.actualTopic(expectedLog, index, topic)
+			})...)
 	}
 	if !expectedLog.Data.Check(actualLog.Data) {
-		return fmt.Errorf("bad log data. Tx %s. Log index: %d. Want:\n%s\nGot:\n%s",
-			txIndex,
-			logIndex,
-			mjwrite.LogToString(expectedLog),
-			mjwrite.LogToString(ae.convertLogToTestFormat(actualLog)))
+		diffs = append(diffs, changedField("log data", key,
+			expectedBytes(expectedLog.Data),
+			ae.actualValue(actualLog.Data, er.NoHint)))
+	}
+	return diffs
+}
+
+// actualTopic shows a topic decoded by its ABI type, if given
+func (ae *ArwenTestExecutor) actualTopic(logEntry *mj.LogEntry, index int, topic []byte) *mc.DiffValue {
+	if index >= len(logEntry.TopicTypes) {
+		return ae.actualValue(topic, er.NoHint)
+	}
+
+	abiType := logEntry.TopicTypes[index]
+	if abiType.IsNumeric() {
+		number, err := abiType.DecodeNumber(topic)
+		if err == nil {
+			return &mc.DiffValue{
+				Mandos: number.String(),
+				Hex:    hexString(topic),
+			}
+		}
+	}
+	switch abiType {
+	case "Address":
+		return ae.actualValue(topic, er.AddressHint)
+	case "TokenIdentifier", "str":
+		return ae.actualValue(topic, er.StrHint)
+	default:
+		return ae.actualValue(topic, er.NoHint)
 	}
-	return nil
 }
 
 // JSONCheckBytesString formats a list of JSONCheckBytes for printing to console.
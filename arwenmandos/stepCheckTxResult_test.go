@@ -0,0 +1,127 @@
+package arwenmandos
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	mc "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/controller"
+	mjparse "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/json/parse"
+	mj "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/model"
+	vmi "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/require"
+)
+
+func expectedTxResult(t *testing.T, logsJSON string) *mj.TransactionResult {
+	p := mjparse.NewParser(nil)
+	step, err := p.ParseScenarioStep(`{
+		"step": "scCall",
+		"txId": "1",
+		"tx": {
+			"from": "address:owner",
+			"to": "sc:contract",
+			"function": "doSomething",
+			"arguments": [],
+			"gasLimit": "0x100000",
+			"gasPrice": "0"
+		},
+		"expect": {
+			"out": "*",
+			"status": "",
+			"logs": ` + logsJSON + `
+		}
+	}`)
+	require.Nil(t, err)
+	return step.(*mj.TxStep).ExpectedResult
+}
+
+func outputWithLogs(logs ...*vmi.LogEntry) *vmi.VMOutput {
+	return &vmi.VMOutput{
+		ReturnCode: vmi.Ok,
+		GasRefund:  big.NewInt(0),
+		Logs:       logs,
+	}
+}
+
+func testLog(identifier string, topics ...[]byte) *vmi.LogEntry {
+	return &vmi.LogEntry{
+		Address:    []byte("contract"),
+		Identifier: []byte(identifier),
+		Topics:     topics,
+	}
+}
+
+func checkTxLogs(t *testing.T, logsJSON string, output *vmi.VMOutput) *mc.CheckFailure {
+	ae := &ArwenTestExecutor{}
+	err := ae.checkTxResults("1", expectedTxResult(t, logsJSON), false, output)
+	if err == nil {
+		return nil
+	}
+	var failure *mc.CheckFailure
+	require.True(t, errors.As(err, &failure))
+	return failure
+}
+
+func TestCheckTxLogs_Events(t *testing.T) {
+	output := outputWithLogs(
+		testLog("first"),
+		testLog("transfer", []byte("TOKEN-123456"), []byte{0x03, 0xe8}),
+		testLog("transfer", []byte("TOKEN-123456"), []byte{0x07, 0xd0}),
+		testLog("last"),
+	)
+
+	failure := checkTxLogs(t, `{
+		"events": [
+			{ "endpoint": "str:last" },
+			{ "endpoint": "str:transfer", "count": "2" },
+			{ "endpoint": "str:transfer", "topics": ["str:TOKEN-123456", "2000"], "count": "1" }
+		]
+	}`, output)
+	require.Nil(t, failure)
+
+	failure = checkTxLogs(t, `{
+		"events": [
+			{ "endpoint": "str:transfer", "count": "1" },
+			{ "endpoint": "str:burn" }
+		]
+	}`, output)
+	require.NotNil(t, failure)
+	require.Equal(t, `check failed, step 1:
+  tx result:
+    ~ log event count [0] str:transfer: want "1" (0x01), have "2" (0x02)
+    - log event count [1] str:burn: want "at least 1", have "0"`, failure.Error())
+}
+
+func TestCheckTxLogs_TopicsPrefixAndTypes(t *testing.T) {
+	output := outputWithLogs(
+		testLog("transfer", []byte("TOKEN-123456"), []byte{0x03, 0xe8}, []byte("more")),
+	)
+
+	failure := checkTxLogs(t, `[
+		{
+			"address": "str:contract",
+			"endpoint": "str:transfer",
+			"topics": ["*", "u64:1000", "+"],
+			"topicTypes": ["TokenIdentifier", "u64"],
+			"data": ""
+		}
+	]`, output)
+	require.Nil(t, failure)
+
+	failure = checkTxLogs(t, `[
+		{
+			"address": "str:contract",
+			"endpoint": "str:transfer",
+			"topics": ["str:TOKEN-123456", "1001", "+"],
+			"topicTypes": ["TokenIdentifier", "u64"],
+			"data": ""
+		}
+	]`, output)
+	require.NotNil(t, failure)
+	require.Len(t, failure.TxResult, 1)
+	diff := failure.TxResult[0]
+	require.Equal(t, "log topic", diff.Field)
+	require.Equal(t, "[0] [1]", diff.Key)
+	require.Equal(t, "1000", diff.Actual.Mandos)
+	require.Equal(t, "0x03e8", diff.Actual.Hex)
+}
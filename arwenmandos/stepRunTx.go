@@ -95,6 +95,10 @@ func (ae *ArwenTestExecutor) executeTx(txIndex string, tx *mj.Transaction) (*vmc
 		default:
 			return nil, errors.New("unknown transaction type")
 		}
+
+		if tx.Type == mj.ScDeploy || tx.Type == mj.ScCall {
+			ae.totalGasUsed += gasForExecution - output.GasRemaining
+		}
 	}
 
 	if output.ReturnCode == vmcommon.Ok {
@@ -0,0 +1,307 @@
+package arwenmandos
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	mc "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/controller"
+	er "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/expression/reconstructor"
+	mjwrite "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/json/write"
+	mj "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/model"
+	oj "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/orderedjson"
+	worldmock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/world"
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	vmi "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+const checkStateStepID = "checkState"
+
+func (ae *ArwenTestExecutor) reviewExpectationChange(stepID string, field string, oldValue string, newValue string) bool {
+	return ae.expectationReviewer.ReviewChange(&mc.ExpectationChange{
+		StepID:   stepID,
+		Field:    field,
+		OldValue: oldValue,
+		NewValue: newValue,
+	})
+}
+
+// updateTxExpectations replaces the expected results that do not match the tx output, for every change the reviewer accepts.
+// Expectations that still match, including "*", are left untouched, so they keep their original form.
+func (ae *ArwenTestExecutor) updateTxExpectations(step *mj.TxStep, output *vmi.VMOutput) {
+	result := step.ExpectedResult
+	txID := step.TxIdent
+
+	returnCode := big.NewInt(int64(output.ReturnCode))
+	if !result.Status.Check(returnCode) {
+		updated := checkBigIntLike(result.Status, returnCode)
+		if ae.reviewExpectationChange(txID, "status", result.Status.Original, updated.Original) {
+			result.Status = updated
+		}
+	}
+
+	if !result.Message.Check([]byte(output.ReturnMessage)) {
+		updated := ae.checkBytesLike(result.Message, []byte(output.ReturnMessage), er.StrHint)
+		if ae.reviewExpectationChange(txID, "message", checkBytesPretty(result.Message), checkBytesPretty(updated)) {
+			result.Message = updated
+		}
+	}
+
+	if !result.Out.CheckList(output.ReturnData) {
+		updated := ae.checkValueListLike(result.Out, output.ReturnData, er.NoHint)
+		if ae.reviewExpectationChange(txID, "out", checkBytesListPretty(result.Out), checkBytesListPretty(updated)) {
+			result.Out = updated
+		}
+	}
+
+	if !result.Refund.Check(output.GasRefund) {
+		updated := checkBigIntLike(result.Refund, output.GasRefund)
+		if ae.reviewExpectationChange(txID, "refund", result.Refund.Original, updated.Original) {
+			result.Refund = updated
+		}
+	}
+
+	// unspecified gas is not checked, so it is not added either
+	if ae.checkGas && !result.Gas.IsUnspecified() && !result.Gas.Check(output.GasRemaining) {
+		updated := checkUint64Like(result.Gas, output.GasRemaining)
+		if ae.reviewExpectationChange(txID, "gas", result.Gas.Original, updated.Original) {
+			result.Gas = updated
+		}
+	}
+
+	// logs matched by event are left to the regular check
+	if !result.Logs.MatchEvents && len(ae.diffTxLogs(result.Logs, output.Logs)) > 0 {
+		updated := ae.logListLike(result.Logs, output.Logs)
+		if ae.reviewExpectationChange(txID, "logs", logListPretty(result.Logs), logListPretty(updated)) {
+			result.Logs = updated
+		}
+	}
+}
+
+// updateCheckAccounts replaces the nonce, balance, username, owner and storage expectations
+// that do not match the world, for every change the reviewer accepts.
+// Missing or unexpected accounts, code and ESDT mismatches are left to the regular check.
+func (ae *ArwenTestExecutor) updateCheckAccounts(checkAccounts *mj.CheckAccounts) {
+	for _, expectedAcct := range checkAccounts.Accounts {
+		matchingAcct, isMatch := ae.World.AcctMap[string(expectedAcct.Address.Value)]
+		if !isMatch {
+			continue
+		}
+		address := expectedAcct.Address.Original
+
+		if !expectedAcct.Nonce.Check(matchingAcct.Nonce) {
+			updated := checkUint64Like(expectedAcct.Nonce, matchingAcct.Nonce)
+			if ae.reviewExpectationChange(checkStateStepID, address+" nonce", expectedAcct.Nonce.Original, updated.Original) {
+				expectedAcct.Nonce = updated
+			}
+		}
+
+		if !expectedAcct.Balance.Check(matchingAcct.Balance) {
+			updated := checkBigIntLike(expectedAcct.Balance, matchingAcct.Balance)
+			if ae.reviewExpectationChange(checkStateStepID, address+" balance", expectedAcct.Balance.Original, updated.Original) {
+				expectedAcct.Balance = updated
+			}
+		}
+
+		if !expectedAcct.Username.Check(matchingAcct.Username) {
+			updated := ae.checkBytesLike(expectedAcct.Username, matchingAcct.Username, er.StrHint)
+			if ae.reviewExpectationChange(checkStateStepID, address+" username",
+				checkBytesPretty(expectedAcct.Username), checkBytesPretty(updated)) {
+				expectedAcct.Username = updated
+			}
+		}
+
+		if !expectedAcct.Owner.IsUnspecified() && !expectedAcct.Owner.Check(matchingAcct.OwnerAddress) {
+			updated := ae.checkBytesLike(expectedAcct.Owner, matchingAcct.OwnerAddress, er.AddressHint)
+			if ae.reviewExpectationChange(checkStateStepID, address+" owner",
+				checkBytesPretty(expectedAcct.Owner), checkBytesPretty(updated)) {
+				expectedAcct.Owner = updated
+			}
+		}
+
+		ae.updateCheckStorage(expectedAcct, matchingAcct)
+	}
+}
+
+func (ae *ArwenTestExecutor) updateCheckStorage(expectedAcct *mj.CheckAccount, matchingAcct *worldmock.Account) {
+	if expectedAcct.IgnoreStorage {
+		return
+	}
+	address := expectedAcct.Address.Original
+
+	expectedKeys := make(map[string]bool)
+	for _, stkvp := range expectedAcct.CheckStorage {
+		expectedKeys[string(stkvp.Key.Value)] = true
+		have := matchingAcct.StorageValue(string(stkvp.Key.Value))
+		if stkvp.CheckValue.Check(have) {
+			continue
+		}
+
+		updated := ae.checkBytesLike(stkvp.CheckValue, have, er.NoHint)
+		field := fmt.Sprintf("%s storage %s", address, stkvp.Key.Original)
+		if ae.reviewExpectationChange(checkStateStepID, field, checkBytesPretty(stkvp.CheckValue), checkBytesPretty(updated)) {
+			stkvp.CheckValue = updated
+		}
+	}
+
+	if expectedAcct.MoreStorageAllowed {
+		return
+	}
+
+	// keys missing from the expectation are added in key order, to keep the output deterministic
+	unexpectedKeys := make([]string, 0)
+	for key, value := range matchingAcct.Storage {
+		if !expectedKeys[key] && len(value) > 0 && !strings.HasPrefix(key, core.ElrondProtectedKeyPrefix) {
+			unexpectedKeys = append(unexpectedKeys, key)
+		}
+	}
+	sort.Strings(unexpectedKeys)
+
+	for _, key := range unexpectedKeys {
+		keyOriginal := ae.exprReconstructor.ReconstructExpression([]byte(key), er.StrHint)
+		updated := ae.checkBytesLike(mj.JSONCheckBytesUnspecified(), matchingAcct.Storage[key], er.NoHint)
+		field := fmt.Sprintf("%s storage %s", address, keyOriginal)
+		if ae.reviewExpectationChange(checkStateStepID, field, "", checkBytesPretty(updated)) {
+			expectedAcct.CheckStorage = append(expectedAcct.CheckStorage, &mj.CheckStorageKeyValuePair{
+				Key:        mj.JSONBytesFromString{Value: []byte(key), Original: keyOriginal},
+				CheckValue: updated,
+			})
+		}
+	}
+}
+
+// checkBytesLike creates an expectation for the value, written in the notation of the old expectation
+func (ae *ArwenTestExecutor) checkBytesLike(
+	old mj.JSONCheckBytes,
+	value []byte,
+	hint er.ExprReconstructorHint,
+) mj.JSONCheckBytes {
+	original := ""
+	if str, isStr := old.Original.(*oj.OJsonString); isStr && !old.IsUnspecified() {
+		original = str.Value
+	}
+	return mj.JSONCheckBytesReconstructed(value, ae.exprReconstructor.ReconstructLike(value, original, hint))
+}
+
+// checkValueListLike creates an expectation for the values, keeping the old items that still match
+func (ae *ArwenTestExecutor) checkValueListLike(
+	old mj.JSONCheckValueList,
+	values [][]byte,
+	hint er.ExprReconstructorHint,
+) mj.JSONCheckValueList {
+	updated := mj.JSONCheckValueList{
+		Values: make([]mj.JSONCheckBytes, len(values)),
+	}
+	for i, value := range values {
+		if i >= len(old.Values) {
+			updated.Values[i] = ae.checkBytesLike(mj.JSONCheckBytesUnspecified(), value, hint)
+			continue
+		}
+		if old.Values[i].Check(value) {
+			updated.Values[i] = old.Values[i]
+			continue
+		}
+		updated.Values[i] = ae.checkBytesLike(old.Values[i], value, hint)
+	}
+	return updated
+}
+
+// logListLike creates an expectation for the logs, keeping the old entries that still match.
+// If more logs were allowed at the end, the expectation does not grow beyond the old number of entries.
+func (ae *ArwenTestExecutor) logListLike(old mj.LogList, logs []*vmi.LogEntry) mj.LogList {
+	numEntries := len(logs)
+	if old.MoreAllowedAtEnd && len(old.List) < numEntries {
+		numEntries = len(old.List)
+	}
+
+	updated := mj.LogList{
+		MoreAllowedAtEnd: old.MoreAllowedAtEnd,
+		List:             make([]*mj.LogEntry, numEntries),
+	}
+	for i := 0; i < numEntries; i++ {
+		oldEntry := &mj.LogEntry{
+			Address:  mj.JSONCheckBytesUnspecified(),
+			Endpoint: mj.JSONCheckBytesUnspecified(),
+			Data:     mj.JSONCheckBytesUnspecified(),
+		}
+		if i < len(old.List) {
+			oldEntry = old.List[i]
+			if len(ae.diffTxLog("", oldEntry, logs[i])) == 0 {
+				updated.List[i] = oldEntry
+				continue
+			}
+		}
+
+		updated.List[i] = &mj.LogEntry{
+			Address:    ae.checkBytesLikeIfMismatch(oldEntry.Address, logs[i].Address, er.AddressHint),
+			Endpoint:   ae.checkBytesLikeIfMismatch(oldEntry.Endpoint, logs[i].Identifier, er.StrHint),
+			Topics:     oldEntry.Topics,
+			Data:       ae.checkBytesLikeIfMismatch(oldEntry.Data, logs[i].Data, er.NoHint),
+			TopicTypes: oldEntry.TopicTypes,
+		}
+		if !oldEntry.CheckTopics(logs[i].Topics) {
+			updated.List[i].Topics = ae.checkValueListLike(oldEntry.Topics, logs[i].Topics, er.NoHint)
+		}
+	}
+	return updated
+}
+
+func (ae *ArwenTestExecutor) checkBytesLikeIfMismatch(
+	old mj.JSONCheckBytes,
+	value []byte,
+	hint er.ExprReconstructorHint,
+) mj.JSONCheckBytes {
+	if !old.IsUnspecified() && old.Check(value) {
+		return old
+	}
+	return ae.checkBytesLike(old, value, hint)
+}
+
+// checkBigIntLike creates an expectation for the value, keeping hexadecimal notation if the old expectation used it
+func checkBigIntLike(old mj.JSONCheckBigInt, value *big.Int) mj.JSONCheckBigInt {
+	return mj.JSONCheckBigInt{
+		Value:    big.NewInt(0).Set(value),
+		Original: numberLike(old.Original, value),
+	}
+}
+
+// checkUint64Like creates an expectation for the value, keeping hexadecimal notation if the old expectation used it
+func checkUint64Like(old mj.JSONCheckUint64, value uint64) mj.JSONCheckUint64 {
+	return mj.JSONCheckUint64{
+		Value:    value,
+		Original: numberLike(old.Original, big.NewInt(0).SetUint64(value)),
+	}
+}
+
+func numberLike(oldOriginal string, value *big.Int) string {
+	if !strings.HasPrefix(oldOriginal, "0x") || value.Sign() < 0 {
+		return value.String()
+	}
+	if value.Sign() == 0 {
+		return "0x00"
+	}
+	return "0x" + hex.EncodeToString(value.Bytes())
+}
+
+func checkBytesPretty(checkBytes mj.JSONCheckBytes) string {
+	if checkBytes.Original == nil {
+		return ""
+	}
+	return oj.JSONString(checkBytes.Original)
+}
+
+func logListPretty(logList mj.LogList) string {
+	if logList.IsStar {
+		return "*"
+	}
+	entries := make([]string, 0, len(logList.List)+1)
+	for _, entry := range logList.List {
+		entries = append(entries, mjwrite.LogToString(entry))
+	}
+	if logList.MoreAllowedAtEnd {
+		entries = append(entries, "\"+\"")
+	}
+	return "[" + strings.Join(entries, ", ") + "]"
+}
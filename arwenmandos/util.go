@@ -6,7 +6,6 @@ import (
 	"math/big"
 
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/esdtconvert"
-	er "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/expression/reconstructor"
 	mj "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/model"
 	worldmock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/world"
 	"github.com/ElrondNetwork/elrond-go-core/core"
@@ -129,30 +128,6 @@ func convertBlockInfo(testBlockInfo *mj.BlockInfo, currentInfo *worldmock.BlockI
 	return currentInfo
 }
 
-// this is a small hack, so we can reuse mandos's JSON printing in error messages
-func (ae *ArwenTestExecutor) convertLogToTestFormat(outputLog *vmcommon.LogEntry) *mj.LogEntry {
-	topics := mj.JSONCheckValueList{
-		Values: make([]mj.JSONCheckBytes, len(outputLog.Topics)),
-	}
-	for i, topic := range outputLog.Topics {
-		topics.Values[i] = mj.JSONCheckBytesReconstructed(topic, "")
-	}
-	testLog := mj.LogEntry{
-		Address: mj.JSONCheckBytesReconstructed(
-			outputLog.Address,
-			ae.exprReconstructor.Reconstruct(outputLog.Address,
-				er.AddressHint)),
-		Endpoint: mj.JSONCheckBytesReconstructed(
-			outputLog.Identifier,
-			ae.exprReconstructor.Reconstruct(outputLog.Identifier,
-				er.StrHint)),
-		Data:   mj.JSONCheckBytesReconstructed(outputLog.Data, ""),
-		Topics: topics,
-	}
-
-	return &testLog
-}
-
 func generateTxHash(txIndex string) []byte {
 	txIndexBytes := []byte(txIndex)
 	if len(txIndexBytes) > 32 {
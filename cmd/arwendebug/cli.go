@@ -1,6 +1,7 @@
 package main
 
 import (
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwendebug"
 	"github.com/urfave/cli"
 )
@@ -20,6 +21,12 @@ func initializeCLI(facade *arwendebug.DebugFacade) *cli.App {
 	}
 
 	// Common for all actions
+	flagGoBackend := cli.BoolFlag{
+		Name:        "go-backend",
+		Usage:       "execute the contracts with the pure Go interpreter, which returns the call stacks of the traps",
+		Destination: &args.GoBackend,
+	}
+
 	flagDatabase := cli.StringFlag{
 		Name:        "database",
 		Destination: &args.Database,
@@ -77,6 +84,12 @@ func initializeCLI(facade *arwendebug.DebugFacade) *cli.App {
 		Destination: &args.GasPrice,
 	}
 
+	flagESDTPayments := cli.StringSliceFlag{
+		Name:  "esdt",
+		Usage: "ESDT payment, as TOKEN:NONCE:VALUE (repeatable)",
+		Value: &args.ESDTPayments,
+	}
+
 	// For deploy / upgrade
 	flagCode := cli.StringFlag{
 		Name:        "code",
@@ -93,6 +106,13 @@ func initializeCLI(facade *arwendebug.DebugFacade) *cli.App {
 		Destination: &args.CodeMetadata,
 	}
 
+	// For simulate
+	flagOverrides := cli.StringFlag{
+		Name:        "overrides",
+		Usage:       "JSON file with the overrides of the accounts and of the block",
+		Destination: &args.OverridesPath,
+	}
+
 	// For create-account
 	flagAccountAddress := cli.StringFlag{
 		Required:    true,
@@ -114,7 +134,98 @@ func initializeCLI(facade *arwendebug.DebugFacade) *cli.App {
 		Destination: &args.AccountNonce,
 	}
 
-	app.Flags = []cli.Flag{}
+	// For ESDT actions
+	flagTokenIdentifier := cli.StringFlag{
+		Required:    true,
+		Name:        "token",
+		Usage:       "token identifier, as TICKER-random",
+		Destination: &args.TokenIdentifier,
+	}
+
+	flagTokenType := cli.StringFlag{
+		Name:        "token-type",
+		Usage:       "FungibleESDT (default), NonFungibleESDT or SemiFungibleESDT",
+		Destination: &args.TokenType,
+	}
+
+	flagTokenSupply := cli.StringFlag{
+		Name:        "supply",
+		Usage:       "initial supply of a fungible token",
+		Destination: &args.TokenSupply,
+	}
+
+	flagTokenRoles := cli.StringSliceFlag{
+		Name:  "roles",
+		Usage: "ESDT role (repeatable)",
+		Value: &args.TokenRoles,
+	}
+
+	flagTokenNonce := cli.Uint64Flag{
+		Name:        "token-nonce",
+		Usage:       "nonce of the NFT, 0 for a fungible token",
+		Destination: &args.TokenNonce,
+	}
+
+	flagTokenAmount := cli.StringFlag{
+		Required:    true,
+		Name:        "amount",
+		Destination: &args.TokenAmount,
+	}
+
+	flagNFTQuantity := cli.StringFlag{
+		Name:        "quantity",
+		Usage:       "quantity of the NFT, 1 by default",
+		Destination: &args.NFTQuantity,
+	}
+
+	flagNFTName := cli.StringFlag{
+		Name:        "name",
+		Destination: &args.NFTName,
+	}
+
+	flagNFTRoyalties := cli.UintFlag{
+		Name:        "royalties",
+		Usage:       "royalties of the NFT, out of 10000",
+		Destination: &args.NFTRoyalties,
+	}
+
+	flagNFTHash := cli.StringFlag{
+		Name:        "hash",
+		Destination: &args.NFTHash,
+	}
+
+	flagNFTAttributes := cli.StringFlag{
+		Name:        "attributes",
+		Destination: &args.NFTAttributes,
+	}
+
+	flagNFTURIs := cli.StringSliceFlag{
+		Name:  "uris",
+		Usage: "URI of the NFT (repeatable)",
+		Value: &args.NFTURIs,
+	}
+
+	flagSender := cli.StringFlag{
+		Required:    true,
+		Name:        "sender",
+		Destination: &args.Sender,
+	}
+
+	flagReceiver := cli.StringFlag{
+		Required:    true,
+		Name:        "receiver",
+		Destination: &args.Receiver,
+	}
+
+	app.Flags = []cli.Flag{
+		flagGoBackend,
+	}
+	app.Before = func(context *cli.Context) error {
+		if args.GoBackend {
+			facade.SetWasmBackend(arwen.GoBackend)
+		}
+		return nil
+	}
 
 	app.Authors = []cli.Author{
 		{
@@ -194,6 +305,7 @@ func initializeCLI(facade *arwendebug.DebugFacade) *cli.App {
 				flagFunction,
 				flagArguments,
 				flagValue,
+				flagESDTPayments,
 				flagGasLimit,
 				flagGasPrice,
 			},
@@ -216,6 +328,28 @@ func initializeCLI(facade *arwendebug.DebugFacade) *cli.App {
 				flagGasLimit,
 			},
 		},
+		{
+			Name:        "simulate",
+			Description: "simulate a smart contract call on top of overrides, without storing its changes",
+			Action: func(context *cli.Context) error {
+				_, err := facade.SimulateSmartContract(args.toSimulateRequest())
+				return err
+			},
+			Flags: []cli.Flag{
+				flagOutcome,
+				flagWorld,
+				flagDatabase,
+				flagContract,
+				flagImpersonated,
+				flagFunction,
+				flagArguments,
+				flagValue,
+				flagESDTPayments,
+				flagGasLimit,
+				flagGasPrice,
+				flagOverrides,
+			},
+		},
 		{
 			Name:        "create-account",
 			Description: "create account",
@@ -232,6 +366,108 @@ func initializeCLI(facade *arwendebug.DebugFacade) *cli.App {
 				flagAccountNonce,
 			},
 		},
+		{
+			Name:        "esdt-issue",
+			Description: "issue a token, giving its roles and its initial supply to an account",
+			Action: func(context *cli.Context) error {
+				_, err := facade.IssueToken(args.toIssueTokenRequest())
+				return err
+			},
+			Flags: []cli.Flag{
+				flagOutcome,
+				flagWorld,
+				flagDatabase,
+				flagAccountAddress,
+				flagTokenIdentifier,
+				flagTokenType,
+				flagTokenSupply,
+				flagTokenRoles,
+			},
+		},
+		{
+			Name:        "esdt-set-roles",
+			Description: "set the ESDT roles of an account",
+			Action: func(context *cli.Context) error {
+				_, err := facade.SetTokenRoles(args.toSetTokenRolesRequest())
+				return err
+			},
+			Flags: []cli.Flag{
+				flagOutcome,
+				flagWorld,
+				flagDatabase,
+				flagAccountAddress,
+				flagTokenIdentifier,
+				flagTokenRoles,
+			},
+		},
+		{
+			Name:        "esdt-mint",
+			Description: "add to the ESDT balance of an account, or to the quantity of one of its NFTs",
+			Action: func(context *cli.Context) error {
+				_, err := facade.MintTokens(args.toMintTokensRequest())
+				return err
+			},
+			Flags: []cli.Flag{
+				flagOutcome,
+				flagWorld,
+				flagDatabase,
+				flagAccountAddress,
+				flagTokenIdentifier,
+				flagTokenNonce,
+				flagTokenAmount,
+			},
+		},
+		{
+			Name:        "esdt-create-nft",
+			Description: "create an NFT on an account",
+			Action: func(context *cli.Context) error {
+				_, err := facade.CreateNFT(args.toCreateNFTRequest())
+				return err
+			},
+			Flags: []cli.Flag{
+				flagOutcome,
+				flagWorld,
+				flagDatabase,
+				flagAccountAddress,
+				flagTokenIdentifier,
+				flagNFTQuantity,
+				flagNFTName,
+				flagNFTRoyalties,
+				flagNFTHash,
+				flagNFTAttributes,
+				flagNFTURIs,
+			},
+		},
+		{
+			Name:        "esdt-transfer",
+			Description: "transfer ESDT tokens and NFTs between accounts",
+			Action: func(context *cli.Context) error {
+				_, err := facade.TransferTokens(args.toTransferTokensRequest())
+				return err
+			},
+			Flags: []cli.Flag{
+				flagOutcome,
+				flagWorld,
+				flagDatabase,
+				flagSender,
+				flagReceiver,
+				flagESDTPayments,
+			},
+		},
+		{
+			Name:        "esdt-inventory",
+			Description: "show the tokens, NFTs and ESDT roles of an account",
+			Action: func(context *cli.Context) error {
+				_, err := facade.GetTokenInventory(args.toGetTokenInventoryRequest())
+				return err
+			},
+			Flags: []cli.Flag{
+				flagOutcome,
+				flagWorld,
+				flagDatabase,
+				flagAccountAddress,
+			},
+		},
 	}
 
 	return app
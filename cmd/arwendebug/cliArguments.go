@@ -11,6 +11,7 @@ type cliArguments struct {
 	Database      string
 	World         string
 	Outcome       string
+	GoBackend     bool
 	// For contract-related actions
 	Impersonated    string
 	ContractAddress string
@@ -23,10 +24,27 @@ type cliArguments struct {
 	Value           string
 	GasLimit        uint64
 	GasPrice        uint64
+	OverridesPath   string
+	ESDTPayments    cli.StringSlice
 	// For blockchain-related action
 	AccountAddress string
 	AccountBalance string
 	AccountNonce   uint64
+	// For ESDT actions
+	TokenIdentifier string
+	TokenType       string
+	TokenSupply     string
+	TokenRoles      cli.StringSlice
+	TokenNonce      uint64
+	TokenAmount     string
+	NFTQuantity     string
+	NFTName         string
+	NFTRoyalties    uint
+	NFTHash         string
+	NFTAttributes   string
+	NFTURIs         cli.StringSlice
+	Sender          string
+	Receiver        string
 }
 
 func (args *cliArguments) toDeployRequest() arwendebug.DeployRequest {
@@ -81,6 +99,7 @@ func (args *cliArguments) populateRunRequest(request *arwendebug.RunRequest) {
 	request.ContractAddressHex = args.ContractAddress
 	request.Function = args.Function
 	request.ArgumentsHex = args.Arguments
+	request.ESDTPaymentsArgs = args.ESDTPayments
 }
 
 func (args *cliArguments) toQueryRequest() arwendebug.QueryRequest {
@@ -90,6 +109,14 @@ func (args *cliArguments) toQueryRequest() arwendebug.QueryRequest {
 	return *request
 }
 
+func (args *cliArguments) toSimulateRequest() arwendebug.SimulateRequest {
+	request := &arwendebug.SimulateRequest{}
+	args.populateRunRequest(&request.RunRequest)
+
+	request.OverridesPath = args.OverridesPath
+	return *request
+}
+
 func (args *cliArguments) toCreateAccountRequest() arwendebug.CreateAccountRequest {
 	request := &arwendebug.CreateAccountRequest{}
 	args.populateRequestBase(&request.RequestBase)
@@ -99,3 +126,69 @@ func (args *cliArguments) toCreateAccountRequest() arwendebug.CreateAccountReque
 	request.Nonce = args.AccountNonce
 	return *request
 }
+
+func (args *cliArguments) toIssueTokenRequest() arwendebug.IssueTokenRequest {
+	request := &arwendebug.IssueTokenRequest{}
+	args.populateRequestBase(&request.RequestBase)
+
+	request.OwnerHex = args.AccountAddress
+	request.TokenIdentifier = args.TokenIdentifier
+	request.TokenType = args.TokenType
+	request.InitialSupply = args.TokenSupply
+	request.Roles = args.TokenRoles
+	return *request
+}
+
+func (args *cliArguments) toSetTokenRolesRequest() arwendebug.SetTokenRolesRequest {
+	request := &arwendebug.SetTokenRolesRequest{}
+	args.populateRequestBase(&request.RequestBase)
+
+	request.AddressHex = args.AccountAddress
+	request.TokenIdentifier = args.TokenIdentifier
+	request.Roles = args.TokenRoles
+	return *request
+}
+
+func (args *cliArguments) toMintTokensRequest() arwendebug.MintTokensRequest {
+	request := &arwendebug.MintTokensRequest{}
+	args.populateRequestBase(&request.RequestBase)
+
+	request.AddressHex = args.AccountAddress
+	request.TokenIdentifier = args.TokenIdentifier
+	request.Nonce = args.TokenNonce
+	request.Value = args.TokenAmount
+	return *request
+}
+
+func (args *cliArguments) toCreateNFTRequest() arwendebug.CreateNFTRequest {
+	request := &arwendebug.CreateNFTRequest{}
+	args.populateRequestBase(&request.RequestBase)
+
+	request.AddressHex = args.AccountAddress
+	request.TokenIdentifier = args.TokenIdentifier
+	request.Quantity = args.NFTQuantity
+	request.Name = args.NFTName
+	request.Royalties = uint32(args.NFTRoyalties)
+	request.HashHex = args.NFTHash
+	request.AttributesHex = args.NFTAttributes
+	request.URIs = args.NFTURIs
+	return *request
+}
+
+func (args *cliArguments) toTransferTokensRequest() arwendebug.TransferTokensRequest {
+	request := &arwendebug.TransferTokensRequest{}
+	args.populateRequestBase(&request.RequestBase)
+
+	request.SenderHex = args.Sender
+	request.ReceiverHex = args.Receiver
+	request.ESDTPaymentsArgs = args.ESDTPayments
+	return *request
+}
+
+func (args *cliArguments) toGetTokenInventoryRequest() arwendebug.GetTokenInventoryRequest {
+	request := &arwendebug.GetTokenInventoryRequest{}
+	args.populateRequestBase(&request.RequestBase)
+
+	request.AddressHex = args.AccountAddress
+	return *request
+}
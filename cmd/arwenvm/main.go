@@ -0,0 +1,25 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/ipc/common"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/ipc/vmpart"
+)
+
+// Runs Arwen in a separate process, started by the VM driver of the node (see ipc/nodepart).
+// The node sends its requests on the file descriptor 3, and receives the responses on the file descriptor 4.
+func main() {
+	nodeToVM := os.NewFile(common.NodeToVMFileDescriptor, "nodeToVM")
+	vmToNode := os.NewFile(common.VMToNodeFileDescriptor, "vmToNode")
+
+	part, err := vmpart.NewVMPart(nodeToVM, vmToNode, vmpart.NewArwenHost)
+	if err == nil {
+		err = part.Start()
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -1,20 +1,50 @@
 package main
 
 import (
+	"flag"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strings"
 
 	mc "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/controller"
+	mjschema "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/json/schema"
+	mjwrite "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/json/write"
 )
 
 func main() {
-	if len(os.Args) != 2 {
+	check := flag.Bool("check", false, "only report the files that are invalid or not formatted, without rewriting them")
+	printSchema := flag.Bool("schema", false, "print the JSON Schema of the Mandos files and exit")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [-check] <path>\n       %s -schema\n", os.Args[0], os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if *printSchema {
+		fmt.Print(mjschema.MandosSchema().ToJSONString())
+		return
+	}
+
+	if flag.NArg() != 1 {
 		panic("One argument expected - the root path where to search.")
 	}
 
-	_ = convertAllInFolder(os.Args[1])
+	if *check {
+		problemCount, err := checkAllInFolder(flag.Arg(0))
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		if problemCount > 0 {
+			fmt.Printf("%d file(s) need attention.\n", problemCount)
+			os.Exit(1)
+		}
+		return
+	}
+
+	_ = convertAllInFolder(flag.Arg(0))
 }
 
 var suffixes = []string{".scen.json", ".step.json", ".steps.json"}
@@ -47,3 +77,50 @@ func upgradeMandosFile(mandosFilePath string) {
 		fmt.Printf("Error upgrading: %s\n", err.Error())
 	}
 }
+
+// checkAllInFolder reports the invalid and the unformatted files, and yields how many there are.
+func checkAllInFolder(path string) (int, error) {
+	problemCount := 0
+	err := filepath.Walk(path, func(mandosFilePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if shouldFormatFile(mandosFilePath) && !checkMandosFile(mandosFilePath) {
+			problemCount++
+		}
+		return nil
+	})
+	return problemCount, err
+}
+
+// checkMandosFile prints the problems of a file, if any, and tells if it is valid and formatted
+func checkMandosFile(mandosFilePath string) bool {
+	validationErrors, err := mc.ValidateMandosScenario(mandosFilePath)
+	if err != nil {
+		fmt.Printf("%s: %s\n", mandosFilePath, err.Error())
+		return false
+	}
+	if len(validationErrors) > 0 {
+		for _, validationErr := range validationErrors {
+			fmt.Printf("%s: %s\n", mandosFilePath, validationErr.Error())
+		}
+		return false
+	}
+
+	scenario, err := mc.ParseMandosScenarioDefaultParser(mandosFilePath)
+	if err != nil {
+		// valid steps files can reference variables bound by the scenarios running them,
+		// they cannot be parsed, nor formatted, on their own
+		return true
+	}
+	contents, err := ioutil.ReadFile(mandosFilePath)
+	if err != nil {
+		fmt.Printf("%s: %s\n", mandosFilePath, err.Error())
+		return false
+	}
+	if string(contents) != mjwrite.ScenarioToJSONString(scenario) {
+		fmt.Printf("%s: not formatted\n", mandosFilePath)
+		return false
+	}
+	return true
+}
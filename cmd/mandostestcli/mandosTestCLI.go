@@ -1,12 +1,16 @@
 package mandostestcli
 
 import (
+	"errors"
 	"flag"
 	"fmt"
 	"os"
+	"os/signal"
 	"path/filepath"
 	"strings"
+	"time"
 
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
 	am "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwenmandos"
 	mc "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/controller"
 )
@@ -24,13 +28,177 @@ func resolveArgument(exeDir string, arg string) (string, bool, error) {
 	return arg, fi.IsDir(), nil
 }
 
-func parseOptionFlags() *mc.RunScenarioOptions {
+// patternList collects the values of a flag that can be given several times, or as a comma separated list
+type patternList []string
+
+func (patterns *patternList) String() string {
+	return strings.Join(*patterns, ",")
+}
+
+func (patterns *patternList) Set(value string) error {
+	for _, pattern := range strings.Split(value, ",") {
+		if len(pattern) > 0 {
+			*patterns = append(*patterns, pattern)
+		}
+	}
+	return nil
+}
+
+type cliOptions struct {
+	scenarioOptions *mc.RunScenarioOptions
+	parallelOptions mc.ParallelRunOptions
+	junitReportPath string
+	jsonReportPath  string
+	color           bool
+	watch           bool
+	pollInterval    time.Duration
+	wasmBackend     arwen.WasmBackend
+}
+
+func parseOptionFlags() *cliOptions {
 	forceTraceGas := flag.Bool("force-trace-gas", false, "overrides the traceGas option in the scenarios")
+	numWorkers := flag.Int("parallel", 0, "number of scenarios run at the same time, when running a directory; 0 means the number of CPUs")
+	timeout := flag.Duration("timeout", 0, "time limit for each scenario, when running a directory, e.g. 30s; 0 means no limit")
+	var includePatterns, excludePatterns patternList
+	flag.Var(&includePatterns, "include", "only run the scenarios matching the glob, relative to the directory; \"**\" matches any number of directories")
+	flag.Var(&excludePatterns, "exclude", "skip the scenarios matching the glob, relative to the directory")
+	junitReportPath := flag.String("junit", "", "write a JUnit XML report to the given file, when running a directory")
+	jsonReportPath := flag.String("json", "", "write a JSON report to the given file, when running a directory")
+	update := flag.Bool("update", false, "rewrite the expectations of the scenarios from the actual results, instead of failing")
+	review := flag.Bool("review", false, "like -update, but asks to accept or reject each change")
+	watch := flag.Bool("watch", false, "keep running, and re-run the scenarios whose files change: the scenario, its externalSteps and its \"file:\" values")
+	pollInterval := flag.Duration("poll", mc.DefaultWatchPollInterval, "how often to check the files for changes, in watch mode")
+	noColor := flag.Bool("no-color", false, "do not color the differences found by failed checks, even when printing to a terminal")
+	goBackend := flag.Bool("go-backend", false, "execute the contracts with the pure Go interpreter instead of Wasmer")
 	flag.Parse()
 
-	return &mc.RunScenarioOptions{
-		ForceTraceGas: *forceTraceGas,
+	color := !*noColor && isTerminal(os.Stdout)
+
+	scenarioOptions := &mc.RunScenarioOptions{
+		ForceTraceGas:      *forceTraceGas,
+		UpdateExpectations: *update || *review,
+	}
+	if *review {
+		scenarioOptions.ExpectationReviewer = mc.NewInteractiveReviewer(os.Stdin, os.Stdout)
+		// one scenario at a time, so that the prompts are not interleaved with the progress of other scenarios
+		*numWorkers = 1
+	}
+	return &cliOptions{
+		scenarioOptions: scenarioOptions,
+		parallelOptions: mc.ParallelRunOptions{
+			NumWorkers:      *numWorkers,
+			Timeout:         *timeout,
+			IncludePatterns: includePatterns,
+			ExcludePatterns: excludePatterns,
+			ScenarioOptions: scenarioOptions,
+			Color:           color,
+		},
+		junitReportPath: *junitReportPath,
+		jsonReportPath:  *jsonReportPath,
+		color:           color,
+		watch:           *watch,
+		pollInterval:    *pollInterval,
+		wasmBackend:     wasmBackendOption(*goBackend),
+	}
+}
+
+func wasmBackendOption(goBackend bool) arwen.WasmBackend {
+	if goBackend {
+		return arwen.GoBackend
+	}
+	return arwen.WasmerBackend
+}
+
+func isTerminal(file *os.File) bool {
+	fileInfo, err := file.Stat()
+	if err != nil {
+		return false
+	}
+	return fileInfo.Mode()&os.ModeCharDevice != 0
+}
+
+func (options *cliOptions) newExecutor() (*am.ArwenTestExecutor, error) {
+	executor, err := am.NewArwenTestExecutor()
+	if err != nil {
+		return nil, err
+	}
+	executor.SetWasmBackend(options.wasmBackend)
+	return executor, nil
+}
+
+func (options *cliOptions) newScenarioExecutor() (mc.ScenarioExecutor, error) {
+	executor, err := options.newExecutor()
+	if err != nil {
+		return nil, err
+	}
+	return executor, nil
+}
+
+// runDirectory runs all the scenarios in the directory in parallel and writes the requested reports
+func runDirectory(dirPath string, options *cliOptions) error {
+	runner := mc.NewParallelScenarioRunner(options.newScenarioExecutor, options.parallelOptions)
+	report, runErr := runner.RunAllJSONScenariosInDirectory(dirPath, "", ".scen.json")
+	if report == nil {
+		return runErr
+	}
+
+	if len(options.junitReportPath) > 0 {
+		err := writeReport(options.junitReportPath, func(file *os.File) error {
+			return report.WriteJUnitXML(file, filepath.Base(dirPath))
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if len(options.jsonReportPath) > 0 {
+		err := writeReport(options.jsonReportPath, func(file *os.File) error {
+			return report.WriteJSON(file)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return runErr
+}
+
+// watch runs the scenarios, then re-runs the affected ones whenever their files change, until interrupted
+func watch(testPath string, options *cliOptions) error {
+	if !strings.HasSuffix(testPath, ".scen.json") {
+		fi, err := os.Stat(testPath)
+		if err != nil {
+			return err
+		}
+		if !fi.IsDir() {
+			return errors.New("watch mode only runs scenarios, i.e. .scen.json files")
+		}
+	}
+
+	runner := mc.NewParallelScenarioRunner(options.newScenarioExecutor, options.parallelOptions)
+	watcher := mc.NewScenarioWatcher(runner, testPath, ".scen.json")
+	watcher.PollInterval = options.pollInterval
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	stop := make(chan struct{})
+	go func() {
+		<-interrupt
+		close(stop)
+	}()
+	return watcher.Watch(stop)
+}
+
+func writeReport(reportPath string, write func(file *os.File) error) error {
+	file, err := os.Create(reportPath)
+	if err != nil {
+		return err
 	}
+	err = write(file)
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+	return file.Close()
 }
 
 // MandosTestCLI provides the functionality for any mandos-go test executor.
@@ -55,8 +223,17 @@ func MandosTestCLI() {
 		os.Exit(1)
 	}
 
+	if options.watch {
+		err = watch(jsonFilePath, options)
+		if err != nil {
+			fmt.Printf("ERROR: %s\n", err.Error())
+			os.Exit(1)
+		}
+		return
+	}
+
 	// init
-	executor, err := am.NewArwenTestExecutor()
+	executor, err := options.newExecutor()
 	if err != nil {
 		panic("Could not instantiate Arwen VM")
 	}
@@ -64,22 +241,13 @@ func MandosTestCLI() {
 	// execute
 	switch {
 	case isDir:
-		runner := mc.NewScenarioRunner(
-			executor,
-			mc.NewDefaultFileResolver(),
-		)
-		err = runner.RunAllJSONScenariosInDirectory(
-			jsonFilePath,
-			"",
-			".scen.json",
-			[]string{},
-			options)
+		err = runDirectory(jsonFilePath, options)
 	case strings.HasSuffix(jsonFilePath, ".scen.json"):
 		runner := mc.NewScenarioRunner(
 			executor,
 			mc.NewDefaultFileResolver(),
 		)
-		err = runner.RunSingleJSONScenario(jsonFilePath, options)
+		err = runner.RunSingleJSONScenario(jsonFilePath, options.scenarioOptions)
 	default:
 		runner := mc.NewTestRunner(
 			executor,
@@ -92,7 +260,12 @@ func MandosTestCLI() {
 	if err == nil {
 		fmt.Println("SUCCESS")
 	} else {
-		fmt.Printf("ERROR: %s\n", err.Error())
+		var checkFailure *mc.CheckFailure
+		if errors.As(err, &checkFailure) {
+			fmt.Printf("ERROR: %s\n", checkFailure.Pretty(options.color))
+		} else {
+			fmt.Printf("ERROR: %s\n", err.Error())
+		}
 		os.Exit(1)
 	}
 }
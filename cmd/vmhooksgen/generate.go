@@ -0,0 +1,333 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"strings"
+	"unicode"
+)
+
+const (
+	vmHooksInterfaceName = "VMHooks"
+	importsNamespace     = "env"
+	exportPrefix         = "v1_4_"
+)
+
+var errUnsupportedSignature = errors.New("unsupported VMHooks signature")
+
+var cTypes = map[string]string{
+	"int32": "int32_t",
+	"int64": "long long",
+}
+
+var eeiValueTypes = map[string]string{
+	"int32": "ValueTypeI32",
+	"int64": "ValueTypeI64",
+}
+
+type hookParam struct {
+	name   string
+	goType string
+}
+
+// hookFunction is a method of the VMHooks, imported by the contracts under its name starting with a lowercase letter
+type hookFunction struct {
+	methodName string
+	params     []hookParam
+	result     string
+}
+
+func (function *hookFunction) importName() string {
+	runes := []rune(function.methodName)
+	runes[0] = unicode.ToLower(runes[0])
+	return string(runes)
+}
+
+func (function *hookFunction) exportName() string {
+	return exportPrefix + function.importName()
+}
+
+// parseVMHooks reads the functions of the VMHooks interface, in the order of its embedded interfaces
+func parseVMHooks(source []byte) ([]*hookFunction, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), "vmHooks.go", source, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	interfaces := make(map[string]*ast.InterfaceType)
+	for _, decl := range file.Decls {
+		genDecl, isGenDecl := decl.(*ast.GenDecl)
+		if !isGenDecl || genDecl.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			typeSpec := spec.(*ast.TypeSpec)
+			interfaceType, isInterface := typeSpec.Type.(*ast.InterfaceType)
+			if isInterface {
+				interfaces[typeSpec.Name.Name] = interfaceType
+			}
+		}
+	}
+
+	vmHooks, found := interfaces[vmHooksInterfaceName]
+	if !found {
+		return nil, fmt.Errorf("interface %s not found", vmHooksInterfaceName)
+	}
+
+	functions := make([]*hookFunction, 0)
+	err = appendInterfaceFunctions(&functions, interfaces, vmHooks)
+	if err != nil {
+		return nil, err
+	}
+	return functions, nil
+}
+
+func appendInterfaceFunctions(functions *[]*hookFunction, interfaces map[string]*ast.InterfaceType, interfaceType *ast.InterfaceType) error {
+	for _, field := range interfaceType.Methods.List {
+		switch fieldType := field.Type.(type) {
+		case *ast.Ident:
+			embedded, found := interfaces[fieldType.Name]
+			if !found {
+				return fmt.Errorf("interface %s not found", fieldType.Name)
+			}
+			err := appendInterfaceFunctions(functions, interfaces, embedded)
+			if err != nil {
+				return err
+			}
+		case *ast.FuncType:
+			function, err := newHookFunction(field.Names[0].Name, fieldType)
+			if err != nil {
+				return err
+			}
+			*functions = append(*functions, function)
+		default:
+			return fmt.Errorf("%w: unexpected field of type %T", errUnsupportedSignature, fieldType)
+		}
+	}
+	return nil
+}
+
+func newHookFunction(methodName string, funcType *ast.FuncType) (*hookFunction, error) {
+	function := &hookFunction{
+		methodName: methodName,
+	}
+
+	for _, param := range funcType.Params.List {
+		goType, err := supportedTypeName(methodName, param.Type)
+		if err != nil {
+			return nil, err
+		}
+		if len(param.Names) == 0 {
+			return nil, fmt.Errorf("%w: %s has unnamed parameters", errUnsupportedSignature, methodName)
+		}
+		for _, name := range param.Names {
+			function.params = append(function.params, hookParam{name: name.Name, goType: goType})
+		}
+	}
+
+	if funcType.Results == nil {
+		return function, nil
+	}
+	if len(funcType.Results.List) != 1 || len(funcType.Results.List[0].Names) > 1 {
+		return nil, fmt.Errorf("%w: %s has more than one result", errUnsupportedSignature, methodName)
+	}
+	result, err := supportedTypeName(methodName, funcType.Results.List[0].Type)
+	if err != nil {
+		return nil, err
+	}
+	function.result = result
+	return function, nil
+}
+
+func supportedTypeName(methodName string, expr ast.Expr) (string, error) {
+	ident, isIdent := expr.(*ast.Ident)
+	if isIdent {
+		_, supported := cTypes[ident.Name]
+		if supported {
+			return ident.Name, nil
+		}
+	}
+	return "", fmt.Errorf("%w: %s uses a type other than int32 and int64", errUnsupportedSignature, methodName)
+}
+
+// generateWasmerImports writes the cgo adapters of the functions, and the Wasmer imports that register them
+func generateWasmerImports(functions []*hookFunction) ([]byte, error) {
+	out := &bytes.Buffer{}
+	fmt.Fprintln(out, "// Code generated by vmhooksgen. DO NOT EDIT.")
+	fmt.Fprintln(out, "// Call `go generate` in `arwen-wasm-vm/arwen/vmhooks` to update it.")
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, "package vmhooks")
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, "// // Declare the function signatures (see [cgo](https://golang.org/cmd/cgo/)).")
+	fmt.Fprintln(out, "//")
+	fmt.Fprintln(out, "// #include <stdlib.h>")
+	fmt.Fprintln(out, "// typedef unsigned char uint8_t;")
+	fmt.Fprintln(out, "// typedef int int32_t;")
+	fmt.Fprintln(out, "//")
+	for _, function := range functions {
+		fmt.Fprintf(out, "// extern %s %s(%s);\n", cResultType(function), function.exportName(), cParams(function))
+	}
+	fmt.Fprintln(out, "import \"C\"")
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, "import (")
+	fmt.Fprintln(out, "\t\"unsafe\"")
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, "\t\"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen\"")
+	fmt.Fprintln(out, "\t\"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/wasmer\"")
+	fmt.Fprintln(out, ")")
+	fmt.Fprintln(out)
+
+	fmt.Fprintln(out, "// WasmerImports creates a new wasmer.Imports populated with all the functions of the VMHooks")
+	fmt.Fprintln(out, "func WasmerImports() (*wasmer.Imports, error) {")
+	fmt.Fprintln(out, "\timports := wasmer.NewImports()")
+	fmt.Fprintf(out, "\timports = imports.Namespace(%q)\n", importsNamespace)
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, "\tvar err error")
+	for _, function := range functions {
+		fmt.Fprintf(out, "\timports, err = imports.Append(%q, %s, C.%s)\n", function.importName(), function.exportName(), function.exportName())
+		fmt.Fprintln(out, "\tif err != nil {")
+		fmt.Fprintln(out, "\t\treturn nil, err")
+		fmt.Fprintln(out, "\t}")
+		fmt.Fprintln(out)
+	}
+	fmt.Fprintln(out, "\treturn imports, nil")
+	fmt.Fprintln(out, "}")
+
+	for _, function := range functions {
+		fmt.Fprintln(out)
+		fmt.Fprintf(out, "//export %s\n", function.exportName())
+		fmt.Fprintf(out, "func %s(%s)%s {\n", function.exportName(), goParams(function), goResult(function))
+		call := fmt.Sprintf("arwen.GetVMHost(context).VMHooks().%s(%s)", function.methodName, goArgs(function))
+		if len(function.result) > 0 {
+			fmt.Fprintf(out, "\treturn %s\n", call)
+		} else {
+			fmt.Fprintf(out, "\t%s\n", call)
+		}
+		fmt.Fprintln(out, "}")
+	}
+
+	return format.Source(out.Bytes())
+}
+
+// generateVMHooksWithMetrics writes the VMHooks decorator which counts the calls of each function
+func generateVMHooksWithMetrics(functions []*hookFunction) ([]byte, error) {
+	out := &bytes.Buffer{}
+	fmt.Fprintln(out, "// Code generated by vmhooksgen. DO NOT EDIT.")
+	fmt.Fprintln(out, "// Call `go generate` in `arwen-wasm-vm/arwen/vmhooks` to update it.")
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, "package vmhooks")
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, "import \"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen\"")
+	fmt.Fprintln(out)
+
+	fmt.Fprintln(out, "// vmHooksFunctionNames are the names of the functions of the VMHooks, as imported by the contracts")
+	fmt.Fprintf(out, "var vmHooksFunctionNames = [%d]string{\n", len(functions))
+	for _, function := range functions {
+		fmt.Fprintf(out, "\t%q,\n", function.importName())
+	}
+	fmt.Fprintln(out, "}")
+	fmt.Fprintln(out)
+
+	fmt.Fprintln(out, "// vmHooksWithMetrics counts the calls of the functions of the VMHooks, in the order of vmHooksFunctionNames")
+	fmt.Fprintln(out, "type vmHooksWithMetrics struct {")
+	fmt.Fprintln(out, "\thooks arwen.VMHooks")
+	fmt.Fprintf(out, "\tcalls [%d]arwen.MetricCounter\n", len(functions))
+	fmt.Fprintln(out, "}")
+
+	for i, function := range functions {
+		fmt.Fprintln(out)
+		fmt.Fprintf(out, "// %s counts the call and calls the wrapped VMHooks\n", function.methodName)
+		fmt.Fprintf(out, "func (decorator *vmHooksWithMetrics) %s(%s)%s {\n", function.methodName, methodParams(function), goResult(function))
+		fmt.Fprintf(out, "\tdecorator.calls[%d].Add(1)\n", i)
+		call := fmt.Sprintf("decorator.hooks.%s(%s)", function.methodName, goArgs(function))
+		if len(function.result) > 0 {
+			fmt.Fprintf(out, "\treturn %s\n", call)
+		} else {
+			fmt.Fprintf(out, "\t%s\n", call)
+		}
+		fmt.Fprintln(out, "}")
+	}
+
+	return format.Source(out.Bytes())
+}
+
+// generateEEISignatures writes the signatures of the functions, for the tools which need the EEI without the cgo adapters
+func generateEEISignatures(functions []*hookFunction) ([]byte, error) {
+	out := &bytes.Buffer{}
+	fmt.Fprintln(out, "// Code generated by vmhooksgen. DO NOT EDIT.")
+	fmt.Fprintln(out, "// Call `go generate` in `arwen-wasm-vm/arwen/vmhooks` to update it.")
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, "package eei")
+	fmt.Fprintln(out)
+
+	fmt.Fprintln(out, "// functionSignatures holds the signatures of the functions of the VMHooks, by the name under which contracts import them")
+	fmt.Fprintln(out, "var functionSignatures = map[string]FunctionSignature{")
+	for _, function := range functions {
+		fields := make([]string, 0, 2)
+		if len(function.params) > 0 {
+			params := make([]string, len(function.params))
+			for i, param := range function.params {
+				params[i] = eeiValueTypes[param.goType]
+			}
+			fields = append(fields, "Params: []ValueType{"+strings.Join(params, ", ")+"}")
+		}
+		if len(function.result) > 0 {
+			fields = append(fields, "Results: []ValueType{"+eeiValueTypes[function.result]+"}")
+		}
+		fmt.Fprintf(out, "\t%q: {%s},\n", function.importName(), strings.Join(fields, ", "))
+	}
+	fmt.Fprintln(out, "}")
+
+	return format.Source(out.Bytes())
+}
+
+func cResultType(function *hookFunction) string {
+	if len(function.result) == 0 {
+		return "void"
+	}
+	return cTypes[function.result]
+}
+
+func cParams(function *hookFunction) string {
+	params := []string{"void *context"}
+	for _, param := range function.params {
+		params = append(params, cTypes[param.goType]+" "+param.name)
+	}
+	return strings.Join(params, ", ")
+}
+
+func goParams(function *hookFunction) string {
+	params := []string{"context unsafe.Pointer"}
+	for _, param := range function.params {
+		params = append(params, param.name+" "+param.goType)
+	}
+	return strings.Join(params, ", ")
+}
+
+func methodParams(function *hookFunction) string {
+	params := make([]string, len(function.params))
+	for i, param := range function.params {
+		params[i] = param.name + " " + param.goType
+	}
+	return strings.Join(params, ", ")
+}
+
+func goResult(function *hookFunction) string {
+	if len(function.result) == 0 {
+		return ""
+	}
+	return " " + function.result
+}
+
+func goArgs(function *hookFunction) string {
+	args := make([]string, len(function.params))
+	for i, param := range function.params {
+		args[i] = param.name
+	}
+	return strings.Join(args, ", ")
+}
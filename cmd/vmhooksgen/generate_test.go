@@ -0,0 +1,101 @@
+package main
+
+import (
+	"errors"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateWasmerImports_UpToDate(t *testing.T) {
+	source, err := ioutil.ReadFile("../../arwen/vmHooks.go")
+	require.Nil(t, err)
+	functions, err := parseVMHooks(source)
+	require.Nil(t, err)
+
+	generated, err := generateWasmerImports(functions)
+	require.Nil(t, err)
+
+	existing, err := ioutil.ReadFile("../../arwen/vmhooks/wasmerImportsCgo.go")
+	require.Nil(t, err)
+	require.True(t, string(existing) == string(generated), "wasmerImportsCgo.go is outdated, run go generate in arwen/vmhooks")
+}
+
+func TestGenerateVMHooksWithMetrics_UpToDate(t *testing.T) {
+	source, err := ioutil.ReadFile("../../arwen/vmHooks.go")
+	require.Nil(t, err)
+	functions, err := parseVMHooks(source)
+	require.Nil(t, err)
+
+	generated, err := generateVMHooksWithMetrics(functions)
+	require.Nil(t, err)
+
+	existing, err := ioutil.ReadFile("../../arwen/vmhooks/vmHooksWithMetrics.go")
+	require.Nil(t, err)
+	require.True(t, string(existing) == string(generated), "vmHooksWithMetrics.go is outdated, run go generate in arwen/vmhooks")
+}
+
+func TestGenerateEEISignatures_UpToDate(t *testing.T) {
+	source, err := ioutil.ReadFile("../../arwen/vmHooks.go")
+	require.Nil(t, err)
+	functions, err := parseVMHooks(source)
+	require.Nil(t, err)
+
+	generated, err := generateEEISignatures(functions)
+	require.Nil(t, err)
+
+	existing, err := ioutil.ReadFile("../../arwen/eei/functions.go")
+	require.Nil(t, err)
+	require.True(t, string(existing) == string(generated), "functions.go is outdated, run go generate in arwen/vmhooks")
+}
+
+func TestParseVMHooks(t *testing.T) {
+	source := []byte(`package arwen
+
+type VMHooks interface {
+	FirstVMHooks
+	SecondVMHooks
+}
+
+type FirstVMHooks interface {
+	GetGasLeft() int64
+}
+
+type SecondVMHooks interface {
+	BigIntAdd(destinationHandle, op1Handle, op2Handle int32)
+}
+`)
+	functions, err := parseVMHooks(source)
+	require.Nil(t, err)
+	require.Len(t, functions, 2)
+
+	require.Equal(t, "getGasLeft", functions[0].importName())
+	require.Equal(t, "int64", functions[0].result)
+	require.Len(t, functions[0].params, 0)
+
+	require.Equal(t, "v1_4_bigIntAdd", functions[1].exportName())
+	require.Equal(t, "", functions[1].result)
+	require.Equal(t, []hookParam{
+		{name: "destinationHandle", goType: "int32"},
+		{name: "op1Handle", goType: "int32"},
+		{name: "op2Handle", goType: "int32"},
+	}, functions[1].params)
+}
+
+func TestParseVMHooks_UnsupportedSignatures(t *testing.T) {
+	unsupported := []string{
+		"SignalError(message []byte)",
+		"GetGasLeft() uint64",
+		"Transfer(int32, int32)",
+		"Split(handle int32) (int32, int32)",
+	}
+	for _, method := range unsupported {
+		source := []byte("package arwen\n\ntype VMHooks interface {\n\t" + method + "\n}\n")
+		_, err := parseVMHooks(source)
+		require.True(t, errors.Is(err, errUnsupportedSignature), method)
+	}
+
+	_, err := parseVMHooks([]byte("package arwen\n\ntype VMHooks interface {\n\tMissingVMHooks\n}\n"))
+	require.NotNil(t, err)
+}
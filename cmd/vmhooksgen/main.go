@@ -0,0 +1,63 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+)
+
+// Generates the cgo adapters of the VMHooks for Wasmer, the VMHooks decorator counting the calls
+// and the signatures of the EEI functions, from the VMHooks interface.
+func main() {
+	inputPath := flag.String("in", "arwen/vmHooks.go", "the file declaring the VMHooks interface")
+	outputPath := flag.String("out", "arwen/vmhooks/wasmerImportsCgo.go", "the generated file")
+	metricsOutputPath := flag.String("metrics", "arwen/vmhooks/vmHooksWithMetrics.go", "the generated file of the decorator counting the calls")
+	eeiOutputPath := flag.String("eei", "arwen/eei/functions.go", "the generated file of the EEI function signatures")
+	flag.Parse()
+
+	err := generateFile(*inputPath, *outputPath, *metricsOutputPath, *eeiOutputPath)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
+
+func generateFile(inputPath string, outputPath string, metricsOutputPath string, eeiOutputPath string) error {
+	source, err := ioutil.ReadFile(inputPath)
+	if err != nil {
+		return err
+	}
+
+	functions, err := parseVMHooks(source)
+	if err != nil {
+		return err
+	}
+
+	generated, err := generateWasmerImports(functions)
+	if err != nil {
+		return err
+	}
+
+	err = ioutil.WriteFile(outputPath, generated, 0644)
+	if err != nil {
+		return err
+	}
+
+	generated, err = generateVMHooksWithMetrics(functions)
+	if err != nil {
+		return err
+	}
+
+	err = ioutil.WriteFile(metricsOutputPath, generated, 0644)
+	if err != nil {
+		return err
+	}
+
+	generated, err = generateEEISignatures(functions)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(eeiOutputPath, generated, 0644)
+}
@@ -0,0 +1,238 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen/eei"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen/wasmanalysis"
+	gasSchedules "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwenmandos/gasSchedules"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/config"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-vm-common/builtInFunctions"
+	vmcommonmock "github.com/ElrondNetwork/elrond-vm-common/mock"
+)
+
+const (
+	exitCodeClean = iota
+	exitCodeIssues
+	exitCodeFailure
+)
+
+type contractReport struct {
+	Path   string               `json:"path"`
+	Error  string               `json:"error,omitempty"`
+	Report *wasmanalysis.Report `json:"report,omitempty"`
+}
+
+func main() {
+	gasScheduleName := flag.String("gas-schedule", "V4", "gas schedule used for the estimations: V3, V4 or the path to a gas schedule TOML file")
+	jsonOutput := flag.Bool("json", false, "print the reports as JSON")
+	maxAPILevel := flag.Uint("api-level", uint(eei.LatestAPILevel), "highest EEI API level enabled on the network, assigned to the contracts that declare none")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [options] contract.wasm...\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(exitCodeFailure)
+	}
+
+	gasMap, err := loadGasSchedule(*gasScheduleName)
+	if err != nil {
+		fmt.Printf("ERROR: cannot load gas schedule: %s\n", err.Error())
+		os.Exit(exitCodeFailure)
+	}
+
+	rules, err := createRules(gasMap, eei.APILevel(*maxAPILevel))
+	if err != nil {
+		fmt.Printf("ERROR: cannot prepare the VM rules: %s\n", err.Error())
+		os.Exit(exitCodeFailure)
+	}
+
+	exitCode := exitCodeClean
+	reports := make([]contractReport, 0, flag.NArg())
+	for _, path := range flag.Args() {
+		result := analyzeContract(path, rules)
+		if result.Error != "" {
+			exitCode = exitCodeFailure
+		} else if result.Report.HasErrors() && exitCode == exitCodeClean {
+			exitCode = exitCodeIssues
+		}
+		reports = append(reports, result)
+	}
+
+	if *jsonOutput {
+		encoded, _ := json.MarshalIndent(reports, "", "  ")
+		fmt.Println(string(encoded))
+	} else {
+		for _, result := range reports {
+			printReport(result)
+		}
+	}
+
+	os.Exit(exitCode)
+}
+
+func loadGasSchedule(name string) (config.GasScheduleMap, error) {
+	switch name {
+	case "V3":
+		return gasSchedules.LoadGasScheduleConfig(gasSchedules.GetV3())
+	case "V4":
+		return gasSchedules.LoadGasScheduleConfig(gasSchedules.GetV4())
+	}
+
+	contents, err := ioutil.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	return gasSchedules.LoadGasScheduleConfig(string(contents))
+}
+
+// createRules collects the EEI, the reserved names and the deployment costs from the same sources as the VM,
+// so that the analysis applies the same rules as an actual deployment. It only depends on packages without cgo,
+// so that the linter builds without the Wasmer library.
+func createRules(gasMap config.GasScheduleMap, maxAPILevel eei.APILevel) (wasmanalysis.Rules, error) {
+	builtinFunctionNames, err := getActiveBuiltinFunctionNames(gasMap)
+	if err != nil {
+		return wasmanalysis.Rules{}, err
+	}
+
+	gasCost, err := config.CreateGasConfig(gasMap)
+	if err != nil {
+		return wasmanalysis.Rules{}, err
+	}
+
+	eeiFunctions := make(map[string]wasmanalysis.FunctionType)
+	for name, signature := range eei.GetFunctionSignatures() {
+		eeiFunctions[name] = wasmanalysis.FunctionType{
+			Params:  convertValueTypes(signature.Params),
+			Results: convertValueTypes(signature.Results),
+		}
+	}
+
+	eeiVersions := make(map[string]wasmanalysis.APIVersion)
+	for name, version := range eei.GetVersionedFunctions() {
+		eeiVersions[name] = wasmanalysis.APIVersion{
+			Introduced: uint32(version.Introduced),
+			Deprecated: uint32(version.Deprecated),
+		}
+	}
+
+	// the VM reserves the EEI functions, which include upgradeContract, and the active builtin functions
+	reservedNames := make(map[string]struct{})
+	for name := range eeiFunctions {
+		reservedNames[name] = struct{}{}
+	}
+	for _, name := range builtinFunctionNames {
+		reservedNames[name] = struct{}{}
+	}
+
+	return wasmanalysis.Rules{
+		EEIFunctions:  eeiFunctions,
+		ReservedNames: reservedNames,
+		EEIVersions:   eeiVersions,
+		MaxAPILevel:   uint32(maxAPILevel),
+		Gas: wasmanalysis.DeployGasCosts{
+			CreateContract:    gasCost.ElrondAPICost.CreateContract,
+			CompilePerByte:    gasCost.BaseOperationCost.CompilePerByte,
+			GetCode:           gasCost.BaseOperationCost.GetCode,
+			AoTPreparePerByte: gasCost.BaseOperationCost.AoTPreparePerByte,
+		},
+	}, nil
+}
+
+// getActiveBuiltinFunctionNames creates the builtin functions the same way as the mock world of the VM,
+// on stub accounts, and returns the names of those active at the first epoch
+func getActiveBuiltinFunctionNames(gasMap config.GasScheduleMap) ([]string, error) {
+	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
+		GasMap:           gasMap,
+		MapDNSAddresses:  make(map[string]struct{}),
+		Marshalizer:      &marshal.GogoProtoMarshalizer{},
+		Accounts:         &vmcommonmock.AccountsStub{},
+		ShardCoordinator: vmcommonmock.NewMultiShardsCoordinatorMock(1),
+		EpochNotifier:    &vmcommonmock.EpochNotifierStub{},
+	}
+
+	builtinFuncFactory, err := builtInFunctions.NewBuiltInFunctionsCreator(argsBuiltIn)
+	if err != nil {
+		return nil, err
+	}
+
+	builtinFuncs, err := builtinFuncFactory.CreateBuiltInFunctionContainer()
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, builtinFuncs.Len())
+	for name := range builtinFuncs.Keys() {
+		function, err := builtinFuncs.Get(name)
+		if err != nil || !function.IsActive() {
+			continue
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+func convertValueTypes(valueTypes []eei.ValueType) []wasmanalysis.ValueType {
+	result := make([]wasmanalysis.ValueType, len(valueTypes))
+	for i, valueType := range valueTypes {
+		result[i] = wasmanalysis.ValueType(valueType)
+	}
+	return result
+}
+
+func analyzeContract(path string, rules wasmanalysis.Rules) contractReport {
+	code, err := ioutil.ReadFile(path)
+	if err != nil {
+		return contractReport{Path: path, Error: err.Error()}
+	}
+
+	report, err := wasmanalysis.Analyze(code, rules)
+	if err != nil {
+		return contractReport{Path: path, Error: err.Error()}
+	}
+
+	return contractReport{Path: path, Report: report}
+}
+
+func printReport(result contractReport) {
+	fmt.Printf("%s\n", result.Path)
+	if result.Error != "" {
+		fmt.Printf("  ERROR: %s\n\n", result.Error)
+		return
+	}
+
+	report := result.Report
+	exported := append([]string{}, report.ExportedFunctions...)
+	sort.Strings(exported)
+
+	fmt.Printf("  code size:          %d bytes\n", report.CodeSize)
+	if report.APILevelDeclared {
+		fmt.Printf("  API level:          %d, declared\n", report.APILevel)
+	} else {
+		fmt.Printf("  API level:          %d, assigned\n", report.APILevel)
+	}
+	fmt.Printf("  functions:          %d defined, %d imported, %d exported\n",
+		report.DefinedFunctions, report.ImportedFunctions, len(exported))
+	fmt.Printf("  memory:             %d pages (%d bytes)\n", report.MemoryPages, uint64(report.MemoryPages)*wasmanalysis.WasmPageSize)
+	fmt.Printf("  data:               %d bytes in %d segments\n", report.DataSize, report.DataSegments)
+	fmt.Printf("  deployment gas:     %d, excluding the init function\n", report.DeployGas)
+	fmt.Printf("  call overhead gas:  %d\n", report.CallOverheadGas)
+
+	if len(report.Issues) == 0 {
+		fmt.Printf("  OK\n\n")
+		return
+	}
+	for _, issue := range report.Issues {
+		fmt.Printf("  %s [%s]: %s\n", issue.Severity, issue.Rule, issue.Message)
+	}
+	fmt.Println()
+}
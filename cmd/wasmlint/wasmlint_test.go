@@ -0,0 +1,51 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen/contexts"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen/eei"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen/host"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen/wasmanalysis"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/config"
+	worldmock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/world"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/wasmer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateRules_SameAsVM(t *testing.T) {
+	gasMap := config.MakeGasMapForTests()
+	rules, err := createRules(gasMap, eei.LatestAPILevel)
+	require.Nil(t, err)
+
+	imports, err := host.CreateEEIImports()
+	require.Nil(t, err)
+	signatures := imports.Signatures()
+	require.Equal(t, len(signatures), len(rules.EEIFunctions))
+	for name, signature := range signatures {
+		require.Equal(t, eei.ModuleName, signature.Namespace, name)
+		require.Equal(t, wasmerValueTypes(signature.Inputs), rules.EEIFunctions[name].Params, name)
+		require.Equal(t, wasmerValueTypes(signature.Outputs), rules.EEIFunctions[name].Results, name)
+	}
+
+	world := worldmock.NewMockWorld()
+	err = world.InitBuiltinFunctions(gasMap)
+	require.Nil(t, err)
+	reserved := contexts.NewReservedFunctions(imports.Names(), world.BuiltinFuncs.Container).GetReserved()
+	require.Equal(t, len(reserved), len(rules.ReservedNames))
+	for _, name := range reserved {
+		_, isReserved := rules.ReservedNames[name]
+		require.True(t, isReserved, name)
+	}
+}
+
+func wasmerValueTypes(valueTypes []wasmer.ValueType) []wasmanalysis.ValueType {
+	result := make([]wasmanalysis.ValueType, len(valueTypes))
+	for i, valueType := range valueTypes {
+		result[i] = wasmanalysis.ValueTypeI32
+		if valueType == wasmer.TypeI64 {
+			result[i] = wasmanalysis.ValueTypeI64
+		}
+	}
+	return result
+}
@@ -312,9 +312,21 @@ func FillGasMap_CryptoAPICosts(value uint64) map[string]uint64 {
 	gasMap["SHA256"] = value
 	gasMap["Keccak256"] = value
 	gasMap["Ripemd160"] = value
+	gasMap["SHA512"] = value
+	gasMap["SHA512PerByte"] = value
+	gasMap["SHA3256"] = value
+	gasMap["SHA3256PerByte"] = value
+	gasMap["Blake2b256"] = value
+	gasMap["Blake2b256PerByte"] = value
+	gasMap["Blake2b512"] = value
+	gasMap["Blake2b512PerByte"] = value
+	gasMap["Poseidon"] = value
+	gasMap["PoseidonPerBlock"] = value
 	gasMap["VerifyBLS"] = value
 	gasMap["VerifyEd25519"] = value
 	gasMap["VerifySecp256k1"] = value
+	gasMap["VerifySecp256r1"] = value
+	gasMap["VerifySchnorr"] = value
 	gasMap["EllipticCurveNew"] = value
 	gasMap["AddECC"] = value
 	gasMap["DoubleECC"] = value
@@ -326,6 +338,16 @@ func FillGasMap_CryptoAPICosts(value uint64) map[string]uint64 {
 	gasMap["UnmarshalCompressedECC"] = value
 	gasMap["GenerateKeyECC"] = value
 	gasMap["EncodeDERSig"] = value
+	gasMap["AddG1"] = value
+	gasMap["ScalarMultG1"] = value
+	gasMap["AddG2"] = value
+	gasMap["ScalarMultG2"] = value
+	gasMap["SubgroupCheckG1"] = value
+	gasMap["SubgroupCheckG2"] = value
+	gasMap["PairingCheck"] = value
+	gasMap["PairingCheckPerPair"] = value
+	gasMap["VerifyGroth16"] = value
+	gasMap["VerifyGroth16PerInput"] = value
 
 	return gasMap
 }
@@ -3,9 +3,12 @@ package factory
 import (
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto"
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto/hashing"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto/pairing"
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto/signing/bls"
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto/signing/ed25519"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto/signing/schnorr"
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto/signing/secp256k1"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto/signing/secp256r1"
 )
 
 // NewVMCrypto returns a composite struct containing VMCrypto functionality implementations
@@ -15,10 +18,16 @@ func NewVMCrypto() crypto.VMCrypto {
 		crypto.Ed25519
 		crypto.BLS
 		crypto.Secp256k1
+		crypto.Secp256r1
+		crypto.Schnorr
+		crypto.Pairing
 	}{
 		Hasher:    hashing.NewHasher(),
 		Ed25519:   ed25519.NewEd25519Signer(),
 		BLS:       bls.NewBLS(),
 		Secp256k1: secp256k1.NewSecp256k1(),
+		Secp256r1: secp256r1.NewSecp256r1(),
+		Schnorr:   schnorr.NewSchnorr(),
+		Pairing:   pairing.NewPairing(),
 	}
 }
@@ -2,7 +2,11 @@ package hashing
 
 import (
 	"crypto/sha256"
+	"crypto/sha512"
+	"hash"
+	"math/big"
 
+	"golang.org/x/crypto/blake2b"
 	"golang.org/x/crypto/ripemd160"
 	"golang.org/x/crypto/sha3"
 )
@@ -50,3 +54,66 @@ func (h *hasher) Ripemd160(data []byte) ([]byte, error) {
 	result := hash.Sum(nil)
 	return result, nil
 }
+
+// Sha512 returns a sha 512 hash of the input string
+func (h *hasher) Sha512(data []byte) ([]byte, error) {
+	return computeHash(sha512.New(), data)
+}
+
+// Sha3256 returns a standard (FIPS 202) sha3 256 hash of the input string, as opposed to the legacy Keccak256
+func (h *hasher) Sha3256(data []byte) ([]byte, error) {
+	return computeHash(sha3.New256(), data)
+}
+
+// Blake2b256 returns an unkeyed blake2b hash with a 32 bytes digest of the input string
+func (h *hasher) Blake2b256(data []byte) ([]byte, error) {
+	blakeHash, err := blake2b.New256(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return computeHash(blakeHash, data)
+}
+
+// Blake2b512 returns an unkeyed blake2b hash with a 64 bytes digest of the input string
+func (h *hasher) Blake2b512(data []byte) ([]byte, error) {
+	blakeHash, err := blake2b.New512(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return computeHash(blakeHash, data)
+}
+
+// Poseidon returns the circomlib-compatible Poseidon hash over the BN254 scalar field.
+// The input is a concatenation of 32 bytes big endian field elements and the result is
+// a single 32 bytes big endian field element.
+func (h *hasher) Poseidon(data []byte) ([]byte, error) {
+	numInputs := len(data) / poseidonFieldElementLength
+	if len(data)%poseidonFieldElementLength != 0 || numInputs == 0 || numInputs > poseidonMaxInputs {
+		return nil, ErrPoseidonInvalidInputLength
+	}
+
+	inputs := make([]*big.Int, numInputs)
+	for i := range inputs {
+		chunk := data[i*poseidonFieldElementLength : (i+1)*poseidonFieldElementLength]
+		inputs[i] = big.NewInt(0).SetBytes(chunk)
+		if inputs[i].Cmp(bn254ScalarField) >= 0 {
+			return nil, ErrPoseidonInputNotInField
+		}
+	}
+
+	result := make([]byte, poseidonFieldElementLength)
+	poseidon(inputs).FillBytes(result)
+	return result, nil
+}
+
+func computeHash(hashFunc hash.Hash, data []byte) ([]byte, error) {
+	_, err := hashFunc.Write(data)
+	if err != nil {
+		return nil, err
+	}
+
+	result := hashFunc.Sum(nil)
+	return result, nil
+}
@@ -0,0 +1,70 @@
+package hashing
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHasher_Sha512(t *testing.T) {
+	t.Parallel()
+
+	result, err := NewHasher().Sha512([]byte("abc"))
+	require.Nil(t, err)
+	assert.Equal(t, "ddaf35a193617abacc417349ae20413112e6fa4e89a97ea20a9eeee64b55d39a2192992a274fc1a836ba3c23a3feebbd454d4423643ce80e2a9ac94fa54ca49f", hex.EncodeToString(result))
+}
+
+func TestHasher_Sha3256(t *testing.T) {
+	t.Parallel()
+
+	result, err := NewHasher().Sha3256([]byte("abc"))
+	require.Nil(t, err)
+	assert.Equal(t, "3a985da74fe225b2045c172d6bd390bd855f086e3e9d525b46bfe24511431532", hex.EncodeToString(result))
+
+	keccak, err := NewHasher().Keccak256([]byte("abc"))
+	require.Nil(t, err)
+	assert.NotEqual(t, keccak, result)
+}
+
+func TestHasher_Blake2b(t *testing.T) {
+	t.Parallel()
+
+	result, err := NewHasher().Blake2b512([]byte("abc"))
+	require.Nil(t, err)
+	assert.Equal(t, "ba80a53f981c4d0d6a2797b69f12f6e94c212f14685ac4b74b12bb6fdbffa2d17d87c5392aab792dc252d5de4533cc9518d38aa8dbf1925ab92386edd4009923", hex.EncodeToString(result))
+
+	result, err = NewHasher().Blake2b256([]byte("abc"))
+	require.Nil(t, err)
+	assert.Len(t, result, 32)
+}
+
+func TestHasher_Poseidon(t *testing.T) {
+	t.Parallel()
+
+	input, _ := hex.DecodeString(
+		"0000000000000000000000000000000000000000000000000000000000000001" +
+			"0000000000000000000000000000000000000000000000000000000000000002")
+	result, err := NewHasher().Poseidon(input)
+	require.Nil(t, err)
+	assert.Equal(t, "115cc0f5e7d690413df64c6b9662e9cf2a3617f2743245519e19607a4417189a", hex.EncodeToString(result))
+}
+
+func TestHasher_PoseidonInvalidInput(t *testing.T) {
+	t.Parallel()
+
+	hasher := NewHasher()
+	_, err := hasher.Poseidon(nil)
+	assert.Equal(t, ErrPoseidonInvalidInputLength, err)
+
+	_, err = hasher.Poseidon(make([]byte, 33))
+	assert.Equal(t, ErrPoseidonInvalidInputLength, err)
+
+	_, err = hasher.Poseidon(make([]byte, 17*32))
+	assert.Equal(t, ErrPoseidonInvalidInputLength, err)
+
+	notInField, _ := hex.DecodeString("30644e72e131a029b85045b68181585d2833e84879b9709143e1f593f0000001")
+	_, err = hasher.Poseidon(notInField)
+	assert.Equal(t, ErrPoseidonInputNotInField, err)
+}
@@ -0,0 +1,244 @@
+package hashing
+
+import (
+	"errors"
+	"math/big"
+	"sync"
+)
+
+// poseidonFieldElementLength is the byte length of an encoded BN254 scalar field element
+const poseidonFieldElementLength = 32
+
+// poseidonMaxInputs is the maximum number of field elements that can be hashed at once
+const poseidonMaxInputs = 16
+
+const poseidonFullRounds = 8
+const poseidonFieldSizeBits = 254
+
+// poseidonPartialRounds holds the number of partial rounds for each state width,
+// starting with width 2, as recommended for the BN254 scalar field and the x^5 S-box
+var poseidonPartialRounds = []int{56, 57, 56, 60, 60, 63, 64, 63, 60, 66, 60, 65, 70, 60, 64, 68}
+
+// bn254ScalarField is the order of the BN254 (alt_bn128) scalar field
+var bn254ScalarField, _ = big.NewInt(0).SetString("21888242871839275222246405745257275088548364400416034343698204186575808495617", 10)
+
+// ErrPoseidonInvalidInputLength signals that the input is not a list of 32 bytes field elements
+var ErrPoseidonInvalidInputLength = errors.New("poseidon input must contain between 1 and 16 field elements of 32 bytes each")
+
+// ErrPoseidonInputNotInField signals that an input element is not smaller than the field modulus
+var ErrPoseidonInputNotInField = errors.New("poseidon input element is not in the BN254 scalar field")
+
+type poseidonParameters struct {
+	roundConstants []*big.Int
+	mds            [][]*big.Int
+}
+
+var poseidonParametersCache = make(map[int]*poseidonParameters)
+var mutPoseidonParametersCache sync.Mutex
+
+// poseidon hashes the given field elements with the Poseidon permutation over the BN254 scalar field.
+// The parameters and the output are compatible with the circomlib implementation.
+func poseidon(inputs []*big.Int) *big.Int {
+	width := len(inputs) + 1
+	params := getPoseidonParameters(width)
+	partialRounds := poseidonPartialRounds[width-2]
+	numRounds := poseidonFullRounds + partialRounds
+
+	state := make([]*big.Int, width)
+	state[0] = big.NewInt(0)
+	for i, input := range inputs {
+		state[i+1] = big.NewInt(0).Set(input)
+	}
+
+	for round := 0; round < numRounds; round++ {
+		for i := range state {
+			state[i].Add(state[i], params.roundConstants[round*width+i])
+			state[i].Mod(state[i], bn254ScalarField)
+		}
+
+		isFullRound := round < poseidonFullRounds/2 || round >= poseidonFullRounds/2+partialRounds
+		if isFullRound {
+			for i := range state {
+				poseidonSBox(state[i])
+			}
+		} else {
+			poseidonSBox(state[0])
+		}
+
+		state = poseidonMix(state, params.mds)
+	}
+
+	return state[0]
+}
+
+// poseidonSBox computes x^5 in place
+func poseidonSBox(x *big.Int) {
+	x2 := big.NewInt(0).Mul(x, x)
+	x2.Mod(x2, bn254ScalarField)
+	x4 := big.NewInt(0).Mul(x2, x2)
+	x4.Mod(x4, bn254ScalarField)
+	x.Mul(x, x4)
+	x.Mod(x, bn254ScalarField)
+}
+
+func poseidonMix(state []*big.Int, mds [][]*big.Int) []*big.Int {
+	newState := make([]*big.Int, len(state))
+	product := big.NewInt(0)
+	for i := range state {
+		newState[i] = big.NewInt(0)
+		for j := range state {
+			product.Mul(mds[i][j], state[j])
+			newState[i].Add(newState[i], product)
+		}
+		newState[i].Mod(newState[i], bn254ScalarField)
+	}
+	return newState
+}
+
+func getPoseidonParameters(width int) *poseidonParameters {
+	mutPoseidonParametersCache.Lock()
+	defer mutPoseidonParametersCache.Unlock()
+
+	params, ok := poseidonParametersCache[width]
+	if !ok {
+		params = generatePoseidonParameters(width)
+		poseidonParametersCache[width] = params
+	}
+
+	return params
+}
+
+// generatePoseidonParameters derives the round constants and the MDS matrix with the
+// Grain LFSR, as specified by the reference implementation of the Poseidon paper
+func generatePoseidonParameters(width int) *poseidonParameters {
+	partialRounds := poseidonPartialRounds[width-2]
+	grain := newGrainLFSR(width, poseidonFullRounds, partialRounds)
+
+	numConstants := (poseidonFullRounds + partialRounds) * width
+	roundConstants := make([]*big.Int, numConstants)
+	for i := range roundConstants {
+		for {
+			candidate := grain.nextInt(poseidonFieldSizeBits)
+			if candidate.Cmp(bn254ScalarField) < 0 {
+				roundConstants[i] = candidate
+				break
+			}
+		}
+	}
+
+	return &poseidonParameters{
+		roundConstants: roundConstants,
+		mds:            generatePoseidonMDS(grain, width),
+	}
+}
+
+func generatePoseidonMDS(grain *grainLFSR, width int) [][]*big.Int {
+	for {
+		elements := make([]*big.Int, 2*width)
+		for i := range elements {
+			elements[i] = grain.nextInt(poseidonFieldSizeBits)
+			elements[i].Mod(elements[i], bn254ScalarField)
+		}
+		if hasDuplicates(elements) {
+			continue
+		}
+
+		xs := elements[:width]
+		ys := elements[width:]
+		mds, ok := cauchyMatrix(xs, ys)
+		if ok {
+			return mds
+		}
+	}
+}
+
+func cauchyMatrix(xs []*big.Int, ys []*big.Int) ([][]*big.Int, bool) {
+	matrix := make([][]*big.Int, len(xs))
+	for i := range xs {
+		matrix[i] = make([]*big.Int, len(ys))
+		for j := range ys {
+			sum := big.NewInt(0).Add(xs[i], ys[j])
+			sum.Mod(sum, bn254ScalarField)
+			if sum.Sign() == 0 {
+				return nil, false
+			}
+			matrix[i][j] = sum.ModInverse(sum, bn254ScalarField)
+		}
+	}
+	return matrix, true
+}
+
+func hasDuplicates(elements []*big.Int) bool {
+	seen := make(map[string]struct{}, len(elements))
+	for _, element := range elements {
+		key := element.String()
+		if _, ok := seen[key]; ok {
+			return true
+		}
+		seen[key] = struct{}{}
+	}
+	return false
+}
+
+// grainLFSR is the self-shrinking 80 bits Grain LFSR used to generate Poseidon parameters
+type grainLFSR struct {
+	state []byte
+}
+
+func newGrainLFSR(width int, fullRounds int, partialRounds int) *grainLFSR {
+	const fieldTypePrime = 1
+	const sboxTypePower = 0
+
+	state := make([]byte, 0, 80)
+	state = appendBits(state, fieldTypePrime, 2)
+	state = appendBits(state, sboxTypePower, 4)
+	state = appendBits(state, poseidonFieldSizeBits, 12)
+	state = appendBits(state, uint64(width), 12)
+	state = appendBits(state, uint64(fullRounds), 10)
+	state = appendBits(state, uint64(partialRounds), 10)
+	for len(state) < 80 {
+		state = append(state, 1)
+	}
+
+	grain := &grainLFSR{state: state}
+	for i := 0; i < 160; i++ {
+		grain.clock()
+	}
+
+	return grain
+}
+
+func appendBits(bits []byte, value uint64, length int) []byte {
+	for i := length - 1; i >= 0; i-- {
+		bits = append(bits, byte((value>>uint(i))&1))
+	}
+	return bits
+}
+
+func (grain *grainLFSR) clock() byte {
+	s := grain.state
+	newBit := s[62] ^ s[51] ^ s[38] ^ s[23] ^ s[13] ^ s[0]
+	grain.state = append(s[1:], newBit)
+	return newBit
+}
+
+func (grain *grainLFSR) nextBit() byte {
+	for {
+		first := grain.clock()
+		second := grain.clock()
+		if first == 1 {
+			return second
+		}
+	}
+}
+
+func (grain *grainLFSR) nextInt(numBits int) *big.Int {
+	result := big.NewInt(0)
+	for i := 0; i < numBits; i++ {
+		result.Lsh(result, 1)
+		if grain.nextBit() == 1 {
+			result.SetBit(result, 0, 1)
+		}
+	}
+	return result
+}
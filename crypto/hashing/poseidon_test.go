@@ -0,0 +1,44 @@
+package hashing
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func bigIntFromString(t *testing.T, value string) *big.Int {
+	result, ok := big.NewInt(0).SetString(value, 10)
+	assert.True(t, ok)
+	return result
+}
+
+func TestPoseidon_CircomlibVectors(t *testing.T) {
+	t.Parallel()
+
+	result := poseidon([]*big.Int{big.NewInt(1)})
+	expected := bigIntFromString(t, "18586133768512220936620570745912940619677854269274689475585506675881198879027")
+	assert.Equal(t, expected, result)
+
+	result = poseidon([]*big.Int{big.NewInt(1), big.NewInt(2)})
+	expected = bigIntFromString(t, "7853200120776062878684798364095072458815029376092732009249414926327459813530")
+	assert.Equal(t, expected, result)
+}
+
+// BenchmarkPoseidon measures the permutation for each number of inputs, for the calibration of the
+// Poseidon and PoseidonPerBlock gas costs
+func BenchmarkPoseidon(b *testing.B) {
+	for numInputs := 1; numInputs <= poseidonMaxInputs; numInputs++ {
+		inputs := make([]*big.Int, numInputs)
+		for i := range inputs {
+			inputs[i] = big.NewInt(0).Sub(bn254ScalarField, big.NewInt(int64(i+1)))
+		}
+
+		b.Run(fmt.Sprintf("inputs=%d", numInputs), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = poseidon(inputs)
+			}
+		})
+	}
+}
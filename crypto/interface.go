@@ -9,6 +9,21 @@ type Hasher interface {
 
 	// Ripemd160 cryptographic function
 	Ripemd160(data []byte) ([]byte, error)
+
+	// Sha512 cryptographic function
+	Sha512(data []byte) ([]byte, error)
+
+	// Sha3256 cryptographic function
+	Sha3256(data []byte) ([]byte, error)
+
+	// Blake2b256 cryptographic function
+	Blake2b256(data []byte) ([]byte, error)
+
+	// Blake2b512 cryptographic function
+	Blake2b512(data []byte) ([]byte, error)
+
+	// Poseidon cryptographic function over the BN254 scalar field
+	Poseidon(data []byte) ([]byte, error)
 }
 
 type BLS interface {
@@ -24,10 +39,34 @@ type Secp256k1 interface {
 	EncodeSecp256k1DERSignature(r, s []byte) []byte
 }
 
+// Secp256r1 verifies NIST P-256 ECDSA signatures
+type Secp256r1 interface {
+	VerifySecp256r1(key []byte, msg []byte, sig []byte) error
+}
+
+// Schnorr verifies BIP-340 Schnorr signatures over secp256k1
+type Schnorr interface {
+	VerifySchnorr(key []byte, msg []byte, sig []byte) error
+}
+
+// Pairing provides arithmetic on the G1 and G2 groups of the BN254 and BLS12-381 curves,
+// pairing checks and Groth16 proof verification
+type Pairing interface {
+	AddG1(curveID int32, point1 []byte, point2 []byte) ([]byte, error)
+	ScalarMultG1(curveID int32, point []byte, scalar []byte) ([]byte, error)
+	AddG2(curveID int32, point1 []byte, point2 []byte) ([]byte, error)
+	ScalarMultG2(curveID int32, point []byte, scalar []byte) ([]byte, error)
+	PairingCheck(curveID int32, g1Points []byte, g2Points []byte) (bool, error)
+	VerifyGroth16(curveID int32, verifyingKey []byte, proof []byte, publicInputs []byte) (bool, error)
+}
+
 // VMCrypto will provide the interface to the main crypto functionalities of the vm
 type VMCrypto interface {
 	Hasher
 	Ed25519
 	BLS
 	Secp256k1
+	Secp256r1
+	Schnorr
+	Pairing
 }
@@ -0,0 +1,110 @@
+package pairing
+
+// lineValue evaluates at p the line of the given slope through the twisted point current, after untwisting.
+// The result is scaled by elements of proper subfields of Fp12 where convenient, since those are
+// cancelled by the final exponentiation.
+func (c *curve) lineValue(current point, slope fp2, p point) fp12 {
+	t := c.tower
+	var line fp12
+	for k := range line {
+		line[k] = t.fp2Zero()
+	}
+
+	constantTerm := t.fp2Sub(t.fp2Mul(slope, current.x), current.y)
+	slopeTerm := t.fp2Neg(t.fp2MulFp(slope, p.x.c0))
+	yTerm := t.fp2FromFp(p.y.c0)
+
+	switch c.twist {
+	case divisiveTwist:
+		// y_P - slope*x_P*w + (slope*x_T - y_T)*w^3
+		line[0] = yTerm
+		line[1] = slopeTerm
+		line[3] = constantTerm
+	case multiplicativeTwist:
+		// (y_P - slope*x_P*w^-1 + (slope*x_T - y_T)*w^-3) * w^3
+		line[0] = constantTerm
+		line[2] = slopeTerm
+		line[3] = yTerm
+	}
+
+	return line
+}
+
+// doublingStep multiplies f by the tangent line at current, evaluated at p, and doubles current.
+// Vertical lines are skipped, as their values lie in Fp6 and are cancelled by the final exponentiation.
+func (c *curve) doublingStep(f fp12, current point, p point) (fp12, point) {
+	if current.infinity {
+		return f, current
+	}
+	if c.tower.fp2IsZero(current.y) {
+		return f, c.g2.infinity()
+	}
+
+	slope := c.g2.tangentSlope(current)
+	f = c.tower.fp12Mul(f, c.lineValue(current, slope, p))
+	return f, c.g2.pointFromSlope(current, current, slope)
+}
+
+// additionStep multiplies f by the line through current and q, evaluated at p, and adds q to current
+func (c *curve) additionStep(f fp12, current point, q point, p point) (fp12, point) {
+	if current.infinity {
+		return f, q
+	}
+	if c.tower.fp2Equal(current.x, q.x) {
+		if c.tower.fp2Equal(current.y, q.y) {
+			return c.doublingStep(f, current, p)
+		}
+		return f, c.g2.infinity()
+	}
+
+	slope := c.g2.chordSlope(current, q)
+	f = c.tower.fp12Mul(f, c.lineValue(current, slope, p))
+	return f, c.g2.pointFromSlope(current, q, slope)
+}
+
+// millerLoop computes the Miller function of the ate pairing for p in G1 and q in G2
+func (c *curve) millerLoop(p point, q point) fp12 {
+	t := c.tower
+	f := t.fp12One()
+	if p.infinity || q.infinity {
+		return f
+	}
+
+	current := q
+	for i := c.ateLoopCount.BitLen() - 2; i >= 0; i-- {
+		f = t.fp12Square(f)
+		f, current = c.doublingStep(f, current, p)
+		if c.ateLoopCount.Bit(i) == 1 {
+			f, current = c.additionStep(f, current, q, p)
+		}
+	}
+
+	if c.ateLoopCountIsNegative {
+		// up to the final exponentiation, f_{-n} = 1/f_n, which is the conjugate after exponentiation
+		f = t.fp12Conjugate(f)
+	}
+
+	return f
+}
+
+// finalExponentiation raises f to the power (p^12-1)/r
+func (c *curve) finalExponentiation(f fp12) fp12 {
+	t := c.tower
+	f = t.fp12Mul(t.fp12Conjugate(f), t.fp12Inv(f))
+	return t.fp12Exp(f, c.finalExponent)
+}
+
+// pair computes the reduced ate pairing of p and q
+func (c *curve) pair(p point, q point) fp12 {
+	return c.finalExponentiation(c.millerLoop(p, q))
+}
+
+// pairingCheck returns true if the product of the pairings of the given points is one
+func (c *curve) pairingCheck(g1Points []point, g2Points []point) bool {
+	t := c.tower
+	f := t.fp12One()
+	for i := range g1Points {
+		f = t.fp12Mul(f, c.millerLoop(g1Points[i], g2Points[i]))
+	}
+	return t.fp12IsOne(c.finalExponentiation(f))
+}
@@ -0,0 +1,254 @@
+package pairing
+
+import (
+	"math/big"
+)
+
+type twistType int
+
+const (
+	// divisiveTwist is the twist y^2 = x^3 + b/xi, untwisted by (x, y) -> (x*w^2, y*w^3)
+	divisiveTwist twistType = iota
+
+	// multiplicativeTwist is the twist y^2 = x^3 + b*xi, untwisted by (x, y) -> (x*w^-2, y*w^-3)
+	multiplicativeTwist
+)
+
+// curve holds the parameters of a pairing-friendly curve with embedding degree 12
+type curve struct {
+	tower *tower
+	g1    *group
+	g2    *group
+	twist twistType
+
+	order            *big.Int
+	g1HasCofactor    bool
+	g1Generator      point
+	g2Generator      point
+	fieldLength      int
+	g2ImaginaryFirst bool
+
+	// the ate pairing uses the Miller loop of length t-1, where t is the trace of Frobenius
+	ateLoopCount           *big.Int
+	ateLoopCountIsNegative bool
+
+	// finalExponent is (p^6+1)/r, the remaining exponent after computing f^(p^6-1)
+	finalExponent *big.Int
+}
+
+type curveParameters struct {
+	p                      string
+	order                  string
+	b                      int64
+	xi                     [2]int64
+	twist                  twistType
+	g1HasCofactor          bool
+	g1Generator            [2]string
+	g2Generator            [4]string
+	fieldLength            int
+	g2ImaginaryFirst       bool
+	ateLoopCount           string
+	ateLoopCountIsNegative bool
+}
+
+func newCurve(params curveParameters) *curve {
+	t := &tower{
+		p:  mustParseHex(params.p),
+		xi: fp2{c0: big.NewInt(params.xi[0]), c1: big.NewInt(params.xi[1])},
+	}
+
+	b := big.NewInt(params.b)
+	twistB := t.fp2FromFp(b)
+	switch params.twist {
+	case divisiveTwist:
+		twistB = t.fp2Mul(twistB, t.fp2Inv(t.xi))
+	case multiplicativeTwist:
+		twistB = t.fp2Mul(twistB, t.xi)
+	}
+
+	order := mustParseHex(params.order)
+	p6 := big.NewInt(0).Exp(t.p, big.NewInt(6), nil)
+	finalExponent := p6.Add(p6, big.NewInt(1))
+	finalExponent.Div(finalExponent, order)
+
+	return &curve{
+		tower:         t,
+		g1:            &group{tower: t, b: t.fp2FromFp(b)},
+		g2:            &group{tower: t, b: twistB},
+		twist:         params.twist,
+		order:         order,
+		g1HasCofactor: params.g1HasCofactor,
+		g1Generator: point{
+			x: t.fp2FromFp(mustParseHex(params.g1Generator[0])),
+			y: t.fp2FromFp(mustParseHex(params.g1Generator[1])),
+		},
+		g2Generator: point{
+			x: fp2{c0: mustParseHex(params.g2Generator[0]), c1: mustParseHex(params.g2Generator[1])},
+			y: fp2{c0: mustParseHex(params.g2Generator[2]), c1: mustParseHex(params.g2Generator[3])},
+		},
+		fieldLength:            params.fieldLength,
+		g2ImaginaryFirst:       params.g2ImaginaryFirst,
+		ateLoopCount:           mustParseHex(params.ateLoopCount),
+		ateLoopCountIsNegative: params.ateLoopCountIsNegative,
+		finalExponent:          finalExponent,
+	}
+}
+
+func mustParseHex(value string) *big.Int {
+	result, ok := big.NewInt(0).SetString(value, 16)
+	if !ok {
+		panic("invalid curve parameter " + value)
+	}
+	return result
+}
+
+func (c *curve) g1Length() int {
+	return 2 * c.fieldLength
+}
+
+func (c *curve) g2Length() int {
+	return 4 * c.fieldLength
+}
+
+func (c *curve) isInSubgroup(g *group, p point) bool {
+	return g.scalarMult(p, c.order).infinity
+}
+
+func (c *curve) decodeFieldElement(data []byte) (*big.Int, error) {
+	element := big.NewInt(0).SetBytes(data)
+	if element.Cmp(c.tower.p) >= 0 {
+		return nil, ErrInvalidPoint
+	}
+	return element, nil
+}
+
+func (c *curve) encodeFieldElement(element *big.Int) []byte {
+	result := make([]byte, c.fieldLength)
+	element.FillBytes(result)
+	return result
+}
+
+// decodeG1 parses the x || y encoding of a G1 point, where the point at infinity is all zeros
+func (c *curve) decodeG1(data []byte) (point, error) {
+	if len(data) != c.g1Length() {
+		return point{}, ErrInvalidPointLength
+	}
+	if isAllZeros(data) {
+		return c.g1.infinity(), nil
+	}
+
+	x, err := c.decodeFieldElement(data[:c.fieldLength])
+	if err != nil {
+		return point{}, err
+	}
+	y, err := c.decodeFieldElement(data[c.fieldLength:])
+	if err != nil {
+		return point{}, err
+	}
+
+	result := point{x: c.tower.fp2FromFp(x), y: c.tower.fp2FromFp(y)}
+	if !c.g1.isOnCurve(result) {
+		return point{}, ErrPointNotOnCurve
+	}
+	if c.g1HasCofactor && !c.isInSubgroup(c.g1, result) {
+		return point{}, ErrPointNotInSubgroup
+	}
+
+	return result, nil
+}
+
+func (c *curve) encodeG1(p point) []byte {
+	if p.infinity {
+		return make([]byte, c.g1Length())
+	}
+	result := make([]byte, 0, c.g1Length())
+	result = append(result, c.encodeFieldElement(p.x.c0)...)
+	result = append(result, c.encodeFieldElement(p.y.c0)...)
+	return result
+}
+
+func (c *curve) decodeFp2(data []byte) (fp2, error) {
+	first, err := c.decodeFieldElement(data[:c.fieldLength])
+	if err != nil {
+		return fp2{}, err
+	}
+	second, err := c.decodeFieldElement(data[c.fieldLength:])
+	if err != nil {
+		return fp2{}, err
+	}
+
+	if c.g2ImaginaryFirst {
+		return fp2{c0: second, c1: first}, nil
+	}
+	return fp2{c0: first, c1: second}, nil
+}
+
+func (c *curve) encodeFp2(element fp2) []byte {
+	first, second := element.c0, element.c1
+	if c.g2ImaginaryFirst {
+		first, second = second, first
+	}
+	result := make([]byte, 0, 2*c.fieldLength)
+	result = append(result, c.encodeFieldElement(first)...)
+	result = append(result, c.encodeFieldElement(second)...)
+	return result
+}
+
+// decodeG2 parses the x || y encoding of a G2 point, where each coordinate holds two field elements
+// and the point at infinity is all zeros
+func (c *curve) decodeG2(data []byte) (point, error) {
+	if len(data) != c.g2Length() {
+		return point{}, ErrInvalidPointLength
+	}
+	if isAllZeros(data) {
+		return c.g2.infinity(), nil
+	}
+
+	coordinateLength := 2 * c.fieldLength
+	x, err := c.decodeFp2(data[:coordinateLength])
+	if err != nil {
+		return point{}, err
+	}
+	y, err := c.decodeFp2(data[coordinateLength:])
+	if err != nil {
+		return point{}, err
+	}
+
+	result := point{x: x, y: y}
+	if !c.g2.isOnCurve(result) {
+		return point{}, ErrPointNotOnCurve
+	}
+	if !c.isInSubgroup(c.g2, result) {
+		return point{}, ErrPointNotInSubgroup
+	}
+
+	return result, nil
+}
+
+func (c *curve) encodeG2(p point) []byte {
+	if p.infinity {
+		return make([]byte, c.g2Length())
+	}
+	result := make([]byte, 0, c.g2Length())
+	result = append(result, c.encodeFp2(p.x)...)
+	result = append(result, c.encodeFp2(p.y)...)
+	return result
+}
+
+// decodeScalar parses a big endian scalar of at most 32 bytes and reduces it modulo the group order
+func (c *curve) decodeScalar(data []byte) (*big.Int, error) {
+	if len(data) > ScalarLength {
+		return nil, ErrInvalidScalarLength
+	}
+	scalar := big.NewInt(0).SetBytes(data)
+	return scalar.Mod(scalar, c.order), nil
+}
+
+func isAllZeros(data []byte) bool {
+	for _, b := range data {
+		if b != 0 {
+			return false
+		}
+	}
+	return true
+}
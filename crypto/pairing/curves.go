@@ -0,0 +1,53 @@
+package pairing
+
+// bn254Parameters describe BN254, also known as alt_bn128, as used by the Ethereum precompiles.
+// G2 coordinates are encoded with the imaginary part first, following EIP-197.
+var bn254Parameters = curveParameters{
+	p:             "30644e72e131a029b85045b68181585d97816a916871ca8d3c208c16d87cfd47",
+	order:         "30644e72e131a029b85045b68181585d2833e84879b9709143e1f593f0000001",
+	b:             3,
+	xi:            [2]int64{9, 1},
+	twist:         divisiveTwist,
+	g1HasCofactor: false,
+	g1Generator:   [2]string{"1", "2"},
+	g2Generator: [4]string{
+		"1800deef121f1e76426a00665e5c4479674322d4f75edadd46debd5cd992f6ed",
+		"198e9393920d483a7260bfb731fb5d25f1aa493335a9e71297e485b7aef312c2",
+		"12c85ea5db8c6deb4aab71808dcb408fe3d1e7690c43d37b4ce6cc0166fa7daa",
+		"090689d0585ff075ec9e99ad690c3395bc4b313370b38ef355acdadcd122975b",
+	},
+	fieldLength:      32,
+	g2ImaginaryFirst: true,
+	// t-1 = 6u^2, with u = 0x44e992b44a6909f1
+	ateLoopCount:           "6f4d8248eeb859fbf83e9682e87cfd46",
+	ateLoopCountIsNegative: false,
+}
+
+// bls12381Parameters describe BLS12-381.
+// G2 coordinates are encoded with the real part first, following EIP-2537, but without padding.
+var bls12381Parameters = curveParameters{
+	p:             "1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b0f6241eabfffeb153ffffb9feffffffffaaab",
+	order:         "73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000001",
+	b:             4,
+	xi:            [2]int64{1, 1},
+	twist:         multiplicativeTwist,
+	g1HasCofactor: true,
+	g1Generator: [2]string{
+		"17f1d3a73197d7942695638c4fa9ac0fc3688c4f9774b905a14e3a3f171bac586c55e83ff97a1aeffb3af00adb22c6bb",
+		"08b3f481e3aaa0f1a09e30ed741d8ae4fcf5e095d5d00af600db18cb2c04b3edd03cc744a2888ae40caa232946c5e7e1",
+	},
+	g2Generator: [4]string{
+		"024aa2b2f08f0a91260805272dc51051c6e47ad4fa403b02b4510b647ae3d1770bac0326a805bbefd48056c8c121bdb8",
+		"13e02b6052719f607dacd3a088274f65596bd0d09920b61ab5da61bbdc7f5049334cf11213945d57e5ac7d055d042b7e",
+		"0ce5d527727d6e118cc9cdc6da2e351aadfd9baa8cbdd3a76d429a695160d12c923ac9cc3baca289e193548608b82801",
+		"0606c4a02ea734cc32acd2b02bc28b99cb3e287e85a763af267492ab572e99ab3f370d275cec1da1aaa9075ff05f79be",
+	},
+	fieldLength:      48,
+	g2ImaginaryFirst: false,
+	// t-1 = u = -0xd201000000010000
+	ateLoopCount:           "d201000000010000",
+	ateLoopCountIsNegative: true,
+}
+
+var bn254 = newCurve(bn254Parameters)
+var bls12381 = newCurve(bls12381Parameters)
@@ -0,0 +1,35 @@
+package pairing
+
+import (
+	"errors"
+)
+
+// ErrUnknownCurve signals that the curve identifier does not denote a supported pairing-friendly curve
+var ErrUnknownCurve = errors.New("unknown pairing curve")
+
+// ErrInvalidPointLength signals that an encoded point does not have the length expected for its group
+var ErrInvalidPointLength = errors.New("invalid point length")
+
+// ErrInvalidPoint signals that a coordinate of an encoded point is not a field element
+var ErrInvalidPoint = errors.New("invalid point encoding")
+
+// ErrPointNotOnCurve signals that a point does not satisfy the curve equation
+var ErrPointNotOnCurve = errors.New("point is not on curve")
+
+// ErrPointNotInSubgroup signals that a point is on the curve but outside the prime order subgroup
+var ErrPointNotInSubgroup = errors.New("point is not in the prime order subgroup")
+
+// ErrInvalidScalarLength signals that a scalar is longer than 32 bytes
+var ErrInvalidScalarLength = errors.New("invalid scalar length")
+
+// ErrInvalidPairingInput signals that the G1 and G2 point lists do not hold the same number of points
+var ErrInvalidPairingInput = errors.New("invalid pairing input")
+
+// ErrInvalidVerifyingKey signals a malformed Groth16 verifying key
+var ErrInvalidVerifyingKey = errors.New("invalid groth16 verifying key")
+
+// ErrInvalidProof signals a malformed Groth16 proof
+var ErrInvalidProof = errors.New("invalid groth16 proof")
+
+// ErrInvalidPublicInputs signals malformed Groth16 public inputs, or a count that does not match the verifying key
+var ErrInvalidPublicInputs = errors.New("invalid groth16 public inputs")
@@ -0,0 +1,155 @@
+package pairing
+
+import (
+	"math/big"
+)
+
+type groth16VerifyingKey struct {
+	alpha point
+	beta  point
+	gamma point
+	delta point
+	ic    []point
+}
+
+type groth16Proof struct {
+	a point
+	b point
+	c point
+}
+
+// Groth16NumPublicInputs returns the number of public inputs accepted by a verifying key of the given length,
+// which holds one IC point more than public inputs
+func Groth16NumPublicInputs(curveID int32, verifyingKeyLength int) (int, error) {
+	c, err := getCurve(curveID)
+	if err != nil {
+		return 0, err
+	}
+	return c.groth16NumPublicInputs(verifyingKeyLength)
+}
+
+// VerifyGroth16 checks a Groth16 proof against a verifying key and the public inputs of the circuit.
+// The verifying key is encoded as alpha (G1) || beta (G2) || gamma (G2) || delta (G2) || IC[0..n] (G1),
+// the proof as A (G1) || B (G2) || C (G1) and the public inputs as n big endian scalars of 32 bytes each.
+// The number of public inputs is checked against the length of the verifying key before decoding any point.
+// It returns false for a well formed proof that does not verify, and an error for malformed arguments.
+func (pr *pairing) VerifyGroth16(curveID int32, verifyingKey []byte, proof []byte, publicInputs []byte) (bool, error) {
+	c, err := getCurve(curveID)
+	if err != nil {
+		return false, err
+	}
+
+	numPublicInputs, err := c.groth16NumPublicInputs(len(verifyingKey))
+	if err != nil {
+		return false, err
+	}
+	if len(publicInputs) != numPublicInputs*ScalarLength {
+		return false, ErrInvalidPublicInputs
+	}
+
+	vk, err := c.decodeGroth16VerifyingKey(verifyingKey)
+	if err != nil {
+		return false, err
+	}
+	decodedProof, err := c.decodeGroth16Proof(proof)
+	if err != nil {
+		return false, err
+	}
+	inputs, err := c.decodeGroth16PublicInputs(publicInputs, numPublicInputs)
+	if err != nil {
+		return false, err
+	}
+
+	// vkX = IC[0] + sum(inputs[i] * IC[i+1])
+	vkX := vk.ic[0]
+	for i, input := range inputs {
+		vkX = c.g1.add(vkX, c.g1.scalarMult(vk.ic[i+1], input))
+	}
+
+	// e(A, B) = e(alpha, beta) * e(vkX, gamma) * e(C, delta)
+	g1Points := []point{c.g1.neg(decodedProof.a), vk.alpha, vkX, decodedProof.c}
+	g2Points := []point{decodedProof.b, vk.beta, vk.gamma, vk.delta}
+	return c.pairingCheck(g1Points, g2Points), nil
+}
+
+func (c *curve) groth16NumPublicInputs(verifyingKeyLength int) (int, error) {
+	fixedLength := c.g1Length() + 3*c.g2Length()
+	if verifyingKeyLength < fixedLength+c.g1Length() || (verifyingKeyLength-fixedLength)%c.g1Length() != 0 {
+		return 0, ErrInvalidVerifyingKey
+	}
+	return (verifyingKeyLength-fixedLength)/c.g1Length() - 1, nil
+}
+
+func (c *curve) decodeGroth16VerifyingKey(data []byte) (*groth16VerifyingKey, error) {
+	numPublicInputs, err := c.groth16NumPublicInputs(len(data))
+	if err != nil {
+		return nil, err
+	}
+
+	vk := &groth16VerifyingKey{
+		ic: make([]point, numPublicInputs+1),
+	}
+	g2Points := []*point{&vk.beta, &vk.gamma, &vk.delta}
+
+	offset := 0
+	vk.alpha, err = c.decodeG1(data[offset : offset+c.g1Length()])
+	if err != nil {
+		return nil, err
+	}
+	offset += c.g1Length()
+
+	for _, g2Point := range g2Points {
+		*g2Point, err = c.decodeG2(data[offset : offset+c.g2Length()])
+		if err != nil {
+			return nil, err
+		}
+		offset += c.g2Length()
+	}
+
+	for i := range vk.ic {
+		vk.ic[i], err = c.decodeG1(data[offset : offset+c.g1Length()])
+		if err != nil {
+			return nil, err
+		}
+		offset += c.g1Length()
+	}
+
+	return vk, nil
+}
+
+func (c *curve) decodeGroth16Proof(data []byte) (*groth16Proof, error) {
+	if len(data) != 2*c.g1Length()+c.g2Length() {
+		return nil, ErrInvalidProof
+	}
+
+	a, err := c.decodeG1(data[:c.g1Length()])
+	if err != nil {
+		return nil, err
+	}
+	b, err := c.decodeG2(data[c.g1Length() : c.g1Length()+c.g2Length()])
+	if err != nil {
+		return nil, err
+	}
+	cPoint, err := c.decodeG1(data[c.g1Length()+c.g2Length():])
+	if err != nil {
+		return nil, err
+	}
+
+	return &groth16Proof{a: a, b: b, c: cPoint}, nil
+}
+
+func (c *curve) decodeGroth16PublicInputs(data []byte, expectedCount int) ([]*big.Int, error) {
+	if len(data) != expectedCount*ScalarLength {
+		return nil, ErrInvalidPublicInputs
+	}
+
+	inputs := make([]*big.Int, expectedCount)
+	for i := range inputs {
+		inputs[i] = big.NewInt(0).SetBytes(data[i*ScalarLength : (i+1)*ScalarLength])
+		if inputs[i].Cmp(c.order) >= 0 {
+			return nil, ErrInvalidPublicInputs
+		}
+	}
+
+	return inputs, nil
+}
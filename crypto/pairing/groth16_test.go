@@ -0,0 +1,131 @@
+package pairing
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createGroth16TestData builds a verifying key and a proof that satisfy the Groth16 verification equation
+// from known discrete logarithms, in place of a proof generated for an actual circuit
+func createGroth16TestData(c *curve, publicInputs []*big.Int) ([]byte, []byte, []byte) {
+	mod := func(value *big.Int) *big.Int {
+		return value.Mod(value, c.order)
+	}
+	g1 := func(scalar *big.Int) []byte {
+		return c.encodeG1(c.g1.scalarMult(c.g1Generator, scalar))
+	}
+	g2 := func(scalar *big.Int) []byte {
+		return c.encodeG2(c.g2.scalarMult(c.g2Generator, scalar))
+	}
+
+	alpha, beta, gamma, delta := big.NewInt(11), big.NewInt(13), big.NewInt(17), big.NewInt(19)
+	a, b := big.NewInt(23), big.NewInt(29)
+
+	verifyingKey := append(g1(alpha), g2(beta)...)
+	verifyingKey = append(verifyingKey, g2(gamma)...)
+	verifyingKey = append(verifyingKey, g2(delta)...)
+
+	// vkX = ic[0] + sum(inputs[i] * ic[i+1]) with ic[i] = (i+31)*G1
+	vkX := big.NewInt(31)
+	verifyingKey = append(verifyingKey, g1(vkX)...)
+	encodedInputs := make([]byte, 0)
+	for i, input := range publicInputs {
+		ic := big.NewInt(int64(i + 32))
+		verifyingKey = append(verifyingKey, g1(ic)...)
+		vkX = mod(vkX.Add(vkX, big.NewInt(0).Mul(input, ic)))
+		encodedInputs = append(encodedInputs, scalarBytes(input)...)
+	}
+
+	// a*b = alpha*beta + vkX*gamma + c*delta
+	cScalar := big.NewInt(0).Mul(a, b)
+	cScalar.Sub(cScalar, big.NewInt(0).Mul(alpha, beta))
+	cScalar.Sub(cScalar, big.NewInt(0).Mul(vkX, gamma))
+	cScalar.Mul(cScalar, big.NewInt(0).ModInverse(delta, c.order))
+	cScalar = mod(cScalar)
+
+	proof := append(g1(a), g2(b)...)
+	proof = append(proof, g1(cScalar)...)
+
+	return verifyingKey, proof, encodedInputs
+}
+
+func TestPairing_VerifyGroth16(t *testing.T) {
+	pr := NewPairing()
+	for name, curveID := range testCurves {
+		t.Run(name, func(t *testing.T) {
+			c, _ := getCurve(curveID)
+			verifyingKey, proof, publicInputs := createGroth16TestData(c, []*big.Int{big.NewInt(5), big.NewInt(7)})
+
+			ok, err := pr.VerifyGroth16(curveID, verifyingKey, proof, publicInputs)
+			assert.Nil(t, err)
+			assert.True(t, ok)
+
+			tamperedInputs := make([]byte, len(publicInputs))
+			copy(tamperedInputs, publicInputs)
+			tamperedInputs[len(tamperedInputs)-1]++
+			ok, err = pr.VerifyGroth16(curveID, verifyingKey, proof, tamperedInputs)
+			assert.Nil(t, err)
+			assert.False(t, ok)
+
+			_, err = pr.VerifyGroth16(curveID, verifyingKey, proof, publicInputs[:ScalarLength])
+			assert.Equal(t, ErrInvalidPublicInputs, err)
+
+			_, err = pr.VerifyGroth16(curveID, verifyingKey[1:], proof, publicInputs)
+			assert.Equal(t, ErrInvalidVerifyingKey, err)
+
+			_, err = pr.VerifyGroth16(curveID, verifyingKey, proof[1:], publicInputs)
+			assert.Equal(t, ErrInvalidProof, err)
+		})
+	}
+}
+
+func TestPairing_VerifyGroth16PublicInputNotInField(t *testing.T) {
+	pr := NewPairing()
+	c, _ := getCurve(CurveBN254)
+	verifyingKey, proof, _ := createGroth16TestData(c, []*big.Int{big.NewInt(5)})
+
+	_, err := pr.VerifyGroth16(CurveBN254, verifyingKey, proof, scalarBytes(c.order))
+	require.Equal(t, ErrInvalidPublicInputs, err)
+}
+
+func TestPairing_Groth16NumPublicInputs(t *testing.T) {
+	for name, curveID := range testCurves {
+		t.Run(name, func(t *testing.T) {
+			c, _ := getCurve(curveID)
+			verifyingKey, _, _ := createGroth16TestData(c, []*big.Int{big.NewInt(5), big.NewInt(7)})
+
+			numPublicInputs, err := Groth16NumPublicInputs(curveID, len(verifyingKey))
+			require.Nil(t, err)
+			require.Equal(t, 2, numPublicInputs)
+
+			_, err = Groth16NumPublicInputs(curveID, len(verifyingKey)-c.g1Length()*3)
+			require.Equal(t, ErrInvalidVerifyingKey, err)
+
+			_, err = Groth16NumPublicInputs(curveID, len(verifyingKey)-1)
+			require.Equal(t, ErrInvalidVerifyingKey, err)
+		})
+	}
+
+	_, err := Groth16NumPublicInputs(7, 0)
+	require.Equal(t, ErrUnknownCurve, err)
+}
+
+func TestPairing_VerifyGroth16InputCountCheckedBeforeDecoding(t *testing.T) {
+	pr := NewPairing()
+	c, _ := getCurve(CurveBN254)
+	verifyingKey, proof, publicInputs := createGroth16TestData(c, []*big.Int{big.NewInt(5), big.NewInt(7)})
+
+	// a verifying key with an invalid point is not decoded when the number of public inputs does not match
+	invalidVerifyingKey := make([]byte, len(verifyingKey))
+	for i := range invalidVerifyingKey {
+		invalidVerifyingKey[i] = 0xff
+	}
+	_, err := pr.VerifyGroth16(CurveBN254, invalidVerifyingKey, proof, publicInputs[:ScalarLength])
+	require.Equal(t, ErrInvalidPublicInputs, err)
+
+	_, err = pr.VerifyGroth16(CurveBN254, invalidVerifyingKey, proof, publicInputs)
+	require.Equal(t, ErrInvalidPoint, err)
+}
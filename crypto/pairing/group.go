@@ -0,0 +1,103 @@
+package pairing
+
+import (
+	"math/big"
+)
+
+// point is an affine point of a short Weierstrass curve y^2 = x^3 + b.
+// Points of G1 are defined over Fp and only use the c0 component of the coordinates.
+type point struct {
+	x        fp2
+	y        fp2
+	infinity bool
+}
+
+// group implements the point arithmetic of the curve y^2 = x^3 + b over Fp or Fp2
+type group struct {
+	tower *tower
+	b     fp2
+}
+
+func (g *group) infinity() point {
+	return point{x: g.tower.fp2Zero(), y: g.tower.fp2Zero(), infinity: true}
+}
+
+func (g *group) isOnCurve(p point) bool {
+	if p.infinity {
+		return true
+	}
+	t := g.tower
+	lhs := t.fp2Square(p.y)
+	rhs := t.fp2Add(t.fp2Mul(t.fp2Square(p.x), p.x), g.b)
+	return t.fp2Equal(lhs, rhs)
+}
+
+func (g *group) equal(p, q point) bool {
+	if p.infinity || q.infinity {
+		return p.infinity == q.infinity
+	}
+	return g.tower.fp2Equal(p.x, q.x) && g.tower.fp2Equal(p.y, q.y)
+}
+
+func (g *group) neg(p point) point {
+	if p.infinity {
+		return p
+	}
+	return point{x: p.x, y: g.tower.fp2Neg(p.y)}
+}
+
+// tangentSlope returns the slope of the tangent at p, which must not be a point of order 2
+func (g *group) tangentSlope(p point) fp2 {
+	t := g.tower
+	numerator := t.fp2MulFp(t.fp2Square(p.x), big.NewInt(3))
+	denominator := t.fp2Add(p.y, p.y)
+	return t.fp2Mul(numerator, t.fp2Inv(denominator))
+}
+
+// chordSlope returns the slope of the line through p and q, which must have different x coordinates
+func (g *group) chordSlope(p, q point) fp2 {
+	t := g.tower
+	return t.fp2Mul(t.fp2Sub(q.y, p.y), t.fp2Inv(t.fp2Sub(q.x, p.x)))
+}
+
+// pointFromSlope returns the third intersection of the line of the given slope through p and q, negated
+func (g *group) pointFromSlope(p, q point, slope fp2) point {
+	t := g.tower
+	x := t.fp2Sub(t.fp2Sub(t.fp2Square(slope), p.x), q.x)
+	y := t.fp2Sub(t.fp2Mul(slope, t.fp2Sub(p.x, x)), p.y)
+	return point{x: x, y: y}
+}
+
+func (g *group) double(p point) point {
+	if p.infinity || g.tower.fp2IsZero(p.y) {
+		return g.infinity()
+	}
+	return g.pointFromSlope(p, p, g.tangentSlope(p))
+}
+
+func (g *group) add(p, q point) point {
+	if p.infinity {
+		return q
+	}
+	if q.infinity {
+		return p
+	}
+	if g.tower.fp2Equal(p.x, q.x) {
+		if g.tower.fp2Equal(p.y, q.y) {
+			return g.double(p)
+		}
+		return g.infinity()
+	}
+	return g.pointFromSlope(p, q, g.chordSlope(p, q))
+}
+
+func (g *group) scalarMult(p point, scalar *big.Int) point {
+	result := g.infinity()
+	for i := scalar.BitLen() - 1; i >= 0; i-- {
+		result = g.double(result)
+		if scalar.Bit(i) == 1 {
+			result = g.add(result, p)
+		}
+	}
+	return result
+}
@@ -0,0 +1,159 @@
+package pairing
+
+// CurveBN254 identifies the BN254 curve, also known as alt_bn128
+const CurveBN254 = int32(0)
+
+// CurveBLS12381 identifies the BLS12-381 curve
+const CurveBLS12381 = int32(1)
+
+// ScalarLength is the maximum byte length of a scalar, and the exact byte length of a Groth16 public input
+const ScalarLength = 32
+
+type pairing struct {
+}
+
+// NewPairing returns a new implementation of the arithmetic and pairing checks on BN254 and BLS12-381.
+// G1 points are encoded as x || y and G2 points as x || y with each coordinate made of two field elements,
+// all of them big endian of the field byte length. The point at infinity is encoded as zeros.
+func NewPairing() *pairing {
+	return &pairing{}
+}
+
+func getCurve(curveID int32) (*curve, error) {
+	switch curveID {
+	case CurveBN254:
+		return bn254, nil
+	case CurveBLS12381:
+		return bls12381, nil
+	}
+	return nil, ErrUnknownCurve
+}
+
+// G1PointLength returns the byte length of an encoded G1 point of the given curve
+func G1PointLength(curveID int32) (int, error) {
+	c, err := getCurve(curveID)
+	if err != nil {
+		return 0, err
+	}
+	return c.g1Length(), nil
+}
+
+// G2PointLength returns the byte length of an encoded G2 point of the given curve
+func G2PointLength(curveID int32) (int, error) {
+	c, err := getCurve(curveID)
+	if err != nil {
+		return 0, err
+	}
+	return c.g2Length(), nil
+}
+
+// G1SubgroupCheckRequired returns true if the G1 points of the given curve are checked to be in the prime order
+// subgroup when decoded, which is only needed when G1 has a cofactor; G2 points are always checked
+func G1SubgroupCheckRequired(curveID int32) (bool, error) {
+	c, err := getCurve(curveID)
+	if err != nil {
+		return false, err
+	}
+	return c.g1HasCofactor, nil
+}
+
+// AddG1 adds two G1 points of the given curve
+func (pr *pairing) AddG1(curveID int32, point1 []byte, point2 []byte) ([]byte, error) {
+	c, err := getCurve(curveID)
+	if err != nil {
+		return nil, err
+	}
+	return addPoints(point1, point2, c.decodeG1, c.g1, c.encodeG1)
+}
+
+// AddG2 adds two G2 points of the given curve
+func (pr *pairing) AddG2(curveID int32, point1 []byte, point2 []byte) ([]byte, error) {
+	c, err := getCurve(curveID)
+	if err != nil {
+		return nil, err
+	}
+	return addPoints(point1, point2, c.decodeG2, c.g2, c.encodeG2)
+}
+
+// ScalarMultG1 multiplies a G1 point of the given curve by a big endian scalar of at most 32 bytes
+func (pr *pairing) ScalarMultG1(curveID int32, point []byte, scalar []byte) ([]byte, error) {
+	c, err := getCurve(curveID)
+	if err != nil {
+		return nil, err
+	}
+	return c.scalarMultPoint(point, scalar, c.decodeG1, c.g1, c.encodeG1)
+}
+
+// ScalarMultG2 multiplies a G2 point of the given curve by a big endian scalar of at most 32 bytes
+func (pr *pairing) ScalarMultG2(curveID int32, point []byte, scalar []byte) ([]byte, error) {
+	c, err := getCurve(curveID)
+	if err != nil {
+		return nil, err
+	}
+	return c.scalarMultPoint(point, scalar, c.decodeG2, c.g2, c.encodeG2)
+}
+
+// PairingCheck takes a concatenation of G1 points and a concatenation of as many G2 points
+// and returns true if the product of their pairings is one, like the EIP-197 precompile
+func (pr *pairing) PairingCheck(curveID int32, g1Points []byte, g2Points []byte) (bool, error) {
+	c, err := getCurve(curveID)
+	if err != nil {
+		return false, err
+	}
+
+	numPairs := len(g1Points) / c.g1Length()
+	if len(g1Points)%c.g1Length() != 0 || len(g2Points) != numPairs*c.g2Length() {
+		return false, ErrInvalidPairingInput
+	}
+
+	decodedG1 := make([]point, numPairs)
+	decodedG2 := make([]point, numPairs)
+	for i := 0; i < numPairs; i++ {
+		decodedG1[i], err = c.decodeG1(g1Points[i*c.g1Length() : (i+1)*c.g1Length()])
+		if err != nil {
+			return false, err
+		}
+		decodedG2[i], err = c.decodeG2(g2Points[i*c.g2Length() : (i+1)*c.g2Length()])
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return c.pairingCheck(decodedG1, decodedG2), nil
+}
+
+func addPoints(
+	point1 []byte,
+	point2 []byte,
+	decode func([]byte) (point, error),
+	g *group,
+	encode func(point) []byte,
+) ([]byte, error) {
+	p1, err := decode(point1)
+	if err != nil {
+		return nil, err
+	}
+	p2, err := decode(point2)
+	if err != nil {
+		return nil, err
+	}
+	return encode(g.add(p1, p2)), nil
+}
+
+func (c *curve) scalarMultPoint(
+	encodedPoint []byte,
+	encodedScalar []byte,
+	decode func([]byte) (point, error),
+	g *group,
+	encode func(point) []byte,
+) ([]byte, error) {
+	p, err := decode(encodedPoint)
+	if err != nil {
+		return nil, err
+	}
+	scalar, err := c.decodeScalar(encodedScalar)
+	if err != nil {
+		return nil, err
+	}
+	return encode(g.scalarMult(p, scalar)), nil
+}
@@ -0,0 +1,284 @@
+package pairing
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testCurves = map[string]int32{
+	"BN254":     CurveBN254,
+	"BLS12-381": CurveBLS12381,
+}
+
+func g1Generator(curveID int32) []byte {
+	c, _ := getCurve(curveID)
+	return c.encodeG1(c.g1Generator)
+}
+
+func g2Generator(curveID int32) []byte {
+	c, _ := getCurve(curveID)
+	return c.encodeG2(c.g2Generator)
+}
+
+func scalarBytes(value *big.Int) []byte {
+	result := make([]byte, ScalarLength)
+	value.FillBytes(result)
+	return result
+}
+
+func TestPairing_GeneratorsAreValid(t *testing.T) {
+	for name, curveID := range testCurves {
+		t.Run(name, func(t *testing.T) {
+			c, err := getCurve(curveID)
+			require.Nil(t, err)
+
+			assert.True(t, c.g1.isOnCurve(c.g1Generator))
+			assert.True(t, c.isInSubgroup(c.g1, c.g1Generator))
+			assert.True(t, c.g2.isOnCurve(c.g2Generator))
+			assert.True(t, c.isInSubgroup(c.g2, c.g2Generator))
+
+			decodedG1, err := c.decodeG1(g1Generator(curveID))
+			require.Nil(t, err)
+			assert.True(t, c.g1.equal(c.g1Generator, decodedG1))
+
+			decodedG2, err := c.decodeG2(g2Generator(curveID))
+			require.Nil(t, err)
+			assert.True(t, c.g2.equal(c.g2Generator, decodedG2))
+		})
+	}
+}
+
+func TestPairing_AddG1KnownAnswer(t *testing.T) {
+	pr := NewPairing()
+
+	// 2 * (1, 2) on BN254, as computed by the EIP-196 ECADD precompile
+	expected, _ := hex.DecodeString("030644e72e131a029b85045b68181585d97816a916871ca8d3c208c16d87cfd3" +
+		"15ed738c0e0a7c92e7845f96b2ae9c0a68a6a449e3538fc7ff3ebf7a5a18a2c4")
+
+	result, err := pr.AddG1(CurveBN254, g1Generator(CurveBN254), g1Generator(CurveBN254))
+	require.Nil(t, err)
+	assert.Equal(t, expected, result)
+}
+
+func decodeHex(t *testing.T, values ...string) []byte {
+	result := make([]byte, 0)
+	for _, value := range values {
+		decoded, err := hex.DecodeString(value)
+		require.Nil(t, err)
+		result = append(result, decoded...)
+	}
+	return result
+}
+
+func TestPairing_EIP196Vectors(t *testing.T) {
+	pr := NewPairing()
+
+	// chfast1 of the ECADD precompile tests
+	sum, err := pr.AddG1(CurveBN254,
+		decodeHex(t, "18b18acfb4c2c30276db5411368e7185b311dd124691610c5d3b74034e093dc9", "063c909c4720840cb5134cb9f59fa749755796819658d32efc0d288198f37266"),
+		decodeHex(t, "07c2b7f58a84bd6145f00c9c2bc0bb1a187f20ff2c92963a88019e7c6a014eed", "06614e20c147e940f2d70da3f74c9a17df361706a4485c742bd6788478fa17d7"))
+	require.Nil(t, err)
+	require.Equal(t, decodeHex(t, "2243525c5efd4b9c3d3c45ac0ca3fe4dd85e830a4ce6b65fa1eeaee202839703", "301d1d33be6da8e509df21cc35964723180eed7532537db9ae5e7d48f195c915"), sum)
+
+	// the point at infinity is encoded as zeros
+	sum, err = pr.AddG1(CurveBN254, make([]byte, 64), make([]byte, 64))
+	require.Nil(t, err)
+	require.Equal(t, make([]byte, 64), sum)
+
+	// chfast1 of the ECMUL precompile tests
+	product, err := pr.ScalarMultG1(CurveBN254,
+		decodeHex(t, "2bd3e6d0f3b142924f5ca7b49ce5b9d54c4703d7ae5648e61d02268b1a0a9fb7", "21611ce0a6af85915e2f1d70300909ce2e49dfad4a4619c8390cae66cefdb204"),
+		decodeHex(t, "00000000000000000000000000000000000000000000000011138ce750fa15c2"))
+	require.Nil(t, err)
+	require.Equal(t, decodeHex(t, "070a8d6a982153cae4be29d434e8faef8a47b274a053f5a4ee2a6c9c13c31e5c", "031b8ce914eba3a9ffb989f9cdd5b0f01943074bf4f0f315690ec3cec6981afc"), product)
+}
+
+func TestPairing_EIP197Vectors(t *testing.T) {
+	pr := NewPairing()
+
+	// jeff1 of the ECPAIRING precompile tests, with the G1 and the G2 points passed separately
+	g1Points := decodeHex(t,
+		"1c76476f4def4bb94541d57ebba1193381ffa7aa76ada664dd31c16024c43f59", "3034dd2920f673e204fee2811c678745fc819b55d3e9d294e45c9b03a76aef41",
+		"111e129f1cf1097710d41c4ac70fcdfa5ba2023c6ff1cbeac322de49d1b6df7c", "2032c61a830e3c17286de9462bf242fca2883585b93870a73853face6a6bf411")
+	g2Points := decodeHex(t,
+		"209dd15ebff5d46c4bd888e51a93cf99a7329636c63514396b4a452003a35bf7", "04bf11ca01483bfa8b34b43561848d28905960114c8ac04049af4b6315a41678",
+		"2bb8324af6cfc93537a2ad1a445cfd0ca2a71acd7ac41fadbf933c2a51be344d", "120a2a4cf30c1bf9845f20c6fe39e07ea2cce61f0c9bb048165fe5e4de877550",
+		"198e9393920d483a7260bfb731fb5d25f1aa493335a9e71297e485b7aef312c2", "1800deef121f1e76426a00665e5c4479674322d4f75edadd46debd5cd992f6ed",
+		"090689d0585ff075ec9e99ad690c3395bc4b313370b38ef355acdadcd122975b", "12c85ea5db8c6deb4aab71808dcb408fe3d1e7690c43d37b4ce6cc0166fa7daa")
+	ok, err := pr.PairingCheck(CurveBN254, g1Points, g2Points)
+	require.Nil(t, err)
+	require.True(t, ok)
+
+	// one_point: e(G1, G2) is not one
+	ok, err = pr.PairingCheck(CurveBN254, g1Generator(CurveBN254), g2Generator(CurveBN254))
+	require.Nil(t, err)
+	require.False(t, ok)
+
+	// empty_data: the empty product is one
+	ok, err = pr.PairingCheck(CurveBN254, []byte{}, []byte{})
+	require.Nil(t, err)
+	require.True(t, ok)
+}
+
+func TestPairing_AddAndScalarMultAgree(t *testing.T) {
+	pr := NewPairing()
+	for name, curveID := range testCurves {
+		t.Run(name, func(t *testing.T) {
+			three := []byte{3}
+
+			g1Double, err := pr.AddG1(curveID, g1Generator(curveID), g1Generator(curveID))
+			require.Nil(t, err)
+			g1Triple, err := pr.AddG1(curveID, g1Double, g1Generator(curveID))
+			require.Nil(t, err)
+			g1Mult, err := pr.ScalarMultG1(curveID, g1Generator(curveID), three)
+			require.Nil(t, err)
+			assert.Equal(t, g1Triple, g1Mult)
+
+			g2Double, err := pr.AddG2(curveID, g2Generator(curveID), g2Generator(curveID))
+			require.Nil(t, err)
+			g2Triple, err := pr.AddG2(curveID, g2Double, g2Generator(curveID))
+			require.Nil(t, err)
+			g2Mult, err := pr.ScalarMultG2(curveID, g2Generator(curveID), three)
+			require.Nil(t, err)
+			assert.Equal(t, g2Triple, g2Mult)
+
+			infinity, err := pr.ScalarMultG1(curveID, g1Generator(curveID), []byte{0})
+			require.Nil(t, err)
+			assert.True(t, isAllZeros(infinity))
+			sum, err := pr.AddG1(curveID, g1Generator(curveID), infinity)
+			require.Nil(t, err)
+			assert.Equal(t, g1Generator(curveID), sum)
+		})
+	}
+}
+
+func TestPairing_InvalidInputs(t *testing.T) {
+	pr := NewPairing()
+
+	_, err := pr.AddG1(2, g1Generator(CurveBN254), g1Generator(CurveBN254))
+	assert.Equal(t, ErrUnknownCurve, err)
+
+	_, err = pr.AddG1(CurveBN254, g1Generator(CurveBN254)[1:], g1Generator(CurveBN254))
+	assert.Equal(t, ErrInvalidPointLength, err)
+
+	notOnCurve := g1Generator(CurveBN254)
+	notOnCurve[63] = 3
+	_, err = pr.AddG1(CurveBN254, notOnCurve, g1Generator(CurveBN254))
+	assert.Equal(t, ErrPointNotOnCurve, err)
+
+	notInField := make([]byte, 64)
+	for i := range notInField[:32] {
+		notInField[i] = 0xff
+	}
+	_, err = pr.AddG1(CurveBN254, notInField, g1Generator(CurveBN254))
+	assert.Equal(t, ErrInvalidPoint, err)
+
+	_, err = pr.ScalarMultG2(CurveBN254, g2Generator(CurveBN254), make([]byte, 33))
+	assert.Equal(t, ErrInvalidScalarLength, err)
+
+	_, err = pr.PairingCheck(CurveBN254, g1Generator(CurveBN254), append(g2Generator(CurveBN254), 0))
+	assert.Equal(t, ErrInvalidPairingInput, err)
+}
+
+func TestPairing_G2PointOutsideSubgroup(t *testing.T) {
+	c, _ := getCurve(CurveBN254)
+
+	// find a point on the BN254 twist with x = i + k, which has a cofactor and so is almost never in G2
+	for k := int64(0); ; k++ {
+		x := fp2{c0: big.NewInt(k), c1: big.NewInt(1)}
+		rhs := c.tower.fp2Add(c.tower.fp2Mul(c.tower.fp2Square(x), x), c.g2.b)
+		y, ok := fp2Sqrt(c.tower, rhs)
+		if !ok {
+			continue
+		}
+
+		_, err := c.decodeG2(c.encodeG2(point{x: x, y: y}))
+		assert.Equal(t, ErrPointNotInSubgroup, err)
+		return
+	}
+}
+
+// fp2Sqrt computes square roots in Fp2 for p = 3 mod 4, as sqrt(a0 + a1*i) = x + y*i
+// with x^2 = (a0 +- sqrt(a0^2 + a1^2))/2 and y = a1/(2x)
+func fp2Sqrt(t *tower, a fp2) (fp2, bool) {
+	exponent := big.NewInt(0).Add(t.p, big.NewInt(1))
+	exponent.Rsh(exponent, 2)
+	fpSqrt := func(v *big.Int) (*big.Int, bool) {
+		root := big.NewInt(0).Exp(v, exponent, t.p)
+		return root, t.fpMul(root, root).Cmp(v) == 0
+	}
+
+	norm := t.fpAdd(t.fpMul(a.c0, a.c0), t.fpMul(a.c1, a.c1))
+	normRoot, ok := fpSqrt(norm)
+	if !ok {
+		return fp2{}, false
+	}
+
+	half := t.fpInv(big.NewInt(2))
+	for _, candidate := range []*big.Int{t.fpAdd(a.c0, normRoot), t.fpSub(a.c0, normRoot)} {
+		x, ok := fpSqrt(t.fpMul(candidate, half))
+		if !ok || x.Sign() == 0 {
+			continue
+		}
+		y := t.fpMul(a.c1, t.fpInv(t.fpAdd(x, x)))
+		root := fp2{c0: x, c1: y}
+		if t.fp2Equal(t.fp2Square(root), a) {
+			return root, true
+		}
+	}
+
+	return fp2{}, false
+}
+
+func TestPairing_PairingCheck(t *testing.T) {
+	pr := NewPairing()
+	for name, curveID := range testCurves {
+		t.Run(name, func(t *testing.T) {
+			c, _ := getCurve(curveID)
+			a := big.NewInt(123456789)
+			b := big.NewInt(987654321)
+			minusAB := big.NewInt(0).Mul(a, b)
+			minusAB.Sub(c.order, minusAB)
+
+			aG1, err := pr.ScalarMultG1(curveID, g1Generator(curveID), scalarBytes(a))
+			require.Nil(t, err)
+			bG2, err := pr.ScalarMultG2(curveID, g2Generator(curveID), scalarBytes(b))
+			require.Nil(t, err)
+			minusABG1, err := pr.ScalarMultG1(curveID, g1Generator(curveID), scalarBytes(minusAB))
+			require.Nil(t, err)
+
+			// e(a*G1, b*G2) * e(-ab*G1, G2) = 1
+			g1Points := append(aG1, minusABG1...)
+			g2Points := append(bG2, g2Generator(curveID)...)
+			ok, err := pr.PairingCheck(curveID, g1Points, g2Points)
+			require.Nil(t, err)
+			assert.True(t, ok)
+
+			// e(G1, G2) != 1
+			ok, err = pr.PairingCheck(curveID, g1Generator(curveID), g2Generator(curveID))
+			require.Nil(t, err)
+			assert.False(t, ok)
+
+			ok, err = pr.PairingCheck(curveID, nil, nil)
+			require.Nil(t, err)
+			assert.True(t, ok)
+		})
+	}
+}
+
+func TestPairing_G1SubgroupCheckRequired(t *testing.T) {
+	required, err := G1SubgroupCheckRequired(CurveBN254)
+	require.Nil(t, err)
+	assert.False(t, required)
+
+	required, err = G1SubgroupCheckRequired(CurveBLS12381)
+	require.Nil(t, err)
+	assert.True(t, required)
+
+	_, err = G1SubgroupCheckRequired(2)
+	assert.Equal(t, ErrUnknownCurve, err)
+}
@@ -0,0 +1,242 @@
+package pairing
+
+import (
+	"math/big"
+)
+
+// fp2 is the element c0 + c1*i of the quadratic extension Fp[i]/(i^2 + 1)
+type fp2 struct {
+	c0 *big.Int
+	c1 *big.Int
+}
+
+// fp6 is the element c0 + c1*v + c2*v^2 of the cubic extension Fp2[v]/(v^3 - xi)
+type fp6 [3]fp2
+
+// fp12 is the element sum(c[k] * w^k) of the extension Fp2[w]/(w^6 - xi).
+// Grouping the even and the odd powers of w gives the usual Fp6[w]/(w^2 - v) tower.
+type fp12 [6]fp2
+
+// tower implements the arithmetic of the extension fields used by a pairing-friendly curve.
+// All the operations return new values and never modify their arguments.
+type tower struct {
+	p  *big.Int
+	xi fp2
+}
+
+func (t *tower) fpReduce(a *big.Int) *big.Int {
+	return a.Mod(a, t.p)
+}
+
+func (t *tower) fpAdd(a, b *big.Int) *big.Int {
+	return t.fpReduce(big.NewInt(0).Add(a, b))
+}
+
+func (t *tower) fpSub(a, b *big.Int) *big.Int {
+	return t.fpReduce(big.NewInt(0).Sub(a, b))
+}
+
+func (t *tower) fpMul(a, b *big.Int) *big.Int {
+	return t.fpReduce(big.NewInt(0).Mul(a, b))
+}
+
+func (t *tower) fpNeg(a *big.Int) *big.Int {
+	return t.fpReduce(big.NewInt(0).Neg(a))
+}
+
+func (t *tower) fpInv(a *big.Int) *big.Int {
+	return big.NewInt(0).ModInverse(a, t.p)
+}
+
+func (t *tower) fp2Zero() fp2 {
+	return fp2{c0: big.NewInt(0), c1: big.NewInt(0)}
+}
+
+func (t *tower) fp2One() fp2 {
+	return fp2{c0: big.NewInt(1), c1: big.NewInt(0)}
+}
+
+func (t *tower) fp2FromFp(a *big.Int) fp2 {
+	return fp2{c0: big.NewInt(0).Set(a), c1: big.NewInt(0)}
+}
+
+func (t *tower) fp2IsZero(a fp2) bool {
+	return a.c0.Sign() == 0 && a.c1.Sign() == 0
+}
+
+func (t *tower) fp2Equal(a, b fp2) bool {
+	return a.c0.Cmp(b.c0) == 0 && a.c1.Cmp(b.c1) == 0
+}
+
+func (t *tower) fp2Add(a, b fp2) fp2 {
+	return fp2{c0: t.fpAdd(a.c0, b.c0), c1: t.fpAdd(a.c1, b.c1)}
+}
+
+func (t *tower) fp2Sub(a, b fp2) fp2 {
+	return fp2{c0: t.fpSub(a.c0, b.c0), c1: t.fpSub(a.c1, b.c1)}
+}
+
+func (t *tower) fp2Neg(a fp2) fp2 {
+	return fp2{c0: t.fpNeg(a.c0), c1: t.fpNeg(a.c1)}
+}
+
+func (t *tower) fp2Mul(a, b fp2) fp2 {
+	// (a0 + a1*i)(b0 + b1*i) = (a0*b0 - a1*b1) + ((a0 + a1)(b0 + b1) - a0*b0 - a1*b1)*i
+	v0 := big.NewInt(0).Mul(a.c0, b.c0)
+	v1 := big.NewInt(0).Mul(a.c1, b.c1)
+	sumA := big.NewInt(0).Add(a.c0, a.c1)
+	sumB := big.NewInt(0).Add(b.c0, b.c1)
+	c1 := sumA.Mul(sumA, sumB)
+	c1.Sub(c1, v0)
+	c1.Sub(c1, v1)
+	c0 := v0.Sub(v0, v1)
+	return fp2{c0: t.fpReduce(c0), c1: t.fpReduce(c1)}
+}
+
+func (t *tower) fp2MulFp(a fp2, b *big.Int) fp2 {
+	return fp2{c0: t.fpMul(a.c0, b), c1: t.fpMul(a.c1, b)}
+}
+
+func (t *tower) fp2Square(a fp2) fp2 {
+	return t.fp2Mul(a, a)
+}
+
+func (t *tower) fp2Inv(a fp2) fp2 {
+	// 1/(a0 + a1*i) = (a0 - a1*i)/(a0^2 + a1^2)
+	norm := big.NewInt(0).Mul(a.c0, a.c0)
+	norm.Add(norm, big.NewInt(0).Mul(a.c1, a.c1))
+	normInv := t.fpInv(t.fpReduce(norm))
+	return fp2{c0: t.fpMul(a.c0, normInv), c1: t.fpMul(t.fpNeg(a.c1), normInv)}
+}
+
+func (t *tower) fp2MulXi(a fp2) fp2 {
+	return t.fp2Mul(a, t.xi)
+}
+
+func (t *tower) fp6Add(a, b fp6) fp6 {
+	return fp6{t.fp2Add(a[0], b[0]), t.fp2Add(a[1], b[1]), t.fp2Add(a[2], b[2])}
+}
+
+func (t *tower) fp6Sub(a, b fp6) fp6 {
+	return fp6{t.fp2Sub(a[0], b[0]), t.fp2Sub(a[1], b[1]), t.fp2Sub(a[2], b[2])}
+}
+
+func (t *tower) fp6Mul(a, b fp6) fp6 {
+	var result fp6
+	for k := range result {
+		result[k] = t.fp2Zero()
+	}
+	for k := 0; k < 3; k++ {
+		for l := 0; l < 3; l++ {
+			term := t.fp2Mul(a[k], b[l])
+			if k+l >= 3 {
+				term = t.fp2MulXi(term)
+			}
+			result[(k+l)%3] = t.fp2Add(result[(k+l)%3], term)
+		}
+	}
+	return result
+}
+
+func (t *tower) fp6MulV(a fp6) fp6 {
+	return fp6{t.fp2MulXi(a[2]), a[0], a[1]}
+}
+
+func (t *tower) fp6Inv(a fp6) fp6 {
+	c0 := t.fp2Sub(t.fp2Square(a[0]), t.fp2MulXi(t.fp2Mul(a[1], a[2])))
+	c1 := t.fp2Sub(t.fp2MulXi(t.fp2Square(a[2])), t.fp2Mul(a[0], a[1]))
+	c2 := t.fp2Sub(t.fp2Square(a[1]), t.fp2Mul(a[0], a[2]))
+
+	norm := t.fp2Add(t.fp2Mul(a[2], c1), t.fp2Mul(a[1], c2))
+	norm = t.fp2Add(t.fp2Mul(a[0], c0), t.fp2MulXi(norm))
+	normInv := t.fp2Inv(norm)
+
+	return fp6{t.fp2Mul(c0, normInv), t.fp2Mul(c1, normInv), t.fp2Mul(c2, normInv)}
+}
+
+func (t *tower) fp12One() fp12 {
+	var result fp12
+	result[0] = t.fp2One()
+	for k := 1; k < len(result); k++ {
+		result[k] = t.fp2Zero()
+	}
+	return result
+}
+
+func (t *tower) fp12IsOne(a fp12) bool {
+	if !t.fp2Equal(a[0], t.fp2One()) {
+		return false
+	}
+	for k := 1; k < len(a); k++ {
+		if !t.fp2IsZero(a[k]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (t *tower) fp12Equal(a, b fp12) bool {
+	for k := range a {
+		if !t.fp2Equal(a[k], b[k]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (t *tower) fp12Mul(a, b fp12) fp12 {
+	var result fp12
+	for k := range result {
+		result[k] = t.fp2Zero()
+	}
+	for k := 0; k < 6; k++ {
+		if t.fp2IsZero(a[k]) {
+			continue
+		}
+		for l := 0; l < 6; l++ {
+			if t.fp2IsZero(b[l]) {
+				continue
+			}
+			term := t.fp2Mul(a[k], b[l])
+			if k+l >= 6 {
+				term = t.fp2MulXi(term)
+			}
+			result[(k+l)%6] = t.fp2Add(result[(k+l)%6], term)
+		}
+	}
+	return result
+}
+
+func (t *tower) fp12Square(a fp12) fp12 {
+	return t.fp12Mul(a, a)
+}
+
+// fp12Conjugate computes a^(p^6), which negates the odd powers of w
+func (t *tower) fp12Conjugate(a fp12) fp12 {
+	return fp12{a[0], t.fp2Neg(a[1]), a[2], t.fp2Neg(a[3]), a[4], t.fp2Neg(a[5])}
+}
+
+func (t *tower) fp12Inv(a fp12) fp12 {
+	// a = even + odd*w, with w^2 = v, so 1/a = (even - odd*w)/(even^2 - odd^2*v)
+	even := fp6{a[0], a[2], a[4]}
+	odd := fp6{a[1], a[3], a[5]}
+
+	norm := t.fp6Sub(t.fp6Mul(even, even), t.fp6MulV(t.fp6Mul(odd, odd)))
+	normInv := t.fp6Inv(norm)
+
+	even = t.fp6Mul(even, normInv)
+	odd = t.fp6Mul(odd, normInv)
+
+	return fp12{even[0], t.fp2Neg(odd[0]), even[1], t.fp2Neg(odd[1]), even[2], t.fp2Neg(odd[2])}
+}
+
+func (t *tower) fp12Exp(a fp12, exponent *big.Int) fp12 {
+	result := t.fp12One()
+	for i := exponent.BitLen() - 1; i >= 0; i-- {
+		result = t.fp12Square(result)
+		if exponent.Bit(i) == 1 {
+			result = t.fp12Mul(result, a)
+		}
+	}
+	return result
+}
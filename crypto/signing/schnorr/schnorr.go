@@ -0,0 +1,111 @@
+package schnorr
+
+import (
+	"crypto/sha256"
+	"math/big"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto/signing"
+
+	"github.com/btcsuite/btcd/btcec"
+)
+
+const publicKeyLength = 32
+const signatureLength = 64
+const challengeTag = "BIP0340/challenge"
+
+type schnorr struct {
+	curve *btcec.KoblitzCurve
+}
+
+// NewSchnorr returns a new verifier for BIP-340 Schnorr signatures over secp256k1
+func NewSchnorr() *schnorr {
+	return &schnorr{
+		curve: btcec.S256(),
+	}
+}
+
+// VerifySchnorr checks a BIP-340 Schnorr signature, as used by Bitcoin Taproot.
+// The public key is the 32 bytes x-only encoding and the signature is the 64 bytes r || s encoding.
+func (sch *schnorr) VerifySchnorr(key []byte, msg []byte, sig []byte) error {
+	if len(key) != publicKeyLength {
+		return signing.ErrInvalidPublicKey
+	}
+	if len(sig) != signatureLength {
+		return signing.ErrInvalidSignature
+	}
+
+	px, py, err := sch.liftX(key)
+	if err != nil {
+		return err
+	}
+
+	params := sch.curve.Params()
+	r := big.NewInt(0).SetBytes(sig[:32])
+	if r.Cmp(params.P) >= 0 {
+		return signing.ErrInvalidSignature
+	}
+	s := big.NewInt(0).SetBytes(sig[32:])
+	if s.Cmp(params.N) >= 0 {
+		return signing.ErrInvalidSignature
+	}
+
+	challenge := taggedHash(challengeTag, sig[:32], key, msg)
+	e := big.NewInt(0).SetBytes(challenge)
+	e.Mod(e, params.N)
+	e.Sub(params.N, e)
+
+	// R = s*G - e*P, computed as s*G + (N-e)*P
+	sGx, sGy := sch.curve.ScalarBaseMult(s.Bytes())
+	ePx, ePy := sch.curve.ScalarMult(px, py, e.Bytes())
+	rx, ry := sch.curve.Add(sGx, sGy, ePx, ePy)
+
+	isInfinity := rx.Sign() == 0 && ry.Sign() == 0
+	if isInfinity || ry.Bit(0) != 0 || rx.Cmp(r) != 0 {
+		return signing.ErrInvalidSignature
+	}
+
+	return nil
+}
+
+// liftX returns the curve point with the given x coordinate and an even y coordinate
+func (sch *schnorr) liftX(key []byte) (*big.Int, *big.Int, error) {
+	params := sch.curve.Params()
+	x := big.NewInt(0).SetBytes(key)
+	if x.Cmp(params.P) >= 0 {
+		return nil, nil, signing.ErrInvalidPublicKey
+	}
+
+	// c = x^3 + 7 mod P
+	c := big.NewInt(0).Exp(x, big.NewInt(3), params.P)
+	c.Add(c, params.B)
+	c.Mod(c, params.P)
+
+	// y = c^((P+1)/4) mod P
+	exponent := big.NewInt(0).Add(params.P, big.NewInt(1))
+	exponent.Rsh(exponent, 2)
+	y := big.NewInt(0).Exp(c, exponent, params.P)
+
+	ySquared := big.NewInt(0).Exp(y, big.NewInt(2), params.P)
+	if ySquared.Cmp(c) != 0 {
+		return nil, nil, signing.ErrInvalidPublicKey
+	}
+
+	if y.Bit(0) != 0 {
+		y.Sub(params.P, y)
+	}
+
+	return x, y, nil
+}
+
+func taggedHash(tag string, data ...[]byte) []byte {
+	tagHash := sha256.Sum256([]byte(tag))
+
+	hash := sha256.New()
+	_, _ = hash.Write(tagHash[:])
+	_, _ = hash.Write(tagHash[:])
+	for _, chunk := range data {
+		_, _ = hash.Write(chunk)
+	}
+
+	return hash.Sum(nil)
+}
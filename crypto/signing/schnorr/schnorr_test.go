@@ -0,0 +1,82 @@
+package schnorr
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto/signing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// test vectors taken from https://github.com/bitcoin/bips/blob/master/bip-0340/test-vectors.csv
+type bip340TestVector struct {
+	key string
+	msg string
+	sig string
+	err error
+}
+
+var bip340TestVectors = []bip340TestVector{
+	{
+		key: "F9308A019258C31049344F85F89D5229B531C845836F99B08601F113BCE036F9",
+		msg: "0000000000000000000000000000000000000000000000000000000000000000",
+		sig: "E907831F80848D1069A5371B402410364BDF1C5F8307B0084C55F1CE2DCA821525F66A4A85EA8B71E482A74F382D2CE5EBEEE8FDB2172F477DF4900D310536C0",
+		err: nil,
+	},
+	{
+		key: "DFF1D77F2A671C5F36183726DB2341BE58FEAE1DA2DECED843240F7B502BA659",
+		msg: "243F6A8885A308D313198A2E03707344A4093822299F31D0082EFA98EC4E6C89",
+		sig: "6896BD60EEAE296DB48A229FF71DFE071BDE413E6D43F917DC8DCF8C78DE33418906D11AC976ABCCB20B091292BFF4EA897EFCB639EA871CFA95F6DE339E4B0A",
+		err: nil,
+	},
+	{
+		// negated message
+		key: "DFF1D77F2A671C5F36183726DB2341BE58FEAE1DA2DECED843240F7B502BA659",
+		msg: "243F6A8885A308D313198A2E03707344A4093822299F31D0082EFA98EC4E6C88",
+		sig: "6896BD60EEAE296DB48A229FF71DFE071BDE413E6D43F917DC8DCF8C78DE33418906D11AC976ABCCB20B091292BFF4EA897EFCB639EA871CFA95F6DE339E4B0A",
+		err: signing.ErrInvalidSignature,
+	},
+	{
+		// public key not on the curve
+		key: "EEFDEA4CDB677750A420FEE807EACF21EB9898AE79B9768766E4FAA04A2D4A34",
+		msg: "243F6A8885A308D313198A2E03707344A4093822299F31D0082EFA98EC4E6C89",
+		sig: "6896BD60EEAE296DB48A229FF71DFE071BDE413E6D43F917DC8DCF8C78DE33418906D11AC976ABCCB20B091292BFF4EA897EFCB639EA871CFA95F6DE339E4B0A",
+		err: signing.ErrInvalidPublicKey,
+	},
+	{
+		// s is equal to the curve order
+		key: "DFF1D77F2A671C5F36183726DB2341BE58FEAE1DA2DECED843240F7B502BA659",
+		msg: "243F6A8885A308D313198A2E03707344A4093822299F31D0082EFA98EC4E6C89",
+		sig: "6CFF5C3BA86C69EA4B7376F31A9BCB4F74C1976089B2D9963DA2E5543E177769FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFEBAAEDCE6AF48A03BBFD25E8CD0364141",
+		err: signing.ErrInvalidSignature,
+	},
+}
+
+func TestSchnorr_BIP340TestVectors(t *testing.T) {
+	t.Parallel()
+
+	verifier := NewSchnorr()
+	for i, vector := range bip340TestVectors {
+		key, err := hex.DecodeString(vector.key)
+		require.Nil(t, err)
+		msg, err := hex.DecodeString(vector.msg)
+		require.Nil(t, err)
+		sig, err := hex.DecodeString(vector.sig)
+		require.Nil(t, err)
+
+		err = verifier.VerifySchnorr(key, msg, sig)
+		assert.Equal(t, vector.err, err, "test vector %d", i)
+	}
+}
+
+func TestSchnorr_InvalidLengths(t *testing.T) {
+	t.Parallel()
+
+	verifier := NewSchnorr()
+	err := verifier.VerifySchnorr(make([]byte, 33), []byte("msg"), make([]byte, 64))
+	assert.Equal(t, signing.ErrInvalidPublicKey, err)
+
+	err = verifier.VerifySchnorr(make([]byte, 32), []byte("msg"), make([]byte, 65))
+	assert.Equal(t, signing.ErrInvalidSignature, err)
+}
@@ -0,0 +1,60 @@
+package secp256r1
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto/signing"
+)
+
+const compressedPublicKeyLength = 33
+const uncompressedPublicKeyLength = 65
+
+type secp256r1 struct {
+}
+
+// NewSecp256r1 returns a new verifier for NIST P-256 ECDSA signatures
+func NewSecp256r1() *secp256r1 {
+	return &secp256r1{}
+}
+
+// VerifySecp256r1 checks a P-256 ECDSA signature provided in the DER encoding format.
+// The message is hashed with SHA-256 before verification, as in the ES256 scheme
+// used by WebAuthn authenticators and most HSMs.
+// The public key can be given either in compressed or in uncompressed SEC1 form.
+func (sec *secp256r1) VerifySecp256r1(key []byte, msg []byte, sig []byte) error {
+	pubKey, err := sec.parsePublicKey(key)
+	if err != nil {
+		return err
+	}
+
+	messageHash := sha256.Sum256(msg)
+	verified := ecdsa.VerifyASN1(pubKey, messageHash[:], sig)
+	if !verified {
+		return signing.ErrInvalidSignature
+	}
+
+	return nil
+}
+
+func (sec *secp256r1) parsePublicKey(key []byte) (*ecdsa.PublicKey, error) {
+	curve := elliptic.P256()
+
+	switch len(key) {
+	case compressedPublicKeyLength:
+		x, y := elliptic.UnmarshalCompressed(curve, key)
+		if x == nil {
+			return nil, signing.ErrInvalidPublicKey
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	case uncompressedPublicKeyLength:
+		x, y := elliptic.Unmarshal(curve, key)
+		if x == nil {
+			return nil, signing.ErrInvalidPublicKey
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, signing.ErrInvalidPublicKey
+	}
+}
@@ -0,0 +1,74 @@
+package secp256r1
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto/signing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func generateSignature(t testing.TB, msg []byte) (*ecdsa.PrivateKey, []byte) {
+	privKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+
+	hash := sha256.Sum256(msg)
+	sig, err := ecdsa.SignASN1(rand.Reader, privKey, hash[:])
+	require.Nil(t, err)
+
+	return privKey, sig
+}
+
+func TestSecp256r1_VerifyUncompressedKey(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte("webauthn assertion")
+	privKey, sig := generateSignature(t, msg)
+	key := elliptic.Marshal(elliptic.P256(), privKey.X, privKey.Y)
+
+	verifier := NewSecp256r1()
+	assert.Nil(t, verifier.VerifySecp256r1(key, msg, sig))
+}
+
+func TestSecp256r1_VerifyCompressedKey(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte("webauthn assertion")
+	privKey, sig := generateSignature(t, msg)
+	key := elliptic.MarshalCompressed(elliptic.P256(), privKey.X, privKey.Y)
+
+	verifier := NewSecp256r1()
+	assert.Nil(t, verifier.VerifySecp256r1(key, msg, sig))
+}
+
+func TestSecp256r1_VerifyWrongMessage(t *testing.T) {
+	t.Parallel()
+
+	privKey, sig := generateSignature(t, []byte("original"))
+	key := elliptic.Marshal(elliptic.P256(), privKey.X, privKey.Y)
+
+	verifier := NewSecp256r1()
+	err := verifier.VerifySecp256r1(key, []byte("tampered"), sig)
+	assert.Equal(t, signing.ErrInvalidSignature, err)
+}
+
+func TestSecp256r1_VerifyInvalidKey(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte("msg")
+	_, sig := generateSignature(t, msg)
+
+	verifier := NewSecp256r1()
+	err := verifier.VerifySecp256r1([]byte{0x04, 0x01, 0x02}, msg, sig)
+	assert.Equal(t, signing.ErrInvalidPublicKey, err)
+
+	notOnCurve := make([]byte, uncompressedPublicKeyLength)
+	notOnCurve[0] = 0x04
+	notOnCurve[1] = 0x01
+	err = verifier.VerifySecp256r1(notOnCurve, msg, sig)
+	assert.Equal(t, signing.ErrInvalidPublicKey, err)
+}
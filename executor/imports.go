@@ -0,0 +1,211 @@
+package executor
+
+import (
+	"fmt"
+	"reflect"
+	"unsafe"
+
+	"github.com/ElrondNetwork/elrond-vm-common"
+)
+
+// ImportedFunctionError represents any kind of errors related to a
+// WebAssembly imported function. It is returned by `Import` or `Imports`
+// functions only.
+type ImportedFunctionError struct {
+	functionName string
+	message      string
+}
+
+// NewImportedFunctionError constructs a new `ImportedFunctionError`,
+// where `functionName` is the name of the imported function, and
+// `message` is the error message. If the error message contains `%s`,
+// then this parameter will be replaced by `functionName`.
+func NewImportedFunctionError(functionName string, message string) *ImportedFunctionError {
+	return &ImportedFunctionError{functionName, message}
+}
+
+// ImportedFunctionError is an actual error. The `Error` function
+// returns the error message.
+func (error *ImportedFunctionError) Error() string {
+	return fmt.Sprintf(error.message, error.functionName)
+}
+
+// ImportedFunction represents a WebAssembly instance imported function.
+type ImportedFunction struct {
+	// The namespace of the imported function.
+	Namespace string
+
+	// The name of the imported function.
+	Name string
+
+	// An implementation must be of type:
+	// `func(context unsafe.Pointer, arguments ...interface{}) interface{}`.
+	// It represents the real function implementation written in Go.
+	Implementation interface{}
+
+	// The pointer to the cgo function implementation, something
+	// like `C.foo`, used by the Wasmer backend only.
+	CgoPointer unsafe.Pointer
+
+	// The function implementation signature as a WebAssembly signature.
+	Inputs []ValueType
+
+	// The function implementation signature as a WebAssembly signature.
+	Outputs []ValueType
+}
+
+// Imports represents a set of imported functions for a WebAssembly instance.
+type Imports struct {
+	// All imports.
+	imports map[string]map[string]ImportedFunction
+
+	// Current namespace where to register the import.
+	currentNamespace string
+}
+
+// NewImports constructs a new empty `Imports`.
+func NewImports() *Imports {
+	var imports = make(map[string]map[string]ImportedFunction)
+	var currentNamespace = "env"
+
+	return &Imports{imports, currentNamespace}
+}
+
+// Namespace changes the current namespace of the next imported functions.
+func (imports *Imports) Namespace(namespace string) *Imports {
+	imports.currentNamespace = namespace
+
+	return imports
+}
+
+func (imports *Imports) Count() int {
+	count := 0
+	for _, namespacedImports := range imports.imports {
+		count += len(namespacedImports)
+	}
+	return count
+}
+
+func (imports *Imports) Names() vmcommon.FunctionNames {
+	names := make(vmcommon.FunctionNames)
+	var empty struct{}
+	for _, env := range imports.imports {
+		for name := range env {
+			names[name] = empty
+		}
+	}
+	return names
+}
+
+// ImportedFunctionSignature holds the namespace and the WebAssembly signature of an imported function
+type ImportedFunctionSignature struct {
+	Namespace string
+	Inputs    []ValueType
+	Outputs   []ValueType
+}
+
+// Signatures returns the namespaces and WebAssembly signatures of all the imported functions, by name
+func (imports *Imports) Signatures() map[string]ImportedFunctionSignature {
+	signatures := make(map[string]ImportedFunctionSignature)
+	for _, namespacedImports := range imports.imports {
+		for name, importFunction := range namespacedImports {
+			signatures[name] = ImportedFunctionSignature{
+				Namespace: importFunction.Namespace,
+				Inputs:    importFunction.Inputs,
+				Outputs:   importFunction.Outputs,
+			}
+		}
+	}
+	return signatures
+}
+
+// Implementation returns the Go function that implements the given imported function
+func (imports *Imports) Implementation(namespace string, name string) (interface{}, bool) {
+	importFunction, ok := imports.imports[namespace][name]
+	if !ok {
+		return nil, false
+	}
+	return importFunction.Implementation, true
+}
+
+// Functions returns all the imported functions, for the backends to register them
+func (imports *Imports) Functions() []ImportedFunction {
+	functions := make([]ImportedFunction, 0, imports.Count())
+	for _, namespacedImports := range imports.imports {
+		for _, importFunction := range namespacedImports {
+			functions = append(functions, importFunction)
+		}
+	}
+	return functions
+}
+
+// Append adds a new imported function to the current set.
+func (imports *Imports) Append(importName string, implementation interface{}, cgoPointer unsafe.Pointer) (*Imports, error) {
+	var importType = reflect.TypeOf(implementation)
+
+	if importType.Kind() != reflect.Func {
+		return nil, NewImportedFunctionError(importName, fmt.Sprintf("Imported function `%%s` must be a function; given `%s`.", importType.Kind()))
+	}
+
+	var importInputsArity = importType.NumIn()
+
+	if importInputsArity < 1 {
+		return nil, NewImportedFunctionError(importName, "Imported function `%s` must at least have one argument for the instance context.")
+	}
+
+	if importType.In(0).Kind() != reflect.UnsafePointer {
+		return nil, NewImportedFunctionError(importName, fmt.Sprintf("The instance context of the `%%s` imported function must be of kind `unsafe.Pointer`; given `%s`; is it missing?", importType.In(0).Kind()))
+	}
+
+	importInputsArity--
+	var importOutputsArity = importType.NumOut()
+	var wasmInputs = make([]ValueType, importInputsArity)
+	var wasmOutputs = make([]ValueType, importOutputsArity)
+
+	for nth := 0; nth < importInputsArity; nth++ {
+		var importInput = importType.In(nth + 1)
+
+		switch importInput.Kind() {
+		case reflect.Int32:
+			wasmInputs[nth] = TypeI32
+		case reflect.Int64:
+			wasmInputs[nth] = TypeI64
+		default:
+			return nil, NewImportedFunctionError(importName, fmt.Sprintf("Invalid input type for the `%%s` imported function; given `%s`; only accept `int32`, `int64`, `float32`, and `float64`.", importInput.Kind()))
+		}
+	}
+
+	if importOutputsArity > 1 {
+		return nil, NewImportedFunctionError(importName, "The `%s` imported function must have at most one output value.")
+	} else if importOutputsArity == 1 {
+		switch importType.Out(0).Kind() {
+		case reflect.Int32:
+			wasmOutputs[0] = TypeI32
+		case reflect.Int64:
+			wasmOutputs[0] = TypeI64
+		default:
+			return nil, NewImportedFunctionError(importName, fmt.Sprintf("Invalid output type for the `%%s` imported function; given `%s`; only accept `int32`, `int64`, `float32`, and `float64`.", importType.Out(0).Kind()))
+		}
+	}
+
+	var namespace = imports.currentNamespace
+
+	if imports.imports[namespace] == nil {
+		imports.imports[namespace] = make(map[string]ImportedFunction)
+	}
+
+	imports.imports[namespace][importName] = ImportedFunction{
+		Namespace:      namespace,
+		Name:           importName,
+		Implementation: implementation,
+		CgoPointer:     cgoPointer,
+		Inputs:         wasmInputs,
+		Outputs:        wasmOutputs,
+	}
+
+	return imports, nil
+}
+
+// Close does nothing, since the Wasmer backend keeps the functions it registers for the lifetime of the process.
+func (imports *Imports) Close() {
+}
@@ -0,0 +1,58 @@
+package executor
+
+// InstanceHandler defines the functionality of a WebAssembly instance, created by any of the backends
+type InstanceHandler interface {
+	HasMemory() bool
+	SetContextData(data uintptr)
+	GetPointsUsed() uint64
+	SetPointsUsed(points uint64)
+	SetGasLimit(gasLimit uint64)
+	SetBreakpointValue(value uint64)
+	GetBreakpointValue() uint64
+	Cache() ([]byte, error)
+	Clean()
+	GetExports() ExportsMap
+	GetSignature(functionName string) (*ExportedFunctionSignature, bool)
+	GetData() uintptr
+	GetInstanceCtxMemory() MemoryHandler
+	GetMemory() MemoryHandler
+	SetMemory(data []byte) bool
+	IsFunctionImported(name string) bool
+	IsInterfaceNil() bool
+}
+
+// MemoryHandler defines the functionality of the memory of a WebAssembly instance
+type MemoryHandler interface {
+	Length() uint32
+	Data() []byte
+	Grow(pages uint32) error
+	Destroy()
+	IsInterfaceNil() bool
+}
+
+// ExportedFunctionSignature holds information about the input/output arities
+// of an exported function
+type ExportedFunctionSignature struct {
+	InputArity  int
+	OutputArity int
+}
+
+// ExportedFunctionCallback calls an exported function of an instance
+type ExportedFunctionCallback func(...interface{}) (Value, error)
+
+// ExportsMap holds the exported functions of an instance, by name
+type ExportsMap map[string]ExportedFunctionCallback
+
+// ExportSignaturesMap holds the signatures of the exported functions of an instance, by name
+type ExportSignaturesMap map[string]*ExportedFunctionSignature
+
+// CompilationOptions holds the options of the compilation and of the metering of a module
+type CompilationOptions struct {
+	GasLimit           uint64
+	UnmeteredLocals    uint64
+	MaxMemoryGrow      uint64
+	MaxMemoryGrowDelta uint64
+	OpcodeTrace        bool
+	Metering           bool
+	RuntimeBreakpoints bool
+}
@@ -0,0 +1,60 @@
+package executor
+
+import (
+	"errors"
+	"fmt"
+)
+
+// TrapFrame is a function on the WebAssembly call stack of an execution that trapped
+type TrapFrame struct {
+	FunctionIndex uint32
+	FunctionName  string
+
+	// CodeOffset locates the executing instruction from the start of the code section contents,
+	// which is how the DWARF sections of WebAssembly modules address the code
+	CodeOffset uint32
+
+	// File, Line and Column are the source location of the instruction, when the module has DWARF sections
+	File   string
+	Line   int
+	Column int
+}
+
+// String formats the frame as the function name and index, the code offset, and the source location when known
+func (frame TrapFrame) String() string {
+	function := fmt.Sprintf("func %d", frame.FunctionIndex)
+	if frame.FunctionName != "" {
+		function = fmt.Sprintf("%s (func %d)", frame.FunctionName, frame.FunctionIndex)
+	}
+	result := fmt.Sprintf("%s at 0x%x", function, frame.CodeOffset)
+	if frame.File != "" {
+		result += fmt.Sprintf(", %s:%d:%d", frame.File, frame.Line, frame.Column)
+	}
+	return result
+}
+
+// TrapError is the error of an execution that trapped, with the call stack at the trap, innermost function first.
+// Only the backends which can walk the call stack of their executions return it.
+type TrapError struct {
+	Err    error
+	Frames []TrapFrame
+}
+
+// Error returns the message of the trap
+func (trapErr *TrapError) Error() string {
+	return trapErr.Err.Error()
+}
+
+// Unwrap returns the trap
+func (trapErr *TrapError) Unwrap() error {
+	return trapErr.Err
+}
+
+// GetTrapError returns the TrapError in the chain of the given execution error, or nil if the backend did not capture the call stack
+func GetTrapError(err error) *TrapError {
+	var trapErr *TrapError
+	if errors.As(err, &trapErr) {
+		return trapErr
+	}
+	return nil
+}
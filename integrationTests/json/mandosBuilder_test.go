@@ -0,0 +1,61 @@
+package vmjsonintegrationtest
+
+import (
+	"testing"
+
+	am "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwenmandos"
+	mb "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/builder"
+	mc "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/controller"
+	"github.com/stretchr/testify/require"
+)
+
+func transferScenario(expectedBalance mb.Value) *mb.ScenarioBuilder {
+	scenario := mb.NewScenario("built transfer")
+	scenario.SetState().
+		Account("address:A").Nonce("0").Balance("150").
+		Account("address:B").Nonce("0").Balance("0")
+	scenario.Transfer("1").
+		From("address:A").
+		To("address:B").
+		EGLDValue("100")
+	scenario.CheckState().
+		Account("address:A").Nonce("1").Balance("50").NoStorage().Code("").
+		Account("address:B").Nonce("0").Balance(expectedBalance).NoStorage().Code("")
+	return scenario
+}
+
+func executeBuiltScenario(t *testing.T, builder *mb.ScenarioBuilder) error {
+	scenario, err := builder.Build()
+	require.Nil(t, err)
+
+	executor, err := am.NewArwenTestExecutor()
+	require.Nil(t, err)
+	defer executor.Close()
+
+	return executor.ExecuteScenario(scenario, mc.NewDefaultFileResolver())
+}
+
+func TestMandosBuilderTransfer(t *testing.T) {
+	err := executeBuiltScenario(t, transferScenario("100"))
+	require.Nil(t, err)
+}
+
+func TestMandosBuilderTransferCheckErr(t *testing.T) {
+	err := executeBuiltScenario(t, transferScenario("101"))
+	require.EqualError(t, err,
+		"check failed, step checkState:\n  account address:B:\n    ~ balance: want \"101\" (0x65), have \"100\" (0x64)")
+}
+
+func TestMandosBuilderCheckAllDifferences(t *testing.T) {
+	scenario := transferScenario("100")
+	scenario.CheckState().
+		Account("address:A").Nonce("2").Balance("50").Storage("str:key", "1").
+		Account("address:C").Nonce("0")
+	err := executeBuiltScenario(t, scenario)
+	require.EqualError(t, err, `check failed, step checkState:
+  account address:A:
+    ~ nonce: want "2" (0x02), have "1" (0x01)
+    - storage str:key: want "1" (0x01), have nothing
+  - account address:C: expected but not found
+  + account address:B: unexpected`)
+}
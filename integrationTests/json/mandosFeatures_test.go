@@ -82,23 +82,13 @@ func TestTimelocks(t *testing.T) {
 	runAllTestsInFolder(t, "timelocks")
 }
 
-// func TestPromises(t *testing.T) {
-// 	executor, err := am.NewArwenTestExecutor()
-// 	require.Nil(t, err)
-// 	runner := mc.NewScenarioRunner(
-// 		executor,
-// 		mc.NewDefaultFileResolver(),
-// 	)
-// 	err = runner.RunAllJSONScenariosInDirectory(
-// 		getTestRoot(),
-// 		"promises",
-// 		".scen.json",
-// 		[]string{})
-
-// 	if err != nil {
-// 		t.Error(err)
-// 	}
-// }
+func TestPromises(t *testing.T) {
+	runAllTestsInFolder(t, "promises")
+}
+
+func TestTrap(t *testing.T) {
+	runAllTestsInFolder(t, "trap")
+}
 
 func TestForwarderTransfExec(t *testing.T) {
 	err := runSingleTestReturnError("features/composability/mandos", "forwarder_call_transf_exec_reject_nft.scen.json")
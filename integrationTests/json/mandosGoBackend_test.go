@@ -0,0 +1,59 @@
+package vmjsonintegrationtest
+
+import (
+	"os"
+	"testing"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	"github.com/stretchr/testify/require"
+)
+
+// compareBackendsEnvironmentVariable enables TestCompareBackends, which runs every scenario twice
+const compareBackendsEnvironmentVariable = "ARWEN_COMPARE_BACKENDS"
+
+func TestGoBackendComposability(t *testing.T) {
+	runTestsInFolderWithBackend(t, "features/composability/mandos", []string{}, arwen.GoBackend)
+}
+
+func TestGoBackendPromises(t *testing.T) {
+	runTestsInFolderWithBackend(t, "promises", []string{}, arwen.GoBackend)
+}
+
+func TestGoBackendTrap(t *testing.T) {
+	runTestsInFolderWithBackend(t, "trap", []string{}, arwen.GoBackend)
+}
+
+func TestGoBackendTrap_CallStackInCheckFailure(t *testing.T) {
+	err := runSingleTestWithBackendReturnError("trap", "trap-unexpected.err.json", arwen.GoBackend)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "trap: integer divide by zero")
+	require.Contains(t, err.Error(), "#0 divide (func 1) at 0x")
+	require.Contains(t, err.Error(), "#1 divideByZero (func 4) at 0x")
+}
+
+func TestGoBackendBasicFeatures(t *testing.T) {
+	if testing.Short() {
+		t.Skip("not a short test")
+	}
+
+	runTestsInFolderWithBackend(t, "features/basic-features/mandos", []string{
+		"features/basic-features/mandos/storage_mapper_fungible_token.scen.json"}, arwen.GoBackend)
+}
+
+// TestCompareBackends checks that Wasmer and the Go backend agree on the outcome and on the gas used
+// by each scenario. It only runs when ARWEN_COMPARE_BACKENDS is set to 1, see make test-compare-backends.
+func TestCompareBackends(t *testing.T) {
+	if os.Getenv(compareBackendsEnvironmentVariable) != "1" {
+		t.Skip(compareBackendsEnvironmentVariable + " is not set")
+	}
+
+	compareBackendsInFolder(t, "adder/mandos", []string{})
+	compareBackendsInFolder(t, "erc20-rust/mandos", []string{})
+	compareBackendsInFolder(t, "features/alloc-features/mandos", []string{})
+	compareBackendsInFolder(t, "features/basic-features/mandos", []string{
+		"features/basic-features/mandos/storage_mapper_fungible_token.scen.json"})
+	compareBackendsInFolder(t, "features/payable-features/mandos", []string{})
+	compareBackendsInFolder(t, "features/composability/mandos", []string{})
+	compareBackendsInFolder(t, "promises", []string{})
+	compareBackendsInFolder(t, "trap", []string{})
+}
@@ -47,97 +47,103 @@ func TestMandoSetAccountSCAddressErr3(t *testing.T) {
 
 func TestMandosCheckNonceErr(t *testing.T) {
 	err := runSingleTestReturnError("mandos-self-test/set-check", "set-check-nonce.err.json")
-	require.EqualError(t, err,
-		"bad account nonce. Account: address:the-address. Want: \"1002\". Have: \"1001\"")
+	require.EqualError(t, err, `check failed, step checkState:
+  account address:the-address:
+    ~ nonce: want "1002" (0x03ea), have "1001" (0x03e9)`)
 }
 
 func TestMandosCheckOwnerErr1(t *testing.T) {
 	err := runSingleTestReturnError("mandos-self-test/set-check", "set-check-owner.err1.json")
-	require.EqualError(t, err,
-		"bad account owner. Account: address:child. Want: \"address:other\". Have: \"address:parent\"")
+	require.EqualError(t, err, `check failed, step checkState:
+  account address:child:
+    ~ owner: want "address:other" (0x6f746865725f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f), have "address:parent" (0x706172656e745f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f)`)
 }
 
 func TestMandosCheckOwnerErr2(t *testing.T) {
 	err := runSingleTestReturnError("mandos-self-test/set-check", "set-check-owner.err2.json")
-	require.EqualError(t, err,
-		"bad account owner. Account: address:parent. Want: \"address:other\". Have: \"\"")
+	require.EqualError(t, err, `check failed, step checkState:
+  account address:parent:
+    ~ owner: want "address:other" (0x6f746865725f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f), have ""`)
 }
 
 func TestMandosCheckBalanceErr(t *testing.T) {
 	err := runSingleTestReturnError("mandos-self-test/set-check", "set-check-balance.err.json")
-	require.EqualError(t, err,
-		"bad account balance. Account: address:the-address. Want: \"1,000,002\". Have: \"1000001\"")
+	require.EqualError(t, err, `check failed, step checkState:
+  account address:the-address:
+    ~ balance: want "1,000,002" (0x0f4242), have "1000001" (0x0f4241)`)
 }
 
 func TestMandosCheckUsernameErr(t *testing.T) {
 	err := runSingleTestReturnError("mandos-self-test/set-check", "set-check-username.err.json")
-	require.EqualError(t, err,
-		"bad account username. Account: address:the-address. Want: \"str:wrong.elrond\". Have: \"str:theusername.elrond\"")
+	require.EqualError(t, err, `check failed, step checkState:
+  account address:the-address:
+    ~ username: want "str:wrong.elrond" (0x77726f6e672e656c726f6e64), have "str:theusername.elrond" (0x746865757365726e616d652e656c726f6e64)`)
 }
 
 func TestMandosCheckCodeErr(t *testing.T) {
 	err := runSingleTestReturnError("mandos-self-test/set-check", "set-check-code.err.json")
-	require.EqualError(t, err,
-		"bad account code. Account: sc:contract-address. Want: \"file:set-check-code.scen.json\". Have: \"0x7b0a2020202022636f6d...\"")
+	require.EqualError(t, err, `check failed, step checkState:
+  account sc:contract-address:
+    ~ code: want "file:set-check-code.scen.json", have "0x7b0a2020202022636f6d..."`)
 }
 
 func TestMandosCheckStorageErr1(t *testing.T) {
 	err := runSingleTestReturnError("mandos-self-test/set-check", "set-check-storage.err1.json")
-	require.EqualError(t, err,
-		"wrong account storage for account \"address:the-address\":\n"+
-			"  for key 0x6b65792d63 (str:key-c): Want: \"str:another-value\". Have: \"0x76616c75652d63 (str:value-c)\"")
+	require.EqualError(t, err, `check failed, step checkState:
+  account address:the-address:
+    ~ storage str:key-c: want "str:another-value" (0x616e6f746865722d76616c7565), have "str:value-c" (0x76616c75652d63)`)
 }
 
 func TestMandosCheckStorageErr2(t *testing.T) {
 	err := runSingleTestReturnError("mandos-self-test/set-check", "set-check-storage.err2.json")
-	require.EqualError(t, err,
-		"wrong account storage for account \"address:the-address\":\n"+
-			"  for key 0x6b65792d63 (str:key-c): Want: \"\". Have: \"0x76616c75652d63 (str:value-c)\"")
+	require.EqualError(t, err, `check failed, step checkState:
+  account address:the-address:
+    + storage str:key-c: want nothing, have "str:value-c" (0x76616c75652d63)`)
 }
 
 func TestMandosCheckStorageErr3(t *testing.T) {
 	err := runSingleTestReturnError("mandos-self-test/set-check", "set-check-storage.err3.json")
-	require.EqualError(t, err,
-		"wrong account storage for account \"address:the-address\":\n"+
-			"  for key 0x6b65792d64 (str:key-d): Want: \"str:value-d\". Have: \"\"")
+	require.EqualError(t, err, `check failed, step checkState:
+  account address:the-address:
+    - storage str:key-d: want "str:value-d" (0x76616c75652d64), have nothing`)
 }
 
 func TestMandosCheckStorageErr4(t *testing.T) {
 	err := runSingleTestReturnError("mandos-self-test/set-check", "set-check-storage.err4.json")
-	require.EqualError(t, err,
-		"wrong account storage for account \"address:the-address\":\n"+
-			"  for key 0x6b65792d63 (str:key-c): Want: \"\". Have: \"0x76616c75652d63 (str:value-c)\"")
+	require.EqualError(t, err, `check failed, step checkState:
+  account address:the-address:
+    + storage str:key-c: want nothing, have "str:value-c" (0x76616c75652d63)`)
 }
 
 func TestMandosCheckStorageErr5(t *testing.T) {
 	err := runSingleTestReturnError("mandos-self-test/set-check", "set-check-storage.err5.json")
-	require.EqualError(t, err,
-		"wrong account storage for account \"address:the-address\":\n"+
-			"  for key 0x6b65792d62 (str:key-b): Want: \"str:another-b\". Have: \"0x76616c75652d62 (str:value-b)\"")
+	require.EqualError(t, err, `check failed, step checkState:
+  account address:the-address:
+    ~ storage str:key-b: want "str:another-b" (0x616e6f746865722d62), have "str:value-b" (0x76616c75652d62)`)
 }
 
 func TestMandosCheckESDTErr1(t *testing.T) {
 	err := runSingleTestReturnError("mandos-self-test/set-check", "set-check-esdt.err1.json")
-	require.EqualError(t, err,
-		`mismatch for account "address:the-address":
-  for token: NFT-123456, nonce: 1: Bad balance. Want: "4". Have: "1"
-  for token: NFT-123456, nonce: 1: Bad creator. Want: "address:another-address". Have: "address:the-address"
-  for token: NFT-123456, nonce: 1: Bad royalties. Want: "2001". Have: "2000"
-  for token: NFT-123456, nonce: 1: Bad hash. Want: "keccak256:str:another_hash". Have: 0x54e3ea4bdef3b22154767a2cae081fca2bec2eae1ec62ee71308cb2a300d675d (str:"T\xe3\xeaK\xde\xf3\xb2!Tvz,\xae\b\x1f\xca+\xec.\xae\x1e\xc6.\xe7\x13\b\xcb*0\rg]")
-  for token: NFT-123456, nonce: 1: Bad URI. Want: ["str:www.cool_nft.com/another_nft.jpg", "*"]. Have: ["str:www.cool_nft.com/my_nft.jpg", "str:www.cool_nft.com/my_nft.json"]
-  for token: NFT-123456, nonce: 1: Bad attributes. Want: "str:other_attributes". Have: "str:serialized_attributes"`)
+	require.EqualError(t, err, `check failed, step checkState:
+  account address:the-address:
+    ~ esdt balance NFT-123456 nonce 1: want "4" (0x04), have "1" (0x01)
+    ~ esdt creator NFT-123456 nonce 1: want "address:another-address" (0x616e6f746865722d616464726573735f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f), have "address:the-address" (0x7468652d616464726573735f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f)
+    ~ esdt royalties NFT-123456 nonce 1: want "2001" (0x07d1), have "2000" (0x07d0)
+    ~ esdt hash NFT-123456 nonce 1: want "keccak256:str:another_hash" (0x7745e71b4f0897a058e04dbe30be98a09caf937c789536a77936c7b8b11036ea), have "0x54e3ea4bdef3b22154767a2cae081fca2bec2eae1ec62ee71308cb2a300d675d"
+    ~ esdt uri NFT-123456 nonce 1 [0]: want "str:www.cool_nft.com/another_nft.jpg" (0x7777772e636f6f6c5f6e66742e636f6d2f616e6f746865725f6e66742e6a7067), have "str:www.cool_nft.com/my_nft.jpg" (0x7777772e636f6f6c5f6e66742e636f6d2f6d795f6e66742e6a7067)
+    ~ esdt attributes NFT-123456 nonce 1: want "str:other_attributes" (0x6f746865725f61747472696275746573), have "str:serialized_attributes" (0x73657269616c697a65645f61747472696275746573)`)
 }
 
 func TestMandosEsdtZeroBalance(t *testing.T) {
 	err := runSingleTestReturnError("mandos-self-test", "esdt-zero-balance-check-err.scen.json")
-	require.EqualError(t, err,
-		`mismatch for account "address:A":
-  for token: TOK-123456, nonce: 0: Bad balance. Want: "". Have: "150"`)
+	require.EqualError(t, err, `check failed, step checkState:
+  account address:A:
+    + esdt balance TOK-123456 nonce 0: want nothing, have "150" (0x96)`)
 }
 
 func TestMandosEsdtNonZeroBalance(t *testing.T) {
 	err := runSingleTestReturnError("mandos-self-test", "esdt-non-zero-balance-check-err.scen.json")
-	require.EqualError(t, err,
-		`mismatch for account "address:B":
-  for token: TOK-123456, nonce: 0: Bad balance. Want: "100". Have: "0"`)
+	require.EqualError(t, err, `check failed, step checkState:
+  account address:B:
+    - esdt balance TOK-123456 nonce 0: want "100" (0x64), have nothing`)
 }
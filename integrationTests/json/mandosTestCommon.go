@@ -1,11 +1,13 @@
 package vmjsonintegrationtest
 
 import (
+	"io/ioutil"
 	"os"
 	"path"
 	"path/filepath"
 	"testing"
 
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
 	am "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwenmandos"
 	mc "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/controller"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
@@ -30,9 +32,14 @@ func runAllTestsInFolder(t *testing.T, folder string) {
 }
 
 func runTestsInFolder(t *testing.T, folder string, exclusions []string) {
+	runTestsInFolderWithBackend(t, folder, exclusions, arwen.WasmerBackend)
+}
+
+func runTestsInFolderWithBackend(t *testing.T, folder string, exclusions []string, wasmBackend arwen.WasmBackend) {
 	executor, err := am.NewArwenTestExecutor()
 	require.Nil(t, err)
 	defer executor.Close()
+	executor.SetWasmBackend(wasmBackend)
 
 	runner := mc.NewScenarioRunner(
 		executor,
@@ -51,12 +58,75 @@ func runTestsInFolder(t *testing.T, folder string, exclusions []string) {
 	}
 }
 
+// compareBackendsInFolder runs the scenarios of the folder with Wasmer and with the Go backend,
+// which must both pass every scenario, using the same gas
+func compareBackendsInFolder(t *testing.T, folder string, exclusions []string) {
+	wasmerReport := runTestsInFolderWithReport(t, folder, exclusions, arwen.WasmerBackend)
+	goBackendReport := runTestsInFolderWithReport(t, folder, exclusions, arwen.GoBackend)
+	require.Equal(t, len(wasmerReport.Results), len(goBackendReport.Results))
+
+	for i, wasmerResult := range wasmerReport.Results {
+		goBackendResult := goBackendReport.Results[i]
+		if wasmerResult.Status != goBackendResult.Status {
+			t.Errorf("%s: %s with Wasmer, %s with the Go backend",
+				wasmerResult.Path, wasmerResult.Status, goBackendResult.Status)
+			continue
+		}
+		if wasmerResult.GasUsed != goBackendResult.GasUsed {
+			t.Errorf("%s: gas used %d with Wasmer, %d with the Go backend",
+				wasmerResult.Path, wasmerResult.GasUsed, goBackendResult.GasUsed)
+		}
+	}
+}
+
+// runTestsInFolderWithReport runs the scenarios of the folder one at a time, each with its own executor,
+// and reports the outcome and the gas used by each of them
+func runTestsInFolderWithReport(t *testing.T, folder string, exclusions []string, wasmBackend arwen.WasmBackend) *mc.ScenarioRunReport {
+	runner := mc.NewParallelScenarioRunner(
+		func() (mc.ScenarioExecutor, error) {
+			executor, err := am.NewArwenTestExecutor()
+			if err != nil {
+				return nil, err
+			}
+			executor.SetWasmBackend(wasmBackend)
+			return executor, nil
+		},
+		mc.ParallelRunOptions{
+			NumWorkers:      1,
+			ExcludePatterns: exclusions,
+			ScenarioOptions: mc.DefaultRunScenarioOptions(),
+			Output:          ioutil.Discard,
+		})
+
+	report, err := runner.RunAllJSONScenariosInDirectory(getTestRoot(), folder, ".scen.json")
+	require.NotNil(t, report, err)
+	for _, result := range report.Results {
+		if result.Status == mc.ScenarioFailed || result.Status == mc.ScenarioTimedOut {
+			t.Errorf("%s with %s: %s", result.Path, wasmBackendName(wasmBackend), result.Failure)
+		}
+	}
+
+	return report
+}
+
+func wasmBackendName(wasmBackend arwen.WasmBackend) string {
+	if wasmBackend == arwen.GoBackend {
+		return "the Go backend"
+	}
+	return "Wasmer"
+}
+
 func runSingleTestReturnError(folder string, filename string) error {
+	return runSingleTestWithBackendReturnError(folder, filename, arwen.WasmerBackend)
+}
+
+func runSingleTestWithBackendReturnError(folder string, filename string, wasmBackend arwen.WasmBackend) error {
 	executor, err := am.NewArwenTestExecutor()
 	if err != nil {
 		return err
 	}
 	defer executor.Close()
+	executor.SetWasmBackend(wasmBackend)
 
 	runner := mc.NewScenarioRunner(
 		executor,
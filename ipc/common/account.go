@@ -0,0 +1,133 @@
+package common
+
+import (
+	"math/big"
+
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+var _ vmcommon.UserAccountHandler = (*Account)(nil)
+
+// Account is a copy of a user account of the node, sent to the VM process.
+// Changing it does not change the account of the node, and it gives no access to the account data.
+type Account struct {
+	Address         []byte
+	Nonce           uint64
+	Balance         *big.Int
+	CodeMetadata    []byte
+	CodeHash        []byte
+	RootHash        []byte
+	DeveloperReward *big.Int
+	OwnerAddress    []byte
+	UserName        []byte
+}
+
+// NewAccount copies a user account of the node
+func NewAccount(account vmcommon.UserAccountHandler) *Account {
+	return &Account{
+		Address:         account.AddressBytes(),
+		Nonce:           account.GetNonce(),
+		Balance:         account.GetBalance(),
+		CodeMetadata:    account.GetCodeMetadata(),
+		CodeHash:        account.GetCodeHash(),
+		RootHash:        account.GetRootHash(),
+		DeveloperReward: account.GetDeveloperReward(),
+		OwnerAddress:    account.GetOwnerAddress(),
+		UserName:        account.GetUserName(),
+	}
+}
+
+// AddressBytes returns the address of the account
+func (account *Account) AddressBytes() []byte {
+	return account.Address
+}
+
+// IncreaseNonce increases the nonce of the copy
+func (account *Account) IncreaseNonce(nonce uint64) {
+	account.Nonce += nonce
+}
+
+// GetNonce returns the nonce
+func (account *Account) GetNonce() uint64 {
+	return account.Nonce
+}
+
+// GetCodeMetadata returns the code metadata
+func (account *Account) GetCodeMetadata() []byte {
+	return account.CodeMetadata
+}
+
+// GetCodeHash returns the code hash
+func (account *Account) GetCodeHash() []byte {
+	return account.CodeHash
+}
+
+// GetRootHash returns the root hash of the account data
+func (account *Account) GetRootHash() []byte {
+	return account.RootHash
+}
+
+// AccountDataHandler returns nil, since the account data stays on the node
+func (account *Account) AccountDataHandler() vmcommon.AccountDataHandler {
+	return nil
+}
+
+// AddToBalance adds the value to the balance of the copy
+func (account *Account) AddToBalance(value *big.Int) error {
+	account.Balance = big.NewInt(0).Add(account.GetBalance(), value)
+	return nil
+}
+
+// GetBalance returns the balance
+func (account *Account) GetBalance() *big.Int {
+	if account.Balance == nil {
+		return big.NewInt(0)
+	}
+	return account.Balance
+}
+
+// ClaimDeveloperRewards resets the developer reward of the copy and returns it
+func (account *Account) ClaimDeveloperRewards(_ []byte) (*big.Int, error) {
+	reward := account.GetDeveloperReward()
+	account.DeveloperReward = big.NewInt(0)
+	return reward, nil
+}
+
+// GetDeveloperReward returns the developer reward
+func (account *Account) GetDeveloperReward() *big.Int {
+	if account.DeveloperReward == nil {
+		return big.NewInt(0)
+	}
+	return account.DeveloperReward
+}
+
+// ChangeOwnerAddress sets the owner address of the copy
+func (account *Account) ChangeOwnerAddress(_ []byte, newAddress []byte) error {
+	account.OwnerAddress = newAddress
+	return nil
+}
+
+// SetOwnerAddress sets the owner address of the copy
+func (account *Account) SetOwnerAddress(address []byte) {
+	account.OwnerAddress = address
+}
+
+// GetOwnerAddress returns the owner address
+func (account *Account) GetOwnerAddress() []byte {
+	return account.OwnerAddress
+}
+
+// SetUserName sets the user name of the copy
+func (account *Account) SetUserName(userName []byte) {
+	account.UserName = userName
+}
+
+// GetUserName returns the user name
+func (account *Account) GetUserName() []byte {
+	return account.UserName
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (account *Account) IsInterfaceNil() bool {
+	return account == nil
+}
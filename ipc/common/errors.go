@@ -0,0 +1,42 @@
+package common
+
+import "errors"
+
+// ErrVMCrashed signals that the VM process exited, or broke the protocol, during a request
+var ErrVMCrashed = errors.New("the VM process crashed")
+
+// ErrVMCallTimeout signals that the VM process did not answer a request before its deadline
+var ErrVMCallTimeout = errors.New("the VM process did not answer in time")
+
+// ErrVMClosed signals that the VM driver was closed
+var ErrVMClosed = errors.New("the VM driver is closed")
+
+// ErrVMNotInitialized signals a request received by the VM process before the VM host was created
+var ErrVMNotInitialized = errors.New("the VM host is not initialized")
+
+// ErrInvalidMessageLength signals a message that is empty or longer than MaxMessageLength
+var ErrInvalidMessageLength = errors.New("invalid message length")
+
+// ErrUnexpectedMessage signals a message that is not allowed at that point of the protocol
+var ErrUnexpectedMessage = errors.New("unexpected message")
+
+// ErrInvalidHookCall signals a blockchain hook call with an unknown function, or with wrong arguments
+var ErrInvalidHookCall = errors.New("invalid blockchain hook call")
+
+// ErrUnknownBuiltInFunction signals that the node did not declare the built-in function
+var ErrUnknownBuiltInFunction = errors.New("unknown built-in function")
+
+// ErrBuiltInFunctionOnNode signals a built-in function called inside the VM process, instead of through the blockchain hook of the node
+var ErrBuiltInFunctionOnNode = errors.New("built-in functions are processed by the node")
+
+// ErrNilBlockchainHook signals that a nil blockchain hook was provided
+var ErrNilBlockchainHook = errors.New("nil blockchain hook")
+
+// ErrNilVMHostParameters signals that nil VM host parameters were provided
+var ErrNilVMHostParameters = errors.New("nil VM host parameters")
+
+// ErrEmptyVMPath signals that the path of the VM executable is missing
+var ErrEmptyVMPath = errors.New("empty path of the VM executable")
+
+// ErrNilHostFactory signals that a nil host factory was provided
+var ErrNilHostFactory = errors.New("nil host factory")
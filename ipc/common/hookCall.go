@@ -0,0 +1,214 @@
+package common
+
+import (
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+// The blockchain hook functions that the VM process calls on the node, named after the vmcommon.BlockchainHook methods.
+const (
+	NewAddressFunction              = "NewAddress"
+	GetStorageDataFunction          = "GetStorageData"
+	GetBlockhashFunction            = "GetBlockhash"
+	LastNonceFunction               = "LastNonce"
+	LastRoundFunction               = "LastRound"
+	LastTimeStampFunction           = "LastTimeStamp"
+	LastRandomSeedFunction          = "LastRandomSeed"
+	LastEpochFunction               = "LastEpoch"
+	GetStateRootHashFunction        = "GetStateRootHash"
+	CurrentNonceFunction            = "CurrentNonce"
+	CurrentRoundFunction            = "CurrentRound"
+	CurrentTimeStampFunction        = "CurrentTimeStamp"
+	CurrentRandomSeedFunction       = "CurrentRandomSeed"
+	CurrentEpochFunction            = "CurrentEpoch"
+	ProcessBuiltInFunctionFunction  = "ProcessBuiltInFunction"
+	GetBuiltinFunctionNamesFunction = "GetBuiltinFunctionNames"
+	GetAllStateFunction             = "GetAllState"
+	GetUserAccountFunction          = "GetUserAccount"
+	GetCodeFunction                 = "GetCode"
+	GetShardOfAddressFunction       = "GetShardOfAddress"
+	IsSmartContractFunction         = "IsSmartContract"
+	IsPayableFunction               = "IsPayable"
+	SaveCompiledCodeFunction        = "SaveCompiledCode"
+	GetCompiledCodeFunction         = "GetCompiledCode"
+	ClearCompiledCodesFunction      = "ClearCompiledCodes"
+	GetESDTTokenFunction            = "GetESDTToken"
+	GetSnapshotFunction             = "GetSnapshot"
+	RevertToSnapshotFunction        = "RevertToSnapshot"
+)
+
+func init() {
+	gob.Register(map[string][]byte{})
+	gob.Register(&vmcommon.ContractCallInput{})
+	gob.Register(&vmcommon.VMOutput{})
+	gob.Register(&esdt.ESDigitalToken{})
+	gob.Register(&Account{})
+}
+
+// HookCallRequest calls a function of the blockchain hook of the node.
+// The accounts are sent by address, and the function names as a list.
+type HookCallRequest struct {
+	Function  string
+	Arguments HookValues
+}
+
+// NewHookCallRequest creates the call of a blockchain hook function
+func NewHookCallRequest(function string, arguments ...interface{}) *HookCallRequest {
+	return &HookCallRequest{
+		Function:  function,
+		Arguments: newHookValues(arguments),
+	}
+}
+
+// HookCallResponse holds the results of a blockchain hook function, the error apart
+type HookCallResponse struct {
+	Results HookValues
+	Error   string
+}
+
+// NewHookCallResponse creates the response of a blockchain hook function
+func NewHookCallResponse(err error, results ...interface{}) *HookCallResponse {
+	response := &HookCallResponse{
+		Results: newHookValues(results),
+	}
+	if err != nil {
+		response.Error = err.Error()
+	}
+	return response
+}
+
+// Err converts the error message back to an error
+func (response *HookCallResponse) Err() error {
+	return ErrorFromMessage(response.Error)
+}
+
+// ErrorFromMessage converts an error message received through the pipe back to an error, nil if empty
+func ErrorFromMessage(message string) error {
+	if len(message) == 0 {
+		return nil
+	}
+	return errors.New(message)
+}
+
+// HookValues are the arguments, or the results, of a blockchain hook function
+type HookValues []interface{}
+
+// newHookValues replaces the typed nil values with nil, since gob cannot encode nil pointers inside interfaces
+func newHookValues(values []interface{}) HookValues {
+	hookValues := make(HookValues, len(values))
+	for i, value := range values {
+		if !isNilValue(value) {
+			hookValues[i] = value
+		}
+	}
+	return hookValues
+}
+
+func isNilValue(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+	reflected := reflect.ValueOf(value)
+	switch reflected.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Interface:
+		return reflected.IsNil()
+	default:
+		return false
+	}
+}
+
+// value yields the value at the index. A missing value, or a value of another type, is a protocol error, which panics.
+func (values HookValues) value(index int) interface{} {
+	if index >= len(values) {
+		panic(fmt.Sprintf("%s: missing value %d", ErrInvalidHookCall, index))
+	}
+	return values[index]
+}
+
+// Bytes yields the byte slice at the index
+func (values HookValues) Bytes(index int) []byte {
+	value := values.value(index)
+	if value == nil {
+		return nil
+	}
+	return value.([]byte)
+}
+
+// Uint64 yields the uint64 at the index
+func (values HookValues) Uint64(index int) uint64 {
+	return values.value(index).(uint64)
+}
+
+// Uint32 yields the uint32 at the index
+func (values HookValues) Uint32(index int) uint32 {
+	return values.value(index).(uint32)
+}
+
+// Int yields the int at the index
+func (values HookValues) Int(index int) int {
+	return values.value(index).(int)
+}
+
+// Bool yields the bool at the index
+func (values HookValues) Bool(index int) bool {
+	return values.value(index).(bool)
+}
+
+// Strings yields the string slice at the index
+func (values HookValues) Strings(index int) []string {
+	value := values.value(index)
+	if value == nil {
+		return nil
+	}
+	return value.([]string)
+}
+
+// BytesMap yields the map at the index
+func (values HookValues) BytesMap(index int) map[string][]byte {
+	value := values.value(index)
+	if value == nil {
+		return nil
+	}
+	return value.(map[string][]byte)
+}
+
+// ContractCallInput yields the contract call input at the index
+func (values HookValues) ContractCallInput(index int) *vmcommon.ContractCallInput {
+	value := values.value(index)
+	if value == nil {
+		return nil
+	}
+	return value.(*vmcommon.ContractCallInput)
+}
+
+// VMOutput yields the VM output at the index
+func (values HookValues) VMOutput(index int) *vmcommon.VMOutput {
+	value := values.value(index)
+	if value == nil {
+		return nil
+	}
+	return value.(*vmcommon.VMOutput)
+}
+
+// ESDTToken yields the ESDT token at the index
+func (values HookValues) ESDTToken(index int) *esdt.ESDigitalToken {
+	value := values.value(index)
+	if value == nil {
+		return nil
+	}
+	return value.(*esdt.ESDigitalToken)
+}
+
+// Account yields the account at the index
+func (values HookValues) Account(index int) *Account {
+	value := values.value(index)
+	if value == nil {
+		return nil
+	}
+	return value.(*Account)
+}
@@ -0,0 +1,66 @@
+package common
+
+import (
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/config"
+)
+
+// VMHostArguments holds the VM host parameters that can be sent to the VM process.
+// The components of the node, i.e. the built-in functions container, the ESDT transfer parser and the epoch notifier,
+// are replaced in the VM process by proxies, or by their own instances.
+type VMHostArguments struct {
+	VMType                                          []byte
+	BlockGasLimit                                   uint64
+	GasSchedule                                     config.GasScheduleMap
+	ElrondProtectedKeyPrefix                        []byte
+	WasmerSIGSEGVPassthrough                        bool
+	MultiESDTTransferAsyncCallBackEnableEpoch       uint32
+	FixOOGReturnCodeEnableEpoch                     uint32
+	RemoveNonUpdatedStorageEnableEpoch              uint32
+	CreateNFTThroughExecByCallerEnableEpoch         uint32
+	UseDifferentGasCostForReadingCachedStorageEpoch uint32
+	FixFailExecutionOnErrorEnableEpoch              uint32
+	ExtendedEEIEnableEpoch                          uint32
+	TimeOutForSCExecutionInMilliseconds             uint32
+	WasmBackend                                     arwen.WasmBackend
+}
+
+// NewVMHostArguments extracts the arguments that can be sent to the VM process
+func NewVMHostArguments(parameters *arwen.VMHostParameters) *VMHostArguments {
+	return &VMHostArguments{
+		VMType:                   parameters.VMType,
+		BlockGasLimit:            parameters.BlockGasLimit,
+		GasSchedule:              parameters.GasSchedule,
+		ElrondProtectedKeyPrefix: parameters.ElrondProtectedKeyPrefix,
+		WasmerSIGSEGVPassthrough: parameters.WasmerSIGSEGVPassthrough,
+		MultiESDTTransferAsyncCallBackEnableEpoch:       parameters.MultiESDTTransferAsyncCallBackEnableEpoch,
+		FixOOGReturnCodeEnableEpoch:                     parameters.FixOOGReturnCodeEnableEpoch,
+		RemoveNonUpdatedStorageEnableEpoch:              parameters.RemoveNonUpdatedStorageEnableEpoch,
+		CreateNFTThroughExecByCallerEnableEpoch:         parameters.CreateNFTThroughExecByCallerEnableEpoch,
+		UseDifferentGasCostForReadingCachedStorageEpoch: parameters.UseDifferentGasCostForReadingCachedStorageEpoch,
+		FixFailExecutionOnErrorEnableEpoch:              parameters.FixFailExecutionOnErrorEnableEpoch,
+		ExtendedEEIEnableEpoch:                          parameters.ExtendedEEIEnableEpoch,
+		TimeOutForSCExecutionInMilliseconds:             parameters.TimeOutForSCExecutionInMilliseconds,
+		WasmBackend:                                     parameters.WasmBackend,
+	}
+}
+
+// ToVMHostParameters yields the VM host parameters, without the components of the node
+func (arguments *VMHostArguments) ToVMHostParameters() *arwen.VMHostParameters {
+	return &arwen.VMHostParameters{
+		VMType:                   arguments.VMType,
+		BlockGasLimit:            arguments.BlockGasLimit,
+		GasSchedule:              arguments.GasSchedule,
+		ElrondProtectedKeyPrefix: arguments.ElrondProtectedKeyPrefix,
+		WasmerSIGSEGVPassthrough: arguments.WasmerSIGSEGVPassthrough,
+		MultiESDTTransferAsyncCallBackEnableEpoch:       arguments.MultiESDTTransferAsyncCallBackEnableEpoch,
+		FixOOGReturnCodeEnableEpoch:                     arguments.FixOOGReturnCodeEnableEpoch,
+		RemoveNonUpdatedStorageEnableEpoch:              arguments.RemoveNonUpdatedStorageEnableEpoch,
+		CreateNFTThroughExecByCallerEnableEpoch:         arguments.CreateNFTThroughExecByCallerEnableEpoch,
+		UseDifferentGasCostForReadingCachedStorageEpoch: arguments.UseDifferentGasCostForReadingCachedStorageEpoch,
+		FixFailExecutionOnErrorEnableEpoch:              arguments.FixFailExecutionOnErrorEnableEpoch,
+		ExtendedEEIEnableEpoch:                          arguments.ExtendedEEIEnableEpoch,
+		TimeOutForSCExecutionInMilliseconds:             arguments.TimeOutForSCExecutionInMilliseconds,
+		WasmBackend:                                     arguments.WasmBackend,
+	}
+}
@@ -0,0 +1,123 @@
+package common
+
+import (
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/config"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+// MessageKind identifies the messages exchanged between the node and the VM process
+type MessageKind uint8
+
+// The node sends a request, then answers the blockchain hook calls of the VM process, until it receives the response.
+const (
+	// Initialize asks the VM process to create its VM host, with an InitializeRequest
+	Initialize MessageKind = iota + 1
+
+	// ContractDeploy runs a ContractDeployRequest
+	ContractDeploy
+
+	// ContractCall runs a ContractCallRequest
+	ContractCall
+
+	// GasScheduleChange applies a GasScheduleChangeRequest
+	GasScheduleChange
+
+	// EpochConfirmed notifies the VM host of an EpochConfirmedRequest
+	EpochConfirmed
+
+	// Stop asks the VM process to close its VM host and exit, it has no body
+	Stop
+
+	// HookCall is a HookCallRequest, sent by the VM process while it runs a request
+	HookCall
+
+	// HookCallAnswer is the HookCallResponse sent back by the node
+	HookCallAnswer
+
+	// Response ends a request, its body depends on the request
+	Response
+)
+
+var messageKindNames = map[MessageKind]string{
+	Initialize:        "Initialize",
+	ContractDeploy:    "ContractDeploy",
+	ContractCall:      "ContractCall",
+	GasScheduleChange: "GasScheduleChange",
+	EpochConfirmed:    "EpochConfirmed",
+	Stop:              "Stop",
+	HookCall:          "HookCall",
+	HookCallAnswer:    "HookCallAnswer",
+	Response:          "Response",
+}
+
+// String yields the name of the kind
+func (kind MessageKind) String() string {
+	name, known := messageKindNames[kind]
+	if !known {
+		return "Unknown"
+	}
+	return name
+}
+
+// InitializeRequest holds what the VM process needs to create its VM host
+type InitializeRequest struct {
+	HostArguments    *VMHostArguments
+	BuiltInFunctions map[string]bool
+	Epoch            uint32
+	EpochTimestamp   uint64
+}
+
+// InitializeResponse answers an InitializeRequest
+type InitializeResponse struct {
+	Version string
+	Error   string
+}
+
+// ContractDeployRequest runs RunSmartContractCreate in the VM process
+type ContractDeployRequest struct {
+	Input            *vmcommon.ContractCreateInput
+	BuiltInFunctions map[string]bool
+}
+
+// ContractCallRequest runs RunSmartContractCall in the VM process
+type ContractCallRequest struct {
+	Input            *vmcommon.ContractCallInput
+	BuiltInFunctions map[string]bool
+}
+
+// ContractResponse answers a ContractDeployRequest or a ContractCallRequest
+type ContractResponse struct {
+	Output *vmcommon.VMOutput
+	Error  string
+}
+
+// GasScheduleChangeRequest replaces the gas schedule of the VM host
+type GasScheduleChangeRequest struct {
+	GasSchedule config.GasScheduleMap
+}
+
+// EpochConfirmedRequest forwards the epoch notifications of the node to the VM host
+type EpochConfirmedRequest struct {
+	Epoch     uint32
+	Timestamp uint64
+}
+
+// EmptyResponse answers the requests that yield nothing but a possible error
+type EmptyResponse struct {
+	Error string
+}
+
+// RestoreVMOutput replaces the nil maps of a decoded VM output with empty ones, as gob decodes empty maps as nil
+func RestoreVMOutput(output *vmcommon.VMOutput) {
+	if output == nil {
+		return
+	}
+	if output.OutputAccounts == nil {
+		output.OutputAccounts = make(map[string]*vmcommon.OutputAccount)
+	}
+	for _, account := range output.OutputAccounts {
+		if account.StorageUpdates == nil {
+			account.StorageUpdates = make(map[string]*vmcommon.StorageUpdate)
+		}
+	}
+}
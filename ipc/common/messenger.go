@@ -0,0 +1,107 @@
+package common
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"fmt"
+	"io"
+)
+
+// MaxMessageLength is the largest message accepted, in bytes
+const MaxMessageLength = 1 << 30
+
+const lengthPrefixSize = 4
+
+// The file descriptors of the pipes in the VM process, after the standard streams.
+const (
+	// NodeToVMFileDescriptor is where the VM process reads the messages of the node
+	NodeToVMFileDescriptor = 3
+
+	// VMToNodeFileDescriptor is where the VM process writes its messages to the node
+	VMToNodeFileDescriptor = 4
+)
+
+// Message is a message received through a Messenger, whose body is decoded according to its kind
+type Message struct {
+	Kind MessageKind
+	body []byte
+}
+
+// Decode decodes the body of the message into the given pointer
+func (message *Message) Decode(body interface{}) error {
+	return gob.NewDecoder(bytes.NewReader(message.body)).Decode(body)
+}
+
+// Messenger exchanges messages over a pair of streams, typically pipes.
+// Each message is framed by its length, as 4 bytes big endian, followed by its kind, as 1 byte, and its gob encoded body.
+type Messenger struct {
+	reader *bufio.Reader
+	writer io.Writer
+}
+
+// NewMessenger creates a messenger that receives from the reader and sends to the writer
+func NewMessenger(reader io.Reader, writer io.Writer) *Messenger {
+	return &Messenger{
+		reader: bufio.NewReader(reader),
+		writer: writer,
+	}
+}
+
+// Send writes a message, as a single frame. The body is nil for the kinds that have none.
+func (messenger *Messenger) Send(kind MessageKind, body interface{}) error {
+	buffer := &bytes.Buffer{}
+	buffer.Write(make([]byte, lengthPrefixSize))
+	buffer.WriteByte(byte(kind))
+	if body != nil {
+		err := gob.NewEncoder(buffer).Encode(body)
+		if err != nil {
+			return err
+		}
+	}
+
+	frame := buffer.Bytes()
+	length := len(frame) - lengthPrefixSize
+	if length > MaxMessageLength {
+		return fmt.Errorf("%w: %d bytes", ErrInvalidMessageLength, length)
+	}
+	binary.BigEndian.PutUint32(frame, uint32(length))
+
+	_, err := messenger.writer.Write(frame)
+	return err
+}
+
+// Receive reads the next message, blocking until it arrives.
+// It yields io.EOF if the stream was closed between messages.
+func (messenger *Messenger) Receive() (*Message, error) {
+	prefix := make([]byte, lengthPrefixSize)
+	_, err := io.ReadFull(messenger.reader, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	length := binary.BigEndian.Uint32(prefix)
+	if length == 0 || length > MaxMessageLength {
+		return nil, fmt.Errorf("%w: %d bytes", ErrInvalidMessageLength, length)
+	}
+
+	frame := make([]byte, length)
+	_, err = io.ReadFull(messenger.reader, frame)
+	if err != nil {
+		return nil, noEOF(err)
+	}
+
+	return &Message{
+		Kind: MessageKind(frame[0]),
+		body: frame[1:],
+	}, nil
+}
+
+// noEOF turns the end of the stream inside a message into an error, since only the end between messages is clean
+func noEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
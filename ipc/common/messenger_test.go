@@ -0,0 +1,76 @@
+package common
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"testing"
+
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMessenger_RoundTrip(t *testing.T) {
+	pipe := &bytes.Buffer{}
+	messenger := NewMessenger(pipe, pipe)
+
+	var nilOutput *vmcommon.VMOutput
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: []byte("caller"),
+			CallValue:  big.NewInt(42),
+		},
+		Function: "increment",
+	}
+	require.Nil(t, messenger.Send(HookCall, NewHookCallRequest(ProcessBuiltInFunctionFunction, input, nilOutput, []byte(nil), uint64(7))))
+	require.Nil(t, messenger.Send(Stop, nil))
+
+	message, err := messenger.Receive()
+	require.Nil(t, err)
+	require.Equal(t, HookCall, message.Kind)
+
+	request := &HookCallRequest{}
+	require.Nil(t, message.Decode(request))
+	require.Equal(t, ProcessBuiltInFunctionFunction, request.Function)
+	require.Equal(t, input, request.Arguments.ContractCallInput(0))
+	require.Nil(t, request.Arguments.VMOutput(1))
+	require.Nil(t, request.Arguments.Bytes(2))
+	require.Equal(t, uint64(7), request.Arguments.Uint64(3))
+
+	message, err = messenger.Receive()
+	require.Nil(t, err)
+	require.Equal(t, Stop, message.Kind)
+
+	_, err = messenger.Receive()
+	require.Equal(t, io.EOF, err)
+}
+
+func TestMessenger_HookCallResponseError(t *testing.T) {
+	pipe := &bytes.Buffer{}
+	messenger := NewMessenger(pipe, pipe)
+
+	require.Nil(t, messenger.Send(HookCallAnswer, NewHookCallResponse(ErrUnknownBuiltInFunction, nil)))
+
+	message, err := messenger.Receive()
+	require.Nil(t, err)
+	response := &HookCallResponse{}
+	require.Nil(t, message.Decode(response))
+	require.Equal(t, ErrUnknownBuiltInFunction.Error(), response.Err().Error())
+	require.Nil(t, response.Results.Bytes(0))
+}
+
+func TestMessenger_InvalidFrames(t *testing.T) {
+	messenger := NewMessenger(bytes.NewReader([]byte{0, 0, 0, 0}), ioutil.Discard)
+	_, err := messenger.Receive()
+	require.True(t, errors.Is(err, ErrInvalidMessageLength))
+
+	messenger = NewMessenger(bytes.NewReader([]byte{0xff, 0xff, 0xff, 0xff}), ioutil.Discard)
+	_, err = messenger.Receive()
+	require.True(t, errors.Is(err, ErrInvalidMessageLength))
+
+	messenger = NewMessenger(bytes.NewReader([]byte{0, 0, 0, 8, byte(Stop)}), ioutil.Discard)
+	_, err = messenger.Receive()
+	require.Equal(t, io.ErrUnexpectedEOF, err)
+}
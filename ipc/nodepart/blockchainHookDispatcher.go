@@ -0,0 +1,109 @@
+package nodepart
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/ipc/common"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+// callBlockchainHook runs a hook call of the VM process on the blockchain hook of the node.
+// Calls with an unknown function or wrong arguments are answered with an error, instead of failing the node.
+func callBlockchainHook(hook vmcommon.BlockchainHook, request *common.HookCallRequest) (response *common.HookCallResponse) {
+	defer func() {
+		r := recover()
+		if r != nil {
+			response = common.NewHookCallResponse(fmt.Errorf("%w: %s: %v", common.ErrInvalidHookCall, request.Function, r))
+		}
+	}()
+
+	args := request.Arguments
+	switch request.Function {
+	case common.NewAddressFunction:
+		address, err := hook.NewAddress(args.Bytes(0), args.Uint64(1), args.Bytes(2))
+		return common.NewHookCallResponse(err, address)
+	case common.GetStorageDataFunction:
+		data, err := hook.GetStorageData(args.Bytes(0), args.Bytes(1))
+		return common.NewHookCallResponse(err, data)
+	case common.GetBlockhashFunction:
+		blockHash, err := hook.GetBlockhash(args.Uint64(0))
+		return common.NewHookCallResponse(err, blockHash)
+	case common.LastNonceFunction:
+		return common.NewHookCallResponse(nil, hook.LastNonce())
+	case common.LastRoundFunction:
+		return common.NewHookCallResponse(nil, hook.LastRound())
+	case common.LastTimeStampFunction:
+		return common.NewHookCallResponse(nil, hook.LastTimeStamp())
+	case common.LastRandomSeedFunction:
+		return common.NewHookCallResponse(nil, hook.LastRandomSeed())
+	case common.LastEpochFunction:
+		return common.NewHookCallResponse(nil, hook.LastEpoch())
+	case common.GetStateRootHashFunction:
+		return common.NewHookCallResponse(nil, hook.GetStateRootHash())
+	case common.CurrentNonceFunction:
+		return common.NewHookCallResponse(nil, hook.CurrentNonce())
+	case common.CurrentRoundFunction:
+		return common.NewHookCallResponse(nil, hook.CurrentRound())
+	case common.CurrentTimeStampFunction:
+		return common.NewHookCallResponse(nil, hook.CurrentTimeStamp())
+	case common.CurrentRandomSeedFunction:
+		return common.NewHookCallResponse(nil, hook.CurrentRandomSeed())
+	case common.CurrentEpochFunction:
+		return common.NewHookCallResponse(nil, hook.CurrentEpoch())
+	case common.ProcessBuiltInFunctionFunction:
+		output, err := hook.ProcessBuiltInFunction(args.ContractCallInput(0))
+		return common.NewHookCallResponse(err, output)
+	case common.GetBuiltinFunctionNamesFunction:
+		return common.NewHookCallResponse(nil, sortedFunctionNames(hook.GetBuiltinFunctionNames()))
+	case common.GetAllStateFunction:
+		state, err := hook.GetAllState(args.Bytes(0))
+		return common.NewHookCallResponse(err, state)
+	case common.GetUserAccountFunction:
+		account, err := hook.GetUserAccount(args.Bytes(0))
+		if err != nil || account == nil || account.IsInterfaceNil() {
+			return common.NewHookCallResponse(err, nil)
+		}
+		return common.NewHookCallResponse(nil, common.NewAccount(account))
+	case common.GetCodeFunction:
+		account, err := hook.GetUserAccount(args.Bytes(0))
+		if err != nil || account == nil || account.IsInterfaceNil() {
+			return common.NewHookCallResponse(nil, nil)
+		}
+		return common.NewHookCallResponse(nil, hook.GetCode(account))
+	case common.GetShardOfAddressFunction:
+		return common.NewHookCallResponse(nil, hook.GetShardOfAddress(args.Bytes(0)))
+	case common.IsSmartContractFunction:
+		return common.NewHookCallResponse(nil, hook.IsSmartContract(args.Bytes(0)))
+	case common.IsPayableFunction:
+		isPayable, err := hook.IsPayable(args.Bytes(0), args.Bytes(1))
+		return common.NewHookCallResponse(err, isPayable)
+	case common.SaveCompiledCodeFunction:
+		hook.SaveCompiledCode(args.Bytes(0), args.Bytes(1))
+		return common.NewHookCallResponse(nil)
+	case common.GetCompiledCodeFunction:
+		found, code := hook.GetCompiledCode(args.Bytes(0))
+		return common.NewHookCallResponse(nil, found, code)
+	case common.ClearCompiledCodesFunction:
+		hook.ClearCompiledCodes()
+		return common.NewHookCallResponse(nil)
+	case common.GetESDTTokenFunction:
+		token, err := hook.GetESDTToken(args.Bytes(0), args.Bytes(1), args.Uint64(2))
+		return common.NewHookCallResponse(err, token)
+	case common.GetSnapshotFunction:
+		return common.NewHookCallResponse(nil, hook.GetSnapshot())
+	case common.RevertToSnapshotFunction:
+		return common.NewHookCallResponse(hook.RevertToSnapshot(args.Int(0)))
+	default:
+		return common.NewHookCallResponse(fmt.Errorf("%w: unknown function %s", common.ErrInvalidHookCall, request.Function))
+	}
+}
+
+func sortedFunctionNames(functionNames vmcommon.FunctionNames) []string {
+	names := make([]string, 0, len(functionNames))
+	for name := range functionNames {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,274 @@
+package nodepart
+
+import (
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/config"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/ipc/common"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+var log = logger.GetOrCreate("ipc/nodepart")
+
+// DefaultCallTimeout is the deadline of a request, unless VMDriverArgs sets another
+const DefaultCallTimeout = 30 * time.Second
+
+// DefaultStopTimeout is how long the VM process is given to exit, before being killed
+const DefaultStopTimeout = 2 * time.Second
+
+var _ vmcommon.VMExecutionHandler = (*VMDriver)(nil)
+var _ vmcommon.EpochSubscriberHandler = (*VMDriver)(nil)
+
+// VMDriverArgs holds the arguments of the VM driver
+type VMDriverArgs struct {
+	// VMHostParameters are the parameters of the VM host in the VM process.
+	// The ESDT transfer parser is not sent, the VM process creates its own.
+	VMHostParameters *arwen.VMHostParameters
+
+	// VMPath is the executable of the VM process, e.g. cmd/arwenvm
+	VMPath string
+
+	// VMArguments are the command line arguments of the VM process
+	VMArguments []string
+
+	// CallTimeout is the deadline of each request, DefaultCallTimeout if zero
+	CallTimeout time.Duration
+
+	// Output receives the standard output and error of the VM process, os.Stderr if nil
+	Output io.Writer
+}
+
+// VMDriver runs the smart contracts in a separate VM process, so the node survives the faults of the VM.
+// It implements the VMExecutionHandler for the node, and answers the blockchain hook calls of the VM process.
+// A VM process that crashes or misses its deadline is killed, the request fails, and the next request starts a new VM process.
+type VMDriver struct {
+	blockchainHook vmcommon.BlockchainHook
+	arguments      VMDriverArgs
+	hostArguments  *common.VMHostArguments
+
+	mutExecution   sync.Mutex
+	process        *vmProcess
+	version        string
+	epoch          uint32
+	epochTimestamp uint64
+	closed         bool
+}
+
+// NewVMDriver creates the VM driver, and starts the VM process
+func NewVMDriver(blockchainHook vmcommon.BlockchainHook, arguments VMDriverArgs) (*VMDriver, error) {
+	if check.IfNil(blockchainHook) {
+		return nil, common.ErrNilBlockchainHook
+	}
+	if arguments.VMHostParameters == nil {
+		return nil, common.ErrNilVMHostParameters
+	}
+	if check.IfNil(arguments.VMHostParameters.BuiltInFuncContainer) {
+		return nil, arwen.ErrNilBuiltInFunctionsContainer
+	}
+	if check.IfNil(arguments.VMHostParameters.EpochNotifier) {
+		return nil, arwen.ErrNilEpochNotifier
+	}
+	if len(arguments.VMPath) == 0 {
+		return nil, common.ErrEmptyVMPath
+	}
+	if arguments.CallTimeout == 0 {
+		arguments.CallTimeout = DefaultCallTimeout
+	}
+	if arguments.Output == nil {
+		arguments.Output = os.Stderr
+	}
+
+	driver := &VMDriver{
+		blockchainHook: blockchainHook,
+		arguments:      arguments,
+		hostArguments:  common.NewVMHostArguments(arguments.VMHostParameters),
+	}
+	arguments.VMHostParameters.EpochNotifier.RegisterNotifyHandler(driver)
+
+	driver.mutExecution.Lock()
+	defer driver.mutExecution.Unlock()
+
+	err := driver.startProcess()
+	if err != nil {
+		return nil, err
+	}
+	return driver, nil
+}
+
+// RunSmartContractCreate deploys a smart contract in the VM process
+func (driver *VMDriver) RunSmartContractCreate(input *vmcommon.ContractCreateInput) (*vmcommon.VMOutput, error) {
+	request := &common.ContractDeployRequest{
+		Input:            input,
+		BuiltInFunctions: driver.builtInFunctions(),
+	}
+	return driver.runContract(common.ContractDeploy, request)
+}
+
+// RunSmartContractCall calls a smart contract in the VM process
+func (driver *VMDriver) RunSmartContractCall(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+	request := &common.ContractCallRequest{
+		Input:            input,
+		BuiltInFunctions: driver.builtInFunctions(),
+	}
+	return driver.runContract(common.ContractCall, request)
+}
+
+func (driver *VMDriver) runContract(kind common.MessageKind, request interface{}) (*vmcommon.VMOutput, error) {
+	driver.mutExecution.Lock()
+	defer driver.mutExecution.Unlock()
+
+	response := &common.ContractResponse{}
+	err := driver.request(kind, request, response)
+	if err != nil {
+		return nil, err
+	}
+
+	common.RestoreVMOutput(response.Output)
+	return response.Output, common.ErrorFromMessage(response.Error)
+}
+
+// GasScheduleChange sets the gas schedule of the VM host, and of the VM processes started afterwards
+func (driver *VMDriver) GasScheduleChange(newGasSchedule config.GasScheduleMap) {
+	driver.mutExecution.Lock()
+	defer driver.mutExecution.Unlock()
+
+	driver.hostArguments.GasSchedule = newGasSchedule
+	if driver.process == nil {
+		return
+	}
+
+	response := &common.EmptyResponse{}
+	err := driver.request(common.GasScheduleChange, &common.GasScheduleChangeRequest{GasSchedule: newGasSchedule}, response)
+	if err == nil {
+		err = common.ErrorFromMessage(response.Error)
+	}
+	if err != nil {
+		log.Error("cannot change the gas schedule of the VM process", "err", err)
+	}
+}
+
+// EpochConfirmed notifies the VM host of the epoch, and the VM processes started afterwards
+func (driver *VMDriver) EpochConfirmed(epoch uint32, timestamp uint64) {
+	driver.mutExecution.Lock()
+	defer driver.mutExecution.Unlock()
+
+	driver.epoch = epoch
+	driver.epochTimestamp = timestamp
+	if driver.process == nil {
+		return
+	}
+
+	request := &common.EpochConfirmedRequest{
+		Epoch:     epoch,
+		Timestamp: timestamp,
+	}
+	err := driver.request(common.EpochConfirmed, request, &common.EmptyResponse{})
+	if err != nil {
+		log.Error("cannot notify the VM process of the epoch", "epoch", epoch, "err", err)
+	}
+}
+
+// GetVersion returns the version of the VM host, as reported by the VM process
+func (driver *VMDriver) GetVersion() string {
+	driver.mutExecution.Lock()
+	defer driver.mutExecution.Unlock()
+
+	return driver.version
+}
+
+// Close stops the VM process, the driver cannot be used afterwards
+func (driver *VMDriver) Close() error {
+	driver.mutExecution.Lock()
+	defer driver.mutExecution.Unlock()
+
+	driver.closed = true
+	if driver.process != nil {
+		driver.process.stop(DefaultStopTimeout)
+		driver.process = nil
+	}
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (driver *VMDriver) IsInterfaceNil() bool {
+	return driver == nil
+}
+
+// request sends a request to the VM process, starting a new one if there is none.
+// On failure the VM process is killed, since it is in an unknown state.
+func (driver *VMDriver) request(kind common.MessageKind, request interface{}, response interface{}) error {
+	if driver.closed {
+		return common.ErrVMClosed
+	}
+	if driver.process != nil && driver.process.hasExited() {
+		log.Warn("the VM process exited, restarting it")
+		driver.stopProcess()
+	}
+	if driver.process == nil {
+		err := driver.startProcess()
+		if err != nil {
+			return err
+		}
+	}
+
+	err := driver.process.request(kind, request, response, driver.arguments.CallTimeout, driver.callBlockchainHook)
+	if err != nil {
+		log.Error("the VM process failed, it will be restarted", "request", kind, "err", err)
+		driver.stopProcess()
+	}
+	return err
+}
+
+func (driver *VMDriver) startProcess() error {
+	process, err := startVMProcess(driver.arguments.VMPath, driver.arguments.VMArguments, driver.arguments.Output)
+	if err != nil {
+		return err
+	}
+
+	request := &common.InitializeRequest{
+		HostArguments:    driver.hostArguments,
+		BuiltInFunctions: driver.builtInFunctions(),
+		Epoch:            driver.epoch,
+		EpochTimestamp:   driver.epochTimestamp,
+	}
+	response := &common.InitializeResponse{}
+	err = process.request(common.Initialize, request, response, driver.arguments.CallTimeout, driver.callBlockchainHook)
+	if err == nil {
+		err = common.ErrorFromMessage(response.Error)
+	}
+	if err != nil {
+		process.kill()
+		return err
+	}
+
+	log.Debug("VM process started", "pid", process.command.Process.Pid, "version", response.Version)
+	driver.process = process
+	driver.version = response.Version
+	return nil
+}
+
+func (driver *VMDriver) stopProcess() {
+	driver.process.kill()
+	driver.process = nil
+}
+
+func (driver *VMDriver) callBlockchainHook(request *common.HookCallRequest) *common.HookCallResponse {
+	return callBlockchainHook(driver.blockchainHook, request)
+}
+
+// builtInFunctions yields the built-in functions of the node, and whether they are active
+func (driver *VMDriver) builtInFunctions() map[string]bool {
+	container := driver.arguments.VMHostParameters.BuiltInFuncContainer
+	functions := make(map[string]bool)
+	for name := range container.Keys() {
+		function, err := container.Get(name)
+		functions[name] = err == nil && function.IsActive()
+	}
+	return functions
+}
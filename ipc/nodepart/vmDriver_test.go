@@ -0,0 +1,227 @@
+package nodepart
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/config"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/ipc/common"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/ipc/vmpart"
+	worldmock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/world"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/require"
+)
+
+const vmProcessEnvironmentVariable = "ARWEN_IPC_TEST_VM_PROCESS"
+const counterWasmPath = "../../test/contracts/counter/output/counter.wasm"
+
+const crashFunction = "crashTheVM"
+const hangFunction = "hangTheVM"
+
+var ownerAddress = []byte("owner___________________________")
+
+// TestMain runs the test binary as the VM process, when started by a VM driver of the tests
+func TestMain(m *testing.M) {
+	if os.Getenv(vmProcessEnvironmentVariable) == "1" {
+		os.Exit(runTestVMProcess())
+	}
+
+	_ = os.Setenv(vmProcessEnvironmentVariable, "1")
+	os.Exit(m.Run())
+}
+
+func runTestVMProcess() int {
+	nodeToVM := os.NewFile(common.NodeToVMFileDescriptor, "nodeToVM")
+	vmToNode := os.NewFile(common.VMToNodeFileDescriptor, "vmToNode")
+	part, err := vmpart.NewVMPart(nodeToVM, vmToNode, newFaultyHost)
+	if err == nil {
+		err = part.Start()
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	return 0
+}
+
+// faultyHost crashes, or hangs, on demand
+type faultyHost struct {
+	vmcommon.VMExecutionHandler
+}
+
+func newFaultyHost(blockchainHook vmcommon.BlockchainHook, parameters *arwen.VMHostParameters) (vmcommon.VMExecutionHandler, error) {
+	vmHost, err := vmpart.NewArwenHost(blockchainHook, parameters)
+	if err != nil {
+		return nil, err
+	}
+	return &faultyHost{VMExecutionHandler: vmHost}, nil
+}
+
+func (host *faultyHost) RunSmartContractCall(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+	switch input.Function {
+	case crashFunction:
+		panic("crashing on demand")
+	case hangFunction:
+		time.Sleep(time.Minute)
+	}
+	return host.VMExecutionHandler.RunSmartContractCall(input)
+}
+
+func newTestDriver(t *testing.T, callTimeout time.Duration) (*VMDriver, *worldmock.MockWorld) {
+	gasSchedule := config.MakeGasMapForTests()
+	world := worldmock.NewMockWorld()
+	require.Nil(t, world.InitBuiltinFunctions(gasSchedule))
+	world.AcctMap.PutAccount(&worldmock.Account{
+		Address: ownerAddress,
+		Nonce:   1,
+		Balance: big.NewInt(1000000),
+	})
+
+	driver, err := NewVMDriver(world, VMDriverArgs{
+		VMHostParameters: &arwen.VMHostParameters{
+			VMType:                   []byte{5, 0},
+			BlockGasLimit:            10000000,
+			GasSchedule:              gasSchedule,
+			BuiltInFuncContainer:     world.BuiltinFuncs.Container,
+			ElrondProtectedKeyPrefix: []byte("ELROND"),
+			EpochNotifier:            &worldmock.EpochNotifierStub{},
+			WasmBackend:              arwen.GoBackend,
+		},
+		VMPath:      os.Args[0],
+		VMArguments: []string{"-test.run=^$"},
+		CallTimeout: callTimeout,
+		Output:      &bytes.Buffer{},
+	})
+	require.Nil(t, err)
+	return driver, world
+}
+
+func deployCounter(t *testing.T, driver *VMDriver, world *worldmock.MockWorld) []byte {
+	code, err := ioutil.ReadFile(counterWasmPath)
+	require.Nil(t, err)
+
+	output, err := driver.RunSmartContractCreate(&vmcommon.ContractCreateInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  ownerAddress,
+			CallValue:   big.NewInt(0),
+			GasPrice:    1,
+			GasProvided: 1000000,
+		},
+		ContractCode:         code,
+		ContractCodeMetadata: []byte{1, 0},
+	})
+	require.Nil(t, err)
+	require.Equal(t, vmcommon.Ok, output.ReturnCode, output.ReturnMessage)
+	require.Nil(t, world.UpdateAccounts(output.OutputAccounts, output.DeletedAccounts))
+
+	// the address was generated by the blockchain hook of the node, called by the VM process
+	return world.LastCreatedContractAddress
+}
+
+func callCounter(driver *VMDriver, world *worldmock.MockWorld, contractAddress []byte, function string) (*vmcommon.VMOutput, error) {
+	output, err := driver.RunSmartContractCall(&vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  ownerAddress,
+			CallValue:   big.NewInt(0),
+			GasPrice:    1,
+			GasProvided: 1000000,
+		},
+		RecipientAddr: contractAddress,
+		Function:      function,
+	})
+	if err == nil && output.ReturnCode == vmcommon.Ok {
+		err = world.UpdateAccounts(output.OutputAccounts, output.DeletedAccounts)
+	}
+	return output, err
+}
+
+func requireCounter(t *testing.T, driver *VMDriver, world *worldmock.MockWorld, contractAddress []byte, expected byte) {
+	output, err := callCounter(driver, world, contractAddress, "increment")
+	require.Nil(t, err)
+	require.Equal(t, vmcommon.Ok, output.ReturnCode, output.ReturnMessage)
+	require.Equal(t, [][]byte{{expected}}, output.ReturnData)
+}
+
+func TestVMDriver_RunsContracts(t *testing.T) {
+	driver, world := newTestDriver(t, 0)
+	defer func() { _ = driver.Close() }()
+
+	require.Equal(t, arwen.ArwenVersion, driver.GetVersion())
+
+	contractAddress := deployCounter(t, driver, world)
+	requireCounter(t, driver, world, contractAddress, 2)
+	requireCounter(t, driver, world, contractAddress, 3)
+
+	output, err := callCounter(driver, world, contractAddress, "missingFunction")
+	require.Nil(t, err)
+	require.Equal(t, vmcommon.FunctionNotFound, output.ReturnCode)
+}
+
+func TestVMDriver_RestartsAfterCrash(t *testing.T) {
+	driver, world := newTestDriver(t, 0)
+	defer func() { _ = driver.Close() }()
+
+	contractAddress := deployCounter(t, driver, world)
+	requireCounter(t, driver, world, contractAddress, 2)
+
+	_, err := callCounter(driver, world, contractAddress, crashFunction)
+	require.True(t, errors.Is(err, common.ErrVMCrashed), err)
+
+	requireCounter(t, driver, world, contractAddress, 3)
+}
+
+func TestVMDriver_KillsAfterDeadline(t *testing.T) {
+	driver, world := newTestDriver(t, 3*time.Second)
+	defer func() { _ = driver.Close() }()
+
+	contractAddress := deployCounter(t, driver, world)
+
+	start := time.Now()
+	_, err := callCounter(driver, world, contractAddress, hangFunction)
+	require.True(t, errors.Is(err, common.ErrVMCallTimeout), err)
+	require.Less(t, int64(time.Since(start)), int64(time.Minute))
+
+	requireCounter(t, driver, world, contractAddress, 2)
+}
+
+func TestVMDriver_Close(t *testing.T) {
+	driver, world := newTestDriver(t, 0)
+	process := driver.process
+
+	require.Nil(t, driver.Close())
+	require.True(t, process.hasExited())
+
+	_, err := callCounter(driver, world, []byte("contract________________________"), "increment")
+	require.Equal(t, common.ErrVMClosed, err)
+}
+
+func TestNewVMDriver_InvalidArguments(t *testing.T) {
+	world := worldmock.NewMockWorld()
+
+	_, err := NewVMDriver(nil, VMDriverArgs{})
+	require.Equal(t, common.ErrNilBlockchainHook, err)
+
+	_, err = NewVMDriver(world, VMDriverArgs{})
+	require.Equal(t, common.ErrNilVMHostParameters, err)
+
+	_, err = NewVMDriver(world, VMDriverArgs{
+		VMHostParameters: &arwen.VMHostParameters{},
+	})
+	require.Equal(t, arwen.ErrNilBuiltInFunctionsContainer, err)
+
+	require.Nil(t, world.InitBuiltinFunctions(config.MakeGasMapForTests()))
+	_, err = NewVMDriver(world, VMDriverArgs{
+		VMHostParameters: &arwen.VMHostParameters{
+			BuiltInFuncContainer: world.BuiltinFuncs.Container,
+			EpochNotifier:        &worldmock.EpochNotifierStub{},
+		},
+	})
+	require.Equal(t, common.ErrEmptyVMPath, err)
+}
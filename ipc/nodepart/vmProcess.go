@@ -0,0 +1,168 @@
+package nodepart
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"time"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/ipc/common"
+)
+
+// hookHandler answers the blockchain hook calls of the VM process
+type hookHandler func(request *common.HookCallRequest) *common.HookCallResponse
+
+// vmProcess is a running VM process, and the node ends of its pipes
+type vmProcess struct {
+	command   *exec.Cmd
+	messenger *common.Messenger
+	nodeToVM  *os.File
+	vmToNode  *os.File
+	exited    chan struct{}
+}
+
+func startVMProcess(path string, arguments []string, output io.Writer) (*vmProcess, error) {
+	vmReader, nodeToVM, err := os.Pipe()
+	if err != nil {
+		return nil, err
+	}
+	vmToNode, vmWriter, err := os.Pipe()
+	if err != nil {
+		closeFiles(vmReader, nodeToVM)
+		return nil, err
+	}
+
+	command := exec.Command(path, arguments...)
+	// the extra files follow the standard streams, as common.NodeToVMFileDescriptor and common.VMToNodeFileDescriptor
+	command.ExtraFiles = []*os.File{vmReader, vmWriter}
+	command.Stdout = output
+	command.Stderr = output
+	err = command.Start()
+	// the VM ends belong to the VM process from now on
+	closeFiles(vmReader, vmWriter)
+	if err != nil {
+		closeFiles(nodeToVM, vmToNode)
+		return nil, err
+	}
+
+	process := &vmProcess{
+		command:   command,
+		messenger: common.NewMessenger(vmToNode, nodeToVM),
+		nodeToVM:  nodeToVM,
+		vmToNode:  vmToNode,
+		exited:    make(chan struct{}),
+	}
+	go func() {
+		_ = command.Wait()
+		close(process.exited)
+	}()
+	return process, nil
+}
+
+func (process *vmProcess) hasExited() bool {
+	select {
+	case <-process.exited:
+		return true
+	default:
+		return false
+	}
+}
+
+// request sends a request and answers the hook calls, until the response arrives or the deadline passes.
+// The VM process is killed on timeout, since it is in an unknown state.
+func (process *vmProcess) request(kind common.MessageKind, request interface{}, response interface{}, timeout time.Duration, handleHookCall hookHandler) error {
+	done := make(chan error, 1)
+	go func() {
+		done <- process.converse(kind, request, response, handleHookCall)
+	}()
+
+	var deadline <-chan time.Time
+	if timeout > 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		deadline = timer.C
+	}
+
+	select {
+	case err := <-done:
+		return err
+	case <-deadline:
+		process.kill()
+		<-done
+		return fmt.Errorf("%w: %s after %s", common.ErrVMCallTimeout, kind, timeout)
+	}
+}
+
+func (process *vmProcess) converse(kind common.MessageKind, request interface{}, response interface{}, handleHookCall hookHandler) error {
+	err := process.messenger.Send(kind, request)
+	if err != nil {
+		return fmt.Errorf("%w: cannot send %s: %v", common.ErrVMCrashed, kind, err)
+	}
+
+	for {
+		message, err := process.messenger.Receive()
+		if err != nil {
+			return fmt.Errorf("%w: no response to %s: %v", common.ErrVMCrashed, kind, err)
+		}
+
+		switch message.Kind {
+		case common.Response:
+			err = message.Decode(response)
+			if err != nil {
+				return fmt.Errorf("%w: invalid response to %s: %v", common.ErrVMCrashed, kind, err)
+			}
+			return nil
+		case common.HookCall:
+			hookCall := &common.HookCallRequest{}
+			err = message.Decode(hookCall)
+			if err != nil {
+				return fmt.Errorf("%w: invalid hook call: %v", common.ErrVMCrashed, err)
+			}
+			err = process.messenger.Send(common.HookCallAnswer, handleHookCall(hookCall))
+			if err != nil {
+				return fmt.Errorf("%w: cannot answer the hook call %s: %v", common.ErrVMCrashed, hookCall.Function, err)
+			}
+		default:
+			return fmt.Errorf("%w: %v %s, while waiting for the response to %s", common.ErrVMCrashed, common.ErrUnexpectedMessage, message.Kind, kind)
+		}
+	}
+}
+
+// stop asks the VM process to exit, and kills it if it does not in time
+func (process *vmProcess) stop(timeout time.Duration) {
+	if !process.hasExited() {
+		err := process.request(common.Stop, nil, &common.EmptyResponse{}, timeout, rejectHookCall)
+		if err != nil {
+			log.Debug("the VM process did not stop", "err", err)
+		}
+	}
+
+	closeFiles(process.nodeToVM)
+	select {
+	case <-process.exited:
+		closeFiles(process.vmToNode)
+	case <-time.After(timeout):
+		log.Debug("the VM process did not exit, killing it")
+		process.kill()
+	}
+}
+
+// kill stops the VM process immediately
+func (process *vmProcess) kill() {
+	if !process.hasExited() {
+		_ = process.command.Process.Kill()
+	}
+	<-process.exited
+	closeFiles(process.nodeToVM, process.vmToNode)
+}
+
+func rejectHookCall(request *common.HookCallRequest) *common.HookCallResponse {
+	return common.NewHookCallResponse(fmt.Errorf("%w: %s while stopping", common.ErrInvalidHookCall, request.Function))
+}
+
+func closeFiles(files ...*os.File) {
+	for _, file := range files {
+		_ = file.Close()
+	}
+}
@@ -0,0 +1,251 @@
+package vmpart
+
+import (
+	"fmt"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/ipc/common"
+	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+var _ vmcommon.BlockchainHook = (*blockchainHookGateway)(nil)
+
+// blockchainHookGateway implements the blockchain hook by calling the node, through the messenger of the VM process.
+// The calls happen while the node waits for the response of its request, so they never interleave with other messages.
+// The VM host cannot continue without the node, so a broken pipe panics, and the VM process exits.
+type blockchainHookGateway struct {
+	messenger *common.Messenger
+}
+
+func newBlockchainHookGateway(messenger *common.Messenger) *blockchainHookGateway {
+	return &blockchainHookGateway{
+		messenger: messenger,
+	}
+}
+
+func (gateway *blockchainHookGateway) call(function string, arguments ...interface{}) (common.HookValues, error) {
+	err := gateway.messenger.Send(common.HookCall, common.NewHookCallRequest(function, arguments...))
+	if err != nil {
+		panic(fmt.Sprintf("cannot call the blockchain hook function %s: %s", function, err))
+	}
+
+	message, err := gateway.messenger.Receive()
+	if err != nil {
+		panic(fmt.Sprintf("no response from the blockchain hook function %s: %s", function, err))
+	}
+	if message.Kind != common.HookCallAnswer {
+		panic(fmt.Sprintf("%s: %s, while waiting for the blockchain hook function %s", common.ErrUnexpectedMessage, message.Kind, function))
+	}
+
+	response := &common.HookCallResponse{}
+	err = message.Decode(response)
+	if err != nil {
+		panic(fmt.Sprintf("invalid response from the blockchain hook function %s: %s", function, err))
+	}
+	return response.Results, response.Err()
+}
+
+// NewAddress calls the node
+func (gateway *blockchainHookGateway) NewAddress(creatorAddress []byte, creatorNonce uint64, vmType []byte) ([]byte, error) {
+	results, err := gateway.call(common.NewAddressFunction, creatorAddress, creatorNonce, vmType)
+	if err != nil {
+		return nil, err
+	}
+	return results.Bytes(0), nil
+}
+
+// GetStorageData calls the node
+func (gateway *blockchainHookGateway) GetStorageData(accountAddress []byte, index []byte) ([]byte, error) {
+	results, err := gateway.call(common.GetStorageDataFunction, accountAddress, index)
+	if err != nil {
+		return nil, err
+	}
+	return results.Bytes(0), nil
+}
+
+// GetBlockhash calls the node
+func (gateway *blockchainHookGateway) GetBlockhash(nonce uint64) ([]byte, error) {
+	results, err := gateway.call(common.GetBlockhashFunction, nonce)
+	if err != nil {
+		return nil, err
+	}
+	return results.Bytes(0), nil
+}
+
+// LastNonce calls the node
+func (gateway *blockchainHookGateway) LastNonce() uint64 {
+	results, _ := gateway.call(common.LastNonceFunction)
+	return results.Uint64(0)
+}
+
+// LastRound calls the node
+func (gateway *blockchainHookGateway) LastRound() uint64 {
+	results, _ := gateway.call(common.LastRoundFunction)
+	return results.Uint64(0)
+}
+
+// LastTimeStamp calls the node
+func (gateway *blockchainHookGateway) LastTimeStamp() uint64 {
+	results, _ := gateway.call(common.LastTimeStampFunction)
+	return results.Uint64(0)
+}
+
+// LastRandomSeed calls the node
+func (gateway *blockchainHookGateway) LastRandomSeed() []byte {
+	results, _ := gateway.call(common.LastRandomSeedFunction)
+	return results.Bytes(0)
+}
+
+// LastEpoch calls the node
+func (gateway *blockchainHookGateway) LastEpoch() uint32 {
+	results, _ := gateway.call(common.LastEpochFunction)
+	return results.Uint32(0)
+}
+
+// GetStateRootHash calls the node
+func (gateway *blockchainHookGateway) GetStateRootHash() []byte {
+	results, _ := gateway.call(common.GetStateRootHashFunction)
+	return results.Bytes(0)
+}
+
+// CurrentNonce calls the node
+func (gateway *blockchainHookGateway) CurrentNonce() uint64 {
+	results, _ := gateway.call(common.CurrentNonceFunction)
+	return results.Uint64(0)
+}
+
+// CurrentRound calls the node
+func (gateway *blockchainHookGateway) CurrentRound() uint64 {
+	results, _ := gateway.call(common.CurrentRoundFunction)
+	return results.Uint64(0)
+}
+
+// CurrentTimeStamp calls the node
+func (gateway *blockchainHookGateway) CurrentTimeStamp() uint64 {
+	results, _ := gateway.call(common.CurrentTimeStampFunction)
+	return results.Uint64(0)
+}
+
+// CurrentRandomSeed calls the node
+func (gateway *blockchainHookGateway) CurrentRandomSeed() []byte {
+	results, _ := gateway.call(common.CurrentRandomSeedFunction)
+	return results.Bytes(0)
+}
+
+// CurrentEpoch calls the node
+func (gateway *blockchainHookGateway) CurrentEpoch() uint32 {
+	results, _ := gateway.call(common.CurrentEpochFunction)
+	return results.Uint32(0)
+}
+
+// ProcessBuiltInFunction calls the node
+func (gateway *blockchainHookGateway) ProcessBuiltInFunction(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+	results, err := gateway.call(common.ProcessBuiltInFunctionFunction, input)
+	output := results.VMOutput(0)
+	common.RestoreVMOutput(output)
+	return output, err
+}
+
+// GetBuiltinFunctionNames calls the node
+func (gateway *blockchainHookGateway) GetBuiltinFunctionNames() vmcommon.FunctionNames {
+	results, _ := gateway.call(common.GetBuiltinFunctionNamesFunction)
+	functionNames := make(vmcommon.FunctionNames)
+	for _, name := range results.Strings(0) {
+		functionNames[name] = struct{}{}
+	}
+	return functionNames
+}
+
+// GetAllState calls the node
+func (gateway *blockchainHookGateway) GetAllState(address []byte) (map[string][]byte, error) {
+	results, err := gateway.call(common.GetAllStateFunction, address)
+	if err != nil {
+		return nil, err
+	}
+	return results.BytesMap(0), nil
+}
+
+// GetUserAccount calls the node, which sends a copy of the account
+func (gateway *blockchainHookGateway) GetUserAccount(address []byte) (vmcommon.UserAccountHandler, error) {
+	results, err := gateway.call(common.GetUserAccountFunction, address)
+	if err != nil {
+		return nil, err
+	}
+	account := results.Account(0)
+	if account == nil {
+		return nil, nil
+	}
+	return account, nil
+}
+
+// GetCode calls the node, with the address of the account
+func (gateway *blockchainHookGateway) GetCode(account vmcommon.UserAccountHandler) []byte {
+	if account == nil || account.IsInterfaceNil() {
+		return nil
+	}
+	results, _ := gateway.call(common.GetCodeFunction, account.AddressBytes())
+	return results.Bytes(0)
+}
+
+// GetShardOfAddress calls the node
+func (gateway *blockchainHookGateway) GetShardOfAddress(address []byte) uint32 {
+	results, _ := gateway.call(common.GetShardOfAddressFunction, address)
+	return results.Uint32(0)
+}
+
+// IsSmartContract calls the node
+func (gateway *blockchainHookGateway) IsSmartContract(address []byte) bool {
+	results, _ := gateway.call(common.IsSmartContractFunction, address)
+	return results.Bool(0)
+}
+
+// IsPayable calls the node
+func (gateway *blockchainHookGateway) IsPayable(sndAddress []byte, recvAddress []byte) (bool, error) {
+	results, err := gateway.call(common.IsPayableFunction, sndAddress, recvAddress)
+	if err != nil {
+		return false, err
+	}
+	return results.Bool(0), nil
+}
+
+// SaveCompiledCode calls the node
+func (gateway *blockchainHookGateway) SaveCompiledCode(codeHash []byte, code []byte) {
+	_, _ = gateway.call(common.SaveCompiledCodeFunction, codeHash, code)
+}
+
+// GetCompiledCode calls the node
+func (gateway *blockchainHookGateway) GetCompiledCode(codeHash []byte) (bool, []byte) {
+	results, _ := gateway.call(common.GetCompiledCodeFunction, codeHash)
+	return results.Bool(0), results.Bytes(1)
+}
+
+// ClearCompiledCodes calls the node
+func (gateway *blockchainHookGateway) ClearCompiledCodes() {
+	_, _ = gateway.call(common.ClearCompiledCodesFunction)
+}
+
+// GetESDTToken calls the node
+func (gateway *blockchainHookGateway) GetESDTToken(address []byte, tokenID []byte, nonce uint64) (*esdt.ESDigitalToken, error) {
+	results, err := gateway.call(common.GetESDTTokenFunction, address, tokenID, nonce)
+	if err != nil {
+		return nil, err
+	}
+	return results.ESDTToken(0), nil
+}
+
+// GetSnapshot calls the node
+func (gateway *blockchainHookGateway) GetSnapshot() int {
+	results, _ := gateway.call(common.GetSnapshotFunction)
+	return results.Int(0)
+}
+
+// RevertToSnapshot calls the node
+func (gateway *blockchainHookGateway) RevertToSnapshot(snapshot int) error {
+	_, err := gateway.call(common.RevertToSnapshotFunction, snapshot)
+	return err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gateway *blockchainHookGateway) IsInterfaceNil() bool {
+	return gateway == nil
+}
@@ -0,0 +1,127 @@
+package vmpart
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/ipc/common"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+var _ vmcommon.BuiltInFunctionContainer = (*builtInFunctionsProxy)(nil)
+var _ vmcommon.BuiltinFunction = (*builtInFunctionProxy)(nil)
+
+// builtInFunctionProxy tells whether a built-in function of the node is active.
+// The VM host runs the built-in functions through the blockchain hook, so they are never processed in the VM process.
+type builtInFunctionProxy struct {
+	active bool
+}
+
+// ProcessBuiltinFunction returns ErrBuiltInFunctionOnNode
+func (function *builtInFunctionProxy) ProcessBuiltinFunction(_, _ vmcommon.UserAccountHandler, _ *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+	return nil, common.ErrBuiltInFunctionOnNode
+}
+
+// SetNewGasConfig does nothing, the node holds the gas configuration of the built-in functions
+func (function *builtInFunctionProxy) SetNewGasConfig(_ *vmcommon.GasCost) {
+}
+
+// IsActive returns whether the function is active on the node
+func (function *builtInFunctionProxy) IsActive() bool {
+	return function.active
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (function *builtInFunctionProxy) IsInterfaceNil() bool {
+	return function == nil
+}
+
+// builtInFunctionsProxy mirrors the built-in functions container of the node, refreshed by each request
+type builtInFunctionsProxy struct {
+	mutFunctions sync.RWMutex
+	functions    map[string]vmcommon.BuiltinFunction
+}
+
+func newBuiltInFunctionsProxy() *builtInFunctionsProxy {
+	return &builtInFunctionsProxy{
+		functions: make(map[string]vmcommon.BuiltinFunction),
+	}
+}
+
+// update sets the built-in functions of the node, and whether they are active
+func (container *builtInFunctionsProxy) update(functions map[string]bool) {
+	container.mutFunctions.Lock()
+	defer container.mutFunctions.Unlock()
+
+	container.functions = make(map[string]vmcommon.BuiltinFunction, len(functions))
+	for name, active := range functions {
+		container.functions[name] = &builtInFunctionProxy{active: active}
+	}
+}
+
+// Get returns the function with the given name
+func (container *builtInFunctionsProxy) Get(key string) (vmcommon.BuiltinFunction, error) {
+	container.mutFunctions.RLock()
+	defer container.mutFunctions.RUnlock()
+
+	function, found := container.functions[key]
+	if !found {
+		return nil, fmt.Errorf("%w: %s", common.ErrUnknownBuiltInFunction, key)
+	}
+	return function, nil
+}
+
+// Add adds a function, if there is none with the same name
+func (container *builtInFunctionsProxy) Add(key string, function vmcommon.BuiltinFunction) error {
+	container.mutFunctions.Lock()
+	defer container.mutFunctions.Unlock()
+
+	_, found := container.functions[key]
+	if found {
+		return fmt.Errorf("built-in function %s already exists", key)
+	}
+	container.functions[key] = function
+	return nil
+}
+
+// Replace sets the function with the given name
+func (container *builtInFunctionsProxy) Replace(key string, function vmcommon.BuiltinFunction) error {
+	container.mutFunctions.Lock()
+	defer container.mutFunctions.Unlock()
+
+	container.functions[key] = function
+	return nil
+}
+
+// Remove removes the function with the given name
+func (container *builtInFunctionsProxy) Remove(key string) {
+	container.mutFunctions.Lock()
+	defer container.mutFunctions.Unlock()
+
+	delete(container.functions, key)
+}
+
+// Len returns the number of functions
+func (container *builtInFunctionsProxy) Len() int {
+	container.mutFunctions.RLock()
+	defer container.mutFunctions.RUnlock()
+
+	return len(container.functions)
+}
+
+// Keys returns the names of the functions
+func (container *builtInFunctionsProxy) Keys() map[string]struct{} {
+	container.mutFunctions.RLock()
+	defer container.mutFunctions.RUnlock()
+
+	keys := make(map[string]struct{}, len(container.functions))
+	for name := range container.functions {
+		keys[name] = struct{}{}
+	}
+	return keys
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (container *builtInFunctionsProxy) IsInterfaceNil() bool {
+	return container == nil
+}
@@ -0,0 +1,50 @@
+package vmpart
+
+import (
+	"sync"
+
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+var _ vmcommon.EpochNotifier = (*epochNotifier)(nil)
+
+// epochNotifier forwards the epoch notifications of the node to the handlers of the VM process
+type epochNotifier struct {
+	mutHandlers sync.Mutex
+	handlers    []vmcommon.EpochSubscriberHandler
+	epoch       uint32
+	timestamp   uint64
+}
+
+// RegisterNotifyHandler registers the handler, and notifies it of the current epoch
+func (notifier *epochNotifier) RegisterNotifyHandler(handler vmcommon.EpochSubscriberHandler) {
+	if handler == nil || handler.IsInterfaceNil() {
+		return
+	}
+
+	notifier.mutHandlers.Lock()
+	notifier.handlers = append(notifier.handlers, handler)
+	epoch, timestamp := notifier.epoch, notifier.timestamp
+	notifier.mutHandlers.Unlock()
+
+	handler.EpochConfirmed(epoch, timestamp)
+}
+
+// confirmEpoch notifies the handlers of a new epoch
+func (notifier *epochNotifier) confirmEpoch(epoch uint32, timestamp uint64) {
+	notifier.mutHandlers.Lock()
+	notifier.epoch = epoch
+	notifier.timestamp = timestamp
+	handlers := make([]vmcommon.EpochSubscriberHandler, len(notifier.handlers))
+	copy(handlers, notifier.handlers)
+	notifier.mutHandlers.Unlock()
+
+	for _, handler := range handlers {
+		handler.EpochConfirmed(epoch, timestamp)
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (notifier *epochNotifier) IsInterfaceNil() bool {
+	return notifier == nil
+}
@@ -0,0 +1,185 @@
+package vmpart
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen/host"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/ipc/common"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/ElrondNetwork/elrond-vm-common/parsers"
+)
+
+var log = logger.GetOrCreate("ipc/vmpart")
+
+// HostFactory creates the VM host of the VM process
+type HostFactory func(blockchainHook vmcommon.BlockchainHook, parameters *arwen.VMHostParameters) (vmcommon.VMExecutionHandler, error)
+
+// NewArwenHost is the HostFactory of Arwen
+func NewArwenHost(blockchainHook vmcommon.BlockchainHook, parameters *arwen.VMHostParameters) (vmcommon.VMExecutionHandler, error) {
+	return host.NewArwenVM(blockchainHook, parameters)
+}
+
+// VMPart runs the VM host in the VM process, serving the requests of the node, one at a time
+type VMPart struct {
+	messenger        *common.Messenger
+	createHost       HostFactory
+	host             vmcommon.VMExecutionHandler
+	blockchainHook   *blockchainHookGateway
+	builtInFunctions *builtInFunctionsProxy
+	epochNotifier    *epochNotifier
+}
+
+// NewVMPart creates the VM part, which receives the requests from the input and answers to the output
+func NewVMPart(input io.Reader, output io.Writer, createHost HostFactory) (*VMPart, error) {
+	if createHost == nil {
+		return nil, common.ErrNilHostFactory
+	}
+
+	messenger := common.NewMessenger(input, output)
+	return &VMPart{
+		messenger:        messenger,
+		createHost:       createHost,
+		blockchainHook:   newBlockchainHookGateway(messenger),
+		builtInFunctions: newBuiltInFunctionsProxy(),
+		epochNotifier:    &epochNotifier{},
+	}, nil
+}
+
+// Start serves the requests, until the node sends Stop or closes the input
+func (part *VMPart) Start() error {
+	defer part.closeHost()
+
+	for {
+		message, err := part.messenger.Receive()
+		if err == io.EOF {
+			log.Debug("the node closed the pipe")
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		log.Trace("request received", "kind", message.Kind)
+		if message.Kind == common.Stop {
+			part.closeHost()
+			return part.messenger.Send(common.Response, &common.EmptyResponse{})
+		}
+
+		response, err := part.handleRequest(message)
+		if err != nil {
+			return err
+		}
+
+		err = part.messenger.Send(common.Response, response)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// handleRequest yields the response to the request. The errors of the VM host go in the response,
+// the errors returned are those of the protocol, after which the VM process cannot continue.
+func (part *VMPart) handleRequest(message *common.Message) (interface{}, error) {
+	switch message.Kind {
+	case common.Initialize:
+		request := &common.InitializeRequest{}
+		err := message.Decode(request)
+		if err != nil {
+			return nil, err
+		}
+		return part.initialize(request), nil
+	case common.ContractDeploy:
+		request := &common.ContractDeployRequest{}
+		err := message.Decode(request)
+		if err != nil {
+			return nil, err
+		}
+		return part.runContract(request.BuiltInFunctions, func() (*vmcommon.VMOutput, error) {
+			return part.host.RunSmartContractCreate(request.Input)
+		}), nil
+	case common.ContractCall:
+		request := &common.ContractCallRequest{}
+		err := message.Decode(request)
+		if err != nil {
+			return nil, err
+		}
+		return part.runContract(request.BuiltInFunctions, func() (*vmcommon.VMOutput, error) {
+			return part.host.RunSmartContractCall(request.Input)
+		}), nil
+	case common.GasScheduleChange:
+		request := &common.GasScheduleChangeRequest{}
+		err := message.Decode(request)
+		if err != nil {
+			return nil, err
+		}
+		if part.host == nil {
+			return &common.EmptyResponse{Error: common.ErrVMNotInitialized.Error()}, nil
+		}
+		part.host.GasScheduleChange(request.GasSchedule)
+		return &common.EmptyResponse{}, nil
+	case common.EpochConfirmed:
+		request := &common.EpochConfirmedRequest{}
+		err := message.Decode(request)
+		if err != nil {
+			return nil, err
+		}
+		part.epochNotifier.confirmEpoch(request.Epoch, request.Timestamp)
+		return &common.EmptyResponse{}, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", common.ErrUnexpectedMessage, message.Kind)
+	}
+}
+
+func (part *VMPart) initialize(request *common.InitializeRequest) *common.InitializeResponse {
+	part.closeHost()
+	part.builtInFunctions.update(request.BuiltInFunctions)
+	part.epochNotifier.confirmEpoch(request.Epoch, request.EpochTimestamp)
+
+	esdtTransferParser, err := parsers.NewESDTTransferParser(&marshal.GogoProtoMarshalizer{})
+	if err != nil {
+		return &common.InitializeResponse{Error: err.Error()}
+	}
+
+	parameters := request.HostArguments.ToVMHostParameters()
+	parameters.BuiltInFuncContainer = part.builtInFunctions
+	parameters.ESDTTransferParser = esdtTransferParser
+	parameters.EpochNotifier = part.epochNotifier
+
+	vmHost, err := part.createHost(part.blockchainHook, parameters)
+	if err != nil {
+		return &common.InitializeResponse{Error: err.Error()}
+	}
+
+	part.host = vmHost
+	return &common.InitializeResponse{Version: vmHost.GetVersion()}
+}
+
+func (part *VMPart) runContract(builtInFunctions map[string]bool, run func() (*vmcommon.VMOutput, error)) *common.ContractResponse {
+	if part.host == nil {
+		return &common.ContractResponse{Error: common.ErrVMNotInitialized.Error()}
+	}
+
+	part.builtInFunctions.update(builtInFunctions)
+	output, err := run()
+	response := &common.ContractResponse{Output: output}
+	if err != nil {
+		response.Error = err.Error()
+	}
+	return response
+}
+
+func (part *VMPart) closeHost() {
+	if part.host == nil {
+		return
+	}
+
+	err := part.host.Close()
+	if err != nil {
+		log.Warn("cannot close the VM host", "err", err)
+	}
+	part.host = nil
+}
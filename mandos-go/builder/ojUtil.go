@@ -0,0 +1,80 @@
+package mandosbuilder
+
+import (
+	"sort"
+
+	oj "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/orderedjson"
+)
+
+func stringToOJ(str string) oj.OJsonObject {
+	return &oj.OJsonString{Value: str}
+}
+
+func valueToOJ(value Value) oj.OJsonObject {
+	return &oj.OJsonString{Value: string(value)}
+}
+
+func valueListToOJ(values []Value) oj.OJsonObject {
+	list := make(oj.OJsonList, len(values))
+	for i, value := range values {
+		list[i] = valueToOJ(value)
+	}
+	return &list
+}
+
+func putIfNotEmpty(mp *oj.OJsonMap, key string, value Value) {
+	if len(value) > 0 {
+		mp.Put(key, valueToOJ(value))
+	}
+}
+
+func boolToOJ(value bool) oj.OJsonObject {
+	ojBool := oj.OJsonBool(value)
+	return &ojBool
+}
+
+// putOrReplace is like Put, but replaces the value if the key already exists, keeping its position.
+func putOrReplace(jsonMap *oj.OJsonMap, key string, value oj.OJsonObject) {
+	for _, kvp := range jsonMap.OrderedKV {
+		if kvp.Key == key {
+			kvp.Value = value
+			return
+		}
+	}
+	jsonMap.Put(key, value)
+}
+
+// getOrCreateMap yields the map under the key, adding an empty one if there is none, or if the key holds another value.
+func getOrCreateMap(jsonMap *oj.OJsonMap, key string) *oj.OJsonMap {
+	for _, kvp := range jsonMap.OrderedKV {
+		if kvp.Key == key {
+			if existing, isMap := kvp.Value.(*oj.OJsonMap); isMap {
+				return existing
+			}
+		}
+	}
+	newMap := oj.NewMap()
+	putOrReplace(jsonMap, key, newMap)
+	return newMap
+}
+
+func sortedKeys(values map[string]Value) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func appendToList(jsonMap *oj.OJsonMap, key string, item oj.OJsonObject) {
+	for _, kvp := range jsonMap.OrderedKV {
+		if kvp.Key == key {
+			if existing, isList := kvp.Value.(*oj.OJsonList); isList {
+				*existing = append(*existing, item)
+				return
+			}
+		}
+	}
+	putOrReplace(jsonMap, key, &oj.OJsonList{item})
+}
@@ -0,0 +1,109 @@
+package mandosbuilder
+
+import (
+	fr "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/fileresolver"
+	mjparse "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/json/parse"
+	mj "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/model"
+	oj "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/orderedjson"
+)
+
+// ScenarioBuilder builds Mandos scenarios in code.
+// It produces the same JSON tree as a scenario file, then parses it like one,
+// so the values are interpreted and validated only in Build.
+type ScenarioBuilder struct {
+	scenarioOJ *oj.OJsonMap
+	stepsOJ    *oj.OJsonList
+}
+
+// NewScenario starts building a scenario.
+func NewScenario(name string) *ScenarioBuilder {
+	builder := &ScenarioBuilder{
+		scenarioOJ: oj.NewMap(),
+		stepsOJ:    &oj.OJsonList{},
+	}
+	if len(name) > 0 {
+		builder.scenarioOJ.Put("name", stringToOJ(name))
+	}
+	return builder
+}
+
+// Comment sets the scenario comment.
+func (builder *ScenarioBuilder) Comment(comment string) *ScenarioBuilder {
+	putOrReplace(builder.scenarioOJ, "comment", stringToOJ(comment))
+	return builder
+}
+
+// CheckGas sets whether the gas used by the transactions is checked. It is checked by default.
+func (builder *ScenarioBuilder) CheckGas(checkGas bool) *ScenarioBuilder {
+	putOrReplace(builder.scenarioOJ, "checkGas", boolToOJ(checkGas))
+	return builder
+}
+
+// TraceGas sets whether the gas used by the transactions is traced.
+func (builder *ScenarioBuilder) TraceGas(traceGas bool) *ScenarioBuilder {
+	putOrReplace(builder.scenarioOJ, "traceGas", boolToOJ(traceGas))
+	return builder
+}
+
+// GasSchedule sets the gas schedule, either a named one ("v3", "v4", "dummy") or a "file:..." reference.
+func (builder *ScenarioBuilder) GasSchedule(gasSchedule Value) *ScenarioBuilder {
+	putOrReplace(builder.scenarioOJ, "gasSchedule", valueToOJ(gasSchedule))
+	return builder
+}
+
+// Variable declares a scenario variable, which values can reference with Var.
+func (builder *ScenarioBuilder) Variable(name string, value Value) *ScenarioBuilder {
+	getOrCreateMap(builder.scenarioOJ, "variables").Put(name, valueToOJ(value))
+	return builder
+}
+
+// ExternalSteps adds a step that runs the steps of another scenario file, with optional arguments.
+func (builder *ScenarioBuilder) ExternalSteps(path string, arguments map[string]Value) *ScenarioBuilder {
+	stepOJ := builder.addStep(mj.StepNameExternalSteps)
+	stepOJ.Put("path", stringToOJ(path))
+	if len(arguments) > 0 {
+		argumentsOJ := oj.NewMap()
+		for _, name := range sortedKeys(arguments) {
+			argumentsOJ.Put(name, valueToOJ(arguments[name]))
+		}
+		stepOJ.Put("arguments", argumentsOJ)
+	}
+	return builder
+}
+
+// DumpState adds a step that prints the entire state to console.
+func (builder *ScenarioBuilder) DumpState() *ScenarioBuilder {
+	builder.addStep(mj.StepNameDumpState)
+	return builder
+}
+
+// Build interprets all values and yields the scenario.
+// Files are resolved relative to the working directory.
+func (builder *ScenarioBuilder) Build() (*mj.Scenario, error) {
+	return builder.BuildWithFileResolver(fr.NewDefaultFileResolver())
+}
+
+// BuildWithFileResolver interprets all values and yields the scenario, using the file resolver for "file:..." values.
+func (builder *ScenarioBuilder) BuildWithFileResolver(fileResolver fr.FileResolver) (*mj.Scenario, error) {
+	parser := mjparse.NewParser(fileResolver)
+	return parser.ParseScenarioOrderedJSON(builder.toOrderedJSON())
+}
+
+// toOrderedJSON yields the scenario tree, with the steps last, as the writer orders them.
+func (builder *ScenarioBuilder) toOrderedJSON() oj.OJsonObject {
+	scenarioOJ := oj.NewMap()
+	for _, kvp := range builder.scenarioOJ.OrderedKV {
+		scenarioOJ.Put(kvp.Key, kvp.Value)
+	}
+	steps := make(oj.OJsonList, len(*builder.stepsOJ))
+	copy(steps, *builder.stepsOJ)
+	scenarioOJ.Put("steps", &steps)
+	return scenarioOJ
+}
+
+func (builder *ScenarioBuilder) addStep(stepType string) *oj.OJsonMap {
+	stepOJ := oj.NewMap()
+	stepOJ.Put("step", stringToOJ(stepType))
+	*builder.stepsOJ = append(*builder.stepsOJ, stepOJ)
+	return stepOJ
+}
@@ -0,0 +1,155 @@
+package mandosbuilder
+
+import (
+	"math/big"
+	"testing"
+
+	mjparse "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/json/parse"
+	mjwrite "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/json/write"
+	mj "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/model"
+	"github.com/stretchr/testify/require"
+)
+
+func exampleScenario() *ScenarioBuilder {
+	scenario := NewScenario("adder")
+	scenario.Comment("built in code").
+		Variable("initial", "1,000")
+	scenario.SetState().
+		Account("address:owner").Nonce("1").Balance(Var("initial")).
+		Account(SC("adder")).Code(Bytes([]byte("code"))).Owner("address:owner").Storage(Str("sum"), "5").
+		ESDT(Str("TOKEN-123456"), "20").
+		CurrentBlockInfo().Nonce("10").Timestamp("12345")
+	scenario.ScCall("add").
+		From("address:owner").
+		To(SC("adder")).
+		Function("add").
+		Arguments(Num(3), U32(7)).
+		GasLimit("5,000,000").
+		GasPrice("0").
+		Expect().Out().Status("0").Message("").AnyLogs().Gas(Any).Refund(Any)
+	scenario.ScQuery("get").
+		To(SC("adder")).
+		Function("getSum").
+		Expect().Out("8")
+	scenario.Transfer("pay").
+		From("address:owner").
+		To(SC("adder")).
+		ESDTValue(Str("TOKEN-123456"), "0", "5").
+		ESDTValue(Str("NFT-123456"), "1", "1")
+	scenario.CheckState().
+		Account("address:owner").Nonce("2").Balance(BigNum(big.NewInt(1000))).
+		Account(SC("adder")).Storage(Str("sum"), "8").MoreStorageAllowed().
+		MoreAccountsAllowed()
+	return scenario
+}
+
+func TestScenarioBuilder(t *testing.T) {
+	scenario, err := exampleScenario().Build()
+	require.Nil(t, err)
+
+	require.Equal(t, "adder", scenario.Name)
+	require.Equal(t, "built in code", scenario.Comment)
+	require.Equal(t, 1, len(scenario.Variables))
+	require.Equal(t, 5, len(scenario.Steps))
+
+	setState, isSetState := scenario.Steps[0].(*mj.SetStateStep)
+	require.True(t, isSetState)
+	require.Equal(t, 2, len(setState.Accounts))
+	require.Equal(t, big.NewInt(1000), setState.Accounts[0].Balance.Value)
+	require.Equal(t, "${initial}", setState.Accounts[0].Balance.Original)
+	require.Equal(t, []byte("code"), setState.Accounts[1].Code.Value)
+	require.Equal(t, uint64(10), setState.CurrentBlockInfo.BlockNonce.Value)
+
+	scCall, isTx := scenario.Steps[1].(*mj.TxStep)
+	require.True(t, isTx)
+	require.Equal(t, "add", scCall.TxIdent)
+	require.Equal(t, mj.ScCall, scCall.Tx.Type)
+	require.Equal(t, "add", scCall.Tx.Function)
+	require.Equal(t, 2, len(scCall.Tx.Arguments))
+	require.Equal(t, []byte{0, 0, 0, 7}, scCall.Tx.Arguments[1].Value)
+	require.Equal(t, uint64(5000000), scCall.Tx.GasLimit.Value)
+	require.Equal(t, 0, len(scCall.ExpectedResult.Out.Values))
+	require.True(t, scCall.ExpectedResult.Gas.IsStar)
+
+	transfer := scenario.Steps[3].(*mj.TxStep)
+	require.Equal(t, 2, len(transfer.Tx.ESDTValue))
+	require.Equal(t, uint64(1), transfer.Tx.ESDTValue[1].Nonce.Value)
+
+	checkState, isCheckState := scenario.Steps[4].(*mj.CheckStateStep)
+	require.True(t, isCheckState)
+	require.True(t, checkState.CheckAccounts.MoreAccountsAllowed)
+	require.True(t, checkState.CheckAccounts.Accounts[1].MoreStorageAllowed)
+}
+
+func TestScenarioBuilderWriteAndParse(t *testing.T) {
+	scenario, err := exampleScenario().Build()
+	require.Nil(t, err)
+
+	serialized := mjwrite.ScenarioToJSONString(scenario)
+
+	parser := mjparse.NewParser(nil)
+	reparsed, err := parser.ParseScenarioFile([]byte(serialized))
+	require.Nil(t, err)
+	require.Equal(t, serialized, mjwrite.ScenarioToJSONString(reparsed))
+}
+
+func TestScenarioBuilderBuildTwice(t *testing.T) {
+	builder := exampleScenario()
+	first, err := builder.Build()
+	require.Nil(t, err)
+
+	builder.DumpState()
+	second, err := builder.Build()
+	require.Nil(t, err)
+	require.Equal(t, len(first.Steps)+1, len(second.Steps))
+}
+
+func TestScenarioBuilderLogEvents(t *testing.T) {
+	scenario := NewScenario("")
+	scenario.ScCall("1").From("address:a").To("sc:b").Function("swap").
+		Expect().LogEvents(
+		LogEvent{
+			Endpoint:   Str("transfer"),
+			Topics:     []Value{Str("TOKEN-123456"), Any, "1000", More},
+			TopicTypes: []string{"TokenIdentifier", "Address", "BigUint"},
+			Count:      "2",
+		},
+		LogEvent{Endpoint: Str("swap")},
+	)
+	built, err := scenario.Build()
+	require.Nil(t, err)
+
+	logs := built.Steps[0].(*mj.TxStep).ExpectedResult.Logs
+	require.True(t, logs.MatchEvents)
+	require.Len(t, logs.Events, 2)
+	require.True(t, logs.Events[0].Topics.MoreAllowedAtEnd)
+	require.Equal(t, []mj.ABIType{"TokenIdentifier", "Address", "BigUint"}, logs.Events[0].TopicTypes)
+	require.Equal(t, uint64(2), logs.Events[0].Count.Value)
+	require.True(t, logs.Events[1].Address.IsStar)
+	require.True(t, logs.Events[1].Count.IsUnspecified())
+}
+
+func TestScenarioBuilderErrors(t *testing.T) {
+	scenario := NewScenario("")
+	scenario.Transfer("1").Function("notAllowed")
+	_, err := scenario.Build()
+	require.NotNil(t, err)
+
+	scenario = NewScenario("")
+	scenario.SetState().Account("address:a").Balance(Var("missing"))
+	_, err = scenario.Build()
+	require.NotNil(t, err)
+}
+
+func TestValues(t *testing.T) {
+	require.Equal(t, Value("str:abc"), Str("abc"))
+	require.Equal(t, Value("address:a"), Address("a"))
+	require.Equal(t, Value("sc:a"), SC("a"))
+	require.Equal(t, Value("u64:5"), U64(5))
+	require.Equal(t, Value("u8:5"), U8(5))
+	require.Equal(t, Value("-5"), BigNum(big.NewInt(-5)))
+	require.Equal(t, Value("0x0102"), Bytes([]byte{1, 2}))
+	require.Equal(t, Value(""), Bytes(nil))
+	require.Equal(t, Value("${x}"), Var("x"))
+	require.Equal(t, Value("u8:1|str:a"), Concat(U8(1), Str("a")))
+}
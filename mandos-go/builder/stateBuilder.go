@@ -0,0 +1,307 @@
+package mandosbuilder
+
+import (
+	mj "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/model"
+	oj "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/orderedjson"
+)
+
+// SetStateBuilder builds a setState step.
+type SetStateBuilder struct {
+	stepOJ *oj.OJsonMap
+}
+
+// SetState adds a step that sets accounts and block info.
+func (builder *ScenarioBuilder) SetState() *SetStateBuilder {
+	return &SetStateBuilder{
+		stepOJ: builder.addStep(mj.StepNameSetState),
+	}
+}
+
+// Comment sets the step comment.
+func (builder *SetStateBuilder) Comment(comment string) *SetStateBuilder {
+	putOrReplace(builder.stepOJ, "comment", stringToOJ(comment))
+	return builder
+}
+
+// Account adds an account to the state, or continues an account already added to this step.
+func (builder *SetStateBuilder) Account(address Value) *AccountBuilder {
+	return &AccountBuilder{
+		setState:  builder,
+		accountOJ: getOrCreateMap(getOrCreateMap(builder.stepOJ, "accounts"), string(address)),
+	}
+}
+
+// NewAddress sets the address of the contract that the creator deploys at the given nonce.
+func (builder *SetStateBuilder) NewAddress(creator Value, creatorNonce Value, newAddress Value) *SetStateBuilder {
+	newAddressOJ := oj.NewMap()
+	newAddressOJ.Put("creatorAddress", valueToOJ(creator))
+	newAddressOJ.Put("creatorNonce", valueToOJ(creatorNonce))
+	newAddressOJ.Put("newAddress", valueToOJ(newAddress))
+	appendToList(builder.stepOJ, "newAddresses", newAddressOJ)
+	return builder
+}
+
+// PreviousBlockInfo sets the info of the previous block.
+func (builder *SetStateBuilder) PreviousBlockInfo() *BlockInfoBuilder {
+	return &BlockInfoBuilder{
+		setState:    builder,
+		blockInfoOJ: getOrCreateMap(builder.stepOJ, "previousBlockInfo"),
+	}
+}
+
+// CurrentBlockInfo sets the info of the current block.
+func (builder *SetStateBuilder) CurrentBlockInfo() *BlockInfoBuilder {
+	return &BlockInfoBuilder{
+		setState:    builder,
+		blockInfoOJ: getOrCreateMap(builder.stepOJ, "currentBlockInfo"),
+	}
+}
+
+// AccountBuilder builds an account of a setState step.
+type AccountBuilder struct {
+	setState  *SetStateBuilder
+	accountOJ *oj.OJsonMap
+}
+
+// Account continues with another account of the same step.
+func (builder *AccountBuilder) Account(address Value) *AccountBuilder {
+	return builder.setState.Account(address)
+}
+
+// PreviousBlockInfo continues with the previous block info of the same step.
+func (builder *AccountBuilder) PreviousBlockInfo() *BlockInfoBuilder {
+	return builder.setState.PreviousBlockInfo()
+}
+
+// CurrentBlockInfo continues with the current block info of the same step.
+func (builder *AccountBuilder) CurrentBlockInfo() *BlockInfoBuilder {
+	return builder.setState.CurrentBlockInfo()
+}
+
+// Comment sets the account comment.
+func (builder *AccountBuilder) Comment(comment string) *AccountBuilder {
+	putOrReplace(builder.accountOJ, "comment", stringToOJ(comment))
+	return builder
+}
+
+// Nonce sets the account nonce.
+func (builder *AccountBuilder) Nonce(nonce Value) *AccountBuilder {
+	putOrReplace(builder.accountOJ, "nonce", valueToOJ(nonce))
+	return builder
+}
+
+// Balance sets the EGLD balance.
+func (builder *AccountBuilder) Balance(balance Value) *AccountBuilder {
+	putOrReplace(builder.accountOJ, "balance", valueToOJ(balance))
+	return builder
+}
+
+// Username sets the account username.
+func (builder *AccountBuilder) Username(username Value) *AccountBuilder {
+	putOrReplace(builder.accountOJ, "username", valueToOJ(username))
+	return builder
+}
+
+// Code sets the contract code, normally File("...wasm").
+func (builder *AccountBuilder) Code(code Value) *AccountBuilder {
+	putOrReplace(builder.accountOJ, "code", valueToOJ(code))
+	return builder
+}
+
+// Owner sets the owner of the contract.
+func (builder *AccountBuilder) Owner(owner Value) *AccountBuilder {
+	putOrReplace(builder.accountOJ, "owner", valueToOJ(owner))
+	return builder
+}
+
+// Storage sets a storage entry.
+func (builder *AccountBuilder) Storage(key Value, value Value) *AccountBuilder {
+	putOrReplace(getOrCreateMap(builder.accountOJ, "storage"), string(key), valueToOJ(value))
+	return builder
+}
+
+// ESDT sets the balance of a fungible token.
+func (builder *AccountBuilder) ESDT(tokenIdentifier Value, balance Value) *AccountBuilder {
+	putOrReplace(getOrCreateMap(builder.accountOJ, "esdt"), string(tokenIdentifier), valueToOJ(balance))
+	return builder
+}
+
+// ESDTInstance sets the balance of an instance of a semi-fungible or non-fungible token.
+func (builder *AccountBuilder) ESDTInstance(tokenIdentifier Value, nonce Value, balance Value) *AccountBuilder {
+	instanceOJ := oj.NewMap()
+	instanceOJ.Put("nonce", valueToOJ(nonce))
+	instanceOJ.Put("balance", valueToOJ(balance))
+	appendToList(getOrCreateMap(getOrCreateMap(builder.accountOJ, "esdt"), string(tokenIdentifier)), "instances", instanceOJ)
+	return builder
+}
+
+// ESDTRoles sets the local roles of the account for a token.
+func (builder *AccountBuilder) ESDTRoles(tokenIdentifier Value, roles ...string) *AccountBuilder {
+	rolesOJ := make(oj.OJsonList, len(roles))
+	for i, role := range roles {
+		rolesOJ[i] = stringToOJ(role)
+	}
+	putOrReplace(getOrCreateMap(getOrCreateMap(builder.accountOJ, "esdt"), string(tokenIdentifier)), "roles", &rolesOJ)
+	return builder
+}
+
+// BlockInfoBuilder builds the previous or current block info of a setState step.
+type BlockInfoBuilder struct {
+	setState    *SetStateBuilder
+	blockInfoOJ *oj.OJsonMap
+}
+
+// Account continues with an account of the same step.
+func (builder *BlockInfoBuilder) Account(address Value) *AccountBuilder {
+	return builder.setState.Account(address)
+}
+
+// Timestamp sets the block timestamp.
+func (builder *BlockInfoBuilder) Timestamp(timestamp Value) *BlockInfoBuilder {
+	putOrReplace(builder.blockInfoOJ, "blockTimestamp", valueToOJ(timestamp))
+	return builder
+}
+
+// Nonce sets the block nonce.
+func (builder *BlockInfoBuilder) Nonce(nonce Value) *BlockInfoBuilder {
+	putOrReplace(builder.blockInfoOJ, "blockNonce", valueToOJ(nonce))
+	return builder
+}
+
+// Round sets the block round.
+func (builder *BlockInfoBuilder) Round(round Value) *BlockInfoBuilder {
+	putOrReplace(builder.blockInfoOJ, "blockRound", valueToOJ(round))
+	return builder
+}
+
+// Epoch sets the block epoch.
+func (builder *BlockInfoBuilder) Epoch(epoch Value) *BlockInfoBuilder {
+	putOrReplace(builder.blockInfoOJ, "blockEpoch", valueToOJ(epoch))
+	return builder
+}
+
+// RandomSeed sets the block random seed.
+func (builder *BlockInfoBuilder) RandomSeed(randomSeed Value) *BlockInfoBuilder {
+	putOrReplace(builder.blockInfoOJ, "blockRandomSeed", valueToOJ(randomSeed))
+	return builder
+}
+
+// CheckStateBuilder builds a checkState step.
+type CheckStateBuilder struct {
+	stepOJ     *oj.OJsonMap
+	accountsOJ *oj.OJsonMap
+}
+
+// CheckState adds a step that checks accounts.
+// Only the listed accounts are allowed to exist, unless MoreAccountsAllowed is called.
+func (builder *ScenarioBuilder) CheckState() *CheckStateBuilder {
+	stepOJ := builder.addStep(mj.StepNameCheckState)
+	return &CheckStateBuilder{
+		stepOJ:     stepOJ,
+		accountsOJ: getOrCreateMap(stepOJ, "accounts"),
+	}
+}
+
+// Comment sets the step comment.
+func (builder *CheckStateBuilder) Comment(comment string) *CheckStateBuilder {
+	putOrReplace(builder.stepOJ, "comment", stringToOJ(comment))
+	return builder
+}
+
+// MoreAccountsAllowed allows accounts other than the ones checked.
+func (builder *CheckStateBuilder) MoreAccountsAllowed() *CheckStateBuilder {
+	putOrReplace(builder.accountsOJ, "+", stringToOJ(""))
+	return builder
+}
+
+// Account adds an account check, or continues an account check already added to this step.
+// Only the specified fields of the account are checked.
+func (builder *CheckStateBuilder) Account(address Value) *CheckAccountBuilder {
+	return &CheckAccountBuilder{
+		checkState: builder,
+		accountOJ:  getOrCreateMap(builder.accountsOJ, string(address)),
+	}
+}
+
+// CheckAccountBuilder builds the checks of an account.
+type CheckAccountBuilder struct {
+	checkState *CheckStateBuilder
+	accountOJ  *oj.OJsonMap
+}
+
+// Account continues with another account of the same step.
+func (builder *CheckAccountBuilder) Account(address Value) *CheckAccountBuilder {
+	return builder.checkState.Account(address)
+}
+
+// MoreAccountsAllowed allows accounts other than the ones checked.
+func (builder *CheckAccountBuilder) MoreAccountsAllowed() *CheckStateBuilder {
+	return builder.checkState.MoreAccountsAllowed()
+}
+
+// Comment sets the account comment.
+func (builder *CheckAccountBuilder) Comment(comment string) *CheckAccountBuilder {
+	putOrReplace(builder.accountOJ, "comment", stringToOJ(comment))
+	return builder
+}
+
+// Nonce checks the account nonce.
+func (builder *CheckAccountBuilder) Nonce(nonce Value) *CheckAccountBuilder {
+	putOrReplace(builder.accountOJ, "nonce", valueToOJ(nonce))
+	return builder
+}
+
+// Balance checks the EGLD balance.
+func (builder *CheckAccountBuilder) Balance(balance Value) *CheckAccountBuilder {
+	putOrReplace(builder.accountOJ, "balance", valueToOJ(balance))
+	return builder
+}
+
+// Username checks the account username.
+func (builder *CheckAccountBuilder) Username(username Value) *CheckAccountBuilder {
+	putOrReplace(builder.accountOJ, "username", valueToOJ(username))
+	return builder
+}
+
+// Code checks the contract code.
+func (builder *CheckAccountBuilder) Code(code Value) *CheckAccountBuilder {
+	putOrReplace(builder.accountOJ, "code", valueToOJ(code))
+	return builder
+}
+
+// Owner checks the owner of the contract.
+func (builder *CheckAccountBuilder) Owner(owner Value) *CheckAccountBuilder {
+	putOrReplace(builder.accountOJ, "owner", valueToOJ(owner))
+	return builder
+}
+
+// Storage checks a storage entry. Once a storage entry is checked, no other entries are allowed,
+// unless MoreStorageAllowed is called.
+func (builder *CheckAccountBuilder) Storage(key Value, value Value) *CheckAccountBuilder {
+	putOrReplace(getOrCreateMap(builder.accountOJ, "storage"), string(key), valueToOJ(value))
+	return builder
+}
+
+// NoStorage checks that the account has no storage.
+func (builder *CheckAccountBuilder) NoStorage() *CheckAccountBuilder {
+	putOrReplace(builder.accountOJ, "storage", oj.NewMap())
+	return builder
+}
+
+// MoreStorageAllowed allows storage entries other than the ones checked.
+func (builder *CheckAccountBuilder) MoreStorageAllowed() *CheckAccountBuilder {
+	putOrReplace(getOrCreateMap(builder.accountOJ, "storage"), "+", stringToOJ(""))
+	return builder
+}
+
+// ESDT checks the balance of a fungible token.
+func (builder *CheckAccountBuilder) ESDT(tokenIdentifier Value, balance Value) *CheckAccountBuilder {
+	putOrReplace(getOrCreateMap(builder.accountOJ, "esdt"), string(tokenIdentifier), valueToOJ(balance))
+	return builder
+}
+
+// AnyESDT allows any tokens, which is also the default when no token is checked.
+func (builder *CheckAccountBuilder) AnyESDT() *CheckAccountBuilder {
+	putOrReplace(builder.accountOJ, "esdt", valueToOJ(Any))
+	return builder
+}
@@ -0,0 +1,235 @@
+package mandosbuilder
+
+import (
+	mj "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/model"
+	oj "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/orderedjson"
+)
+
+// TxBuilder builds a transaction step.
+// Fields that are not allowed for the type of transaction are reported by Build.
+type TxBuilder struct {
+	stepOJ *oj.OJsonMap
+	txOJ   *oj.OJsonMap
+}
+
+// ScCall adds a step that calls a smart contract function.
+func (builder *ScenarioBuilder) ScCall(txID string) *TxBuilder {
+	return builder.addTxStep(mj.StepNameScCall, txID, true)
+}
+
+// ScDeploy adds a step that deploys a smart contract.
+func (builder *ScenarioBuilder) ScDeploy(txID string) *TxBuilder {
+	return builder.addTxStep(mj.StepNameScDeploy, txID, true)
+}
+
+// ScQuery adds a step that queries a smart contract, without changing the state.
+func (builder *ScenarioBuilder) ScQuery(txID string) *TxBuilder {
+	return builder.addTxStep(mj.StepNameScQuery, txID, true)
+}
+
+// Transfer adds a step that transfers EGLD or ESDT tokens, without calling any function.
+func (builder *ScenarioBuilder) Transfer(txID string) *TxBuilder {
+	return builder.addTxStep(mj.StepNameTransfer, txID, false)
+}
+
+// ValidatorReward adds a step that sends a validator reward to an account.
+func (builder *ScenarioBuilder) ValidatorReward(txID string) *TxBuilder {
+	return builder.addTxStep(mj.StepNameValidatorReward, txID, false)
+}
+
+func (builder *ScenarioBuilder) addTxStep(stepType string, txID string, hasArguments bool) *TxBuilder {
+	stepOJ := builder.addStep(stepType)
+	if len(txID) > 0 {
+		stepOJ.Put("txId", stringToOJ(txID))
+	}
+	txOJ := oj.NewMap()
+	if hasArguments {
+		txOJ.Put("arguments", &oj.OJsonList{})
+	}
+	stepOJ.Put("tx", txOJ)
+	return &TxBuilder{
+		stepOJ: stepOJ,
+		txOJ:   txOJ,
+	}
+}
+
+// Comment sets the step comment.
+func (builder *TxBuilder) Comment(comment string) *TxBuilder {
+	putOrReplace(builder.stepOJ, "comment", stringToOJ(comment))
+	return builder
+}
+
+// DisplayLogs prints the logs produced by the transaction.
+func (builder *TxBuilder) DisplayLogs() *TxBuilder {
+	putOrReplace(builder.stepOJ, "displayLogs", boolToOJ(true))
+	return builder
+}
+
+// From sets the sender.
+func (builder *TxBuilder) From(from Value) *TxBuilder {
+	putOrReplace(builder.txOJ, "from", valueToOJ(from))
+	return builder
+}
+
+// To sets the receiver.
+func (builder *TxBuilder) To(to Value) *TxBuilder {
+	putOrReplace(builder.txOJ, "to", valueToOJ(to))
+	return builder
+}
+
+// EGLDValue sets the EGLD value transferred.
+func (builder *TxBuilder) EGLDValue(value Value) *TxBuilder {
+	putOrReplace(builder.txOJ, "egldValue", valueToOJ(value))
+	return builder
+}
+
+// ESDTValue adds a token to the transfer, several calls make a multi-transfer. The nonce is "0" for fungible tokens.
+func (builder *TxBuilder) ESDTValue(tokenIdentifier Value, nonce Value, value Value) *TxBuilder {
+	esdtOJ := oj.NewMap()
+	esdtOJ.Put("tokenIdentifier", valueToOJ(tokenIdentifier))
+	esdtOJ.Put("nonce", valueToOJ(nonce))
+	esdtOJ.Put("value", valueToOJ(value))
+	appendToList(builder.txOJ, "esdtValue", esdtOJ)
+	return builder
+}
+
+// Function sets the function called.
+func (builder *TxBuilder) Function(function string) *TxBuilder {
+	putOrReplace(builder.txOJ, "function", stringToOJ(function))
+	return builder
+}
+
+// Arguments sets the function, or constructor, arguments.
+func (builder *TxBuilder) Arguments(arguments ...Value) *TxBuilder {
+	putOrReplace(builder.txOJ, "arguments", valueListToOJ(arguments))
+	return builder
+}
+
+// Code sets the code deployed, normally File("...wasm").
+func (builder *TxBuilder) Code(code Value) *TxBuilder {
+	putOrReplace(builder.txOJ, "contractCode", valueToOJ(code))
+	return builder
+}
+
+// GasLimit sets the gas limit.
+func (builder *TxBuilder) GasLimit(gasLimit Value) *TxBuilder {
+	putOrReplace(builder.txOJ, "gasLimit", valueToOJ(gasLimit))
+	return builder
+}
+
+// GasPrice sets the gas price.
+func (builder *TxBuilder) GasPrice(gasPrice Value) *TxBuilder {
+	putOrReplace(builder.txOJ, "gasPrice", valueToOJ(gasPrice))
+	return builder
+}
+
+// Expect sets the expected result of the transaction.
+// Only the specified fields of the result are checked.
+func (builder *TxBuilder) Expect() *ExpectBuilder {
+	return &ExpectBuilder{
+		expectOJ: getOrCreateMap(builder.stepOJ, "expect"),
+	}
+}
+
+// ExpectBuilder builds the expected result of a transaction.
+type ExpectBuilder struct {
+	expectOJ *oj.OJsonMap
+}
+
+// LogEntry is an expected log entry. Use Any for fields that are not checked.
+type LogEntry struct {
+	Address  Value
+	Endpoint Value
+	Topics   []Value
+	Data     Value
+}
+
+// LogEvent matches logs regardless of their position. Fields left empty match any value.
+type LogEvent struct {
+	Address    Value
+	Endpoint   Value
+	Topics     []Value
+	TopicTypes []string
+	Data       Value
+
+	// Count is the exact number of matching logs, if empty at least one log should match.
+	Count Value
+}
+
+// Out checks the values returned.
+func (builder *ExpectBuilder) Out(out ...Value) *ExpectBuilder {
+	putOrReplace(builder.expectOJ, "out", valueListToOJ(out))
+	return builder
+}
+
+// Status checks the return code, "0" for success.
+func (builder *ExpectBuilder) Status(status Value) *ExpectBuilder {
+	putOrReplace(builder.expectOJ, "status", valueToOJ(status))
+	return builder
+}
+
+// Message checks the error message.
+func (builder *ExpectBuilder) Message(message Value) *ExpectBuilder {
+	putOrReplace(builder.expectOJ, "message", valueToOJ(message))
+	return builder
+}
+
+// Gas checks the gas remaining.
+func (builder *ExpectBuilder) Gas(gas Value) *ExpectBuilder {
+	putOrReplace(builder.expectOJ, "gas", valueToOJ(gas))
+	return builder
+}
+
+// Refund checks the gas refund.
+func (builder *ExpectBuilder) Refund(refund Value) *ExpectBuilder {
+	putOrReplace(builder.expectOJ, "refund", valueToOJ(refund))
+	return builder
+}
+
+// AnyLogs accepts any logs.
+func (builder *ExpectBuilder) AnyLogs() *ExpectBuilder {
+	putOrReplace(builder.expectOJ, "logs", valueToOJ(Any))
+	return builder
+}
+
+// Logs checks the logs, in order. No other logs are allowed.
+func (builder *ExpectBuilder) Logs(logs ...LogEntry) *ExpectBuilder {
+	logsOJ := make(oj.OJsonList, len(logs))
+	for i, log := range logs {
+		logOJ := oj.NewMap()
+		logOJ.Put("address", valueToOJ(log.Address))
+		logOJ.Put("endpoint", valueToOJ(log.Endpoint))
+		logOJ.Put("topics", valueListToOJ(log.Topics))
+		logOJ.Put("data", valueToOJ(log.Data))
+		logsOJ[i] = logOJ
+	}
+	putOrReplace(builder.expectOJ, "logs", &logsOJ)
+	return builder
+}
+
+// LogEvents checks that the logs contain the events, in any order. Other logs are allowed.
+func (builder *ExpectBuilder) LogEvents(events ...LogEvent) *ExpectBuilder {
+	eventsOJ := make(oj.OJsonList, len(events))
+	for i, event := range events {
+		eventOJ := oj.NewMap()
+		putIfNotEmpty(eventOJ, "address", event.Address)
+		putIfNotEmpty(eventOJ, "endpoint", event.Endpoint)
+		if len(event.Topics) > 0 {
+			eventOJ.Put("topics", valueListToOJ(event.Topics))
+		}
+		if len(event.TopicTypes) > 0 {
+			typesOJ := make(oj.OJsonList, len(event.TopicTypes))
+			for j, topicType := range event.TopicTypes {
+				typesOJ[j] = stringToOJ(topicType)
+			}
+			eventOJ.Put("topicTypes", &typesOJ)
+		}
+		putIfNotEmpty(eventOJ, "data", event.Data)
+		putIfNotEmpty(eventOJ, "count", event.Count)
+		eventsOJ[i] = eventOJ
+	}
+	logsOJ := oj.NewMap()
+	logsOJ.Put("events", &eventsOJ)
+	putOrReplace(builder.expectOJ, "logs", logsOJ)
+	return builder
+}
@@ -0,0 +1,90 @@
+package mandosbuilder
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Value is an expression in the Mandos value format, e.g. "1,000", "str:abc", "address:alice".
+// String constants convert to Value implicitly, the functions below build values from Go types.
+type Value string
+
+// Any is the "*" value, which accepts anything in checks.
+const Any Value = "*"
+
+// More ends a checked list, e.g. of log topics, that allows more items after the ones given.
+const More Value = "+"
+
+// Str yields a "str:..." string value.
+func Str(str string) Value {
+	return Value("str:" + str)
+}
+
+// Address yields an "address:..." value, 32 bytes padded with underscores.
+func Address(name string) Value {
+	return Value("address:" + name)
+}
+
+// SC yields a "sc:..." smart contract address value.
+func SC(name string) Value {
+	return Value("sc:" + name)
+}
+
+// Num yields an unsigned number, in decimal.
+func Num(number uint64) Value {
+	return Value(fmt.Sprintf("%d", number))
+}
+
+// BigNum yields an arbitrary length number, in decimal. Negative numbers are written in two's complement.
+func BigNum(number *big.Int) Value {
+	return Value(number.String())
+}
+
+// U64 yields a number encoded on 8 bytes.
+func U64(number uint64) Value {
+	return Value(fmt.Sprintf("u64:%d", number))
+}
+
+// U32 yields a number encoded on 4 bytes.
+func U32(number uint32) Value {
+	return Value(fmt.Sprintf("u32:%d", number))
+}
+
+// U16 yields a number encoded on 2 bytes.
+func U16(number uint16) Value {
+	return Value(fmt.Sprintf("u16:%d", number))
+}
+
+// U8 yields a number encoded on 1 byte.
+func U8(number uint8) Value {
+	return Value(fmt.Sprintf("u8:%d", number))
+}
+
+// Bytes yields the raw bytes, in hex.
+func Bytes(bytes []byte) Value {
+	if len(bytes) == 0 {
+		return ""
+	}
+	return Value("0x" + hex.EncodeToString(bytes))
+}
+
+// File yields the contents of a file, e.g. the code of a contract.
+func File(path string) Value {
+	return Value("file:" + path)
+}
+
+// Var yields a reference to a scenario variable.
+func Var(name string) Value {
+	return Value("${" + name + "}")
+}
+
+// Concat concatenates the values.
+func Concat(values ...Value) Value {
+	parts := make([]string, len(values))
+	for i, value := range values {
+		parts[i] = string(value)
+	}
+	return Value(strings.Join(parts, "|"))
+}
@@ -0,0 +1,168 @@
+package mandoscontroller
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DiffKind classifies a difference between an expectation and the actual result
+type DiffKind string
+
+const (
+	// DiffChanged signals a value that is both expected and present, but differs from the expectation
+	DiffChanged DiffKind = "changed"
+
+	// DiffMissing signals an expected account, storage key, token, role or value that is not present
+	DiffMissing DiffKind = "missing"
+
+	// DiffExtra signals an account, storage key, token, role or value that is present but not expected
+	DiffExtra DiffKind = "extra"
+)
+
+const (
+	colorReset  = "\033[0m"
+	colorRed    = "\033[31m"
+	colorGreen  = "\033[32m"
+	colorYellow = "\033[33m"
+	colorBold   = "\033[1m"
+)
+
+// DiffValue shows a value both as a Mandos expression and in hex.
+// For expectations, the Mandos form is the expression as written in the scenario.
+// For actual results, it is reconstructed from the bytes.
+type DiffValue struct {
+	Mandos string `json:"mandos"`
+	Hex    string `json:"hex,omitempty"`
+}
+
+// FieldDiff is a single difference, e.g. a storage key with the wrong value
+type FieldDiff struct {
+	Kind DiffKind `json:"kind"`
+
+	// Field names what is checked, e.g. "balance", "storage", "out", "status".
+	Field string `json:"field"`
+
+	// Key identifies the entry within the field, e.g. the storage key, the token and nonce, or the index of an output.
+	Key string `json:"key,omitempty"`
+
+	Expected *DiffValue `json:"expected,omitempty"`
+	Actual   *DiffValue `json:"actual,omitempty"`
+}
+
+// AccountDiff groups the differences found for an account.
+// Missing and extra accounts have no field differences.
+type AccountDiff struct {
+	Kind    DiffKind     `json:"kind"`
+	Address string       `json:"address"`
+	Fields  []*FieldDiff `json:"fields,omitempty"`
+}
+
+// CheckFailure is the error returned when the checks of a step fail.
+// It holds all the differences found in the step, not only the first.
+type CheckFailure struct {
+	// Step identifies the step, e.g. the tx id, or "checkState".
+	Step string `json:"step"`
+
+	// Accounts holds the differences found by a checkState step.
+	Accounts []*AccountDiff `json:"accounts,omitempty"`
+
+	// TxResult holds the differences found in the result of a transaction.
+	TxResult []*FieldDiff `json:"txResult,omitempty"`
+
+	// Trap describes where the contract of the transaction trapped, if it did and the executor can tell.
+	// It helps explaining the differences, but is not one.
+	Trap string `json:"trap,omitempty"`
+}
+
+// HasDifferences returns true if at least one difference was found.
+func (failure *CheckFailure) HasDifferences() bool {
+	return len(failure.Accounts) > 0 || len(failure.TxResult) > 0
+}
+
+// Error yields the differences as plain text.
+func (failure *CheckFailure) Error() string {
+	return failure.Pretty(false)
+}
+
+// Pretty yields the differences as text, one per line, optionally color-coded for terminals:
+// changes are yellow, missing entries red and extra entries green.
+func (failure *CheckFailure) Pretty(color bool) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("check failed, step %s:", failure.Step))
+	for _, accountDiff := range failure.Accounts {
+		writeAccountDiff(&sb, accountDiff, color)
+	}
+	if len(failure.TxResult) > 0 {
+		sb.WriteString("\n  tx result:")
+		for _, fieldDiff := range failure.TxResult {
+			writeFieldDiff(&sb, fieldDiff, color)
+		}
+	}
+	if failure.Trap != "" {
+		sb.WriteString("\n  trap: ")
+		sb.WriteString(strings.ReplaceAll(failure.Trap, "\n", "\n  "))
+	}
+	return sb.String()
+}
+
+func writeAccountDiff(sb *strings.Builder, accountDiff *AccountDiff, color bool) {
+	switch accountDiff.Kind {
+	case DiffMissing:
+		sb.WriteString("\n  " + colored(diffSymbol(DiffMissing)+" account "+accountDiff.Address+": expected but not found", DiffMissing, color))
+	case DiffExtra:
+		sb.WriteString("\n  " + colored(diffSymbol(DiffExtra)+" account "+accountDiff.Address+": unexpected", DiffExtra, color))
+	default:
+		accountLine := "account " + accountDiff.Address + ":"
+		if color {
+			accountLine = colorBold + accountLine + colorReset
+		}
+		sb.WriteString("\n  " + accountLine)
+	}
+	for _, fieldDiff := range accountDiff.Fields {
+		writeFieldDiff(sb, fieldDiff, color)
+	}
+}
+
+func writeFieldDiff(sb *strings.Builder, fieldDiff *FieldDiff, color bool) {
+	line := diffSymbol(fieldDiff.Kind) + " " + fieldDiff.Field
+	if len(fieldDiff.Key) > 0 {
+		line += " " + fieldDiff.Key
+	}
+	line += ": want " + diffValueString(fieldDiff.Expected) + ", have " + diffValueString(fieldDiff.Actual)
+	sb.WriteString("\n    " + colored(line, fieldDiff.Kind, color))
+}
+
+func diffSymbol(kind DiffKind) string {
+	switch kind {
+	case DiffMissing:
+		return "-"
+	case DiffExtra:
+		return "+"
+	default:
+		return "~"
+	}
+}
+
+func diffValueString(value *DiffValue) string {
+	if value == nil {
+		return "nothing"
+	}
+	if len(value.Hex) == 0 || value.Hex == value.Mandos {
+		return fmt.Sprintf("%q", value.Mandos)
+	}
+	return fmt.Sprintf("%q (%s)", value.Mandos, value.Hex)
+}
+
+func colored(text string, kind DiffKind, color bool) string {
+	if !color {
+		return text
+	}
+	switch kind {
+	case DiffMissing:
+		return colorRed + text + colorReset
+	case DiffExtra:
+		return colorGreen + text + colorReset
+	default:
+		return colorYellow + text + colorReset
+	}
+}
@@ -0,0 +1,126 @@
+package mandoscontroller
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testCheckFailure() *CheckFailure {
+	return &CheckFailure{
+		Step: "checkState",
+		Accounts: []*AccountDiff{
+			{
+				Kind:    DiffChanged,
+				Address: "address:alice",
+				Fields: []*FieldDiff{
+					{
+						Kind:     DiffChanged,
+						Field:    "balance",
+						Expected: &DiffValue{Mandos: "1,000", Hex: "0x03e8"},
+						Actual:   &DiffValue{Mandos: "999", Hex: "0x03e7"},
+					},
+					{
+						Kind:     DiffMissing,
+						Field:    "storage",
+						Key:      "str:a",
+						Expected: &DiffValue{Mandos: "0x01", Hex: "0x01"},
+					},
+					{
+						Kind:   DiffExtra,
+						Field:  "storage",
+						Key:    "str:b",
+						Actual: &DiffValue{Mandos: "str:value", Hex: "0x76616c7565"},
+					},
+				},
+			},
+			{
+				Kind:    DiffMissing,
+				Address: "address:bob",
+			},
+			{
+				Kind:    DiffExtra,
+				Address: "address:carol",
+			},
+		},
+	}
+}
+
+func TestCheckFailure_Error(t *testing.T) {
+	require.Equal(t, `check failed, step checkState:
+  account address:alice:
+    ~ balance: want "1,000" (0x03e8), have "999" (0x03e7)
+    - storage str:a: want "0x01", have nothing
+    + storage str:b: want nothing, have "str:value" (0x76616c7565)
+  - account address:bob: expected but not found
+  + account address:carol: unexpected`, testCheckFailure().Error())
+
+	txFailure := &CheckFailure{
+		Step: "1",
+		TxResult: []*FieldDiff{
+			{
+				Kind:     DiffChanged,
+				Field:    "status",
+				Expected: &DiffValue{Mandos: "0"},
+				Actual:   &DiffValue{Mandos: "4 (user error)"},
+			},
+			{
+				Kind:   DiffExtra,
+				Field:  "out",
+				Key:    "[0]",
+				Actual: &DiffValue{Mandos: "5", Hex: "0x05"},
+			},
+		},
+	}
+	require.Equal(t, `check failed, step 1:
+  tx result:
+    ~ status: want "0", have "4 (user error)"
+    + out [0]: want nothing, have "5" (0x05)`, txFailure.Error())
+}
+
+func TestCheckFailure_PrettyColor(t *testing.T) {
+	pretty := testCheckFailure().Pretty(true)
+	require.Contains(t, pretty, colorBold+"account address:alice:"+colorReset)
+	require.Contains(t, pretty, colorYellow+`~ balance: want "1,000" (0x03e8), have "999" (0x03e7)`+colorReset)
+	require.Contains(t, pretty, colorRed+"- account address:bob: expected but not found"+colorReset)
+	require.Contains(t, pretty, colorGreen+"+ account address:carol: unexpected"+colorReset)
+}
+
+func TestCheckFailure_JSON(t *testing.T) {
+	encoded, err := json.Marshal(testCheckFailure())
+	require.Nil(t, err)
+
+	var decoded CheckFailure
+	require.Nil(t, json.Unmarshal(encoded, &decoded))
+	require.Equal(t, testCheckFailure(), &decoded)
+	require.Contains(t, string(encoded), `{"kind":"missing","field":"storage","key":"str:a","expected":{"mandos":"0x01","hex":"0x01"}}`)
+}
+
+func TestParallelScenarioRunner_CheckFailureDiff(t *testing.T) {
+	dir := writeTestScenarios(t, map[string]string{
+		"diff.scen.json": scenarioJSON("diff", "v3"),
+	})
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	factory := func() (ScenarioExecutor, error) {
+		return &executorStub{onClose: func() {}}, nil
+	}
+	output := &bytes.Buffer{}
+	runner := NewParallelScenarioRunner(factory, ParallelRunOptions{
+		Output: output,
+	})
+	report, err := runner.RunAllJSONScenariosInDirectory(dir, "", ".scen.json")
+	require.NotNil(t, err)
+	require.Equal(t, testCheckFailure(), report.Results[0].Diff)
+	require.Contains(t, output.String(), "FAIL: "+testCheckFailure().Error())
+
+	jsonOutput := &bytes.Buffer{}
+	require.Nil(t, report.WriteJSON(jsonOutput))
+	require.Contains(t, jsonOutput.String(), `"diff": {`)
+	require.Contains(t, jsonOutput.String(), `"address": "address:bob"`)
+}
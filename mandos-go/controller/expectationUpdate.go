@@ -0,0 +1,171 @@
+package mandoscontroller
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	fr "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/fileresolver"
+	mj "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/model"
+)
+
+// ErrExpectationUpdateNotSupported signals that the executor cannot rewrite the expectations of scenarios
+var ErrExpectationUpdateNotSupported = errors.New("executor cannot update scenario expectations")
+
+// ExpectationChange describes an expectation of a scenario that does not match the actual result,
+// together with the expression that would replace it.
+type ExpectationChange struct {
+	// ScenarioPath is the file holding the expectation, which can also be an external steps file.
+	ScenarioPath string
+
+	// StepID identifies the step, e.g. the tx id, or "checkState".
+	StepID string
+
+	// Field identifies the expectation within the step, e.g. "out", or "sc:adder storage str:sum".
+	Field string
+
+	OldValue string
+	NewValue string
+}
+
+// String yields a short description of the change, for printing to console.
+func (change *ExpectationChange) String() string {
+	return fmt.Sprintf("%s, step %s, %s:\n  - %s\n  + %s",
+		change.ScenarioPath, change.StepID, change.Field, change.OldValue, change.NewValue)
+}
+
+// ExpectationReviewer decides which expectation changes are written to the scenarios.
+type ExpectationReviewer interface {
+	// ReviewChange returns true if the change is accepted.
+	ReviewChange(change *ExpectationChange) bool
+}
+
+// ExpectationUpdatingExecutor is implemented by the executors that can rewrite the expectations of the scenarios they run.
+type ExpectationUpdatingExecutor interface {
+	ScenarioExecutor
+
+	// ExecuteScenarioUpdatingExpectations executes the scenario like ExecuteScenario,
+	// but first replaces the expectations that do not match the actual results, for every change the reviewer accepts.
+	// Expectations that cannot be rewritten, or whose change was rejected, still fail the scenario.
+	ExecuteScenarioUpdatingExpectations(*mj.Scenario, fr.FileResolver, ExpectationReviewer) error
+}
+
+// AcceptAllReviewer accepts all expectation changes.
+type AcceptAllReviewer struct{}
+
+// ReviewChange accepts the change.
+func (*AcceptAllReviewer) ReviewChange(_ *ExpectationChange) bool {
+	return true
+}
+
+// InteractiveReviewer prints each expectation change and asks whether to accept it.
+// It is safe to use from scenarios running in parallel.
+type InteractiveReviewer struct {
+	input     *bufio.Reader
+	output    io.Writer
+	acceptAll bool
+	rejectAll bool
+	mutex     sync.Mutex
+}
+
+// NewInteractiveReviewer creates a reviewer that reads the answers from the input and writes the prompts to the output.
+func NewInteractiveReviewer(input io.Reader, output io.Writer) *InteractiveReviewer {
+	return &InteractiveReviewer{
+		input:  bufio.NewReader(input),
+		output: output,
+	}
+}
+
+// ReviewChange prompts for the change.
+// Besides accepting or rejecting it, the answer can accept or reject all the remaining changes.
+// The end of the input rejects all the remaining changes.
+func (reviewer *InteractiveReviewer) ReviewChange(change *ExpectationChange) bool {
+	reviewer.mutex.Lock()
+	defer reviewer.mutex.Unlock()
+
+	if reviewer.acceptAll {
+		return true
+	}
+	if reviewer.rejectAll {
+		return false
+	}
+
+	_, _ = fmt.Fprintf(reviewer.output, "%s\n", change.String())
+	for {
+		_, _ = fmt.Fprint(reviewer.output, "Accept change? [y]es, [n]o, [a]ll remaining, [q]uit (reject all remaining): ")
+		answer, err := reviewer.input.ReadString('\n')
+		if err != nil && len(answer) == 0 {
+			_, _ = fmt.Fprintln(reviewer.output)
+			reviewer.rejectAll = true
+			return false
+		}
+
+		switch strings.ToLower(strings.TrimSpace(answer)) {
+		case "y", "yes":
+			return true
+		case "n", "no":
+			return false
+		case "a", "all":
+			reviewer.acceptAll = true
+			return true
+		case "q", "quit":
+			reviewer.rejectAll = true
+			return false
+		}
+	}
+}
+
+// scenarioReviewer attributes the changes to a scenario file and counts the accepted ones.
+// Changes coming from nested external steps are already attributed to their own file, so they are not counted.
+type scenarioReviewer struct {
+	scenarioPath string
+	reviewer     ExpectationReviewer
+	numAccepted  int
+}
+
+func (reviewer *scenarioReviewer) ReviewChange(change *ExpectationChange) bool {
+	if len(change.ScenarioPath) == 0 {
+		change.ScenarioPath = reviewer.scenarioPath
+	}
+	accepted := reviewer.reviewer.ReviewChange(change)
+	if accepted && change.ScenarioPath == reviewer.scenarioPath {
+		reviewer.numAccepted++
+	}
+	return accepted
+}
+
+// executeScenarioUpdatingExpectations runs the scenario in update mode,
+// then writes it back to its file if any of its changes were accepted, even if the scenario failed afterwards.
+func executeScenarioUpdatingExpectations(
+	executor ScenarioExecutor,
+	scenario *mj.Scenario,
+	scenarioPath string,
+	fileResolver fr.FileResolver,
+	options *RunScenarioOptions,
+) error {
+	updatingExecutor, ok := executor.(ExpectationUpdatingExecutor)
+	if !ok {
+		return ErrExpectationUpdateNotSupported
+	}
+
+	reviewer := &scenarioReviewer{
+		scenarioPath: scenarioPath,
+		reviewer:     options.ExpectationReviewer,
+	}
+	if reviewer.reviewer == nil {
+		reviewer.reviewer = &AcceptAllReviewer{}
+	}
+
+	err := updatingExecutor.ExecuteScenarioUpdatingExpectations(scenario, fileResolver, reviewer)
+	if reviewer.numAccepted > 0 {
+		writeErr := WriteMandosScenario(scenario, scenarioPath)
+		if writeErr != nil {
+			return writeErr
+		}
+	}
+
+	return err
+}
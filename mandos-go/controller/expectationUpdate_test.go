@@ -0,0 +1,134 @@
+package mandoscontroller
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	fr "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/fileresolver"
+	mj "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/model"
+	"github.com/stretchr/testify/require"
+)
+
+const updatedScenarioJSON = `{
+    "name": "update",
+    "comment": "comments are kept",
+    "steps": [
+        {
+            "step": "scQuery",
+            "txId": "1",
+            "tx": {
+                "to": "sc:adder",
+                "function": "getSum",
+                "arguments": []
+            },
+            "expect": {
+                "out": [
+                    "str:old"
+                ]
+            }
+        },
+        {
+            "step": "scQuery",
+            "txId": "2",
+            "tx": {
+                "to": "sc:adder",
+                "function": "getSum",
+                "arguments": []
+            },
+            "expect": {
+                "out": [
+                    "str:old"
+                ]
+            }
+        }
+    ]
+}`
+
+// updatingExecutorStub proposes to replace the output of every tx step with "str:new"
+type updatingExecutorStub struct {
+	changes []*ExpectationChange
+}
+
+func (executor *updatingExecutorStub) Reset() {
+}
+
+func (executor *updatingExecutorStub) ExecuteScenario(_ *mj.Scenario, _ fr.FileResolver) error {
+	return nil
+}
+
+func (executor *updatingExecutorStub) ExecuteScenarioUpdatingExpectations(
+	scenario *mj.Scenario,
+	_ fr.FileResolver,
+	reviewer ExpectationReviewer,
+) error {
+	for _, step := range scenario.Steps {
+		txStep, isTx := step.(*mj.TxStep)
+		if !isTx {
+			continue
+		}
+		change := &ExpectationChange{StepID: txStep.TxIdent, Field: "out", OldValue: `["str:old"]`, NewValue: `["str:new"]`}
+		executor.changes = append(executor.changes, change)
+		if reviewer.ReviewChange(change) {
+			txStep.ExpectedResult.Out = mj.JSONCheckValueList{
+				Values: []mj.JSONCheckBytes{mj.JSONCheckBytesReconstructed([]byte("new"), "str:new")},
+			}
+		}
+	}
+	return nil
+}
+
+func writeUpdatedScenario(t *testing.T) string {
+	dir := writeTestScenarios(t, map[string]string{"update.scen.json": updatedScenarioJSON})
+	return filepath.Join(dir, "update.scen.json")
+}
+
+func TestScenarioRunner_UpdateExpectations(t *testing.T) {
+	scenarioPath := writeUpdatedScenario(t)
+	defer func() {
+		_ = os.RemoveAll(filepath.Dir(scenarioPath))
+	}()
+
+	executor := &updatingExecutorStub{}
+	prompts := &bytes.Buffer{}
+	options := &RunScenarioOptions{
+		UpdateExpectations:  true,
+		ExpectationReviewer: NewInteractiveReviewer(strings.NewReader("maybe\nn\ny\n"), prompts),
+	}
+	err := NewScenarioRunner(executor, NewDefaultFileResolver()).RunSingleJSONScenario(scenarioPath, options)
+	require.Nil(t, err)
+
+	require.Len(t, executor.changes, 2)
+	require.Equal(t, scenarioPath, executor.changes[0].ScenarioPath)
+	require.Equal(t, 3, strings.Count(prompts.String(), "Accept change?"))
+
+	contents, err := ioutil.ReadFile(scenarioPath)
+	require.Nil(t, err)
+	require.Contains(t, string(contents), `"comment": "comments are kept"`)
+	require.Equal(t, 1, strings.Count(string(contents), `"str:old"`))
+	require.Equal(t, 1, strings.Count(string(contents), `"str:new"`))
+}
+
+func TestScenarioRunner_UpdateExpectationsAllRejected(t *testing.T) {
+	scenarioPath := writeUpdatedScenario(t)
+	defer func() {
+		_ = os.RemoveAll(filepath.Dir(scenarioPath))
+	}()
+
+	options := &RunScenarioOptions{
+		UpdateExpectations:  true,
+		ExpectationReviewer: NewInteractiveReviewer(strings.NewReader("q\n"), ioutil.Discard),
+	}
+	err := NewScenarioRunner(&updatingExecutorStub{}, NewDefaultFileResolver()).RunSingleJSONScenario(scenarioPath, options)
+	require.Nil(t, err)
+
+	contents, err := ioutil.ReadFile(scenarioPath)
+	require.Nil(t, err)
+	require.Equal(t, updatedScenarioJSON, string(contents))
+
+	err = NewScenarioRunner(&executorStub{}, NewDefaultFileResolver()).RunSingleJSONScenario(scenarioPath, options)
+	require.Equal(t, ErrExpectationUpdateNotSupported, err)
+}
@@ -0,0 +1,64 @@
+package mandoscontroller
+
+import (
+	"path"
+	"strings"
+)
+
+// validateGlobPatterns checks the syntax of all the given patterns, so that matching never fails later
+func validateGlobPatterns(patterns []string) error {
+	for _, pattern := range patterns {
+		for _, segment := range strings.Split(pattern, "/") {
+			if segment == "**" {
+				continue
+			}
+			_, err := path.Match(segment, "")
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// matchesAnyGlob returns true if the slash separated path matches one of the patterns
+func matchesAnyGlob(patterns []string, testPath string) bool {
+	for _, pattern := range patterns {
+		if matchGlob(pattern, testPath) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchGlob matches a slash separated path against a pattern in the syntax of path.Match,
+// extended with the "**" segment, which matches any number of directories.
+// Patterns without a slash are matched against the file name only.
+func matchGlob(pattern string, testPath string) bool {
+	if !strings.Contains(pattern, "/") {
+		matched, _ := path.Match(pattern, path.Base(testPath))
+		return matched
+	}
+	return matchSegments(strings.Split(pattern, "/"), strings.Split(testPath, "/"))
+}
+
+func matchSegments(patternSegments []string, pathSegments []string) bool {
+	if len(patternSegments) == 0 {
+		return len(pathSegments) == 0
+	}
+
+	if patternSegments[0] == "**" {
+		for skipped := 0; skipped <= len(pathSegments); skipped++ {
+			if matchSegments(patternSegments[1:], pathSegments[skipped:]) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if len(pathSegments) == 0 {
+		return false
+	}
+	matched, _ := path.Match(patternSegments[0], pathSegments[0])
+	return matched && matchSegments(patternSegments[1:], pathSegments[1:])
+}
@@ -0,0 +1,380 @@
+package mandoscontroller
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	fr "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/fileresolver"
+	mjparse "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/json/parse"
+	mj "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/model"
+)
+
+// ScenarioExecutorFactory creates the executor of a single scenario, with its own VM and world.
+type ScenarioExecutorFactory func() (ScenarioExecutor, error)
+
+// GasUsageReporter is implemented by the executors that account for the gas consumed by the scenarios they run.
+type GasUsageReporter interface {
+	GetTotalGasUsed() uint64
+}
+
+type closableExecutor interface {
+	Close()
+}
+
+// ParallelRunOptions configures a ParallelScenarioRunner.
+type ParallelRunOptions struct {
+	// NumWorkers is the number of scenarios run at the same time. Zero means the number of CPUs.
+	NumWorkers int
+
+	// Timeout limits the execution of each scenario. Zero means no limit.
+	// A scenario that times out is reported as such, but keeps running in the background until it ends;
+	// the scenarios of the next gas schedule only start once it has ended, and so does the report.
+	Timeout time.Duration
+
+	// IncludePatterns restricts the run to the scenarios matching at least one of the patterns.
+	// Patterns are relative to the general test path and may use "**" to match any number of directories.
+	IncludePatterns []string
+
+	// ExcludePatterns skips the scenarios matching any of the patterns.
+	ExcludePatterns []string
+
+	// ScenarioOptions is applied to every scenario.
+	ScenarioOptions *RunScenarioOptions
+
+	// Output receives the progress of the run. Nil means the standard output.
+	Output io.Writer
+
+	// Color prints the differences found by failed checks color-coded, for terminals.
+	Color bool
+}
+
+// ParallelScenarioRunner runs all the scenarios in a directory tree in parallel, each with a new executor.
+// Scenarios are grouped by gas schedule and the groups run one after another,
+// since VM implementations may keep the gas costs in process-wide state.
+type ParallelScenarioRunner struct {
+	ExecutorFactory ScenarioExecutorFactory
+	Options         ParallelRunOptions
+
+	outputMutex sync.Mutex
+}
+
+type scenarioJob struct {
+	filePath     string
+	fileResolver *fr.RecordingFileResolver
+	parser       mjparse.Parser
+	scenario     *mj.Scenario
+	result       *ScenarioResult
+}
+
+// dependencies yields the scenario file, followed by the files it resolved while parsing and running
+func (job *scenarioJob) dependencies() []string {
+	scenarioPath, err := filepath.Abs(job.filePath)
+	if err != nil {
+		scenarioPath = job.filePath
+	}
+	dependencies := []string{scenarioPath}
+	if job.fileResolver == nil {
+		return dependencies
+	}
+	for _, dependency := range job.fileResolver.RecordedPaths() {
+		if dependency != scenarioPath {
+			dependencies = append(dependencies, dependency)
+		}
+	}
+	return dependencies
+}
+
+// NewParallelScenarioRunner creates new ParallelScenarioRunner instance.
+func NewParallelScenarioRunner(executorFactory ScenarioExecutorFactory, options ParallelRunOptions) *ParallelScenarioRunner {
+	return &ParallelScenarioRunner{
+		ExecutorFactory: executorFactory,
+		Options:         options,
+	}
+}
+
+// RunAllJSONScenariosInDirectory finds, parses and runs all the scenarios in the directory.
+// The report holds one result per scenario file found, in path order, including the skipped ones.
+func (r *ParallelScenarioRunner) RunAllJSONScenariosInDirectory(
+	generalTestPath string,
+	specificTestPath string,
+	allowedSuffix string,
+) (*ScenarioRunReport, error) {
+	err := validateGlobPatterns(r.Options.IncludePatterns)
+	if err != nil {
+		return nil, err
+	}
+	err = validateGlobPatterns(r.Options.ExcludePatterns)
+	if err != nil {
+		return nil, err
+	}
+
+	scenarioPaths, err := findScenarioFiles(path.Join(generalTestPath, specificTestPath), allowedSuffix)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.RunJSONScenarioFiles(generalTestPath, scenarioPaths)
+}
+
+// RunJSONScenarioFiles parses and runs the given scenarios, applying the include and exclude patterns.
+// The report holds one result per scenario file, in the same order as the paths.
+func (r *ParallelScenarioRunner) RunJSONScenarioFiles(
+	generalTestPath string,
+	scenarioPaths []string,
+) (*ScenarioRunReport, error) {
+	startTime := time.Now()
+	report := &ScenarioRunReport{
+		Results: make([]*ScenarioResult, len(scenarioPaths)),
+	}
+	jobs := make([]*scenarioJob, 0, len(scenarioPaths))
+	for i, scenarioPath := range scenarioPaths {
+		result := &ScenarioResult{Path: filepath.ToSlash(shortenTestPath(scenarioPath, generalTestPath))}
+		report.Results[i] = result
+		if !r.isSelected(result.Path) {
+			result.Status = ScenarioSkipped
+			r.printResult(result)
+			continue
+		}
+		jobs = append(jobs, &scenarioJob{filePath: scenarioPath, result: result})
+	}
+
+	r.forEachInParallel(len(jobs), func(index int) {
+		r.parseScenario(jobs[index])
+	})
+
+	for _, group := range groupByGasSchedule(jobs) {
+		var running sync.WaitGroup
+		r.forEachInParallel(len(group), func(index int) {
+			r.runScenario(group[index], &running)
+			r.printResult(group[index].result)
+		})
+		// the scenarios that timed out may still be running with the gas schedule that the next group replaces
+		running.Wait()
+	}
+
+	for _, job := range jobs {
+		job.result.Dependencies = job.dependencies()
+	}
+
+	report.Duration = time.Since(startTime)
+	r.printf("Done. Passed: %d. Failed: %d. Timed out: %d. Skipped: %d. Gas used: %d. Duration: %s.\n",
+		report.NumWithStatus(ScenarioPassed),
+		report.NumWithStatus(ScenarioFailed),
+		report.NumWithStatus(ScenarioTimedOut),
+		report.NumWithStatus(ScenarioSkipped),
+		report.TotalGasUsed(),
+		report.Duration.Round(time.Millisecond))
+
+	if report.NumFailed() > 0 {
+		return report, errors.New("some tests failed")
+	}
+
+	return report, nil
+}
+
+func findScenarioFiles(mainDirPath string, allowedSuffix string) ([]string, error) {
+	scenarioPaths := make([]string, 0)
+	err := filepath.Walk(mainDirPath, func(testFilePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() && strings.HasSuffix(testFilePath, allowedSuffix) {
+			scenarioPaths = append(scenarioPaths, testFilePath)
+		}
+		return nil
+	})
+	return scenarioPaths, err
+}
+
+func (r *ParallelScenarioRunner) isSelected(relativePath string) bool {
+	if len(r.Options.IncludePatterns) > 0 && !matchesAnyGlob(r.Options.IncludePatterns, relativePath) {
+		return false
+	}
+	return !matchesAnyGlob(r.Options.ExcludePatterns, relativePath)
+}
+
+// groupByGasSchedule splits the parsed scenarios by gas schedule, keeping the groups in order of first appearance
+func groupByGasSchedule(jobs []*scenarioJob) [][]*scenarioJob {
+	groupIndexes := make(map[string]int)
+	groups := make([][]*scenarioJob, 0)
+	for _, job := range jobs {
+		if job.scenario == nil {
+			continue
+		}
+		key := gasScheduleKey(job.scenario)
+		index, ok := groupIndexes[key]
+		if !ok {
+			index = len(groups)
+			groupIndexes[key] = index
+			groups = append(groups, make([]*scenarioJob, 0))
+		}
+		groups[index] = append(groups[index], job)
+	}
+	return groups
+}
+
+// gasScheduleKey identifies the gas costs of a scenario,
+// including the contents of its gas schedule file and its overrides, in order
+func gasScheduleKey(scenario *mj.Scenario) string {
+	key := fmt.Sprintf("%d", scenario.GasSchedule)
+	if scenario.GasSchedule == mj.GasScheduleFile {
+		key += fmt.Sprintf(":%x", sha256.Sum256(scenario.GasScheduleFile.Value))
+	}
+	for _, override := range scenario.GasScheduleOverrides {
+		key += fmt.Sprintf(",%s=%d", override.Key, override.Value.Value)
+	}
+	return key
+}
+
+func (r *ParallelScenarioRunner) numWorkers() int {
+	if r.Options.NumWorkers > 0 {
+		return r.Options.NumWorkers
+	}
+	return runtime.NumCPU()
+}
+
+func (r *ParallelScenarioRunner) forEachInParallel(count int, process func(index int)) {
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for worker := 0; worker < r.numWorkers(); worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range indexes {
+				process(index)
+			}
+		}()
+	}
+
+	for index := 0; index < count; index++ {
+		indexes <- index
+	}
+	close(indexes)
+	wg.Wait()
+}
+
+func (r *ParallelScenarioRunner) parseScenario(job *scenarioJob) {
+	job.fileResolver = fr.NewRecordingFileResolver(NewDefaultFileResolver())
+	job.parser = mjparse.NewParser(job.fileResolver)
+	scenario, err := ParseMandosScenario(job.parser, job.filePath)
+	if err != nil {
+		job.result.Status = ScenarioFailed
+		job.result.Failure = err.Error()
+		r.printResult(job.result)
+		return
+	}
+
+	scenario.IsNewTest = true
+	if r.Options.ScenarioOptions != nil {
+		applyScenarioOptions(scenario, r.Options.ScenarioOptions)
+	}
+	job.scenario = scenario
+}
+
+type scenarioOutcome struct {
+	gasUsed uint64
+	err     error
+}
+
+// runScenario runs the scenario of the job until it ends or times out; the running group is done once the scenario ends
+func (r *ParallelScenarioRunner) runScenario(job *scenarioJob, running *sync.WaitGroup) {
+	startTime := time.Now()
+	done := make(chan scenarioOutcome, 1)
+	running.Add(1)
+	go func() {
+		defer running.Done()
+		done <- r.executeScenario(job)
+	}()
+
+	var timeoutChannel <-chan time.Time
+	if r.Options.Timeout > 0 {
+		timer := time.NewTimer(r.Options.Timeout)
+		defer timer.Stop()
+		timeoutChannel = timer.C
+	}
+
+	select {
+	case outcome := <-done:
+		job.result.GasUsed = outcome.gasUsed
+		job.result.Status = ScenarioPassed
+		if outcome.err != nil {
+			job.result.Status = ScenarioFailed
+			job.result.Failure = outcome.err.Error()
+			var checkFailure *CheckFailure
+			if errors.As(outcome.err, &checkFailure) {
+				job.result.Diff = checkFailure
+			}
+		}
+	case <-timeoutChannel:
+		job.result.Status = ScenarioTimedOut
+		job.result.Failure = fmt.Sprintf("scenario did not finish within %s", r.Options.Timeout)
+	}
+	job.result.Duration = time.Since(startTime)
+}
+
+func (r *ParallelScenarioRunner) executeScenario(job *scenarioJob) (outcome scenarioOutcome) {
+	defer func() {
+		recovered := recover()
+		if recovered != nil {
+			outcome.err = fmt.Errorf("panic: %v", recovered)
+		}
+	}()
+
+	executor, err := r.ExecutorFactory()
+	if err != nil {
+		return scenarioOutcome{err: err}
+	}
+	closable, ok := executor.(closableExecutor)
+	if ok {
+		defer closable.Close()
+	}
+
+	fileResolver := job.parser.ExprInterpreter.FileResolver
+	if r.Options.ScenarioOptions != nil && r.Options.ScenarioOptions.UpdateExpectations {
+		outcome.err = executeScenarioUpdatingExpectations(
+			executor, job.scenario, job.filePath, fileResolver, r.Options.ScenarioOptions)
+	} else {
+		outcome.err = executor.ExecuteScenario(job.scenario, fileResolver)
+	}
+
+	gasReporter, ok := executor.(GasUsageReporter)
+	if ok {
+		outcome.gasUsed = gasReporter.GetTotalGasUsed()
+	}
+	return outcome
+}
+
+func (r *ParallelScenarioRunner) printResult(result *ScenarioResult) {
+	switch result.Status {
+	case ScenarioPassed:
+		r.printf("Scenario: %s ...   ok (%s)\n", result.Path, result.Duration.Round(time.Millisecond))
+	case ScenarioSkipped:
+		r.printf("Scenario: %s ...   skip\n", result.Path)
+	default:
+		failure := result.Failure
+		if result.Diff != nil {
+			failure = result.Diff.Pretty(r.Options.Color)
+		}
+		r.printf("Scenario: %s ...   FAIL: %s\n", result.Path, failure)
+	}
+}
+
+func (r *ParallelScenarioRunner) printf(format string, args ...interface{}) {
+	r.outputMutex.Lock()
+	defer r.outputMutex.Unlock()
+
+	output := r.Options.Output
+	if output == nil {
+		output = os.Stdout
+	}
+	_, _ = fmt.Fprintf(output, format, args...)
+}
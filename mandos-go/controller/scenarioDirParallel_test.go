@@ -0,0 +1,240 @@
+package mandoscontroller
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	fr "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/fileresolver"
+	mj "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/model"
+	"github.com/stretchr/testify/require"
+)
+
+// executorStub passes or fails each scenario depending on its name, and reports 100 gas for each scenario that passes
+type executorStub struct {
+	gasUsed   uint64
+	onExecute func(name string)
+	onClose   func()
+}
+
+func (executor *executorStub) Reset() {
+}
+
+func (executor *executorStub) ExecuteScenario(scenario *mj.Scenario, _ fr.FileResolver) error {
+	if executor.onExecute != nil {
+		executor.onExecute(scenario.Name)
+	}
+	switch scenario.Name {
+	case "fail":
+		return errors.New("tx step failed")
+	case "diff":
+		return fmt.Errorf("external step failed: %w", testCheckFailure())
+	case "panic":
+		panic("unexpected")
+	case "slow":
+		time.Sleep(time.Second)
+	}
+	executor.gasUsed = 100
+	return nil
+}
+
+func (executor *executorStub) GetTotalGasUsed() uint64 {
+	return executor.gasUsed
+}
+
+func (executor *executorStub) Close() {
+	executor.onClose()
+}
+
+func writeTestScenarios(t *testing.T, scenarios map[string]string) string {
+	dir, err := ioutil.TempDir("", "mandos-parallel")
+	require.Nil(t, err)
+	for relativePath, contents := range scenarios {
+		fullPath := filepath.Join(dir, relativePath)
+		require.Nil(t, os.MkdirAll(filepath.Dir(fullPath), os.ModePerm))
+		require.Nil(t, ioutil.WriteFile(fullPath, []byte(contents), 0644))
+	}
+	return dir
+}
+
+func scenarioJSON(name string, gasSchedule string) string {
+	return fmt.Sprintf(`{"name": "%s", "gasSchedule": "%s", "steps": []}`, name, gasSchedule)
+}
+
+func TestParallelScenarioRunner_RunAllJSONScenariosInDirectory(t *testing.T) {
+	dir := writeTestScenarios(t, map[string]string{
+		"a/ok.scen.json":        scenarioJSON("ok", "v3"),
+		"a/fail.scen.json":      scenarioJSON("fail", "v4"),
+		"b/panic.scen.json":     scenarioJSON("panic", "v3"),
+		"b/excluded.scen.json":  scenarioJSON("ok", "v3"),
+		"b/invalid.scen.json":   `{"unknownField": 1}`,
+		"c/deep/ok.scen.json":   scenarioJSON("ok", "v4"),
+		"c/deep/slow.scen.json": scenarioJSON("slow", "v4"),
+		"c/ignored.json":        scenarioJSON("ok", "v4"),
+	})
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	var closeMutex sync.Mutex
+	numClosed := 0
+	factory := func() (ScenarioExecutor, error) {
+		return &executorStub{onClose: func() {
+			closeMutex.Lock()
+			numClosed++
+			closeMutex.Unlock()
+		}}, nil
+	}
+
+	output := &bytes.Buffer{}
+	runner := NewParallelScenarioRunner(factory, ParallelRunOptions{
+		NumWorkers:      3,
+		Timeout:         200 * time.Millisecond,
+		ExcludePatterns: []string{"b/excluded.scen.json"},
+		Output:          output,
+	})
+	report, err := runner.RunAllJSONScenariosInDirectory(dir, "", ".scen.json")
+	require.NotNil(t, err)
+
+	statuses := make(map[string]ScenarioStatus)
+	for _, result := range report.Results {
+		statuses[result.Path] = result.Status
+	}
+	require.Equal(t, map[string]ScenarioStatus{
+		"a/fail.scen.json":      ScenarioFailed,
+		"a/ok.scen.json":        ScenarioPassed,
+		"b/excluded.scen.json":  ScenarioSkipped,
+		"b/invalid.scen.json":   ScenarioFailed,
+		"b/panic.scen.json":     ScenarioFailed,
+		"c/deep/ok.scen.json":   ScenarioPassed,
+		"c/deep/slow.scen.json": ScenarioTimedOut,
+	}, statuses)
+	require.Equal(t, "a/fail.scen.json", report.Results[0].Path)
+	require.Equal(t, uint64(200), report.TotalGasUsed())
+	require.Equal(t, 4, report.NumFailed())
+	require.Contains(t, output.String(), "Done. Passed: 2. Failed: 3. Timed out: 1. Skipped: 1.")
+
+	// the run waits for the timed out scenario, which closes its executor once it ends
+	closeMutex.Lock()
+	require.Equal(t, 5, numClosed)
+	closeMutex.Unlock()
+}
+
+func TestParallelScenarioRunner_TimedOutScenarioEndsBeforeNextGasSchedule(t *testing.T) {
+	dir := writeTestScenarios(t, map[string]string{
+		"a/slow.scen.json": scenarioJSON("slow", "v3"),
+		"b/ok.scen.json":   scenarioJSON("ok", "v4"),
+	})
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	var eventsMutex sync.Mutex
+	events := make([]string, 0)
+	addEvent := func(event string) {
+		eventsMutex.Lock()
+		events = append(events, event)
+		eventsMutex.Unlock()
+	}
+	factory := func() (ScenarioExecutor, error) {
+		executor := &executorStub{}
+		executor.onExecute = func(name string) {
+			addEvent("execute " + name)
+		}
+		executor.onClose = func() {
+			addEvent("close")
+		}
+		return executor, nil
+	}
+
+	runner := NewParallelScenarioRunner(factory, ParallelRunOptions{
+		Timeout: 100 * time.Millisecond,
+		Output:  ioutil.Discard,
+	})
+	report, err := runner.RunAllJSONScenariosInDirectory(dir, "", ".scen.json")
+	require.NotNil(t, err)
+	require.Equal(t, ScenarioTimedOut, report.Results[0].Status)
+	require.Equal(t, []string{"execute slow", "close", "execute ok", "close"}, events)
+}
+
+func TestParallelScenarioRunner_IncludePatterns(t *testing.T) {
+	dir := writeTestScenarios(t, map[string]string{
+		"a/ok.scen.json":      scenarioJSON("ok", "v3"),
+		"a/fail.scen.json":    scenarioJSON("fail", "v3"),
+		"c/deep/ok.scen.json": scenarioJSON("ok", "v3"),
+	})
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	factory := func() (ScenarioExecutor, error) {
+		return &executorStub{onClose: func() {}}, nil
+	}
+	options := ParallelRunOptions{
+		IncludePatterns: []string{"**/ok.scen.json"},
+		Output:          ioutil.Discard,
+	}
+	report, err := NewParallelScenarioRunner(factory, options).RunAllJSONScenariosInDirectory(dir, "", ".scen.json")
+	require.Nil(t, err)
+	require.Equal(t, 2, report.NumWithStatus(ScenarioPassed))
+	require.Equal(t, 1, report.NumWithStatus(ScenarioSkipped))
+
+	options.IncludePatterns = []string{"[a-"}
+	_, err = NewParallelScenarioRunner(factory, options).RunAllJSONScenariosInDirectory(dir, "", ".scen.json")
+	require.NotNil(t, err)
+}
+
+func TestMatchGlob(t *testing.T) {
+	require.True(t, matchGlob("*.scen.json", "features/a/ok.scen.json"))
+	require.True(t, matchGlob("features/*/ok.scen.json", "features/a/ok.scen.json"))
+	require.False(t, matchGlob("features/*.scen.json", "features/a/ok.scen.json"))
+	require.True(t, matchGlob("features/**/ok.scen.json", "features/ok.scen.json"))
+	require.True(t, matchGlob("features/**/ok.scen.json", "features/a/b/ok.scen.json"))
+	require.True(t, matchGlob("**/a/**", "features/a/b/ok.scen.json"))
+	require.False(t, matchGlob("**/c/**", "features/a/b/ok.scen.json"))
+}
+
+func TestScenarioRunReport_Write(t *testing.T) {
+	report := &ScenarioRunReport{
+		Results: []*ScenarioResult{
+			{Path: "ok.scen.json", Status: ScenarioPassed, Duration: 1500 * time.Millisecond, GasUsed: 10},
+			{Path: "fail.scen.json", Status: ScenarioFailed, Failure: "tx step failed", GasUsed: 5},
+			{Path: "skip.scen.json", Status: ScenarioSkipped},
+		},
+		Duration: 2 * time.Second,
+	}
+
+	jsonOutput := &bytes.Buffer{}
+	require.Nil(t, report.WriteJSON(jsonOutput))
+	var decoded map[string]interface{}
+	require.Nil(t, json.Unmarshal(jsonOutput.Bytes(), &decoded))
+	require.Equal(t, float64(1), decoded["passed"])
+	require.Equal(t, float64(1), decoded["failed"])
+	require.Equal(t, float64(15), decoded["gasUsed"])
+	scenarios := decoded["scenarios"].([]interface{})
+	require.Len(t, scenarios, 3)
+	require.Equal(t, 1.5, scenarios[0].(map[string]interface{})["durationSeconds"])
+	require.Equal(t, "tx step failed", scenarios[1].(map[string]interface{})["failure"])
+
+	xmlOutput := &bytes.Buffer{}
+	require.Nil(t, report.WriteJUnitXML(xmlOutput, "mandos"))
+	var suites junitTestSuites
+	require.Nil(t, xml.Unmarshal(xmlOutput.Bytes(), &suites))
+	require.Len(t, suites.Suites, 1)
+	suite := suites.Suites[0]
+	require.Equal(t, 3, suite.Tests)
+	require.Equal(t, 1, suite.Failures)
+	require.Equal(t, 1, suite.Skipped)
+	require.Equal(t, "2.000", suite.Time)
+	require.Equal(t, "1.500", suite.TestCases[0].Time)
+	require.Equal(t, "tx step failed", suite.TestCases[1].Failure.Message)
+	require.NotNil(t, suite.TestCases[2].Skipped)
+}
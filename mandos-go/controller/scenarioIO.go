@@ -6,12 +6,23 @@ import (
 	"path/filepath"
 
 	mjparse "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/json/parse"
+	mjschema "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/json/schema"
 	mjwrite "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/json/write"
 	mj "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/model"
 )
 
 // ParseMandosScenario reads and parses a Mandos scenario from a JSON file.
 func ParseMandosScenario(parser mjparse.Parser, scenFilePath string) (*mj.Scenario, error) {
+	return ParseMandosScenarioWithArguments(parser, scenFilePath, nil)
+}
+
+// ParseMandosScenarioWithArguments reads and parses a Mandos scenario from a JSON file,
+// with the arguments bound as variables, like when it runs as external steps.
+func ParseMandosScenarioWithArguments(
+	parser mjparse.Parser,
+	scenFilePath string,
+	arguments []*mj.ScenarioVariable,
+) (*mj.Scenario, error) {
 	var err error
 	scenFilePath, err = filepath.Abs(scenFilePath)
 	if err != nil {
@@ -35,7 +46,7 @@ func ParseMandosScenario(parser mjparse.Parser, scenFilePath string) (*mj.Scenar
 	}
 
 	parser.ExprInterpreter.FileResolver.SetContext(scenFilePath)
-	return parser.ParseScenarioFile(byteValue)
+	return parser.ParseScenarioFileWithArguments(byteValue, arguments)
 }
 
 // ParseMandosScenario reads and parses a Mandos scenario from a JSON file.
@@ -45,6 +56,23 @@ func ParseMandosScenarioDefaultParser(scenFilePath string) (*mj.Scenario, error)
 	return ParseMandosScenario(parser, scenFilePath)
 }
 
+// ValidateMandosScenario checks a Mandos scenario, or steps file, and yields all the problems found,
+// located by JSON path, line and column.
+func ValidateMandosScenario(scenFilePath string) ([]*mjschema.ValidationError, error) {
+	absolutePath, err := filepath.Abs(scenFilePath)
+	if err != nil {
+		return nil, err
+	}
+	byteValue, err := ioutil.ReadFile(absolutePath)
+	if err != nil {
+		return nil, err
+	}
+
+	parser := mjparse.NewParser(NewDefaultFileResolver())
+	parser.ExprInterpreter.FileResolver.SetContext(absolutePath)
+	return parser.ValidateScenarioFile(byteValue), nil
+}
+
 // WriteMandosScenario exports a Mandos scenario to a file, using the default formatting.
 func WriteMandosScenario(scenario *mj.Scenario, toPath string) error {
 	jsonString := mjwrite.ScenarioToJSONString(scenario)
@@ -6,6 +6,15 @@ import (
 
 type RunScenarioOptions struct {
 	ForceTraceGas bool
+
+	// UpdateExpectations rewrites the expectations of the scenario files from the actual results, instead of failing.
+	UpdateExpectations bool
+
+	// ExpectationReviewer decides which of the rewritten expectations are kept. Nil accepts all.
+	ExpectationReviewer ExpectationReviewer
+
+	// Arguments are bound as variables of the scenario, overriding the values it declares.
+	Arguments []*mj.ScenarioVariable
 }
 
 func applyScenarioOptions(scenario *mj.Scenario, options *RunScenarioOptions) {
@@ -22,7 +31,7 @@ func DefaultRunScenarioOptions() *RunScenarioOptions {
 
 // RunSingleJSONScenario parses and prepares test, then calls testCallback.
 func (r *ScenarioRunner) RunSingleJSONScenario(contextPath string, options *RunScenarioOptions) error {
-	scenario, parseErr := ParseMandosScenario(r.Parser, contextPath)
+	scenario, parseErr := ParseMandosScenarioWithArguments(r.Parser, contextPath, options.Arguments)
 
 	if parseErr != nil {
 		return parseErr
@@ -35,5 +44,10 @@ func (r *ScenarioRunner) RunSingleJSONScenario(contextPath string, options *RunS
 
 	applyScenarioOptions(scenario, options)
 
+	if options.UpdateExpectations {
+		return executeScenarioUpdatingExpectations(
+			r.Executor, scenario, contextPath, r.Parser.ExprInterpreter.FileResolver, options)
+	}
+
 	return r.Executor.ExecuteScenario(scenario, r.Parser.ExprInterpreter.FileResolver)
 }
@@ -0,0 +1,198 @@
+package mandoscontroller
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"time"
+)
+
+// ScenarioStatus is the outcome of running a scenario
+type ScenarioStatus string
+
+const (
+	// ScenarioPassed signals that the scenario ran and all its checks passed
+	ScenarioPassed ScenarioStatus = "passed"
+
+	// ScenarioFailed signals that the scenario could not be parsed, or that one of its steps failed
+	ScenarioFailed ScenarioStatus = "failed"
+
+	// ScenarioTimedOut signals that the scenario did not finish within the timeout
+	ScenarioTimedOut ScenarioStatus = "timeout"
+
+	// ScenarioSkipped signals that the scenario was excluded from the run
+	ScenarioSkipped ScenarioStatus = "skipped"
+)
+
+// ScenarioResult holds the outcome of a single scenario
+type ScenarioResult struct {
+	Path     string         `json:"path"`
+	Status   ScenarioStatus `json:"status"`
+	Duration time.Duration  `json:"-"`
+	GasUsed  uint64         `json:"gasUsed"`
+	Failure  string         `json:"failure,omitempty"`
+
+	// Diff holds the differences found, when the scenario failed one of its checks
+	Diff *CheckFailure `json:"diff,omitempty"`
+
+	// Dependencies are the absolute paths of the files the scenario uses, starting with the scenario itself.
+	// They include the externalSteps and the "file:" values, as far as the scenario got before failing.
+	Dependencies []string `json:"-"`
+}
+
+// ScenarioRunReport holds the outcome of running all the scenarios in a directory
+type ScenarioRunReport struct {
+	Results  []*ScenarioResult
+	Duration time.Duration
+}
+
+// NumWithStatus returns the number of scenarios that ended with the given status
+func (report *ScenarioRunReport) NumWithStatus(status ScenarioStatus) int {
+	count := 0
+	for _, result := range report.Results {
+		if result.Status == status {
+			count++
+		}
+	}
+	return count
+}
+
+// NumFailed returns the number of scenarios that failed or timed out
+func (report *ScenarioRunReport) NumFailed() int {
+	return report.NumWithStatus(ScenarioFailed) + report.NumWithStatus(ScenarioTimedOut)
+}
+
+// TotalGasUsed returns the gas consumed by all the scenarios
+func (report *ScenarioRunReport) TotalGasUsed() uint64 {
+	total := uint64(0)
+	for _, result := range report.Results {
+		total += result.GasUsed
+	}
+	return total
+}
+
+type jsonScenarioResult struct {
+	*ScenarioResult
+	DurationSeconds float64 `json:"durationSeconds"`
+}
+
+type jsonScenarioRunReport struct {
+	Passed          int                  `json:"passed"`
+	Failed          int                  `json:"failed"`
+	TimedOut        int                  `json:"timedOut"`
+	Skipped         int                  `json:"skipped"`
+	GasUsed         uint64               `json:"gasUsed"`
+	DurationSeconds float64              `json:"durationSeconds"`
+	Scenarios       []jsonScenarioResult `json:"scenarios"`
+}
+
+// WriteJSON writes the report as a JSON document
+func (report *ScenarioRunReport) WriteJSON(writer io.Writer) error {
+	jsonReport := jsonScenarioRunReport{
+		Passed:          report.NumWithStatus(ScenarioPassed),
+		Failed:          report.NumWithStatus(ScenarioFailed),
+		TimedOut:        report.NumWithStatus(ScenarioTimedOut),
+		Skipped:         report.NumWithStatus(ScenarioSkipped),
+		GasUsed:         report.TotalGasUsed(),
+		DurationSeconds: report.Duration.Seconds(),
+		Scenarios:       make([]jsonScenarioResult, 0, len(report.Results)),
+	}
+	for _, result := range report.Results {
+		jsonReport.Scenarios = append(jsonReport.Scenarios, jsonScenarioResult{
+			ScenarioResult:  result,
+			DurationSeconds: result.Duration.Seconds(),
+		})
+	}
+
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(jsonReport)
+}
+
+type junitTestSuites struct {
+	XMLName xml.Name         `xml:"testsuites"`
+	Suites  []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name       string          `xml:"name,attr"`
+	Tests      int             `xml:"tests,attr"`
+	Failures   int             `xml:"failures,attr"`
+	Skipped    int             `xml:"skipped,attr"`
+	Time       string          `xml:"time,attr"`
+	Properties []junitProperty `xml:"properties>property"`
+	TestCases  []junitTestCase `xml:"testcase"`
+}
+
+type junitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	Skipped   *struct{}     `xml:"skipped,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Details string `xml:",chardata"`
+}
+
+// WriteJUnitXML writes the report in the JUnit XML format understood by CI servers, as a single test suite
+func (report *ScenarioRunReport) WriteJUnitXML(writer io.Writer, suiteName string) error {
+	suite := junitTestSuite{
+		Name:     suiteName,
+		Tests:    len(report.Results),
+		Failures: report.NumFailed(),
+		Skipped:  report.NumWithStatus(ScenarioSkipped),
+		Time:     formatJUnitSeconds(report.Duration),
+		Properties: []junitProperty{
+			{Name: "gasUsed", Value: fmt.Sprintf("%d", report.TotalGasUsed())},
+		},
+		TestCases: make([]junitTestCase, 0, len(report.Results)),
+	}
+
+	for _, result := range report.Results {
+		testCase := junitTestCase{
+			Name:      result.Path,
+			ClassName: suiteName,
+			Time:      formatJUnitSeconds(result.Duration),
+			SystemOut: fmt.Sprintf("gas used: %d", result.GasUsed),
+		}
+		switch result.Status {
+		case ScenarioFailed, ScenarioTimedOut:
+			testCase.Failure = &junitFailure{
+				Message: result.Failure,
+				Type:    string(result.Status),
+				Details: result.Failure,
+			}
+		case ScenarioSkipped:
+			testCase.Skipped = &struct{}{}
+		}
+		suite.TestCases = append(suite.TestCases, testCase)
+	}
+
+	_, err := io.WriteString(writer, xml.Header)
+	if err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(writer)
+	encoder.Indent("", "  ")
+	err = encoder.Encode(junitTestSuites{Suites: []junitTestSuite{suite}})
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(writer, "\n")
+	return err
+}
+
+func formatJUnitSeconds(duration time.Duration) string {
+	return fmt.Sprintf("%.3f", duration.Seconds())
+}
@@ -0,0 +1,191 @@
+package mandoscontroller
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// DefaultWatchPollInterval is how often the watcher checks the files for changes, unless configured otherwise.
+const DefaultWatchPollInterval = 500 * time.Millisecond
+
+// ScenarioWatcher runs all the scenarios under a path, then watches their files
+// and re-runs only the scenarios affected by each change.
+// The files of a scenario are the scenario itself, its externalSteps and its "file:" values.
+// Changes are detected by polling the modification time and size of the files.
+type ScenarioWatcher struct {
+	Runner        *ParallelScenarioRunner
+	TestPath      string
+	AllowedSuffix string
+	PollInterval  time.Duration
+
+	// results holds the latest result of each scenario, by scenario path
+	results map[string]*ScenarioResult
+
+	// fileStates holds the state of the dependencies of each scenario, as they were when it last ran
+	fileStates map[string]map[string]watchedFileState
+}
+
+type watchedFileState struct {
+	exists  bool
+	modTime time.Time
+	size    int64
+}
+
+func (state watchedFileState) equals(other watchedFileState) bool {
+	return state.exists == other.exists && state.modTime.Equal(other.modTime) && state.size == other.size
+}
+
+// NewScenarioWatcher creates new ScenarioWatcher instance.
+// The test path can be a directory or a single scenario file.
+func NewScenarioWatcher(runner *ParallelScenarioRunner, testPath string, allowedSuffix string) *ScenarioWatcher {
+	return &ScenarioWatcher{
+		Runner:        runner,
+		TestPath:      testPath,
+		AllowedSuffix: allowedSuffix,
+		PollInterval:  DefaultWatchPollInterval,
+		results:       make(map[string]*ScenarioResult),
+		fileStates:    make(map[string]map[string]watchedFileState),
+	}
+}
+
+// Watch runs all the scenarios, then re-runs the affected ones whenever their files change, until stopped.
+// Failing scenarios do not stop the watch, only errors that prevent finding or running the scenarios do.
+func (w *ScenarioWatcher) Watch(stop <-chan struct{}) error {
+	_, err := w.RunChanged()
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(w.PollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return nil
+		case <-ticker.C:
+			_, err = w.RunChanged()
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// RunChanged runs the scenarios that are new, or whose files changed since they last ran,
+// then prints the summary of all the scenarios watched.
+// It yields the report of the scenarios that ran, or nil if nothing changed.
+func (w *ScenarioWatcher) RunChanged() (*ScenarioRunReport, error) {
+	scenarioPaths, err := findScenarioFiles(w.TestPath, w.AllowedSuffix)
+	if err != nil {
+		return nil, err
+	}
+
+	found := make(map[string]bool)
+	affectedPaths := make([]string, 0)
+	for _, scenarioPath := range scenarioPaths {
+		found[scenarioPath] = true
+		if w.isAffected(scenarioPath) {
+			affectedPaths = append(affectedPaths, scenarioPath)
+		}
+	}
+	removed := w.forgetRemoved(found)
+	if len(affectedPaths) == 0 {
+		if removed {
+			w.printSummary()
+		}
+		return nil, nil
+	}
+
+	report, err := w.Runner.RunJSONScenarioFiles(w.TestPath, affectedPaths)
+	if report == nil {
+		return nil, err
+	}
+	for i, scenarioPath := range affectedPaths {
+		result := report.Results[i]
+		w.results[scenarioPath] = result
+		w.fileStates[scenarioPath] = currentFileStates(result.Dependencies)
+	}
+
+	w.printSummary()
+	return report, nil
+}
+
+// Summary yields the latest result of each scenario watched, in path order.
+func (w *ScenarioWatcher) Summary() *ScenarioRunReport {
+	scenarioPaths := make([]string, 0, len(w.results))
+	for scenarioPath := range w.results {
+		scenarioPaths = append(scenarioPaths, scenarioPath)
+	}
+	sort.Strings(scenarioPaths)
+
+	summary := &ScenarioRunReport{
+		Results: make([]*ScenarioResult, len(scenarioPaths)),
+	}
+	for i, scenarioPath := range scenarioPaths {
+		summary.Results[i] = w.results[scenarioPath]
+	}
+	return summary
+}
+
+func (w *ScenarioWatcher) isAffected(scenarioPath string) bool {
+	fileStates, ran := w.fileStates[scenarioPath]
+	if !ran {
+		return true
+	}
+	for filePath, state := range fileStates {
+		if !getWatchedFileState(filePath).equals(state) {
+			return true
+		}
+	}
+	return false
+}
+
+func (w *ScenarioWatcher) forgetRemoved(found map[string]bool) bool {
+	removed := false
+	for scenarioPath := range w.results {
+		if !found[scenarioPath] {
+			delete(w.results, scenarioPath)
+			delete(w.fileStates, scenarioPath)
+			removed = true
+		}
+	}
+	return removed
+}
+
+func (w *ScenarioWatcher) printSummary() {
+	summary := w.Summary()
+	w.Runner.printf("Watching %d scenarios. Passed: %d. Failed: %d. Timed out: %d. Skipped: %d.\n",
+		len(summary.Results),
+		summary.NumWithStatus(ScenarioPassed),
+		summary.NumWithStatus(ScenarioFailed),
+		summary.NumWithStatus(ScenarioTimedOut),
+		summary.NumWithStatus(ScenarioSkipped))
+	for _, result := range summary.Results {
+		if result.Status == ScenarioFailed || result.Status == ScenarioTimedOut {
+			w.Runner.printf("  %s: %s\n", result.Status, result.Path)
+		}
+	}
+}
+
+func currentFileStates(filePaths []string) map[string]watchedFileState {
+	fileStates := make(map[string]watchedFileState, len(filePaths))
+	for _, filePath := range filePaths {
+		fileStates[filePath] = getWatchedFileState(filePath)
+	}
+	return fileStates
+}
+
+// getWatchedFileState also works for missing files, so that creating a missing dependency triggers a re-run
+func getWatchedFileState(filePath string) watchedFileState {
+	fileInfo, err := os.Stat(filepath.Clean(filePath))
+	if err != nil {
+		return watchedFileState{}
+	}
+	return watchedFileState{
+		exists:  true,
+		modTime: fileInfo.ModTime(),
+		size:    fileInfo.Size(),
+	}
+}
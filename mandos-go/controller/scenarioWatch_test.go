@@ -0,0 +1,142 @@
+package mandoscontroller
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func scenarioWithCodeJSON(name string, codePath string) string {
+	return `{
+		"name": "` + name + `",
+		"steps": [
+			{
+				"step": "setState",
+				"accounts": {
+					"sc:contract": {
+						"code": "file:` + codePath + `"
+					}
+				}
+			}
+		]
+	}`
+}
+
+// touch changes the modification time, so that the change is detected even within the file system time resolution
+func touch(t *testing.T, filePath string, contents string, modTime time.Time) {
+	require.Nil(t, ioutil.WriteFile(filePath, []byte(contents), 0644))
+	require.Nil(t, os.Chtimes(filePath, modTime, modTime))
+}
+
+func resultPaths(report *ScenarioRunReport) []string {
+	if report == nil {
+		return nil
+	}
+	paths := make([]string, len(report.Results))
+	for i, result := range report.Results {
+		paths[i] = result.Path
+	}
+	return paths
+}
+
+func TestScenarioWatcher_RunChanged(t *testing.T) {
+	dir := writeTestScenarios(t, map[string]string{
+		"a.scen.json":        scenarioWithCodeJSON("ok", "contract.wasm"),
+		"b.scen.json":        scenarioWithCodeJSON("ok", "other.wasm"),
+		"sub/c.scen.json":    scenarioJSON("ok", "v3"),
+		"contract.wasm":      "contract code",
+		"other.wasm":         "other code",
+		"sub/notScenario.go": "",
+	})
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	factory := func() (ScenarioExecutor, error) {
+		return &executorStub{onClose: func() {}}, nil
+	}
+	output := &bytes.Buffer{}
+	runner := NewParallelScenarioRunner(factory, ParallelRunOptions{Output: output})
+	watcher := NewScenarioWatcher(runner, dir, ".scen.json")
+
+	// first run: everything
+	report, err := watcher.RunChanged()
+	require.Nil(t, err)
+	require.Equal(t, []string{"a.scen.json", "b.scen.json", "sub/c.scen.json"}, resultPaths(report))
+	contractPath, err := filepath.Abs(filepath.Join(dir, "contract.wasm"))
+	require.Nil(t, err)
+	require.Contains(t, report.Results[0].Dependencies, contractPath)
+	require.Contains(t, output.String(), "Watching 3 scenarios. Passed: 3. Failed: 0.")
+
+	// nothing changed
+	report, err = watcher.RunChanged()
+	require.Nil(t, err)
+	require.Nil(t, report)
+
+	// a changed dependency only re-runs the scenarios using it
+	later := time.Now().Add(time.Minute)
+	touch(t, filepath.Join(dir, "contract.wasm"), "new contract code", later)
+	report, err = watcher.RunChanged()
+	require.Nil(t, err)
+	require.Equal(t, []string{"a.scen.json"}, resultPaths(report))
+
+	// a changed scenario, that now fails, and a new one
+	output.Reset()
+	touch(t, filepath.Join(dir, "b.scen.json"), scenarioWithCodeJSON("fail", "other.wasm"), later)
+	touch(t, filepath.Join(dir, "sub/d.scen.json"), scenarioJSON("ok", "v3"), later)
+	report, err = watcher.RunChanged()
+	require.Nil(t, err)
+	require.Equal(t, []string{"b.scen.json", "sub/d.scen.json"}, resultPaths(report))
+	require.Contains(t, output.String(), "Watching 4 scenarios. Passed: 3. Failed: 1.")
+	require.Contains(t, output.String(), "  failed: b.scen.json")
+
+	// a removed scenario is no longer watched
+	require.Nil(t, os.Remove(filepath.Join(dir, "sub/c.scen.json")))
+	report, err = watcher.RunChanged()
+	require.Nil(t, err)
+	require.Nil(t, report)
+	require.Len(t, watcher.Summary().Results, 3)
+
+	// a scenario that failed to parse is re-run once its missing file appears
+	touch(t, filepath.Join(dir, "e.scen.json"), scenarioWithCodeJSON("ok", "missing.wasm"), later)
+	report, err = watcher.RunChanged()
+	require.Nil(t, err)
+	require.Equal(t, ScenarioFailed, report.Results[0].Status)
+	touch(t, filepath.Join(dir, "missing.wasm"), "code", later)
+	report, err = watcher.RunChanged()
+	require.Nil(t, err)
+	require.Equal(t, []string{"e.scen.json"}, resultPaths(report))
+	require.Equal(t, ScenarioPassed, report.Results[0].Status)
+}
+
+func TestScenarioWatcher_Watch(t *testing.T) {
+	dir := writeTestScenarios(t, map[string]string{
+		"a.scen.json": scenarioJSON("ok", "v3"),
+	})
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	factory := func() (ScenarioExecutor, error) {
+		return &executorStub{onClose: func() {}}, nil
+	}
+	runner := NewParallelScenarioRunner(factory, ParallelRunOptions{Output: ioutil.Discard})
+	watcher := NewScenarioWatcher(runner, filepath.Join(dir, "a.scen.json"), ".scen.json")
+	watcher.PollInterval = 10 * time.Millisecond
+
+	stop := make(chan struct{})
+	done := make(chan error)
+	go func() {
+		done <- watcher.Watch(stop)
+	}()
+	time.Sleep(50 * time.Millisecond)
+	close(stop)
+	require.Nil(t, <-done)
+	require.Len(t, watcher.Summary().Results, 1)
+	require.Equal(t, ScenarioPassed, watcher.Summary().Results[0].Status)
+}
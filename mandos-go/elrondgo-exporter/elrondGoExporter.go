@@ -6,6 +6,7 @@ import (
 
 	mc "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/controller"
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/esdtconvert"
+	mjparse "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/json/parse"
 	mj "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/model"
 )
 
@@ -46,7 +47,11 @@ func getInvalidScenarioWithBenchmark() ScenarioWithBenchmark {
 }
 
 func GetAccountsAndTransactionsFromMandos(mandosTestPath string) (stateAndBenchmarkInfo ScenarioWithBenchmark, err error) {
-	scenario, err := getScenario(mandosTestPath)
+	return getAccountsAndTransactionsFromMandos(mandosTestPath, nil)
+}
+
+func getAccountsAndTransactionsFromMandos(mandosTestPath string, arguments []*mj.ScenarioVariable) (stateAndBenchmarkInfo ScenarioWithBenchmark, err error) {
+	scenario, err := getScenario(mandosTestPath, arguments)
 	if err != nil {
 		return getInvalidScenarioWithBenchmark(), err
 	}
@@ -58,8 +63,9 @@ func GetAccountsAndTransactionsFromMandos(mandosTestPath string) (stateAndBenchm
 	return stateAndBenchmarkInfo, nil
 }
 
-func getScenario(testPath string) (scenario *mj.Scenario, err error) {
-	scenario, err = mc.ParseMandosScenarioDefaultParser(testPath)
+func getScenario(testPath string, arguments []*mj.ScenarioVariable) (scenario *mj.Scenario, err error) {
+	parser := mjparse.NewParser(mc.NewDefaultFileResolver())
+	scenario, err = mc.ParseMandosScenarioWithArguments(parser, testPath, arguments)
 	if err != nil {
 		return nil, err
 	}
@@ -69,6 +75,7 @@ func getScenario(testPath string) (scenario *mj.Scenario, err error) {
 func getAccountsAndTransactionsFromSteps(steps []mj.Step) (stateAndBenchmarkInfo ScenarioWithBenchmark, err error) {
 	stateAndBenchmarkInfo.BenchmarkTxPos = -1
 
+	steps = expandForEachSteps(steps)
 	if len(steps) == 0 {
 		return getInvalidScenarioWithBenchmark(), errNoStepsProvided
 	}
@@ -130,7 +137,7 @@ func getAccountsAndTransactionsFromSteps(steps []mj.Step) (stateAndBenchmarkInfo
 				}
 			}
 		case *mj.ExternalStepsStep:
-			externalStateAndBenchmarkInfo, err := GetAccountsAndTransactionsFromMandos(step.Path)
+			externalStateAndBenchmarkInfo, err := getAccountsAndTransactionsFromMandos(step.Path, step.Arguments)
 			if err != nil {
 				return getInvalidScenarioWithBenchmark(), err
 			}
@@ -149,6 +156,22 @@ func getAccountsAndTransactionsFromSteps(steps []mj.Step) (stateAndBenchmarkInfo
 	return stateAndBenchmarkInfo, nil
 }
 
+// expandForEachSteps replaces the forEach steps with the steps of all their iterations, in order.
+func expandForEachSteps(steps []mj.Step) []mj.Step {
+	expanded := make([]mj.Step, 0, len(steps))
+	for _, step := range steps {
+		forEachStep, isForEach := step.(*mj.ForEachStep)
+		if !isForEach {
+			expanded = append(expanded, step)
+			continue
+		}
+		for _, iteration := range forEachStep.Iterations {
+			expanded = append(expanded, expandForEachSteps(iteration)...)
+		}
+	}
+	return expanded
+}
+
 func getAccountsFromSetStateStep(setStateStep *mj.SetStateStep) (accounts []*TestAccount, deployedAccounts []*TestAccount, err error) {
 	accounts = make([]*TestAccount, 0)
 	deployedAccounts = make([]*TestAccount, 0)
@@ -75,3 +75,25 @@ func TestGetAccountsAndTransactionsFrom_AdderWithExternalSteps(t *testing.T) {
 	require.Equal(t, expectedTxs, sbi.Txs)
 	require.Equal(t, expectedDeployTxs, sbi.DeployTxs)
 }
+
+func TestGetAccountsAndTransactionsFrom_AdderWithForEach(t *testing.T) {
+	sbi, err := mge.GetAccountsAndTransactionsFromMandos("adder_with_for_each.scen.json")
+	require.Nil(t, err)
+
+	ownerAccount := mge.SetNewAccount(1, addressOwner, big.NewInt(48), make(map[string][]byte), make([]byte, 0), make([]byte, 0))
+	scAccount := mge.SetNewAccount(0, append(mge.ScAddressPrefix, addressAdder[mge.ScAddressPrefixLength:]...), big.NewInt(0), make(map[string][]byte), arwen.GetSCCode("../../../test/adder/output/adder.wasm"), addressOwner)
+	bobAccount := mge.SetNewAccount(3, addressBob, big.NewInt(11), make(map[string][]byte), make([]byte, 0), make([]byte, 0))
+	require.Equal(t, []*mge.TestAccount{ownerAccount, scAccount, bobAccount}, sbi.Accs)
+
+	expectedTxs := make([]*mge.Transaction, 0)
+	for _, addend := range []byte{1, 2, 7} {
+		sender := sbi.Accs[0].GetAddress()
+		if addend == 7 {
+			sender = sbi.Accs[2].GetAddress()
+		}
+		transaction := mge.CreateTransaction("add", [][]byte{{addend}}, 0, big.NewInt(0), make([]*mj.ESDTTxData, 0), sender, sbi.Accs[1].GetAddress(), 5000000, 1)
+		expectedTxs = append(expectedTxs, transaction)
+	}
+	require.Equal(t, expectedTxs, sbi.Txs)
+	require.Equal(t, mge.InvalidBenchmarkTxPos, sbi.BenchmarkTxPos)
+}
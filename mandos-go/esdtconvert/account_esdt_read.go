@@ -95,6 +95,12 @@ func GetTokenRoles(tokenName []byte, source map[string][]byte) ([][]byte, error)
 
 }
 
+// GetLastNonce returns the last nonce of the specified token, created by the account.
+func GetLastNonce(tokenIdentifier []byte, source map[string][]byte) uint64 {
+	tokenNonceKey := makeLastNonceKey(tokenIdentifier)
+	return big.NewInt(0).SetBytes(source[string(tokenNonceKey)]).Uint64()
+}
+
 // GetTokenKeys returns the storage keys of all the ESDT tokens owned by the account.
 func GetTokenKeys(source map[string][]byte) [][]byte {
 	tokenKeys := make([][]byte, 0)
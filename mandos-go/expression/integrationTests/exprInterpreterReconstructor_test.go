@@ -2,6 +2,7 @@ package mandosjsontest
 
 import (
 	"encoding/hex"
+	"math/big"
 	"testing"
 
 	mei "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/expression/interpreter"
@@ -477,6 +478,36 @@ func TestConcat(t *testing.T) {
 	require.Equal(t, []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x00}, result)
 }
 
+func TestConcatSeparatorInString(t *testing.T) {
+	ei := mei.ExprInterpreter{}
+	result, err := ei.InterpretString("str:a|b")
+	require.Nil(t, err)
+	require.Equal(t, []byte("a|b"), result)
+
+	result, err = ei.InterpretString("str:a|b||c|u8:1")
+	require.Nil(t, err)
+	require.Equal(t, []byte("a|b||c\x01"), result)
+
+	result, err = ei.InterpretString("``a|b|''c|d")
+	require.Nil(t, err)
+	require.Equal(t, []byte("a|bc|d"), result)
+
+	result, err = ei.InterpretString("str:a|true|str:b|0x63")
+	require.Nil(t, err)
+	require.Equal(t, []byte("a\x01bc"), result)
+
+	result, err = ei.InterpretString("str:a|")
+	require.Nil(t, err)
+	require.Equal(t, []byte("a|"), result)
+
+	result, err = ei.InterpretString("str:a|trueish")
+	require.Nil(t, err)
+	require.Equal(t, []byte("a|trueish"), result)
+
+	_, err = ei.InterpretString("0x61|b")
+	require.NotNil(t, err)
+}
+
 func TestKeccak256(t *testing.T) {
 	ei := mei.ExprInterpreter{}
 	result, err := ei.InterpretString("keccak256:0x01|5")
@@ -542,6 +573,141 @@ func TestKeccak256(t *testing.T) {
 
 }
 
+func TestScaledDecimal(t *testing.T) {
+	ei := mei.ExprInterpreter{}
+
+	result, err := ei.InterpretString("1.5e18")
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(1500000000000000000).Bytes(), result)
+
+	result, err = ei.InterpretString("1e3")
+	require.Nil(t, err)
+	require.Equal(t, []byte{0x03, 0xe8}, result)
+
+	result, err = ei.InterpretString("12,500e-2")
+	require.Nil(t, err)
+	require.Equal(t, []byte{125}, result)
+
+	result, err = ei.InterpretString("u64:2.5e1")
+	require.Nil(t, err)
+	require.Equal(t, []byte{0, 0, 0, 0, 0, 0, 0, 25}, result)
+
+	_, err = ei.InterpretString("1.5")
+	require.NotNil(t, err)
+
+	_, err = ei.InterpretString("1e-3")
+	require.NotNil(t, err)
+
+	_, err = ei.InterpretString("1e100000")
+	require.NotNil(t, err)
+}
+
+func TestArithmetic(t *testing.T) {
+	ei := mei.ExprInterpreter{}
+
+	result, err := ei.InterpretString("2*(1e18-5)")
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(1999999999999999990).Bytes(), result)
+
+	result, err = ei.InterpretString("10/3")
+	require.Nil(t, err)
+	require.Equal(t, []byte{3}, result)
+
+	result, err = ei.InterpretString("1 + 2 * 3")
+	require.Nil(t, err)
+	require.Equal(t, []byte{7}, result)
+
+	result, err = ei.InterpretString("0x10 + 0b1")
+	require.Nil(t, err)
+	require.Equal(t, []byte{17}, result)
+
+	result, err = ei.InterpretString("-(2+3)")
+	require.Nil(t, err)
+	require.Equal(t, []byte{0xfb}, result)
+
+	result, err = ei.InterpretString("i16:1-3")
+	require.Nil(t, err)
+	require.Equal(t, []byte{0xff, 0xfe}, result)
+
+	result, err = ei.InterpretString("biguint:100*2")
+	require.Nil(t, err)
+	require.Equal(t, []byte{0, 0, 0, 1, 200}, result)
+
+	result, err = ei.InterpretString("u8:1+2|u8:3*2")
+	require.Nil(t, err)
+	require.Equal(t, []byte{3, 6}, result)
+
+	_, err = ei.InterpretString("1/0")
+	require.NotNil(t, err)
+
+	_, err = ei.InterpretString("1-2")
+	require.NotNil(t, err)
+
+	_, err = ei.InterpretString("u8:200+100")
+	require.NotNil(t, err)
+
+	_, err = ei.InterpretString("(1+2")
+	require.NotNil(t, err)
+
+	_, err = ei.InterpretString("1+")
+	require.NotNil(t, err)
+
+	_, err = ei.InterpretString("2 3")
+	require.NotNil(t, err)
+}
+
+func TestBech32(t *testing.T) {
+	ei := mei.ExprInterpreter{}
+	er := mer.ExprReconstructor{}
+
+	alice, _ := hex.DecodeString("0139472eff6886771a982f3083da5d421f24c29181e63888228dc81ca60d69e1")
+	result, err := ei.InterpretString("bech32:erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th")
+	require.Nil(t, err)
+	require.Equal(t, alice, result)
+	require.Equal(t, "bech32:erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th", er.Reconstruct(result, mer.Bech32Hint))
+
+	result, err = ei.InterpretString("address:owner")
+	require.Nil(t, err)
+	reconstructed := er.Reconstruct(result, mer.Bech32Hint)
+	roundTrip, err := ei.InterpretString(reconstructed)
+	require.Nil(t, err)
+	require.Equal(t, result, roundTrip)
+
+	_, err = ei.InterpretString("bech32:erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6tx")
+	require.NotNil(t, err)
+
+	_, err = ei.InterpretString("bech32:")
+	require.NotNil(t, err)
+}
+
+func TestVariables(t *testing.T) {
+	ei := mei.ExprInterpreter{
+		Variables: map[string]string{
+			"user":   "alice",
+			"amount": "1e3",
+		},
+	}
+
+	result, err := ei.InterpretString("address:${user}")
+	require.Nil(t, err)
+	require.Equal(t, []byte("alice___________________________"), result)
+
+	result, err = ei.InterpretString("${amount} * 2|str:${user}")
+	require.Nil(t, err)
+	require.Equal(t, append([]byte{0x07, 0xd0}, []byte("alice")...), result)
+
+	result, err = ei.InterpretString("keccak256:str:${user}")
+	require.Nil(t, err)
+	expected, _ := ei.InterpretString("keccak256:str:alice")
+	require.Equal(t, expected, result)
+
+	_, err = ei.InterpretString("${unknown}")
+	require.NotNil(t, err)
+
+	_, err = ei.InterpretString("str:${user")
+	require.NotNil(t, err)
+}
+
 func TestFile(t *testing.T) {
 	ei := mei.ExprInterpreter{
 		FileResolver: fr.NewDefaultFileResolver(),
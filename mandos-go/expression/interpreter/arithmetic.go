@@ -0,0 +1,317 @@
+package mandosexpressioninterpreter
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// numberExpression is the result of parsing a number, or an arithmetic expression of numbers
+type numberExpression struct {
+	value *big.Int
+
+	// explicitSign is set when the expression starts with "+" or "-", which asks for a signed encoding
+	explicitSign bool
+
+	// literalBytes holds the representation of an expression made of a single unsigned literal,
+	// so that hex literals keep their leading zeros
+	literalBytes []byte
+}
+
+// unsignedBytes yields the literal representation if there is one, otherwise the minimal representation of the value
+func (number *numberExpression) unsignedBytes(strRaw string) ([]byte, error) {
+	if number.value.Sign() < 0 {
+		return []byte{}, fmt.Errorf("negative numbers not allowed in this context: %s", strRaw)
+	}
+	if number.literalBytes != nil {
+		return number.literalBytes, nil
+	}
+	return number.value.Bytes(), nil
+}
+
+type numberTokenKind int
+
+const (
+	literalToken numberTokenKind = iota
+	operatorToken
+	endToken
+)
+
+type numberToken struct {
+	kind     numberTokenKind
+	text     string
+	value    *big.Int
+	rawBytes []byte
+}
+
+// numberParser is a recursive descent parser for the arithmetic expressions:
+//
+//	expression := term (("+" | "-") term)*
+//	term       := unary (("*" | "/") unary)*
+//	unary      := ("+" | "-") unary | literal | "(" expression ")"
+//
+// Literals are hex ("0x..."), binary ("0b...") or decimal numbers, the latter optionally scaled, e.g. "1.5e18".
+// Digits can be grouped with "_" or ",". Division truncates towards zero.
+type numberParser struct {
+	tokens []*numberToken
+	index  int
+}
+
+func parseNumberExpression(strRaw string) (*numberExpression, error) {
+	tokens, err := tokenizeNumberExpression(strRaw)
+	if err != nil {
+		return nil, err
+	}
+
+	parser := &numberParser{tokens: tokens}
+	value, err := parser.parseExpression()
+	if err != nil {
+		return nil, fmt.Errorf("could not parse number expression %s: %w", strRaw, err)
+	}
+	if parser.peek().kind != endToken {
+		return nil, fmt.Errorf("could not parse number expression %s: unexpected %s", strRaw, parser.peek().text)
+	}
+
+	number := &numberExpression{
+		value:        value,
+		explicitSign: tokens[0].text == "+" || tokens[0].text == "-",
+	}
+	if len(tokens) == 2 && tokens[0].kind == literalToken {
+		number.literalBytes = tokens[0].rawBytes
+	}
+	return number, nil
+}
+
+func (parser *numberParser) peek() *numberToken {
+	return parser.tokens[parser.index]
+}
+
+func (parser *numberParser) next() *numberToken {
+	token := parser.tokens[parser.index]
+	if token.kind != endToken {
+		parser.index++
+	}
+	return token
+}
+
+func (parser *numberParser) parseExpression() (*big.Int, error) {
+	result, err := parser.parseTerm()
+	if err != nil {
+		return nil, err
+	}
+	for parser.peek().text == "+" || parser.peek().text == "-" {
+		operator := parser.next().text
+		operand, err := parser.parseTerm()
+		if err != nil {
+			return nil, err
+		}
+		if operator == "+" {
+			result.Add(result, operand)
+		} else {
+			result.Sub(result, operand)
+		}
+	}
+	return result, nil
+}
+
+func (parser *numberParser) parseTerm() (*big.Int, error) {
+	result, err := parser.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for parser.peek().text == "*" || parser.peek().text == "/" {
+		operator := parser.next().text
+		operand, err := parser.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		if operator == "*" {
+			result.Mul(result, operand)
+			continue
+		}
+		if operand.Sign() == 0 {
+			return nil, errors.New("division by zero")
+		}
+		result.Quo(result, operand)
+	}
+	return result, nil
+}
+
+func (parser *numberParser) parseUnary() (*big.Int, error) {
+	token := parser.next()
+	switch {
+	case token.kind == literalToken:
+		return big.NewInt(0).Set(token.value), nil
+	case token.text == "-":
+		operand, err := parser.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return operand.Neg(operand), nil
+	case token.text == "+":
+		return parser.parseUnary()
+	case token.text == "(":
+		result, err := parser.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+		if parser.next().text != ")" {
+			return nil, errors.New("missing closing parenthesis")
+		}
+		return result, nil
+	case token.kind == endToken:
+		return nil, errors.New("unexpected end of expression")
+	default:
+		return nil, fmt.Errorf("unexpected %s", token.text)
+	}
+}
+
+func tokenizeNumberExpression(strRaw string) ([]*numberToken, error) {
+	tokens := make([]*numberToken, 0)
+	position := 0
+	for position < len(strRaw) {
+		c := strRaw[position]
+		switch {
+		case c == ' ':
+			position++
+		case strings.IndexByte("+-*/()", c) >= 0:
+			tokens = append(tokens, &numberToken{kind: operatorToken, text: string(c)})
+			position++
+		case isDigit(c):
+			end := position + 1
+			for end < len(strRaw) && isLiteralChar(strRaw, position, end) {
+				end++
+			}
+			token, err := parseNumberLiteral(strRaw[position:end])
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, token)
+			position = end
+		default:
+			return nil, fmt.Errorf("could not parse base 10 value: %s", strRaw)
+		}
+	}
+
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("could not parse base 10 value: %s", strRaw)
+	}
+	return append(tokens, &numberToken{kind: endToken, text: "end of expression"}), nil
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// isLiteralChar also accepts the sign of an exponent, e.g. "1e-3" is still a single literal, albeit not an integer
+func isLiteralChar(strRaw string, start int, position int) bool {
+	c := strRaw[position]
+	isAfterExponent := strRaw[position-1] == 'e' || strRaw[position-1] == 'E'
+	if (c == '+' || c == '-') && isAfterExponent && !isHexLiteralPrefix(strRaw[start:]) {
+		return true
+	}
+	return isDigit(c) || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c == '_' || c == ',' || c == '.'
+}
+
+func isHexLiteralPrefix(strRaw string) bool {
+	return strings.HasPrefix(strRaw, "0x") || strings.HasPrefix(strRaw, "0X")
+}
+
+func parseNumberLiteral(literal string) (*numberToken, error) {
+	str := strings.ReplaceAll(literal, "_", "") // allow underscores, to group digits
+	str = strings.ReplaceAll(str, ",", "")      // also allow commas to group digits
+
+	// hex, the usual representation
+	if isHexLiteralPrefix(str) {
+		hexDigits := str[2:]
+		if len(hexDigits)%2 == 1 {
+			hexDigits = "0" + hexDigits
+		}
+		rawBytes, err := hex.DecodeString(hexDigits)
+		if err != nil {
+			return nil, err
+		}
+		return &numberToken{
+			kind:     literalToken,
+			text:     literal,
+			value:    big.NewInt(0).SetBytes(rawBytes),
+			rawBytes: rawBytes,
+		}, nil
+	}
+
+	// binary representation
+	if strings.HasPrefix(str, "0b") || strings.HasPrefix(str, "0B") {
+		value, parseOk := big.NewInt(0).SetString(str[2:], 2)
+		if !parseOk {
+			return nil, fmt.Errorf("could not parse binary value: %s", literal)
+		}
+		return newIntegerToken(literal, value), nil
+	}
+
+	// default: base 10, optionally scaled
+	value, err := parseScaledDecimal(str)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse base 10 value: %s", literal)
+	}
+	return newIntegerToken(literal, value), nil
+}
+
+func newIntegerToken(literal string, value *big.Int) *numberToken {
+	return &numberToken{
+		kind:     literalToken,
+		text:     literal,
+		value:    value,
+		rawBytes: value.Bytes(),
+	}
+}
+
+// parseScaledDecimal parses "digits[.digits][e[+|-]digits]", which must denote an integer
+func parseScaledDecimal(str string) (*big.Int, error) {
+	mantissa := str
+	exponent := int64(0)
+	exponentIndex := strings.IndexAny(str, "eE")
+	if exponentIndex >= 0 {
+		mantissa = str[:exponentIndex]
+		parsedExponent, ok := big.NewInt(0).SetString(str[exponentIndex+1:], 10)
+		if !ok || !parsedExponent.IsInt64() || abs(parsedExponent.Int64()) > maxDecimalExponent {
+			return nil, errors.New("invalid exponent")
+		}
+		exponent = parsedExponent.Int64()
+	}
+
+	fractionIndex := strings.IndexByte(mantissa, '.')
+	if fractionIndex >= 0 {
+		exponent -= int64(len(mantissa) - fractionIndex - 1)
+		mantissa = mantissa[:fractionIndex] + mantissa[fractionIndex+1:]
+	}
+	if len(mantissa) == 0 || strings.IndexFunc(mantissa, func(r rune) bool { return r < '0' || r > '9' }) >= 0 {
+		return nil, errors.New("invalid digits")
+	}
+
+	value, _ := big.NewInt(0).SetString(mantissa, 10)
+	if exponent >= 0 {
+		return value.Mul(value, tenToThePowerOf(exponent)), nil
+	}
+
+	quotient, remainder := big.NewInt(0).QuoRem(value, tenToThePowerOf(-exponent), big.NewInt(0))
+	if remainder.Sign() != 0 {
+		return nil, errors.New("not an integer")
+	}
+	return quotient, nil
+}
+
+// maxDecimalExponent keeps the scaled decimals within reasonable sizes
+const maxDecimalExponent = 1000
+
+func abs(value int64) int64 {
+	if value < 0 {
+		return -value
+	}
+	return value
+}
+
+func tenToThePowerOf(exponent int64) *big.Int {
+	return big.NewInt(0).Exp(big.NewInt(10), big.NewInt(exponent), nil)
+}
@@ -0,0 +1,29 @@
+package mandosexpressioninterpreter
+
+import (
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/pubkeyConverter"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+)
+
+const addressLength = 32
+
+var bech32Converter, _ = pubkeyConverter.NewBech32PubkeyConverter(addressLength, logger.GetOrCreate("mandos/interpreter"))
+
+// Generates a 32-byte address from its bech32 representation, e.g. "erd1...".
+func bech32Expression(input string) ([]byte, error) {
+	address, err := bech32Converter.Decode(input)
+	if err != nil {
+		return []byte{}, fmt.Errorf("could not decode bech32 address %s: %w", input, err)
+	}
+	return address, nil
+}
+
+// Bech32Encode yields the bech32 representation of a 32-byte address, e.g. "erd1...".
+func Bech32Encode(address []byte) (string, error) {
+	if len(address) != addressLength {
+		return "", fmt.Errorf("bech32 addresses must have %d bytes, got %d", addressLength, len(address))
+	}
+	return bech32Converter.Encode(address), nil
+}
@@ -1,7 +1,6 @@
 package mandosexpressioninterpreter
 
 import (
-	"encoding/hex"
 	"errors"
 	"fmt"
 	"math/big"
@@ -16,6 +15,7 @@ var strPrefixes = []string{"str:", "``", "''"}
 
 const addrPrefix = "address:"
 const scAddrPrefix = "sc:"
+const bech32Prefix = "bech32:"
 
 const filePrefix = "file:"
 const keccak256Prefix = "keccak256:"
@@ -32,9 +32,53 @@ const i8Prefix = "i8:"
 const biguintPrefix = "biguint:"
 const nestedPrefix = "nested:"
 
+const concatSeparator = "|"
+
+// prefixRule interprets the expressions starting with a prefix, given the rest of the expression
+type prefixRule struct {
+	prefix    string
+	interpret func(ei *ExprInterpreter, argument string) ([]byte, error)
+}
+
+// wholeExpressionRules take the entire rest of the expression as argument, concatenation included
+var wholeExpressionRules []prefixRule
+
+// partRules apply to each part of a concatenation
+var partRules []prefixRule
+
+// the rules are set up in init, since some of them interpret their argument recursively
+func init() {
+	wholeExpressionRules = []prefixRule{
+		{filePrefix, (*ExprInterpreter).interpretFile},
+		{keccak256Prefix, (*ExprInterpreter).interpretKeccak256},
+	}
+
+	partRules = []prefixRule{
+		{strPrefixes[0], interpretStr},
+		{strPrefixes[1], interpretStr},
+		{strPrefixes[2], interpretStr},
+		{addrPrefix, ignoreInterpreter(addressExpression)},
+		{scAddrPrefix, ignoreInterpreter(scExpression)},
+		{bech32Prefix, ignoreInterpreter(bech32Expression)},
+		{u64Prefix, unsignedFixedWidth(8)},
+		{u32Prefix, unsignedFixedWidth(4)},
+		{u16Prefix, unsignedFixedWidth(2)},
+		{u8Prefix, unsignedFixedWidth(1)},
+		{i64Prefix, signedFixedWidth(8)},
+		{i32Prefix, signedFixedWidth(4)},
+		{i16Prefix, signedFixedWidth(2)},
+		{i8Prefix, signedFixedWidth(1)},
+		{biguintPrefix, (*ExprInterpreter).interpretBigUint},
+		{nestedPrefix, (*ExprInterpreter).interpretNested},
+	}
+}
+
 // ExprInterpreter provides context for computing Mandos values.
 type ExprInterpreter struct {
 	FileResolver fr.FileResolver
+
+	// Variables holds the values of the scenario variables in scope, referenced in expressions as "${name}".
+	Variables map[string]string
 }
 
 // InterpretSubTree attempts to produce a value based on a JSON subtree.
@@ -82,55 +126,43 @@ func (ei *ExprInterpreter) InterpretSubTree(obj oj.OJsonObject) ([]byte, error)
 
 // InterpretString resolves a string to a byte slice according to the Mandos value format.
 // Supported rules are:
-// - numbers: decimal, hex, binary, signed/unsigned
-// - fixed length numbers: "u32:5", "i8:-3", etc.
-// - ascii strings as "str:...", "``...", "''..."
-// - "true"/"false"
-// - "address:..."
-// - "sc:..." (also an address)
-// - "file:..."
-// - "keccak256:..."
-// - concatenation using |
 //
+//	numbers                  decimal, hex, binary, signed/unsigned
+//	arithmetic               scaled decimals, e.g. "1.5e18", and + - * / with parentheses, e.g. "2*(1e18 - 5)"
+//	fixed length numbers     "u32:5", "i8:-3", etc.
+//	ascii strings            "str:...", "``...", "''..."
+//	booleans                 "true", "false"
+//	addresses                "address:...", "sc:...", "bech32:erd1..."
+//	files                    "file:..."
+//	hashes                   "keccak256:..."
+//	concatenation            "str:abc|u32:5"; a | inside a string is part of the string,
+//	                         unless another value follows it, so "str:a|b" is the string "a|b"
+//	variables                "${name}", substituted before any of the above
 func (ei *ExprInterpreter) InterpretString(strRaw string) ([]byte, error) {
-	if len(strRaw) == 0 {
-		return []byte{}, nil
+	str, err := ei.SubstituteVariables(strRaw)
+	if err != nil {
+		return []byte{}, err
 	}
+	return ei.interpretString(str)
+}
 
-	// file contents
-	// TODO: make this part of a proper parser
-	if strings.HasPrefix(strRaw, filePrefix) {
-		if ei.FileResolver == nil {
-			return []byte{}, errors.New("parser FileResolver not provided")
-		}
-		fileContents, err := ei.FileResolver.ResolveFileValue(strRaw[len(filePrefix):])
-		if err != nil {
-			return []byte{}, err
-		}
-		return fileContents, nil
+func (ei *ExprInterpreter) interpretString(strRaw string) ([]byte, error) {
+	if len(strRaw) == 0 {
+		return []byte{}, nil
 	}
 
-	// keccak256
-	// TODO: make this part of a proper parser
-	if strings.HasPrefix(strRaw, keccak256Prefix) {
-		arg, err := ei.InterpretString(strRaw[len(keccak256Prefix):])
-		if err != nil {
-			return []byte{}, fmt.Errorf("cannot parse keccak256 argument: %w", err)
-		}
-		hash, err := Keccak256(arg)
-		if err != nil {
-			return []byte{}, fmt.Errorf("error computing keccak256: %w", err)
+	for _, rule := range wholeExpressionRules {
+		if strings.HasPrefix(strRaw, rule.prefix) {
+			return rule.interpret(ei, strRaw[len(rule.prefix):])
 		}
-		return hash, nil
 	}
 
 	// concatenate values of different formats
-	// TODO: make this part of a proper parser
-	parts := strings.Split(strRaw, "|")
+	parts := splitConcatenation(strRaw)
 	if len(parts) > 1 {
 		concat := make([]byte, 0)
 		for _, part := range parts {
-			eval, err := ei.InterpretString(part)
+			eval, err := ei.interpretString(part)
 			if err != nil {
 				return []byte{}, err
 			}
@@ -139,177 +171,200 @@ func (ei *ExprInterpreter) InterpretString(strRaw string) ([]byte, error) {
 		return concat, nil
 	}
 
-	if strRaw == "false" {
-		return []byte{}, nil
+	return ei.interpretPart(strRaw)
+}
+
+// splitConcatenation splits the expression into the values it concatenates. The values end at the next |,
+// except the strings, which can contain | and only end at a | followed by the beginning of another value.
+func splitConcatenation(expression string) []string {
+	parts := make([]string, 0)
+	start := 0
+	for start <= len(expression) {
+		end := findPartEnd(expression, start)
+		parts = append(parts, expression[start:end])
+		start = end + len(concatSeparator)
+	}
+	return parts
+}
+
+// findPartEnd returns the position of the | ending the value which begins at start, or the length of the expression
+func findPartEnd(expression string, start int) int {
+	isString := hasStrPrefix(expression[start:])
+	position := start
+	for {
+		separator := strings.Index(expression[position:], concatSeparator)
+		if separator < 0 {
+			return len(expression)
+		}
+		position += separator
+		if !isString || isValueStart(expression[position+len(concatSeparator):]) {
+			return position
+		}
+		position += len(concatSeparator)
 	}
+}
 
-	if strRaw == "true" {
-		return []byte{0x01}, nil
+func hasStrPrefix(part string) bool {
+	for _, prefix := range strPrefixes {
+		if strings.HasPrefix(part, prefix) {
+			return true
+		}
 	}
+	return false
+}
 
-	// allow ascii strings, for readability
-	for _, strPrefix := range strPrefixes {
-		if strings.HasPrefix(strRaw, strPrefix) {
-			str := strRaw[len(strPrefix):]
-			return []byte(str), nil
+// isValueStart tells whether the rest of a concatenation, after a |, begins with a value other than a bare word
+func isValueStart(rest string) bool {
+	for _, rules := range [][]prefixRule{wholeExpressionRules, partRules} {
+		for _, rule := range rules {
+			if strings.HasPrefix(rest, rule.prefix) {
+				return true
+			}
 		}
 	}
 
-	// address
-	if strings.HasPrefix(strRaw, addrPrefix) {
-		addrArgument := strRaw[len(addrPrefix):]
-		return addressExpression(addrArgument)
+	for _, word := range []string{"true", "false"} {
+		if strings.HasPrefix(rest, word) {
+			next := rest[len(word):]
+			if len(next) == 0 || strings.HasPrefix(next, concatSeparator) {
+				return true
+			}
+		}
 	}
 
-	// smart contract address (different format)
-	if strings.HasPrefix(strRaw, scAddrPrefix) {
-		addrArgument := strRaw[len(scAddrPrefix):]
-		return scExpression(addrArgument)
+	// numbers and arithmetic expressions
+	return len(rest) > 0 && strings.ContainsRune("0123456789+-(", rune(rest[0]))
+}
+
+func (ei *ExprInterpreter) interpretPart(strRaw string) ([]byte, error) {
+	if strRaw == "false" {
+		return []byte{}, nil
 	}
 
-	// fixed width numbers
-	parsed, result, err := ei.tryInterpretFixedWidth(strRaw)
-	if err != nil {
-		return nil, err
+	if strRaw == "true" {
+		return []byte{0x01}, nil
 	}
-	if parsed {
-		return result, nil
+
+	for _, rule := range partRules {
+		if strings.HasPrefix(strRaw, rule.prefix) {
+			return rule.interpret(ei, strRaw[len(rule.prefix):])
+		}
 	}
 
 	// general numbers, arbitrary length
-	return ei.interpretNumber(strRaw, 0)
+	return interpretNumber(strRaw)
 }
 
-// targetWidth = 0 means minimum length that can contain the result
-func (ei *ExprInterpreter) interpretNumber(strRaw string, targetWidth int) ([]byte, error) {
-	// signed numbers
-	if strRaw[0] == '-' || strRaw[0] == '+' {
-		numberBytes, err := ei.interpretUnsignedNumber(strRaw[1:])
-		if err != nil {
-			return []byte{}, err
-		}
-		number := big.NewInt(0).SetBytes(numberBytes)
-		if strRaw[0] == '-' {
-			number = number.Neg(number)
-		}
-		if targetWidth == 0 {
-			return twos.ToBytes(number), nil
-		}
-
-		return twos.ToBytesOfLength(number, targetWidth)
+func (ei *ExprInterpreter) interpretFile(path string) ([]byte, error) {
+	if ei.FileResolver == nil {
+		return []byte{}, errors.New("parser FileResolver not provided")
 	}
-
-	// unsigned numbers
-	if targetWidth == 0 {
-		return ei.interpretUnsignedNumber(strRaw)
+	fileContents, err := ei.FileResolver.ResolveFileValue(path)
+	if err != nil {
+		return []byte{}, err
 	}
+	return fileContents, nil
+}
 
-	return ei.interpretUnsignedNumberFixedWidth(strRaw, targetWidth)
+func (ei *ExprInterpreter) interpretKeccak256(argument string) ([]byte, error) {
+	arg, err := ei.interpretString(argument)
+	if err != nil {
+		return []byte{}, fmt.Errorf("cannot parse keccak256 argument: %w", err)
+	}
+	hash, err := Keccak256(arg)
+	if err != nil {
+		return []byte{}, fmt.Errorf("error computing keccak256: %w", err)
+	}
+	return hash, nil
 }
 
-func (ei *ExprInterpreter) interpretUnsignedNumber(strRaw string) ([]byte, error) {
-	str := strings.ReplaceAll(strRaw, "_", "") // allow underscores, to group digits
-	strRaw = strings.ReplaceAll(str, ",", "")  // also allow commas to group digits
+func (ei *ExprInterpreter) interpretBigUint(argument string) ([]byte, error) {
+	biBytes, err := interpretUnsignedNumber(argument)
+	return withLengthPrefix(biBytes), err
+}
 
-	// hex, the usual representation
-	if strings.HasPrefix(strRaw, "0x") || strings.HasPrefix(strRaw, "0X") {
-		str := strRaw[2:]
-		if len(str)%2 == 1 {
-			str = "0" + str
-		}
-		return hex.DecodeString(str)
-	}
+func (ei *ExprInterpreter) interpretNested(argument string) ([]byte, error) {
+	nestedBytes, err := ei.interpretString(argument)
+	return withLengthPrefix(nestedBytes), err
+}
 
-	// binary representation
-	if strings.HasPrefix(strRaw, "0b") || strings.HasPrefix(strRaw, "0B") {
-		result := new(big.Int)
-		var parseOk bool
-		result, parseOk = result.SetString(str[2:], 2)
-		if !parseOk {
-			return []byte{}, fmt.Errorf("could not parse binary value: %s", strRaw)
-		}
+func interpretStr(_ *ExprInterpreter, str string) ([]byte, error) {
+	return []byte(str), nil
+}
 
-		return result.Bytes(), nil
+func ignoreInterpreter(interpret func(argument string) ([]byte, error)) func(*ExprInterpreter, string) ([]byte, error) {
+	return func(_ *ExprInterpreter, argument string) ([]byte, error) {
+		return interpret(argument)
 	}
+}
 
-	// default: parse as BigInt, base 10
-	result := new(big.Int)
-	var parseOk bool
-	result, parseOk = result.SetString(strRaw, 10)
-	if !parseOk {
-		return []byte{}, fmt.Errorf("could not parse base 10 value: %s", strRaw)
+func unsignedFixedWidth(width int) func(*ExprInterpreter, string) ([]byte, error) {
+	return func(_ *ExprInterpreter, argument string) ([]byte, error) {
+		return interpretUnsignedNumberFixedWidth(argument, width)
 	}
+}
 
-	if result.Sign() < 0 {
-		return []byte{}, fmt.Errorf("negative numbers not allowed in this context: %s", strRaw)
+func signedFixedWidth(width int) func(*ExprInterpreter, string) ([]byte, error) {
+	return func(_ *ExprInterpreter, argument string) ([]byte, error) {
+		return interpretNumberFixedWidth(argument, width)
 	}
+}
 
-	return result.Bytes(), nil
+// withLengthPrefix prepends the length of the value, as a 4 byte big endian number, as in the nested encoding
+func withLengthPrefix(value []byte) []byte {
+	lengthBytes := big.NewInt(int64(len(value))).Bytes()
+	encodedLength := twos.CopyAlignRight(lengthBytes, 4)
+	return append(encodedLength, value...)
 }
 
-func (ei *ExprInterpreter) interpretUnsignedNumberFixedWidth(strRaw string, targetWidth int) ([]byte, error) {
-	numberBytes, err := ei.interpretUnsignedNumber(strRaw)
+// interpretNumber yields the minimal representation of the number.
+// Numbers with an explicit sign are encoded in two's complement.
+// A single unsigned literal keeps its representation, so hex literals keep their leading zeros.
+func interpretNumber(strRaw string) ([]byte, error) {
+	number, err := parseNumberExpression(strRaw)
 	if err != nil {
 		return []byte{}, err
 	}
-	if targetWidth == 0 {
-		return numberBytes, nil
+	if number.explicitSign {
+		return twos.ToBytes(number.value), nil
 	}
+	return number.unsignedBytes(strRaw)
+}
 
-	if len(numberBytes) > targetWidth {
-		return []byte{}, fmt.Errorf("representation of %s does not fit in %d bytes", strRaw, targetWidth)
+func interpretUnsignedNumber(strRaw string) ([]byte, error) {
+	number, err := parseNumberExpression(strRaw)
+	if err != nil {
+		return []byte{}, err
 	}
-	return twos.CopyAlignRight(numberBytes, targetWidth), nil
+	return number.unsignedBytes(strRaw)
 }
 
-func (ei *ExprInterpreter) tryInterpretFixedWidth(strRaw string) (bool, []byte, error) {
-	if strings.HasPrefix(strRaw, u64Prefix) {
-		r, err := ei.interpretUnsignedNumberFixedWidth(strRaw[len(u64Prefix):], 8)
-		return true, r, err
-	}
-	if strings.HasPrefix(strRaw, u32Prefix) {
-		r, err := ei.interpretUnsignedNumberFixedWidth(strRaw[len(u32Prefix):], 4)
-		return true, r, err
-	}
-	if strings.HasPrefix(strRaw, u16Prefix) {
-		r, err := ei.interpretUnsignedNumberFixedWidth(strRaw[len(u16Prefix):], 2)
-		return true, r, err
+// interpretNumberFixedWidth encodes negative numbers, or numbers with an explicit sign, in two's complement
+func interpretNumberFixedWidth(strRaw string, targetWidth int) ([]byte, error) {
+	number, err := parseNumberExpression(strRaw)
+	if err != nil {
+		return []byte{}, err
 	}
-	if strings.HasPrefix(strRaw, u8Prefix) {
-		r, err := ei.interpretUnsignedNumberFixedWidth(strRaw[len(u8Prefix):], 1)
-		return true, r, err
+	if number.explicitSign || number.value.Sign() < 0 {
+		return twos.ToBytesOfLength(number.value, targetWidth)
 	}
+	return alignUnsignedNumber(number.value.Bytes(), strRaw, targetWidth)
+}
 
-	if strings.HasPrefix(strRaw, i64Prefix) {
-		r, err := ei.interpretNumber(strRaw[len(i64Prefix):], 8)
-		return true, r, err
-	}
-	if strings.HasPrefix(strRaw, i32Prefix) {
-		r, err := ei.interpretNumber(strRaw[len(i32Prefix):], 4)
-		return true, r, err
-	}
-	if strings.HasPrefix(strRaw, i16Prefix) {
-		r, err := ei.interpretNumber(strRaw[len(i16Prefix):], 2)
-		return true, r, err
-	}
-	if strings.HasPrefix(strRaw, i8Prefix) {
-		r, err := ei.interpretNumber(strRaw[len(i8Prefix):], 1)
-		return true, r, err
+func interpretUnsignedNumberFixedWidth(strRaw string, targetWidth int) ([]byte, error) {
+	number, err := parseNumberExpression(strRaw)
+	if err != nil {
+		return []byte{}, err
 	}
-
-	if strings.HasPrefix(strRaw, biguintPrefix) {
-		biBytes, err := ei.interpretUnsignedNumber(strRaw[len(biguintPrefix):])
-		lengthBytes := big.NewInt(int64(len(biBytes))).Bytes()
-		encodedLength := twos.CopyAlignRight(lengthBytes, 4)
-		return true, append(encodedLength, biBytes...), err
+	if number.value.Sign() < 0 {
+		return []byte{}, fmt.Errorf("negative numbers not allowed in this context: %s", strRaw)
 	}
+	return alignUnsignedNumber(number.value.Bytes(), strRaw, targetWidth)
+}
 
-	if strings.HasPrefix(strRaw, nestedPrefix) {
-		nestedBytes, err := ei.InterpretString(strRaw[len(nestedPrefix):])
-		lengthBytes := big.NewInt(int64(len(nestedBytes))).Bytes()
-		encodedLength := twos.CopyAlignRight(lengthBytes, 4)
-		return true, append(encodedLength, nestedBytes...), err
+func alignUnsignedNumber(numberBytes []byte, strRaw string, targetWidth int) ([]byte, error) {
+	if len(numberBytes) > targetWidth {
+		return []byte{}, fmt.Errorf("representation of %s does not fit in %d bytes", strRaw, targetWidth)
 	}
-
-	return false, []byte{}, nil
+	return twos.CopyAlignRight(numberBytes, targetWidth), nil
 }
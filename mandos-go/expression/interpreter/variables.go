@@ -0,0 +1,55 @@
+package mandosexpressioninterpreter
+
+import (
+	"fmt"
+	"strings"
+)
+
+const variableReferenceStart = "${"
+const variableReferenceEnd = "}"
+
+// SubstituteVariables replaces the "${name}" references in an expression with the values of the variables.
+// The values are substituted as text, so they can also be parts of an expression, e.g. "address:user${index}".
+func (ei *ExprInterpreter) SubstituteVariables(strRaw string) (string, error) {
+	if !strings.Contains(strRaw, variableReferenceStart) {
+		return strRaw, nil
+	}
+
+	var result strings.Builder
+	rest := strRaw
+	for {
+		start := strings.Index(rest, variableReferenceStart)
+		if start < 0 {
+			result.WriteString(rest)
+			return result.String(), nil
+		}
+		result.WriteString(rest[:start])
+		rest = rest[start+len(variableReferenceStart):]
+
+		end := strings.Index(rest, variableReferenceEnd)
+		if end < 0 {
+			return "", fmt.Errorf("unterminated variable reference in %s", strRaw)
+		}
+		name := rest[:end]
+		value, found := ei.Variables[name]
+		if !found {
+			return "", fmt.Errorf("unknown variable %s in %s", name, strRaw)
+		}
+		result.WriteString(value)
+		rest = rest[end+len(variableReferenceEnd):]
+	}
+}
+
+// IsValidVariableName checks that a variable name is made of letters, digits and underscores.
+func IsValidVariableName(name string) bool {
+	if len(name) == 0 {
+		return false
+	}
+	for _, c := range name {
+		isLetter := (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+		if !isLetter && !(c >= '0' && c <= '9') && c != '_' {
+			return false
+		}
+	}
+	return true
+}
@@ -28,6 +28,9 @@ const (
 
 	// CodeHint hints that value should be a smart contract code, normally loaded from a file
 	CodeHint
+
+	// Bech32Hint hints that value should be an address, written in its bech32 representation, "bech32:erd1..."
+	Bech32Hint
 )
 
 const maxBytesInterpretedAsNumber = 15
@@ -45,6 +48,8 @@ func (er *ExprReconstructor) Reconstruct(value []byte, hint ExprReconstructorHin
 		return addressPretty(value)
 	case CodeHint:
 		return codePretty(value)
+	case Bech32Hint:
+		return bech32Pretty(value)
 	default:
 		return unknownByteArrayPretty(value)
 	}
@@ -122,6 +127,14 @@ func addressPretty(value []byte) string {
 	}
 }
 
+func bech32Pretty(value []byte) string {
+	encoded, err := ei.Bech32Encode(value)
+	if err != nil {
+		return unknownByteArrayPretty(value)
+	}
+	return "bech32:" + encoded
+}
+
 func canInterpretAsString(bytes []byte) bool {
 	if len(bytes) == 0 {
 		return false
@@ -146,3 +159,114 @@ func codePretty(bytes []byte) string {
 
 	return fmt.Sprintf("0x%s", encoded)
 }
+
+var strPrefixes = []string{"str:", "``", "''"}
+
+var fixedWidthUnsignedPrefixes = map[string]int{
+	"u64:": 8,
+	"u32:": 4,
+	"u16:": 2,
+	"u8:":  1,
+}
+
+// ReconstructLike yields a valid Mandos expression for the value, written in the same notation as the original expression,
+// e.g. a value that was originally "str:..." stays a string, a decimal number stays decimal.
+// The hint is used when the notation of the original cannot hold the new value, or is not known.
+// Unlike Reconstruct, the result can always be interpreted back to the same value.
+func (er *ExprReconstructor) ReconstructLike(value []byte, original string, hint ExprReconstructorHint) string {
+	if strings.Contains(original, "|") {
+		return er.ReconstructExpression(value, hint)
+	}
+
+	for _, prefix := range strPrefixes {
+		if strings.HasPrefix(original, prefix) && canWriteAsString(value) {
+			return prefix + string(value)
+		}
+	}
+
+	for prefix, width := range fixedWidthUnsignedPrefixes {
+		if strings.HasPrefix(original, prefix) && len(value) == width {
+			return prefix + big.NewInt(0).SetBytes(value).String()
+		}
+	}
+
+	switch {
+	case strings.HasPrefix(original, "address:") || strings.HasPrefix(original, "sc:"):
+		return er.ReconstructExpression(value, AddressHint)
+	case strings.HasPrefix(original, "bech32:"):
+		return er.ReconstructExpression(value, Bech32Hint)
+	case strings.HasPrefix(original, "0x"):
+		return hexExpression(value)
+	case isDecimalNumber(original) && canWriteAsNumber(value):
+		return big.NewInt(0).SetBytes(value).String()
+	}
+
+	return er.ReconstructExpression(value, hint)
+}
+
+// ReconstructExpression is similar to Reconstruct, but always yields a valid Mandos expression for the value.
+func (er *ExprReconstructor) ReconstructExpression(value []byte, hint ExprReconstructorHint) string {
+	if len(value) == 0 {
+		return ""
+	}
+
+	switch hint {
+	case NumberHint:
+		if canWriteAsNumber(value) {
+			return big.NewInt(0).SetBytes(value).String()
+		}
+	case StrHint:
+		if canWriteAsString(value) {
+			return "str:" + string(value)
+		}
+	case AddressHint:
+		if len(value) == 32 {
+			address := addressPretty(value)
+			if canWriteAsString([]byte(address)) {
+				return address
+			}
+		}
+	case Bech32Hint:
+		if len(value) == 32 {
+			return bech32Pretty(value)
+		}
+	case NoHint:
+		if len(value) > 4 && canWriteAsString(value) {
+			return "str:" + string(value)
+		}
+		if len(value) < maxBytesInterpretedAsNumber && canWriteAsNumber(value) {
+			return big.NewInt(0).SetBytes(value).String()
+		}
+	}
+
+	return hexExpression(value)
+}
+
+func hexExpression(value []byte) string {
+	if len(value) == 0 {
+		return ""
+	}
+	return "0x" + hex.EncodeToString(value)
+}
+
+// canWriteAsNumber is false for values with leading zeros, since numbers are interpreted in their minimal representation
+func canWriteAsNumber(value []byte) bool {
+	return len(value) == 0 || value[0] != 0
+}
+
+// canWriteAsString excludes "|", which would be interpreted as a concatenation
+func canWriteAsString(value []byte) bool {
+	return len(value) == 0 || (canInterpretAsString(value) && !bytes.Contains(value, []byte("|")))
+}
+
+func isDecimalNumber(str string) bool {
+	if len(str) == 0 {
+		return false
+	}
+	for _, c := range str {
+		if (c < '0' || c > '9') && c != ',' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,66 @@
+package mandosexpressionreconstructor
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	ei "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/expression/interpreter"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReconstructLike(t *testing.T) {
+	er := ExprReconstructor{}
+	require.Equal(t, "str:new", er.ReconstructLike([]byte("new"), "str:old", NoHint))
+	require.Equal(t, "''new", er.ReconstructLike([]byte("new"), "''old", NoHint))
+	require.Equal(t, "0x01ff", er.ReconstructLike([]byte{1, 255}, "str:old", StrHint))
+	require.Equal(t, "511", er.ReconstructLike([]byte{1, 255}, "1,000", NoHint))
+	require.Equal(t, "0x0001", er.ReconstructLike([]byte{0, 1}, "5", NoHint))
+	require.Equal(t, "0x6e6577", er.ReconstructLike([]byte("new"), "0x00", NoHint))
+	require.Equal(t, "u32:7", er.ReconstructLike([]byte{0, 0, 0, 7}, "u32:5", NoHint))
+	require.Equal(t, "sc:adder", er.ReconstructLike(scAddress("adder"), "address:owner", NoHint))
+	require.Equal(t, "0x617c62", er.ReconstructLike([]byte("a|b"), "str:a|u8:1", StrHint))
+	require.Equal(t, "0x617c62", er.ReconstructLike([]byte("a|b"), "str:old", StrHint))
+	require.Equal(t, "", er.ReconstructLike([]byte{}, "", NoHint))
+
+	bech32Address := er.ReconstructExpression(scAddress("adder"), Bech32Hint)
+	require.True(t, strings.HasPrefix(bech32Address, "bech32:erd1"))
+	require.Equal(t, bech32Address, er.ReconstructLike(scAddress("adder"), "bech32:erd1old", NoHint))
+	require.Equal(t, "0x0102", er.ReconstructLike([]byte{1, 2}, "bech32:erd1old", NoHint))
+}
+
+func TestReconstructBech32(t *testing.T) {
+	er := ExprReconstructor{}
+	alice, _ := hex.DecodeString("0139472eff6886771a982f3083da5d421f24c29181e63888228dc81ca60d69e1")
+	require.Equal(t,
+		"bech32:erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th",
+		er.Reconstruct(alice, Bech32Hint))
+	require.Equal(t, "0x0102 (258)", er.Reconstruct([]byte{1, 2}, Bech32Hint))
+}
+
+func TestReconstructExpression(t *testing.T) {
+	er := ExprReconstructor{}
+	require.Equal(t, "str:hello", er.ReconstructExpression([]byte("hello"), NoHint))
+	require.Equal(t, "1000", er.ReconstructExpression([]byte{3, 232}, NoHint))
+	require.Equal(t, "0x000102", er.ReconstructExpression([]byte{0, 1, 2}, NoHint))
+	require.Equal(t, "str:x", er.ReconstructExpression([]byte("x"), StrHint))
+	require.Equal(t, "0x0102", er.ReconstructExpression([]byte{1, 2}, AddressHint))
+
+	interpreter := ei.ExprInterpreter{}
+	for _, value := range [][]byte{[]byte("hello"), {3, 232}, {0, 1, 2}, scAddress("adder"), []byte("a|b")} {
+		for _, hint := range []ExprReconstructorHint{NoHint, NumberHint, StrHint, AddressHint, CodeHint, Bech32Hint} {
+			interpreted, err := interpreter.InterpretString(er.ReconstructExpression(value, hint))
+			require.Nil(t, err)
+			require.Equal(t, value, interpreted)
+		}
+	}
+}
+
+func scAddress(name string) []byte {
+	address := make([]byte, 32)
+	copy(address[ei.SCAddressNumLeadingZeros:], name)
+	for i := ei.SCAddressNumLeadingZeros + len(name); i < 32; i++ {
+		address[i] = '_'
+	}
+	return address
+}
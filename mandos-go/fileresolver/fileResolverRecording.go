@@ -0,0 +1,85 @@
+package mandosfileresolver
+
+import (
+	"path/filepath"
+	"sort"
+	"sync"
+)
+
+var _ FileResolver = (*RecordingFileResolver)(nil)
+
+// RecordingFileResolver resolves paths using another FileResolver and remembers all the paths it resolved,
+// i.e. the "file:" values and the externalSteps of a scenario.
+// Clones share the recorded paths, so the files used by external steps are also recorded.
+type RecordingFileResolver struct {
+	wrapped  FileResolver
+	recorded *recordedPaths
+}
+
+type recordedPaths struct {
+	mutex sync.Mutex
+	paths map[string]bool
+}
+
+// NewRecordingFileResolver yields a new RecordingFileResolver instance.
+func NewRecordingFileResolver(wrapped FileResolver) *RecordingFileResolver {
+	return &RecordingFileResolver{
+		wrapped: wrapped,
+		recorded: &recordedPaths{
+			paths: make(map[string]bool),
+		},
+	}
+}
+
+// Clone creates new instance of the same type, which records to the same paths.
+func (fr *RecordingFileResolver) Clone() FileResolver {
+	return &RecordingFileResolver{
+		wrapped:  fr.wrapped.Clone(),
+		recorded: fr.recorded,
+	}
+}
+
+// SetContext sets directory where the test runs, to help resolve relative paths.
+func (fr *RecordingFileResolver) SetContext(contextPath string) {
+	fr.wrapped.SetContext(contextPath)
+}
+
+// ResolveAbsolutePath yields absolute value based on context, and records it.
+func (fr *RecordingFileResolver) ResolveAbsolutePath(value string) string {
+	fullPath := fr.wrapped.ResolveAbsolutePath(value)
+	fr.record(fullPath)
+	return fullPath
+}
+
+// ResolveFileValue converts a value prefixed with "file:" and replaces it with the file contents.
+// The path of the file is recorded even if it cannot be read, since it might be created later.
+func (fr *RecordingFileResolver) ResolveFileValue(value string) ([]byte, error) {
+	if len(value) > 0 {
+		fr.record(fr.wrapped.ResolveAbsolutePath(value))
+	}
+	return fr.wrapped.ResolveFileValue(value)
+}
+
+// RecordedPaths yields the absolute paths resolved so far, sorted.
+func (fr *RecordingFileResolver) RecordedPaths() []string {
+	fr.recorded.mutex.Lock()
+	defer fr.recorded.mutex.Unlock()
+
+	paths := make([]string, 0, len(fr.recorded.paths))
+	for path := range fr.recorded.paths {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+func (fr *RecordingFileResolver) record(path string) {
+	absolutePath, err := filepath.Abs(path)
+	if err == nil {
+		path = absolutePath
+	}
+
+	fr.recorded.mutex.Lock()
+	defer fr.recorded.mutex.Unlock()
+	fr.recorded.paths[path] = true
+}
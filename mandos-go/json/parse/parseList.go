@@ -67,7 +67,15 @@ func (p *Parser) parseCheckValueList(obj oj.OJsonObject) (mj.JSONCheckValueList,
 		return mj.JSONCheckValueList{}, errors.New("not a JSON list")
 	}
 	var values []mj.JSONCheckBytes
+	moreAllowedAtEnd := false
 	for _, elemRaw := range listRaw.AsList() {
+		if moreAllowedAtEnd {
+			return mj.JSONCheckValueList{}, errors.New("\"+\" is only allowed at the end of the list")
+		}
+		if isMoreAllowed(elemRaw) {
+			moreAllowedAtEnd = true
+			continue
+		}
 		checkBytes, err := p.parseCheckBytes(elemRaw)
 		if err != nil {
 			return mj.JSONCheckValueList{}, err
@@ -75,6 +83,12 @@ func (p *Parser) parseCheckValueList(obj oj.OJsonObject) (mj.JSONCheckValueList,
 		values = append(values, checkBytes)
 	}
 	return mj.JSONCheckValueList{
-		Values: values,
+		Values:           values,
+		MoreAllowedAtEnd: moreAllowedAtEnd,
 	}, nil
 }
+
+func isMoreAllowed(obj oj.OJsonObject) bool {
+	str, isStr := obj.(*oj.OJsonString)
+	return isStr && str.Value == "+"
+}
@@ -16,9 +16,13 @@ func (p *Parser) processLogList(logsRaw oj.OJsonObject) (mj.LogList, error) {
 		}, nil
 	}
 
+	if logsMap, isMap := logsRaw.(*oj.OJsonMap); isMap {
+		return p.processLogEvents(logsMap)
+	}
+
 	logList, isList := logsRaw.(*oj.OJsonList)
 	if !isList {
-		return mj.LogList{}, errors.New("unmarshalled logs list is not a list")
+		return mj.LogList{}, errors.New("unmarshalled logs list is not a list or an object")
 	}
 	result := mj.LogList{
 		IsUnspecified:    false,
@@ -26,7 +30,6 @@ func (p *Parser) processLogList(logsRaw oj.OJsonObject) (mj.LogList, error) {
 		MoreAllowedAtEnd: false,
 		List:             nil,
 	}
-	var err error
 	for _, logRaw := range logList.AsList() {
 		switch logItem := logRaw.(type) {
 		case *oj.OJsonString:
@@ -42,29 +45,9 @@ func (p *Parser) processLogList(logsRaw oj.OJsonObject) (mj.LogList, error) {
 
 			logEntry := mj.LogEntry{}
 			for _, kvp := range logItem.OrderedKV {
-				switch kvp.Key {
-				case "address":
-					logEntry.Address, err = p.parseCheckBytes(kvp.Value)
-					if err != nil {
-						return mj.LogList{}, fmt.Errorf("invalid log address: %w", err)
-					}
-				case "endpoint":
-					logEntry.Endpoint, err = p.parseCheckBytes(kvp.Value)
-					if err != nil {
-						return mj.LogList{}, fmt.Errorf("invalid log identifier: %w", err)
-					}
-				case "topics":
-					logEntry.Topics, err = p.parseCheckValueList(kvp.Value)
-					if err != nil {
-						return mj.LogList{}, fmt.Errorf("invalid log entry topics: %w", err)
-					}
-				case "data":
-					logEntry.Data, err = p.parseCheckBytes(kvp.Value)
-					if err != nil {
-						return mj.LogList{}, fmt.Errorf("invalid log data: %w", err)
-					}
-				default:
-					return mj.LogList{}, fmt.Errorf("unknown log field: %s", kvp.Key)
+				err := p.processLogEntryField(&logEntry, kvp)
+				if err != nil {
+					return mj.LogList{}, err
 				}
 			}
 			result.List = append(result.List, &logEntry)
@@ -75,3 +58,125 @@ func (p *Parser) processLogList(logsRaw oj.OJsonObject) (mj.LogList, error) {
 
 	return result, nil
 }
+
+func (p *Parser) processLogEvents(logsMap *oj.OJsonMap) (mj.LogList, error) {
+	result := mj.LogList{
+		MatchEvents: true,
+		Events:      []*mj.LogEvent{},
+	}
+	for _, kvp := range logsMap.OrderedKV {
+		switch kvp.Key {
+		case "events":
+			eventList, isList := kvp.Value.(*oj.OJsonList)
+			if !isList {
+				return mj.LogList{}, errors.New("log events are not a list")
+			}
+			for _, eventRaw := range eventList.AsList() {
+				event, err := p.processLogEvent(eventRaw)
+				if err != nil {
+					return mj.LogList{}, err
+				}
+				result.Events = append(result.Events, event)
+			}
+		default:
+			return mj.LogList{}, fmt.Errorf("unknown logs field: %s", kvp.Key)
+		}
+	}
+	return result, nil
+}
+
+func (p *Parser) processLogEvent(eventRaw oj.OJsonObject) (*mj.LogEvent, error) {
+	eventMap, isMap := eventRaw.(*oj.OJsonMap)
+	if !isMap {
+		return nil, errors.New("log event is not an object")
+	}
+
+	event := &mj.LogEvent{
+		LogEntry: mj.LogEntry{
+			Address:  unspecifiedStar(),
+			Endpoint: unspecifiedStar(),
+			Topics: mj.JSONCheckValueList{
+				IsStar:      true,
+				Unspecified: true,
+			},
+			Data: unspecifiedStar(),
+		},
+		Count: mj.JSONCheckUint64Unspecified(),
+	}
+	var err error
+	for _, kvp := range eventMap.OrderedKV {
+		if kvp.Key == "count" {
+			event.Count, err = p.processCheckUint64(kvp.Value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid log event count: %w", err)
+			}
+			continue
+		}
+		err = p.processLogEntryField(&event.LogEntry, kvp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return event, nil
+}
+
+// unspecifiedStar is the default of the log event fields, which match any value unless specified
+func unspecifiedStar() mj.JSONCheckBytes {
+	star := mj.JSONCheckBytesStar()
+	star.Unspecified = true
+	return star
+}
+
+func (p *Parser) processLogEntryField(logEntry *mj.LogEntry, kvp *oj.OJsonKeyValuePair) error {
+	var err error
+	switch kvp.Key {
+	case "address":
+		logEntry.Address, err = p.parseCheckBytes(kvp.Value)
+		if err != nil {
+			return fmt.Errorf("invalid log address: %w", err)
+		}
+	case "endpoint":
+		logEntry.Endpoint, err = p.parseCheckBytes(kvp.Value)
+		if err != nil {
+			return fmt.Errorf("invalid log identifier: %w", err)
+		}
+	case "topics":
+		logEntry.Topics, err = p.parseCheckValueList(kvp.Value)
+		if err != nil {
+			return fmt.Errorf("invalid log entry topics: %w", err)
+		}
+	case "topicTypes":
+		logEntry.TopicTypes, err = p.processABITypes(kvp.Value)
+		if err != nil {
+			return fmt.Errorf("invalid log entry topic types: %w", err)
+		}
+	case "data":
+		logEntry.Data, err = p.parseCheckBytes(kvp.Value)
+		if err != nil {
+			return fmt.Errorf("invalid log data: %w", err)
+		}
+	default:
+		return fmt.Errorf("unknown log field: %s", kvp.Key)
+	}
+	return nil
+}
+
+func (p *Parser) processABITypes(typesRaw oj.OJsonObject) ([]mj.ABIType, error) {
+	typeList, isList := typesRaw.(*oj.OJsonList)
+	if !isList {
+		return nil, errors.New("not a JSON list")
+	}
+	var abiTypes []mj.ABIType
+	for _, typeRaw := range typeList.AsList() {
+		typeName, err := p.parseString(typeRaw)
+		if err != nil {
+			return nil, err
+		}
+		abiType, err := mj.ParseABIType(typeName)
+		if err != nil {
+			return nil, err
+		}
+		abiTypes = append(abiTypes, abiType)
+	}
+	return abiTypes, nil
+}
@@ -0,0 +1,140 @@
+package mandosjsonparse
+
+import (
+	"testing"
+
+	mj "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/model"
+	"github.com/stretchr/testify/require"
+)
+
+func parseExpectedLogs(t *testing.T, logsJSON string) (mj.LogList, error) {
+	snippet := `
+	{
+		"step": "scCall",
+		"txId": "1",
+		"tx": {
+			"from": "address:owner",
+			"to": "sc:contract",
+			"function": "doSomething",
+			"arguments": [],
+			"gasLimit": "0x100000",
+			"gasPrice": "0"
+		},
+		"expect": {
+			"out": [],
+			"status": "",
+			"logs": ` + logsJSON + `
+		}
+	}`
+
+	p := Parser{}
+	step, err := p.ParseScenarioStep(snippet)
+	if err != nil {
+		return mj.LogList{}, err
+	}
+	txStep, isTx := step.(*mj.TxStep)
+	require.True(t, isTx)
+	return txStep.ExpectedResult.Logs, nil
+}
+
+func TestParseLogs_Events(t *testing.T) {
+	logs, err := parseExpectedLogs(t, `{
+		"events": [
+			{
+				"endpoint": "str:transfer",
+				"topics": ["address:owner", "*", "+"],
+				"topicTypes": ["Address", "u64"],
+				"count": "2"
+			},
+			{
+				"endpoint": "str:other"
+			}
+		]
+	}`)
+	require.Nil(t, err)
+	require.True(t, logs.MatchEvents)
+	require.Len(t, logs.Events, 2)
+
+	event := logs.Events[0]
+	require.Equal(t, []byte("transfer"), event.Endpoint.Value)
+	require.True(t, event.Address.IsStar)
+	require.True(t, event.Data.IsStar)
+	require.True(t, event.Topics.MoreAllowedAtEnd)
+	require.Len(t, event.Topics.Values, 2)
+	require.True(t, event.Topics.Values[1].IsStar)
+	require.Equal(t, []mj.ABIType{"Address", "u64"}, event.TopicTypes)
+	require.Equal(t, uint64(2), event.Count.Value)
+
+	event = logs.Events[1]
+	require.True(t, event.Topics.IsStar)
+	require.True(t, event.Count.IsUnspecified())
+	require.True(t, event.Check([]byte("any address"), []byte("other"), [][]byte{[]byte("any topic")}, nil))
+}
+
+func TestParseLogs_TopicsPrefix(t *testing.T) {
+	logs, err := parseExpectedLogs(t, `[
+		{
+			"address": "sc:contract",
+			"endpoint": "str:transfer",
+			"topics": ["str:first", "+"],
+			"data": ""
+		}
+	]`)
+	require.Nil(t, err)
+	require.False(t, logs.MatchEvents)
+	require.Len(t, logs.List, 1)
+
+	logEntry := logs.List[0]
+	require.True(t, logEntry.CheckTopics([][]byte{[]byte("first")}))
+	require.True(t, logEntry.CheckTopics([][]byte{[]byte("first"), []byte("second")}))
+	require.False(t, logEntry.CheckTopics([][]byte{[]byte("second"), []byte("first")}))
+	require.False(t, logEntry.CheckTopics(nil))
+}
+
+func TestParseLogs_TopicTypes(t *testing.T) {
+	logs, err := parseExpectedLogs(t, `{
+		"events": [
+			{
+				"topics": ["1000", "-1", "str:TOKEN-123456"],
+				"topicTypes": ["u64", "i32", "TokenIdentifier"]
+			}
+		]
+	}`)
+	require.Nil(t, err)
+
+	event := logs.Events[0]
+	require.True(t, event.CheckTopics([][]byte{
+		{0, 0, 0, 0, 0, 0, 0x03, 0xe8},
+		{0xff, 0xff, 0xff, 0xff},
+		[]byte("TOKEN-123456"),
+	}))
+	require.False(t, event.CheckTopics([][]byte{
+		{0, 0, 0, 0, 0, 0, 0x03, 0xe9},
+		{0xff, 0xff, 0xff, 0xff},
+		[]byte("TOKEN-123456"),
+	}))
+	// too long for a u64
+	require.False(t, event.CheckTopics([][]byte{
+		{0, 0, 0, 0, 0, 0, 0, 0, 0x03, 0xe8},
+		{0xff, 0xff, 0xff, 0xff},
+		[]byte("TOKEN-123456"),
+	}))
+}
+
+func TestParseLogs_Errors(t *testing.T) {
+	_, err := parseExpectedLogs(t, `{"events": [{"topicTypes": ["u128"]}]}`)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unknown ABI type: u128")
+
+	_, err = parseExpectedLogs(t, `{"events": [{"topics": ["+", "str:first"]}]}`)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `"+" is only allowed at the end of the list`)
+
+	_, err = parseExpectedLogs(t, `{"eventz": []}`)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unknown logs field: eventz")
+
+	_, err = parseExpectedLogs(t, `{"events": [{"count": "many"}]}`)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "invalid log event count")
+}
@@ -3,18 +3,37 @@ package mandosjsonparse
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	mj "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/model"
 	oj "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/orderedjson"
 )
 
+const gasScheduleFilePrefix = "file:"
+
 // ParseScenarioFile converts a scenario json string to scenario object representation
 func (p *Parser) ParseScenarioFile(jsonString []byte) (*mj.Scenario, error) {
+	return p.ParseScenarioFileWithArguments(jsonString, nil)
+}
+
+// ParseScenarioFileWithArguments converts a scenario json string to scenario object representation,
+// with the arguments bound as variables. Arguments take precedence over the variables declared by the scenario.
+func (p *Parser) ParseScenarioFileWithArguments(jsonString []byte, arguments []*mj.ScenarioVariable) (*mj.Scenario, error) {
 	jobj, err := oj.ParseOrderedJSON(jsonString)
 	if err != nil {
 		return nil, err
 	}
 
+	return p.processScenario(jobj, arguments)
+}
+
+// ParseScenarioOrderedJSON converts a scenario that is already an ordered JSON tree, e.g. one built in code.
+func (p *Parser) ParseScenarioOrderedJSON(jobj oj.OJsonObject) (*mj.Scenario, error) {
+	return p.processScenario(jobj, nil)
+}
+
+func (p *Parser) processScenario(jobj oj.OJsonObject, arguments []*mj.ScenarioVariable) (*mj.Scenario, error) {
+	var err error
 	topMap, isMap := jobj.(*oj.OJsonMap)
 	if !isMap {
 		return nil, errors.New("unmarshalled test top level object is not a map")
@@ -26,6 +45,19 @@ func (p *Parser) ParseScenarioFile(jsonString []byte) (*mj.Scenario, error) {
 		GasSchedule: mj.GasScheduleDefault,
 	}
 
+	restoreVariables := p.bindVariables(arguments)
+	defer restoreVariables()
+
+	// variables come first, since all other fields can reference them
+	for _, kvp := range topMap.OrderedKV {
+		if kvp.Key == "variables" {
+			scenario.Variables, err = p.processScenarioVariables(kvp.Value, arguments)
+			if err != nil {
+				return nil, fmt.Errorf("bad scenario variables: %w", err)
+			}
+		}
+	}
+
 	for _, kvp := range topMap.OrderedKV {
 		switch kvp.Key {
 		case "name":
@@ -51,10 +83,11 @@ func (p *Parser) ParseScenarioFile(jsonString []byte) (*mj.Scenario, error) {
 			}
 			scenario.TraceGas = bool(*traceGasOJ)
 		case "gasSchedule":
-			scenario.GasSchedule, err = p.parseGasSchedule(kvp.Value)
+			err = p.processGasSchedule(kvp.Value, scenario)
 			if err != nil {
 				return nil, fmt.Errorf("bad scenario gasSchedule: %w", err)
 			}
+		case "variables":
 		case "steps":
 			scenario.Steps, err = p.processScenarioStepList(kvp.Value)
 			if err != nil {
@@ -67,11 +100,59 @@ func (p *Parser) ParseScenarioFile(jsonString []byte) (*mj.Scenario, error) {
 	return scenario, nil
 }
 
-func (p *Parser) parseGasSchedule(value oj.OJsonObject) (mj.GasSchedule, error) {
+// processGasSchedule accepts either the name of a gas schedule, a "file:..." reference to a TOML gas schedule,
+// or a map with the base schedule, under "base", and the costs it replaces, under "overrides".
+func (p *Parser) processGasSchedule(value oj.OJsonObject, scenario *mj.Scenario) error {
+	gasScheduleMap, isMap := value.(*oj.OJsonMap)
+	if !isMap {
+		return p.processGasScheduleBase(value, scenario)
+	}
+
+	for _, kvp := range gasScheduleMap.OrderedKV {
+		switch kvp.Key {
+		case "base":
+			err := p.processGasScheduleBase(kvp.Value, scenario)
+			if err != nil {
+				return err
+			}
+		case "overrides":
+			overridesMap, isMap := kvp.Value.(*oj.OJsonMap)
+			if !isMap {
+				return errors.New("gasSchedule overrides not a map")
+			}
+			for _, overrideKvp := range overridesMap.OrderedKV {
+				cost, err := p.processUint64(overrideKvp.Value)
+				if err != nil {
+					return fmt.Errorf("invalid gasSchedule override %s: %w", overrideKvp.Key, err)
+				}
+				scenario.GasScheduleOverrides = append(scenario.GasScheduleOverrides, &mj.GasScheduleOverride{
+					Key:   overrideKvp.Key,
+					Value: cost,
+				})
+			}
+		default:
+			return fmt.Errorf("unknown gasSchedule field: %s", kvp.Key)
+		}
+	}
+	return nil
+}
+
+func (p *Parser) processGasScheduleBase(value oj.OJsonObject, scenario *mj.Scenario) error {
 	gasScheduleStr, err := p.parseString(value)
 	if err != nil {
-		return mj.GasScheduleDummy, fmt.Errorf("gasSchedule type not a string: %w", err)
+		return fmt.Errorf("gasSchedule type not a string: %w", err)
 	}
+	if strings.HasPrefix(gasScheduleStr, gasScheduleFilePrefix) {
+		scenario.GasSchedule = mj.GasScheduleFile
+		scenario.GasScheduleFile, err = p.processStringAsByteArray(value)
+		return err
+	}
+
+	scenario.GasSchedule, err = p.parseGasSchedule(gasScheduleStr)
+	return err
+}
+
+func (p *Parser) parseGasSchedule(gasScheduleStr string) (mj.GasSchedule, error) {
 	switch gasScheduleStr {
 	case "default":
 		return mj.GasScheduleDefault, nil
@@ -159,11 +240,18 @@ func (p *Parser) processScenarioStep(stepObj oj.OJsonObject) (mj.Step, error) {
 				if err != nil {
 					return nil, fmt.Errorf("bad externalSteps path: %w", err)
 				}
+			case "arguments":
+				step.Arguments, err = p.processVariableMap(kvp.Value)
+				if err != nil {
+					return nil, fmt.Errorf("bad externalSteps arguments: %w", err)
+				}
 			default:
 				return nil, fmt.Errorf("invalid externalSteps field: %s", kvp.Key)
 			}
 		}
 		return step, nil
+	case mj.StepNameForEach:
+		return p.processForEachStep(stepMap)
 	case mj.StepNameSetState:
 		step := &mj.SetStateStep{}
 		for _, kvp := range stepMap.OrderedKV {
@@ -0,0 +1,64 @@
+package mandosjsonparse
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	fr "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/fileresolver"
+	mj "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseScenarioGasSchedule(t *testing.T) {
+	p := Parser{}
+	scenario, err := p.ParseScenarioFile([]byte(`{"gasSchedule": "v3", "steps": []}`))
+	require.Nil(t, err)
+	require.Equal(t, mj.GasScheduleV3, scenario.GasSchedule)
+	require.Len(t, scenario.GasScheduleOverrides, 0)
+
+	scenario, err = p.ParseScenarioFile([]byte(`{
+		"gasSchedule": {
+			"base": "v4",
+			"overrides": {
+				"BaseOperationCost.StorePerByte": "5",
+				"GetNumArguments": "1,000"
+			}
+		},
+		"steps": []
+	}`))
+	require.Nil(t, err)
+	require.Equal(t, mj.GasScheduleV4, scenario.GasSchedule)
+	require.Len(t, scenario.GasScheduleOverrides, 2)
+	require.Equal(t, "BaseOperationCost.StorePerByte", scenario.GasScheduleOverrides[0].Key)
+	require.Equal(t, uint64(5), scenario.GasScheduleOverrides[0].Value.Value)
+	require.Equal(t, uint64(1000), scenario.GasScheduleOverrides[1].Value.Value)
+
+	_, err = p.ParseScenarioFile([]byte(`{"gasSchedule": {"base": "v4", "other": {}}, "steps": []}`))
+	require.NotNil(t, err)
+	_, err = p.ParseScenarioFile([]byte(`{"gasSchedule": {"overrides": {"GetCaller": "x"}}, "steps": []}`))
+	require.NotNil(t, err)
+}
+
+func TestParseScenarioGasScheduleFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mandos-gas")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	gasScheduleContents := "[BaseOperationCost]\n    StorePerByte = 3\n"
+	require.Nil(t, ioutil.WriteFile(filepath.Join(dir, "gas.toml"), []byte(gasScheduleContents), 0644))
+
+	fileResolver := fr.NewDefaultFileResolver()
+	fileResolver.SetContext(filepath.Join(dir, "test.scen.json"))
+	p := NewParser(fileResolver)
+	scenario, err := p.ParseScenarioFile([]byte(`{"gasSchedule": "file:gas.toml", "steps": []}`))
+	require.Nil(t, err)
+	require.Equal(t, mj.GasScheduleFile, scenario.GasSchedule)
+	require.Equal(t, "file:gas.toml", scenario.GasScheduleFile.Original)
+	require.Equal(t, gasScheduleContents, string(scenario.GasScheduleFile.Value))
+
+	_, err = p.ParseScenarioFile([]byte(`{"gasSchedule": "file:missing.toml", "steps": []}`))
+	require.NotNil(t, err)
+}
@@ -0,0 +1,148 @@
+package mandosjsonparse
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+
+	mjschema "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/json/schema"
+	oj "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/orderedjson"
+)
+
+var variableReferenceRegexp = regexp.MustCompile(`\$\{([^}]*)\}`)
+
+// ValidateScenarioFile checks a scenario, or steps file, and yields all the problems found, instead of only the first.
+// Each problem is located by its JSON path and its line and column in the file.
+// The file is checked against the Mandos schema, its expressions are interpreted,
+// then each step that is valid according to the schema is parsed, to find the remaining problems.
+// Steps referencing variables that the file does not declare are not parsed, since they are bound by the external steps running the file.
+func (p *Parser) ValidateScenarioFile(jsonString []byte) []*mjschema.ValidationError {
+	jobj, positions, err := oj.ParseOrderedJSONWithPositions(jsonString)
+	if err != nil {
+		validationErr := &mjschema.ValidationError{
+			Path:    mjschema.RootPath,
+			Message: err.Error(),
+		}
+		var syntaxErr *oj.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			validationErr.Position = syntaxErr.Position
+			validationErr.Message = syntaxErr.Message
+		}
+		return []*mjschema.ValidationError{validationErr}
+	}
+
+	validationErrors := mjschema.MandosSchema().Validate(jobj, positions, p.checkExpressionFormat)
+	topMap, isMap := jobj.(*oj.OJsonMap)
+	if !isMap || len(validationErrors) > 0 && !isInsideSteps(validationErrors) {
+		return validationErrors
+	}
+
+	// the scenario fields other than the steps, variables included
+	scenarioFields := oj.NewMap()
+	var steps *oj.OJsonList
+	for _, kvp := range topMap.OrderedKV {
+		if kvp.Key == "steps" {
+			steps, _ = kvp.Value.(*oj.OJsonList)
+		} else {
+			scenarioFields.Put(kvp.Key, kvp.Value)
+		}
+	}
+	restoreVariables := p.bindVariables(nil)
+	defer restoreVariables()
+	_, err = p.processScenario(scenarioFields, nil)
+	if err != nil {
+		position, _ := positions.OfValue(jobj)
+		return append(validationErrors, &mjschema.ValidationError{
+			Path:     mjschema.RootPath,
+			Position: position,
+			Message:  err.Error(),
+		})
+	}
+	if steps == nil {
+		return validationErrors
+	}
+	p.bindScenarioVariables(topMap)
+
+	stepsPath := mjschema.PropertyPath(mjschema.RootPath, "steps")
+	for i, stepObj := range steps.AsList() {
+		stepPath := mjschema.ItemPath(stepsPath, i)
+		if hasErrorsAt(validationErrors, stepPath) || p.referencesUnknownVariables(stepObj) {
+			continue
+		}
+		_, err = p.processScenarioStep(stepObj)
+		if err != nil {
+			position, _ := positions.OfValue(stepObj)
+			validationErrors = append(validationErrors, &mjschema.ValidationError{
+				Path:     stepPath,
+				Position: position,
+				Message:  err.Error(),
+			})
+		}
+	}
+	return validationErrors
+}
+
+// checkExpressionFormat interprets the Mandos expressions.
+// Those referencing variables are skipped, since the variables might be bound by the external steps running the file.
+func (p *Parser) checkExpressionFormat(format string, value string) error {
+	if strings.Contains(value, "${") {
+		return nil
+	}
+	if format == mjschema.FormatCheckValue && (value == "*" || value == "+") {
+		return nil
+	}
+	_, err := p.ExprInterpreter.InterpretString(value)
+	return err
+}
+
+func (p *Parser) bindScenarioVariables(topMap *oj.OJsonMap) {
+	for _, kvp := range topMap.OrderedKV {
+		if kvp.Key == "variables" {
+			// the errors were already reported while processing the scenario fields
+			_, _ = p.processScenarioVariables(kvp.Value, nil)
+		}
+	}
+}
+
+func (p *Parser) referencesUnknownVariables(obj oj.OJsonObject) bool {
+	switch value := obj.(type) {
+	case *oj.OJsonString:
+		for _, match := range variableReferenceRegexp.FindAllStringSubmatch(value.Value, -1) {
+			if _, known := p.ExprInterpreter.Variables[match[1]]; !known {
+				return true
+			}
+		}
+	case *oj.OJsonList:
+		for _, item := range value.AsList() {
+			if p.referencesUnknownVariables(item) {
+				return true
+			}
+		}
+	case *oj.OJsonMap:
+		for _, kvp := range value.OrderedKV {
+			if p.referencesUnknownVariables(&oj.OJsonString{Value: kvp.Key}) || p.referencesUnknownVariables(kvp.Value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func isInsideSteps(validationErrors []*mjschema.ValidationError) bool {
+	stepsPath := mjschema.PropertyPath(mjschema.RootPath, "steps")
+	for _, validationErr := range validationErrors {
+		if !strings.HasPrefix(validationErr.Path, stepsPath+"[") {
+			return false
+		}
+	}
+	return true
+}
+
+func hasErrorsAt(validationErrors []*mjschema.ValidationError, path string) bool {
+	for _, validationErr := range validationErrors {
+		if validationErr.Path == path || strings.HasPrefix(validationErr.Path, path+".") || strings.HasPrefix(validationErr.Path, path+"[") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,91 @@
+package mandosjsonparse
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	fr "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/fileresolver"
+	oj "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/orderedjson"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateScenarioFile_AllErrors(t *testing.T) {
+	p := NewParser(fr.NewDefaultFileResolver())
+	validationErrors := p.ValidateScenarioFile([]byte(`{
+    "steps": [
+        {
+            "step": "setState",
+            "accounts": {
+                "address:owner": {
+                    "balance": "u8:300"
+                }
+            }
+        },
+        {
+            "step": "transfer",
+            "tx": {
+                "from": "address:owner",
+                "to": "address:other",
+                "egldValue": "${amount}"
+            }
+        },
+        {
+            "step": "checkState",
+            "accounts": {
+                "address:owner": {
+                    "nonce": "*",
+                    "color": "red"
+                }
+            }
+        }
+    ]
+}`))
+	require.Len(t, validationErrors, 2)
+	require.Equal(t, `$.steps[0].accounts["address:owner"].balance`, validationErrors[0].Path)
+	require.Equal(t, oj.Position{Line: 7, Column: 32}, validationErrors[0].Position)
+	require.Equal(t, `$.steps[2].accounts["address:owner"].color`, validationErrors[1].Path)
+	require.Equal(t, oj.Position{Line: 24, Column: 21}, validationErrors[1].Position)
+}
+
+func TestValidateScenarioFile_SyntaxError(t *testing.T) {
+	p := NewParser(fr.NewDefaultFileResolver())
+	validationErrors := p.ValidateScenarioFile([]byte("{\n  \"steps\": [\n    {\"step\" \"setState\"}\n  ]\n}"))
+	require.Len(t, validationErrors, 1)
+	require.Equal(t, "$", validationErrors[0].Path)
+	require.Equal(t, 3, validationErrors[0].Position.Line)
+}
+
+func TestValidateScenarioFile_StepErrors(t *testing.T) {
+	p := NewParser(fr.NewDefaultFileResolver())
+	validationErrors := p.ValidateScenarioFile([]byte(`{
+		"steps": [
+			{"step": "setState", "accounts": {"str:short": {}}},
+			{"step": "setState"}
+		]
+	}`))
+	require.Len(t, validationErrors, 1)
+	require.Equal(t, "$.steps[0]", validationErrors[0].Path)
+	require.Equal(t, 3, validationErrors[0].Position.Line)
+}
+
+// the schema should accept all the files that the parser accepts
+func TestValidateScenarioFile_SelfTests(t *testing.T) {
+	root, err := filepath.Abs(filepath.Join("..", "..", "..", "test", "mandos-self-test"))
+	require.Nil(t, err)
+	err = filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil || !strings.HasSuffix(path, ".scen.json") && !strings.HasSuffix(path, ".step.json") && !strings.HasSuffix(path, ".steps.json") {
+			return err
+		}
+		contents, err := ioutil.ReadFile(path)
+		require.Nil(t, err)
+		fileResolver := fr.NewDefaultFileResolver()
+		fileResolver.SetContext(path)
+		p := NewParser(fileResolver)
+		require.Empty(t, p.ValidateScenarioFile(contents), path)
+		return nil
+	})
+	require.Nil(t, err)
+}
@@ -0,0 +1,154 @@
+package mandosjsonparse
+
+import (
+	"errors"
+	"fmt"
+
+	ei "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/expression/interpreter"
+	mj "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/model"
+	oj "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/orderedjson"
+)
+
+// processVariable parses a variable, substituting in its value the variables already in scope.
+func (p *Parser) processVariable(name string, valueRaw oj.OJsonObject) (*mj.ScenarioVariable, error) {
+	if !ei.IsValidVariableName(name) {
+		return nil, fmt.Errorf("invalid variable name: %s", name)
+	}
+	original, err := p.parseString(valueRaw)
+	if err != nil {
+		return nil, fmt.Errorf("variable %s value not a string: %w", name, err)
+	}
+	value, err := p.ExprInterpreter.SubstituteVariables(original)
+	if err != nil {
+		return nil, fmt.Errorf("bad variable %s: %w", name, err)
+	}
+	return &mj.ScenarioVariable{
+		Name:     name,
+		Value:    value,
+		Original: original,
+	}, nil
+}
+
+// processVariableMap parses a map of variables. The values can only reference the variables already in scope,
+// not the other variables in the map.
+func (p *Parser) processVariableMap(obj oj.OJsonObject) ([]*mj.ScenarioVariable, error) {
+	variableMap, isMap := obj.(*oj.OJsonMap)
+	if !isMap {
+		return nil, errors.New("variables not a map")
+	}
+	var variables []*mj.ScenarioVariable
+	for _, kvp := range variableMap.OrderedKV {
+		variable, err := p.processVariable(kvp.Key, kvp.Value)
+		if err != nil {
+			return nil, err
+		}
+		variables = append(variables, variable)
+	}
+	return variables, nil
+}
+
+// processScenarioVariables parses the variables declared by a scenario and binds them, in order,
+// so each one can reference the ones before it.
+// Variables that were already bound, as arguments of the external step that runs the scenario, keep their value.
+func (p *Parser) processScenarioVariables(obj oj.OJsonObject, arguments []*mj.ScenarioVariable) ([]*mj.ScenarioVariable, error) {
+	variableMap, isMap := obj.(*oj.OJsonMap)
+	if !isMap {
+		return nil, errors.New("variables not a map")
+	}
+	var variables []*mj.ScenarioVariable
+	for _, kvp := range variableMap.OrderedKV {
+		variable, err := p.processVariable(kvp.Key, kvp.Value)
+		if err != nil {
+			return nil, err
+		}
+		variables = append(variables, variable)
+		if findVariable(arguments, variable.Name) == nil {
+			p.bindVariables([]*mj.ScenarioVariable{variable})
+		}
+	}
+	return variables, nil
+}
+
+func findVariable(variables []*mj.ScenarioVariable, name string) *mj.ScenarioVariable {
+	for _, variable := range variables {
+		if variable.Name == name {
+			return variable
+		}
+	}
+	return nil
+}
+
+// bindVariables brings the variables in scope, shadowing the ones with the same names.
+// The returned function restores the previous scope.
+func (p *Parser) bindVariables(variables []*mj.ScenarioVariable) (restore func()) {
+	previous := p.ExprInterpreter.Variables
+	scope := make(map[string]string, len(previous)+len(variables))
+	for name, value := range previous {
+		scope[name] = value
+	}
+	for _, variable := range variables {
+		scope[variable.Name] = variable.Value
+	}
+	p.ExprInterpreter.Variables = scope
+
+	return func() {
+		p.ExprInterpreter.Variables = previous
+	}
+}
+
+func (p *Parser) processParameterTable(obj oj.OJsonObject) ([][]*mj.ScenarioVariable, error) {
+	rowList, isList := obj.(*oj.OJsonList)
+	if !isList {
+		return nil, errors.New("forEach parameters not a list")
+	}
+	table := make([][]*mj.ScenarioVariable, 0, len(rowList.AsList()))
+	for i, rowRaw := range rowList.AsList() {
+		row, err := p.processVariableMap(rowRaw)
+		if err != nil {
+			return nil, fmt.Errorf("bad forEach parameters row %d: %w", i, err)
+		}
+		table = append(table, row)
+	}
+	return table, nil
+}
+
+func (p *Parser) processForEachStep(stepMap *oj.OJsonMap) (*mj.ForEachStep, error) {
+	step := &mj.ForEachStep{}
+	var err error
+	for _, kvp := range stepMap.OrderedKV {
+		switch kvp.Key {
+		case "step":
+		case "comment":
+			step.Comment, err = p.parseString(kvp.Value)
+			if err != nil {
+				return nil, fmt.Errorf("bad forEach step comment: %w", err)
+			}
+		case "parameters":
+			step.Parameters, err = p.processParameterTable(kvp.Value)
+			if err != nil {
+				return nil, err
+			}
+		case "steps":
+			step.StepsOriginal = kvp.Value
+		default:
+			return nil, fmt.Errorf("invalid forEach field: %s", kvp.Key)
+		}
+	}
+	if step.Parameters == nil {
+		return nil, errors.New("forEach step has no parameters")
+	}
+	if step.StepsOriginal == nil {
+		return nil, errors.New("forEach step has no steps")
+	}
+
+	for i, row := range step.Parameters {
+		restore := p.bindVariables(row)
+		steps, err := p.processScenarioStepList(step.StepsOriginal)
+		restore()
+		if err != nil {
+			return nil, fmt.Errorf("error processing forEach iteration %d: %w", i, err)
+		}
+		step.Iterations = append(step.Iterations, steps)
+	}
+	return step, nil
+}
@@ -0,0 +1,103 @@
+package mandosjsonparse
+
+import (
+	"testing"
+
+	mj "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/model"
+	"github.com/stretchr/testify/require"
+)
+
+const scenarioWithVariables = `{
+	"steps": [
+		{
+			"step": "forEach",
+			"parameters": [
+				{"user": "alice", "amount": "${base}"},
+				{"user": "bob", "amount": "${base} * 2"}
+			],
+			"steps": [
+				{
+					"step": "transfer",
+					"txId": "t",
+					"tx": {
+						"from": "address:owner",
+						"to": "address:${user}",
+						"egldValue": "${amount}"
+					}
+				}
+			]
+		},
+		{
+			"step": "externalSteps",
+			"path": "other.scen.json",
+			"arguments": {"amount": "${base} + 1"}
+		}
+	],
+	"variables": {
+		"base": "1e3",
+		"double": "${base} * 2"
+	}
+}`
+
+func TestParseScenarioVariables(t *testing.T) {
+	p := Parser{}
+	scenario, err := p.ParseScenarioFile([]byte(scenarioWithVariables))
+	require.Nil(t, err)
+	require.Nil(t, p.ExprInterpreter.Variables)
+
+	require.Len(t, scenario.Variables, 2)
+	require.Equal(t, "1e3 * 2", scenario.Variables[1].Value)
+	require.Equal(t, "${base} * 2", scenario.Variables[1].Original)
+
+	forEachStep := scenario.Steps[0].(*mj.ForEachStep)
+	require.Len(t, forEachStep.Parameters, 2)
+	require.Len(t, forEachStep.Iterations, 2)
+	firstTx := forEachStep.Iterations[0][0].(*mj.TxStep).Tx
+	secondTx := forEachStep.Iterations[1][0].(*mj.TxStep).Tx
+	require.Equal(t, []byte("alice___________________________"), firstTx.To.Value)
+	require.Equal(t, "address:${user}", firstTx.To.Original)
+	require.Equal(t, uint64(1000), firstTx.EGLDValue.Value.Uint64())
+	require.Equal(t, []byte("bob_____________________________"), secondTx.To.Value)
+	require.Equal(t, uint64(2000), secondTx.EGLDValue.Value.Uint64())
+
+	externalStep := scenario.Steps[1].(*mj.ExternalStepsStep)
+	require.Equal(t, "amount", externalStep.Arguments[0].Name)
+	require.Equal(t, "1e3 + 1", externalStep.Arguments[0].Value)
+}
+
+func TestParseScenarioArguments(t *testing.T) {
+	p := Parser{}
+	arguments := []*mj.ScenarioVariable{{Name: "base", Value: "5"}}
+	scenario, err := p.ParseScenarioFileWithArguments([]byte(scenarioWithVariables), arguments)
+	require.Nil(t, err)
+
+	require.Equal(t, "1e3", scenario.Variables[0].Value)
+	require.Equal(t, "5 * 2", scenario.Variables[1].Value)
+	firstTx := scenario.Steps[0].(*mj.ForEachStep).Iterations[0][0].(*mj.TxStep).Tx
+	require.Equal(t, uint64(5), firstTx.EGLDValue.Value.Uint64())
+}
+
+func TestParseScenarioVariablesErrors(t *testing.T) {
+	p := Parser{}
+	_, err := p.ParseScenarioFile([]byte(`{"variables": {"a": "${b}"}, "steps": []}`))
+	require.NotNil(t, err)
+	_, err = p.ParseScenarioFile([]byte(`{"variables": {"a-b": "1"}, "steps": []}`))
+	require.NotNil(t, err)
+	_, err = p.ParseScenarioFile([]byte(`{"variables": {"a": "${a"}, "steps": []}`))
+	require.NotNil(t, err)
+
+	_, err = p.ParseScenarioStep(`{"step": "forEach", "steps": []}`)
+	require.NotNil(t, err)
+	_, err = p.ParseScenarioStep(`{"step": "forEach", "parameters": [], "steps": {}}`)
+	require.Nil(t, err)
+	_, err = p.ParseScenarioStep(`{"step": "forEach", "parameters": [{"a": "1"}], "steps": {}}`)
+	require.NotNil(t, err)
+	_, err = p.ParseScenarioStep(`{"step": "forEach", "parameters": ["a"], "steps": []}`)
+	require.NotNil(t, err)
+	_, err = p.ParseScenarioStep(`{
+		"step": "forEach",
+		"parameters": [{"a": "1"}],
+		"steps": [{"step": "transfer", "tx": {"to": "${b}"}}]
+	}`)
+	require.NotNil(t, err)
+}
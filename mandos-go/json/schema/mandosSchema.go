@@ -0,0 +1,345 @@
+package mandosjsonschema
+
+import (
+	mj "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/model"
+)
+
+// MandosSchema yields the schema of the Mandos scenario files: .scen.json, .steps.json and .step.json.
+// The step types, the transaction fields and the ABI types come from the model, so that they stay in sync.
+func MandosSchema() *Document {
+	return &Document{
+		Title:       "Mandos scenario",
+		Description: "A Mandos scenario, or steps referenced by scenarios through externalSteps.",
+		Root:        ref("scenario"),
+		Definitions: []*Definition{
+			{"scenario", scenarioSchema()},
+			{"step", stepSchema()},
+			{"value", &Schema{
+				Description: "A Mandos value expression, e.g. '1,000', '0x1234', 'str:abc', 'address:alice', 'file:code.wasm', 'u32:5|str:abc'.",
+				Type:        "string",
+				Format:      FormatValue,
+			}},
+			{"valueTree", anyOf(
+				"A Mandos value, or a list or an object of values, which are concatenated.",
+				ref("value"),
+				arrayOf("", ref("valueTree")),
+				mapOf("", ref("valueTree")),
+			)},
+			{"checkValue", &Schema{
+				Description: "A Mandos value expression, or '*' to accept any value.",
+				Type:        "string",
+				Format:      FormatCheckValue,
+			}},
+			{"checkValueTree", anyOf(
+				"A Mandos value to check, '*' to accept any value, or a list or an object of values, which are concatenated.",
+				ref("checkValue"),
+				arrayOf("", ref("valueTree")),
+				mapOf("", ref("valueTree")),
+			)},
+			{"checkValueList", anyOf(
+				"The values to check, in order, or '*' to accept any list. A last item '+' allows more items.",
+				constant("*"),
+				arrayOf("", ref("checkValueTree")),
+			)},
+			{"account", accountSchema()},
+			{"esdt", esdtSchema()},
+			{"esdtInstance", object("An instance of a token, i.e. the fungible balance, or an NFT or SFT with its nonce.",
+				esdtInstanceProperties("value")...)},
+			{"checkAccount", checkAccountSchema()},
+			{"checkESDT", checkESDTSchema()},
+			{"checkESDTInstance", object("The expected instance of a token.",
+				esdtInstanceProperties("checkValue")...)},
+			{"blockInfo", object("The block information seen by the contracts.",
+				prop("blockTimestamp", ref("value")),
+				prop("blockNonce", ref("value")),
+				prop("blockRound", ref("value")),
+				prop("blockEpoch", ref("value")),
+				prop("blockRandomSeed", ref("value")),
+			)},
+			{"newAddress", object("The address of a contract deployed by an account, with a given nonce.",
+				prop("creatorAddress", ref("value")),
+				prop("creatorNonce", ref("value")),
+				prop("newAddress", ref("value")),
+			)},
+			{"esdtTransfer", object("A token transferred along with the transaction.",
+				prop("tokenIdentifier", ref("value")),
+				prop("nonce", ref("value")),
+				prop("value", ref("value")),
+			)},
+			{"expect", expectSchema()},
+			{"logEntry", object("An expected log, checked in order.", logEntryProperties()...)},
+			{"logEvent", object("Logs to find regardless of their position. Fields left out accept any value.",
+				append(logEntryProperties(),
+					prop("count", describedRef("checkValue", "The exact number of matching logs. If left out, at least one log must match.")),
+				)...)},
+		},
+	}
+}
+
+func scenarioSchema() *Schema {
+	return object("",
+		prop("name", str("The name of the scenario.")),
+		prop("comment", str("")),
+		prop("checkGas", boolean("Checks the gas remaining after the transactions, default true.")),
+		prop("traceGas", boolean("Prints the gas used by each step.")),
+		prop("gasSchedule", anyOf("The gas costs: a named schedule, a 'file:...' TOML schedule, or a schedule with some costs replaced.",
+			enum("default", "dummy", "v3", "v4"),
+			&Schema{Type: "string", Pattern: "^file:"},
+			object("",
+				prop("base", str("A named schedule, or a 'file:...' TOML schedule.")),
+				prop("overrides", mapOf("The costs replaced, by name or by 'Section.Name'.", ref("value"))),
+			),
+		)),
+		prop("variables", mapOf("Values referenced in expressions as '${name}'.", ref("value"))),
+		prop("steps", arrayOf("", ref("step"))),
+	)
+}
+
+func stepSchema() *Schema {
+	steps := []*Schema{
+		stepObject(mj.StepNameExternalSteps, "Runs the steps of another file.",
+			prop("path", str("The path of the file, relative to this one.")),
+			prop("traceGas", boolean("")),
+			prop("arguments", mapOf("Variables of the external steps.", ref("value"))),
+		).require("step", "path"),
+		stepObject(mj.StepNameForEach, "Runs the steps once for each row of parameters.",
+			prop("parameters", arrayOf("", mapOf("The variables of an iteration.", ref("value")))),
+			prop("steps", arrayOf("", ref("step"))),
+		).require("step", "parameters", "steps"),
+		stepObject(mj.StepNameSetState, "Sets up the accounts and the blockchain state.",
+			prop("accounts", mapOf("The accounts, by address.", ref("account"))),
+			prop("newAddresses", arrayOf("", ref("newAddress"))),
+			prop("previousBlockInfo", ref("blockInfo")),
+			prop("currentBlockInfo", ref("blockInfo")),
+			prop("blockHashes", arrayOf("", ref("value"))),
+		),
+		stepObject(mj.StepNameCheckState, "Checks the state of the accounts.",
+			prop("accounts", checkMapOf("The accounts, by address. A '+' entry allows other accounts.", ref("checkAccount"))),
+		),
+		stepObject(mj.StepNameDumpState, "Prints the state of the accounts."),
+	}
+	for _, txType := range []mj.TransactionType{mj.ScCall, mj.ScDeploy, mj.ScQuery, mj.Transfer, mj.ValidatorReward} {
+		steps = append(steps, txStepSchema(txType))
+	}
+	return anyOf("A step of the scenario, its type is given by the 'step' field.", steps...)
+}
+
+func stepObject(stepName string, description string, properties ...*Property) *Schema {
+	allProperties := []*Property{
+		prop("step", constant(stepName)),
+		prop("comment", str("")),
+	}
+	return object(description, append(allProperties, properties...)...).require("step")
+}
+
+func txStepSchema(txType mj.TransactionType) *Schema {
+	stepName := (&mj.TxStep{Tx: &mj.Transaction{Type: txType}}).StepTypeName()
+	properties := []*Property{
+		prop("txId", str("Identifies the transaction in the test output.")),
+		prop("displayLogs", boolean("Prints the VM logs while the transaction runs.")),
+		prop("tx", txSchema(txType)),
+	}
+	if txType.IsSmartContractTx() {
+		properties = append(properties, prop("expect", ref("expect")))
+	}
+	return stepObject(stepName, "", properties...).require("step", "tx")
+}
+
+// txSchema allows the fields the parser accepts for the transaction type.
+// The parser also accepts some fields when they are empty, e.g. "to" in deployments, so they are allowed as well.
+func txSchema(txType mj.TransactionType) *Schema {
+	properties := []*Property{prop("nonce", ref("value"))}
+	if txType.HasSender() {
+		properties = append(properties, prop("from", ref("value")))
+	}
+	properties = append(properties, prop("to", ref("value")))
+	if txType.HasValue() {
+		properties = append(properties,
+			prop("egldValue", ref("value")),
+			prop("value", describedRef("value", "Same as egldValue, kept for backwards compatibility.")),
+		)
+	}
+	if txType.HasESDT() {
+		esdtTransfers := anyOf("The tokens transferred.", arrayOf("", ref("esdtTransfer")), ref("esdtTransfer"))
+		properties = append(properties,
+			prop("esdtValue", esdtTransfers),
+			prop("esdt", esdtTransfers),
+		)
+	}
+	properties = append(properties,
+		prop("function", str("The endpoint called.")),
+		prop("arguments", arrayOf("", ref("valueTree"))),
+		prop("contractCode", ref("value")),
+	)
+	if txType.HasGasLimit() {
+		properties = append(properties, prop("gasLimit", ref("value")))
+	}
+	if txType.HasGasPrice() {
+		properties = append(properties, prop("gasPrice", ref("value")))
+	}
+	return object("The transaction.", properties...)
+}
+
+func accountSchema() *Schema {
+	return object("An account. The address is the key in the accounts object.",
+		prop("comment", str("")),
+		prop("shard", ref("value")),
+		prop("nonce", ref("value")),
+		prop("balance", ref("value")),
+		prop("esdt", mapOf("The tokens held, by token identifier.", ref("esdt"))),
+		prop("username", ref("value")),
+		prop("storage", mapOf("The storage, by key.", ref("valueTree"))),
+		prop("code", ref("value")),
+		prop("owner", ref("value")),
+		prop("asyncCallData", ref("value")),
+		prop("update", boolean("Changes only the fields given of an existing account, instead of replacing it.")),
+	)
+}
+
+func esdtSchema() *Schema {
+	properties := []*Property{
+		prop("instances", arrayOf("", ref("esdtInstance"))),
+		prop("lastNonce", ref("value")),
+		prop("roles", arrayOf("", str("An ESDT role, e.g. 'ESDTRoleLocalMint'."))),
+		prop("frozen", ref("value")),
+	}
+	return anyOf("A token: the fungible balance, or the token instances, roles and nonce.",
+		ref("value"),
+		object("", append(properties, esdtInstanceProperties("value")...)...),
+	)
+}
+
+func checkESDTSchema() *Schema {
+	properties := []*Property{
+		prop("instances", arrayOf("", ref("checkESDTInstance"))),
+		prop("lastNonce", ref("checkValue")),
+		prop("roles", arrayOf("", str(""))),
+		prop("frozen", ref("checkValue")),
+	}
+	return anyOf("The expected token: the fungible balance, or the token instances, roles and nonce.",
+		ref("checkValue"),
+		object("", append(properties, esdtInstanceProperties("checkValue")...)...),
+	)
+}
+
+func esdtInstanceProperties(valueDefinition string) []*Property {
+	uriSchema := arrayOf("", ref("value"))
+	if valueDefinition == "checkValue" {
+		uriSchema = ref("checkValueList")
+	}
+	return []*Property{
+		prop("nonce", ref(valueDefinition)),
+		prop("balance", ref(valueDefinition)),
+		prop("creator", ref(valueDefinition)),
+		prop("royalties", ref(valueDefinition)),
+		prop("hash", ref(valueDefinition)),
+		prop("uri", uriSchema),
+		prop("attributes", ref(valueDefinition)),
+	}
+}
+
+func checkAccountSchema() *Schema {
+	return object("The expected account. Fields left out are not checked, except the storage and the tokens.",
+		prop("comment", str("")),
+		prop("nonce", ref("checkValue")),
+		prop("balance", ref("checkValue")),
+		prop("esdt", anyOf("The tokens, by token identifier. A '+' entry allows other tokens.",
+			constant("*"),
+			checkMapOf("", ref("checkESDT")),
+		)),
+		prop("username", ref("checkValue")),
+		prop("storage", anyOf("The storage, by key. A '+' entry allows other keys.",
+			constant("*"),
+			checkMapOf("", ref("checkValueTree")),
+		)),
+		prop("code", ref("checkValue")),
+		prop("owner", ref("checkValue")),
+		prop("asyncCallData", ref("checkValue")),
+	)
+}
+
+func expectSchema() *Schema {
+	return object("The expected result of the transaction.",
+		prop("out", ref("checkValueList")),
+		prop("status", ref("checkValue")),
+		prop("message", ref("checkValue")),
+		prop("logs", anyOf("The expected logs: '*', the logs in order, or the events to find among the logs.",
+			constant("*"),
+			arrayOf("A last item '+' allows more logs.", anyOf("", ref("logEntry"), constant("+"))),
+			object("", prop("events", arrayOf("", ref("logEvent")))),
+		)),
+		prop("gas", ref("checkValue")),
+		prop("refund", ref("checkValue")),
+	)
+}
+
+func logEntryProperties() []*Property {
+	return []*Property{
+		prop("address", ref("checkValue")),
+		prop("endpoint", describedRef("checkValue", "The event identifier.")),
+		prop("topics", ref("checkValueList")),
+		prop("topicTypes", arrayOf("Decode the topics at the same positions before comparing them.",
+			enum(mj.ABITypeNames()...))),
+		prop("data", ref("checkValueTree")),
+	}
+}
+
+func ref(name string) *Schema {
+	return &Schema{Ref: name}
+}
+
+// describedRef is a reference with a description, which JSON Schema only allows as an alternative
+func describedRef(name string, description string) *Schema {
+	return &Schema{Description: description, AnyOf: []*Schema{ref(name)}}
+}
+
+func str(description string) *Schema {
+	return &Schema{Description: description, Type: "string"}
+}
+
+func boolean(description string) *Schema {
+	return &Schema{Description: description, Type: "boolean"}
+}
+
+func constant(value string) *Schema {
+	return &Schema{Type: "string", Const: value}
+}
+
+func enum(values ...string) *Schema {
+	return &Schema{Type: "string", Enum: values}
+}
+
+func prop(name string, schema *Schema) *Property {
+	return &Property{Name: name, Schema: schema}
+}
+
+func object(description string, properties ...*Property) *Schema {
+	return &Schema{Description: description, Type: "object", Properties: properties}
+}
+
+func (schema *Schema) require(names ...string) *Schema {
+	schema.Required = names
+	return schema
+}
+
+func mapOf(description string, values *Schema) *Schema {
+	return &Schema{Description: description, Type: "object", AdditionalProperties: values}
+}
+
+// checkMapOf also allows the '+' key, which accepts entries that are not listed
+func checkMapOf(description string, values *Schema) *Schema {
+	return &Schema{
+		Description:          description,
+		Type:                 "object",
+		Properties:           []*Property{prop("+", &Schema{Description: "Any value, it is ignored."})},
+		AdditionalProperties: values,
+	}
+}
+
+func arrayOf(description string, items *Schema) *Schema {
+	return &Schema{Description: description, Type: "array", Items: items}
+}
+
+func anyOf(description string, alternatives ...*Schema) *Schema {
+	return &Schema{Description: description, AnyOf: alternatives}
+}
@@ -0,0 +1,179 @@
+package mandosjsonschema
+
+import (
+	oj "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/orderedjson"
+)
+
+// The formats of the strings that hold Mandos expressions.
+// They are custom JSON Schema formats: editors ignore them, the validator checks them using a FormatChecker.
+const (
+	// FormatValue is a Mandos value expression, e.g. "1000", "str:abc", "address:alice".
+	FormatValue = "mandos-value"
+
+	// FormatCheckValue is a Mandos value expression, or "*", which accepts any value.
+	FormatCheckValue = "mandos-check-value"
+)
+
+// Schema describes the allowed JSON values, as a subset of JSON Schema (draft-07).
+// Objects only allow the properties listed, unless AdditionalProperties is given.
+type Schema struct {
+	// Ref names a definition of the document, the other fields are then ignored.
+	Ref string
+
+	Description string
+
+	// Type is one of "object", "array", "string", "boolean", or empty for any type.
+	Type string
+
+	// Const is the only value allowed for a string, if not empty.
+	Const string
+
+	// Enum lists the values allowed for a string, if not empty.
+	Enum []string
+
+	// Pattern is a regular expression that strings must match, if not empty.
+	Pattern string
+
+	// Format is one of the Mandos formats, checked by the validator.
+	Format string
+
+	Properties           []*Property
+	Required             []string
+	AdditionalProperties *Schema
+
+	// Items is the schema of all the items of an array.
+	Items *Schema
+
+	// AnyOf lists alternative schemas, the value must match at least one of them.
+	AnyOf []*Schema
+}
+
+// Property is a named property of an object.
+type Property struct {
+	Name   string
+	Schema *Schema
+}
+
+// Definition is a named schema, referenced by other schemas.
+type Definition struct {
+	Name   string
+	Schema *Schema
+}
+
+// Document is a JSON Schema document: the schema of the whole file, and the definitions it references.
+type Document struct {
+	Title       string
+	Description string
+	Root        *Schema
+	Definitions []*Definition
+
+	definitionsByName map[string]*Schema
+}
+
+func (document *Document) definition(name string) *Schema {
+	if document.definitionsByName == nil {
+		document.definitionsByName = make(map[string]*Schema)
+		for _, definition := range document.Definitions {
+			document.definitionsByName[definition.Name] = definition.Schema
+		}
+	}
+	return document.definitionsByName[name]
+}
+
+// resolve follows the references, to the schema that describes the value
+func (document *Document) resolve(schema *Schema) *Schema {
+	for schema != nil && len(schema.Ref) > 0 {
+		schema = document.definition(schema.Ref)
+	}
+	return schema
+}
+
+// ToOrderedJSON converts the document to the JSON Schema format.
+func (document *Document) ToOrderedJSON() oj.OJsonObject {
+	result := oj.NewMap()
+	result.Put("$schema", stringToOJ("http://json-schema.org/draft-07/schema#"))
+	result.Put("title", stringToOJ(document.Title))
+	result.Put("description", stringToOJ(document.Description))
+	for _, kvp := range schemaToOJ(document.Root).(*oj.OJsonMap).OrderedKV {
+		result.Put(kvp.Key, kvp.Value)
+	}
+
+	definitions := oj.NewMap()
+	for _, definition := range document.Definitions {
+		definitions.Put(definition.Name, schemaToOJ(definition.Schema))
+	}
+	result.Put("definitions", definitions)
+	return result
+}
+
+// ToJSONString yields the document in the JSON Schema format, formatted like the Mandos files.
+func (document *Document) ToJSONString() string {
+	return oj.JSONString(document.ToOrderedJSON()) + "\n"
+}
+
+func schemaToOJ(schema *Schema) oj.OJsonObject {
+	result := oj.NewMap()
+	if len(schema.Ref) > 0 {
+		result.Put("$ref", stringToOJ("#/definitions/"+schema.Ref))
+		return result
+	}
+	if len(schema.Description) > 0 {
+		result.Put("description", stringToOJ(schema.Description))
+	}
+	if len(schema.Type) > 0 {
+		result.Put("type", stringToOJ(schema.Type))
+	}
+	if len(schema.Const) > 0 {
+		result.Put("const", stringToOJ(schema.Const))
+	}
+	if len(schema.Enum) > 0 {
+		result.Put("enum", stringListToOJ(schema.Enum))
+	}
+	if len(schema.Pattern) > 0 {
+		result.Put("pattern", stringToOJ(schema.Pattern))
+	}
+	if len(schema.Format) > 0 {
+		result.Put("format", stringToOJ(schema.Format))
+	}
+	if schema.Type == "object" {
+		if len(schema.Properties) > 0 {
+			properties := oj.NewMap()
+			for _, property := range schema.Properties {
+				properties.Put(property.Name, schemaToOJ(property.Schema))
+			}
+			result.Put("properties", properties)
+		}
+		if len(schema.Required) > 0 {
+			result.Put("required", stringListToOJ(schema.Required))
+		}
+		if schema.AdditionalProperties != nil {
+			result.Put("additionalProperties", schemaToOJ(schema.AdditionalProperties))
+		} else {
+			noAdditionalProperties := oj.OJsonBool(false)
+			result.Put("additionalProperties", &noAdditionalProperties)
+		}
+	}
+	if schema.Items != nil {
+		result.Put("items", schemaToOJ(schema.Items))
+	}
+	if len(schema.AnyOf) > 0 {
+		anyOf := make(oj.OJsonList, len(schema.AnyOf))
+		for i, alternative := range schema.AnyOf {
+			anyOf[i] = schemaToOJ(alternative)
+		}
+		result.Put("anyOf", &anyOf)
+	}
+	return result
+}
+
+func stringToOJ(str string) oj.OJsonObject {
+	return &oj.OJsonString{Value: str}
+}
+
+func stringListToOJ(strs []string) oj.OJsonObject {
+	list := make(oj.OJsonList, len(strs))
+	for i, str := range strs {
+		list[i] = stringToOJ(str)
+	}
+	return &list
+}
@@ -0,0 +1,97 @@
+package mandosjsonschema
+
+import (
+	"io/ioutil"
+	"testing"
+
+	oj "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/orderedjson"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMandosSchemaFileUpToDate(t *testing.T) {
+	published, err := ioutil.ReadFile("mandos.schema.json")
+	require.Nil(t, err)
+	require.Equal(t, MandosSchema().ToJSONString(), string(published),
+		"mandos.schema.json is out of date, regenerate it with: go run ./cmd/mandosfmt -schema > mandos-go/json/schema/mandos.schema.json")
+}
+
+func validate(t *testing.T, input string) []*ValidationError {
+	jobj, positions, err := oj.ParseOrderedJSONWithPositions([]byte(input))
+	require.Nil(t, err)
+	return MandosSchema().Validate(jobj, positions, nil)
+}
+
+func TestValidate_Valid(t *testing.T) {
+	validationErrors := validate(t, `{
+		"name": "valid",
+		"steps": [
+			{
+				"step": "setState",
+				"accounts": {
+					"address:owner": {"nonce": "0", "balance": "1000"}
+				}
+			},
+			{
+				"step": "scCall",
+				"txId": "1",
+				"tx": {
+					"from": "address:owner",
+					"to": "sc:adder",
+					"function": "add",
+					"arguments": ["5"],
+					"gasLimit": "5,000,000",
+					"gasPrice": "0"
+				},
+				"expect": {"out": [], "status": "0", "logs": "*"}
+			},
+			{
+				"step": "checkState",
+				"accounts": {
+					"address:owner": {"nonce": "*", "storage": {"+": ""}},
+					"+": ""
+				}
+			}
+		]
+	}`)
+	require.Empty(t, validationErrors)
+}
+
+func TestValidate_AllErrors(t *testing.T) {
+	validationErrors := validate(t, `{
+  "steps": [
+    {
+      "step": "transfer",
+      "tx": {
+        "from": "address:owner",
+        "to": "address:other",
+        "egldValue": "1",
+        "gas": "5"
+      }
+    },
+    {
+      "step": "scCal",
+      "tx": {}
+    },
+    {
+      "step": "setState",
+      "accounts": []
+    }
+  ],
+  "gasSchedule": ["v3"]
+}`)
+	require.Len(t, validationErrors, 4)
+	require.Equal(t, "$.steps[0].tx.gas", validationErrors[0].Path)
+	require.Equal(t, oj.Position{Line: 9, Column: 9}, validationErrors[0].Position)
+	require.Contains(t, validationErrors[0].Message, "unknown field gas, expected one of: nonce, from, to, ")
+	require.Equal(t, "$.steps[1].step", validationErrors[1].Path)
+	require.Contains(t, validationErrors[1].Message, "found scCal")
+	require.Equal(t, "$.steps[2].accounts", validationErrors[2].Path)
+	require.Equal(t, oj.Position{Line: 18, Column: 19}, validationErrors[2].Position)
+	require.Equal(t, "$.gasSchedule", validationErrors[3].Path)
+}
+
+func TestPropertyPath(t *testing.T) {
+	require.Equal(t, "$.steps", PropertyPath(RootPath, "steps"))
+	require.Equal(t, `$.accounts["address:owner"]`, PropertyPath("$.accounts", "address:owner"))
+	require.Equal(t, "$.steps[3]", ItemPath("$.steps", 3))
+}
@@ -0,0 +1,362 @@
+package mandosjsonschema
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	oj "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/orderedjson"
+)
+
+// ValidationError is a problem found in a file, located by its JSON path and, if known, its position in the file.
+type ValidationError struct {
+	// Path is the JSON path of the value, e.g. `$.steps[2].tx.gasLimit`.
+	Path string
+
+	// Position is where the value starts, zero if unknown.
+	Position oj.Position
+
+	Message string
+}
+
+// Error yields the message, prefixed by the position and the path.
+func (err *ValidationError) Error() string {
+	if err.Position.Line == 0 {
+		return fmt.Sprintf("%s: %s", err.Path, err.Message)
+	}
+	return fmt.Sprintf("line %d, column %d, %s: %s", err.Position.Line, err.Position.Column, err.Path, err.Message)
+}
+
+// FormatChecker checks the strings that have a format, e.g. that Mandos expressions can be interpreted.
+type FormatChecker func(format string, value string) error
+
+// RootPath is the JSON path of the whole file.
+const RootPath = "$"
+
+var identifierRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// PropertyPath yields the JSON path of a property of the object at the given path.
+func PropertyPath(path string, name string) string {
+	if identifierRegexp.MatchString(name) {
+		return path + "." + name
+	}
+	return fmt.Sprintf("%s[%q]", path, name)
+}
+
+// ItemPath yields the JSON path of an item of the array at the given path.
+func ItemPath(path string, index int) string {
+	return fmt.Sprintf("%s[%d]", path, index)
+}
+
+type validator struct {
+	document    *Document
+	positions   *oj.Positions
+	checkFormat FormatChecker
+	errors      []*ValidationError
+}
+
+// Validate checks a whole JSON tree against the document and yields all the problems found, in file order.
+// The positions and the format checker are optional.
+func (document *Document) Validate(tree oj.OJsonObject, positions *oj.Positions, checkFormat FormatChecker) []*ValidationError {
+	v := &validator{
+		document:    document,
+		positions:   positions,
+		checkFormat: checkFormat,
+	}
+	v.validate(document.Root, tree, RootPath)
+	return v.errors
+}
+
+func (v *validator) addError(value oj.OJsonObject, path string, format string, args ...interface{}) {
+	position, _ := v.positions.OfValue(value)
+	v.errors = append(v.errors, &ValidationError{
+		Path:     path,
+		Position: position,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+func (v *validator) validate(schema *Schema, value oj.OJsonObject, path string) {
+	schema = v.document.resolve(schema)
+	if schema == nil {
+		return
+	}
+	if len(schema.AnyOf) > 0 {
+		v.validateAnyOf(schema, value, path)
+		return
+	}
+
+	switch schema.Type {
+	case "object":
+		v.validateObject(schema, value, path)
+	case "array":
+		list, isList := value.(*oj.OJsonList)
+		if !isList {
+			v.addError(value, path, "expected a list, found %s", typeName(value))
+			return
+		}
+		for i, item := range list.AsList() {
+			v.validate(schema.Items, item, ItemPath(path, i))
+		}
+	case "string":
+		v.validateString(schema, value, path)
+	case "boolean":
+		if _, isBool := value.(*oj.OJsonBool); !isBool {
+			v.addError(value, path, "expected true or false, found %s", typeName(value))
+		}
+	}
+}
+
+func (v *validator) validateObject(schema *Schema, value oj.OJsonObject, path string) {
+	mp, isMap := value.(*oj.OJsonMap)
+	if !isMap {
+		v.addError(value, path, "expected an object, found %s", typeName(value))
+		return
+	}
+
+	for _, kvp := range mp.OrderedKV {
+		propertyPath := PropertyPath(path, kvp.Key)
+		propertySchema := findProperty(schema, kvp.Key)
+		if propertySchema == nil {
+			propertySchema = schema.AdditionalProperties
+		}
+		if propertySchema == nil {
+			position, _ := v.positions.OfKey(kvp)
+			v.errors = append(v.errors, &ValidationError{
+				Path:     propertyPath,
+				Position: position,
+				Message:  fmt.Sprintf("unknown field %s, expected one of: %s", kvp.Key, propertyNames(schema)),
+			})
+			continue
+		}
+		v.validate(propertySchema, kvp.Value, propertyPath)
+	}
+
+	for _, required := range schema.Required {
+		if !mp.KeySet[required] {
+			v.addError(value, path, "missing field %s", required)
+		}
+	}
+}
+
+func (v *validator) validateString(schema *Schema, value oj.OJsonObject, path string) {
+	str, isStr := value.(*oj.OJsonString)
+	if !isStr {
+		v.addError(value, path, "expected a string, found %s", typeName(value))
+		return
+	}
+	if len(schema.Const) > 0 && str.Value != schema.Const {
+		v.addError(value, path, "expected %s, found %s", schema.Const, str.Value)
+		return
+	}
+	if len(schema.Enum) > 0 && !containsString(schema.Enum, str.Value) {
+		v.addError(value, path, "expected one of: %s, found %s", strings.Join(schema.Enum, ", "), str.Value)
+		return
+	}
+	if len(schema.Pattern) > 0 && !regexp.MustCompile(schema.Pattern).MatchString(str.Value) {
+		v.addError(value, path, "%s does not match %s", str.Value, schema.Pattern)
+		return
+	}
+	if len(schema.Format) > 0 && v.checkFormat != nil {
+		err := v.checkFormat(schema.Format, str.Value)
+		if err != nil {
+			v.addError(value, path, "%s", err.Error())
+		}
+	}
+}
+
+// validateAnyOf accepts the value if any alternative does.
+// Otherwise it reports the problems found by the closest alternative.
+// Only the alternatives of the right type are tried, and for objects, those with the right constant fields, e.g. the right "step".
+func (v *validator) validateAnyOf(schema *Schema, value oj.OJsonObject, path string) {
+	var closestErrors []*ValidationError
+	var typeNames []string
+	for _, alternative := range schema.AnyOf {
+		typeNames = appendTypeNames(typeNames, v.document, alternative)
+		if !v.isCandidate(alternative, value) {
+			continue
+		}
+		alternativeErrors := v.alternativeErrors(alternative, value, path)
+		if len(alternativeErrors) == 0 {
+			return
+		}
+		if closestErrors == nil || len(alternativeErrors) < len(closestErrors) {
+			closestErrors = alternativeErrors
+		}
+	}
+
+	if closestErrors != nil {
+		v.errors = append(v.errors, closestErrors...)
+		return
+	}
+	if v.addConstantFieldError(schema, value, path) {
+		return
+	}
+	v.addError(value, path, "expected %s, found %s", strings.Join(typeNames, " or "), typeName(value))
+}
+
+// addConstantFieldError reports an object whose constant field, e.g. "step", matches none of the alternatives
+func (v *validator) addConstantFieldError(schema *Schema, value oj.OJsonObject, path string) bool {
+	mp, isMap := value.(*oj.OJsonMap)
+	if !isMap {
+		return false
+	}
+	for _, kvp := range mp.OrderedKV {
+		var allowed []string
+		for _, alternative := range schema.AnyOf {
+			alternative = v.document.resolve(alternative)
+			propertySchema := v.document.resolve(findProperty(alternative, kvp.Key))
+			if alternative.Type == "object" && propertySchema != nil && len(propertySchema.Const) > 0 {
+				allowed = append(allowed, propertySchema.Const)
+			}
+		}
+		if len(allowed) > 0 {
+			v.addError(kvp.Value, PropertyPath(path, kvp.Key), "expected one of: %s, found %s",
+				strings.Join(allowed, ", "), typeNameOrString(kvp.Value))
+			return true
+		}
+	}
+	return false
+}
+
+func (v *validator) alternativeErrors(alternative *Schema, value oj.OJsonObject, path string) []*ValidationError {
+	alternativeValidator := &validator{
+		document:    v.document,
+		positions:   v.positions,
+		checkFormat: v.checkFormat,
+	}
+	alternativeValidator.validate(alternative, value, path)
+	return alternativeValidator.errors
+}
+
+// isCandidate tells if the alternative is meant for the value: it has the right type and, for objects,
+// the constant fields have the right value
+func (v *validator) isCandidate(alternative *Schema, value oj.OJsonObject) bool {
+	alternative = v.document.resolve(alternative)
+	if len(alternative.AnyOf) > 0 {
+		for _, nested := range alternative.AnyOf {
+			if v.isCandidate(nested, value) {
+				return true
+			}
+		}
+		return false
+	}
+	if !typeMatches(alternative.Type, value) {
+		return false
+	}
+	mp, isMap := value.(*oj.OJsonMap)
+	if !isMap {
+		return true
+	}
+	for _, kvp := range mp.OrderedKV {
+		propertySchema := v.document.resolve(findProperty(alternative, kvp.Key))
+		if propertySchema == nil || len(propertySchema.Const) == 0 {
+			continue
+		}
+		str, isStr := kvp.Value.(*oj.OJsonString)
+		if !isStr || str.Value != propertySchema.Const {
+			return false
+		}
+	}
+	return true
+}
+
+func typeMatches(schemaType string, value oj.OJsonObject) bool {
+	switch schemaType {
+	case "":
+		return true
+	case "object":
+		_, isMap := value.(*oj.OJsonMap)
+		return isMap
+	case "array":
+		_, isList := value.(*oj.OJsonList)
+		return isList
+	case "string":
+		_, isStr := value.(*oj.OJsonString)
+		return isStr
+	case "boolean":
+		_, isBool := value.(*oj.OJsonBool)
+		return isBool
+	default:
+		return false
+	}
+}
+
+func appendTypeNames(typeNames []string, document *Document, schema *Schema) []string {
+	schema = document.resolve(schema)
+	if len(schema.AnyOf) > 0 {
+		for _, nested := range schema.AnyOf {
+			typeNames = appendTypeNames(typeNames, document, nested)
+		}
+		return typeNames
+	}
+	name := schemaTypeName(schema)
+	if !containsString(typeNames, name) {
+		typeNames = append(typeNames, name)
+	}
+	return typeNames
+}
+
+func schemaTypeName(schema *Schema) string {
+	switch {
+	case len(schema.Const) > 0:
+		return schema.Const
+	case schema.Type == "object":
+		return "an object"
+	case schema.Type == "array":
+		return "a list"
+	case schema.Type == "boolean":
+		return "true or false"
+	default:
+		return "a string"
+	}
+}
+
+func typeName(value oj.OJsonObject) string {
+	switch value.(type) {
+	case *oj.OJsonMap:
+		return "an object"
+	case *oj.OJsonList:
+		return "a list"
+	case *oj.OJsonBool:
+		return "a bool"
+	case *oj.OJsonString:
+		return "a string"
+	default:
+		return "nothing"
+	}
+}
+
+func typeNameOrString(value oj.OJsonObject) string {
+	str, isStr := value.(*oj.OJsonString)
+	if isStr {
+		return str.Value
+	}
+	return typeName(value)
+}
+
+func findProperty(schema *Schema, name string) *Schema {
+	for _, property := range schema.Properties {
+		if property.Name == name {
+			return property.Schema
+		}
+	}
+	return nil
+}
+
+func propertyNames(schema *Schema) string {
+	names := make([]string, len(schema.Properties))
+	for i, property := range schema.Properties {
+		names[i] = property.Name
+	}
+	return strings.Join(names, ", ")
+}
+
+func containsString(strs []string, str string) bool {
+	for _, s := range strs {
+		if s == str {
+			return true
+		}
+	}
+	return false
+}
@@ -48,12 +48,59 @@ func logToOJ(logEntry *mj.LogEntry) oj.OJsonObject {
 	logOJ.Put("address", checkBytesToOJ(logEntry.Address))
 	logOJ.Put("endpoint", checkBytesToOJ(logEntry.Endpoint))
 	logOJ.Put("topics", checkValueListToOJ(logEntry.Topics))
+	if len(logEntry.TopicTypes) > 0 {
+		logOJ.Put("topicTypes", abiTypesToOJ(logEntry.TopicTypes))
+	}
 	logOJ.Put("data", checkBytesToOJ(logEntry.Data))
 
 	return logOJ
 }
 
+// logEventToOJ only writes the fields that were specified, since the others match any value
+func logEventToOJ(event *mj.LogEvent) oj.OJsonObject {
+	eventOJ := oj.NewMap()
+	if !event.Address.IsUnspecified() {
+		eventOJ.Put("address", checkBytesToOJ(event.Address))
+	}
+	if !event.Endpoint.IsUnspecified() {
+		eventOJ.Put("endpoint", checkBytesToOJ(event.Endpoint))
+	}
+	if !event.Topics.IsUnspecified() {
+		eventOJ.Put("topics", checkValueListToOJ(event.Topics))
+	}
+	if len(event.TopicTypes) > 0 {
+		eventOJ.Put("topicTypes", abiTypesToOJ(event.TopicTypes))
+	}
+	if !event.Data.IsUnspecified() {
+		eventOJ.Put("data", checkBytesToOJ(event.Data))
+	}
+	if !event.Count.IsUnspecified() {
+		eventOJ.Put("count", checkUint64ToOJ(event.Count))
+	}
+	return eventOJ
+}
+
+func abiTypesToOJ(abiTypes []mj.ABIType) oj.OJsonObject {
+	var typeList []oj.OJsonObject
+	for _, abiType := range abiTypes {
+		typeList = append(typeList, stringToOJ(string(abiType)))
+	}
+	typeOJList := oj.OJsonList(typeList)
+	return &typeOJList
+}
+
 func logsToOJ(logEntries mj.LogList) oj.OJsonObject {
+	if logEntries.MatchEvents {
+		eventList := make([]oj.OJsonObject, 0, len(logEntries.Events))
+		for _, event := range logEntries.Events {
+			eventList = append(eventList, logEventToOJ(event))
+		}
+		eventOJList := oj.OJsonList(eventList)
+		logsOJ := oj.NewMap()
+		logsOJ.Put("events", &eventOJList)
+		return logsOJ
+	}
+
 	var logList []oj.OJsonObject
 	for _, logEntry := range logEntries.List {
 		logOJ := logToOJ(logEntry)
@@ -140,6 +187,9 @@ func checkValueListToOJ(jcbl mj.JSONCheckValueList) oj.OJsonObject {
 	for _, jcb := range jcbl.Values {
 		valuesList = append(valuesList, checkBytesToOJ(jcb))
 	}
+	if jcbl.MoreAllowedAtEnd {
+		valuesList = append(valuesList, stringToOJ("+"))
+	}
 	ojList := oj.OJsonList(valuesList)
 	return &ojList
 }
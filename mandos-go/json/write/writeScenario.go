@@ -33,8 +33,12 @@ func ScenarioToOrderedJSON(scenario *mj.Scenario) oj.OJsonObject {
 		scenarioOJ.Put("traceGas", &ojTrue)
 	}
 
-	if scenario.GasSchedule != mj.GasScheduleDefault {
-		scenarioOJ.Put("gasSchedule", gasScheduleToOJ(scenario.GasSchedule))
+	if scenario.GasSchedule != mj.GasScheduleDefault || len(scenario.GasScheduleOverrides) > 0 {
+		scenarioOJ.Put("gasSchedule", scenarioGasScheduleToOJ(scenario))
+	}
+
+	if len(scenario.Variables) > 0 {
+		scenarioOJ.Put("variables", variablesToOJ(scenario.Variables))
 	}
 
 	var stepOJList []oj.OJsonObject
@@ -48,6 +52,20 @@ func ScenarioToOrderedJSON(scenario *mj.Scenario) oj.OJsonObject {
 				stepOJ.Put("comment", stringToOJ(step.Comment))
 			}
 			stepOJ.Put("path", stringToOJ(step.Path))
+			if len(step.Arguments) > 0 {
+				stepOJ.Put("arguments", variablesToOJ(step.Arguments))
+			}
+		case *mj.ForEachStep:
+			if len(step.Comment) > 0 {
+				stepOJ.Put("comment", stringToOJ(step.Comment))
+			}
+			var rowList []oj.OJsonObject
+			for _, row := range step.Parameters {
+				rowList = append(rowList, variablesToOJ(row))
+			}
+			rowOJList := oj.OJsonList(rowList)
+			stepOJ.Put("parameters", &rowOJList)
+			stepOJ.Put("steps", step.StepsOriginal)
 		case *mj.SetStateStep:
 			if len(step.Comment) > 0 {
 				stepOJ.Put("comment", stringToOJ(step.Comment))
@@ -143,6 +161,14 @@ func transactionToScenarioOJ(tx *mj.Transaction) oj.OJsonObject {
 	return transactionOJ
 }
 
+func variablesToOJ(variables []*mj.ScenarioVariable) oj.OJsonObject {
+	variablesOJ := oj.NewMap()
+	for _, variable := range variables {
+		variablesOJ.Put(variable.Name, stringToOJ(variable.Original))
+	}
+	return variablesOJ
+}
+
 func newAddressMocksToOJ(newAddressMocks []*mj.NewAddressMock) oj.OJsonObject {
 	var namList []oj.OJsonObject
 	for _, namEntry := range newAddressMocks {
@@ -177,6 +203,27 @@ func blockInfoToOJ(blockInfo *mj.BlockInfo) oj.OJsonObject {
 	return blockInfoOJ
 }
 
+func scenarioGasScheduleToOJ(scenario *mj.Scenario) oj.OJsonObject {
+	baseOJ := gasScheduleToOJ(scenario.GasSchedule)
+	if scenario.GasSchedule == mj.GasScheduleFile {
+		baseOJ = bytesFromStringToOJ(scenario.GasScheduleFile)
+	}
+	if len(scenario.GasScheduleOverrides) == 0 {
+		return baseOJ
+	}
+
+	gasScheduleOJ := oj.NewMap()
+	if scenario.GasSchedule != mj.GasScheduleDefault {
+		gasScheduleOJ.Put("base", baseOJ)
+	}
+	overridesOJ := oj.NewMap()
+	for _, override := range scenario.GasScheduleOverrides {
+		overridesOJ.Put(override.Key, uint64ToOJ(override.Value))
+	}
+	gasScheduleOJ.Put("overrides", overridesOJ)
+	return gasScheduleOJ
+}
+
 func gasScheduleToOJ(gasSchedule mj.GasSchedule) oj.OJsonObject {
 	switch gasSchedule {
 	case mj.GasScheduleDefault:
@@ -0,0 +1,103 @@
+package mandosjsonmodel
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sort"
+
+	twos "github.com/ElrondNetwork/big-int-util/twos-complement"
+)
+
+// ABIType is the type of a value in a contract ABI, e.g. "u64", "BigUint", "Address".
+// Values given a type are compared by their decoded form,
+// so that numbers match regardless of their encoded length.
+type ABIType string
+
+type abiTypeInfo struct {
+	numeric bool
+	signed  bool
+
+	// maxLength is the maximum encoded length, 0 means no limit
+	maxLength int
+}
+
+var abiTypes = map[ABIType]abiTypeInfo{
+	"bool":            {numeric: true, maxLength: 1},
+	"u8":              {numeric: true, maxLength: 1},
+	"u16":             {numeric: true, maxLength: 2},
+	"u32":             {numeric: true, maxLength: 4},
+	"u64":             {numeric: true, maxLength: 8},
+	"usize":           {numeric: true, maxLength: 4},
+	"BigUint":         {numeric: true},
+	"i8":              {numeric: true, signed: true, maxLength: 1},
+	"i16":             {numeric: true, signed: true, maxLength: 2},
+	"i32":             {numeric: true, signed: true, maxLength: 4},
+	"i64":             {numeric: true, signed: true, maxLength: 8},
+	"isize":           {numeric: true, signed: true, maxLength: 4},
+	"BigInt":          {numeric: true, signed: true},
+	"Address":         {},
+	"TokenIdentifier": {},
+	"bytes":           {},
+	"str":             {},
+}
+
+// ParseABIType validates the name of an ABI type.
+func ParseABIType(name string) (ABIType, error) {
+	abiType := ABIType(name)
+	if _, known := abiTypes[abiType]; !known {
+		return "", fmt.Errorf("unknown ABI type: %s", name)
+	}
+	return abiType, nil
+}
+
+// ABITypeNames lists the names of all the ABI types, sorted.
+func ABITypeNames() []string {
+	names := make([]string, 0, len(abiTypes))
+	for abiType := range abiTypes {
+		names = append(names, string(abiType))
+	}
+	sort.Strings(names)
+	return names
+}
+
+// IsNumeric returns true for the integer types and bool.
+func (abiType ABIType) IsNumeric() bool {
+	return abiTypes[abiType].numeric
+}
+
+// DecodeNumber yields the value of an encoded number. It fails if the type is not numeric,
+// or if the value does not fit the type.
+func (abiType ABIType) DecodeNumber(encoded []byte) (*big.Int, error) {
+	info := abiTypes[abiType]
+	if !info.numeric {
+		return nil, fmt.Errorf("%s is not a numeric type", abiType)
+	}
+	if info.maxLength > 0 && len(encoded) > info.maxLength {
+		return nil, fmt.Errorf("value 0x%x too long for type %s", encoded, abiType)
+	}
+	if info.signed {
+		return twos.FromBytes(encoded), nil
+	}
+	return big.NewInt(0).SetBytes(encoded), nil
+}
+
+// Equal compares the decoded forms of the values.
+// Values that cannot be decoded are only equal if their encodings are.
+func (abiType ABIType) Equal(expected []byte, actual []byte) bool {
+	if bytes.Equal(expected, actual) {
+		return true
+	}
+	if !abiType.IsNumeric() {
+		return false
+	}
+	expectedNumber, err := abiType.DecodeNumber(expected)
+	if err != nil {
+		return false
+	}
+	actualNumber, err := abiType.DecodeNumber(actual)
+	if err != nil {
+		return false
+	}
+	return expectedNumber.Cmp(actualNumber) == 0
+}
@@ -16,4 +16,15 @@ const (
 
 	// GasScheduleV4 is currently used on mainnet.
 	GasScheduleV4
+
+	// GasScheduleFile is loaded from a TOML file, referenced by the scenario as "file:...".
+	GasScheduleFile
 )
+
+// GasScheduleOverride replaces a single cost of the gas schedule used by a scenario.
+// The key is either "Section.Name", e.g. "BaseOperationCost.StorageStore",
+// or just the name, if it only appears in one section of the gas schedule.
+type GasScheduleOverride struct {
+	Key   string
+	Value JSONUint64
+}
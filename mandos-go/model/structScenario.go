@@ -1,14 +1,31 @@
 package mandosjsonmodel
 
+import oj "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/orderedjson"
+
 // Scenario is a json object representing a test scenario with steps.
 type Scenario struct {
-	Name        string
-	Comment     string
-	CheckGas    bool
-	TraceGas    bool
-	IsNewTest   bool
-	GasSchedule GasSchedule
-	Steps       []Step
+	Name                 string
+	Comment              string
+	CheckGas             bool
+	TraceGas             bool
+	IsNewTest            bool
+	GasSchedule          GasSchedule
+	GasScheduleFile      JSONBytesFromString
+	GasScheduleOverrides []*GasScheduleOverride
+	Variables            []*ScenarioVariable
+	Steps                []Step
+}
+
+// ScenarioVariable is a named value, referenced in the expressions of a scenario as "${name}".
+// Variables are declared by scenarios, passed as arguments to external steps, or bound by forEach steps.
+type ScenarioVariable struct {
+	Name string
+
+	// Value is the text substituted for the references, after substituting the variables it references in turn.
+	Value string
+
+	// Original is the value as written in the JSON.
+	Original string
 }
 
 // Step is the basic block of a scenario.
@@ -42,9 +59,23 @@ const (
 
 // ExternalStepsStep allows including steps from another file
 type ExternalStepsStep struct {
-	Comment  string
-	TraceGas TraceGasStatus
-	Path     string
+	Comment   string
+	TraceGas  TraceGasStatus
+	Path      string
+	Arguments []*ScenarioVariable
+}
+
+// ForEachStep repeats a list of steps once for each row of a parameter table,
+// with the parameters of the row bound as variables.
+type ForEachStep struct {
+	Comment    string
+	Parameters [][]*ScenarioVariable
+
+	// StepsOriginal is the list of steps as written in the JSON, before binding the parameters.
+	StepsOriginal oj.OJsonObject
+
+	// Iterations holds the steps parsed for each row of the parameter table.
+	Iterations [][]Step
 }
 
 // SetStateStep is a step where data is saved to the blockchain mock.
@@ -78,6 +109,7 @@ type TxStep struct {
 }
 
 var _ Step = (*ExternalStepsStep)(nil)
+var _ Step = (*ForEachStep)(nil)
 var _ Step = (*SetStateStep)(nil)
 var _ Step = (*CheckStateStep)(nil)
 var _ Step = (*DumpStateStep)(nil)
@@ -91,6 +123,14 @@ func (*ExternalStepsStep) StepTypeName() string {
 	return StepNameExternalSteps
 }
 
+// StepNameForEach is a json step type name.
+const StepNameForEach = "forEach"
+
+// StepTypeName type as string
+func (*ForEachStep) StepTypeName() string {
+	return StepNameForEach
+}
+
 // StepNameSetState is a json step type name.
 const StepNameSetState = "setState"
 
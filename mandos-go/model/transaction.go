@@ -87,11 +87,18 @@ type TransactionResult struct {
 	Logs    LogList
 }
 
+// LogList holds the expected logs of a transaction.
+// Logs given as a list are checked in order. Logs given as an object list events,
+// which are matched against all the logs, regardless of their position.
 type LogList struct {
 	IsUnspecified    bool
 	IsStar           bool
 	MoreAllowedAtEnd bool
 	List             []*LogEntry
+
+	// MatchEvents is set when the logs are given as an object. Logs that match no event are then allowed.
+	MatchEvents bool
+	Events      []*LogEvent
 }
 
 // LogEntry is a json object representing an expected transaction result log entry.
@@ -100,4 +107,52 @@ type LogEntry struct {
 	Endpoint JSONCheckBytes
 	Topics   JSONCheckValueList
 	Data     JSONCheckBytes
+
+	// TopicTypes decode the topics at the same positions before comparing them, if given.
+	TopicTypes []ABIType
+}
+
+// LogEvent matches the logs that have all of its specified fields, regardless of their position.
+// Unspecified fields match any value.
+type LogEvent struct {
+	LogEntry
+
+	// Count is the exact number of logs that should match. If unspecified, at least one log should match.
+	Count JSONCheckUint64
+}
+
+// CheckTopic compares the expected topic at the index with an actual topic, by its decoded form if its type is given.
+func (logEntry *LogEntry) CheckTopic(index int, expected JSONCheckBytes, topic []byte) bool {
+	if expected.IsStar {
+		return true
+	}
+	if index < len(logEntry.TopicTypes) {
+		return logEntry.TopicTypes[index].Equal(expected.Value, topic)
+	}
+	return expected.Check(topic)
+}
+
+// CheckTopics compares the expected topics with the actual ones.
+func (logEntry *LogEntry) CheckTopics(topics [][]byte) bool {
+	expected := logEntry.Topics
+	if expected.IsStar {
+		return true
+	}
+	if len(expected.Values) != len(topics) && !(expected.MoreAllowedAtEnd && len(expected.Values) < len(topics)) {
+		return false
+	}
+	for i, expectedTopic := range expected.Values {
+		if !logEntry.CheckTopic(i, expectedTopic, topics[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Check returns true if an actual log matches all fields.
+func (logEntry *LogEntry) Check(address []byte, identifier []byte, topics [][]byte, data []byte) bool {
+	return logEntry.Address.Check(address) &&
+		logEntry.Endpoint.Check(identifier) &&
+		logEntry.CheckTopics(topics) &&
+		logEntry.Data.Check(data)
 }
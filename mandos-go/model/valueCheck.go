@@ -162,6 +162,9 @@ type JSONCheckValueList struct {
 	Values      []JSONCheckBytes
 	IsStar      bool
 	Unspecified bool
+
+	// MoreAllowedAtEnd is set by a final "+" in the list, so the values only check a prefix of the list.
+	MoreAllowedAtEnd bool
 }
 
 // JSONCheckValueListUnspecified yields JSONCheckBytesList empty value.
@@ -192,7 +195,7 @@ func (jcbl JSONCheckValueList) CheckList(other [][]byte) bool {
 	if jcbl.IsStar {
 		return true
 	}
-	if len(jcbl.Values) != len(other) {
+	if len(jcbl.Values) != len(other) && !(jcbl.MoreAllowedAtEnd && len(jcbl.Values) < len(other)) {
 		return false
 	}
 	for i, expected := range jcbl.Values {
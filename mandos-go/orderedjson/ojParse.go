@@ -15,6 +15,7 @@ type jsonParserStateAnyObjPlaceholder struct {
 type jsonParserStateSingleValue struct {
 	buffer       bytes.Buffer
 	stringEscape bool
+	position     Position
 }
 
 type jsonParserStateMap struct {
@@ -22,9 +23,10 @@ type jsonParserStateMap struct {
 }
 
 type jsonStateMapKeyValue struct {
-	keyBuffer bytes.Buffer
-	state     int // 0=key, 1=':', 2=value
-	currentKV OJsonKeyValuePair
+	keyBuffer   bytes.Buffer
+	state       int // 0=key, 1=':', 2=value
+	currentKV   OJsonKeyValuePair
+	keyPosition Position
 }
 
 type jsonParserStateList struct {
@@ -37,11 +39,31 @@ func isWhitespace(c byte) bool {
 
 // ParseOrderedJSON parses JSON preserving order in maps
 func ParseOrderedJSON(input []byte) (OJsonObject, error) {
+	return parseOrderedJSON(input, nil)
+}
+
+// ParseOrderedJSONWithPositions parses JSON preserving order in maps,
+// and also yields where each value and each map key starts in the input.
+func ParseOrderedJSONWithPositions(input []byte) (OJsonObject, *Positions, error) {
+	positions := newPositions()
+	result, err := parseOrderedJSON(input, positions)
+	if err != nil {
+		return nil, nil, err
+	}
+	return result, positions, nil
+}
+
+// parseOrderedJSON records positions only if a Positions instance is given
+func parseOrderedJSON(input []byte, positions *Positions) (OJsonObject, error) {
 	stateStack := &jsonParserStateStack{}
 	stateStack.push(&jsonParserStateAnyObjPlaceholder{})
 	var pendingResult OJsonObject
+	position := Position{Line: 1, Column: 1}
 
 	for i, c := range input {
+		if i > 0 {
+			position = position.after(input[i-1])
+		}
 		done := false
 		for !done {
 			done = true
@@ -50,7 +72,7 @@ func ParseOrderedJSON(input []byte) (OJsonObject, error) {
 				if isWhitespace(c) {
 					continue
 				} else {
-					return nil, errors.New("unexpected characters at the end")
+					return nil, newSyntaxError(position, "unexpected characters at the end")
 				}
 			}
 
@@ -58,21 +80,25 @@ func ParseOrderedJSON(input []byte) (OJsonObject, error) {
 			switch specificState := state.(type) {
 			case *jsonParserStateAnyObjPlaceholder:
 				if pendingResult != nil {
-					return nil, errors.New("invalid state")
+					return nil, newSyntaxError(position, "invalid state")
 				}
 				if isWhitespace(c) {
 					// leading whitespace, ignore
 				} else if c == '{' {
 					// replace with map state
-					stateStack.replaceTop(&jsonParserStateMap{currentMap: NewMap()})
+					newMap := NewMap()
+					positions.putValue(newMap, position)
+					stateStack.replaceTop(&jsonParserStateMap{currentMap: newMap})
 				} else if c == '[' {
 					// replace with list state
-					stateStack.replaceTop(&jsonParserStateList{})
+					newList := &jsonParserStateList{}
+					positions.putValue(&newList.list, position)
+					stateStack.replaceTop(newList)
 				} else if c == ']' || c == '}' || c == ',' {
-					return nil, errors.New("misplaced character")
+					return nil, newSyntaxError(position, "misplaced character")
 				} else {
 					// replace with single value
-					stateStack.replaceTop(&jsonParserStateSingleValue{})
+					stateStack.replaceTop(&jsonParserStateSingleValue{position: position})
 					done = false
 				}
 			case *jsonParserStateSingleValue:
@@ -88,8 +114,9 @@ func ParseOrderedJSON(input []byte) (OJsonObject, error) {
 							var err error
 							pendingResult, err = specificState.finalize()
 							if err != nil {
-								return nil, err
+								return nil, newSyntaxError(specificState.position, err.Error())
 							}
+							positions.putValue(pendingResult, specificState.position)
 						}
 					} else {
 						if c == ']' || c == '}' || c == ',' || isWhitespace(c) {
@@ -97,8 +124,9 @@ func ParseOrderedJSON(input []byte) (OJsonObject, error) {
 							var err error
 							pendingResult, err = specificState.finalize()
 							if err != nil {
-								return nil, err
+								return nil, newSyntaxError(specificState.position, err.Error())
 							}
+							positions.putValue(pendingResult, specificState.position)
 							done = false
 						} else {
 							specificState.buffer.WriteByte(c)
@@ -136,7 +164,7 @@ func ParseOrderedJSON(input []byte) (OJsonObject, error) {
 					stateStack.push(&jsonStateMapKeyValue{})
 					done = false
 				} else {
-					return nil, errors.New("invalid map state")
+					return nil, newSyntaxError(position, "invalid map state")
 				}
 			case *jsonStateMapKeyValue:
 				switch specificState.state {
@@ -146,9 +174,10 @@ func ParseOrderedJSON(input []byte) (OJsonObject, error) {
 							// ignore
 						} else {
 							if c != '"' {
-								return nil, errors.New("map key must start with a quote")
+								return nil, newSyntaxError(position, "map key must start with a quote")
 							}
 							specificState.keyBuffer.WriteByte(c)
+							specificState.keyPosition = position
 						}
 					} else {
 						specificState.keyBuffer.WriteByte(c)
@@ -164,36 +193,40 @@ func ParseOrderedJSON(input []byte) (OJsonObject, error) {
 						specificState.state = 2
 						stateStack.push(&jsonParserStateAnyObjPlaceholder{})
 					} else {
-						return nil, errors.New("invalid character in map definition, colon expected")
+						return nil, newSyntaxError(position, "invalid character in map definition, colon expected")
 					}
 				case 2: // value
 					if pendingResult == nil {
-						return nil, errors.New("missing value in map")
+						return nil, newSyntaxError(position, "missing value in map")
 					}
 					key := specificState.keyBuffer.String()
 					if !strings.HasPrefix(key, "\"") || !strings.HasSuffix(key, "\"") {
-						return nil, errors.New("map key should be a string enclosed in quotes")
+						return nil, newSyntaxError(position, "map key should be a string enclosed in quotes")
 					}
 					key = key[1 : len(key)-1]
 					stateStack.pop()
 					mapState, isMap := stateStack.peek().(*jsonParserStateMap)
 					if !isMap {
-						return nil, errors.New("map key value state, but no map state underneath")
+						return nil, newSyntaxError(position, "map key value state, but no map state underneath")
+					}
+					if !mapState.currentMap.KeySet[key] {
+						mapState.currentMap.Put(key, pendingResult)
+						lastKV := mapState.currentMap.OrderedKV[len(mapState.currentMap.OrderedKV)-1]
+						positions.putKey(lastKV, specificState.keyPosition)
 					}
-					mapState.currentMap.Put(key, pendingResult)
 					pendingResult = nil
 					done = false
 				default:
-					return nil, errors.New("unknown jsonStateMapKeyValue state")
+					return nil, newSyntaxError(position, "unknown jsonStateMapKeyValue state")
 				}
 			default:
-				return nil, errors.New("invalid parser state")
+				return nil, newSyntaxError(position, "invalid parser state")
 			}
 		}
 	}
 
 	if stateStack.size() != 0 {
-		return nil, errors.New("state stack should be empty at the end")
+		return nil, newSyntaxError(position, "unexpected end of input")
 	}
 
 	return pendingResult, nil
@@ -0,0 +1,82 @@
+package orderedjson
+
+import "fmt"
+
+// Position is a location in a JSON input. Lines and columns start at 1, columns count bytes.
+type Position struct {
+	Line   int
+	Column int
+}
+
+func (position Position) after(c byte) Position {
+	if c == '\n' {
+		return Position{Line: position.Line + 1, Column: 1}
+	}
+	return Position{Line: position.Line, Column: position.Column + 1}
+}
+
+// String yields the position as "line:column".
+func (position Position) String() string {
+	return fmt.Sprintf("%d:%d", position.Line, position.Column)
+}
+
+// Positions holds where each value and each map key of a parsed JSON tree starts in the input.
+type Positions struct {
+	values map[OJsonObject]Position
+	keys   map[*OJsonKeyValuePair]Position
+}
+
+func newPositions() *Positions {
+	return &Positions{
+		values: make(map[OJsonObject]Position),
+		keys:   make(map[*OJsonKeyValuePair]Position),
+	}
+}
+
+// OfValue yields the position of a value of the tree.
+func (positions *Positions) OfValue(value OJsonObject) (Position, bool) {
+	if positions == nil {
+		return Position{}, false
+	}
+	position, found := positions.values[value]
+	return position, found
+}
+
+// OfKey yields the position of the key of a map entry of the tree.
+func (positions *Positions) OfKey(kvp *OJsonKeyValuePair) (Position, bool) {
+	if positions == nil {
+		return Position{}, false
+	}
+	position, found := positions.keys[kvp]
+	return position, found
+}
+
+func (positions *Positions) putValue(value OJsonObject, position Position) {
+	if positions != nil {
+		positions.values[value] = position
+	}
+}
+
+func (positions *Positions) putKey(kvp *OJsonKeyValuePair, position Position) {
+	if positions != nil {
+		positions.keys[kvp] = position
+	}
+}
+
+// SyntaxError is returned when the input is not valid JSON.
+type SyntaxError struct {
+	Position Position
+	Message  string
+}
+
+func newSyntaxError(position Position, message string) *SyntaxError {
+	return &SyntaxError{
+		Position: position,
+		Message:  message,
+	}
+}
+
+// Error yields the message, prefixed by the position.
+func (err *SyntaxError) Error() string {
+	return fmt.Sprintf("line %d, column %d: %s", err.Position.Line, err.Position.Column, err.Message)
+}
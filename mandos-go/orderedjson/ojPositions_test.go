@@ -0,0 +1,46 @@
+package orderedjson
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseOrderedJSONWithPositions(t *testing.T) {
+	input := "{\n\t\"a\": \"1\",\n\t\"b\": [\n\t\t{ \"c\": true }\n\t]\n}"
+	jobj, positions, err := ParseOrderedJSONWithPositions([]byte(input))
+	require.Nil(t, err)
+
+	position, found := positions.OfValue(jobj)
+	require.True(t, found)
+	require.Equal(t, Position{Line: 1, Column: 1}, position)
+
+	topMap := jobj.(*OJsonMap)
+	position, _ = positions.OfKey(topMap.OrderedKV[0])
+	require.Equal(t, Position{Line: 2, Column: 2}, position)
+	position, _ = positions.OfValue(topMap.OrderedKV[0].Value)
+	require.Equal(t, Position{Line: 2, Column: 7}, position)
+
+	list := topMap.OrderedKV[1].Value.(*OJsonList)
+	position, _ = positions.OfValue(list)
+	require.Equal(t, Position{Line: 3, Column: 7}, position)
+	innerMap := list.AsList()[0].(*OJsonMap)
+	position, _ = positions.OfValue(innerMap)
+	require.Equal(t, Position{Line: 4, Column: 3}, position)
+	position, _ = positions.OfValue(innerMap.OrderedKV[0].Value)
+	require.Equal(t, Position{Line: 4, Column: 10}, position)
+}
+
+func TestParseOrderedJSONSyntaxError(t *testing.T) {
+	_, err := ParseOrderedJSON([]byte("{\n  \"a\": \"1\"\n  \"b\": \"2\"\n}"))
+	require.NotNil(t, err)
+	var syntaxErr *SyntaxError
+	require.True(t, errors.As(err, &syntaxErr))
+	require.Equal(t, 3, syntaxErr.Position.Line)
+	require.Equal(t, 3, syntaxErr.Position.Column)
+
+	_, err = ParseOrderedJSON([]byte(`{"a": "1"`))
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "unexpected end of input")
+}
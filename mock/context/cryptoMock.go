@@ -21,6 +21,31 @@ func (c *CryptoHookMock) Ripemd160(data []byte) ([]byte, error) {
 	return c.Result, c.Err
 }
 
+// Sha512 mocked method
+func (c *CryptoHookMock) Sha512(data []byte) ([]byte, error) {
+	return c.Result, c.Err
+}
+
+// Sha3256 mocked method
+func (c *CryptoHookMock) Sha3256(data []byte) ([]byte, error) {
+	return c.Result, c.Err
+}
+
+// Blake2b256 mocked method
+func (c *CryptoHookMock) Blake2b256(data []byte) ([]byte, error) {
+	return c.Result, c.Err
+}
+
+// Blake2b512 mocked method
+func (c *CryptoHookMock) Blake2b512(data []byte) ([]byte, error) {
+	return c.Result, c.Err
+}
+
+// Poseidon mocked method
+func (c *CryptoHookMock) Poseidon(data []byte) ([]byte, error) {
+	return c.Result, c.Err
+}
+
 // VerifyBLS mocked method
 func (c *CryptoHookMock) VerifyBLS(key []byte, msg []byte, sig []byte) error {
 	return c.Err
@@ -36,6 +61,46 @@ func (c *CryptoHookMock) VerifySecp256k1(key []byte, msg []byte, sig []byte, has
 	return c.Err
 }
 
+// VerifySecp256r1 mocked method
+func (c *CryptoHookMock) VerifySecp256r1(key []byte, msg []byte, sig []byte) error {
+	return c.Err
+}
+
+// VerifySchnorr mocked method
+func (c *CryptoHookMock) VerifySchnorr(key []byte, msg []byte, sig []byte) error {
+	return c.Err
+}
+
+// AddG1 mocked method
+func (c *CryptoHookMock) AddG1(curveID int32, point1 []byte, point2 []byte) ([]byte, error) {
+	return c.Result, c.Err
+}
+
+// ScalarMultG1 mocked method
+func (c *CryptoHookMock) ScalarMultG1(curveID int32, point []byte, scalar []byte) ([]byte, error) {
+	return c.Result, c.Err
+}
+
+// AddG2 mocked method
+func (c *CryptoHookMock) AddG2(curveID int32, point1 []byte, point2 []byte) ([]byte, error) {
+	return c.Result, c.Err
+}
+
+// ScalarMultG2 mocked method
+func (c *CryptoHookMock) ScalarMultG2(curveID int32, point []byte, scalar []byte) ([]byte, error) {
+	return c.Result, c.Err
+}
+
+// PairingCheck mocked method
+func (c *CryptoHookMock) PairingCheck(curveID int32, g1Points []byte, g2Points []byte) (bool, error) {
+	return c.Err == nil, c.Err
+}
+
+// VerifyGroth16 mocked method
+func (c *CryptoHookMock) VerifyGroth16(curveID int32, verifyingKey []byte, proof []byte, publicInputs []byte) (bool, error) {
+	return c.Err == nil, c.Err
+}
+
 // EncodeSecp256k1DERSignature mocked method
 func (c *CryptoHookMock) EncodeSecp256k1DERSignature(r, s []byte) []byte {
 	return make([]byte, 0)
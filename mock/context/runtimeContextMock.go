@@ -327,6 +327,15 @@ func (r *RuntimeContextMock) GetAsyncContext(_ []byte) (*arwen.AsyncContext, err
 	return nil, nil
 }
 
+// SetCallbackClosure mocked method
+func (r *RuntimeContextMock) SetCallbackClosure(_ []byte) {
+}
+
+// GetCallbackClosure mocked method
+func (r *RuntimeContextMock) GetCallbackClosure() []byte {
+	return nil
+}
+
 // SetCustomCallFunction mocked method
 func (r *RuntimeContextMock) SetCustomCallFunction(_ string) {
 }
@@ -345,6 +354,15 @@ func (r *RuntimeContextMock) GetAllErrors() error {
 	return nil
 }
 
+// SetTrapBacktrace mocked method
+func (r *RuntimeContextMock) SetTrapBacktrace(_ *arwen.TrapBacktrace) {
+}
+
+// GetTrapBacktrace mocked method
+func (r *RuntimeContextMock) GetTrapBacktrace() *arwen.TrapBacktrace {
+	return nil
+}
+
 // DisableUseDifferentGasCostFlag mocked method
 func (r *RuntimeContextMock) DisableUseDifferentGasCostFlag() {
 }
@@ -62,6 +62,10 @@ type RuntimeContextWrapper struct {
 	// function that will be called by the corresponding RuntimeContext function implementation (by default this will call the same wrapped context function)
 	GetAsyncContextFunc func(contextIdentifier []byte) (*arwen.AsyncContext, error)
 	// function that will be called by the corresponding RuntimeContext function implementation (by default this will call the same wrapped context function)
+	SetCallbackClosureFunc func(callbackClosure []byte)
+	// function that will be called by the corresponding RuntimeContext function implementation (by default this will call the same wrapped context function)
+	GetCallbackClosureFunc func() []byte
+	// function that will be called by the corresponding RuntimeContext function implementation (by default this will call the same wrapped context function)
 	RunningInstancesCountFunc func() uint64
 	// function that will be called by the corresponding RuntimeContext function implementation (by default this will call the same wrapped context function)
 	IsFunctionImportedFunc func(name string) bool
@@ -113,6 +117,10 @@ type RuntimeContextWrapper struct {
 	AddErrorFunc func(err error, otherInfo ...string)
 	// function that will be called by the corresponding RuntimeContext function implementation (by default this will call the same wrapped context function)
 	GetAllErrorsFunc func() error
+	// function that will be called by the corresponding RuntimeContext function implementation (by default this will call the same wrapped context function)
+	SetTrapBacktraceFunc func(backtrace *arwen.TrapBacktrace)
+	// function that will be called by the corresponding RuntimeContext function implementation (by default this will call the same wrapped context function)
+	GetTrapBacktraceFunc func() *arwen.TrapBacktrace
 
 	// function that will be called by the corresponding RuntimeContext function implementation (by default this will call the same wrapped context function)
 	InitStateFunc func()
@@ -231,6 +239,14 @@ func NewRuntimeContextWrapper(inputRuntimeContext *arwen.RuntimeContext) *Runtim
 		return runtimeWrapper.runtimeContext.GetAsyncContext(contextIdentifier)
 	}
 
+	runtimeWrapper.SetCallbackClosureFunc = func(callbackClosure []byte) {
+		runtimeWrapper.runtimeContext.SetCallbackClosure(callbackClosure)
+	}
+
+	runtimeWrapper.GetCallbackClosureFunc = func() []byte {
+		return runtimeWrapper.runtimeContext.GetCallbackClosure()
+	}
+
 	runtimeWrapper.RunningInstancesCountFunc = func() uint64 {
 		return runtimeWrapper.runtimeContext.RunningInstancesCount()
 	}
@@ -335,6 +351,14 @@ func NewRuntimeContextWrapper(inputRuntimeContext *arwen.RuntimeContext) *Runtim
 		return runtimeWrapper.runtimeContext.GetAllErrors()
 	}
 
+	runtimeWrapper.SetTrapBacktraceFunc = func(backtrace *arwen.TrapBacktrace) {
+		runtimeWrapper.runtimeContext.SetTrapBacktrace(backtrace)
+	}
+
+	runtimeWrapper.GetTrapBacktraceFunc = func() *arwen.TrapBacktrace {
+		return runtimeWrapper.runtimeContext.GetTrapBacktrace()
+	}
+
 	runtimeWrapper.InitStateFunc = func() {
 		runtimeWrapper.runtimeContext.InitState()
 	}
@@ -483,6 +507,16 @@ func (contextWrapper *RuntimeContextWrapper) GetAsyncContext(contextIdentifier [
 	return contextWrapper.GetAsyncContextFunc(contextIdentifier)
 }
 
+// SetCallbackClosure calls corresponding xxxFunc function, that by default in turn calls the original method of the wrapped RuntimeContext
+func (contextWrapper *RuntimeContextWrapper) SetCallbackClosure(callbackClosure []byte) {
+	contextWrapper.SetCallbackClosureFunc(callbackClosure)
+}
+
+// GetCallbackClosure calls corresponding xxxFunc function, that by default in turn calls the original method of the wrapped RuntimeContext
+func (contextWrapper *RuntimeContextWrapper) GetCallbackClosure() []byte {
+	return contextWrapper.GetCallbackClosureFunc()
+}
+
 // RunningInstancesCount calls corresponding xxxFunc function, that by default in turn calls the original method of the wrapped RuntimeContext
 func (contextWrapper *RuntimeContextWrapper) RunningInstancesCount() uint64 {
 	return contextWrapper.RunningInstancesCountFunc()
@@ -613,6 +647,16 @@ func (contextWrapper *RuntimeContextWrapper) GetAllErrors() error {
 	return contextWrapper.GetAllErrorsFunc()
 }
 
+// SetTrapBacktrace calls corresponding xxxFunc function, that by default in turn calls the original method of the wrapped RuntimeContext
+func (contextWrapper *RuntimeContextWrapper) SetTrapBacktrace(backtrace *arwen.TrapBacktrace) {
+	contextWrapper.SetTrapBacktraceFunc(backtrace)
+}
+
+// GetTrapBacktrace calls corresponding xxxFunc function, that by default in turn calls the original method of the wrapped RuntimeContext
+func (contextWrapper *RuntimeContextWrapper) GetTrapBacktrace() *arwen.TrapBacktrace {
+	return contextWrapper.GetTrapBacktraceFunc()
+}
+
 // InitState calls corresponding xxxFunc function, that by default in turn calls the original method of the wrapped RuntimeContext
 func (contextWrapper *RuntimeContextWrapper) InitState() {
 	contextWrapper.InitStateFunc()
@@ -24,6 +24,9 @@ type VMHostMock struct {
 	MeteringContext     arwen.MeteringContext
 	StorageContext      arwen.StorageContext
 	ManagedTypesContext arwen.ManagedTypesContext
+	Hooks               arwen.VMHooks
+	Metrics             arwen.MetricsRegistry
+	StateAccess         *arwen.StateAccessTracer
 
 	SCAPIMethods  *wasmer.Imports
 	IsBuiltinFunc bool
@@ -64,6 +67,21 @@ func (host *VMHostMock) Storage() arwen.StorageContext {
 	return host.StorageContext
 }
 
+// VMHooks mocked method
+func (host *VMHostMock) VMHooks() arwen.VMHooks {
+	return host.Hooks
+}
+
+// MetricsRegistry mocked method
+func (host *VMHostMock) MetricsRegistry() arwen.MetricsRegistry {
+	return host.Metrics
+}
+
+// StateAccessTracer mocked method
+func (host *VMHostMock) StateAccessTracer() *arwen.StateAccessTracer {
+	return host.StateAccess
+}
+
 // BigInt mocked method
 func (host *VMHostMock) ManagedTypes() arwen.ManagedTypesContext {
 	return host.ManagedTypesContext
@@ -26,6 +26,9 @@ type VMHostStub struct {
 	OutputCalled                func() arwen.OutputContext
 	MeteringCalled              func() arwen.MeteringContext
 	StorageCalled               func() arwen.StorageContext
+	VMHooksCalled               func() arwen.VMHooks
+	MetricsRegistryCalled       func() arwen.MetricsRegistry
+	StateAccessTracerCalled     func() *arwen.StateAccessTracer
 	ExecuteESDTTransferCalled   func(destination []byte, sender []byte, transfers []*vmcommon.ESDTTransfer, callType vm.CallType) (*vmcommon.VMOutput, uint64, error)
 	CreateNewContractCalled     func(input *vmcommon.ContractCreateInput) ([]byte, error)
 	ExecuteOnSameContextCalled  func(input *vmcommon.ContractCallInput) (*arwen.AsyncContextInfo, error)
@@ -164,6 +167,30 @@ func (vhs *VMHostStub) Storage() arwen.StorageContext {
 	return nil
 }
 
+// VMHooks mocked method
+func (vhs *VMHostStub) VMHooks() arwen.VMHooks {
+	if vhs.VMHooksCalled != nil {
+		return vhs.VMHooksCalled()
+	}
+	return nil
+}
+
+// MetricsRegistry mocked method
+func (vhs *VMHostStub) MetricsRegistry() arwen.MetricsRegistry {
+	if vhs.MetricsRegistryCalled != nil {
+		return vhs.MetricsRegistryCalled()
+	}
+	return nil
+}
+
+// StateAccessTracer mocked method
+func (vhs *VMHostStub) StateAccessTracer() *arwen.StateAccessTracer {
+	if vhs.StateAccessTracerCalled != nil {
+		return vhs.StateAccessTracerCalled()
+	}
+	return nil
+}
+
 // ExecuteESDTTransfer mocked method
 func (vhs *VMHostStub) ExecuteESDTTransfer(destination []byte, sender []byte, transfers []*vmcommon.ESDTTransfer, callType vm.CallType) (*vmcommon.VMOutput, uint64, error) {
 	if vhs.ExecuteESDTTransferCalled != nil {
@@ -0,0 +1,19 @@
+package contracts
+
+import (
+	mock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/context"
+)
+
+// PromisesCallbacksParentMock is an exposed mock contract method, which finishes the name of the called promise callback and its closure
+func PromisesCallbacksParentMock(instanceMock *mock.InstanceMock, _ interface{}) {
+	for _, callbackName := range []string{"onSuccess", "onError"} {
+		callbackName := callbackName
+		instanceMock.AddMockMethod(callbackName, func() *mock.InstanceMock {
+			host := instanceMock.Host
+			instance := mock.GetMockInstance(host)
+			host.Output().Finish([]byte(callbackName))
+			host.Output().Finish(host.Runtime().GetCallbackClosure())
+			return instance
+		})
+	}
+}
@@ -49,3 +49,13 @@ func (a *Account) SetTokenData(tokenIdentifier []byte, nonce uint64, tokenData *
 func (a *Account) SetTokenRolesAsStrings(tokenIdentifier []byte, rolesAsStrings []string) error {
 	return esdtconvert.SetTokenRolesAsStrings(tokenIdentifier, rolesAsStrings, a.Storage)
 }
+
+// GetLastNonce returns the last nonce of the specified token, created by the account.
+func (a *Account) GetLastNonce(tokenIdentifier []byte) uint64 {
+	return esdtconvert.GetLastNonce(tokenIdentifier, a.Storage)
+}
+
+// SetLastNonce sets the last nonce of the specified token, created by the account.
+func (a *Account) SetLastNonce(tokenIdentifier []byte, lastNonce uint64) error {
+	return esdtconvert.SetLastNonce(tokenIdentifier, lastNonce, a.Storage)
+}
@@ -0,0 +1,43 @@
+;; Hashes the first argument with the Poseidon EEI functions, and finishes the hash.
+(module
+  (import "env" "getArgument" (func $getArgument (param i32 i32) (result i32)))
+  (import "env" "finish" (func $finish (param i32 i32)))
+  (import "env" "mBufferGetArgument" (func $mBufferGetArgument (param i32 i32) (result i32)))
+  (import "env" "mBufferFinish" (func $mBufferFinish (param i32) (result i32)))
+  (import "env" "poseidon" (func $poseidon (param i32 i32 i32) (result i32)))
+  (import "env" "managedPoseidon" (func $managedPoseidon (param i32 i32) (result i32)))
+
+  (func $init (export "init"))
+
+  ;; the data is loaded at offset 0 and the hash is stored at offset 1024
+  (func $poseidonHash (export "poseidonHash")
+    (local $length i32)
+    i32.const 0
+    i32.const 0
+    call $getArgument
+    local.set $length
+    i32.const 0
+    local.get $length
+    i32.const 1024
+    call $poseidon
+    drop
+    i32.const 1024
+    i32.const 32
+    call $finish)
+
+  ;; the data is loaded in the buffer 1 and the hash is written to the buffer 2
+  (func $managedPoseidonHash (export "managedPoseidonHash")
+    i32.const 0
+    i32.const 1
+    call $mBufferGetArgument
+    drop
+    i32.const 1
+    i32.const 2
+    call $managedPoseidon
+    drop
+    i32.const 2
+    call $mBufferFinish
+    drop)
+
+  (memory 1)
+  (export "memory" (memory 0)))
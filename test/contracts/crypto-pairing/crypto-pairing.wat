@@ -0,0 +1,112 @@
+;; Calls the pairing EEI functions on the curve given as the first argument and on the managed buffers
+;; of the next arguments, and finishes their results.
+(module
+  (import "env" "int64getArgument" (func $int64getArgument (param i32) (result i64)))
+  (import "env" "int64finish" (func $int64finish (param i64)))
+  (import "env" "mBufferGetArgument" (func $mBufferGetArgument (param i32 i32) (result i32)))
+  (import "env" "mBufferFinish" (func $mBufferFinish (param i32) (result i32)))
+  (import "env" "managedAddG1" (func $managedAddG1 (param i32 i32 i32 i32) (result i32)))
+  (import "env" "managedScalarMultG1" (func $managedScalarMultG1 (param i32 i32 i32 i32) (result i32)))
+  (import "env" "managedAddG2" (func $managedAddG2 (param i32 i32 i32 i32) (result i32)))
+  (import "env" "managedScalarMultG2" (func $managedScalarMultG2 (param i32 i32 i32 i32) (result i32)))
+  (import "env" "managedPairingCheck" (func $managedPairingCheck (param i32 i32 i32) (result i32)))
+  (import "env" "managedVerifyGroth16" (func $managedVerifyGroth16 (param i32 i32 i32 i32) (result i32)))
+
+  (func $init (export "init"))
+
+  ;; loadArguments returns the curve and loads the next arguments in the buffers 1, 2 and 3
+  (func $loadArguments (param $numBuffers i32) (result i32)
+    (local $i i32)
+    i32.const 1
+    local.set $i
+    block $done
+      loop $next
+        local.get $i
+        local.get $numBuffers
+        i32.gt_u
+        br_if $done
+        local.get $i
+        local.get $i
+        call $mBufferGetArgument
+        drop
+        local.get $i
+        i32.const 1
+        i32.add
+        local.set $i
+        br $next
+      end
+    end
+    i32.const 0
+    call $int64getArgument
+    i32.wrap_i64)
+
+  ;; the group operations write their result to the buffer 4
+  (func $addG1 (export "addG1")
+    i32.const 2
+    call $loadArguments
+    i32.const 4
+    i32.const 1
+    i32.const 2
+    call $managedAddG1
+    drop
+    i32.const 4
+    call $mBufferFinish
+    drop)
+
+  (func $scalarMultG1 (export "scalarMultG1")
+    i32.const 2
+    call $loadArguments
+    i32.const 4
+    i32.const 1
+    i32.const 2
+    call $managedScalarMultG1
+    drop
+    i32.const 4
+    call $mBufferFinish
+    drop)
+
+  (func $addG2 (export "addG2")
+    i32.const 2
+    call $loadArguments
+    i32.const 4
+    i32.const 1
+    i32.const 2
+    call $managedAddG2
+    drop
+    i32.const 4
+    call $mBufferFinish
+    drop)
+
+  (func $scalarMultG2 (export "scalarMultG2")
+    i32.const 2
+    call $loadArguments
+    i32.const 4
+    i32.const 1
+    i32.const 2
+    call $managedScalarMultG2
+    drop
+    i32.const 4
+    call $mBufferFinish
+    drop)
+
+  (func $pairingCheck (export "pairingCheck")
+    i32.const 2
+    call $loadArguments
+    i32.const 1
+    i32.const 2
+    call $managedPairingCheck
+    i64.extend_i32_s
+    call $int64finish)
+
+  (func $verifyGroth16 (export "verifyGroth16")
+    i32.const 3
+    call $loadArguments
+    i32.const 1
+    i32.const 2
+    i32.const 3
+    call $managedVerifyGroth16
+    i64.extend_i32_s
+    call $int64finish)
+
+  (memory 1)
+  (export "memory" (memory 0)))
@@ -0,0 +1,95 @@
+;; Verifies the signature given as the arguments (key, message, signature) through the
+;; secp256r1 and Schnorr EEI functions, and finishes the result of the verification.
+(module
+  (import "env" "getArgument" (func $getArgument (param i32 i32) (result i32)))
+  (import "env" "int64finish" (func $int64finish (param i64)))
+  (import "env" "mBufferGetArgument" (func $mBufferGetArgument (param i32 i32) (result i32)))
+  (import "env" "verifySecp256r1" (func $verifySecp256r1 (param i32 i32 i32 i32 i32) (result i32)))
+  (import "env" "managedVerifySecp256r1" (func $managedVerifySecp256r1 (param i32 i32 i32) (result i32)))
+  (import "env" "verifySchnorr" (func $verifySchnorr (param i32 i32 i32 i32) (result i32)))
+  (import "env" "managedVerifySchnorr" (func $managedVerifySchnorr (param i32 i32 i32) (result i32)))
+
+  (func $init (export "init"))
+
+  ;; the key is loaded at offset 0, the message at offset 128 and the signature at offset 1024
+  (func $verifySecp256r1Endpoint (export "verifySecp256r1Signature")
+    (local $keyLength i32)
+    (local $messageLength i32)
+    i32.const 0
+    i32.const 0
+    call $getArgument
+    local.set $keyLength
+    i32.const 1
+    i32.const 128
+    call $getArgument
+    local.set $messageLength
+    i32.const 2
+    i32.const 1024
+    call $getArgument
+    drop
+    i32.const 0
+    local.get $keyLength
+    i32.const 128
+    local.get $messageLength
+    i32.const 1024
+    call $verifySecp256r1
+    i64.extend_i32_s
+    call $int64finish)
+
+  (func $verifySchnorrEndpoint (export "verifySchnorrSignature")
+    (local $messageLength i32)
+    i32.const 0
+    i32.const 0
+    call $getArgument
+    drop
+    i32.const 1
+    i32.const 128
+    call $getArgument
+    local.set $messageLength
+    i32.const 2
+    i32.const 1024
+    call $getArgument
+    drop
+    i32.const 0
+    i32.const 128
+    local.get $messageLength
+    i32.const 1024
+    call $verifySchnorr
+    i64.extend_i32_s
+    call $int64finish)
+
+  ;; the managed endpoints load the arguments in the buffers 1, 2 and 3
+  (func $loadManagedArguments
+    i32.const 0
+    i32.const 1
+    call $mBufferGetArgument
+    drop
+    i32.const 1
+    i32.const 2
+    call $mBufferGetArgument
+    drop
+    i32.const 2
+    i32.const 3
+    call $mBufferGetArgument
+    drop)
+
+  (func $managedVerifySecp256r1Endpoint (export "managedVerifySecp256r1Signature")
+    call $loadManagedArguments
+    i32.const 1
+    i32.const 2
+    i32.const 3
+    call $managedVerifySecp256r1
+    i64.extend_i32_s
+    call $int64finish)
+
+  (func $managedVerifySchnorrEndpoint (export "managedVerifySchnorrSignature")
+    call $loadManagedArguments
+    i32.const 1
+    i32.const 2
+    i32.const 3
+    call $managedVerifySchnorr
+    i64.extend_i32_s
+    call $int64finish)
+
+  (memory 1)
+  (export "memory" (memory 0)))
@@ -0,0 +1,294 @@
+;; Creates promises with the managed and the raw createAsyncCall, and records
+;; their callbacks with the callback closures in its storage.
+(module
+  (import "env" "getNumArguments" (func $getNumArguments (result i32)))
+  (import "env" "getArgument" (func $getArgument (param i32 i32) (result i32)))
+  (import "env" "smallIntGetUnsignedArgument" (func $smallIntGetUnsignedArgument (param i32) (result i64)))
+  (import "env" "signalError" (func $signalError (param i32 i32)))
+  (import "env" "bigIntNew" (func $bigIntNew (param i64) (result i32)))
+  (import "env" "mBufferNew" (func $mBufferNew (result i32)))
+  (import "env" "mBufferNewFromBytes" (func $mBufferNewFromBytes (param i32 i32) (result i32)))
+  (import "env" "mBufferGetArgument" (func $mBufferGetArgument (param i32 i32) (result i32)))
+  (import "env" "mBufferAppend" (func $mBufferAppend (param i32 i32) (result i32)))
+  (import "env" "mBufferAppendBytes" (func $mBufferAppendBytes (param i32 i32 i32) (result i32)))
+  (import "env" "mBufferFinish" (func $mBufferFinish (param i32) (result i32)))
+  (import "env" "mBufferStorageLoad" (func $mBufferStorageLoad (param i32 i32) (result i32)))
+  (import "env" "mBufferStorageStore" (func $mBufferStorageStore (param i32 i32) (result i32)))
+  (import "env" "createAsyncCall" (func $createAsyncCall (param i32 i32 i32 i32 i32 i32 i32 i32 i32 i32 i64)))
+  (import "env" "managedCreateAsyncCall" (func $managedCreateAsyncCall (param i32 i32 i32 i32 i32 i32 i32 i64 i64 i32)))
+  (import "env" "managedGetCallbackClosure" (func $managedGetCallbackClosure (param i32)))
+
+  ;; argument returns a new managed buffer holding the given argument
+  (func $argument (param $id i32) (result i32)
+    (local $handle i32)
+    call $mBufferNew
+    local.set $handle
+    local.get $id
+    local.get $handle
+    call $mBufferGetArgument
+    drop
+    local.get $handle)
+
+  ;; appendArgumentHandles appends to a managed vec the arguments starting with the given one
+  (func $appendArgumentHandles (param $vec i32) (param $from i32)
+    (local $handle i32)
+    block $done
+      loop $next
+        local.get $from
+        call $getNumArguments
+        i32.ge_u
+        br_if $done
+        local.get $from
+        call $argument
+        local.set $handle
+        ;; managed vec items are big endian handles
+        i32.const 128
+        local.get $handle
+        i32.const 24
+        i32.shr_u
+        i32.store8
+        i32.const 129
+        local.get $handle
+        i32.const 16
+        i32.shr_u
+        i32.store8
+        i32.const 130
+        local.get $handle
+        i32.const 8
+        i32.shr_u
+        i32.store8
+        i32.const 131
+        local.get $handle
+        i32.store8
+        local.get $vec
+        i32.const 128
+        i32.const 4
+        call $mBufferAppendBytes
+        drop
+        local.get $from
+        i32.const 1
+        i32.add
+        local.set $from
+        br $next
+      end
+    end)
+
+  ;; concatArguments returns a new managed buffer holding the arguments starting with the given one
+  (func $concatArguments (param $from i32) (result i32)
+    (local $result i32)
+    call $mBufferNew
+    local.set $result
+    block $done
+      loop $next
+        local.get $from
+        call $getNumArguments
+        i32.ge_u
+        br_if $done
+        local.get $result
+        local.get $from
+        call $argument
+        call $mBufferAppend
+        drop
+        local.get $from
+        i32.const 1
+        i32.add
+        local.set $from
+        br $next
+      end
+    end
+    local.get $result)
+
+  ;; appendToStorage appends a managed buffer to the value stored under the given key
+  (func $appendToStorage (param $keyOffset i32) (param $keyLength i32) (param $data i32)
+    (local $key i32)
+    (local $stored i32)
+    local.get $keyOffset
+    local.get $keyLength
+    call $mBufferNewFromBytes
+    local.set $key
+    call $mBufferNew
+    local.set $stored
+    local.get $key
+    local.get $stored
+    call $mBufferStorageLoad
+    drop
+    local.get $stored
+    local.get $data
+    call $mBufferAppend
+    drop
+    local.get $key
+    local.get $stored
+    call $mBufferStorageStore
+    drop)
+
+  ;; createPromise calls onSuccess or onError after the given function of the destination
+  (func $createPromise (param $dest i32) (param $function i32) (param $gas i64) (param $extraGas i64) (param $closure i32) (param $arguments i32)
+    i32.const 0
+    i32.const 8
+    call $mBufferNewFromBytes
+    local.get $dest
+    i64.const 0
+    call $bigIntNew
+    local.get $function
+    local.get $arguments
+    i32.const 16
+    i32.const 9
+    call $mBufferNewFromBytes
+    i32.const 32
+    i32.const 7
+    call $mBufferNewFromBytes
+    local.get $gas
+    local.get $extraGas
+    local.get $closure
+    call $managedCreateAsyncCall)
+
+  ;; createPromise(destination, function, gas, extraGasForCallback, closure, arguments...)
+  (func $createPromiseEndpoint (export "createPromise")
+    (local $arguments i32)
+    call $mBufferNew
+    local.set $arguments
+    local.get $arguments
+    i32.const 5
+    call $appendArgumentHandles
+    i32.const 0
+    call $argument
+    i32.const 1
+    call $argument
+    i32.const 2
+    call $smallIntGetUnsignedArgument
+    i32.const 3
+    call $smallIntGetUnsignedArgument
+    i32.const 4
+    call $argument
+    local.get $arguments
+    call $createPromise)
+
+  ;; createPromisePair(destination, function, gas, extraGasForCallback, firstClosure, secondClosure)
+  (func $createPromisePair (export "createPromisePair")
+    i32.const 0
+    call $argument
+    i32.const 1
+    call $argument
+    i32.const 2
+    call $smallIntGetUnsignedArgument
+    i32.const 3
+    call $smallIntGetUnsignedArgument
+    i32.const 4
+    call $argument
+    call $mBufferNew
+    call $createPromise
+    i32.const 0
+    call $argument
+    i32.const 1
+    call $argument
+    i32.const 2
+    call $smallIntGetUnsignedArgument
+    i32.const 3
+    call $smallIntGetUnsignedArgument
+    i32.const 5
+    call $argument
+    call $mBufferNew
+    call $createPromise)
+
+  ;; createLegacyPromise(destination, data, gas) uses the raw createAsyncCall, without a closure
+  (func $createLegacyPromise (export "createLegacyPromise")
+    (local $dataLength i32)
+    i32.const 0
+    i32.const 256
+    call $getArgument
+    drop
+    i32.const 1
+    i32.const 320
+    call $getArgument
+    local.set $dataLength
+    i32.const 0
+    i32.const 8
+    i32.const 256
+    i32.const 288
+    i32.const 320
+    local.get $dataLength
+    i32.const 16
+    i32.const 9
+    i32.const 32
+    i32.const 7
+    i32.const 2
+    call $smallIntGetUnsignedArgument
+    call $createAsyncCall)
+
+  ;; onSuccess appends the closure to "successes" and the results to "results"
+  (func $onSuccess (export "onSuccess")
+    (local $closure i32)
+    call $mBufferNew
+    local.set $closure
+    local.get $closure
+    call $managedGetCallbackClosure
+    i32.const 48
+    i32.const 9
+    local.get $closure
+    call $appendToStorage
+    i32.const 64
+    i32.const 7
+    i32.const 1
+    call $concatArguments
+    call $appendToStorage)
+
+  ;; onError appends the closure to "errors" and stores the error message in "lastError"
+  (func $onError (export "onError")
+    (local $closure i32)
+    call $mBufferNew
+    local.set $closure
+    local.get $closure
+    call $managedGetCallbackClosure
+    i32.const 80
+    i32.const 6
+    local.get $closure
+    call $appendToStorage
+    i32.const 96
+    i32.const 9
+    call $mBufferNewFromBytes
+    i32.const 1
+    call $argument
+    call $mBufferStorageStore
+    drop)
+
+  ;; echo finishes its arguments
+  (func $echo (export "echo")
+    (local $id i32)
+    block $done
+      loop $next
+        local.get $id
+        call $getNumArguments
+        i32.ge_u
+        br_if $done
+        local.get $id
+        call $argument
+        call $mBufferFinish
+        drop
+        local.get $id
+        i32.const 1
+        i32.add
+        local.set $id
+        br $next
+      end
+    end)
+
+  (func $fail (export "fail")
+    i32.const 112
+    i32.const 14
+    call $signalError)
+
+  (func $infiniteLoop (export "infiniteLoop")
+    loop $forever
+      br $forever
+    end)
+
+  (memory 2)
+  (export "memory" (memory 0))
+  (data (i32.const 0) "promises")
+  (data (i32.const 16) "onSuccess")
+  (data (i32.const 32) "onError")
+  (data (i32.const 48) "successes")
+  (data (i32.const 64) "results")
+  (data (i32.const 80) "errors")
+  (data (i32.const 96) "lastError")
+  (data (i32.const 112) "promise failed"))
@@ -0,0 +1,104 @@
+;; Reads and writes the storage, and calls itself on the destination context or asynchronously,
+;; for the recording of the state accessed by the transactions.
+(module
+  (import "env" "storageLoad" (func $storageLoad (param i32 i32 i32) (result i32)))
+  (import "env" "storageStore" (func $storageStore (param i32 i32 i32 i32) (result i32)))
+  (import "env" "getSCAddress" (func $getSCAddress (param i32)))
+  (import "env" "signalError" (func $signalError (param i32 i32)))
+  (import "env" "asyncCall" (func $asyncCall (param i32 i32 i32 i32)))
+  (import "env" "executeOnDestContext" (func $executeOnDestContext (param i64 i32 i32 i32 i32 i32 i32 i32) (result i32)))
+
+  ;; the call value of the nested calls is the zero at offset 64, and the address is written at offset 96
+  (data (i32.const 0) "read")
+  (data (i32.const 8) "written")
+  (data (i32.const 16) "failed")
+  (data (i32.const 24) "readWrite")
+  (data (i32.const 40) "readWriteFail")
+  (data (i32.const 56) "callBack")
+
+  ;; readWrite reads the "read" key and writes the "written" key
+  (func $readWrite (export "readWrite")
+    i32.const 0
+    i32.const 4
+    i32.const 128
+    call $storageLoad
+    drop
+    i32.const 8
+    i32.const 7
+    i32.const 8
+    i32.const 7
+    call $storageStore
+    drop)
+
+  ;; readWriteFail writes the "failed" key, then fails
+  (func $readWriteFail (export "readWriteFail")
+    i32.const 16
+    i32.const 6
+    i32.const 16
+    i32.const 6
+    call $storageStore
+    drop
+    i32.const 16
+    i32.const 6
+    call $signalError)
+
+  ;; callReadWrite calls readWrite on the destination context
+  (func $callReadWrite (export "callReadWrite")
+    i32.const 96
+    call $getSCAddress
+    i64.const 10000
+    i32.const 96
+    i32.const 64
+    i32.const 24
+    i32.const 9
+    i32.const 0
+    i32.const 0
+    i32.const 0
+    call $executeOnDestContext
+    drop)
+
+  ;; callChildren calls readWrite and readWriteFail on the destination context
+  (func $callChildren (export "callChildren")
+    i32.const 96
+    call $getSCAddress
+    i64.const 10000
+    i32.const 96
+    i32.const 64
+    i32.const 24
+    i32.const 9
+    i32.const 0
+    i32.const 0
+    i32.const 0
+    call $executeOnDestContext
+    drop
+    i64.const 10000
+    i32.const 96
+    i32.const 64
+    i32.const 40
+    i32.const 13
+    i32.const 0
+    i32.const 0
+    i32.const 0
+    call $executeOnDestContext
+    drop)
+
+  ;; asyncCallFail calls readWriteFail asynchronously, which fails without failing its caller
+  (func $asyncCallFail (export "asyncCallFail")
+    i32.const 96
+    call $getSCAddress
+    i32.const 96
+    i32.const 64
+    i32.const 40
+    i32.const 13
+    call $asyncCall)
+
+  ;; callBack reads the "callBack" key
+  (func $callBack (export "callBack")
+    i32.const 56
+    i32.const 8
+    i32.const 128
+    call $storageLoad
+    drop)
+
+  (memory 1)
+  (export "memory" (memory 0)))
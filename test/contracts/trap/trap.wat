@@ -0,0 +1,36 @@
+;; Traps in a function called by its endpoints, and names its functions in the name section,
+;; for the call stacks captured at the traps.
+(module
+  (import "env" "getNumArguments" (func $getNumArguments (result i32)))
+
+  (func $divide (param $dividend i32) (param $divisor i32) (result i32)
+    local.get $dividend
+    local.get $divisor
+    i32.div_u)
+
+  (func $load (param $address i32) (result i32)
+    local.get $address
+    i32.load)
+
+  (func $fail
+    unreachable)
+
+  ;; divideByZero divides by the number of arguments
+  (func $divideByZero (export "divideByZero")
+    i32.const 7
+    call $getNumArguments
+    call $divide
+    drop)
+
+  (func $outOfBounds (export "outOfBounds")
+    i32.const 65536
+    call $load
+    drop)
+
+  (func $unreachable (export "unreachable")
+    call $fail)
+
+  (func $init (export "init"))
+
+  (memory 1)
+  (export "memory" (memory 0)))
@@ -5,9 +5,11 @@ import "C"
 import (
 	"fmt"
 	"unsafe"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/executor"
 )
 
-const OPCODE_COUNT = 448
+const OPCODE_COUNT = executor.OPCODE_COUNT
 
 // InstanceError represents any kind of errors related to a WebAssembly instance. It
 // is returned by `Instance` functions only.
@@ -37,10 +39,7 @@ type ExportedFunctionError struct {
 
 // ExportedFunctionSignature holds information about the input/output arities
 // of an exported function
-type ExportedFunctionSignature struct {
-	InputArity  int
-	OutputArity int
-}
+type ExportedFunctionSignature = executor.ExportedFunctionSignature
 
 // SetRkyvSerializationEnabled enables or disables RKYV serialization of
 // instances in Wasmer
@@ -74,9 +73,9 @@ func (error *ExportedFunctionError) Error() string {
 	return error.message
 }
 
-type ExportedFunctionCallback func(...interface{}) (Value, error)
-type ExportsMap map[string]ExportedFunctionCallback
-type ExportSignaturesMap map[string]*ExportedFunctionSignature
+type ExportedFunctionCallback = executor.ExportedFunctionCallback
+type ExportsMap = executor.ExportsMap
+type ExportSignaturesMap = executor.ExportSignaturesMap
 
 // Instance represents a WebAssembly instance.
 type Instance struct {
@@ -109,15 +108,7 @@ type Instance struct {
 	InstanceCtx InstanceContext
 }
 
-type CompilationOptions struct {
-	GasLimit           uint64
-	UnmeteredLocals    uint64
-	MaxMemoryGrow      uint64
-	MaxMemoryGrowDelta uint64
-	OpcodeTrace        bool
-	Metering           bool
-	RuntimeBreakpoints bool
-}
+type CompilationOptions = executor.CompilationOptions
 
 func newWrappedError(target error) error {
 	var lastError string
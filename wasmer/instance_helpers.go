@@ -6,43 +6,38 @@ import (
 )
 
 func generateWasmerImports(imports *Imports) (*cWasmerImportT, int) {
-	var numberOfImports = imports.Count()
+	var importFunctions = imports.Functions()
+	var numberOfImports = len(importFunctions)
 	var wasmImports = make([]cWasmerImportT, numberOfImports)
-	var importFunctionNth = 0
 
-	for _, namespacedImports := range imports.imports {
-		for importName, importFunction := range namespacedImports {
-			var wasmInputsArity = len(importFunction.wasmInputs)
-			var wasmOutputsArity = len(importFunction.wasmOutputs)
+	for importFunctionNth, importFunction := range importFunctions {
+		var wasmInputs = valueTagsFromTypes(importFunction.Inputs)
+		var wasmOutputs = valueTagsFromTypes(importFunction.Outputs)
 
-			var importFunctionInputsCPointer *cWasmerValueTag
-			var importFunctionOutputsCPointer *cWasmerValueTag
+		var importFunctionInputsCPointer *cWasmerValueTag
+		var importFunctionOutputsCPointer *cWasmerValueTag
 
-			if wasmInputsArity > 0 {
-				importFunctionInputsCPointer = (*cWasmerValueTag)(unsafe.Pointer(&importFunction.wasmInputs[0]))
-			}
-
-			if wasmOutputsArity > 0 {
-				importFunctionOutputsCPointer = (*cWasmerValueTag)(unsafe.Pointer(&importFunction.wasmOutputs[0]))
-			}
+		if len(wasmInputs) > 0 {
+			importFunctionInputsCPointer = (*cWasmerValueTag)(unsafe.Pointer(&wasmInputs[0]))
+		}
 
-			importFunction.importedFunctionPointer = cWasmerImportFuncNew(
-				importFunction.cgoPointer,
-				importFunctionInputsCPointer,
-				cUint(wasmInputsArity),
-				importFunctionOutputsCPointer,
-				cUint(wasmOutputsArity),
-			)
-
-			var importedFunction = cNewWasmerImportT(
-				importFunction.namespace,
-				importName,
-				importFunction.importedFunctionPointer,
-			)
-
-			wasmImports[importFunctionNth] = importedFunction
-			importFunctionNth++
+		if len(wasmOutputs) > 0 {
+			importFunctionOutputsCPointer = (*cWasmerValueTag)(unsafe.Pointer(&wasmOutputs[0]))
 		}
+
+		var importedFunctionPointer = cWasmerImportFuncNew(
+			importFunction.CgoPointer,
+			importFunctionInputsCPointer,
+			cUint(len(wasmInputs)),
+			importFunctionOutputsCPointer,
+			cUint(len(wasmOutputs)),
+		)
+
+		wasmImports[importFunctionNth] = cNewWasmerImportT(
+			importFunction.Namespace,
+			importFunction.Name,
+			importedFunctionPointer,
+		)
 	}
 
 	var wasmImportsCPointer *cWasmerImportT
@@ -54,6 +49,17 @@ func generateWasmerImports(imports *Imports) (*cWasmerImportT, int) {
 	return wasmImportsCPointer, numberOfImports
 }
 
+func valueTagsFromTypes(valueTypes []ValueType) []cWasmerValueTag {
+	tags := make([]cWasmerValueTag, len(valueTypes))
+	for i, valueType := range valueTypes {
+		tags[i] = cWasmI32
+		if valueType == TypeI64 {
+			tags[i] = cWasmI64
+		}
+	}
+	return tags
+}
+
 func retrieveExportedMemory(wasmExports *cWasmerExportsT) (Memory, bool, error) {
 	var numberOfExports = int(cWasmerExportsLen(wasmExports))
 
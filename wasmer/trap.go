@@ -0,0 +1,14 @@
+package wasmer
+
+import "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/executor"
+
+// TrapFrame is a function on the WebAssembly call stack of an execution that trapped
+type TrapFrame = executor.TrapFrame
+
+// TrapError is the error of an execution that trapped, with the call stack at the trap
+type TrapError = executor.TrapError
+
+// GetTrapError returns the TrapError in the chain of the given execution error, or nil if the backend did not capture the call stack
+func GetTrapError(err error) *TrapError {
+	return executor.GetTrapError(err)
+}
@@ -0,0 +1,98 @@
+package wasmgo
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"sync"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/executor"
+	"github.com/ElrondNetwork/elrond-go-core/storage"
+	"github.com/ElrondNetwork/elrond-go-core/storage/lrucache"
+)
+
+// compiledModulesCacheSize is the number of compiled modules that the instance builder keeps
+const compiledModulesCacheSize = 100
+
+// compiledCodeMarker prefixes the compiled code of this backend, which is the bytecode itself
+var compiledCodeMarker = []byte("\x00wasmgo\x01")
+
+// InstanceBuilder creates the instances of this backend, with the given imported functions and opcode costs.
+// It implements the arwen.InstanceBuilder interface, and keeps the recently compiled modules.
+type InstanceBuilder struct {
+	imports         *executor.Imports
+	mutex           sync.RWMutex
+	opcodeCosts     [executor.OPCODE_COUNT]uint32
+	compiledModules storage.Cacher
+}
+
+// NewInstanceBuilder creates an instance builder, the opcode costs are the ones that configure Wasmer as well
+func NewInstanceBuilder(imports *executor.Imports, opcodeCosts *[executor.OPCODE_COUNT]uint32) (*InstanceBuilder, error) {
+	compiledModules, err := lrucache.NewCache(compiledModulesCacheSize)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := &InstanceBuilder{
+		imports:         imports,
+		compiledModules: compiledModules,
+	}
+	builder.SetOpcodeCosts(opcodeCosts)
+	return builder, nil
+}
+
+// SetOpcodeCosts replaces the opcode costs of the instances created from now on
+func (builder *InstanceBuilder) SetOpcodeCosts(opcodeCosts *[executor.OPCODE_COUNT]uint32) {
+	builder.mutex.Lock()
+	builder.opcodeCosts = *opcodeCosts
+	builder.mutex.Unlock()
+}
+
+// NewInstanceWithOptions compiles the WASM bytecode, unless it was compiled recently, and creates an instance
+func (builder *InstanceBuilder) NewInstanceWithOptions(
+	contractCode []byte,
+	options executor.CompilationOptions,
+) (executor.InstanceHandler, error) {
+	compiled, err := builder.compile(contractCode)
+	if err != nil {
+		return nil, err
+	}
+	return builder.newInstance(compiled, options)
+}
+
+// NewInstanceFromCompiledCodeWithOptions creates an instance from the code returned by Instance.Cache
+func (builder *InstanceBuilder) NewInstanceFromCompiledCodeWithOptions(
+	compiledCode []byte,
+	options executor.CompilationOptions,
+) (executor.InstanceHandler, error) {
+	if !bytes.HasPrefix(compiledCode, compiledCodeMarker) {
+		return nil, ErrInvalidCompiledCode
+	}
+	return builder.NewInstanceWithOptions(compiledCode[len(compiledCodeMarker):], options)
+}
+
+func (builder *InstanceBuilder) compile(contractCode []byte) (*compiledModule, error) {
+	codeHash := sha256.Sum256(contractCode)
+	cached, ok := builder.compiledModules.Get(codeHash[:])
+	if ok {
+		return cached.(*compiledModule), nil
+	}
+
+	compiled, err := compileModule(contractCode, builder.imports)
+	if err != nil {
+		return nil, err
+	}
+	builder.compiledModules.Put(codeHash[:], compiled, len(contractCode))
+	return compiled, nil
+}
+
+func (builder *InstanceBuilder) newInstance(compiled *compiledModule, options executor.CompilationOptions) (executor.InstanceHandler, error) {
+	builder.mutex.RLock()
+	opcodeCosts := builder.opcodeCosts
+	builder.mutex.RUnlock()
+
+	instance, err := newInstance(compiled, options, &opcodeCosts)
+	if err != nil {
+		return nil, err
+	}
+	return instance, nil
+}
@@ -0,0 +1,610 @@
+package wasmgo
+
+import (
+	"fmt"
+)
+
+// instruction is a decoded instruction, with its immediates and the branch targets resolved.
+// Branches hold the target in a, the number of values they carry in b and the operand stack height at the target in c.
+// The offset locates the instruction in the code section, for the call stacks of the traps.
+type instruction struct {
+	opcode uint16
+	cost   uint16
+	a      uint32
+	b      uint32
+	offset uint32
+	c      uint64
+}
+
+type branchTarget struct {
+	pc     uint32
+	arity  uint32
+	height uint64
+}
+
+// compiledFunction is a function defined by the module, ready to be interpreted
+type compiledFunction struct {
+	index          uint32
+	offset         uint32
+	signature      *functionType
+	numParams      int
+	numLocals      int
+	declaredLocals int
+	maxHeight      int
+	code           []instruction
+	branchTables   [][]branchTarget
+}
+
+// controlFrame is a block, a loop, an if, or the function body, while compiling
+type controlFrame struct {
+	opcode      byte
+	params      int
+	results     int
+	height      int
+	startPC     uint32
+	ifIndex     int
+	hasElse     bool
+	unreachable bool
+	fixups      []branchFixup
+}
+
+// branchFixup is a forward branch, patched with the target once the end of the block is reached
+type branchFixup struct {
+	instructionIndex int
+	tableEntry       int
+}
+
+type functionCompiler struct {
+	module   *module
+	function *compiledFunction
+	r        *reader
+	frames   []*controlFrame
+	height   int
+
+	// instructionOffset is the offset in the code section of the instruction being compiled
+	instructionOffset uint32
+}
+
+func compileFunction(m *module, definedIndex int) (*compiledFunction, error) {
+	typeIndex := m.functionTypes[definedIndex]
+	if int(typeIndex) >= len(m.types) {
+		return nil, fmt.Errorf("invalid type index %d", typeIndex)
+	}
+	signature := &m.types[typeIndex]
+	body := m.bodies[definedIndex]
+	function := &compiledFunction{
+		index:          uint32(len(m.imports) + definedIndex),
+		offset:         body.offset,
+		signature:      signature,
+		numParams:      len(signature.params),
+		numLocals:      len(signature.params) + len(body.locals),
+		declaredLocals: len(body.locals),
+	}
+
+	c := &functionCompiler{
+		module:   m,
+		function: function,
+		r:        newReader(body.code),
+	}
+	c.frames = append(c.frames, &controlFrame{
+		opcode:  0,
+		results: len(signature.results),
+		ifIndex: -1,
+	})
+	for len(c.frames) > 0 {
+		if c.r.isEOF() {
+			return nil, ErrUnexpectedEnd
+		}
+		err := c.compileInstruction()
+		if err != nil {
+			return nil, fmt.Errorf("offset 0x%x: %w", c.r.offset, err)
+		}
+	}
+	if !c.r.isEOF() {
+		return nil, fmt.Errorf("unexpected instructions after the end of the function")
+	}
+	return function, nil
+}
+
+func (c *functionCompiler) currentFrame() *controlFrame {
+	return c.frames[len(c.frames)-1]
+}
+
+func (c *functionCompiler) pop(count int) error {
+	frame := c.currentFrame()
+	if c.height-count < frame.height {
+		if !frame.unreachable {
+			return fmt.Errorf("operand stack underflow")
+		}
+		c.height = frame.height
+		return nil
+	}
+	c.height -= count
+	return nil
+}
+
+func (c *functionCompiler) push(count int) {
+	c.height += count
+	if c.height > c.function.maxHeight {
+		c.function.maxHeight = c.height
+	}
+}
+
+func (c *functionCompiler) popPush(popCount int, pushCount int) error {
+	err := c.pop(popCount)
+	if err != nil {
+		return err
+	}
+	c.push(pushCount)
+	return nil
+}
+
+// setUnreachable marks the rest of the block as dead code, after an unconditional branch
+func (c *functionCompiler) setUnreachable() {
+	frame := c.currentFrame()
+	frame.unreachable = true
+	c.height = frame.height
+}
+
+func (c *functionCompiler) emit(opcode uint16, a uint32, b uint32, value uint64) int {
+	c.function.code = append(c.function.code, instruction{
+		opcode: opcode,
+		cost:   uint16(costIndexes[opcode]),
+		a:      a,
+		b:      b,
+		offset: c.instructionOffset,
+		c:      value,
+	})
+	return len(c.function.code) - 1
+}
+
+func (c *functionCompiler) nextPC() uint32 {
+	return uint32(len(c.function.code))
+}
+
+func (c *functionCompiler) readBlockType() (int, int, error) {
+	if c.r.isEOF() {
+		return 0, 0, ErrUnexpectedEnd
+	}
+	next := c.r.data[c.r.offset]
+	if next == blockTypeEmpty {
+		c.r.offset++
+		return 0, 0, nil
+	}
+	if valueType(next).isValid() {
+		c.r.offset++
+		return 0, 1, nil
+	}
+	typeIndex, err := c.r.readVarInt(33)
+	if err != nil {
+		return 0, 0, err
+	}
+	if typeIndex < 0 || int(typeIndex) >= len(c.module.types) {
+		return 0, 0, fmt.Errorf("invalid block type %d", typeIndex)
+	}
+	blockType := c.module.types[typeIndex]
+	return len(blockType.params), len(blockType.results), nil
+}
+
+func (c *functionCompiler) readMemoryArgument() (uint64, error) {
+	if c.module.memory == nil {
+		return 0, fmt.Errorf("memory instruction without a memory")
+	}
+	_, err := c.r.readU32()
+	if err != nil {
+		return 0, err
+	}
+	offset, err := c.r.readU32()
+	return uint64(offset), err
+}
+
+func (c *functionCompiler) readZeroByte() error {
+	b, err := c.r.readByte()
+	if err != nil {
+		return err
+	}
+	if b != 0 {
+		return fmt.Errorf("invalid reserved byte 0x%02x", b)
+	}
+	return nil
+}
+
+// branchTo resolves a branch to the frame at the given depth, and records it to be patched for forward branches
+func (c *functionCompiler) branchTo(depth uint32, instructionIndex int, tableEntry int) (branchTarget, error) {
+	if int(depth) >= len(c.frames) {
+		return branchTarget{}, fmt.Errorf("invalid branch depth %d", depth)
+	}
+	frame := c.frames[len(c.frames)-1-int(depth)]
+	if frame.opcode == opLoop {
+		return branchTarget{pc: frame.startPC, arity: uint32(frame.params), height: uint64(frame.height)}, nil
+	}
+	frame.fixups = append(frame.fixups, branchFixup{instructionIndex: instructionIndex, tableEntry: tableEntry})
+	return branchTarget{arity: uint32(frame.results), height: uint64(frame.height)}, nil
+}
+
+func (c *functionCompiler) emitBranch(opcode uint16, depth uint32) error {
+	index := c.emit(opcode, 0, 0, 0)
+	target, err := c.branchTo(depth, index, -1)
+	if err != nil {
+		return err
+	}
+	if c.height-int(target.arity) < c.currentFrame().height && !c.currentFrame().unreachable {
+		return fmt.Errorf("operand stack underflow")
+	}
+	ins := &c.function.code[index]
+	ins.a, ins.b, ins.c = target.pc, target.arity, target.height
+	return nil
+}
+
+func (c *functionCompiler) enterBlock(opcode byte) error {
+	params, results, err := c.readBlockType()
+	if err != nil {
+		return err
+	}
+	err = c.pop(params)
+	if err != nil {
+		return err
+	}
+	frame := &controlFrame{
+		opcode:  opcode,
+		params:  params,
+		results: results,
+		height:  c.height,
+		ifIndex: -1,
+	}
+	index := c.emit(uint16(opcode), 0, 0, 0)
+	frame.startPC = c.nextPC()
+	if opcode == opIf {
+		frame.ifIndex = index
+	}
+	c.frames = append(c.frames, frame)
+	c.push(params)
+	return nil
+}
+
+func (c *functionCompiler) compileElse() error {
+	frame := c.currentFrame()
+	if frame.opcode != opIf || frame.hasElse {
+		return fmt.Errorf("else outside of an if")
+	}
+	index := c.emit(opElse, 0, 0, 0)
+	frame.fixups = append(frame.fixups, branchFixup{instructionIndex: index, tableEntry: -1})
+	frame.hasElse = true
+	c.function.code[frame.ifIndex].a = c.nextPC()
+	frame.unreachable = false
+	c.height = frame.height + frame.params
+	return nil
+}
+
+func (c *functionCompiler) compileEnd() error {
+	frame := c.currentFrame()
+	if !frame.unreachable && c.height != frame.height+frame.results {
+		return fmt.Errorf("block ends with %d values instead of %d", c.height-frame.height, frame.results)
+	}
+
+	isFunctionEnd := len(c.frames) == 1
+	if isFunctionEnd {
+		c.emit(opEnd, 0, 1, 0)
+	} else {
+		c.emit(opEnd, 0, 0, 0)
+	}
+
+	afterEnd := c.nextPC()
+	for _, fixup := range frame.fixups {
+		if fixup.tableEntry < 0 {
+			c.function.code[fixup.instructionIndex].a = afterEnd
+		} else {
+			tableIndex := c.function.code[fixup.instructionIndex].a
+			c.function.branchTables[tableIndex][fixup.tableEntry].pc = afterEnd
+		}
+	}
+	if frame.opcode == opIf && !frame.hasElse {
+		c.function.code[frame.ifIndex].a = afterEnd
+	}
+
+	c.frames = c.frames[:len(c.frames)-1]
+	c.height = frame.height
+	c.push(frame.results)
+	return nil
+}
+
+func (c *functionCompiler) compileBranchTable() error {
+	count, err := c.r.readU32()
+	if err != nil {
+		return err
+	}
+	if int(count) > len(c.r.data) {
+		return ErrUnexpectedEnd
+	}
+	err = c.pop(1)
+	if err != nil {
+		return err
+	}
+
+	tableIndex := uint32(len(c.function.branchTables))
+	index := c.emit(opBrTable, tableIndex, 0, 0)
+	table := make([]branchTarget, count+1)
+	c.function.branchTables = append(c.function.branchTables, table)
+	for i := range table {
+		depth, err := c.r.readU32()
+		if err != nil {
+			return err
+		}
+		table[i], err = c.branchTo(depth, index, i)
+		if err != nil {
+			return err
+		}
+	}
+	c.setUnreachable()
+	return nil
+}
+
+func (c *functionCompiler) compileCall(functionIndex uint32) error {
+	signature, err := c.module.functionType(functionIndex)
+	if err != nil {
+		return err
+	}
+	c.emit(opCall, functionIndex, 0, 0)
+	return c.popPush(len(signature.params), len(signature.results))
+}
+
+func (c *functionCompiler) compileCallIndirect() error {
+	typeIndex, err := c.r.readU32()
+	if err != nil {
+		return err
+	}
+	err = c.readZeroByte()
+	if err != nil {
+		return err
+	}
+	if int(typeIndex) >= len(c.module.types) {
+		return fmt.Errorf("invalid type index %d", typeIndex)
+	}
+	if c.module.table == nil {
+		return fmt.Errorf("indirect call without a table")
+	}
+	signature := &c.module.types[typeIndex]
+	c.emit(opCallIndirect, typeIndex, 0, 0)
+	return c.popPush(len(signature.params)+1, len(signature.results))
+}
+
+func (c *functionCompiler) compileLocal(opcode uint16) error {
+	index, err := c.r.readU32()
+	if err != nil {
+		return err
+	}
+	if int(index) >= c.function.numLocals {
+		return fmt.Errorf("invalid local index %d", index)
+	}
+	c.emit(opcode, index, 0, 0)
+	switch opcode {
+	case opLocalGet:
+		return c.popPush(0, 1)
+	case opLocalSet:
+		return c.pop(1)
+	default:
+		return c.popPush(1, 1)
+	}
+}
+
+func (c *functionCompiler) compileGlobal(opcode uint16) error {
+	index, err := c.r.readU32()
+	if err != nil {
+		return err
+	}
+	if int(index) >= len(c.module.globals) {
+		return fmt.Errorf("invalid global index %d", index)
+	}
+	c.emit(opcode, index, 0, 0)
+	if opcode == opGlobalGet {
+		return c.popPush(0, 1)
+	}
+	if !c.module.globals[index].mutable {
+		return fmt.Errorf("global %d is immutable", index)
+	}
+	return c.pop(1)
+}
+
+func (c *functionCompiler) compileMisc() error {
+	subOpcode, err := c.r.readU32()
+	if err != nil {
+		return err
+	}
+	opcode := uint16(opPrefixMisc)<<8 | uint16(subOpcode)
+	if subOpcode > 0xff {
+		return fmt.Errorf("%w: 0xfc 0x%x", ErrUnsupportedInstruction, subOpcode)
+	}
+	if isFloatOpcode(opcode) {
+		return fmt.Errorf("%w: 0xfc 0x%02x", ErrFloatingPoint, subOpcode)
+	}
+
+	switch opcode {
+	case opMiscMemoryInit:
+		dataIndex, err := c.r.readU32()
+		if err != nil {
+			return err
+		}
+		if int(dataIndex) >= len(c.module.data) || c.module.memory == nil {
+			return fmt.Errorf("invalid data index %d", dataIndex)
+		}
+		err = c.readZeroByte()
+		if err != nil {
+			return err
+		}
+		c.emit(opcode, dataIndex, 0, 0)
+		return c.pop(3)
+	case opMiscDataDrop:
+		dataIndex, err := c.r.readU32()
+		if err != nil {
+			return err
+		}
+		if int(dataIndex) >= len(c.module.data) {
+			return fmt.Errorf("invalid data index %d", dataIndex)
+		}
+		c.emit(opcode, dataIndex, 0, 0)
+		return nil
+	case opMiscMemoryCopy, opMiscMemoryFill:
+		reservedBytes := 1
+		if opcode == opMiscMemoryCopy {
+			reservedBytes = 2
+		}
+		for i := 0; i < reservedBytes; i++ {
+			err = c.readZeroByte()
+			if err != nil {
+				return err
+			}
+		}
+		if c.module.memory == nil {
+			return fmt.Errorf("memory instruction without a memory")
+		}
+		c.emit(opcode, 0, 0, 0)
+		return c.pop(3)
+	}
+	return fmt.Errorf("%w: 0xfc 0x%02x", ErrUnsupportedInstruction, subOpcode)
+}
+
+func (c *functionCompiler) compileInstruction() error {
+	c.instructionOffset = c.function.offset + uint32(c.r.offset)
+	b, err := c.r.readByte()
+	if err != nil {
+		return err
+	}
+	opcode := uint16(b)
+	if isFloatOpcode(opcode) {
+		return fmt.Errorf("%w: 0x%02x", ErrFloatingPoint, b)
+	}
+
+	switch {
+	case opcode == opUnreachable:
+		c.emit(opcode, 0, 0, 0)
+		c.setUnreachable()
+	case opcode == opNop:
+		c.emit(opcode, 0, 0, 0)
+	case opcode == opBlock || opcode == opLoop || opcode == opIf:
+		if opcode == opIf {
+			err = c.pop(1)
+			if err != nil {
+				return err
+			}
+		}
+		return c.enterBlock(b)
+	case opcode == opElse:
+		return c.compileElse()
+	case opcode == opEnd:
+		return c.compileEnd()
+	case opcode == opBr:
+		depth, err := c.r.readU32()
+		if err != nil {
+			return err
+		}
+		err = c.emitBranch(opcode, depth)
+		if err != nil {
+			return err
+		}
+		c.setUnreachable()
+	case opcode == opBrIf:
+		depth, err := c.r.readU32()
+		if err != nil {
+			return err
+		}
+		err = c.pop(1)
+		if err != nil {
+			return err
+		}
+		return c.emitBranch(opcode, depth)
+	case opcode == opBrTable:
+		return c.compileBranchTable()
+	case opcode == opReturn:
+		if c.height-len(c.function.signature.results) < c.currentFrame().height && !c.currentFrame().unreachable {
+			return fmt.Errorf("operand stack underflow")
+		}
+		c.emit(opcode, 0, 0, 0)
+		c.setUnreachable()
+	case opcode == opCall:
+		functionIndex, err := c.r.readU32()
+		if err != nil {
+			return err
+		}
+		return c.compileCall(functionIndex)
+	case opcode == opCallIndirect:
+		return c.compileCallIndirect()
+	case opcode == opDrop:
+		c.emit(opcode, 0, 0, 0)
+		return c.pop(1)
+	case opcode == opSelect || opcode == opTypedSelect:
+		if opcode == opTypedSelect {
+			_, err = readValueTypes(c.r)
+			if err != nil {
+				return err
+			}
+		}
+		c.emit(opcode, 0, 0, 0)
+		return c.popPush(3, 1)
+	case opcode >= opLocalGet && opcode <= opLocalTee:
+		return c.compileLocal(opcode)
+	case opcode == opGlobalGet || opcode == opGlobalSet:
+		return c.compileGlobal(opcode)
+	case opcode >= opI32Load && opcode <= opI64Load32U:
+		offset, err := c.readMemoryArgument()
+		if err != nil {
+			return err
+		}
+		c.emit(opcode, 0, 0, offset)
+		return c.popPush(1, 1)
+	case opcode >= opI32Store && opcode <= opI64Store32:
+		offset, err := c.readMemoryArgument()
+		if err != nil {
+			return err
+		}
+		c.emit(opcode, 0, 0, offset)
+		return c.pop(2)
+	case opcode == opMemorySize || opcode == opMemoryGrow:
+		err = c.readZeroByte()
+		if err != nil {
+			return err
+		}
+		if c.module.memory == nil {
+			return fmt.Errorf("memory instruction without a memory")
+		}
+		c.emit(opcode, 0, 0, 0)
+		if opcode == opMemorySize {
+			return c.popPush(0, 1)
+		}
+		return c.popPush(1, 1)
+	case opcode == opI32Const:
+		value, err := c.r.readVarInt(32)
+		if err != nil {
+			return err
+		}
+		c.emit(opcode, 0, 0, uint64(uint32(value)))
+		c.push(1)
+	case opcode == opI64Const:
+		value, err := c.r.readVarInt(64)
+		if err != nil {
+			return err
+		}
+		c.emit(opcode, 0, 0, uint64(value))
+		c.push(1)
+	case opcode == opI32Eqz || opcode == opI64Eqz:
+		c.emit(opcode, 0, 0, 0)
+		return c.popPush(1, 1)
+	case opcode >= opI32Eq && opcode <= opI64GeU:
+		c.emit(opcode, 0, 0, 0)
+		return c.popPush(2, 1)
+	case opcode >= opI32Clz && opcode <= opI32Popcnt, opcode >= opI64Clz && opcode <= opI64Popcnt:
+		c.emit(opcode, 0, 0, 0)
+		return c.popPush(1, 1)
+	case opcode >= opI32Add && opcode <= opI32Rotr, opcode >= opI64Add && opcode <= opI64Rotr:
+		c.emit(opcode, 0, 0, 0)
+		return c.popPush(2, 1)
+	case opcode == opI32WrapI64 || opcode == opI64ExtendI32S || opcode == opI64ExtendI32U,
+		opcode >= opI32Extend8S && opcode <= opI64Extend32S:
+		c.emit(opcode, 0, 0, 0)
+		return c.popPush(1, 1)
+	case opcode == opPrefixMisc:
+		return c.compileMisc()
+	default:
+		return fmt.Errorf("%w: 0x%02x", ErrUnsupportedInstruction, b)
+	}
+	return nil
+}
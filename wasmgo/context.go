@@ -0,0 +1,44 @@
+package wasmgo
+
+import (
+	"sync"
+	"sync/atomic"
+	"unsafe"
+)
+
+// The instances are handed to the imported functions as their context pointer.
+// The registry tells those pointers apart from the Wasmer instance contexts, which the imports also receive.
+// The number of instances is kept apart, so that the processes which only run Wasmer skip the lookup.
+var instancesMutex sync.RWMutex
+var instances = make(map[unsafe.Pointer]*Instance)
+var numInstances int32
+
+func registerInstance(instance *Instance) {
+	instancesMutex.Lock()
+	instances[unsafe.Pointer(instance)] = instance
+	atomic.StoreInt32(&numInstances, int32(len(instances)))
+	instancesMutex.Unlock()
+}
+
+func unregisterInstance(instance *Instance) {
+	instancesMutex.Lock()
+	delete(instances, unsafe.Pointer(instance))
+	atomic.StoreInt32(&numInstances, int32(len(instances)))
+	instancesMutex.Unlock()
+}
+
+// ContextData returns the data set on the instance whose imported function received the given context,
+// or false if the context does not belong to an instance of this backend.
+func ContextData(context unsafe.Pointer) (uintptr, bool) {
+	if atomic.LoadInt32(&numInstances) == 0 {
+		return 0, false
+	}
+
+	instancesMutex.RLock()
+	instance, ok := instances[context]
+	instancesMutex.RUnlock()
+	if !ok {
+		return 0, false
+	}
+	return instance.GetData(), true
+}
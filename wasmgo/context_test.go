@@ -0,0 +1,28 @@
+package wasmgo
+
+import (
+	"sync/atomic"
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestContextData_CountsInstances(t *testing.T) {
+	numInstancesBefore := atomic.LoadInt32(&numInstances)
+	instance := &Instance{}
+	instance.SetContextData(0x1234)
+
+	registerInstance(instance)
+	require.Equal(t, numInstancesBefore+1, atomic.LoadInt32(&numInstances))
+	data, ok := ContextData(unsafe.Pointer(instance))
+	require.True(t, ok)
+	require.Equal(t, uintptr(0x1234), data)
+
+	// an instance cleaned twice is only counted out once
+	unregisterInstance(instance)
+	unregisterInstance(instance)
+	require.Equal(t, numInstancesBefore, atomic.LoadInt32(&numInstances))
+	_, ok = ContextData(unsafe.Pointer(instance))
+	require.False(t, ok)
+}
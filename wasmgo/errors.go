@@ -0,0 +1,62 @@
+package wasmgo
+
+import (
+	"errors"
+)
+
+// ErrInvalidModule signals a malformed WebAssembly module
+var ErrInvalidModule = errors.New("invalid WebAssembly module")
+
+// ErrUnexpectedEnd signals that a section or the module ends in the middle of an item
+var ErrUnexpectedEnd = errors.New("unexpected end of WebAssembly module")
+
+// ErrInvalidLEB128 signals an integer encoding longer than its type allows
+var ErrInvalidLEB128 = errors.New("invalid LEB128 integer")
+
+// ErrUnsupportedInstruction signals an instruction that the VM does not enable, e.g. SIMD, atomics or reference types
+var ErrUnsupportedInstruction = errors.New("unsupported instruction")
+
+// ErrFloatingPoint signals an instruction operating on floating point values, which the VM rejects
+var ErrFloatingPoint = errors.New("floating point instructions are not allowed")
+
+// ErrUnsupportedImport signals an import other than a function, or a function that the imports do not provide
+var ErrUnsupportedImport = errors.New("unsupported import")
+
+// ErrInvalidCompiledCode signals compiled code that was not produced by this backend
+var ErrInvalidCompiledCode = errors.New("invalid compiled code")
+
+// ErrInvalidArguments signals arguments that do not match the signature of an exported function
+var ErrInvalidArguments = errors.New("invalid arguments")
+
+// ErrUnreachable is the trap of the unreachable instruction
+var ErrUnreachable = errors.New("unreachable")
+
+// ErrIntegerDivideByZero is the trap of integer divisions and remainders by zero
+var ErrIntegerDivideByZero = errors.New("integer divide by zero")
+
+// ErrIntegerOverflow is the trap of the signed division of the minimum integer by -1
+var ErrIntegerOverflow = errors.New("integer overflow")
+
+// ErrOutOfBoundsMemoryAccess is the trap of loads, stores and bulk memory instructions outside the memory
+var ErrOutOfBoundsMemoryAccess = errors.New("out of bounds memory access")
+
+// ErrUndefinedElement is the trap of indirect calls outside the table, or to an uninitialized element
+var ErrUndefinedElement = errors.New("undefined element")
+
+// ErrIndirectCallTypeMismatch is the trap of indirect calls to a function of another type
+var ErrIndirectCallTypeMismatch = errors.New("indirect call type mismatch")
+
+// ErrCallStackExhausted is the trap of calls nested too deep
+var ErrCallStackExhausted = errors.New("call stack exhausted")
+
+// ErrOutOfGas is the trap of an execution that uses more points than its gas limit
+var ErrOutOfGas = errors.New("gas limit exceeded")
+
+// ErrMemoryLimit is the trap of memory.grow instructions over the limits of the compilation options
+var ErrMemoryLimit = errors.New("memory grow limit exceeded")
+
+// ErrBreakpoint is the trap of an imported function that set a runtime breakpoint
+var ErrBreakpoint = errors.New("execution interrupted by a runtime breakpoint")
+
+// ErrMemoryGrow signals a memory that cannot grow by the requested number of pages
+var ErrMemoryGrow = errors.New("cannot grow the memory")
@@ -0,0 +1,608 @@
+package wasmgo
+
+import (
+	"encoding/binary"
+	"math"
+	"math/bits"
+	"reflect"
+	"unsafe"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/executor"
+)
+
+// maxCallDepth is the number of nested calls after which the execution traps
+const maxCallDepth = 4096
+
+// The breakpoint values that the backend sets itself, the same as the ones of the VM host
+const (
+	breakpointOutOfGas    = 4
+	breakpointMemoryLimit = 5
+)
+
+// nullElement marks the uninitialized table elements
+const nullElement = math.MaxUint32
+
+func boolToValue(condition bool) uint64 {
+	if condition {
+		return 1
+	}
+	return 0
+}
+
+func (instance *Instance) ensureStack(size int) {
+	if size <= len(instance.stack) {
+		return
+	}
+	newSize := 2 * len(instance.stack)
+	if newSize < size {
+		newSize = size
+	}
+	stack := make([]uint64, newSize)
+	copy(stack, instance.stack)
+	instance.stack = stack
+}
+
+// checkGas traps the execution if it used more points than its gas limit
+func (instance *Instance) checkGas() error {
+	if instance.options.Metering && instance.pointsUsed > instance.gasLimit {
+		instance.breakpoint = breakpointOutOfGas
+		return ErrOutOfGas
+	}
+	return nil
+}
+
+// callFunction calls the function with the given index, whose arguments are on the stack starting at fp.
+// The result, if any, replaces the first argument.
+func (instance *Instance) callFunction(functionIndex uint32, fp int) error {
+	numImports := uint32(len(instance.compiled.imports))
+	if functionIndex < numImports {
+		return instance.callImport(instance.compiled.imports[functionIndex], fp)
+	}
+	return instance.execute(instance.compiled.functions[functionIndex-numImports], fp)
+}
+
+func (instance *Instance) callImport(imported *resolvedImport, fp int) error {
+	signature := imported.signature
+	arguments := make([]reflect.Value, len(signature.params)+1)
+	arguments[0] = reflect.ValueOf(unsafe.Pointer(instance))
+	for i, param := range signature.params {
+		value := instance.stack[fp+i]
+		if param == valueTypeI32 {
+			arguments[i+1] = reflect.ValueOf(int32(uint32(value)))
+		} else {
+			arguments[i+1] = reflect.ValueOf(int64(value))
+		}
+	}
+
+	previousTop := instance.top
+	instance.top = fp + len(signature.params)
+	results := imported.implementation.Call(arguments)
+	instance.top = previousTop
+
+	if len(results) > 0 {
+		if signature.results[0] == valueTypeI32 {
+			instance.stack[fp] = uint64(uint32(results[0].Int()))
+		} else {
+			instance.stack[fp] = uint64(results[0].Int())
+		}
+	}
+	if instance.options.RuntimeBreakpoints && instance.breakpoint != 0 {
+		return ErrBreakpoint
+	}
+	return instance.checkGas()
+}
+
+// branch moves the values carried by a branch down to the operand stack height of its target
+func branch(stack []uint64, base int, sp int, arity uint32, height uint64) int {
+	target := base + int(height)
+	if arity > 0 {
+		copy(stack[target:target+int(arity)], stack[sp-int(arity):sp])
+	}
+	return target + int(arity)
+}
+
+// execute interprets a function defined by the module. The locals start at fp, the operand stack follows them.
+// The traps unwinding the function record it on their call stack.
+func (instance *Instance) execute(function *compiledFunction, fp int) (err error) {
+	pc := 0
+	instance.depth++
+	defer func() {
+		instance.depth--
+		if err != nil && err != ErrBreakpoint {
+			err = addTrapFrame(err, function, pc)
+		}
+	}()
+	if instance.depth > maxCallDepth {
+		return ErrCallStackExhausted
+	}
+
+	metering := instance.options.Metering
+	if metering {
+		unmeteredLocals := instance.options.UnmeteredLocals
+		if uint64(function.declaredLocals) > unmeteredLocals {
+			instance.pointsUsed += (uint64(function.declaredLocals) - unmeteredLocals) * uint64(instance.costs[executor.OpcodeLocalAllocate])
+		}
+		err := instance.checkGas()
+		if err != nil {
+			return err
+		}
+	}
+
+	base := fp + function.numLocals
+	instance.ensureStack(base + function.maxHeight)
+	stack := instance.stack
+	for i := fp + function.numParams; i < base; i++ {
+		stack[i] = 0
+	}
+	sp := base
+	code := function.code
+
+	for {
+		ins := &code[pc]
+		pc++
+		if metering {
+			instance.pointsUsed += uint64(instance.costs[ins.cost])
+		}
+
+		switch ins.opcode {
+		case opUnreachable:
+			return ErrUnreachable
+		case opNop, opBlock, opLoop:
+		case opIf:
+			sp--
+			if uint32(stack[sp]) == 0 {
+				pc = int(ins.a)
+			}
+		case opElse:
+			pc = int(ins.a)
+		case opEnd:
+			if ins.b == 1 {
+				return instance.returnFrom(function, fp, sp)
+			}
+		case opBr:
+			sp = branch(stack, base, sp, ins.b, ins.c)
+			pc = int(ins.a)
+			err := instance.checkGas()
+			if err != nil {
+				return err
+			}
+		case opBrIf:
+			sp--
+			if uint32(stack[sp]) != 0 {
+				sp = branch(stack, base, sp, ins.b, ins.c)
+				pc = int(ins.a)
+			}
+			err := instance.checkGas()
+			if err != nil {
+				return err
+			}
+		case opBrTable:
+			sp--
+			table := function.branchTables[ins.a]
+			index := uint64(uint32(stack[sp]))
+			if index >= uint64(len(table)) {
+				index = uint64(len(table) - 1)
+			}
+			target := &table[index]
+			sp = branch(stack, base, sp, target.arity, target.height)
+			pc = int(target.pc)
+			err := instance.checkGas()
+			if err != nil {
+				return err
+			}
+		case opReturn:
+			return instance.returnFrom(function, fp, sp)
+		case opCall, opCallIndirect:
+			functionIndex := ins.a
+			if ins.opcode == opCallIndirect {
+				sp--
+				var err error
+				functionIndex, err = instance.resolveIndirectCall(ins.a, uint32(stack[sp]))
+				if err != nil {
+					return err
+				}
+			}
+			signature := instance.compiled.signatures[functionIndex]
+			calleeFp := sp - len(signature.params)
+			err := instance.checkGas()
+			if err != nil {
+				return err
+			}
+			err = instance.callFunction(functionIndex, calleeFp)
+			if err != nil {
+				return err
+			}
+			stack = instance.stack
+			sp = calleeFp + len(signature.results)
+		case opDrop:
+			sp--
+		case opSelect, opTypedSelect:
+			sp -= 2
+			if uint32(stack[sp+1]) == 0 {
+				stack[sp-1] = stack[sp]
+			}
+		case opLocalGet:
+			stack[sp] = stack[fp+int(ins.a)]
+			sp++
+		case opLocalSet:
+			sp--
+			stack[fp+int(ins.a)] = stack[sp]
+		case opLocalTee:
+			stack[fp+int(ins.a)] = stack[sp-1]
+		case opGlobalGet:
+			stack[sp] = instance.globals[ins.a]
+			sp++
+		case opGlobalSet:
+			sp--
+			instance.globals[ins.a] = stack[sp]
+		case opI32Load, opI64Load, opI32Load8S, opI32Load8U, opI32Load16S, opI32Load16U,
+			opI64Load8S, opI64Load8U, opI64Load16S, opI64Load16U, opI64Load32S, opI64Load32U:
+			value, err := instance.load(ins.opcode, uint64(uint32(stack[sp-1]))+ins.c)
+			if err != nil {
+				return err
+			}
+			stack[sp-1] = value
+		case opI32Store, opI64Store, opI32Store8, opI32Store16, opI64Store8, opI64Store16, opI64Store32:
+			sp -= 2
+			err := instance.store(ins.opcode, uint64(uint32(stack[sp]))+ins.c, stack[sp+1])
+			if err != nil {
+				return err
+			}
+		case opMemorySize:
+			stack[sp] = uint64(instance.memory.pages())
+			sp++
+		case opMemoryGrow:
+			result, err := instance.growMemory(uint32(stack[sp-1]))
+			if err != nil {
+				return err
+			}
+			stack[sp-1] = uint64(uint32(result))
+		case opI32Const, opI64Const:
+			stack[sp] = ins.c
+			sp++
+		case opI32Eqz:
+			stack[sp-1] = boolToValue(uint32(stack[sp-1]) == 0)
+		case opI64Eqz:
+			stack[sp-1] = boolToValue(stack[sp-1] == 0)
+		case opI32Clz:
+			stack[sp-1] = uint64(bits.LeadingZeros32(uint32(stack[sp-1])))
+		case opI32Ctz:
+			stack[sp-1] = uint64(bits.TrailingZeros32(uint32(stack[sp-1])))
+		case opI32Popcnt:
+			stack[sp-1] = uint64(bits.OnesCount32(uint32(stack[sp-1])))
+		case opI64Clz:
+			stack[sp-1] = uint64(bits.LeadingZeros64(stack[sp-1]))
+		case opI64Ctz:
+			stack[sp-1] = uint64(bits.TrailingZeros64(stack[sp-1]))
+		case opI64Popcnt:
+			stack[sp-1] = uint64(bits.OnesCount64(stack[sp-1]))
+		case opI32WrapI64, opI64ExtendI32U:
+			stack[sp-1] = uint64(uint32(stack[sp-1]))
+		case opI64ExtendI32S:
+			stack[sp-1] = uint64(int64(int32(uint32(stack[sp-1]))))
+		case opI32Extend8S:
+			stack[sp-1] = uint64(uint32(int32(int8(stack[sp-1]))))
+		case opI32Extend16S:
+			stack[sp-1] = uint64(uint32(int32(int16(stack[sp-1]))))
+		case opI64Extend8S:
+			stack[sp-1] = uint64(int64(int8(stack[sp-1])))
+		case opI64Extend16S:
+			stack[sp-1] = uint64(int64(int16(stack[sp-1])))
+		case opI64Extend32S:
+			stack[sp-1] = uint64(int64(int32(stack[sp-1])))
+		case opMiscMemoryInit:
+			sp -= 3
+			err := instance.initMemory(ins.a, uint32(stack[sp]), uint32(stack[sp+1]), uint32(stack[sp+2]))
+			if err != nil {
+				return err
+			}
+		case opMiscDataDrop:
+			instance.droppedData[ins.a] = true
+		case opMiscMemoryCopy:
+			sp -= 3
+			err := instance.copyMemory(uint32(stack[sp]), uint32(stack[sp+1]), uint32(stack[sp+2]))
+			if err != nil {
+				return err
+			}
+		case opMiscMemoryFill:
+			sp -= 3
+			err := instance.fillMemory(uint32(stack[sp]), byte(stack[sp+1]), uint32(stack[sp+2]))
+			if err != nil {
+				return err
+			}
+		default:
+			sp--
+			var err error
+			if isI32Binary(ins.opcode) {
+				stack[sp-1], err = executeI32Binary(ins.opcode, uint32(stack[sp-1]), uint32(stack[sp]))
+			} else {
+				stack[sp-1], err = executeI64Binary(ins.opcode, stack[sp-1], stack[sp])
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// returnFrom moves the results of the function to the start of its frame
+func (instance *Instance) returnFrom(function *compiledFunction, fp int, sp int) error {
+	numResults := len(function.signature.results)
+	if numResults > 0 {
+		copy(instance.stack[fp:fp+numResults], instance.stack[sp-numResults:sp])
+	}
+	return instance.checkGas()
+}
+
+func (instance *Instance) resolveIndirectCall(typeIndex uint32, elementIndex uint32) (uint32, error) {
+	if elementIndex >= uint32(len(instance.table)) {
+		return 0, ErrUndefinedElement
+	}
+	functionIndex := instance.table[elementIndex]
+	if functionIndex == nullElement {
+		return 0, ErrUndefinedElement
+	}
+	if !instance.compiled.signatures[functionIndex].equals(&instance.compiled.module.types[typeIndex]) {
+		return 0, ErrIndirectCallTypeMismatch
+	}
+	return functionIndex, nil
+}
+
+func isI32Binary(opcode uint16) bool {
+	return opcode >= opI32Eq && opcode <= opI32GeU || opcode >= opI32Add && opcode <= opI32Rotr
+}
+
+// executeI32Binary implements the comparisons and the binary operators on 32 bit integers
+func executeI32Binary(opcode uint16, a uint32, b uint32) (uint64, error) {
+	switch opcode {
+	case opI32Eq:
+		return boolToValue(a == b), nil
+	case opI32Ne:
+		return boolToValue(a != b), nil
+	case opI32LtS:
+		return boolToValue(int32(a) < int32(b)), nil
+	case opI32LtU:
+		return boolToValue(a < b), nil
+	case opI32GtS:
+		return boolToValue(int32(a) > int32(b)), nil
+	case opI32GtU:
+		return boolToValue(a > b), nil
+	case opI32LeS:
+		return boolToValue(int32(a) <= int32(b)), nil
+	case opI32LeU:
+		return boolToValue(a <= b), nil
+	case opI32GeS:
+		return boolToValue(int32(a) >= int32(b)), nil
+	case opI32GeU:
+		return boolToValue(a >= b), nil
+	case opI32Add:
+		return uint64(a + b), nil
+	case opI32Sub:
+		return uint64(a - b), nil
+	case opI32Mul:
+		return uint64(a * b), nil
+	case opI32DivS:
+		if b == 0 {
+			return 0, ErrIntegerDivideByZero
+		}
+		if int32(a) == math.MinInt32 && int32(b) == -1 {
+			return 0, ErrIntegerOverflow
+		}
+		return uint64(uint32(int32(a) / int32(b))), nil
+	case opI32DivU:
+		if b == 0 {
+			return 0, ErrIntegerDivideByZero
+		}
+		return uint64(a / b), nil
+	case opI32RemS:
+		if b == 0 {
+			return 0, ErrIntegerDivideByZero
+		}
+		return uint64(uint32(int32(a) % int32(b))), nil
+	case opI32RemU:
+		if b == 0 {
+			return 0, ErrIntegerDivideByZero
+		}
+		return uint64(a % b), nil
+	case opI32And:
+		return uint64(a & b), nil
+	case opI32Or:
+		return uint64(a | b), nil
+	case opI32Xor:
+		return uint64(a ^ b), nil
+	case opI32Shl:
+		return uint64(a << (b & 31)), nil
+	case opI32ShrS:
+		return uint64(uint32(int32(a) >> (b & 31))), nil
+	case opI32ShrU:
+		return uint64(a >> (b & 31)), nil
+	case opI32Rotl:
+		return uint64(bits.RotateLeft32(a, int(b&31))), nil
+	case opI32Rotr:
+		return uint64(bits.RotateLeft32(a, -int(b&31))), nil
+	}
+	return 0, ErrUnsupportedInstruction
+}
+
+// executeI64Binary implements the comparisons and the binary operators on 64 bit integers
+func executeI64Binary(opcode uint16, a uint64, b uint64) (uint64, error) {
+	switch opcode {
+	case opI64Eq:
+		return boolToValue(a == b), nil
+	case opI64Ne:
+		return boolToValue(a != b), nil
+	case opI64LtS:
+		return boolToValue(int64(a) < int64(b)), nil
+	case opI64LtU:
+		return boolToValue(a < b), nil
+	case opI64GtS:
+		return boolToValue(int64(a) > int64(b)), nil
+	case opI64GtU:
+		return boolToValue(a > b), nil
+	case opI64LeS:
+		return boolToValue(int64(a) <= int64(b)), nil
+	case opI64LeU:
+		return boolToValue(a <= b), nil
+	case opI64GeS:
+		return boolToValue(int64(a) >= int64(b)), nil
+	case opI64GeU:
+		return boolToValue(a >= b), nil
+	case opI64Add:
+		return a + b, nil
+	case opI64Sub:
+		return a - b, nil
+	case opI64Mul:
+		return a * b, nil
+	case opI64DivS:
+		if b == 0 {
+			return 0, ErrIntegerDivideByZero
+		}
+		if int64(a) == math.MinInt64 && int64(b) == -1 {
+			return 0, ErrIntegerOverflow
+		}
+		return uint64(int64(a) / int64(b)), nil
+	case opI64DivU:
+		if b == 0 {
+			return 0, ErrIntegerDivideByZero
+		}
+		return a / b, nil
+	case opI64RemS:
+		if b == 0 {
+			return 0, ErrIntegerDivideByZero
+		}
+		return uint64(int64(a) % int64(b)), nil
+	case opI64RemU:
+		if b == 0 {
+			return 0, ErrIntegerDivideByZero
+		}
+		return a % b, nil
+	case opI64And:
+		return a & b, nil
+	case opI64Or:
+		return a | b, nil
+	case opI64Xor:
+		return a ^ b, nil
+	case opI64Shl:
+		return a << (b & 63), nil
+	case opI64ShrS:
+		return uint64(int64(a) >> (b & 63)), nil
+	case opI64ShrU:
+		return a >> (b & 63), nil
+	case opI64Rotl:
+		return bits.RotateLeft64(a, int(b&63)), nil
+	case opI64Rotr:
+		return bits.RotateLeft64(a, -int(b&63)), nil
+	}
+	return 0, ErrUnsupportedInstruction
+}
+
+func loadSize(opcode uint16) uint64 {
+	switch opcode {
+	case opI32Load8S, opI32Load8U, opI64Load8S, opI64Load8U, opI32Store8, opI64Store8:
+		return 1
+	case opI32Load16S, opI32Load16U, opI64Load16S, opI64Load16U, opI32Store16, opI64Store16:
+		return 2
+	case opI32Load, opI64Load32S, opI64Load32U, opI32Store, opI64Store32:
+		return 4
+	}
+	return 8
+}
+
+func (instance *Instance) load(opcode uint16, address uint64) (uint64, error) {
+	bytes, ok := instance.memory.access(address, loadSize(opcode))
+	if !ok {
+		return 0, ErrOutOfBoundsMemoryAccess
+	}
+	switch opcode {
+	case opI32Load, opI64Load32U:
+		return uint64(binary.LittleEndian.Uint32(bytes)), nil
+	case opI64Load:
+		return binary.LittleEndian.Uint64(bytes), nil
+	case opI32Load8S:
+		return uint64(uint32(int32(int8(bytes[0])))), nil
+	case opI32Load8U, opI64Load8U:
+		return uint64(bytes[0]), nil
+	case opI32Load16S:
+		return uint64(uint32(int32(int16(binary.LittleEndian.Uint16(bytes))))), nil
+	case opI32Load16U, opI64Load16U:
+		return uint64(binary.LittleEndian.Uint16(bytes)), nil
+	case opI64Load8S:
+		return uint64(int64(int8(bytes[0]))), nil
+	case opI64Load16S:
+		return uint64(int64(int16(binary.LittleEndian.Uint16(bytes)))), nil
+	case opI64Load32S:
+		return uint64(int64(int32(binary.LittleEndian.Uint32(bytes)))), nil
+	}
+	return 0, ErrUnsupportedInstruction
+}
+
+func (instance *Instance) store(opcode uint16, address uint64, value uint64) error {
+	bytes, ok := instance.memory.access(address, loadSize(opcode))
+	if !ok {
+		return ErrOutOfBoundsMemoryAccess
+	}
+	switch len(bytes) {
+	case 1:
+		bytes[0] = byte(value)
+	case 2:
+		binary.LittleEndian.PutUint16(bytes, uint16(value))
+	case 4:
+		binary.LittleEndian.PutUint32(bytes, uint32(value))
+	default:
+		binary.LittleEndian.PutUint64(bytes, value)
+	}
+	return nil
+}
+
+// growMemory implements memory.grow, enforcing the limits of the compilation options; a zero limit is not enforced
+func (instance *Instance) growMemory(pages uint32) (int32, error) {
+	instance.memoryGrowCount++
+	maxMemoryGrow := instance.options.MaxMemoryGrow
+	maxMemoryGrowDelta := instance.options.MaxMemoryGrowDelta
+	if maxMemoryGrow > 0 && instance.memoryGrowCount > maxMemoryGrow ||
+		maxMemoryGrowDelta > 0 && uint64(pages) > maxMemoryGrowDelta {
+		instance.breakpoint = breakpointMemoryLimit
+		return 0, ErrMemoryLimit
+	}
+	return instance.memory.grow(pages), nil
+}
+
+func (instance *Instance) initMemory(dataIndex uint32, destination uint32, source uint32, length uint32) error {
+	var data []byte
+	if !instance.droppedData[dataIndex] {
+		data = instance.compiled.module.data[dataIndex].data
+	}
+	if uint64(source)+uint64(length) > uint64(len(data)) {
+		return ErrOutOfBoundsMemoryAccess
+	}
+	bytes, ok := instance.memory.access(uint64(destination), uint64(length))
+	if !ok {
+		return ErrOutOfBoundsMemoryAccess
+	}
+	copy(bytes, data[source:source+length])
+	return nil
+}
+
+func (instance *Instance) copyMemory(destination uint32, source uint32, length uint32) error {
+	destinationBytes, ok := instance.memory.access(uint64(destination), uint64(length))
+	if !ok {
+		return ErrOutOfBoundsMemoryAccess
+	}
+	sourceBytes, ok := instance.memory.access(uint64(source), uint64(length))
+	if !ok {
+		return ErrOutOfBoundsMemoryAccess
+	}
+	copy(destinationBytes, sourceBytes)
+	return nil
+}
+
+func (instance *Instance) fillMemory(destination uint32, value byte, length uint32) error {
+	bytes, ok := instance.memory.access(uint64(destination), uint64(length))
+	if !ok {
+		return ErrOutOfBoundsMemoryAccess
+	}
+	for i := range bytes {
+		bytes[i] = value
+	}
+	return nil
+}
@@ -0,0 +1,435 @@
+package wasmgo
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen/wasmanalysis"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/executor"
+)
+
+// compiledModule is a module with its functions compiled and its imports resolved, shared by all its instances
+type compiledModule struct {
+	module     *module
+	code       []byte
+	imports    []*resolvedImport
+	functions  []*compiledFunction
+	signatures []*functionType
+
+	debugInfoOnce sync.Once
+	debugInfo     *wasmanalysis.DebugInfo
+}
+
+type resolvedImport struct {
+	signature      *functionType
+	implementation reflect.Value
+}
+
+func compileModule(code []byte, imports *executor.Imports) (*compiledModule, error) {
+	code = append([]byte(nil), code...)
+	m, err := decodeModule(code)
+	if err != nil {
+		return nil, err
+	}
+
+	compiled := &compiledModule{
+		module:     m,
+		code:       code,
+		signatures: make([]*functionType, m.numFunctions()),
+	}
+	for i := range compiled.signatures {
+		compiled.signatures[i], err = m.functionType(uint32(i))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidModule, err.Error())
+		}
+	}
+	for _, imported := range m.imports {
+		resolved, err := resolveImport(imports, imported, &m.types[imported.typeIndex])
+		if err != nil {
+			return nil, err
+		}
+		compiled.imports = append(compiled.imports, resolved)
+	}
+	for i := range m.bodies {
+		function, err := compileFunction(m, i)
+		if err != nil {
+			return nil, fmt.Errorf("function %d: %w", len(m.imports)+i, err)
+		}
+		compiled.functions = append(compiled.functions, function)
+	}
+	return compiled, nil
+}
+
+// resolveImport finds the implementation of an imported function, and checks that it has the signature that the module expects
+func resolveImport(imports *executor.Imports, imported importedFunction, signature *functionType) (*resolvedImport, error) {
+	implementation, ok := imports.Implementation(imported.module, imported.name)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s.%s is not provided", ErrUnsupportedImport, imported.module, imported.name)
+	}
+
+	implementationType := reflect.TypeOf(implementation)
+	matches := implementationType.NumIn() == len(signature.params)+1 && implementationType.NumOut() == len(signature.results)
+	for i := 0; matches && i < len(signature.params); i++ {
+		matches = kindMatches(implementationType.In(i+1).Kind(), signature.params[i])
+	}
+	for i := 0; matches && i < len(signature.results); i++ {
+		matches = kindMatches(implementationType.Out(i).Kind(), signature.results[i])
+	}
+	if !matches {
+		return nil, fmt.Errorf("%w: %s.%s has another signature", ErrUnsupportedImport, imported.module, imported.name)
+	}
+
+	return &resolvedImport{
+		signature:      signature,
+		implementation: reflect.ValueOf(implementation),
+	}, nil
+}
+
+func kindMatches(kind reflect.Kind, vt valueType) bool {
+	return kind == reflect.Int32 && vt == valueTypeI32 || kind == reflect.Int64 && vt == valueTypeI64
+}
+
+// Instance is an instance of a module, interpreted by this backend; it implements executor.InstanceHandler
+type Instance struct {
+	compiled          *compiledModule
+	options           executor.CompilationOptions
+	costs             [executor.OPCODE_COUNT]uint32
+	memory            *Memory
+	hasExportedMemory bool
+	globals           []uint64
+	table             []uint32
+	droppedData       []bool
+	exports           executor.ExportsMap
+	signatures        executor.ExportSignaturesMap
+
+	stack           []uint64
+	top             int
+	depth           int
+	pointsUsed      uint64
+	gasLimit        uint64
+	breakpoint      uint64
+	memoryGrowCount uint64
+	data            uintptr
+}
+
+func newInstance(compiled *compiledModule, options executor.CompilationOptions, costs *[executor.OPCODE_COUNT]uint32) (*Instance, error) {
+	m := compiled.module
+	instance := &Instance{
+		compiled:    compiled,
+		options:     options,
+		costs:       *costs,
+		gasLimit:    options.GasLimit,
+		droppedData: make([]bool, len(m.data)),
+		exports:     make(executor.ExportsMap),
+		signatures:  make(executor.ExportSignaturesMap),
+	}
+
+	err := instance.initGlobals()
+	if err != nil {
+		return nil, err
+	}
+	err = instance.initLinearMemory()
+	if err != nil {
+		return nil, err
+	}
+	err = instance.initTable()
+	if err != nil {
+		return nil, err
+	}
+	instance.initExports()
+
+	registerInstance(instance)
+	if m.start >= 0 {
+		err = instance.callFunction(uint32(m.start), 0)
+		if err != nil {
+			instance.Clean()
+			return nil, fmt.Errorf("start function: %w", err)
+		}
+	}
+	return instance, nil
+}
+
+func (instance *Instance) evaluate(expression constantExpression) (uint64, error) {
+	if expression.opcode != opGlobalGet {
+		return expression.value, nil
+	}
+	if expression.value >= uint64(len(instance.globals)) {
+		return 0, fmt.Errorf("%w: initializer reads the undefined global %d", ErrInvalidModule, expression.value)
+	}
+	return instance.globals[expression.value], nil
+}
+
+func (instance *Instance) initGlobals() error {
+	for _, g := range instance.compiled.module.globals {
+		value, err := instance.evaluate(g.init)
+		if err != nil {
+			return err
+		}
+		instance.globals = append(instance.globals, value)
+	}
+	return nil
+}
+
+func (instance *Instance) initLinearMemory() error {
+	m := instance.compiled.module
+	if m.memory == nil {
+		return nil
+	}
+	instance.memory = newMemory(m.memory)
+	for _, exported := range m.exports {
+		if exported.kind == externalMemory {
+			instance.hasExportedMemory = true
+		}
+	}
+
+	for i, segment := range m.data {
+		if segment.passive {
+			continue
+		}
+		offset, err := instance.evaluate(segment.offset)
+		if err != nil {
+			return err
+		}
+		bytes, ok := instance.memory.access(uint64(uint32(offset)), uint64(len(segment.data)))
+		if !ok {
+			return fmt.Errorf("%w: data segment %d does not fit in the memory", ErrInvalidModule, i)
+		}
+		copy(bytes, segment.data)
+		instance.droppedData[i] = true
+	}
+	return nil
+}
+
+func (instance *Instance) initTable() error {
+	m := instance.compiled.module
+	if m.table == nil {
+		return nil
+	}
+	instance.table = make([]uint32, m.table.initial)
+	for i := range instance.table {
+		instance.table[i] = nullElement
+	}
+
+	for i, segment := range m.elements {
+		offset, err := instance.evaluate(segment.offset)
+		if err != nil {
+			return err
+		}
+		start := uint64(uint32(offset))
+		if start+uint64(len(segment.functions)) > uint64(len(instance.table)) {
+			return fmt.Errorf("%w: element segment %d does not fit in the table", ErrInvalidModule, i)
+		}
+		for j, functionIndex := range segment.functions {
+			if int(functionIndex) >= m.numFunctions() {
+				return fmt.Errorf("%w: element segment %d references the undefined function %d", ErrInvalidModule, i, functionIndex)
+			}
+			instance.table[start+uint64(j)] = functionIndex
+		}
+	}
+	return nil
+}
+
+func (instance *Instance) initExports() {
+	m := instance.compiled.module
+	for _, name := range m.exportNames {
+		exported := m.exports[name]
+		if exported.kind != externalFunction {
+			continue
+		}
+		signature := instance.compiled.signatures[exported.index]
+		instance.exports[name] = instance.exportedFunction(name, exported.index)
+		instance.signatures[name] = &executor.ExportedFunctionSignature{
+			InputArity:  len(signature.params),
+			OutputArity: len(signature.results),
+		}
+	}
+}
+
+func (instance *Instance) exportedFunction(name string, functionIndex uint32) executor.ExportedFunctionCallback {
+	return func(arguments ...interface{}) (executor.Value, error) {
+		return instance.invoke(name, functionIndex, arguments)
+	}
+}
+
+// invoke calls an exported function; the instance can be invoked again by the imported functions it calls
+func (instance *Instance) invoke(name string, functionIndex uint32, arguments []interface{}) (executor.Value, error) {
+	signature := instance.compiled.signatures[functionIndex]
+	if len(arguments) != len(signature.params) {
+		return executor.Void(), fmt.Errorf("%w: the `%s` exported function expects %d argument(s), given %d",
+			ErrInvalidArguments, name, len(signature.params), len(arguments))
+	}
+
+	fp := instance.top
+	instance.ensureStack(fp + len(arguments))
+	for i, argument := range arguments {
+		value, ok := argumentValue(argument, signature.params[i])
+		if !ok {
+			return executor.Void(), fmt.Errorf("%w: argument #%d of the `%s` exported function has the wrong type",
+				ErrInvalidArguments, i+1, name)
+		}
+		instance.stack[fp+i] = value
+	}
+
+	err := instance.callFunction(functionIndex, fp)
+	instance.top = fp
+	if err != nil {
+		trapErr := executor.GetTrapError(err)
+		if trapErr != nil {
+			instance.compiled.symbolize(trapErr.Frames)
+		}
+		return executor.Void(), fmt.Errorf("Failed to call the `%s` exported function: %w", name, err)
+	}
+	if len(signature.results) == 0 {
+		return executor.Void(), nil
+	}
+	if signature.results[0] == valueTypeI32 {
+		return executor.I32(int32(uint32(instance.stack[fp]))), nil
+	}
+	return executor.I64(int64(instance.stack[fp])), nil
+}
+
+// argumentValue converts the arguments of exported functions, accepting the same types as the Wasmer instances
+func argumentValue(argument interface{}, vt valueType) (uint64, bool) {
+	var value int64
+	switch typedArgument := argument.(type) {
+	case int8:
+		value = int64(typedArgument)
+	case uint8:
+		value = int64(typedArgument)
+	case int16:
+		value = int64(typedArgument)
+	case uint16:
+		value = int64(typedArgument)
+	case int32:
+		value = int64(typedArgument)
+	case uint32:
+		value = int64(typedArgument)
+	case int64:
+		value = typedArgument
+	case int:
+		value = int64(typedArgument)
+	case uint:
+		value = int64(typedArgument)
+	case executor.Value:
+		switch {
+		case typedArgument.GetType() == executor.TypeI32 && vt == valueTypeI32:
+			return uint64(uint32(typedArgument.ToI32())), true
+		case typedArgument.GetType() == executor.TypeI64 && vt == valueTypeI64:
+			return uint64(typedArgument.ToI64()), true
+		}
+		return 0, false
+	default:
+		return 0, false
+	}
+
+	if vt == valueTypeI32 {
+		return uint64(uint32(value)), true
+	}
+	return uint64(value), true
+}
+
+// HasMemory returns true if the instance exports its memory
+func (instance *Instance) HasMemory() bool {
+	return instance.hasExportedMemory
+}
+
+// SetContextData sets the data that the imported functions retrieve from their context
+func (instance *Instance) SetContextData(data uintptr) {
+	instance.data = data
+}
+
+// GetData returns the data set by SetContextData
+func (instance *Instance) GetData() uintptr {
+	return instance.data
+}
+
+// GetPointsUsed returns the points used by the instance so far
+func (instance *Instance) GetPointsUsed() uint64 {
+	return instance.pointsUsed
+}
+
+// SetPointsUsed sets the points used by the instance
+func (instance *Instance) SetPointsUsed(points uint64) {
+	instance.pointsUsed = points
+}
+
+// SetGasLimit sets the number of points over which the execution stops
+func (instance *Instance) SetGasLimit(gasLimit uint64) {
+	instance.gasLimit = gasLimit
+}
+
+// SetBreakpointValue sets the breakpoint value
+func (instance *Instance) SetBreakpointValue(value uint64) {
+	instance.breakpoint = value
+}
+
+// GetBreakpointValue returns the breakpoint value
+func (instance *Instance) GetBreakpointValue() uint64 {
+	return instance.breakpoint
+}
+
+// Cache returns the compiled code of the instance, which the instance builder accepts as precompiled code
+func (instance *Instance) Cache() ([]byte, error) {
+	compiledCode := make([]byte, 0, len(compiledCodeMarker)+len(instance.compiled.code))
+	compiledCode = append(compiledCode, compiledCodeMarker...)
+	compiledCode = append(compiledCode, instance.compiled.code...)
+	return compiledCode, nil
+}
+
+// Clean releases the instance, which can no longer be used
+func (instance *Instance) Clean() {
+	unregisterInstance(instance)
+	if instance.memory != nil {
+		instance.memory.Destroy()
+	}
+	instance.stack = nil
+}
+
+// GetExports returns the exported functions
+func (instance *Instance) GetExports() executor.ExportsMap {
+	return instance.exports
+}
+
+// GetSignature returns the signature of the given exported function
+func (instance *Instance) GetSignature(functionName string) (*executor.ExportedFunctionSignature, bool) {
+	signature, ok := instance.signatures[functionName]
+	return signature, ok
+}
+
+// GetInstanceCtxMemory returns the memory of the instance, the same as GetMemory
+func (instance *Instance) GetInstanceCtxMemory() executor.MemoryHandler {
+	return instance.GetMemory()
+}
+
+// GetMemory returns the exported memory of the instance, or nil if it does not export one
+func (instance *Instance) GetMemory() executor.MemoryHandler {
+	if !instance.hasExportedMemory {
+		return nil
+	}
+	return instance.memory
+}
+
+// SetMemory replaces the contents of the memory, returns true if it succeeded
+func (instance *Instance) SetMemory(data []byte) bool {
+	if !instance.hasExportedMemory || len(instance.memory.data) != len(data) {
+		return false
+	}
+	copy(instance.memory.data, data)
+	return true
+}
+
+// IsFunctionImported returns true if the module imports a function with the given name
+func (instance *Instance) IsFunctionImported(name string) bool {
+	for _, imported := range instance.compiled.module.imports {
+		if imported.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (instance *Instance) IsInterfaceNil() bool {
+	return instance == nil
+}
@@ -0,0 +1,429 @@
+package wasmgo
+
+import (
+	"errors"
+	"testing"
+	"unsafe"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/executor"
+	"github.com/stretchr/testify/require"
+)
+
+const i32 = byte(valueTypeI32)
+const i64 = byte(valueTypeI64)
+
+type testImport struct {
+	name    string
+	params  []byte
+	results []byte
+}
+
+type testFunction struct {
+	export  string
+	params  []byte
+	results []byte
+	locals  []byte
+	code    []byte
+}
+
+type testModule struct {
+	imports        []testImport
+	functions      []testFunction
+	memory         bool
+	customSections [][]byte
+}
+
+func uleb(value uint32) []byte {
+	result := make([]byte, 0)
+	for {
+		b := byte(value & 0x7f)
+		value >>= 7
+		if value == 0 {
+			return append(result, b)
+		}
+		result = append(result, b|0x80)
+	}
+}
+
+func vector(items ...[]byte) []byte {
+	result := uleb(uint32(len(items)))
+	for _, item := range items {
+		result = append(result, item...)
+	}
+	return result
+}
+
+func name(str string) []byte {
+	return append(uleb(uint32(len(str))), str...)
+}
+
+func section(id byte, contents []byte) []byte {
+	return append(append([]byte{id}, uleb(uint32(len(contents)))...), contents...)
+}
+
+func functionTypeBytes(params []byte, results []byte) []byte {
+	result := append([]byte{functionTypeForm}, vector(bytesToItems(params)...)...)
+	return append(result, vector(bytesToItems(results)...)...)
+}
+
+func bytesToItems(bytes []byte) [][]byte {
+	items := make([][]byte, len(bytes))
+	for i, b := range bytes {
+		items[i] = []byte{b}
+	}
+	return items
+}
+
+// assemble encodes the module, with one type per imported or defined function
+func (tm *testModule) assemble() []byte {
+	types := make([][]byte, 0)
+	imports := make([][]byte, 0)
+	functions := make([][]byte, 0)
+	exports := make([][]byte, 0)
+	bodies := make([][]byte, 0)
+
+	for _, imported := range tm.imports {
+		imports = append(imports, append(append(name("env"), name(imported.name)...), externalFunction, byte(len(types))))
+		types = append(types, functionTypeBytes(imported.params, imported.results))
+	}
+	for i, function := range tm.functions {
+		functions = append(functions, uleb(uint32(len(types))))
+		types = append(types, functionTypeBytes(function.params, function.results))
+		if len(function.export) > 0 {
+			exports = append(exports, append(name(function.export), externalFunction, byte(len(tm.imports)+i)))
+		}
+		locals := make([][]byte, len(function.locals))
+		for j, local := range function.locals {
+			locals[j] = []byte{1, local}
+		}
+		body := append(vector(locals...), function.code...)
+		bodies = append(bodies, append(uleb(uint32(len(body))), body...))
+	}
+
+	code := []byte(wasmMagic + wasmVersion)
+	code = append(code, section(sectionType, vector(types...))...)
+	if len(imports) > 0 {
+		code = append(code, section(sectionImport, vector(imports...))...)
+	}
+	code = append(code, section(sectionFunction, vector(functions...))...)
+	if tm.memory {
+		code = append(code, section(sectionMemory, vector([]byte{1, 1, 4}))...)
+		exports = append(exports, append(name("memory"), externalMemory, 0))
+	}
+	code = append(code, section(sectionExport, vector(exports...))...)
+	code = append(code, section(sectionCode, vector(bodies...))...)
+	for _, custom := range tm.customSections {
+		code = append(code, section(sectionCustom, custom)...)
+	}
+	return code
+}
+
+func unitCosts() *[executor.OPCODE_COUNT]uint32 {
+	costs := &[executor.OPCODE_COUNT]uint32{}
+	for i := range costs {
+		costs[i] = 1
+	}
+	return costs
+}
+
+func newTestInstance(t *testing.T, tm *testModule, imports *executor.Imports, options executor.CompilationOptions) executor.InstanceHandler {
+	if imports == nil {
+		imports = executor.NewImports()
+	}
+	builder, err := NewInstanceBuilder(imports, unitCosts())
+	require.Nil(t, err)
+	instance, err := builder.NewInstanceWithOptions(tm.assemble(), options)
+	require.Nil(t, err)
+	return instance
+}
+
+func call(t *testing.T, instance executor.InstanceHandler, function string, arguments ...interface{}) (executor.Value, error) {
+	exported, ok := instance.GetExports()[function]
+	require.True(t, ok)
+	return exported(arguments...)
+}
+
+func TestInstance_Arithmetic(t *testing.T) {
+	instance := newTestInstance(t, &testModule{functions: []testFunction{
+		{export: "add", params: []byte{i32, i32}, results: []byte{i32}, code: []byte{0x20, 0, 0x20, 1, 0x6a, 0x0b}},
+		{export: "sub64", params: []byte{i64, i64}, results: []byte{i64}, code: []byte{0x20, 0, 0x20, 1, 0x7d, 0x0b}},
+		{export: "extend", params: []byte{i32}, results: []byte{i64}, code: []byte{0x20, 0, 0xac, 0x0b}},
+	}}, nil, executor.CompilationOptions{})
+	defer instance.Clean()
+
+	result, err := call(t, instance, "add", 3, 4)
+	require.Nil(t, err)
+	require.Equal(t, int32(7), result.ToI32())
+
+	result, err = call(t, instance, "add", int32(-1), executor.I32(-2))
+	require.Nil(t, err)
+	require.Equal(t, int32(-3), result.ToI32())
+
+	result, err = call(t, instance, "sub64", int64(1), int64(3))
+	require.Nil(t, err)
+	require.Equal(t, int64(-2), result.ToI64())
+
+	result, err = call(t, instance, "extend", -5)
+	require.Nil(t, err)
+	require.Equal(t, int64(-5), result.ToI64())
+
+	signature, ok := instance.GetSignature("add")
+	require.True(t, ok)
+	require.Equal(t, &executor.ExportedFunctionSignature{InputArity: 2, OutputArity: 1}, signature)
+
+	_, err = call(t, instance, "add", 3)
+	require.True(t, errors.Is(err, ErrInvalidArguments))
+	_, err = call(t, instance, "add", 3, executor.I64(4))
+	require.True(t, errors.Is(err, ErrInvalidArguments))
+}
+
+func TestInstance_ControlFlow(t *testing.T) {
+	factorial := []byte{
+		0x20, 0, 0x50, // local.get 0, i64.eqz
+		0x04, i64, 0x42, 1, // if (result i64), i64.const 1
+		0x05, 0x20, 0, 0x20, 0, 0x42, 1, 0x7d, 0x10, 0, 0x7e, // else n * factorial(n - 1)
+		0x0b, 0x0b,
+	}
+	branchTable := []byte{
+		0x02, 0x40, 0x02, 0x40, 0x02, 0x40, // three nested blocks
+		0x20, 0, 0x0e, 2, 0, 1, 2, 0x0b, // br_table on the parameter
+		0x41, 10, 0x0f, 0x0b, // return 10
+		0x41, 20, 0x0f, 0x0b, // return 20
+		0x41, 30, 0x0b, // 30 by default
+	}
+	sumLoop := []byte{
+		0x03, 0x40, // loop
+		0x20, 1, 0x20, 0, 0x6a, 0x21, 1, // sum += n
+		0x20, 0, 0x41, 1, 0x6b, 0x22, 0, // n--
+		0x0d, 0, 0x0b, // br_if while n != 0
+		0x20, 1, 0x0b,
+	}
+	instance := newTestInstance(t, &testModule{functions: []testFunction{
+		{export: "factorial", params: []byte{i64}, results: []byte{i64}, code: factorial},
+		{export: "branchTable", params: []byte{i32}, results: []byte{i32}, code: branchTable},
+		{export: "sumLoop", params: []byte{i32}, results: []byte{i32}, locals: []byte{i32}, code: sumLoop},
+	}}, nil, executor.CompilationOptions{})
+	defer instance.Clean()
+
+	result, err := call(t, instance, "factorial", int64(10))
+	require.Nil(t, err)
+	require.Equal(t, int64(3628800), result.ToI64())
+
+	for argument, expected := range map[int]int32{0: 10, 1: 20, 2: 30, 100: 30} {
+		result, err = call(t, instance, "branchTable", argument)
+		require.Nil(t, err)
+		require.Equal(t, expected, result.ToI32())
+	}
+
+	result, err = call(t, instance, "sumLoop", 100)
+	require.Nil(t, err)
+	require.Equal(t, int32(5050), result.ToI32())
+}
+
+func TestInstance_Traps(t *testing.T) {
+	instance := newTestInstance(t, &testModule{functions: []testFunction{
+		{export: "divide", params: []byte{i32, i32}, results: []byte{i32}, code: []byte{0x20, 0, 0x20, 1, 0x6d, 0x0b}},
+		{export: "unreachable", code: []byte{0x00, 0x0b}},
+		{export: "recurse", code: []byte{0x10, 2, 0x0b}},
+	}}, nil, executor.CompilationOptions{})
+	defer instance.Clean()
+
+	_, err := call(t, instance, "divide", 1, 0)
+	require.True(t, errors.Is(err, ErrIntegerDivideByZero))
+	_, err = call(t, instance, "divide", int32(-2147483648), -1)
+	require.True(t, errors.Is(err, ErrIntegerOverflow))
+	_, err = call(t, instance, "unreachable")
+	require.True(t, errors.Is(err, ErrUnreachable))
+	_, err = call(t, instance, "recurse")
+	require.True(t, errors.Is(err, ErrCallStackExhausted))
+
+	result, err := call(t, instance, "divide", 7, -2)
+	require.Nil(t, err)
+	require.Equal(t, int32(-3), result.ToI32())
+}
+
+func TestInstance_TrapFrames(t *testing.T) {
+	functionNames := vector(append(uleb(0), name("outer")...), append(uleb(1), name("inner")...))
+	nameSection := append(name("name"), append([]byte{1}, append(uleb(uint32(len(functionNames))), functionNames...)...)...)
+	instance := newTestInstance(t, &testModule{
+		functions: []testFunction{
+			{export: "outer", code: []byte{0x01, 0x10, 1, 0x0b}},
+			{code: []byte{0x41, 1, 0x41, 0, 0x6d, 0x1a, 0x0b}},
+			{export: "recurse", code: []byte{0x10, 2, 0x0b}},
+		},
+		customSections: [][]byte{nameSection},
+	}, nil, executor.CompilationOptions{})
+	defer instance.Clean()
+
+	_, err := call(t, instance, "outer")
+	require.True(t, errors.Is(err, ErrIntegerDivideByZero))
+	trapErr := executor.GetTrapError(err)
+	require.NotNil(t, trapErr)
+	require.Equal(t, []executor.TrapFrame{
+		{FunctionIndex: 1, FunctionName: "inner", CodeOffset: 13},
+		{FunctionIndex: 0, FunctionName: "outer", CodeOffset: 4},
+	}, trapErr.Frames)
+	require.Equal(t, "inner (func 1) at 0xd", trapErr.Frames[0].String())
+
+	_, err = call(t, instance, "recurse")
+	require.True(t, errors.Is(err, ErrCallStackExhausted))
+	trapErr = executor.GetTrapError(err)
+	require.NotNil(t, trapErr)
+	require.Len(t, trapErr.Frames, maxTrapFrames)
+	require.Equal(t, "func 2 at 0x12", trapErr.Frames[0].String())
+}
+
+func TestInstance_Metering(t *testing.T) {
+	tm := &testModule{functions: []testFunction{
+		{export: "spin", code: []byte{0x03, 0x40, 0x0c, 0, 0x0b, 0x0b}},
+		{export: "locals", locals: []byte{i64, i64, i64}, code: []byte{0x0b}},
+	}}
+
+	instance := newTestInstance(t, tm, nil, executor.CompilationOptions{GasLimit: 1000, Metering: true})
+	defer instance.Clean()
+	_, err := call(t, instance, "spin")
+	require.True(t, errors.Is(err, ErrOutOfGas))
+	require.Equal(t, uint64(breakpointOutOfGas), instance.GetBreakpointValue())
+	require.Equal(t, uint64(1001), instance.GetPointsUsed())
+
+	instance = newTestInstance(t, tm, nil, executor.CompilationOptions{GasLimit: 1000, Metering: true, UnmeteredLocals: 1})
+	defer instance.Clean()
+	_, err = call(t, instance, "locals")
+	require.Nil(t, err)
+	require.Equal(t, uint64(2+1), instance.GetPointsUsed())
+}
+
+func TestInstance_Memory(t *testing.T) {
+	instance := newTestInstance(t, &testModule{memory: true, functions: []testFunction{
+		{export: "store", params: []byte{i32, i64}, code: []byte{0x20, 0, 0x20, 1, 0x37, 3, 0, 0x0b}},
+		{export: "load8", params: []byte{i32}, results: []byte{i32}, code: []byte{0x20, 0, 0x2c, 0, 0, 0x0b}},
+		{export: "grow", params: []byte{i32}, results: []byte{i32}, code: []byte{0x20, 0, 0x40, 0, 0x0b}},
+	}}, nil, executor.CompilationOptions{MaxMemoryGrow: 2, MaxMemoryGrowDelta: 2})
+	defer instance.Clean()
+
+	require.True(t, instance.HasMemory())
+	memory := instance.GetMemory()
+	require.Equal(t, uint32(PageSize), memory.Length())
+
+	_, err := call(t, instance, "store", 8, int64(-2))
+	require.Nil(t, err)
+	require.Equal(t, []byte{0xfe, 0xff}, memory.Data()[8:10])
+	result, err := call(t, instance, "load8", 8)
+	require.Nil(t, err)
+	require.Equal(t, int32(-2), result.ToI32())
+
+	_, err = call(t, instance, "load8", PageSize)
+	require.True(t, errors.Is(err, ErrOutOfBoundsMemoryAccess))
+	_, err = call(t, instance, "store", PageSize-4, int64(0))
+	require.True(t, errors.Is(err, ErrOutOfBoundsMemoryAccess))
+
+	result, err = call(t, instance, "grow", 2)
+	require.Nil(t, err)
+	require.Equal(t, int32(1), result.ToI32())
+	require.Equal(t, uint32(3*PageSize), instance.GetMemory().Length())
+	result, err = call(t, instance, "grow", 2)
+	require.Nil(t, err)
+	require.Equal(t, int32(-1), result.ToI32(), "the memory declares a maximum of 4 pages")
+
+	_, err = call(t, instance, "grow", 3)
+	require.True(t, errors.Is(err, ErrMemoryLimit))
+	require.Equal(t, uint64(breakpointMemoryLimit), instance.GetBreakpointValue())
+
+	clean := make([]byte, 3*PageSize)
+	require.True(t, instance.SetMemory(clean))
+	require.False(t, instance.SetMemory(clean[:PageSize]))
+}
+
+var testInstanceContexts []unsafe.Pointer
+
+func testDouble(context unsafe.Pointer, value int64) int64 {
+	testInstanceContexts = append(testInstanceContexts, context)
+	return 2 * value
+}
+
+func testSignalError(context unsafe.Pointer) {
+	testInstanceContexts = append(testInstanceContexts, context)
+	(*Instance)(context).SetBreakpointValue(3)
+}
+
+func TestInstance_Imports(t *testing.T) {
+	imports := executor.NewImports()
+	_, err := imports.Append("double", testDouble, nil)
+	require.Nil(t, err)
+	_, err = imports.Append("signalError", testSignalError, nil)
+	require.Nil(t, err)
+
+	tm := &testModule{
+		imports: []testImport{
+			{name: "double", params: []byte{i64}, results: []byte{i64}},
+			{name: "signalError"},
+		},
+		functions: []testFunction{
+			{export: "quadruple", params: []byte{i64}, results: []byte{i64}, code: []byte{0x20, 0, 0x10, 0, 0x10, 0, 0x0b}},
+			{export: "fail", code: []byte{0x10, 1, 0x41, 0, 0x1a, 0x0b}},
+		},
+	}
+	instance := newTestInstance(t, tm, imports, executor.CompilationOptions{RuntimeBreakpoints: true})
+	instance.SetContextData(0x1234)
+	require.True(t, instance.IsFunctionImported("double"))
+	require.False(t, instance.IsFunctionImported("quadruple"))
+
+	testInstanceContexts = nil
+	result, err := call(t, instance, "quadruple", int64(5))
+	require.Nil(t, err)
+	require.Equal(t, int64(20), result.ToI64())
+	require.Len(t, testInstanceContexts, 2)
+	data, ok := ContextData(testInstanceContexts[0])
+	require.True(t, ok)
+	require.Equal(t, instance.GetData(), data)
+
+	_, err = call(t, instance, "fail")
+	require.True(t, errors.Is(err, ErrBreakpoint))
+	require.Equal(t, uint64(3), instance.GetBreakpointValue())
+	require.Nil(t, executor.GetTrapError(err))
+
+	instance.Clean()
+	_, ok = ContextData(testInstanceContexts[0])
+	require.False(t, ok)
+
+	builder, err := NewInstanceBuilder(executor.NewImports(), unitCosts())
+	require.Nil(t, err)
+	_, err = builder.NewInstanceWithOptions(tm.assemble(), executor.CompilationOptions{})
+	require.True(t, errors.Is(err, ErrUnsupportedImport))
+}
+
+func TestInstanceBuilder_CompiledCode(t *testing.T) {
+	tm := &testModule{functions: []testFunction{
+		{export: "answer", results: []byte{i32}, code: []byte{0x41, 42, 0x0b}},
+	}}
+	builder, err := NewInstanceBuilder(executor.NewImports(), unitCosts())
+	require.Nil(t, err)
+	instance, err := builder.NewInstanceWithOptions(tm.assemble(), executor.CompilationOptions{})
+	require.Nil(t, err)
+	defer instance.Clean()
+
+	compiledCode, err := instance.Cache()
+	require.Nil(t, err)
+	cachedInstance, err := builder.NewInstanceFromCompiledCodeWithOptions(compiledCode, executor.CompilationOptions{})
+	require.Nil(t, err)
+	defer cachedInstance.Clean()
+	result, err := call(t, cachedInstance, "answer")
+	require.Nil(t, err)
+	require.Equal(t, int32(42), result.ToI32())
+
+	_, err = builder.NewInstanceFromCompiledCodeWithOptions(tm.assemble(), executor.CompilationOptions{})
+	require.Equal(t, ErrInvalidCompiledCode, err)
+}
+
+func TestInstanceBuilder_RejectsFloatingPoint(t *testing.T) {
+	tm := &testModule{functions: []testFunction{
+		{export: "float", code: []byte{0x43, 0, 0, 0x80, 0x3f, 0x1a, 0x0b}},
+	}}
+	builder, err := NewInstanceBuilder(executor.NewImports(), unitCosts())
+	require.Nil(t, err)
+	_, err = builder.NewInstanceWithOptions(tm.assemble(), executor.CompilationOptions{})
+	require.True(t, errors.Is(err, ErrFloatingPoint))
+
+	_, err = builder.NewInstanceWithOptions([]byte("\x00asm\x02"), executor.CompilationOptions{})
+	require.NotNil(t, err)
+}
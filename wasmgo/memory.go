@@ -0,0 +1,80 @@
+package wasmgo
+
+import (
+	"fmt"
+)
+
+// Memory is the linear memory of an instance, held in a Go slice
+type Memory struct {
+	data    []byte
+	maximum uint32
+}
+
+func newMemory(memoryLimits *limits) *Memory {
+	maximum := uint32(maxPages)
+	if memoryLimits.hasMaximum && memoryLimits.maximum < maximum {
+		maximum = memoryLimits.maximum
+	}
+	return &Memory{
+		data:    make([]byte, uint64(memoryLimits.initial)*PageSize),
+		maximum: maximum,
+	}
+}
+
+// Length returns the size of the memory, in bytes
+func (memory *Memory) Length() uint32 {
+	return uint32(len(memory.data))
+}
+
+// Data returns the contents of the memory; the slice is replaced when the memory grows
+func (memory *Memory) Data() []byte {
+	return memory.data
+}
+
+// Grow adds the given number of pages to the memory, filled with zeros
+func (memory *Memory) Grow(pages uint32) error {
+	currentPages := memory.pages()
+	if uint64(currentPages)+uint64(pages) > uint64(memory.maximum) {
+		return fmt.Errorf("%w: %d pages over %d, the maximum is %d", ErrMemoryGrow, pages, currentPages, memory.maximum)
+	}
+	if pages == 0 {
+		return nil
+	}
+	grown := make([]byte, len(memory.data)+int(pages)*PageSize)
+	copy(grown, memory.data)
+	memory.data = grown
+	return nil
+}
+
+// Destroy releases the contents of the memory
+func (memory *Memory) Destroy() {
+	memory.data = nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (memory *Memory) IsInterfaceNil() bool {
+	return memory == nil
+}
+
+func (memory *Memory) pages() uint32 {
+	return uint32(len(memory.data) / PageSize)
+}
+
+// grow implements memory.grow, yielding the previous number of pages, or -1 if the memory cannot grow
+func (memory *Memory) grow(pages uint32) int32 {
+	previousPages := memory.pages()
+	err := memory.Grow(pages)
+	if err != nil {
+		return -1
+	}
+	return int32(previousPages)
+}
+
+// access yields the slice of memory at the given address, or false if it is out of bounds
+func (memory *Memory) access(address uint64, length uint64) ([]byte, bool) {
+	end := address + length
+	if end > uint64(len(memory.data)) || end < address {
+		return nil, false
+	}
+	return memory.data[address:end], true
+}
@@ -0,0 +1,564 @@
+package wasmgo
+
+import (
+	"fmt"
+	"math"
+)
+
+const wasmMagic = "\x00asm"
+const wasmVersion = "\x01\x00\x00\x00"
+
+const (
+	sectionCustom   = 0
+	sectionType     = 1
+	sectionImport   = 2
+	sectionFunction = 3
+	sectionTable    = 4
+	sectionMemory   = 5
+	sectionGlobal   = 6
+	sectionExport   = 7
+	sectionStart    = 8
+	sectionElement  = 9
+	sectionCode     = 10
+	sectionData     = 11
+	sectionDataCnt  = 12
+)
+
+const (
+	externalFunction = 0
+	externalTable    = 1
+	externalMemory   = 2
+	externalGlobal   = 3
+)
+
+const functionTypeForm = 0x60
+const funcRefType = 0x70
+
+// PageSize is the size of a WebAssembly memory page, in bytes
+const PageSize = 65536
+
+// maxPages is the number of pages of the largest memory that 32 bit addresses reach
+const maxPages = 65536
+
+type valueType byte
+
+const (
+	valueTypeI32 valueType = 0x7f
+	valueTypeI64 valueType = 0x7e
+	valueTypeF32 valueType = 0x7d
+	valueTypeF64 valueType = 0x7c
+)
+
+func (vt valueType) isValid() bool {
+	return vt == valueTypeI32 || vt == valueTypeI64 || vt == valueTypeF32 || vt == valueTypeF64
+}
+
+type functionType struct {
+	params  []valueType
+	results []valueType
+}
+
+func (ft *functionType) equals(other *functionType) bool {
+	if len(ft.params) != len(other.params) || len(ft.results) != len(other.results) {
+		return false
+	}
+	for i, param := range ft.params {
+		if param != other.params[i] {
+			return false
+		}
+	}
+	for i, result := range ft.results {
+		if result != other.results[i] {
+			return false
+		}
+	}
+	return true
+}
+
+type importedFunction struct {
+	module    string
+	name      string
+	typeIndex uint32
+}
+
+type limits struct {
+	initial    uint32
+	maximum    uint32
+	hasMaximum bool
+}
+
+// constantExpression is an initializer: a constant, or the value of a global
+type constantExpression struct {
+	opcode byte
+	value  uint64
+}
+
+type global struct {
+	valueType valueType
+	mutable   bool
+	init      constantExpression
+}
+
+type export struct {
+	kind  byte
+	index uint32
+}
+
+type dataSegment struct {
+	passive bool
+	offset  constantExpression
+	data    []byte
+}
+
+type elementSegment struct {
+	offset    constantExpression
+	functions []uint32
+}
+
+type functionBody struct {
+	locals []valueType
+	code   []byte
+	offset uint32
+}
+
+// module is a decoded WebAssembly module, before its functions are compiled
+type module struct {
+	types         []functionType
+	imports       []importedFunction
+	functionTypes []uint32
+	table         *limits
+	memory        *limits
+	globals       []global
+	exports       map[string]export
+	exportNames   []string
+	start         int64
+	elements      []elementSegment
+	data          []dataSegment
+	bodies        []functionBody
+}
+
+func decodeModule(code []byte) (*module, error) {
+	r := newReader(code)
+	header, err := r.readBytes(len(wasmMagic) + len(wasmVersion))
+	if err != nil || string(header[:len(wasmMagic)]) != wasmMagic {
+		return nil, fmt.Errorf("%w: not a WebAssembly module", ErrInvalidModule)
+	}
+	if string(header[len(wasmMagic):]) != wasmVersion {
+		return nil, fmt.Errorf("%w: unsupported version", ErrInvalidModule)
+	}
+
+	m := &module{
+		exports: make(map[string]export),
+		start:   -1,
+	}
+	for !r.isEOF() {
+		sectionID, err := r.readByte()
+		if err != nil {
+			return nil, err
+		}
+		sectionSize, err := r.readU32()
+		if err != nil {
+			return nil, err
+		}
+		contents, err := r.readBytes(int(sectionSize))
+		if err != nil {
+			return nil, err
+		}
+
+		err = m.decodeSection(sectionID, newReader(contents))
+		if err != nil {
+			return nil, fmt.Errorf("%w: section %d: %s", ErrInvalidModule, sectionID, err.Error())
+		}
+	}
+
+	if len(m.functionTypes) != len(m.bodies) {
+		return nil, fmt.Errorf("%w: function and code sections have different lengths", ErrInvalidModule)
+	}
+	return m, nil
+}
+
+func (m *module) decodeSection(sectionID byte, r *reader) error {
+	var err error
+	switch sectionID {
+	case sectionCustom, sectionDataCnt:
+		return nil
+	case sectionType:
+		err = r.readVector(m.decodeFunctionType)
+	case sectionImport:
+		err = r.readVector(m.decodeImport)
+	case sectionFunction:
+		err = r.readVector(func(r *reader) error {
+			typeIndex, err := r.readU32()
+			m.functionTypes = append(m.functionTypes, typeIndex)
+			return err
+		})
+	case sectionTable:
+		err = r.readVector(m.decodeTable)
+	case sectionMemory:
+		err = r.readVector(m.decodeMemory)
+	case sectionGlobal:
+		err = r.readVector(m.decodeGlobal)
+	case sectionExport:
+		err = r.readVector(m.decodeExport)
+	case sectionStart:
+		var start uint32
+		start, err = r.readU32()
+		m.start = int64(start)
+	case sectionElement:
+		err = r.readVector(m.decodeElementSegment)
+	case sectionCode:
+		err = r.readVector(m.decodeFunctionBody)
+	case sectionData:
+		err = r.readVector(m.decodeDataSegment)
+	default:
+		return fmt.Errorf("unknown section id")
+	}
+	if err != nil {
+		return err
+	}
+	if !r.isEOF() {
+		return fmt.Errorf("unexpected data at the end of the section")
+	}
+	return nil
+}
+
+func readValueTypes(r *reader) ([]valueType, error) {
+	count, err := r.readU32()
+	if err != nil {
+		return nil, err
+	}
+	raw, err := r.readBytes(int(count))
+	if err != nil {
+		return nil, err
+	}
+	valueTypes := make([]valueType, count)
+	for i, b := range raw {
+		valueTypes[i] = valueType(b)
+		if !valueTypes[i].isValid() {
+			return nil, fmt.Errorf("%w: value type 0x%02x", ErrUnsupportedInstruction, b)
+		}
+	}
+	return valueTypes, nil
+}
+
+func readLimits(r *reader) (limits, error) {
+	flags, err := r.readByte()
+	if err != nil {
+		return limits{}, err
+	}
+	initial, err := r.readU32()
+	if err != nil {
+		return limits{}, err
+	}
+	result := limits{initial: initial}
+	if flags&0x01 != 0 {
+		result.maximum, err = r.readU32()
+		result.hasMaximum = true
+	}
+	return result, err
+}
+
+func readConstantExpression(r *reader) (constantExpression, error) {
+	opcode, err := r.readByte()
+	if err != nil {
+		return constantExpression{}, err
+	}
+	expression := constantExpression{opcode: opcode}
+	switch opcode {
+	case opI32Const:
+		var value int64
+		value, err = r.readVarInt(32)
+		expression.value = uint64(uint32(value))
+	case opI64Const:
+		var value int64
+		value, err = r.readVarInt(64)
+		expression.value = uint64(value)
+	case opGlobalGet:
+		var index uint32
+		index, err = r.readU32()
+		expression.value = uint64(index)
+	case opF32Const:
+		var raw []byte
+		raw, err = r.readBytes(4)
+		if err == nil {
+			expression.value = uint64(uint32(raw[0]) | uint32(raw[1])<<8 | uint32(raw[2])<<16 | uint32(raw[3])<<24)
+		}
+	case opF64Const:
+		var raw []byte
+		raw, err = r.readBytes(8)
+		if err == nil {
+			for i := 7; i >= 0; i-- {
+				expression.value = expression.value<<8 | uint64(raw[i])
+			}
+		}
+	default:
+		return expression, fmt.Errorf("unsupported constant expression opcode 0x%02x", opcode)
+	}
+	if err != nil {
+		return expression, err
+	}
+
+	end, err := r.readByte()
+	if err != nil {
+		return expression, err
+	}
+	if end != opEnd {
+		return expression, fmt.Errorf("constant expression with more than one instruction")
+	}
+	return expression, nil
+}
+
+func (m *module) decodeFunctionType(r *reader) error {
+	form, err := r.readByte()
+	if err != nil {
+		return err
+	}
+	if form != functionTypeForm {
+		return fmt.Errorf("invalid function type form 0x%02x", form)
+	}
+	params, err := readValueTypes(r)
+	if err != nil {
+		return err
+	}
+	results, err := readValueTypes(r)
+	if err != nil {
+		return err
+	}
+	if len(results) > 1 {
+		return fmt.Errorf("%w: functions with more than one result", ErrUnsupportedInstruction)
+	}
+	m.types = append(m.types, functionType{params: params, results: results})
+	return nil
+}
+
+func (m *module) decodeImport(r *reader) error {
+	moduleName, err := r.readName()
+	if err != nil {
+		return err
+	}
+	name, err := r.readName()
+	if err != nil {
+		return err
+	}
+	kind, err := r.readByte()
+	if err != nil {
+		return err
+	}
+	if kind != externalFunction {
+		return fmt.Errorf("%w: %s.%s is not a function", ErrUnsupportedImport, moduleName, name)
+	}
+	typeIndex, err := r.readU32()
+	if err != nil {
+		return err
+	}
+	if int(typeIndex) >= len(m.types) {
+		return fmt.Errorf("invalid type index %d", typeIndex)
+	}
+	m.imports = append(m.imports, importedFunction{module: moduleName, name: name, typeIndex: typeIndex})
+	return nil
+}
+
+func (m *module) decodeTable(r *reader) error {
+	if m.table != nil {
+		return fmt.Errorf("more than one table")
+	}
+	elementType, err := r.readByte()
+	if err != nil {
+		return err
+	}
+	if elementType != funcRefType {
+		return fmt.Errorf("%w: table element type 0x%02x", ErrUnsupportedInstruction, elementType)
+	}
+	table, err := readLimits(r)
+	if err != nil {
+		return err
+	}
+	m.table = &table
+	return nil
+}
+
+func (m *module) decodeMemory(r *reader) error {
+	if m.memory != nil {
+		return fmt.Errorf("more than one memory")
+	}
+	memory, err := readLimits(r)
+	if err != nil {
+		return err
+	}
+	if memory.initial > maxPages || memory.hasMaximum && (memory.maximum > maxPages || memory.maximum < memory.initial) {
+		return fmt.Errorf("invalid memory limits")
+	}
+	m.memory = &memory
+	return nil
+}
+
+func (m *module) decodeGlobal(r *reader) error {
+	types, err := r.readBytes(2)
+	if err != nil {
+		return err
+	}
+	g := global{valueType: valueType(types[0]), mutable: types[1] == 1}
+	if !g.valueType.isValid() || types[1] > 1 {
+		return fmt.Errorf("invalid global type")
+	}
+	g.init, err = readConstantExpression(r)
+	if err != nil {
+		return err
+	}
+	if g.init.opcode == opGlobalGet && g.init.value >= uint64(len(m.globals)) {
+		return fmt.Errorf("invalid global index %d", g.init.value)
+	}
+	m.globals = append(m.globals, g)
+	return nil
+}
+
+func (m *module) decodeExport(r *reader) error {
+	name, err := r.readName()
+	if err != nil {
+		return err
+	}
+	kind, err := r.readByte()
+	if err != nil {
+		return err
+	}
+	index, err := r.readU32()
+	if err != nil {
+		return err
+	}
+	if _, exists := m.exports[name]; exists {
+		return fmt.Errorf("duplicate export %s", name)
+	}
+	m.exports[name] = export{kind: kind, index: index}
+	m.exportNames = append(m.exportNames, name)
+	return nil
+}
+
+func (m *module) decodeElementSegment(r *reader) error {
+	flags, err := r.readU32()
+	if err != nil {
+		return err
+	}
+	if flags != 0 {
+		return fmt.Errorf("%w: element segment flags %d", ErrUnsupportedInstruction, flags)
+	}
+	offset, err := readConstantExpression(r)
+	if err != nil {
+		return err
+	}
+	segment := elementSegment{offset: offset}
+	err = r.readVector(func(r *reader) error {
+		functionIndex, err := r.readU32()
+		segment.functions = append(segment.functions, functionIndex)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	m.elements = append(m.elements, segment)
+	return nil
+}
+
+func (m *module) decodeFunctionBody(r *reader) error {
+	bodySize, err := r.readU32()
+	if err != nil {
+		return err
+	}
+	body, err := r.readBytes(int(bodySize))
+	if err != nil {
+		return err
+	}
+
+	bodyReader := newReader(body)
+	var locals []valueType
+	err = bodyReader.readVector(func(r *reader) error {
+		count, err := r.readU32()
+		if err != nil {
+			return err
+		}
+		localType, err := r.readByte()
+		if err != nil {
+			return err
+		}
+		if !valueType(localType).isValid() {
+			return fmt.Errorf("%w: local type 0x%02x", ErrUnsupportedInstruction, localType)
+		}
+		if uint64(len(locals))+uint64(count) > math.MaxUint16 {
+			return fmt.Errorf("too many locals")
+		}
+		for i := uint32(0); i < count; i++ {
+			locals = append(locals, valueType(localType))
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	bodyOffset := r.offset - len(body)
+	m.bodies = append(m.bodies, functionBody{
+		locals: locals,
+		code:   body[bodyReader.offset:],
+		offset: uint32(bodyOffset + bodyReader.offset),
+	})
+	return nil
+}
+
+func (m *module) decodeDataSegment(r *reader) error {
+	flags, err := r.readU32()
+	if err != nil {
+		return err
+	}
+	segment := dataSegment{}
+	switch flags {
+	case 0:
+		segment.offset, err = readConstantExpression(r)
+	case 1:
+		segment.passive = true
+	case 2:
+		var memoryIndex uint32
+		memoryIndex, err = r.readU32()
+		if err == nil && memoryIndex != 0 {
+			err = fmt.Errorf("invalid memory index %d", memoryIndex)
+		}
+		if err == nil {
+			segment.offset, err = readConstantExpression(r)
+		}
+	default:
+		err = fmt.Errorf("invalid data segment flags %d", flags)
+	}
+	if err != nil {
+		return err
+	}
+
+	size, err := r.readU32()
+	if err != nil {
+		return err
+	}
+	segment.data, err = r.readBytes(int(size))
+	if err != nil {
+		return err
+	}
+	m.data = append(m.data, segment)
+	return nil
+}
+
+func (m *module) numFunctions() int {
+	return len(m.imports) + len(m.functionTypes)
+}
+
+// functionType yields the signature of the function with the given index, counting the imports first
+func (m *module) functionType(functionIndex uint32) (*functionType, error) {
+	typeIndex := uint32(0)
+	switch {
+	case int(functionIndex) < len(m.imports):
+		typeIndex = m.imports[functionIndex].typeIndex
+	case int(functionIndex) < m.numFunctions():
+		typeIndex = m.functionTypes[int(functionIndex)-len(m.imports)]
+	default:
+		return nil, fmt.Errorf("invalid function index %d", functionIndex)
+	}
+	if int(typeIndex) >= len(m.types) {
+		return nil, fmt.Errorf("invalid type index %d", typeIndex)
+	}
+	return &m.types[typeIndex], nil
+}
@@ -0,0 +1,275 @@
+package wasmgo
+
+import (
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/executor"
+)
+
+// The single byte opcodes of the WebAssembly binary format.
+const (
+	opUnreachable        = 0x00
+	opNop                = 0x01
+	opBlock              = 0x02
+	opLoop               = 0x03
+	opIf                 = 0x04
+	opElse               = 0x05
+	opEnd                = 0x0b
+	opBr                 = 0x0c
+	opBrIf               = 0x0d
+	opBrTable            = 0x0e
+	opReturn             = 0x0f
+	opCall               = 0x10
+	opCallIndirect       = 0x11
+	opDrop               = 0x1a
+	opSelect             = 0x1b
+	opTypedSelect        = 0x1c
+	opLocalGet           = 0x20
+	opLocalSet           = 0x21
+	opLocalTee           = 0x22
+	opGlobalGet          = 0x23
+	opGlobalSet          = 0x24
+	opI32Load            = 0x28
+	opI64Load            = 0x29
+	opF32Load            = 0x2a
+	opF64Load            = 0x2b
+	opI32Load8S          = 0x2c
+	opI32Load8U          = 0x2d
+	opI32Load16S         = 0x2e
+	opI32Load16U         = 0x2f
+	opI64Load8S          = 0x30
+	opI64Load8U          = 0x31
+	opI64Load16S         = 0x32
+	opI64Load16U         = 0x33
+	opI64Load32S         = 0x34
+	opI64Load32U         = 0x35
+	opI32Store           = 0x36
+	opI64Store           = 0x37
+	opF32Store           = 0x38
+	opF64Store           = 0x39
+	opI32Store8          = 0x3a
+	opI32Store16         = 0x3b
+	opI64Store8          = 0x3c
+	opI64Store16         = 0x3d
+	opI64Store32         = 0x3e
+	opMemorySize         = 0x3f
+	opMemoryGrow         = 0x40
+	opI32Const           = 0x41
+	opI64Const           = 0x42
+	opF32Const           = 0x43
+	opF64Const           = 0x44
+	opI32Eqz             = 0x45
+	opI32Eq              = 0x46
+	opI32Ne              = 0x47
+	opI32LtS             = 0x48
+	opI32LtU             = 0x49
+	opI32GtS             = 0x4a
+	opI32GtU             = 0x4b
+	opI32LeS             = 0x4c
+	opI32LeU             = 0x4d
+	opI32GeS             = 0x4e
+	opI32GeU             = 0x4f
+	opI64Eqz             = 0x50
+	opI64Eq              = 0x51
+	opI64Ne              = 0x52
+	opI64LtS             = 0x53
+	opI64LtU             = 0x54
+	opI64GtS             = 0x55
+	opI64GtU             = 0x56
+	opI64LeS             = 0x57
+	opI64LeU             = 0x58
+	opI64GeS             = 0x59
+	opI64GeU             = 0x5a
+	opI32Clz             = 0x67
+	opI32Ctz             = 0x68
+	opI32Popcnt          = 0x69
+	opI32Add             = 0x6a
+	opI32Sub             = 0x6b
+	opI32Mul             = 0x6c
+	opI32DivS            = 0x6d
+	opI32DivU            = 0x6e
+	opI32RemS            = 0x6f
+	opI32RemU            = 0x70
+	opI32And             = 0x71
+	opI32Or              = 0x72
+	opI32Xor             = 0x73
+	opI32Shl             = 0x74
+	opI32ShrS            = 0x75
+	opI32ShrU            = 0x76
+	opI32Rotl            = 0x77
+	opI32Rotr            = 0x78
+	opI64Clz             = 0x79
+	opI64Ctz             = 0x7a
+	opI64Popcnt          = 0x7b
+	opI64Add             = 0x7c
+	opI64Sub             = 0x7d
+	opI64Mul             = 0x7e
+	opI64DivS            = 0x7f
+	opI64DivU            = 0x80
+	opI64RemS            = 0x81
+	opI64RemU            = 0x82
+	opI64And             = 0x83
+	opI64Or              = 0x84
+	opI64Xor             = 0x85
+	opI64Shl             = 0x86
+	opI64ShrS            = 0x87
+	opI64ShrU            = 0x88
+	opI64Rotl            = 0x89
+	opI64Rotr            = 0x8a
+	opI32WrapI64         = 0xa7
+	opI64ExtendI32S      = 0xac
+	opI64ExtendI32U      = 0xad
+	opI32Extend8S        = 0xc0
+	opI32Extend16S       = 0xc1
+	opI64Extend8S        = 0xc2
+	opI64Extend16S       = 0xc3
+	opI64Extend32S       = 0xc4
+	opPrefixMisc         = 0xfc
+	opMiscMemoryInit     = 0xfc08
+	opMiscDataDrop       = 0xfc09
+	opMiscMemoryCopy     = 0xfc0a
+	opMiscMemoryFill     = 0xfc0b
+	firstFloatCompare    = 0x5b
+	lastFloatCompare     = 0x66
+	firstFloatArithmetic = 0x8b
+	lastFloatArithmetic  = 0xa6
+	firstFloatConversion = 0xa8
+	lastFloatConversion  = 0xbf
+)
+
+const blockTypeEmpty = 0x40
+
+// costIndexes maps the opcodes to their index in the opcode costs array, the same one that configures Wasmer
+var costIndexes = map[uint16]int{
+	opUnreachable:    executor.OpcodeUnreachable,
+	opNop:            executor.OpcodeNop,
+	opBlock:          executor.OpcodeBlock,
+	opLoop:           executor.OpcodeLoop,
+	opIf:             executor.OpcodeIf,
+	opElse:           executor.OpcodeElse,
+	opEnd:            executor.OpcodeEnd,
+	opBr:             executor.OpcodeBr,
+	opBrIf:           executor.OpcodeBrIf,
+	opBrTable:        executor.OpcodeBrTable,
+	opReturn:         executor.OpcodeReturn,
+	opCall:           executor.OpcodeCall,
+	opCallIndirect:   executor.OpcodeCallIndirect,
+	opDrop:           executor.OpcodeDrop,
+	opSelect:         executor.OpcodeSelect,
+	opTypedSelect:    executor.OpcodeTypedSelect,
+	opLocalGet:       executor.OpcodeLocalGet,
+	opLocalSet:       executor.OpcodeLocalSet,
+	opLocalTee:       executor.OpcodeLocalTee,
+	opGlobalGet:      executor.OpcodeGlobalGet,
+	opGlobalSet:      executor.OpcodeGlobalSet,
+	opI32Load:        executor.OpcodeI32Load,
+	opI64Load:        executor.OpcodeI64Load,
+	opI32Load8S:      executor.OpcodeI32Load8S,
+	opI32Load8U:      executor.OpcodeI32Load8U,
+	opI32Load16S:     executor.OpcodeI32Load16S,
+	opI32Load16U:     executor.OpcodeI32Load16U,
+	opI64Load8S:      executor.OpcodeI64Load8S,
+	opI64Load8U:      executor.OpcodeI64Load8U,
+	opI64Load16S:     executor.OpcodeI64Load16S,
+	opI64Load16U:     executor.OpcodeI64Load16U,
+	opI64Load32S:     executor.OpcodeI64Load32S,
+	opI64Load32U:     executor.OpcodeI64Load32U,
+	opI32Store:       executor.OpcodeI32Store,
+	opI64Store:       executor.OpcodeI64Store,
+	opI32Store8:      executor.OpcodeI32Store8,
+	opI32Store16:     executor.OpcodeI32Store16,
+	opI64Store8:      executor.OpcodeI64Store8,
+	opI64Store16:     executor.OpcodeI64Store16,
+	opI64Store32:     executor.OpcodeI64Store32,
+	opMemorySize:     executor.OpcodeMemorySize,
+	opMemoryGrow:     executor.OpcodeMemoryGrow,
+	opI32Const:       executor.OpcodeI32Const,
+	opI64Const:       executor.OpcodeI64Const,
+	opI32Eqz:         executor.OpcodeI32Eqz,
+	opI32Eq:          executor.OpcodeI32Eq,
+	opI32Ne:          executor.OpcodeI32Ne,
+	opI32LtS:         executor.OpcodeI32LtS,
+	opI32LtU:         executor.OpcodeI32LtU,
+	opI32GtS:         executor.OpcodeI32GtS,
+	opI32GtU:         executor.OpcodeI32GtU,
+	opI32LeS:         executor.OpcodeI32LeS,
+	opI32LeU:         executor.OpcodeI32LeU,
+	opI32GeS:         executor.OpcodeI32GeS,
+	opI32GeU:         executor.OpcodeI32GeU,
+	opI64Eqz:         executor.OpcodeI64Eqz,
+	opI64Eq:          executor.OpcodeI64Eq,
+	opI64Ne:          executor.OpcodeI64Ne,
+	opI64LtS:         executor.OpcodeI64LtS,
+	opI64LtU:         executor.OpcodeI64LtU,
+	opI64GtS:         executor.OpcodeI64GtS,
+	opI64GtU:         executor.OpcodeI64GtU,
+	opI64LeS:         executor.OpcodeI64LeS,
+	opI64LeU:         executor.OpcodeI64LeU,
+	opI64GeS:         executor.OpcodeI64GeS,
+	opI64GeU:         executor.OpcodeI64GeU,
+	opI32Clz:         executor.OpcodeI32Clz,
+	opI32Ctz:         executor.OpcodeI32Ctz,
+	opI32Popcnt:      executor.OpcodeI32Popcnt,
+	opI32Add:         executor.OpcodeI32Add,
+	opI32Sub:         executor.OpcodeI32Sub,
+	opI32Mul:         executor.OpcodeI32Mul,
+	opI32DivS:        executor.OpcodeI32DivS,
+	opI32DivU:        executor.OpcodeI32DivU,
+	opI32RemS:        executor.OpcodeI32RemS,
+	opI32RemU:        executor.OpcodeI32RemU,
+	opI32And:         executor.OpcodeI32And,
+	opI32Or:          executor.OpcodeI32Or,
+	opI32Xor:         executor.OpcodeI32Xor,
+	opI32Shl:         executor.OpcodeI32Shl,
+	opI32ShrS:        executor.OpcodeI32ShrS,
+	opI32ShrU:        executor.OpcodeI32ShrU,
+	opI32Rotl:        executor.OpcodeI32Rotl,
+	opI32Rotr:        executor.OpcodeI32Rotr,
+	opI64Clz:         executor.OpcodeI64Clz,
+	opI64Ctz:         executor.OpcodeI64Ctz,
+	opI64Popcnt:      executor.OpcodeI64Popcnt,
+	opI64Add:         executor.OpcodeI64Add,
+	opI64Sub:         executor.OpcodeI64Sub,
+	opI64Mul:         executor.OpcodeI64Mul,
+	opI64DivS:        executor.OpcodeI64DivS,
+	opI64DivU:        executor.OpcodeI64DivU,
+	opI64RemS:        executor.OpcodeI64RemS,
+	opI64RemU:        executor.OpcodeI64RemU,
+	opI64And:         executor.OpcodeI64And,
+	opI64Or:          executor.OpcodeI64Or,
+	opI64Xor:         executor.OpcodeI64Xor,
+	opI64Shl:         executor.OpcodeI64Shl,
+	opI64ShrS:        executor.OpcodeI64ShrS,
+	opI64ShrU:        executor.OpcodeI64ShrU,
+	opI64Rotl:        executor.OpcodeI64Rotl,
+	opI64Rotr:        executor.OpcodeI64Rotr,
+	opI32WrapI64:     executor.OpcodeI32WrapI64,
+	opI64ExtendI32S:  executor.OpcodeI64ExtendI32S,
+	opI64ExtendI32U:  executor.OpcodeI64ExtendI32U,
+	opI32Extend8S:    executor.OpcodeI32Extend8S,
+	opI32Extend16S:   executor.OpcodeI32Extend16S,
+	opI64Extend8S:    executor.OpcodeI64Extend8S,
+	opI64Extend16S:   executor.OpcodeI64Extend16S,
+	opI64Extend32S:   executor.OpcodeI64Extend32S,
+	opMiscMemoryInit: executor.OpcodeMemoryInit,
+	opMiscDataDrop:   executor.OpcodeDataDrop,
+	opMiscMemoryCopy: executor.OpcodeMemoryCopy,
+	opMiscMemoryFill: executor.OpcodeMemoryFill,
+}
+
+// isFloatOpcode tells if the opcode operates on floating point values
+func isFloatOpcode(opcode uint16) bool {
+	switch {
+	case opcode == opF32Load || opcode == opF64Load || opcode == opF32Store || opcode == opF64Store:
+		return true
+	case opcode == opF32Const || opcode == opF64Const:
+		return true
+	case opcode >= firstFloatCompare && opcode <= lastFloatCompare:
+		return true
+	case opcode >= firstFloatArithmetic && opcode <= lastFloatArithmetic:
+		return true
+	case opcode >= firstFloatConversion && opcode <= lastFloatConversion:
+		return opcode != opI64ExtendI32S && opcode != opI64ExtendI32U
+	case opcode >= opPrefixMisc<<8 && opcode <= opPrefixMisc<<8|0x07:
+		return true
+	}
+	return false
+}
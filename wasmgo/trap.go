@@ -0,0 +1,51 @@
+package wasmgo
+
+import (
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen/wasmanalysis"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/executor"
+)
+
+// maxTrapFrames bounds the call stack captured at a trap; the outermost calls of deeper stacks are left out
+const maxTrapFrames = 100
+
+// addTrapFrame records the function unwound by an execution error on the call stack of the error.
+// The frame points at the instruction the function was executing: the one that trapped, or a call.
+func addTrapFrame(err error, function *compiledFunction, pc int) error {
+	trapErr, ok := err.(*executor.TrapError)
+	if !ok {
+		trapErr = &executor.TrapError{Err: err}
+	}
+	if len(trapErr.Frames) >= maxTrapFrames {
+		return trapErr
+	}
+
+	codeOffset := function.offset
+	if pc > 0 {
+		codeOffset = function.code[pc-1].offset
+	}
+	trapErr.Frames = append(trapErr.Frames, executor.TrapFrame{
+		FunctionIndex: function.index,
+		CodeOffset:    codeOffset,
+	})
+	return trapErr
+}
+
+// symbolize resolves the frames of a trap to function names and source locations.
+// The debug info is only parsed on the first trap of the module, and shared by all its instances.
+func (compiled *compiledModule) symbolize(frames []executor.TrapFrame) {
+	compiled.debugInfoOnce.Do(func() {
+		compiled.debugInfo, _ = wasmanalysis.ParseDebugInfo(compiled.code)
+	})
+
+	debugInfo := compiled.debugInfo
+	for i := range frames {
+		frame := &frames[i]
+		frame.FunctionName = debugInfo.FunctionName(frame.FunctionIndex)
+		location, ok := debugInfo.SourceLocation(frame.CodeOffset)
+		if ok {
+			frame.File = location.File
+			frame.Line = location.Line
+			frame.Column = location.Column
+		}
+	}
+}